@@ -42,6 +42,10 @@ func newMsgFactory(localMsg bool, rocksmqPath string) msgstream.Factory {
 	if localMsg {
 		return msgstream.NewRmsFactory(rocksmqPath)
 	}
+	paramtable.Params.Init()
+	if brokerList, _ := paramtable.Params.Load("_KafkaBrokerList"); brokerList != "" {
+		return msgstream.NewKmsFactory(strings.Split(brokerList, ","))
+	}
 	return msgstream.NewPmsFactory()
 }
 
@@ -78,6 +78,9 @@ struct QueryResult {
     std::vector<int64_t> internal_seg_offsets_;
     std::vector<int64_t> result_offsets_;
     std::vector<std::vector<char>> row_data_;
+
+    // set by range search, whether more hits in the distance range of each query may be missed
+    std::vector<bool> range_search_truncated_;
 };
 
 using QueryResultPtr = std::shared_ptr<QueryResult>;
@@ -36,6 +36,9 @@ struct QueryInfo {
     MetricType metric_type_;
     std::string deprecated_metric_type_;  // TODO: use enum
     nlohmann::json search_params_;
+    // set for range search only, see FilterRangeSearchResult
+    std::optional<float> radius_;
+    std::optional<float> range_filter_;
 };
 
 struct VectorPlanNode : PlanNode {
@@ -99,6 +99,12 @@ ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     query_info.metric_type_ = GetMetricType(query_info_proto.metric_type());
     query_info.topK_ = query_info_proto.topk();
     query_info.search_params_ = json::parse(query_info_proto.search_params());
+    if (query_info_proto.range_search()) {
+        query_info.radius_ = query_info_proto.radius();
+        if (query_info_proto.has_range_filter()) {
+            query_info.range_filter_ = query_info_proto.range_filter();
+        }
+    }
 
     auto plan_node = [&]() -> std::unique_ptr<VectorPlanNode> {
         if (anns_proto.is_binary()) {
@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#include <algorithm>
+#include <optional>
+
+#include "query/RangeSearch.h"
+#include "query/SubQueryResult.h"
+
+namespace milvus::query {
+
+namespace {
+// RangeChecker tells where a distance is relative to the distance range, which is (radius, range_filter] for IP
+// and [range_filter, radius) for the other metrics, range_filter is unbounded if not set
+class RangeChecker {
+ public:
+    explicit RangeChecker(const QueryInfo& query_info)
+        : is_desc_(SubQueryResult::is_descending(query_info.metric_type_)),
+          radius_(query_info.radius_.value()),
+          range_filter_(query_info.range_filter_) {
+    }
+
+    bool
+    in_radius(float distance) const {
+        return is_desc_ ? distance > radius_ : distance < radius_;
+    }
+
+    bool
+    in_range(float distance) const {
+        if (!in_radius(distance)) {
+            return false;
+        }
+        if (!range_filter_.has_value()) {
+            return true;
+        }
+        return is_desc_ ? distance <= range_filter_.value() : distance >= range_filter_.value();
+    }
+
+ private:
+    bool is_desc_;
+    float radius_;
+    std::optional<float> range_filter_;
+};
+
+bool
+query_completed(const RangeChecker& checker, const int64_t* offsets, const float* distances, int64_t k, int64_t topk) {
+    if (k == 0 || offsets[k - 1] == -1 || !checker.in_radius(distances[k - 1])) {
+        return true;
+    }
+    auto in_range = std::count_if(distances, distances + k, [&](float distance) { return checker.in_range(distance); });
+    return in_range >= topk;
+}
+}  // namespace
+
+bool
+RangeSearchCompleted(const QueryInfo& query_info, const QueryResult& result, int64_t topk) {
+    if (!query_info.radius_.has_value()) {
+        return true;
+    }
+    RangeChecker checker(query_info);
+    auto k = result.topK_;
+    for (int64_t q = 0; q < result.num_queries_; ++q) {
+        auto offsets = result.internal_seg_offsets_.data() + q * k;
+        auto distances = result.result_distances_.data() + q * k;
+        if (!query_completed(checker, offsets, distances, k, topk)) {
+            return false;
+        }
+    }
+    return true;
+}
+
+QueryResult
+FilterRangeSearchResult(const QueryInfo& query_info, const QueryResult& result, int64_t topk) {
+    if (!query_info.radius_.has_value()) {
+        return result;
+    }
+    RangeChecker checker(query_info);
+    auto invalid_value = SubQueryResult::init_value(query_info.metric_type_);
+    QueryResult filtered(result.num_queries_, topk);
+    std::fill(filtered.internal_seg_offsets_.begin(), filtered.internal_seg_offsets_.end(), -1);
+    std::fill(filtered.result_distances_.begin(), filtered.result_distances_.end(), invalid_value);
+    filtered.range_search_truncated_.resize(result.num_queries_, false);
+
+    auto k = result.topK_;
+    for (int64_t q = 0; q < result.num_queries_; ++q) {
+        auto offsets = result.internal_seg_offsets_.data() + q * k;
+        auto distances = result.result_distances_.data() + q * k;
+        auto dst_offsets = filtered.internal_seg_offsets_.data() + q * topk;
+        auto dst_distances = filtered.result_distances_.data() + q * topk;
+        int64_t valid = 0;
+        for (int64_t i = 0; i < k && valid < topk; ++i) {
+            if (offsets[i] != -1 && checker.in_range(distances[i])) {
+                dst_offsets[valid] = offsets[i];
+                dst_distances[valid] = distances[i];
+                ++valid;
+            }
+        }
+        filtered.range_search_truncated_[q] = !query_completed(checker, offsets, distances, k, topk);
+    }
+    return filtered;
+}
+
+}  // namespace milvus::query
@@ -0,0 +1,31 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#pragma once
+
+#include "common/Types.h"
+#include "query/PlanNode.h"
+
+namespace milvus::query {
+// RangeSearchCompleted checks whether the top k hits of each query in result contain its first topk hits in the
+// distance range of a range search, that is the query has topk hits in the range, or its last hit is out of the
+// radius or invalid. The hits are sorted from the closest, so no hit in the range follows then.
+bool
+RangeSearchCompleted(const QueryInfo& query_info, const QueryResult& result, int64_t topk);
+
+// FilterRangeSearchResult returns the first topk hits in the distance range of a range search among the top k hits
+// of each query in result, padded with the hits of offset -1 like the hits beyond the row count of a segment. The
+// queries not completed, see RangeSearchCompleted, are marked in range_search_truncated_ of the returned result,
+// more of their hits may be in the range.
+QueryResult
+FilterRangeSearchResult(const QueryInfo& query_info, const QueryResult& result, int64_t topk);
+
+}  // namespace milvus::query
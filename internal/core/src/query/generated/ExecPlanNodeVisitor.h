@@ -15,7 +15,9 @@
 #include "utils/Json.h"
 #include "query/PlanImpl.h"
 #include "segcore/SegmentGrowing.h"
+#include <algorithm>
 #include <utility>
+#include <vector>
 #include "PlanNodeVisitor.h"
 
 namespace milvus::query {
@@ -34,6 +36,15 @@ class ExecPlanNodeVisitor : public PlanNodeVisitor {
                         const PlaceholderGroup& placeholder_group)
         : segment_(segment), timestamp_(timestamp), placeholder_group_(placeholder_group) {
     }
+    // every query of the placeholder group searches the data visible at its own timestamp
+    ExecPlanNodeVisitor(const segcore::SegmentInterface& segment,
+                        std::vector<Timestamp> timestamps,
+                        const PlaceholderGroup& placeholder_group)
+        : segment_(segment),
+          timestamp_(timestamps.empty() ? 0 : *std::max_element(timestamps.begin(), timestamps.end())),
+          timestamps_(std::move(timestamps)),
+          placeholder_group_(placeholder_group) {
+    }
     // using RetType = nlohmann::json;
 
     RetType
@@ -55,6 +66,7 @@ class ExecPlanNodeVisitor : public PlanNodeVisitor {
     // std::optional<RetType> ret_;
     const segcore::SegmentInterface& segment_;
     Timestamp timestamp_;
+    std::vector<Timestamp> timestamps_;  // timestamps of the queries, all of them search at timestamp_ if empty
     const PlaceholderGroup& placeholder_group_;
 
     std::optional<RetType> ret_;
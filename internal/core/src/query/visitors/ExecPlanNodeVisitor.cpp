@@ -12,12 +12,17 @@
 #include "utils/Json.h"
 #include "query/PlanImpl.h"
 #include "segcore/SegmentGrowing.h"
+#include <algorithm>
+#include <map>
 #include <utility>
+#include <vector>
 #include "query/generated/ExecPlanNodeVisitor.h"
 #include "segcore/SegmentGrowingImpl.h"
 #include "query/generated/ExecExprVisitor.h"
 #include "query/SearchOnGrowing.h"
 #include "query/SearchOnSealed.h"
+#include "query/RangeSearch.h"
+#include "utils/tools.h"
 
 namespace milvus::query {
 
@@ -33,6 +38,15 @@ class ExecPlanNodeVisitor : PlanNodeVisitor {
                         const PlaceholderGroup& placeholder_group)
         : segment_(segment), timestamp_(timestamp), placeholder_group_(placeholder_group) {
     }
+    // every query of the placeholder group searches the data visible at its own timestamp
+    ExecPlanNodeVisitor(const segcore::SegmentInterface& segment,
+                        std::vector<Timestamp> timestamps,
+                        const PlaceholderGroup& placeholder_group)
+        : segment_(segment),
+          timestamp_(timestamps.empty() ? 0 : *std::max_element(timestamps.begin(), timestamps.end())),
+          timestamps_(std::move(timestamps)),
+          placeholder_group_(placeholder_group) {
+    }
     // using RetType = nlohmann::json;
 
     RetType
@@ -54,6 +68,7 @@ class ExecPlanNodeVisitor : PlanNodeVisitor {
     // std::optional<RetType> ret_;
     const segcore::SegmentInterface& segment_;
     Timestamp timestamp_;
+    std::vector<Timestamp> timestamps_;  // timestamps of the queries, all of them search at timestamp_ if empty
     const PlaceholderGroup& placeholder_group_;
 
     std::optional<RetType> ret_;
@@ -72,6 +87,46 @@ empty_query_result(int64_t num_queries, int64_t topk, MetricType metric_type) {
     return final_result;
 }
 
+// the max k the vector indexes search with
+constexpr int64_t MAX_SEARCH_TOPK = 16384;
+
+static int64_t
+count_rows(const ExecExprVisitor::RetType& bitset_chunks) {
+    int64_t count = 0;
+    for (auto& chunk : bitset_chunks) {
+        count += chunk.count();
+    }
+    return count;
+}
+
+// copy_hits copies the top k hits of query src_query of src to query dst_query of dst, skipping the hits not
+// visible to the query if visible is given
+static void
+copy_hits(const QueryResult& src,
+          int64_t src_query,
+          QueryResult& dst,
+          int64_t dst_query,
+          int64_t size_per_chunk,
+          const ExecExprVisitor::RetType* visible) {
+    auto src_offsets = src.internal_seg_offsets_.data() + src_query * src.topK_;
+    auto src_distances = src.result_distances_.data() + src_query * src.topK_;
+    auto dst_offsets = dst.internal_seg_offsets_.data() + dst_query * dst.topK_;
+    auto dst_distances = dst.result_distances_.data() + dst_query * dst.topK_;
+    int64_t copied = 0;
+    for (int64_t i = 0; i < src.topK_ && copied < dst.topK_; ++i) {
+        auto offset = src_offsets[i];
+        if (offset == -1) {
+            break;
+        }
+        if (visible != nullptr && !(*visible)[offset / size_per_chunk][offset % size_per_chunk]) {
+            continue;
+        }
+        dst_offsets[copied] = offset;
+        dst_distances[copied] = src_distances[i];
+        ++copied;
+    }
+}
+
 template <typename VectorType>
 void
 ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
@@ -79,13 +134,12 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
     assert(!ret_.has_value());
     auto segment = dynamic_cast<const segcore::SegmentInternalInterface*>(&segment_);
     AssertInfo(segment, "support SegmentSmallIndex Only");
-    RetType ret;
     auto& ph = placeholder_group_.at(0);
     auto src_data = ph.get_blob<EmbeddedType<VectorType>>();
     auto num_queries = ph.num_of_queries_;
+    AssertInfo(timestamps_.empty() || static_cast<int64_t>(timestamps_.size()) == num_queries,
+               "timestamps mismatch the queries");
 
-    aligned_vector<uint8_t> bitset_holder;
-    BitsetView view;
     // TODO: add API to unify row_count
     // auto row_count = segment->get_row_count();
     auto active_count = segment->get_active_count(timestamp_);
@@ -96,17 +150,124 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
         return;
     }
 
+    auto size_per_chunk = segment->size_per_chunk();
+    ExecExprVisitor::RetType candidates;
     if (node.predicate_.has_value()) {
-        ExecExprVisitor::RetType expr_ret =
-            ExecExprVisitor(*segment, active_count, timestamp_).call_child(*node.predicate_.value());
-        segment->mask_with_timestamps(expr_ret, timestamp_);
-        bitset_holder = AssembleNegBitset(expr_ret);
-        view = BitsetView(bitset_holder.data(), bitset_holder.size() * 8);
+        candidates = ExecExprVisitor(*segment, active_count, timestamp_).call_child(*node.predicate_.value());
+    } else {
+        // no predicate, every active row is a candidate
+        auto num_chunk = upper_div(active_count, size_per_chunk);
+        for (int64_t chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+            boost::dynamic_bitset<> chunk(size_per_chunk);
+            chunk.set();
+            candidates.emplace_back(std::move(chunk));
+        }
+    }
+    // the candidates inserted and not deleted at the timestamp
+    auto visible_at = [&](Timestamp timestamp) {
+        auto visible = candidates;
+        if (node.predicate_.has_value()) {
+            segment->mask_with_timestamps(visible, timestamp);
+        }
+        auto ins_barrier = segment->get_active_count(timestamp);
+        for (auto offset = ins_barrier; offset < active_count; ++offset) {
+            visible[offset / size_per_chunk][offset % size_per_chunk] = false;
+        }
+        segment->mask_with_delete(visible, ins_barrier, timestamp);
+        return visible;
+    };
+    auto search = [&](const ExecExprVisitor::RetType& visible, const QueryInfo& query_info, const void* query_data,
+                      int64_t query_count, QueryResult& output) {
+        auto bitset_holder = AssembleNegBitset(visible);
+        BitsetView view(bitset_holder.data(), bitset_holder.size() * 8);
+        segment->vector_search(active_count, query_info, query_data, query_count, MAX_TIMESTAMP, view, output);
+    };
+
+    std::map<Timestamp, std::vector<int64_t>> queries_at;
+    for (int64_t i = 0; i < static_cast<int64_t>(timestamps_.size()); ++i) {
+        queries_at[timestamps_[i]].push_back(i);
+    }
+    // the queries of a batch may travel to different timestamps, the rows visible at any of them are searched at
+    // once with the top k widened by the most rows invisible to some query, so that the top k hits of every query
+    // are still found after dropping the hits invisible to it
+    std::map<Timestamp, ExecExprVisitor::RetType> visible;
+    ExecExprVisitor::RetType any_visible;
+    int64_t invisible_count = 0;
+    if (queries_at.size() <= 1) {
+        any_visible = visible_at(timestamp_);
+    } else {
+        for (auto& [timestamp, queries] : queries_at) {
+            auto rows = visible_at(timestamp);
+            if (any_visible.empty()) {
+                any_visible = rows;
+            } else {
+                for (size_t chunk_id = 0; chunk_id < rows.size(); ++chunk_id) {
+                    any_visible[chunk_id] |= rows[chunk_id];
+                }
+            }
+            visible.emplace(timestamp, std::move(rows));
+        }
+        auto any_visible_count = count_rows(any_visible);
+        for (auto& [timestamp, rows] : visible) {
+            invisible_count = std::max(invisible_count, any_visible_count - count_rows(rows));
+        }
     }
 
-    segment->vector_search(active_count, node.query_info_, src_data, num_queries, MAX_TIMESTAMP, view, ret);
+    // search_topk searches the top k hits of every query among the rows visible to it
+    auto search_topk = [&](int64_t topk) {
+        auto query_info = node.query_info_;
+        query_info.topK_ = topk;
+        QueryResult result;
+        if (queries_at.size() <= 1) {
+            search(any_visible, query_info, src_data, num_queries, result);
+            return result;
+        }
 
-    ret_ = ret;
+        SubQueryResult empty(num_queries, topk, query_info.metric_type_);
+        result.num_queries_ = num_queries;
+        result.topK_ = topk;
+        result.internal_seg_offsets_ = std::move(empty.mutable_labels());
+        result.result_distances_ = std::move(empty.mutable_values());
+        if (topk + invisible_count <= MAX_SEARCH_TOPK) {
+            auto batch_query_info = query_info;
+            batch_query_info.topK_ = topk + invisible_count;
+            QueryResult batch;
+            search(any_visible, batch_query_info, src_data, num_queries, batch);
+            for (int64_t i = 0; i < num_queries; ++i) {
+                copy_hits(batch, i, result, i, size_per_chunk, &visible.at(timestamps_[i]));
+            }
+            return result;
+        }
+        // too many rows changed between the timestamps, the queries of every timestamp are searched apart
+        for (auto& [timestamp, queries] : queries_at) {
+            aligned_vector<char> query_data(queries.size() * ph.line_sizeof_);
+            for (size_t i = 0; i < queries.size(); ++i) {
+                std::copy_n(ph.blob_.data() + queries[i] * ph.line_sizeof_, ph.line_sizeof_,
+                            query_data.data() + i * ph.line_sizeof_);
+            }
+            QueryResult sub_result;
+            search(visible.at(timestamp), query_info, query_data.data(), queries.size(), sub_result);
+            for (size_t i = 0; i < queries.size(); ++i) {
+                copy_hits(sub_result, i, result, queries[i], size_per_chunk, nullptr);
+            }
+        }
+        return result;
+    };
+
+    auto topk = node.query_info_.topK_;
+    if (!node.query_info_.radius_.has_value()) {
+        ret_ = search_topk(topk);
+        return;
+    }
+    // range search keeps widening k until the top k hits contain the first topk hits in the distance range of
+    // every query, the queries still not completed at MAX_SEARCH_TOPK are marked truncated
+    auto k = topk;
+    auto result = search_topk(k);
+    while (k < MAX_SEARCH_TOPK && !RangeSearchCompleted(node.query_info_, result, topk)) {
+        k = std::min(k * 2, MAX_SEARCH_TOPK);
+        result = search_topk(k);
+    }
+    ret_ = FilterRangeSearchResult(node.query_info_, result, topk);
 }
 
 void
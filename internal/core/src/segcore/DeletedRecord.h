@@ -16,6 +16,10 @@
 #include "knowhere/index/vector_index/IndexIVF.h"
 #include <utility>
 #include <memory>
+#include <unordered_map>
+#include <vector>
+#include <tuple>
+#include <algorithm>
 #include "segcore/Record.h"
 
 namespace milvus::segcore {
@@ -55,6 +59,50 @@ struct DeletedRecord {
         lru_ = std::move(new_entry);
     }
 
+    int64_t
+    PreDelete(int64_t size) {
+        return reserved.fetch_add(size);
+    }
+
+    void
+    Delete(int64_t reserved_begin, int64_t size, const idx_t* uids_raw, const Timestamp* timestamps_raw) {
+        std::vector<std::tuple<Timestamp, idx_t>> ordering(size);
+        for (int64_t i = 0; i < size; ++i) {
+            ordering[i] = std::make_tuple(timestamps_raw[i], uids_raw[i]);
+        }
+        std::sort(ordering.begin(), ordering.end());
+        std::vector<idx_t> uids(size);
+        std::vector<Timestamp> timestamps(size);
+        for (int64_t index = 0; index < size; ++index) {
+            auto [t, uid] = ordering[index];
+            timestamps[index] = t;
+            uids[index] = uid;
+        }
+        timestamps_.set_data(reserved_begin, timestamps.data(), size);
+        uids_.set_data(reserved_begin, uids.data(), size);
+        ack_responder_.AddSegment(reserved_begin, reserved_begin + size);
+    }
+
+    // map each deleted uid to the latest delete timestamp which is not after query timestamp,
+    // a row is invisible if it was inserted before the delete timestamp of its uid
+    std::unordered_map<idx_t, Timestamp>
+    get_delete_timestamps(Timestamp query_timestamp) const {
+        std::unordered_map<idx_t, Timestamp> res;
+        auto del_barrier = ack_responder_.GetAck();
+        for (int64_t del_index = 0; del_index < del_barrier; ++del_index) {
+            auto del_ts = timestamps_[del_index];
+            if (del_ts > query_timestamp) {
+                continue;
+            }
+            auto uid = uids_[del_index];
+            auto iter = res.find(uid);
+            if (iter == res.end() || iter->second < del_ts) {
+                res[uid] = del_ts;
+            }
+        }
+        return res;
+    }
+
  public:
     std::atomic<int64_t> reserved = 0;
     AckResponder ack_responder_;
@@ -39,8 +39,7 @@ SegmentGrowingImpl::PreInsert(int64_t size) {
 
 int64_t
 SegmentGrowingImpl::PreDelete(int64_t size) {
-    auto reserved_begin = deleted_record_.reserved.fetch_add(size);
-    return reserved_begin;
+    return deleted_record_.PreDelete(size);
 }
 
 auto
@@ -213,31 +212,8 @@ SegmentGrowingImpl::Delete(int64_t reserved_begin,
                            int64_t size,
                            const int64_t* uids_raw,
                            const Timestamp* timestamps_raw) {
-    std::vector<std::tuple<Timestamp, idx_t>> ordering;
-    ordering.resize(size);
-    // #pragma omp parallel for
-    for (int i = 0; i < size; ++i) {
-        ordering[i] = std::make_tuple(timestamps_raw[i], uids_raw[i]);
-    }
-    std::sort(ordering.begin(), ordering.end());
-    std::vector<idx_t> uids(size);
-    std::vector<Timestamp> timestamps(size);
-    // #pragma omp parallel for
-    for (int index = 0; index < size; ++index) {
-        auto [t, uid] = ordering[index];
-        timestamps[index] = t;
-        uids[index] = uid;
-    }
-    deleted_record_.timestamps_.set_data(reserved_begin, timestamps.data(), size);
-    deleted_record_.uids_.set_data(reserved_begin, uids.data(), size);
-    deleted_record_.ack_responder_.AddSegment(reserved_begin, reserved_begin + size);
+    deleted_record_.Delete(reserved_begin, size, uids_raw, timestamps_raw);
     return Status::OK();
-    //    for (int i = 0; i < size; ++i) {
-    //        auto key = row_ids[i];
-    //        auto time = timestamps[i];
-    //        delete_logs_.insert(std::make_pair(key, time));
-    //    }
-    //    return Status::OK();
 }
 
 Status
@@ -470,6 +446,7 @@ SegmentGrowingImpl::search_ids(const IdArray& id_array, Timestamp timestamp) con
     auto res_id_arr = std::make_unique<IdArray>();
     auto res_int_id_arr = res_id_arr->mutable_int_id();
     std::vector<SegOffset> res_offsets;
+    auto delete_timestamps = deleted_record_.get_delete_timestamps(timestamp);
     for (auto uid : src_int_arr.data()) {
         auto [iter_b, iter_e] = uid2offset_.equal_range(uid);
         SegOffset the_offset(-1);
@@ -483,6 +460,11 @@ SegmentGrowingImpl::search_ids(const IdArray& id_array, Timestamp timestamp) con
         if (the_offset == SegOffset(-1)) {
             continue;
         }
+        // if deleted, skip
+        auto del_iter = delete_timestamps.find(uid);
+        if (del_iter != delete_timestamps.end() && record_.timestamps_[the_offset.get()] < del_iter->second) {
+            continue;
+        }
         res_int_id_arr->add_data(uid);
         res_offsets.push_back(the_offset);
     }
@@ -509,4 +491,25 @@ SegmentGrowingImpl::mask_with_timestamps(std::deque<boost::dynamic_bitset<>>& bi
     // DO NOTHING
 }
 
+void
+SegmentGrowingImpl::mask_with_delete(std::deque<boost::dynamic_bitset<>>& bitset_chunks,
+                                     int64_t ins_barrier,
+                                     Timestamp timestamp) const {
+    auto delete_timestamps = deleted_record_.get_delete_timestamps(timestamp);
+    if (delete_timestamps.empty()) {
+        return;
+    }
+    auto size_per_chunk = segcore_config_.get_size_per_chunk();
+    for (auto [uid, del_ts] : delete_timestamps) {
+        auto [iter_b, iter_e] = uid2offset_.equal_range(uid);
+        for (auto iter = iter_b; iter != iter_e; ++iter) {
+            auto offset = iter->second;
+            if (offset >= ins_barrier || record_.timestamps_[offset] >= del_ts) {
+                continue;
+            }
+            bitset_chunks[offset / size_per_chunk][offset % size_per_chunk] = false;
+        }
+    }
+}
+
 }  // namespace milvus::segcore
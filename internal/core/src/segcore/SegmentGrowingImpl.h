@@ -177,6 +177,11 @@ class SegmentGrowingImpl : public SegmentGrowing {
     void
     mask_with_timestamps(std::deque<boost::dynamic_bitset<>>& bitset_chunks, Timestamp timestamp) const override;
 
+    void
+    mask_with_delete(std::deque<boost::dynamic_bitset<>>& bitset_chunks,
+                     int64_t ins_barrier,
+                     Timestamp timestamp) const override;
+
     void
     vector_search(int64_t vec_count,
                   query::QueryInfo query_info,
@@ -82,6 +82,17 @@ SegmentInternalInterface::Search(const query::Plan* plan,
     return results;
 }
 
+QueryResult
+SegmentInternalInterface::Search(const query::Plan* plan,
+                                 const query::PlaceholderGroup& placeholder_group,
+                                 const std::vector<Timestamp>& timestamps) const {
+    std::shared_lock lck(mutex_);
+    check_search(plan);
+    query::ExecPlanNodeVisitor visitor(*this, timestamps, placeholder_group);
+    auto results = visitor.get_moved_result(*plan->plan_node_);
+    return results;
+}
+
 // Note: this is temporary solution.
 // modify bulk script implement to make process more clear
 static std::unique_ptr<ScalarArray>
@@ -39,6 +39,12 @@ class SegmentInterface {
     virtual QueryResult
     Search(const query::Plan* Plan, const query::PlaceholderGroup& placeholder_group, Timestamp timestamp) const = 0;
 
+    // every query of the placeholder group searches the data visible at its own timestamp
+    virtual QueryResult
+    Search(const query::Plan* Plan,
+           const query::PlaceholderGroup& placeholder_group,
+           const std::vector<Timestamp>& timestamps) const = 0;
+
     virtual std::unique_ptr<proto::segcore::RetrieveResults>
     GetEntityById(const std::vector<FieldOffset>& field_offsets,
                   const IdArray& id_array,
@@ -50,6 +56,12 @@ class SegmentInterface {
     virtual int64_t
     get_row_count() const = 0;
 
+    virtual int64_t
+    PreDelete(int64_t size) = 0;
+
+    virtual Status
+    Delete(int64_t reserved_offset, int64_t size, const int64_t* row_ids, const Timestamp* timestamps) = 0;
+
     virtual const Schema&
     get_schema() const = 0;
 
@@ -84,6 +96,11 @@ class SegmentInternalInterface : public SegmentInterface {
            const query::PlaceholderGroup& placeholder_group,
            Timestamp timestamp) const override;
 
+    QueryResult
+    Search(const query::Plan* Plan,
+           const query::PlaceholderGroup& placeholder_group,
+           const std::vector<Timestamp>& timestamps) const override;
+
     void
     FillTargetEntry(const query::Plan* plan, QueryResult& results) const override;
 
@@ -112,6 +129,12 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual void
     mask_with_timestamps(std::deque<boost::dynamic_bitset<>>& bitset_chunks, Timestamp timestamp) const = 0;
 
+    // clear the bits of rows which have been deleted before timestamp
+    virtual void
+    mask_with_delete(std::deque<boost::dynamic_bitset<>>& bitset_chunks,
+                     int64_t ins_barrier,
+                     Timestamp timestamp) const = 0;
+
     // count of chunks
     virtual int64_t
     num_chunk() const = 0;
@@ -443,7 +443,26 @@ SegmentSealedImpl::search_ids(const IdArray& id_array, Timestamp timestamp) cons
     AssertInfo(id_array.has_int_id(), "string ids are not implemented");
     auto arr = id_array.int_id();
     Assert(primary_key_index_);
-    return primary_key_index_->do_search_ids(id_array);
+    auto [ids, offsets] = primary_key_index_->do_search_ids(id_array);
+    auto delete_timestamps = deleted_record_.get_delete_timestamps(timestamp);
+    if (delete_timestamps.empty()) {
+        return {std::move(ids), std::move(offsets)};
+    }
+
+    auto res_id_arr = std::make_unique<IdArray>();
+    auto res_int_id_arr = res_id_arr->mutable_int_id();
+    std::vector<SegOffset> res_offsets;
+    for (int64_t i = 0; i < offsets.size(); ++i) {
+        auto uid = ids->int_id().data(i);
+        auto offset = offsets[i];
+        auto iter = delete_timestamps.find(uid);
+        if (iter != delete_timestamps.end() && timestamps_[offset.get()] < iter->second) {
+            continue;
+        }
+        res_int_id_arr->add_data(uid);
+        res_offsets.push_back(offset);
+    }
+    return {std::move(res_id_arr), std::move(res_offsets)};
 }
 
 std::string
@@ -484,6 +503,46 @@ SegmentSealedImpl::mask_with_timestamps(std::deque<boost::dynamic_bitset<>>& bit
     bitset_chunks[0] &= mask;
 }
 
+int64_t
+SegmentSealedImpl::PreDelete(int64_t size) {
+    return deleted_record_.PreDelete(size);
+}
+
+Status
+SegmentSealedImpl::Delete(int64_t reserved_offset,
+                          int64_t size,
+                          const int64_t* row_ids,
+                          const Timestamp* timestamps) {
+    deleted_record_.Delete(reserved_offset, size, row_ids, timestamps);
+    return Status::OK();
+}
+
+void
+SegmentSealedImpl::mask_with_delete(std::deque<boost::dynamic_bitset<>>& bitset_chunks,
+                                    int64_t ins_barrier,
+                                    Timestamp timestamp) const {
+    auto delete_timestamps = deleted_record_.get_delete_timestamps(timestamp);
+    if (delete_timestamps.empty()) {
+        return;
+    }
+    Assert(primary_key_index_);
+    Assert(bitset_chunks.size() == 1);
+    IdArray id_array;
+    auto int_id_arr = id_array.mutable_int_id();
+    for (auto [uid, del_ts] : delete_timestamps) {
+        int_id_arr->add_data(uid);
+    }
+    auto [ids, offsets] = primary_key_index_->do_search_ids(id_array);
+    for (int64_t i = 0; i < offsets.size(); ++i) {
+        auto offset = offsets[i].get();
+        auto del_ts = delete_timestamps.at(ids->int_id().data(i));
+        if (offset >= ins_barrier || timestamps_[offset] >= del_ts) {
+            continue;
+        }
+        bitset_chunks[0][offset] = false;
+    }
+}
+
 SegmentSealedPtr
 CreateSealedSegment(SchemaPtr schema) {
     return std::make_unique<SegmentSealedImpl>(schema);
@@ -14,6 +14,7 @@
 #include "segcore/SegmentSealed.h"
 #include "SealedIndexingRecord.h"
 #include "ScalarIndex.h"
+#include "DeletedRecord.h"
 #include <deque>
 #include <map>
 #include <vector>
@@ -50,6 +51,12 @@ class SegmentSealedImpl : public SegmentSealed {
     const Schema&
     get_schema() const override;
 
+    int64_t
+    PreDelete(int64_t size) override;
+
+    Status
+    Delete(int64_t reserved_offset, int64_t size, const int64_t* row_ids, const Timestamp* timestamps) override;
+
  public:
     int64_t
     num_chunk_index(FieldOffset field_offset) const override;
@@ -109,6 +116,11 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     mask_with_timestamps(std::deque<boost::dynamic_bitset<>>& bitset_chunks, Timestamp timestamp) const override;
 
+    void
+    mask_with_delete(std::deque<boost::dynamic_bitset<>>& bitset_chunks,
+                     int64_t ins_barrier,
+                     Timestamp timestamp) const override;
+
     void
     vector_search(int64_t vec_count,
                   query::QueryInfo query_info,
@@ -150,6 +162,7 @@ class SegmentSealedImpl : public SegmentSealed {
     aligned_vector<idx_t> row_ids_;
     aligned_vector<Timestamp> timestamps_;
     TimestampIndex timestamp_index_;
+    DeletedRecord deleted_record_;
     SchemaPtr schema_;
 };
 }  // namespace milvus::segcore
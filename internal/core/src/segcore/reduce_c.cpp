@@ -357,3 +357,12 @@ GetHitSizePeerQueries(CMarshaledHits c_marshaled_hits, int64_t group_index, int6
         hit_size_peer_query[i] = blob_lens[i];
     }
 }
+
+void
+GetRangeSearchTruncated(CQueryResult c_search_result, int64_t num_queries, bool* truncated) {
+    auto search_result = (SearchResult*)c_search_result;
+    auto& range_search_truncated = search_result->range_search_truncated_;
+    for (int64_t i = 0; i < num_queries && i < range_search_truncated.size(); i++) {
+        truncated[i] = truncated[i] || range_search_truncated[i];
+    }
+}
@@ -57,6 +57,11 @@ GetNumQueriesPeerGroup(CMarshaledHits c_marshaled_hits, int64_t group_index);
 void
 GetHitSizePeerQueries(CMarshaledHits c_marshaled_hits, int64_t group_index, int64_t* hit_size_peer_query);
 
+// truncated must hold num_queries elements, truncated[i] is set if query i of a range search
+// may have more hits within the radius than the ones in c_search_result
+void
+GetRangeSearchTruncated(CQueryResult c_search_result, int64_t num_queries, bool* truncated);
+
 #ifdef __cplusplus
 }
 #endif
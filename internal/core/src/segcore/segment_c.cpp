@@ -88,6 +88,32 @@ Search(CSegmentInterface c_segment,
     }
 }
 
+CStatus
+SearchWithTimestamps(CSegmentInterface c_segment,
+                     CPlan c_plan,
+                     CPlaceholderGroup c_placeholder_group,
+                     const uint64_t* timestamps,
+                     int64_t num_queries,
+                     CQueryResult* result) {
+    auto query_result = std::make_unique<milvus::QueryResult>();
+    try {
+        auto segment = (milvus::segcore::SegmentInterface*)c_segment;
+        auto plan = (milvus::query::Plan*)c_plan;
+        auto phg_ptr = reinterpret_cast<const milvus::query::PlaceholderGroup*>(c_placeholder_group);
+        std::vector<milvus::Timestamp> query_timestamps(timestamps, timestamps + num_queries);
+        *query_result = segment->Search(plan, *phg_ptr, query_timestamps);
+        if (plan->plan_node_->query_info_.metric_type_ != milvus::MetricType::METRIC_INNER_PRODUCT) {
+            for (auto& dis : query_result->result_distances_) {
+                dis *= -1;
+            }
+        }
+        *result = query_result.release();
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 CStatus
 FillTargetEntry(CSegmentInterface c_segment, CPlan c_plan, CQueryResult c_result) {
     auto segment = (milvus::segcore::SegmentInterface*)c_segment;
@@ -165,7 +191,7 @@ Delete(CSegmentInterface c_segment,
        int64_t size,
        const int64_t* row_ids,
        const uint64_t* timestamps) {
-    auto segment = (milvus::segcore::SegmentGrowing*)c_segment;
+    auto segment = (milvus::segcore::SegmentInterface*)c_segment;
 
     try {
         auto res = segment->Delete(reserved_offset, size, row_ids, timestamps);
@@ -177,7 +203,7 @@ Delete(CSegmentInterface c_segment,
 
 int64_t
 PreDelete(CSegmentInterface c_segment, int64_t size) {
-    auto segment = (milvus::segcore::SegmentGrowing*)c_segment;
+    auto segment = (milvus::segcore::SegmentInterface*)c_segment;
 
     return segment->PreDelete(size);
 }
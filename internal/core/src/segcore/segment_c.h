@@ -43,6 +43,16 @@ Search(CSegmentInterface c_segment,
        uint64_t timestamp,
        CQueryResult* result);
 
+// SearchWithTimestamps searches every query of the placeholder group at its own timestamp, timestamps has
+// num_queries elements, one for each query
+CStatus
+SearchWithTimestamps(CSegmentInterface c_segment,
+                     CPlan c_plan,
+                     CPlaceholderGroup c_placeholder_group,
+                     const uint64_t* timestamps,
+                     int64_t num_queries,
+                     CQueryResult* result);
+
 CProtoResult
 GetEntityByIds(CSegmentInterface c_segment, CRetrievePlan plan, uint64_t timestamp);
 
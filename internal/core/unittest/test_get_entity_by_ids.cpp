@@ -155,3 +155,56 @@ TEST(GetEntityByIds, PrimaryKey) {
         }
     }
 }
+
+TEST(GetEntityByIds, Delete) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("counter_i64", DataType::INT64);
+    auto DIM = 16;
+    auto fid_vec = schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, MetricType::METRIC_L2);
+    schema->set_primary_key(FieldOffset(0));
+
+    int64_t N = 10000;
+    int64_t req_size = 10;
+    auto choose = [=](int i) { return i * 3 % N; };
+
+    auto dataset = DataGen(schema, N);
+    auto i64_col = dataset.get_col<int64_t>(0);
+
+    auto req_ids = std::make_unique<IdArray>();
+    auto req_ids_arr = req_ids->mutable_int_id();
+    for (int i = 0; i < req_size; ++i) {
+        req_ids_arr->add_data(i64_col[choose(i)]);
+    }
+
+    // delete the first half of the requested ids after all rows are inserted
+    int64_t del_size = req_size / 2;
+    std::vector<int64_t> del_ids;
+    std::vector<Timestamp> del_tss(del_size, N);
+    for (int i = 0; i < del_size; ++i) {
+        del_ids.push_back(i64_col[choose(i)]);
+    }
+
+    auto growing = CreateGrowingSegment(schema);
+    auto offset = growing->PreInsert(N);
+    growing->Insert(offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(), dataset.raw_);
+
+    auto sealed = CreateSealedSegment(schema);
+    SealedLoader(dataset, *sealed);
+
+    std::vector<FieldOffset> target_offsets{FieldOffset(0), FieldOffset(1)};
+    for (SegmentInterface* segment : std::vector<SegmentInterface*>{growing.get(), sealed.get()}) {
+        auto del_offset = segment->PreDelete(del_size);
+        segment->Delete(del_offset, del_size, del_ids.data(), del_tss.data());
+
+        // deletes are invisible to queries before the delete timestamp
+        auto before = segment->GetEntityById(target_offsets, *req_ids, N - 1);
+        ASSERT_EQ(before->ids().int_id().data_size(), req_size);
+
+        auto after = segment->GetEntityById(target_offsets, *req_ids, N + 1);
+        auto ids = after->ids().int_id();
+        ASSERT_EQ(ids.data_size(), req_size - del_size);
+        for (int i = 0; i < ids.data_size(); ++i) {
+            ASSERT_EQ(ids.data(i), i64_col[choose(i + del_size)]);
+        }
+    }
+}
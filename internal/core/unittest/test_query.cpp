@@ -367,6 +367,62 @@ TEST(Query, ExecWithPredicate) {
     ASSERT_EQ(json.dump(2), ref.dump(2));
 }
 
+TEST(Query, ExecWithTimestamps) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    schema->AddDebugField("age", DataType::FLOAT);
+    std::string dsl = R"({
+        "bool": {
+            "must": [
+            {
+                "vector": {
+                    "fakevec": {
+                        "metric_type": "L2",
+                        "params": {
+                            "nprobe": 10
+                        },
+                        "query": "$0",
+                        "topk": 5
+                    }
+                }
+            }
+            ]
+        }
+    })";
+    int64_t N = 10000;
+    Timestamp max_ts = N;
+    auto dataset = DataGen(schema, N);
+    auto segment = CreateGrowingSegment(schema);
+    segment->PreInsert(N);
+    segment->Insert(0, N, dataset.row_ids_.data(), dataset.timestamps_.data(), dataset.raw_);
+    // the first rows are deleted in the middle of the insertions
+    int64_t num_deleted = 100;
+    std::vector<Timestamp> delete_timestamps(num_deleted, max_ts / 2);
+    auto offset = segment->PreDelete(num_deleted);
+    segment->Delete(offset, num_deleted, dataset.row_ids_.data(), delete_timestamps.data());
+
+    auto plan = CreatePlan(*schema, dsl);
+    auto num_queries = 5;
+    auto ph_group_raw = CreatePlaceholderGroup(num_queries, 16, 1024);
+    auto ph_group = ParsePlaceholderGroup(plan.get(), ph_group_raw.SerializeAsString());
+    std::vector<Timestamp> timestamps{max_ts / 4, max_ts / 4, max_ts / 2 - 1, max_ts / 2, max_ts};
+
+    // every query sees the same hits as searching at its own timestamp
+    auto qr = segment->Search(plan.get(), *ph_group, timestamps);
+    ASSERT_EQ(qr.num_queries_, num_queries);
+    auto topk = qr.topK_;
+    for (int64_t i = 0; i < num_queries; ++i) {
+        auto expected = segment->Search(plan.get(), *ph_group, timestamps[i]);
+        ASSERT_EQ(expected.topK_, topk);
+        for (int64_t k = 0; k < topk; ++k) {
+            ASSERT_EQ(qr.internal_seg_offsets_[i * topk + k], expected.internal_seg_offsets_[i * topk + k]);
+            ASSERT_EQ(qr.result_distances_[i * topk + k], expected.result_distances_[i * topk + k]);
+        }
+    }
+}
+
 TEST(Query, ExecTerm) {
     using namespace milvus::query;
     using namespace milvus::segcore;
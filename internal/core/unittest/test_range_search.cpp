@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#include <gtest/gtest.h>
+#include <vector>
+#include "query/RangeSearch.h"
+#include "query/SubQueryResult.h"
+
+using namespace milvus;
+using namespace milvus::query;
+
+TEST(RangeSearch, L2) {
+    QueryResult result(2, 4);
+    result.internal_seg_offsets_ = {1, 2, 3, 4, 5, 6, -1, -1};
+    result.result_distances_ = {0.5, 1.0, 2.0, 3.0, 1.5, 2.5, 3.0e38, 3.0e38};
+    QueryInfo query_info;
+    query_info.metric_type_ = MetricType::METRIC_L2;
+
+    // not a range search
+    ASSERT_TRUE(RangeSearchCompleted(query_info, result, 4));
+    auto filtered = FilterRangeSearchResult(query_info, result, 4);
+    ASSERT_EQ(filtered.internal_seg_offsets_, std::vector<int64_t>({1, 2, 3, 4, 5, 6, -1, -1}));
+
+    query_info.radius_ = 2.5;
+    query_info.range_filter_ = 1.0;
+    ASSERT_TRUE(RangeSearchCompleted(query_info, result, 4));
+    filtered = FilterRangeSearchResult(query_info, result, 4);
+    auto invalid = SubQueryResult::init_value(MetricType::METRIC_L2);
+    ASSERT_EQ(filtered.topK_, 4);
+    ASSERT_EQ(filtered.internal_seg_offsets_, std::vector<int64_t>({2, 3, -1, -1, 5, -1, -1, -1}));
+    ASSERT_EQ(filtered.result_distances_,
+              std::vector<float>({1.0, 2.0, invalid, invalid, 1.5, invalid, invalid, invalid}));
+    ASSERT_EQ(filtered.range_search_truncated_, std::vector<bool>({false, false}));
+}
+
+TEST(RangeSearch, IP) {
+    QueryResult result(1, 4);
+    result.internal_seg_offsets_ = {1, 2, 3, 4};
+    result.result_distances_ = {0.9, 0.8, 0.5, 0.1};
+    QueryInfo query_info;
+    query_info.metric_type_ = MetricType::METRIC_INNER_PRODUCT;
+    query_info.radius_ = 0.5;
+
+    auto filtered = FilterRangeSearchResult(query_info, result, 4);
+    auto invalid = SubQueryResult::init_value(MetricType::METRIC_INNER_PRODUCT);
+    ASSERT_EQ(filtered.internal_seg_offsets_, std::vector<int64_t>({1, 2, -1, -1}));
+    ASSERT_EQ(filtered.result_distances_, std::vector<float>({0.9, 0.8, invalid, invalid}));
+    ASSERT_EQ(filtered.range_search_truncated_, std::vector<bool>({false}));
+}
+
+TEST(RangeSearch, Truncated) {
+    // the top 4 hits are all closer than the radius but only 3 of them are in the range, more may follow
+    QueryResult result(1, 4);
+    result.internal_seg_offsets_ = {1, 2, 3, 4};
+    result.result_distances_ = {0.5, 1.0, 1.5, 2.0};
+    QueryInfo query_info;
+    query_info.metric_type_ = MetricType::METRIC_L2;
+    query_info.radius_ = 3.0;
+    query_info.range_filter_ = 1.0;
+
+    ASSERT_FALSE(RangeSearchCompleted(query_info, result, 4));
+    auto filtered = FilterRangeSearchResult(query_info, result, 4);
+    ASSERT_EQ(filtered.internal_seg_offsets_, std::vector<int64_t>({2, 3, 4, -1}));
+    ASSERT_EQ(filtered.range_search_truncated_, std::vector<bool>({true}));
+
+    // enough hits in the range are found
+    ASSERT_TRUE(RangeSearchCompleted(query_info, result, 3));
+    filtered = FilterRangeSearchResult(query_info, result, 3);
+    ASSERT_EQ(filtered.internal_seg_offsets_, std::vector<int64_t>({2, 3, 4}));
+    ASSERT_EQ(filtered.range_search_truncated_, std::vector<bool>({false}));
+}
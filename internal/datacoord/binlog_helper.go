@@ -4,6 +4,7 @@ import (
 	"errors"
 	"path"
 	"strconv"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -127,6 +128,50 @@ func (s *Server) getSegmentBinlogMeta(segmentID UniqueID) (metas []*datapb.Segme
 	return
 }
 
+// getSegmentFieldBinlogs querys segment binlog paths from kv store, grouped by field
+func (s *Server) getSegmentFieldBinlogs(segmentID UniqueID) ([]*datapb.ID2PathList, error) {
+	metas, err := s.getSegmentBinlogMeta(segmentID)
+	if err != nil {
+		return nil, err
+	}
+	field2Paths := make(map[UniqueID]*datapb.ID2PathList)
+	ret := make([]*datapb.ID2PathList, 0)
+	for _, m := range metas {
+		paths, ok := field2Paths[m.FieldID]
+		if !ok {
+			paths = &datapb.ID2PathList{ID: m.FieldID}
+			field2Paths[m.FieldID] = paths
+			ret = append(ret, paths)
+		}
+		paths.Paths = append(paths.Paths, m.BinlogPath)
+	}
+	return ret, nil
+}
+
+// listSegmentBinlogKeys lists the kv store keys of segment binlog paths
+func (s *Server) listSegmentBinlogKeys(segmentID UniqueID) ([]string, error) {
+	prefix, err := s.genKey(false, segmentID)
+	if err != nil {
+		return nil, err
+	}
+	prefix = path.Join(Params.SegmentBinlogSubPath, prefix)
+	keys, _, err := s.kvClient.LoadWithPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	// loaded keys contain the root path of kv client, and keys of segment 10 also match the prefix of segment 1
+	prefix += "/"
+	ret := make([]string, 0, len(keys))
+	for _, key := range keys {
+		idx := strings.Index(key, prefix)
+		if idx < 0 {
+			continue
+		}
+		ret = append(ret, key[idx:])
+	}
+	return ret, nil
+}
+
 // GetVChanPositions get vchannel latest postitions with provided dml channel names
 func (s *Server) GetVChanPositions(vchans []vchannel, isAccurate bool) ([]*datapb.VchannelInfo, error) {
 	if s.kvClient == nil {
@@ -143,6 +188,10 @@ func (s *Server) GetVChanPositions(vchans []vchannel, isAccurate bool) ([]*datap
 		for _, s := range segments {
 			if s.State == commonpb.SegmentState_Flushing || s.State == commonpb.SegmentState_Flushed {
 				flushedSegmentIDs = append(flushedSegmentIDs, s.ID)
+				// segments imported from files have no position in the channel
+				if s.DmlPosition == nil {
+					continue
+				}
 				if seekPosition == nil || (!useUnflushedPosition && s.DmlPosition.Timestamp > seekPosition.Timestamp) {
 					seekPosition = s.DmlPosition
 				}
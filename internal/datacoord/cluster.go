@@ -12,6 +12,7 @@
 package datacoord
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -46,8 +47,10 @@ const (
 type NodeEventType int
 
 const (
-	Watch NodeEventType = 0
-	Flush NodeEventType = 1
+	Watch      NodeEventType = 0
+	Flush      NodeEventType = 1
+	Compaction NodeEventType = 2
+	Import     NodeEventType = 3
 )
 
 type Event struct {
@@ -265,6 +268,32 @@ func (c *Cluster) handleEvent(node *NodeInfo) {
 				if err = VerifyResponse(resp, err); err != nil {
 					log.Warn("Failed to flush segments", zap.String("addr", node.info.GetAddress()))
 				}
+			case Compaction:
+				req, ok := event.Req.(*datapb.CompactionPlan)
+				if !ok {
+					log.Warn("request type is not Compaction")
+					continue
+				}
+				tCtx, cancel := context.WithTimeout(ctx, eventTimeout)
+				resp, err := cli.Compaction(tCtx, req)
+				cancel()
+				if err = VerifyResponse(resp, err); err != nil {
+					log.Warn("Failed to execute compaction plan", zap.String("addr", node.info.GetAddress()),
+						zap.Int64("planID", req.GetPlanID()), zap.Error(err))
+				}
+			case Import:
+				req, ok := event.Req.(*datapb.ImportTask)
+				if !ok {
+					log.Warn("request type is not Import")
+					continue
+				}
+				tCtx, cancel := context.WithTimeout(ctx, eventTimeout)
+				resp, err := cli.Import(tCtx, req)
+				cancel()
+				if err = VerifyResponse(resp, err); err != nil {
+					log.Warn("Failed to execute import task", zap.String("addr", node.info.GetAddress()),
+						zap.Int64("taskID", req.GetTaskID()), zap.Error(err))
+				}
 			default:
 				log.Warn("Wrong event type", zap.Any("type", event.Type))
 			}
@@ -451,6 +480,55 @@ func (c *Cluster) handleFlush(segments []*datapb.SegmentInfo) {
 	}
 }
 
+// Compaction sends the compaction plan to the datanode which watches the channel of the plan
+func (c *Cluster) Compaction(plan *datapb.CompactionPlan) error {
+	c.mu.Lock()
+	dataNodes := c.nodes.GetNodes()
+	c.mu.Unlock()
+
+	for _, node := range dataNodes {
+		for _, chstatus := range node.info.GetChannels() {
+			if chstatus.Name == plan.GetChannel() {
+				node.GetEventChannel() <- &NodeEvent{
+					Type: Compaction,
+					Req:  plan,
+				}
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("no datanode watches channel %s", plan.GetChannel())
+}
+
+// Import sends the import task to a datanode, the one watching the first channel of the task is preferred.
+// The id of the datanode is returned.
+func (c *Cluster) Import(task *datapb.ImportTask) (UniqueID, error) {
+	c.mu.Lock()
+	dataNodes := c.nodes.GetNodes()
+	c.mu.Unlock()
+	if len(dataNodes) == 0 {
+		return 0, errors.New("no datanode available")
+	}
+
+	target := dataNodes[int(task.GetTaskID())%len(dataNodes)]
+	if len(task.GetChannelNames()) > 0 {
+	loop:
+		for _, node := range dataNodes {
+			for _, chstatus := range node.info.GetChannels() {
+				if chstatus.Name == task.GetChannelNames()[0] {
+					target = node
+					break loop
+				}
+			}
+		}
+	}
+	target.GetEventChannel() <- &NodeEvent{
+		Type: Import,
+		Req:  task,
+	}
+	return target.info.GetVersion(), nil
+}
+
 func (c *Cluster) watch(n *NodeInfo) {
 	var logMsg string
 	uncompletes := make([]vchannel, 0, len(n.info.Channels))
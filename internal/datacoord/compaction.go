@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const handoffSegmentPrefix = "querycoord-handoff"
+
+// compactionExecutor sends compaction plans to the datanodes executing them
+type compactionExecutor interface {
+	Compaction(plan *datapb.CompactionPlan) error
+}
+
+// compactionPlanHandler keeps track of the compaction plans executing on datanodes,
+// a plan is forgotten once it is completed or timed out.
+type compactionPlanHandler struct {
+	mu       sync.RWMutex
+	plans    map[int64]*datapb.CompactionPlan // plan id to executing plan
+	meta     *meta
+	executor compactionExecutor
+	flushCh  chan<- UniqueID
+}
+
+func newCompactionPlanHandler(meta *meta, executor compactionExecutor, flushCh chan<- UniqueID) *compactionPlanHandler {
+	return &compactionPlanHandler{
+		plans:    make(map[int64]*datapb.CompactionPlan),
+		meta:     meta,
+		executor: executor,
+		flushCh:  flushCh,
+	}
+}
+
+// execCompactionPlan sends the plan to the datanode and starts tracking it
+func (h *compactionPlanHandler) execCompactionPlan(plan *datapb.CompactionPlan) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if err := h.executor.Compaction(plan); err != nil {
+		return err
+	}
+	h.plans[plan.GetPlanID()] = plan
+	log.Debug("compaction plan is executing", zap.Int64("planID", plan.GetPlanID()),
+		zap.Int64("targetSegmentID", plan.GetTargetSegmentID()), zap.String("channel", plan.GetChannel()))
+	return nil
+}
+
+// getCompaction returns the executing plan of planID, nil if not found
+func (h *compactionPlanHandler) getCompaction(planID int64) *datapb.CompactionPlan {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.plans[planID]
+}
+
+// isCompacting checks whether the segment is compacted by an executing plan
+func (h *compactionPlanHandler) isCompacting(segmentID UniqueID) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for _, plan := range h.plans {
+		for _, segmentBinlogs := range plan.GetSegmentBinlogs() {
+			if segmentBinlogs.GetSegmentID() == segmentID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// completeCompaction swaps the compacted segments with the result segment in meta, binlogs are
+// the binlog paths meta of the result segment. The result segment is sent to flush channel to notify
+// its flush completion.
+func (h *compactionPlanHandler) completeCompaction(result *datapb.CompactionResult, binlogs map[string]string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	plan, ok := h.plans[result.GetPlanID()]
+	if !ok {
+		return fmt.Errorf("compaction plan %d not found", result.GetPlanID())
+	}
+	if plan.GetTargetSegmentID() != result.GetSegmentID() {
+		return fmt.Errorf("compaction plan %d targets segment %d, but got segment %d",
+			plan.GetPlanID(), plan.GetTargetSegmentID(), result.GetSegmentID())
+	}
+	segment, err := h.meta.CompleteMergeCompaction(plan, result, binlogs)
+	if err != nil {
+		return err
+	}
+	delete(h.plans, plan.GetPlanID())
+	log.Debug("compaction plan completed", zap.Int64("planID", plan.GetPlanID()),
+		zap.Int64("segmentID", segment.GetID()), zap.Int64s("compactionFrom", segment.GetCompactionFrom()),
+		zap.Int64("numOfRows", segment.GetNumOfRows()))
+	h.flushCh <- segment.GetID()
+	return nil
+}
+
+// expireTimeout forgets the plans which are not completed before their timeout
+func (h *compactionPlanHandler) expireTimeout(ts Timestamp) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	now, _ := tsoutil.ParseTS(ts)
+	for id, plan := range h.plans {
+		start, _ := tsoutil.ParseTS(plan.GetStartTime())
+		if now.Sub(start) > time.Duration(plan.GetTimeoutInSeconds())*time.Second {
+			log.Warn("compaction plan timeout", zap.Int64("planID", id))
+			delete(h.plans, id)
+		}
+	}
+}
+
+func buildHandoffSegmentPath(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID) string {
+	return fmt.Sprintf("%s/%d/%d/%d", handoffSegmentPrefix, collectionID, partitionID, segmentID)
+}
@@ -0,0 +1,250 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+package datacoord
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCompactionExecutor struct {
+	plans []*datapb.CompactionPlan
+	err   error
+}
+
+func (e *mockCompactionExecutor) Compaction(plan *datapb.CompactionPlan) error {
+	if e.err != nil {
+		return e.err
+	}
+	e.plans = append(e.plans, plan)
+	return nil
+}
+
+func buildFlushedSegment(id UniqueID, numOfRows int64, deleted uint64) *datapb.SegmentInfo {
+	segment := &datapb.SegmentInfo{
+		ID:            id,
+		CollectionID:  0,
+		PartitionID:   1,
+		InsertChannel: "c1",
+		NumOfRows:     numOfRows,
+		MaxRowNum:     100,
+		State:         commonpb.SegmentState_Flushed,
+	}
+	if deleted > 0 {
+		segment.Deltalogs = []*datapb.DeltaLogInfo{{RecordEntries: deleted, DeltaLogPath: "delta"}}
+	}
+	return segment
+}
+
+func TestSmallAndDeletedPolicy(t *testing.T) {
+	policy := newSmallAndDeletedPolicy(0.5, 0.2)
+
+	t.Run("merge small segments", func(t *testing.T) {
+		segments := []*datapb.SegmentInfo{
+			buildFlushedSegment(1, 40, 0),
+			buildFlushedSegment(2, 30, 0),
+			buildFlushedSegment(3, 20, 0),
+			buildFlushedSegment(4, 45, 0),
+			buildFlushedSegment(5, 90, 0),
+		}
+		toCompact := policy(segments)
+		assert.EqualValues(t, 1, len(toCompact))
+		assert.EqualValues(t, datapb.CompactionType_MergeCompaction, toCompact[0].compactionType)
+		ids := make([]UniqueID, 0)
+		for _, segment := range toCompact[0].segments {
+			ids = append(ids, segment.GetID())
+		}
+		assert.ElementsMatch(t, []UniqueID{3, 2, 1}, ids)
+	})
+
+	t.Run("compact delete heavy segments", func(t *testing.T) {
+		segments := []*datapb.SegmentInfo{
+			buildFlushedSegment(1, 90, 30),
+			buildFlushedSegment(2, 90, 10),
+			buildFlushedSegment(3, 10, 5),
+		}
+		toCompact := policy(segments)
+		assert.EqualValues(t, 2, len(toCompact))
+		for _, c := range toCompact {
+			assert.EqualValues(t, datapb.CompactionType_InnerCompaction, c.compactionType)
+			assert.EqualValues(t, 1, len(c.segments))
+			assert.NotEqualValues(t, 2, c.segments[0].GetID())
+		}
+	})
+}
+
+func TestMeta_CompleteMergeCompaction(t *testing.T) {
+	meta, err := newMemoryMeta(newMockAllocator())
+	assert.Nil(t, err)
+
+	seg1 := buildFlushedSegment(1, 10, 0)
+	seg1.DmlPosition = &internalpb.MsgPosition{Timestamp: 100}
+	seg2 := buildFlushedSegment(2, 20, 0)
+	seg2.DmlPosition = &internalpb.MsgPosition{Timestamp: 200}
+	seg2.Deltalogs = []*datapb.DeltaLogInfo{{RecordEntries: 1, DeltaLogPath: "compacted"}, {RecordEntries: 1, DeltaLogPath: "late"}}
+	growing := buildFlushedSegment(3, 20, 0)
+	growing.State = commonpb.SegmentState_Growing
+	for _, segment := range []*datapb.SegmentInfo{seg1, seg2, growing} {
+		assert.Nil(t, meta.AddSegment(segment))
+	}
+
+	plan := &datapb.CompactionPlan{
+		PlanID: 1,
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+			{SegmentID: 1},
+			{SegmentID: 2, Deltalogs: []*datapb.DeltaLogInfo{{RecordEntries: 1, DeltaLogPath: "compacted"}}},
+		},
+		TargetSegmentID: 4,
+	}
+	result := &datapb.CompactionResult{PlanID: 1, SegmentID: 4, NumOfRows: 29}
+
+	t.Run("compact segment not flushed", func(t *testing.T) {
+		p := &datapb.CompactionPlan{
+			SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: 1}, {SegmentID: 3}},
+		}
+		_, err := meta.CompleteMergeCompaction(p, result, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("compact segment not found", func(t *testing.T) {
+		p := &datapb.CompactionPlan{
+			SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: 1}, {SegmentID: 5}},
+		}
+		_, err := meta.CompleteMergeCompaction(p, result, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("complete merge compaction", func(t *testing.T) {
+		segment, err := meta.CompleteMergeCompaction(plan, result, nil)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 4, segment.GetID())
+		assert.EqualValues(t, 29, segment.GetNumOfRows())
+		assert.EqualValues(t, commonpb.SegmentState_Flushing, segment.GetState())
+		assert.EqualValues(t, []UniqueID{1, 2}, segment.GetCompactionFrom())
+		assert.EqualValues(t, 200, segment.GetDmlPosition().GetTimestamp())
+		assert.EqualValues(t, 1, len(segment.GetDeltalogs()))
+		assert.EqualValues(t, "late", segment.GetDeltalogs()[0].GetDeltaLogPath())
+
+		assert.Nil(t, meta.GetSegment(1))
+		assert.Nil(t, meta.GetSegment(2))
+		assert.NotNil(t, meta.GetSegment(4))
+
+		// the compacted segments are kept as dropped segments
+		dropped := meta.GetDroppedSegments()
+		assert.Equal(t, 2, len(dropped))
+		for _, segment := range dropped {
+			assert.NotZero(t, segment.GetDropTime())
+		}
+	})
+}
+
+func TestCompactionPlanHandler(t *testing.T) {
+	meta, err := newMemoryMeta(newMockAllocator())
+	assert.Nil(t, err)
+	for _, segment := range []*datapb.SegmentInfo{buildFlushedSegment(1, 10, 0), buildFlushedSegment(2, 20, 0)} {
+		assert.Nil(t, meta.AddSegment(segment))
+	}
+
+	executor := &mockCompactionExecutor{}
+	flushCh := make(chan UniqueID, 1)
+	handler := newCompactionPlanHandler(meta, executor, flushCh)
+
+	plan := &datapb.CompactionPlan{
+		PlanID:           1,
+		SegmentBinlogs:   []*datapb.CompactionSegmentBinlogs{{SegmentID: 1}, {SegmentID: 2}},
+		StartTime:        tsoutil.ComposeTS(time.Now().UnixNano()/int64(time.Millisecond), 0),
+		TimeoutInSeconds: 60,
+		TargetSegmentID:  3,
+	}
+
+	t.Run("exec plan failed", func(t *testing.T) {
+		executor.err = errors.New("no datanode")
+		assert.NotNil(t, handler.execCompactionPlan(plan))
+		assert.Nil(t, handler.getCompaction(1))
+		executor.err = nil
+	})
+
+	t.Run("exec plan", func(t *testing.T) {
+		assert.Nil(t, handler.execCompactionPlan(plan))
+		assert.NotNil(t, handler.getCompaction(1))
+		assert.True(t, handler.isCompacting(1))
+		assert.False(t, handler.isCompacting(3))
+	})
+
+	t.Run("complete with wrong target", func(t *testing.T) {
+		err := handler.completeCompaction(&datapb.CompactionResult{PlanID: 1, SegmentID: 4}, nil)
+		assert.NotNil(t, err)
+		err = handler.completeCompaction(&datapb.CompactionResult{PlanID: 2, SegmentID: 3}, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("complete compaction", func(t *testing.T) {
+		err := handler.completeCompaction(&datapb.CompactionResult{PlanID: 1, SegmentID: 3, NumOfRows: 30}, nil)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 3, <-flushCh)
+		assert.Nil(t, handler.getCompaction(1))
+		assert.False(t, handler.isCompacting(1))
+	})
+
+	t.Run("expire timeout", func(t *testing.T) {
+		timeout := &datapb.CompactionPlan{
+			PlanID:           2,
+			StartTime:        tsoutil.ComposeTS(time.Now().Add(-time.Minute).UnixNano()/int64(time.Millisecond), 0),
+			TimeoutInSeconds: 1,
+		}
+		assert.Nil(t, handler.execCompactionPlan(timeout))
+		handler.expireTimeout(tsoutil.ComposeTS(time.Now().UnixNano()/int64(time.Millisecond), 0))
+		assert.Nil(t, handler.getCompaction(2))
+	})
+}
+
+func TestCompactionTrigger(t *testing.T) {
+	meta, err := newMemoryMeta(newMockAllocator())
+	assert.Nil(t, err)
+	for _, segment := range []*datapb.SegmentInfo{
+		buildFlushedSegment(1, 10, 0),
+		buildFlushedSegment(2, 20, 0),
+		buildFlushedSegment(3, 90, 0),
+	} {
+		assert.Nil(t, meta.AddSegment(segment))
+	}
+
+	executor := &mockCompactionExecutor{}
+	handler := newCompactionPlanHandler(meta, executor, make(chan UniqueID, 1))
+	getBinlogs := func(segmentID UniqueID) ([]*datapb.ID2PathList, error) {
+		return []*datapb.ID2PathList{{ID: 100, Paths: []string{"binlog"}}}, nil
+	}
+	trigger := newCompactionTrigger(meta, newMockAllocator(), handler, getBinlogs)
+	trigger.policy = newSmallAndDeletedPolicy(0.5, 0.2)
+	trigger.retention = time.Hour
+
+	err = trigger.triggerCompaction()
+	assert.Nil(t, err)
+	assert.EqualValues(t, 1, len(executor.plans))
+	plan := executor.plans[0]
+	assert.EqualValues(t, datapb.CompactionType_MergeCompaction, plan.GetType())
+	assert.EqualValues(t, "c1", plan.GetChannel())
+	assert.EqualValues(t, 2, len(plan.GetSegmentBinlogs()))
+	assert.EqualValues(t, tsoutil.SubPhysicalDuration(plan.GetStartTime(), trigger.retention), plan.GetTimetravel())
+	assert.NotZero(t, plan.GetTargetSegmentID())
+
+	// segments in compaction are not compacted again
+	err = trigger.triggerCompaction()
+	assert.Nil(t, err)
+	assert.EqualValues(t, 1, len(executor.plans))
+}
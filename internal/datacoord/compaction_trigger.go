@@ -0,0 +1,199 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// segmentsToCompact is a group of segments compacted by one plan
+type segmentsToCompact struct {
+	compactionType datapb.CompactionType
+	segments       []*datapb.SegmentInfo
+}
+
+// compactionPolicy selects the segments to compact from the flushed segments of a channel in a partition
+type compactionPolicy func(segments []*datapb.SegmentInfo) []*segmentsToCompact
+
+// segmentBinlogsGetter gets the field binlog paths of a segment
+type segmentBinlogsGetter func(segmentID UniqueID) ([]*datapb.ID2PathList, error)
+
+// compactionTrigger looks for flushed segments worth compacting and executes compaction plans for them
+type compactionTrigger struct {
+	meta        *meta
+	allocator   allocator
+	handler     *compactionPlanHandler
+	policy      compactionPolicy
+	getBinlogs  segmentBinlogsGetter
+	timeoutSecs int32
+	retention   time.Duration
+}
+
+func newCompactionTrigger(meta *meta, allocator allocator, handler *compactionPlanHandler,
+	getBinlogs segmentBinlogsGetter) *compactionTrigger {
+	return &compactionTrigger{
+		meta:        meta,
+		allocator:   allocator,
+		handler:     handler,
+		policy:      newSmallAndDeletedPolicy(Params.CompactionSmallProportion, Params.CompactionDeleteRatio),
+		getBinlogs:  getBinlogs,
+		timeoutSecs: Params.CompactionTimeout,
+		retention:   time.Duration(Params.RetentionDuration) * time.Second,
+	}
+}
+
+// newSmallAndDeletedPolicy merges segments with less rows than maxRowNum * smallProportion
+// and compacts alone the other segments with more deleted entities than numOfRows * deleteRatio
+func newSmallAndDeletedPolicy(smallProportion, deleteRatio float64) compactionPolicy {
+	return func(segments []*datapb.SegmentInfo) []*segmentsToCompact {
+		ret := make([]*segmentsToCompact, 0)
+		small := make([]*datapb.SegmentInfo, 0)
+		for _, segment := range segments {
+			if float64(segment.GetNumOfRows()) < float64(segment.GetMaxRowNum())*smallProportion {
+				small = append(small, segment)
+				continue
+			}
+			if isDeleteHeavy(segment, deleteRatio) {
+				ret = append(ret, &segmentsToCompact{
+					compactionType: datapb.CompactionType_InnerCompaction,
+					segments:       []*datapb.SegmentInfo{segment},
+				})
+			}
+		}
+
+		// pack small segments into groups not exceeding max row num
+		sort.Slice(small, func(i, j int) bool {
+			return small[i].GetNumOfRows() < small[j].GetNumOfRows()
+		})
+		var group []*datapb.SegmentInfo
+		var rows int64
+		flushGroup := func() {
+			switch {
+			case len(group) > 1:
+				ret = append(ret, &segmentsToCompact{
+					compactionType: datapb.CompactionType_MergeCompaction,
+					segments:       group,
+				})
+			case len(group) == 1 && isDeleteHeavy(group[0], deleteRatio):
+				ret = append(ret, &segmentsToCompact{
+					compactionType: datapb.CompactionType_InnerCompaction,
+					segments:       group,
+				})
+			}
+			group = nil
+			rows = 0
+		}
+		for _, segment := range small {
+			if len(group) > 0 && rows+segment.GetNumOfRows() > segment.GetMaxRowNum() {
+				flushGroup()
+			}
+			group = append(group, segment)
+			rows += segment.GetNumOfRows()
+		}
+		flushGroup()
+		return ret
+	}
+}
+
+func isDeleteHeavy(segment *datapb.SegmentInfo, deleteRatio float64) bool {
+	var deleted uint64
+	for _, deltalog := range segment.GetDeltalogs() {
+		deleted += deltalog.GetRecordEntries()
+	}
+	return deleted > 0 && float64(deleted) >= float64(segment.GetNumOfRows())*deleteRatio
+}
+
+type compactionGroupKey struct {
+	collectionID UniqueID
+	partitionID  UniqueID
+	channel      string
+}
+
+// triggerCompaction makes compaction plans for the flushed segments not in compaction and executes them
+func (t *compactionTrigger) triggerCompaction() error {
+	groups := make(map[compactionGroupKey][]*datapb.SegmentInfo)
+	for _, segment := range t.meta.GetFlushedSegments() {
+		if t.handler.isCompacting(segment.GetID()) {
+			continue
+		}
+		key := compactionGroupKey{
+			collectionID: segment.GetCollectionID(),
+			partitionID:  segment.GetPartitionID(),
+			channel:      segment.GetInsertChannel(),
+		}
+		groups[key] = append(groups[key], segment)
+	}
+
+	for key, segments := range groups {
+		for _, toCompact := range t.policy(segments) {
+			plan, err := t.buildCompactionPlan(key, toCompact)
+			if err != nil {
+				return err
+			}
+			if err := t.handler.execCompactionPlan(plan); err != nil {
+				log.Warn("failed to execute compaction plan", zap.Int64("planID", plan.GetPlanID()), zap.Error(err))
+			}
+		}
+	}
+	return nil
+}
+
+func (t *compactionTrigger) buildCompactionPlan(key compactionGroupKey, toCompact *segmentsToCompact) (*datapb.CompactionPlan, error) {
+	planID, err := t.allocator.allocID()
+	if err != nil {
+		return nil, err
+	}
+	targetSegmentID, err := t.allocator.allocID()
+	if err != nil {
+		return nil, err
+	}
+	ts, err := t.allocator.allocTimestamp()
+	if err != nil {
+		return nil, err
+	}
+
+	// deletes within the retention duration are kept in delta logs to support time travel
+	plan := &datapb.CompactionPlan{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.NodeID,
+		},
+		PlanID:           planID,
+		StartTime:        ts,
+		TimeoutInSeconds: t.timeoutSecs,
+		Type:             toCompact.compactionType,
+		Timetravel:       tsoutil.SubPhysicalDuration(ts, t.retention),
+		Channel:          key.channel,
+		CollectionID:     key.collectionID,
+		PartitionID:      key.partitionID,
+		TargetSegmentID:  targetSegmentID,
+	}
+	for _, segment := range toCompact.segments {
+		fieldBinlogs, err := t.getBinlogs(segment.GetID())
+		if err != nil {
+			return nil, err
+		}
+		plan.SegmentBinlogs = append(plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
+			SegmentID:    segment.GetID(),
+			FieldBinlogs: fieldBinlogs,
+			Deltalogs:    segment.GetDeltalogs(),
+		})
+	}
+	return plan, nil
+}
@@ -0,0 +1,377 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// gcStorage is the object storage holding the binlogs, implemented by MinIOKV
+type gcStorage interface {
+	ListObjectsWithPrefix(prefix string) ([]string, []time.Time, error)
+	MultiRemove(keys []string) error
+}
+
+// segmentBinlogKeysLister lists the kv store keys of the binlog paths of a segment
+type segmentBinlogKeysLister func(segmentID UniqueID) ([]string, error)
+
+// garbageCollector removes the binlogs and index files in object storage which are not referenced,
+// they are left by dropped collections, dropped partitions, compacted segments, failed flushes, and
+// reassigned and failed index builds. The segments of dropped collections and partitions are moved to the dropped
+// segments of meta, and the dropped and compacted segments are purged after the retention duration.
+type garbageCollector struct {
+	cli            gcStorage
+	meta           *meta
+	rootCoord      types.RootCoord
+	indexCoord     types.IndexCoord
+	getBinlogs     segmentBinlogsGetter
+	listBinlogKeys segmentBinlogKeysLister
+	// unreferenced binlogs younger than missingTolerance are kept, they may be written by flushes in progress
+	missingTolerance time.Duration
+	// dropped segments are kept within retention to support time travel
+	retention time.Duration
+	dryRun    bool
+}
+
+func newGarbageCollector(cli gcStorage, meta *meta, rootCoord types.RootCoord, indexCoord types.IndexCoord,
+	getBinlogs segmentBinlogsGetter, listBinlogKeys segmentBinlogKeysLister) *garbageCollector {
+	return &garbageCollector{
+		cli:              cli,
+		meta:             meta,
+		rootCoord:        rootCoord,
+		indexCoord:       indexCoord,
+		getBinlogs:       getBinlogs,
+		listBinlogKeys:   listBinlogKeys,
+		missingTolerance: time.Duration(Params.GCMissingTolerance) * time.Second,
+		retention:        time.Duration(Params.RetentionDuration) * time.Second,
+		dryRun:           Params.GCDryRun,
+	}
+}
+
+// collect removes the unreferenced binlogs and index files older than missingTolerance and purges the
+// dropped segments older than retention, they are only reported in dry run mode. The binlogs of the dropped
+// segments and the DDL binlogs of their collections are referenced until the segments are purged.
+// The removed or reported keys are returned.
+func (gc *garbageCollector) collect(ctx context.Context) ([]string, error) {
+	partitions, err := gc.getExistingPartitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !gc.dryRun {
+		if err := gc.dropSegmentsOfDroppedPartitions(partitions); err != nil {
+			return nil, err
+		}
+	}
+	expireTs := tsoutil.SubPhysicalDuration(tsoutil.GetCurrentTime(), gc.retention)
+	segments := gc.getRetainedSegments(expireTs)
+	referenced, err := gc.getReferencedBinlogs(segments)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	garbage := make([]string, 0)
+	for _, prefix := range []string{Params.InsertBinlogRootPath, Params.StatsBinlogRootPath, Params.DeleteBinlogRootPath} {
+		keys, err := gc.listGarbage(prefix+"/", now, func(key string) bool {
+			_, ok := referenced[key]
+			return ok
+		})
+		if err != nil {
+			return nil, err
+		}
+		garbage = append(garbage, keys...)
+	}
+
+	// the DDL binlogs of a collection are referenced by the collection and its retained segments
+	collectionIDs := make(map[UniqueID]struct{}, len(partitions))
+	for collectionID := range partitions {
+		collectionIDs[collectionID] = struct{}{}
+	}
+	for _, segment := range segments {
+		collectionIDs[segment.GetCollectionID()] = struct{}{}
+	}
+	keys, err := gc.listGarbage(Params.DdlBinlogRootPath+"/", now, func(key string) bool {
+		collectionID, err := parsePathID(strings.TrimPrefix(key, Params.DdlBinlogRootPath+"/"))
+		if err != nil {
+			return true
+		}
+		_, ok := collectionIDs[collectionID]
+		return ok
+	})
+	if err != nil {
+		return nil, err
+	}
+	garbage = append(garbage, keys...)
+
+	keys, err = gc.listIndexFileGarbage(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	garbage = append(garbage, keys...)
+
+	if gc.dryRun {
+		for _, key := range garbage {
+			log.Info("garbage collection dry run, object to remove", zap.String("key", key))
+		}
+		return garbage, nil
+	}
+	if len(garbage) > 0 {
+		if err := gc.cli.MultiRemove(garbage); err != nil {
+			return nil, err
+		}
+		log.Debug("garbage collection removed objects", zap.Int("count", len(garbage)))
+	}
+	if err := gc.purgeDroppedSegments(expireTs); err != nil {
+		return nil, err
+	}
+	return garbage, nil
+}
+
+// listGarbage lists the keys with prefix which are not referenced and older than missingTolerance
+func (gc *garbageCollector) listGarbage(prefix string, now time.Time, isReferenced func(key string) bool) ([]string, error) {
+	keys, modTimes, err := gc.cli.ListObjectsWithPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	garbage := make([]string, 0)
+	for i, key := range keys {
+		if now.Sub(modTimes[i]) < gc.missingTolerance || isReferenced(key) {
+			continue
+		}
+		garbage = append(garbage, key)
+	}
+	return garbage, nil
+}
+
+// listIndexFileGarbage lists the unreferenced index files older than missingTolerance. Index files are
+// saved as ${indexBuildID}/${version}/${partitionID}/${segmentID}/${key} under IndexFileRootPath, the
+// files of finished builds are referenced by their file paths in IndexCoord, and all the files of builds
+// in progress are referenced. The files of failed builds and of the builds unknown to IndexCoord are garbage.
+func (gc *garbageCollector) listIndexFileGarbage(ctx context.Context, now time.Time) ([]string, error) {
+	prefix := Params.IndexFileRootPath + "/"
+	keys, modTimes, err := gc.cli.ListObjectsWithPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	buildIDs := make([]UniqueID, 0)
+	buildKeys := make(map[UniqueID][]int)
+	for i, key := range keys {
+		buildID, err := parsePathID(strings.TrimPrefix(key, prefix))
+		if err != nil {
+			// not an index file
+			continue
+		}
+		if _, ok := buildKeys[buildID]; !ok {
+			buildIDs = append(buildIDs, buildID)
+		}
+		buildKeys[buildID] = append(buildKeys[buildID], i)
+	}
+	if len(buildIDs) == 0 {
+		return nil, nil
+	}
+
+	stateResp, err := gc.indexCoord.GetIndexStates(ctx, &indexpb.GetIndexStatesRequest{IndexBuildIDs: buildIDs})
+	if err != nil {
+		return nil, err
+	}
+	if stateResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, fmt.Errorf("failed to get index states: %s", stateResp.GetStatus().GetReason())
+	}
+	finished := make([]UniqueID, 0)
+	inProgress := make(map[UniqueID]struct{})
+	for _, state := range stateResp.GetStates() {
+		switch state.GetState() {
+		case commonpb.IndexState_Finished:
+			finished = append(finished, state.GetIndexBuildID())
+		case commonpb.IndexState_Unissued, commonpb.IndexState_InProgress:
+			inProgress[state.GetIndexBuildID()] = struct{}{}
+		}
+	}
+	referenced := make(map[string]struct{})
+	if len(finished) > 0 {
+		pathResp, err := gc.indexCoord.GetIndexFilePaths(ctx, &indexpb.GetIndexFilePathsRequest{IndexBuildIDs: finished})
+		if err != nil {
+			return nil, err
+		}
+		if pathResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return nil, fmt.Errorf("failed to get index file paths: %s", pathResp.GetStatus().GetReason())
+		}
+		for _, info := range pathResp.GetFilePaths() {
+			for _, filePath := range info.GetIndexFilePaths() {
+				referenced[filePath] = struct{}{}
+			}
+		}
+	}
+
+	garbage := make([]string, 0)
+	for _, buildID := range buildIDs {
+		if _, ok := inProgress[buildID]; ok {
+			continue
+		}
+		for _, i := range buildKeys[buildID] {
+			if _, ok := referenced[keys[i]]; ok || now.Sub(modTimes[i]) < gc.missingTolerance {
+				continue
+			}
+			garbage = append(garbage, keys[i])
+		}
+	}
+	return garbage, nil
+}
+
+// parsePathID parses the id which is the first element of the path
+func parsePathID(p string) (UniqueID, error) {
+	return strconv.ParseInt(strings.SplitN(p, "/", 2)[0], 10, 64)
+}
+
+// dropSegmentsOfDroppedPartitions moves the flushed segments of the partitions dropped in RootCoord
+// to the dropped segments, the segments being flushed are moved after their flushes complete
+func (gc *garbageCollector) dropSegmentsOfDroppedPartitions(partitions map[UniqueID]map[UniqueID]struct{}) error {
+	segmentIDs := make([]UniqueID, 0)
+	for _, segment := range gc.meta.GetAllSegments() {
+		if segment.GetState() != commonpb.SegmentState_Flushed {
+			continue
+		}
+		if _, ok := partitions[segment.GetCollectionID()][segment.GetPartitionID()]; !ok {
+			segmentIDs = append(segmentIDs, segment.GetID())
+		}
+	}
+	if len(segmentIDs) == 0 {
+		return nil
+	}
+	log.Debug("garbage collection drops the segments of dropped partitions", zap.Int64s("segmentIDs", segmentIDs))
+	return gc.meta.MarkSegmentsDropped(segmentIDs, tsoutil.GetCurrentTime())
+}
+
+// purgeDroppedSegments removes the dropped segments which are dropped before expireTs and their binlog paths
+func (gc *garbageCollector) purgeDroppedSegments(expireTs Timestamp) error {
+	for _, segment := range gc.meta.GetDroppedSegments() {
+		if segment.GetDropTime() >= expireTs {
+			continue
+		}
+		keys, err := gc.listBinlogKeys(segment.GetID())
+		if err != nil {
+			return err
+		}
+		if err := gc.meta.PurgeDroppedSegment(segment.GetID(), keys); err != nil {
+			return err
+		}
+		log.Debug("garbage collection purged dropped segment", zap.Int64("segmentID", segment.GetID()))
+	}
+	return nil
+}
+
+// getRetainedSegments returns the segments in meta and the dropped segments which are dropped after expireTs
+func (gc *garbageCollector) getRetainedSegments(expireTs Timestamp) []*datapb.SegmentInfo {
+	segments := gc.meta.GetAllSegments()
+	for _, segment := range gc.meta.GetDroppedSegments() {
+		if segment.GetDropTime() >= expireTs {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// getReferencedBinlogs returns the keys of binlogs referenced by the segments
+func (gc *garbageCollector) getReferencedBinlogs(segments []*datapb.SegmentInfo) (map[string]struct{}, error) {
+	referenced := make(map[string]struct{})
+	for _, segment := range segments {
+		fieldBinlogs, err := gc.getBinlogs(segment.GetID())
+		if err != nil {
+			return nil, err
+		}
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlogPath := range fieldBinlog.GetPaths() {
+				referenced[binlogPath] = struct{}{}
+				// stats binlog shares the key of insert binlog under a different root path
+				if strings.HasPrefix(binlogPath, Params.InsertBinlogRootPath) {
+					statsPath := path.Join(Params.StatsBinlogRootPath, strings.TrimPrefix(binlogPath, Params.InsertBinlogRootPath))
+					referenced[statsPath] = struct{}{}
+				}
+			}
+		}
+		for _, deltalog := range segment.GetDeltalogs() {
+			referenced[deltalog.GetDeltaLogPath()] = struct{}{}
+		}
+	}
+	return referenced, nil
+}
+
+// getExistingPartitions returns the partition ids of the collections not dropped in RootCoord, of all the databases
+func (gc *garbageCollector) getExistingPartitions(ctx context.Context) (map[UniqueID]map[UniqueID]struct{}, error) {
+	dbResp, err := gc.rootCoord.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_ListDatabases,
+			SourceID: Params.NodeID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if dbResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, fmt.Errorf("failed to list databases: %s", dbResp.GetStatus().GetReason())
+	}
+
+	collectionIDs := make([]UniqueID, 0)
+	for _, dbName := range dbResp.GetDbNames() {
+		collResp, err := gc.rootCoord.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_ShowCollections,
+				SourceID: Params.NodeID,
+			},
+			DbName: dbName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if collResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return nil, fmt.Errorf("failed to show collections of database %s: %s", dbName, collResp.GetStatus().GetReason())
+		}
+		collectionIDs = append(collectionIDs, collResp.GetCollectionIds()...)
+	}
+
+	partitions := make(map[UniqueID]map[UniqueID]struct{}, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		partResp, err := gc.rootCoord.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_ShowPartitions,
+				SourceID: Params.NodeID,
+			},
+			CollectionID: collectionID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if partResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return nil, fmt.Errorf("failed to show partitions of collection %d: %s", collectionID, partResp.GetStatus().GetReason())
+		}
+		partitions[collectionID] = make(map[UniqueID]struct{}, len(partResp.GetPartitionIDs()))
+		for _, partitionID := range partResp.GetPartitionIDs() {
+			partitions[collectionID][partitionID] = struct{}{}
+		}
+	}
+	return partitions, nil
+}
@@ -0,0 +1,239 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+type mockGcStorage struct {
+	objects map[string]time.Time
+}
+
+func (s *mockGcStorage) ListObjectsWithPrefix(prefix string) ([]string, []time.Time, error) {
+	keys := make([]string, 0)
+	modTimes := make([]time.Time, 0)
+	for key, modTime := range s.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+			modTimes = append(modTimes, modTime)
+		}
+	}
+	return keys, modTimes, nil
+}
+
+func (s *mockGcStorage) MultiRemove(keys []string) error {
+	for _, key := range keys {
+		delete(s.objects, key)
+	}
+	return nil
+}
+
+type gcRootCoord struct {
+	*mockRootCoordService
+	partitions map[UniqueID][]UniqueID // collection id to partition ids
+}
+
+func (m *gcRootCoord) ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	resp := &milvuspb.ShowCollectionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	for collectionID := range m.partitions {
+		resp.CollectionIds = append(resp.CollectionIds, collectionID)
+	}
+	return resp, nil
+}
+
+func (m *gcRootCoord) ShowPartitions(ctx context.Context, req *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
+	return &milvuspb.ShowPartitionsResponse{
+		Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		PartitionIDs: m.partitions[req.GetCollectionID()],
+	}, nil
+}
+
+type gcIndexCoord struct {
+	*mockIndexCoord
+	states    map[UniqueID]commonpb.IndexState // index build id to state, the unknown builds are absent
+	filePaths map[UniqueID][]string            // index build id to the file paths of finished build
+}
+
+func (m *gcIndexCoord) GetIndexStates(ctx context.Context, req *indexpb.GetIndexStatesRequest) (*indexpb.GetIndexStatesResponse, error) {
+	resp := &indexpb.GetIndexStatesResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	for _, buildID := range req.GetIndexBuildIDs() {
+		resp.States = append(resp.States, &indexpb.IndexInfo{IndexBuildID: buildID, State: m.states[buildID]})
+	}
+	return resp, nil
+}
+
+func (m *gcIndexCoord) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
+	resp := &indexpb.GetIndexFilePathsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	for _, buildID := range req.GetIndexBuildIDs() {
+		resp.FilePaths = append(resp.FilePaths, &indexpb.IndexFilePathInfo{IndexBuildID: buildID, IndexFilePaths: m.filePaths[buildID]})
+	}
+	return resp, nil
+}
+
+func TestGarbageCollector_collect(t *testing.T) {
+	Params.Init()
+
+	meta, err := newMemoryMeta(newMockAllocator())
+	assert.Nil(t, err)
+	// segment 1 in an existing partition, segment 2 in a dropped partition, segment 5 compacted long ago
+	seg1 := &datapb.SegmentInfo{ID: 1, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed,
+		Deltalogs: []*datapb.DeltaLogInfo{{DeltaLogPath: path.Join(Params.DeleteBinlogRootPath, "100/10/1/1000")}}}
+	seg2 := &datapb.SegmentInfo{ID: 2, CollectionID: 100, PartitionID: 20, State: commonpb.SegmentState_Flushed}
+	seg5 := &datapb.SegmentInfo{ID: 5, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed}
+	for _, segment := range []*datapb.SegmentInfo{seg1, seg2, seg5} {
+		assert.Nil(t, meta.AddSegment(segment))
+	}
+	assert.Nil(t, meta.MarkSegmentsDropped([]UniqueID{5}, tsoutil.ComposeTS(time.Now().Add(-3*time.Hour).UnixNano()/int64(time.Millisecond), 0)))
+
+	insertLog := func(segmentPath string) string {
+		return path.Join(Params.InsertBinlogRootPath, segmentPath)
+	}
+	statsLog := func(segmentPath string) string {
+		return path.Join(Params.StatsBinlogRootPath, segmentPath)
+	}
+	binlogKeys := map[UniqueID][]string{1: {"binlog/1"}, 2: {"binlog/2"}, 5: {"binlog/5"}}
+	getBinlogs := func(segmentID UniqueID) ([]*datapb.ID2PathList, error) {
+		if _, ok := binlogKeys[segmentID]; !ok {
+			return nil, nil
+		}
+		switch segmentID {
+		case 1:
+			return []*datapb.ID2PathList{{ID: 0, Paths: []string{insertLog("100/10/1/0/1001")}}}, nil
+		case 2:
+			return []*datapb.ID2PathList{{ID: 0, Paths: []string{insertLog("100/20/2/0/1002")}}}, nil
+		case 5:
+			return []*datapb.ID2PathList{{ID: 0, Paths: []string{insertLog("100/10/5/0/1006")}}}, nil
+		}
+		return nil, nil
+	}
+	listBinlogKeys := func(segmentID UniqueID) ([]string, error) {
+		keys := binlogKeys[segmentID]
+		delete(binlogKeys, segmentID)
+		return keys, nil
+	}
+
+	ddlLog := func(collectionPath string) string {
+		return path.Join(Params.DdlBinlogRootPath, collectionPath)
+	}
+
+	indexFile := func(filePath string) string {
+		return path.Join(Params.IndexFileRootPath, filePath)
+	}
+
+	old := time.Now().Add(-2 * time.Hour)
+	storage := &mockGcStorage{objects: map[string]time.Time{
+		// ddl binlogs of the existing collection and the dropped collection
+		ddlLog("100/ddl/1"): old,
+		ddlLog("200/ddl/1"): old,
+		// index files of a finished build, a reassigned build, an unfinished build, a dropped build and a failed build
+		indexFile("1000/2/10/1/index"):                          old,
+		indexFile("1000/1/10/1/index"):                          old,
+		indexFile("1001/1/10/1/index"):                          old,
+		indexFile("1002/1/10/1/index"):                          old,
+		indexFile("1003/1/10/1/index"):                          old,
+		insertLog("100/10/1/0/1001"):                            old,
+		statsLog("100/10/1/0/1001"):                             old,
+		path.Join(Params.DeleteBinlogRootPath, "100/10/1/1000"): old,
+		// failed flush of segment 1
+		insertLog("100/10/1/0/1003"): old,
+		// dropped partition
+		insertLog("100/20/2/0/1002"): old,
+		statsLog("100/20/2/0/1002"):  old,
+		// dropped collection
+		insertLog("200/30/3/0/1004"): old,
+		// being written
+		insertLog("100/10/4/0/1005"): time.Now(),
+		// compacted segment out of the retention duration
+		insertLog("100/10/5/0/1006"): old,
+		// out of the index file root path
+		"1004/1/10/1/index": old,
+	}}
+	rootCoord := &gcRootCoord{
+		mockRootCoordService: newMockRootCoordService(),
+		partitions:           map[UniqueID][]UniqueID{100: {10}},
+	}
+
+	indexCoord := &gcIndexCoord{
+		mockIndexCoord: &mockIndexCoord{},
+		states: map[UniqueID]commonpb.IndexState{
+			1000: commonpb.IndexState_Finished,
+			1001: commonpb.IndexState_InProgress,
+			1003: commonpb.IndexState_Failed,
+		},
+		filePaths: map[UniqueID][]string{1000: {indexFile("1000/2/10/1/index")}},
+	}
+
+	gc := newGarbageCollector(storage, meta, rootCoord, indexCoord, getBinlogs, listBinlogKeys)
+	gc.missingTolerance = time.Hour
+	gc.retention = time.Hour
+	expected := []string{
+		insertLog("100/10/1/0/1003"),
+		insertLog("200/30/3/0/1004"),
+		ddlLog("200/ddl/1"),
+		indexFile("1000/1/10/1/index"),
+		indexFile("1002/1/10/1/index"),
+		indexFile("1003/1/10/1/index"),
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		gc.dryRun = true
+		garbage, err := gc.collect(context.Background())
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, append(expected, insertLog("100/10/5/0/1006")), garbage)
+		assert.Equal(t, 17, len(storage.objects))
+		assert.NotNil(t, meta.GetSegment(2))
+		assert.Equal(t, 1, len(meta.GetDroppedSegments()))
+	})
+
+	t.Run("collect", func(t *testing.T) {
+		gc.dryRun = false
+		garbage, err := gc.collect(context.Background())
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, append(expected, insertLog("100/10/5/0/1006")), garbage)
+		assert.Equal(t, 10, len(storage.objects))
+		for _, key := range garbage {
+			_, ok := storage.objects[key]
+			assert.False(t, ok)
+		}
+
+		// the segment of the dropped partition is kept within the retention duration
+		assert.Nil(t, meta.GetSegment(2))
+		dropped := meta.GetDroppedSegments()
+		assert.Equal(t, 1, len(dropped))
+		assert.EqualValues(t, 2, dropped[0].GetID())
+		_, ok := binlogKeys[5]
+		assert.False(t, ok)
+	})
+
+	t.Run("collect after retention", func(t *testing.T) {
+		time.Sleep(10 * time.Millisecond)
+		gc.retention = 0
+		garbage, err := gc.collect(context.Background())
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{insertLog("100/20/2/0/1002"), statsLog("100/20/2/0/1002")}, garbage)
+		assert.Equal(t, 0, len(meta.GetDroppedSegments()))
+		assert.Equal(t, 8, len(storage.objects))
+	})
+}
@@ -106,6 +106,10 @@ func (s *Server) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentI
 				continue
 			}
 		}
+		if r.GetImportTaskID() != 0 {
+			assigns = append(assigns, s.assignImportSegment(ctx, r))
+			continue
+		}
 		//if err := s.validateAllocRequest(r.CollectionID, r.PartitionID, r.ChannelName); err != nil {
 		//result.Status.Reason = err.Error()
 		//assigns = append(assigns, result)
@@ -147,6 +151,41 @@ func (s *Server) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentI
 	}, nil
 }
 
+// assignImportSegment opens a segment for the rows of an import task, the segment holds
+// at most the returned count of rows
+func (s *Server) assignImportSegment(ctx context.Context, r *datapb.SegmentIDRequest) *datapb.SegmentIDAssignment {
+	result := &datapb.SegmentIDAssignment{
+		ChannelName:  r.ChannelName,
+		CollectionID: r.CollectionID,
+		PartitionID:  r.PartitionID,
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	task := s.importManager.getTask(r.GetImportTaskID())
+	if task == nil {
+		result.Status.Reason = fmt.Sprintf("import task %d not found", r.GetImportTaskID())
+		return result
+	}
+	if isImportDone(task.GetState()) {
+		result.Status.Reason = fmt.Sprintf("import task %d is already done, state = %s", task.GetTaskID(), task.GetState().String())
+		return result
+	}
+	segmentID, retCount, err := s.segmentManager.AllocImportSegment(ctx,
+		r.CollectionID, r.PartitionID, r.ChannelName, r.GetImportTaskID(), int64(r.Count))
+	if err != nil {
+		result.Status.Reason = fmt.Sprintf("Allocation of import task %d, collection %d, partition %d, channel %s, count %d error:  %s",
+			r.GetImportTaskID(), r.CollectionID, r.PartitionID, r.ChannelName, r.Count, err.Error())
+		return result
+	}
+	log.Debug("Assign import segment success", zap.Int64("importTaskID", r.GetImportTaskID()),
+		zap.Int64("segmentID", segmentID), zap.Int64("count", retCount))
+	result.SegID = segmentID
+	result.Count = uint32(retCount)
+	result.Status.ErrorCode = commonpb.ErrorCode_Success
+	return result
+}
+
 func (s *Server) GetSegmentStates(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error) {
 	resp := &datapb.GetSegmentStatesResponse{
 		Status: &commonpb.Status{
@@ -312,7 +351,7 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 
 	// set segment to SegmentState_Flushing and save binlogs and checkpoints
 	err = s.meta.SaveBinlogAndCheckPoints(req.GetSegmentID(), req.GetFlushed(),
-		binlogs, req.GetCheckPoints(), req.GetStartPositions())
+		binlogs, req.GetBinlogSize(), req.GetCheckPoints(), req.GetStartPositions(), req.GetDeltalogs())
 	if err != nil {
 		log.Error("Save binlog and checkpoints failed",
 			zap.Int64("segmentID", req.GetSegmentID()),
@@ -331,6 +370,216 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 	return resp, nil
 }
 
+// CompleteCompaction saves the segment produced by an executed compaction plan and
+// replaces the compacted segments with it
+func (s *Server) CompleteCompaction(ctx context.Context, req *datapb.CompactionResult) (*commonpb.Status, error) {
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	if s.isClosed() {
+		resp.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	log.Debug("Receive CompleteCompaction request",
+		zap.Int64("planID", req.GetPlanID()),
+		zap.Int64("segmentID", req.GetSegmentID()),
+		zap.Int64("numOfRows", req.GetNumOfRows()))
+
+	plan := s.compactionHandler.getCompaction(req.GetPlanID())
+	if plan == nil {
+		resp.Reason = fmt.Sprintf("compaction plan %d not found", req.GetPlanID())
+		return resp, nil
+	}
+
+	binlogs := make(map[string]string)
+	for _, fieldBlp := range req.GetInsertLogs() {
+		fieldMeta, err := s.prepareField2PathMeta(req.GetSegmentID(), fieldBlp)
+		if err != nil {
+			log.Error("Prepare binlog meta failed", zap.Error(err))
+			resp.Reason = err.Error()
+			return resp, nil
+		}
+		for k, v := range fieldMeta {
+			binlogs[k] = v
+		}
+	}
+	if err := s.compactionHandler.completeCompaction(req, binlogs); err != nil {
+		log.Error("Complete compaction failed", zap.Int64("planID", req.GetPlanID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// Import starts a task importing the files in object storage into the collection, the task
+// is executed by a datanode and its progress is queried by GetImportState
+func (s *Server) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*milvuspb.ImportResponse, error) {
+	resp := &milvuspb.ImportResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		resp.Status.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	log.Debug("Receive Import request",
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("partitionID", req.GetPartitionID()),
+		zap.Strings("files", req.GetFiles()))
+
+	if len(req.GetFiles()) == 0 {
+		resp.Status.Reason = "no file to import"
+		return resp, nil
+	}
+	if coll := s.meta.GetCollection(req.GetCollectionID()); coll == nil {
+		if err := s.loadCollectionFromRootCoord(ctx, req.GetCollectionID()); err != nil {
+			resp.Status.Reason = fmt.Sprintf("Can not load collection %d", req.GetCollectionID())
+			return resp, nil
+		}
+	}
+	taskID, err := s.allocator.allocID()
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	ts, err := s.allocator.allocTimestamp()
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	task := &datapb.ImportTask{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_Import,
+			SourceID: Params.NodeID,
+		},
+		TaskID:       taskID,
+		CollectionID: req.GetCollectionID(),
+		PartitionID:  req.GetPartitionID(),
+		ChannelNames: req.GetChannelNames(),
+		Files:        req.GetFiles(),
+		State:        commonpb.ImportState_ImportPending,
+		CreateTs:     ts,
+	}
+	if err := s.importManager.addTask(task); err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	resp.TaskID = taskID
+
+	nodeID, err := s.cluster.Import(task)
+	if err != nil {
+		log.Warn("Failed to send import task", zap.Int64("taskID", taskID), zap.Error(err))
+		s.failImportTasks(func(t *datapb.ImportTask) bool {
+			return t.GetTaskID() == taskID
+		}, err.Error())
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	if err := s.importManager.setNode(taskID, nodeID); err != nil {
+		log.Warn("Failed to save datanode of import task", zap.Int64("taskID", taskID), zap.Error(err))
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// GetImportState returns the state and the progress of an import task
+func (s *Server) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	resp := &milvuspb.GetImportStateResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		resp.Status.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	task := s.importManager.getTask(req.GetTaskID())
+	if task == nil {
+		resp.Status.Reason = fmt.Sprintf("import task %d not found", req.GetTaskID())
+		return resp, nil
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.State = task.GetState()
+	resp.RowCount = task.GetRowCount()
+	resp.SegmentIDs = task.GetSegmentIDs()
+	resp.Reason = task.GetReason()
+	return resp, nil
+}
+
+// ReportImport saves the segments written by an import task and updates the state of the task,
+// the segments are flushed once saved. The segments not saved are dropped if the task fails.
+func (s *Server) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	if s.isClosed() {
+		resp.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	log.Debug("Receive ReportImport request",
+		zap.Int64("taskID", req.GetTaskID()),
+		zap.String("state", req.GetState().String()),
+		zap.Int("segments", len(req.GetSegments())))
+
+	task := s.importManager.getTask(req.GetTaskID())
+	if task == nil {
+		resp.Reason = fmt.Sprintf("import task %d not found", req.GetTaskID())
+		return resp, nil
+	}
+	if isImportDone(task.GetState()) {
+		resp.Reason = fmt.Sprintf("import task %d is already done, state = %s", task.GetTaskID(), task.GetState().String())
+		return resp, nil
+	}
+
+	saved := make([]*datapb.ImportSegment, 0, len(req.GetSegments()))
+	for _, importSegment := range req.GetSegments() {
+		segment := s.meta.GetSegment(importSegment.GetSegmentID())
+		if segment == nil || segment.GetImportTaskID() != task.GetTaskID() {
+			resp.Reason = fmt.Sprintf("segment %d not found in import task %d", importSegment.GetSegmentID(), task.GetTaskID())
+			return resp, nil
+		}
+		if segment.GetState() != commonpb.SegmentState_Growing {
+			// saved by a former report which is retried
+			continue
+		}
+		binlogs := make(map[string]string)
+		for _, fieldBlp := range importSegment.GetInsertLogs() {
+			fieldMeta, err := s.prepareField2PathMeta(importSegment.GetSegmentID(), fieldBlp)
+			if err != nil {
+				log.Error("Prepare binlog meta failed", zap.Error(err))
+				resp.Reason = err.Error()
+				return resp, nil
+			}
+			for k, v := range fieldMeta {
+				binlogs[k] = v
+			}
+		}
+		if err := s.meta.SaveImportSegment(importSegment.GetSegmentID(), importSegment.GetNumOfRows(), binlogs); err != nil {
+			log.Error("Save import segment failed", zap.Int64("segmentID", importSegment.GetSegmentID()), zap.Error(err))
+			resp.Reason = err.Error()
+			return resp, nil
+		}
+		saved = append(saved, importSegment)
+	}
+
+	result := proto.Clone(req).(*datapb.ImportResult)
+	result.Segments = saved
+	if _, err := s.importManager.updateTask(result); err != nil {
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+	for _, segment := range saved {
+		s.flushCh <- segment.GetSegmentID()
+	}
+	if req.GetState() == commonpb.ImportState_ImportFailed {
+		s.dropImportSegments(task.GetTaskID())
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
 // todo deprecated rpc
 func (s *Server) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
 	resp := &internalpb.ComponentStates{
@@ -373,6 +622,7 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 	}
 	segmentIDs := s.meta.GetSegmentsOfPartition(collectionID, partitionID)
 	segment2Binlogs := make(map[UniqueID][]*datapb.FieldBinlog)
+	segment2Deltalogs := make(map[UniqueID][]*datapb.DeltaLogInfo)
 	for _, id := range segmentIDs {
 		segment := s.meta.GetSegment(id)
 		if segment == nil {
@@ -403,6 +653,7 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 			}
 			segment2Binlogs[id] = append(segment2Binlogs[id], fieldBinlogs)
 		}
+		segment2Deltalogs[id] = segment.GetDeltalogs()
 	}
 
 	binlogs := make([]*datapb.SegmentBinlogs, 0, len(segment2Binlogs))
@@ -410,6 +661,7 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 		sbl := &datapb.SegmentBinlogs{
 			SegmentID:    segmentID,
 			FieldBinlogs: fieldBinlogs,
+			Deltalogs:    segment2Deltalogs[segmentID],
 		}
 		binlogs = append(binlogs, sbl)
 	}
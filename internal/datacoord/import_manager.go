@@ -0,0 +1,161 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+const importTaskPrefix = metaPrefix + "/import-task"
+
+// importManager keeps the import tasks, which load files in object storage into segments.
+// The tasks are persisted in kv, so that their states can still be queried after DataCoord restarts.
+type importManager struct {
+	mu    sync.RWMutex
+	kv    kv.TxnKV
+	tasks map[UniqueID]*datapb.ImportTask // task id -> task
+}
+
+func newImportManager(kv kv.TxnKV) (*importManager, error) {
+	im := &importManager{
+		kv:    kv,
+		tasks: make(map[UniqueID]*datapb.ImportTask),
+	}
+	_, values, err := kv.LoadWithPrefix(importTaskPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		task := &datapb.ImportTask{}
+		if err := proto.UnmarshalText(value, task); err != nil {
+			return nil, fmt.Errorf("DataCoord newImportManager UnMarshalText datapb.ImportTask err:%w", err)
+		}
+		im.tasks[task.GetTaskID()] = task
+	}
+	return im, nil
+}
+
+func buildImportTaskPath(taskID UniqueID) string {
+	return fmt.Sprintf("%s/%d", importTaskPrefix, taskID)
+}
+
+func isImportDone(state commonpb.ImportState) bool {
+	return state == commonpb.ImportState_ImportCompleted || state == commonpb.ImportState_ImportFailed
+}
+
+func (im *importManager) saveTask(task *datapb.ImportTask) error {
+	return im.kv.Save(buildImportTaskPath(task.GetTaskID()), proto.MarshalTextString(task))
+}
+
+// addTask saves a new task
+func (im *importManager) addTask(task *datapb.ImportTask) error {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	if _, ok := im.tasks[task.GetTaskID()]; ok {
+		return fmt.Errorf("import task %d already exists", task.GetTaskID())
+	}
+	if err := im.saveTask(task); err != nil {
+		return err
+	}
+	im.tasks[task.GetTaskID()] = task
+	return nil
+}
+
+// getTask returns a copy of the task, nil if not found
+func (im *importManager) getTask(taskID UniqueID) *datapb.ImportTask {
+	im.mu.RLock()
+	defer im.mu.RUnlock()
+	task, ok := im.tasks[taskID]
+	if !ok {
+		return nil
+	}
+	return proto.Clone(task).(*datapb.ImportTask)
+}
+
+// setNode records the datanode a pending task is sent to
+func (im *importManager) setNode(taskID UniqueID, nodeID UniqueID) error {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	task, ok := im.tasks[taskID]
+	if !ok {
+		return fmt.Errorf("import task %d not found", taskID)
+	}
+	task = proto.Clone(task).(*datapb.ImportTask)
+	task.NodeID = nodeID
+	if err := im.saveTask(task); err != nil {
+		return err
+	}
+	im.tasks[taskID] = task
+	return nil
+}
+
+// updateTask applies a report of the datanode running the task, the segments in the report must
+// have been saved. Reports of finished tasks are rejected, so that the datanode stops a task which
+// has been failed by DataCoord.
+func (im *importManager) updateTask(result *datapb.ImportResult) (*datapb.ImportTask, error) {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	task, ok := im.tasks[result.GetTaskID()]
+	if !ok {
+		return nil, fmt.Errorf("import task %d not found", result.GetTaskID())
+	}
+	if isImportDone(task.GetState()) {
+		return nil, fmt.Errorf("import task %d is already done, state = %s, reason = %s",
+			task.GetTaskID(), task.GetState().String(), task.GetReason())
+	}
+	task = proto.Clone(task).(*datapb.ImportTask)
+	task.State = result.GetState()
+	task.Reason = result.GetReason()
+	for _, segment := range result.GetSegments() {
+		task.SegmentIDs = append(task.SegmentIDs, segment.GetSegmentID())
+		task.RowCount += segment.GetNumOfRows()
+	}
+	if err := im.saveTask(task); err != nil {
+		return nil, err
+	}
+	im.tasks[task.GetTaskID()] = task
+	log.Debug("import task updated", zap.Int64("taskID", task.GetTaskID()),
+		zap.String("state", task.GetState().String()), zap.Int64("rowCount", task.GetRowCount()),
+		zap.String("reason", task.GetReason()))
+	return task, nil
+}
+
+// failTasks fails the unfinished tasks matched by filter and returns them
+func (im *importManager) failTasks(filter func(task *datapb.ImportTask) bool, reason string) []*datapb.ImportTask {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	failed := make([]*datapb.ImportTask, 0)
+	for id, task := range im.tasks {
+		if isImportDone(task.GetState()) || !filter(task) {
+			continue
+		}
+		task = proto.Clone(task).(*datapb.ImportTask)
+		task.State = commonpb.ImportState_ImportFailed
+		task.Reason = reason
+		if err := im.saveTask(task); err != nil {
+			log.Warn("failed to save import task", zap.Int64("taskID", id), zap.Error(err))
+			continue
+		}
+		im.tasks[id] = task
+		failed = append(failed, task)
+	}
+	return failed
+}
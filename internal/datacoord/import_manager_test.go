@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestImportManager(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	im, err := newImportManager(kv)
+	assert.Nil(t, err)
+
+	task := &datapb.ImportTask{
+		TaskID:       1,
+		CollectionID: 100,
+		ChannelNames: []string{"ch1"},
+		Files:        []string{"a.json"},
+		State:        commonpb.ImportState_ImportPending,
+	}
+	assert.Nil(t, im.addTask(task))
+	assert.NotNil(t, im.addTask(task))
+	assert.Nil(t, im.getTask(2))
+	assert.Nil(t, im.setNode(1, 10))
+	assert.NotNil(t, im.setNode(2, 10))
+
+	_, err = im.updateTask(&datapb.ImportResult{TaskID: 2})
+	assert.NotNil(t, err)
+	updated, err := im.updateTask(&datapb.ImportResult{
+		TaskID:   1,
+		State:    commonpb.ImportState_ImportRunning,
+		Segments: []*datapb.ImportSegment{{SegmentID: 1000, NumOfRows: 10}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportRunning, updated.GetState())
+	_, err = im.updateTask(&datapb.ImportResult{
+		TaskID:   1,
+		State:    commonpb.ImportState_ImportRunning,
+		Segments: []*datapb.ImportSegment{{SegmentID: 1001, NumOfRows: 5}},
+	})
+	assert.Nil(t, err)
+
+	// the tasks are reloaded from kv
+	im, err = newImportManager(kv)
+	assert.Nil(t, err)
+	task = im.getTask(1)
+	assert.NotNil(t, task)
+	assert.EqualValues(t, 10, task.GetNodeID())
+	assert.EqualValues(t, 15, task.GetRowCount())
+	assert.Equal(t, []UniqueID{1000, 1001}, task.GetSegmentIDs())
+
+	failed := im.failTasks(func(task *datapb.ImportTask) bool { return task.GetNodeID() == 11 }, "node down")
+	assert.Equal(t, 0, len(failed))
+	failed = im.failTasks(func(task *datapb.ImportTask) bool { return task.GetNodeID() == 10 }, "node down")
+	assert.Equal(t, 1, len(failed))
+	task = im.getTask(1)
+	assert.Equal(t, commonpb.ImportState_ImportFailed, task.GetState())
+	assert.Equal(t, "node down", task.GetReason())
+
+	// the reports of failed tasks are rejected
+	_, err = im.updateTask(&datapb.ImportResult{TaskID: 1, State: commonpb.ImportState_ImportCompleted})
+	assert.NotNil(t, err)
+}
+
+func TestMeta_SaveImportSegment(t *testing.T) {
+	Params.Init()
+	mockAllocator := newMockAllocator()
+	meta, err := newMemoryMeta(mockAllocator)
+	assert.Nil(t, err)
+	segmentManager := newSegmentManager(meta, mockAllocator)
+
+	collID, err := mockAllocator.allocID()
+	assert.Nil(t, err)
+	meta.AddCollection(&datapb.CollectionInfo{ID: collID, Schema: newTestSchema()})
+
+	segID, count, err := segmentManager.AllocImportSegment(context.TODO(), collID, 100, "c1", 1, 100)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 100, count)
+	segment := meta.GetSegment(segID)
+	assert.NotNil(t, segment)
+	assert.Equal(t, commonpb.SegmentState_Growing, segment.GetState())
+	assert.EqualValues(t, 1, segment.GetImportTaskID())
+
+	// import segments are not allocated to inserts
+	id, _, _, err := segmentManager.AllocSegment(context.TODO(), collID, 100, "c1", 10)
+	assert.Nil(t, err)
+	assert.NotEqual(t, segID, id)
+	assert.Equal(t, 1, len(meta.GetSegmentsOfImportTask(1)))
+
+	err = meta.SaveImportSegment(segID, 100, map[string]string{"binlog": "path"})
+	assert.Nil(t, err)
+	segment = meta.GetSegment(segID)
+	assert.Equal(t, commonpb.SegmentState_Flushing, segment.GetState())
+	assert.EqualValues(t, 100, segment.GetNumOfRows())
+	value, err := meta.client.Load("binlog")
+	assert.Nil(t, err)
+	assert.Equal(t, "path", value)
+
+	// the segment is saved once
+	assert.NotNil(t, meta.SaveImportSegment(segID, 100, nil))
+	assert.NotNil(t, meta.SaveImportSegment(-1, 100, nil))
+}
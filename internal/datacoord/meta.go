@@ -19,11 +19,14 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
 const (
 	metaPrefix    = "datacoord-meta"
 	segmentPrefix = metaPrefix + "/s"
+	// dropped and compacted segments are kept under droppedSegmentPrefix within the retention duration
+	droppedSegmentPrefix = metaPrefix + "/d"
 )
 
 type meta struct {
@@ -31,6 +34,7 @@ type meta struct {
 	client      kv.TxnKV                            // client of a reliable kv service, i.e. etcd client
 	collections map[UniqueID]*datapb.CollectionInfo // collection id to collection info
 	segments    *SegmentsInfo                       // segment id to segment info
+	dropped     map[UniqueID]*datapb.SegmentInfo    // segment id to the info of dropped or compacted segment
 }
 
 func newMeta(kv kv.TxnKV) (*meta, error) {
@@ -38,6 +42,7 @@ func newMeta(kv kv.TxnKV) (*meta, error) {
 		client:      kv,
 		collections: make(map[UniqueID]*datapb.CollectionInfo),
 		segments:    NewSegmentsInfo(),
+		dropped:     make(map[UniqueID]*datapb.SegmentInfo),
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
@@ -61,6 +66,19 @@ func (m *meta) reloadFromKV() error {
 		m.segments.SetSegment(segmentInfo.GetID(), segmentInfo)
 	}
 
+	_, values, err = m.client.LoadWithPrefix(droppedSegmentPrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		segmentInfo := &datapb.SegmentInfo{}
+		err = proto.UnmarshalText(value, segmentInfo)
+		if err != nil {
+			return fmt.Errorf("DataCoord reloadFromKV UnMarshalText dropped datapb.SegmentInfo err:%w", err)
+		}
+		m.dropped[segmentInfo.GetID()] = segmentInfo
+	}
+
 	return nil
 }
 
@@ -151,8 +169,8 @@ func (m *meta) SetState(segmentID UniqueID, state commonpb.SegmentState) error {
 }
 
 func (m *meta) SaveBinlogAndCheckPoints(segID UniqueID, flushed bool,
-	binlogs map[string]string, checkpoints []*datapb.CheckPoint,
-	startPositions []*datapb.SegmentStartPosition) error {
+	binlogs map[string]string, binlogSize int64, checkpoints []*datapb.CheckPoint,
+	startPositions []*datapb.SegmentStartPosition, deltalogs []*datapb.DeltaLogInfo) error {
 	m.Lock()
 	defer m.Unlock()
 	kv := make(map[string]string)
@@ -164,6 +182,26 @@ func (m *meta) SaveBinlogAndCheckPoints(segID UniqueID, flushed bool,
 	}
 
 	modSegments := make([]UniqueID, 0)
+	if binlogSize > 0 {
+		if segment := m.segments.GetSegment(segID); segment != nil {
+			m.segments.AddBinlogSize(segID, binlogSize)
+			modSegments = append(modSegments, segID)
+		}
+	}
+	if len(deltalogs) > 0 {
+		// delete records of a segment which has been compacted belong to the compacted segment
+		targetID := segID
+		if m.segments.GetSegment(segID) == nil {
+			if segment := m.getCompactedTo(segID); segment != nil {
+				targetID = segment.GetID()
+			}
+		}
+		if segment := m.segments.GetSegment(targetID); segment != nil {
+			m.segments.AddDeltalogs(targetID, deltalogs)
+			modSegments = append(modSegments, targetID)
+		}
+	}
+
 	for _, pos := range startPositions {
 		if len(pos.GetStartPosition().GetMsgID()) != 0 {
 			continue
@@ -194,12 +232,211 @@ func (m *meta) SaveBinlogAndCheckPoints(segID UniqueID, flushed bool,
 		}
 	}
 
-	if err := m.saveKvTxn(kv); err != nil {
+	if err := m.saveKvTxn(kv, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SaveImportSegment saves the binlog paths and the row count of a segment written by an import task
+// in one transaction, the segment is in Flushing state until the flush completion is handled.
+func (m *meta) SaveImportSegment(segID UniqueID, numOfRows int64, binlogs map[string]string) error {
+	m.Lock()
+	defer m.Unlock()
+	segment := m.segments.GetSegment(segID)
+	if segment == nil {
+		return fmt.Errorf("import segment %d not found", segID)
+	}
+	if segment.GetState() != commonpb.SegmentState_Growing {
+		return fmt.Errorf("import segment %d is already saved, state = %s", segID, segment.GetState().String())
+	}
+	segment = proto.Clone(segment).(*datapb.SegmentInfo)
+	segment.NumOfRows = numOfRows
+	segment.State = commonpb.SegmentState_Flushing
+
+	kv := make(map[string]string)
+	for k, v := range binlogs {
+		kv[k] = v
+	}
+	kv[buildSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())] = proto.MarshalTextString(segment)
+	if err := m.saveKvTxn(kv, nil); err != nil {
+		return err
+	}
+	m.segments.SetSegment(segID, segment)
+	return nil
+}
+
+// GetSegmentsOfImportTask returns the segments allocated to an import task
+func (m *meta) GetSegmentsOfImportTask(taskID UniqueID) []*datapb.SegmentInfo {
+	m.RLock()
+	defer m.RUnlock()
+	ret := make([]*datapb.SegmentInfo, 0)
+	for _, segment := range m.segments.GetSegments() {
+		if segment.GetImportTaskID() == taskID {
+			ret = append(ret, segment)
+		}
+	}
+	return ret
+}
+
+// CompleteMergeCompaction swaps the segments compacted by plan with the segment in result.
+// The new segment, its binlog paths and the move of the compacted segments to dropped segments are saved
+// in one transaction. The new segment is in Flushing state until the flush completion is handled.
+// The compacted segments and their binlog paths are kept until the garbage collector purges them.
+func (m *meta) CompleteMergeCompaction(plan *datapb.CompactionPlan, result *datapb.CompactionResult,
+	binlogs map[string]string) (*datapb.SegmentInfo, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	compactedDeltalogs := make(map[string]struct{})
+	compactionFrom := make([]*datapb.SegmentInfo, 0, len(plan.GetSegmentBinlogs()))
+	for _, segmentBinlogs := range plan.GetSegmentBinlogs() {
+		segment := m.segments.GetSegment(segmentBinlogs.GetSegmentID())
+		if segment == nil {
+			return nil, fmt.Errorf("compacted segment %d not found", segmentBinlogs.GetSegmentID())
+		}
+		if segment.GetState() != commonpb.SegmentState_Flushed {
+			return nil, fmt.Errorf("compacted segment %d is not flushed, state = %s",
+				segment.GetID(), segment.GetState().String())
+		}
+		for _, deltalog := range segmentBinlogs.GetDeltalogs() {
+			compactedDeltalogs[deltalog.GetDeltaLogPath()] = struct{}{}
+		}
+		compactionFrom = append(compactionFrom, segment)
+	}
+	if len(compactionFrom) == 0 {
+		return nil, fmt.Errorf("compaction plan %d has no segment", plan.GetPlanID())
+	}
+
+	segment := &datapb.SegmentInfo{
+		ID:            result.GetSegmentID(),
+		CollectionID:  compactionFrom[0].GetCollectionID(),
+		PartitionID:   compactionFrom[0].GetPartitionID(),
+		InsertChannel: compactionFrom[0].GetInsertChannel(),
+		NumOfRows:     result.GetNumOfRows(),
+		State:         commonpb.SegmentState_Flushing,
+		Deltalogs:     result.GetDeltalogs(),
+		BinlogSize:    result.GetBinlogSize(),
+	}
+	for _, from := range compactionFrom {
+		segment.CompactionFrom = append(segment.CompactionFrom, from.GetID())
+		if from.GetMaxRowNum() > segment.MaxRowNum {
+			segment.MaxRowNum = from.GetMaxRowNum()
+		}
+		if from.GetLastExpireTime() > segment.LastExpireTime {
+			segment.LastExpireTime = from.GetLastExpireTime()
+		}
+		if pos := from.GetDmlPosition(); pos != nil &&
+			(segment.DmlPosition == nil || pos.GetTimestamp() > segment.DmlPosition.GetTimestamp()) {
+			segment.DmlPosition = pos
+		}
+		if pos := from.GetStartPosition(); pos != nil &&
+			(segment.StartPosition == nil || pos.GetTimestamp() < segment.StartPosition.GetTimestamp()) {
+			segment.StartPosition = pos
+		}
+		// delete records saved after the plan was made are not applied by the compaction
+		for _, deltalog := range from.GetDeltalogs() {
+			if _, ok := compactedDeltalogs[deltalog.GetDeltaLogPath()]; !ok {
+				segment.Deltalogs = append(segment.Deltalogs, deltalog)
+			}
+		}
+	}
+
+	kv := make(map[string]string)
+	for k, v := range binlogs {
+		kv[k] = v
+	}
+	kv[buildSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())] = proto.MarshalTextString(segment)
+	dropTime := tsoutil.GetCurrentTime()
+	dropped := make([]*datapb.SegmentInfo, 0, len(compactionFrom))
+	keys := make([]string, 0, len(compactionFrom))
+	for _, from := range compactionFrom {
+		from = proto.Clone(from).(*datapb.SegmentInfo)
+		from.DropTime = dropTime
+		dropped = append(dropped, from)
+		kv[buildDroppedSegmentPath(from.GetCollectionID(), from.GetPartitionID(), from.GetID())] = proto.MarshalTextString(from)
+		keys = append(keys, buildSegmentPath(from.GetCollectionID(), from.GetPartitionID(), from.GetID()))
+	}
+	if err := m.saveKvTxn(kv, keys); err != nil {
+		return nil, err
+	}
+
+	for _, from := range dropped {
+		m.segments.DropSegment(from.GetID())
+		m.dropped[from.GetID()] = from
+	}
+	m.segments.SetSegment(segment.GetID(), segment)
+	return segment, nil
+}
+
+// MarkSegmentsDropped moves the segments to dropped segments with dropTime, they are kept
+// with their binlog paths until the garbage collector purges them
+func (m *meta) MarkSegmentsDropped(segmentIDs []UniqueID, dropTime Timestamp) error {
+	m.Lock()
+	defer m.Unlock()
+	kv := make(map[string]string)
+	keys := make([]string, 0, len(segmentIDs))
+	dropped := make([]*datapb.SegmentInfo, 0, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		segment := m.segments.GetSegment(segmentID)
+		if segment == nil {
+			continue
+		}
+		segment = proto.Clone(segment).(*datapb.SegmentInfo)
+		segment.DropTime = dropTime
+		dropped = append(dropped, segment)
+		kv[buildDroppedSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())] = proto.MarshalTextString(segment)
+		keys = append(keys, buildSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID()))
+	}
+	if len(dropped) == 0 {
+		return nil
+	}
+	if err := m.saveKvTxn(kv, keys); err != nil {
 		return err
 	}
+	for _, segment := range dropped {
+		m.segments.DropSegment(segment.GetID())
+		m.dropped[segment.GetID()] = segment
+	}
 	return nil
 }
 
+// GetDroppedSegments returns the dropped and compacted segments which are not purged
+func (m *meta) GetDroppedSegments() []*datapb.SegmentInfo {
+	m.RLock()
+	defer m.RUnlock()
+	ret := make([]*datapb.SegmentInfo, 0, len(m.dropped))
+	for _, segment := range m.dropped {
+		ret = append(ret, segment)
+	}
+	return ret
+}
+
+// PurgeDroppedSegment removes a dropped segment and the keys of its binlog paths in one transaction
+func (m *meta) PurgeDroppedSegment(segmentID UniqueID, binlogKeys []string) error {
+	m.Lock()
+	defer m.Unlock()
+	segment, ok := m.dropped[segmentID]
+	if !ok {
+		return fmt.Errorf("dropped segment %d not found", segmentID)
+	}
+	keys := make([]string, 0, len(binlogKeys)+1)
+	keys = append(keys, binlogKeys...)
+	keys = append(keys, buildDroppedSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID()))
+	if err := m.client.MultiRemove(keys); err != nil {
+		return err
+	}
+	delete(m.dropped, segmentID)
+	return nil
+}
+
+// GetAllSegments returns the segments of all states in meta
+func (m *meta) GetAllSegments() []*datapb.SegmentInfo {
+	m.RLock()
+	defer m.RUnlock()
+	return m.segments.GetSegments()
+}
+
 func (m *meta) GetSegmentsByChannel(dmlCh string) []*datapb.SegmentInfo {
 	m.RLock()
 	defer m.RUnlock()
@@ -279,6 +516,19 @@ func (m *meta) GetFlushingSegments() []*datapb.SegmentInfo {
 	return ret
 }
 
+func (m *meta) GetFlushedSegments() []*datapb.SegmentInfo {
+	m.RLock()
+	defer m.RUnlock()
+	ret := make([]*datapb.SegmentInfo, 0)
+	segments := m.segments.GetSegments()
+	for _, info := range segments {
+		if info.State == commonpb.SegmentState_Flushed {
+			ret = append(ret, info)
+		}
+	}
+	return ret
+}
+
 func (m *meta) saveSegmentInfo(segment *datapb.SegmentInfo) error {
 	segBytes := proto.MarshalTextString(segment)
 
@@ -291,14 +541,33 @@ func (m *meta) removeSegmentInfo(segment *datapb.SegmentInfo) error {
 	return m.client.Remove(key)
 }
 
-func (m *meta) saveKvTxn(kv map[string]string) error {
-	return m.client.MultiSave(kv)
+func (m *meta) saveKvTxn(kv map[string]string, removals []string) error {
+	if len(removals) == 0 {
+		return m.client.MultiSave(kv)
+	}
+	return m.client.MultiSaveAndRemove(kv, removals)
+}
+
+// getCompactedTo returns the segment which segID has been compacted into, caller should hold the lock
+func (m *meta) getCompactedTo(segID UniqueID) *datapb.SegmentInfo {
+	for _, segment := range m.segments.GetSegments() {
+		for _, id := range segment.GetCompactionFrom() {
+			if id == segID {
+				return segment
+			}
+		}
+	}
+	return nil
 }
 
 func buildSegmentPath(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID) string {
 	return fmt.Sprintf("%s/%d/%d/%d", segmentPrefix, collectionID, partitionID, segmentID)
 }
 
+func buildDroppedSegmentPath(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID) string {
+	return fmt.Sprintf("%s/%d/%d/%d", droppedSegmentPrefix, collectionID, partitionID, segmentID)
+}
+
 func buildCollectionPath(collectionID UniqueID) string {
 	return fmt.Sprintf("%s/%d/", segmentPrefix, collectionID)
 }
@@ -176,3 +176,59 @@ func TestGetUnFlushedSegments(t *testing.T) {
 	assert.EqualValues(t, 0, segments[0].ID)
 	assert.NotEqualValues(t, commonpb.SegmentState_Flushed, segments[0].State)
 }
+
+func TestSaveBinlogAndCheckPoints_BinlogSize(t *testing.T) {
+	mockAllocator := newMockAllocator()
+	meta, err := newMemoryMeta(mockAllocator)
+	assert.Nil(t, err)
+	err = meta.AddSegment(&datapb.SegmentInfo{
+		ID:           0,
+		CollectionID: 0,
+		PartitionID:  0,
+		State:        commonpb.SegmentState_Growing,
+	})
+	assert.Nil(t, err)
+
+	err = meta.SaveBinlogAndCheckPoints(0, false, map[string]string{}, 1024, nil, nil, nil)
+	assert.Nil(t, err)
+	err = meta.SaveBinlogAndCheckPoints(0, true, map[string]string{}, 512, nil, nil, nil)
+	assert.Nil(t, err)
+	err = meta.SetState(0, commonpb.SegmentState_Flushed)
+	assert.Nil(t, err)
+
+	segment := meta.GetSegment(0)
+	assert.NotNil(t, segment)
+	assert.EqualValues(t, 1536, segment.BinlogSize)
+}
+
+func TestMeta_DroppedSegments(t *testing.T) {
+	meta, err := newMemoryMeta(newMockAllocator())
+	assert.Nil(t, err)
+	for _, segment := range []*datapb.SegmentInfo{buildFlushedSegment(1, 10, 0), buildFlushedSegment(2, 10, 0)} {
+		assert.Nil(t, meta.AddSegment(segment))
+	}
+	assert.Nil(t, meta.client.Save("binlog/1", "path"))
+
+	assert.Nil(t, meta.MarkSegmentsDropped([]UniqueID{1, 3}, 100))
+	assert.Nil(t, meta.GetSegment(1))
+	assert.NotNil(t, meta.GetSegment(2))
+
+	// dropped segments are reloaded from kv
+	reloaded, err := newMeta(meta.client)
+	assert.Nil(t, err)
+	assert.Nil(t, reloaded.GetSegment(1))
+	dropped := reloaded.GetDroppedSegments()
+	assert.Equal(t, 1, len(dropped))
+	assert.EqualValues(t, 1, dropped[0].GetID())
+	assert.EqualValues(t, 100, dropped[0].GetDropTime())
+
+	assert.Nil(t, reloaded.PurgeDroppedSegment(1, []string{"binlog/1"}))
+	assert.NotNil(t, reloaded.PurgeDroppedSegment(1, nil))
+	assert.Equal(t, 0, len(reloaded.GetDroppedSegments()))
+	keys, _, err := meta.client.LoadWithPrefix("binlog/")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(keys))
+	_, values, err := meta.client.LoadWithPrefix(droppedSegmentPrefix)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(values))
+}
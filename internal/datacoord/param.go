@@ -41,6 +41,19 @@ type ParamTable struct {
 	// --- Rocksmq ---
 	RocksmqPath string
 
+	// --- MinIO ---
+	MinioAddress         string
+	MinioAccessKeyID     string
+	MinioSecretAccessKey string
+	MinioUseSSL          bool
+	MinioBucketName      string
+
+	InsertBinlogRootPath string
+	StatsBinlogRootPath  string
+	DeleteBinlogRootPath string
+	DdlBinlogRootPath    string
+	IndexFileRootPath    string
+
 	FlushStreamPosSubPath string
 	StatsStreamPosSubPath string
 
@@ -49,6 +62,22 @@ type ParamTable struct {
 	SegmentSealProportion   float64
 	SegAssignmentExpiration int64
 
+	// compaction
+	EnableCompaction          bool
+	CompactionInterval        int64
+	CompactionTimeout         int32
+	CompactionSmallProportion float64
+	CompactionDeleteRatio     float64
+
+	// seconds of history retained for time travel
+	RetentionDuration int64
+
+	// garbage collection
+	EnableGarbageCollection bool
+	GCInterval              int64
+	GCMissingTolerance      int64
+	GCDryRun                bool
+
 	InsertChannelPrefixName   string
 	StatisticsChannelName     string
 	TimeTickChannelName       string
@@ -80,9 +109,30 @@ func (p *ParamTable) Init() {
 		p.initPulsarAddress()
 		p.initRocksmqPath()
 
+		p.initMinioAddress()
+		p.initMinioAccessKeyID()
+		p.initMinioSecretAccessKey()
+		p.initMinioUseSSL()
+		p.initMinioBucketName()
+		p.initInsertBinlogRootPath()
+		p.initStatsBinlogRootPath()
+		p.initDeleteBinlogRootPath()
+		p.initDdlBinlogRootPath()
+		p.initIndexFileRootPath()
+
 		p.initSegmentMaxSize()
 		p.initSegmentSealProportion()
 		p.initSegAssignmentExpiration()
+		p.initEnableCompaction()
+		p.initCompactionInterval()
+		p.initCompactionTimeout()
+		p.initCompactionSmallProportion()
+		p.initCompactionDeleteRatio()
+		p.initRetentionDuration()
+		p.initEnableGarbageCollection()
+		p.initGCInterval()
+		p.initGCMissingTolerance()
+		p.initGCDryRun()
 		p.initInsertChannelPrefixName()
 		p.initStatisticsChannelName()
 		p.initTimeTickChannelName()
@@ -119,6 +169,88 @@ func (p *ParamTable) initRocksmqPath() {
 	p.RocksmqPath = path
 }
 
+func (p *ParamTable) initMinioAddress() {
+	endpoint, err := p.Load("_MinioAddress")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioAddress = endpoint
+}
+
+func (p *ParamTable) initMinioAccessKeyID() {
+	keyID, err := p.Load("minio.accessKeyID")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioAccessKeyID = keyID
+}
+
+func (p *ParamTable) initMinioSecretAccessKey() {
+	key, err := p.Load("minio.secretAccessKey")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioSecretAccessKey = key
+}
+
+func (p *ParamTable) initMinioUseSSL() {
+	usessl, err := p.Load("minio.useSSL")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioUseSSL, _ = strconv.ParseBool(usessl)
+}
+
+func (p *ParamTable) initMinioBucketName() {
+	bucketName, err := p.Load("minio.bucketName")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioBucketName = bucketName
+}
+
+// binlog root paths are the same as the ones datanodes write binlogs to
+func (p *ParamTable) initInsertBinlogRootPath() {
+	rootPath, err := p.Load("etcd.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.InsertBinlogRootPath = path.Join(rootPath, "insert_log")
+}
+
+func (p *ParamTable) initStatsBinlogRootPath() {
+	rootPath, err := p.Load("etcd.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.StatsBinlogRootPath = path.Join(rootPath, "stats_log")
+}
+
+func (p *ParamTable) initDeleteBinlogRootPath() {
+	rootPath, err := p.Load("etcd.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.DeleteBinlogRootPath = path.Join(rootPath, "delta_log")
+}
+
+func (p *ParamTable) initDdlBinlogRootPath() {
+	rootPath, err := p.Load("etcd.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.DdlBinlogRootPath = path.Join(rootPath, "data_definition_log")
+}
+
+// index file root path is the same as the one indexnodes write index files to
+func (p *ParamTable) initIndexFileRootPath() {
+	rootPath, err := p.Load("etcd.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.IndexFileRootPath = path.Join(rootPath, "index_files")
+}
+
 func (p *ParamTable) initMetaRootPath() {
 	rootPath, err := p.Load("etcd.rootPath")
 	if err != nil {
@@ -171,6 +303,67 @@ func (p *ParamTable) initSegAssignmentExpiration() {
 	p.SegAssignmentExpiration = p.ParseInt64("datacoord.segment.assignmentExpiration")
 }
 
+func (p *ParamTable) initEnableCompaction() {
+	enable, err := p.Load("datacoord.compaction.enable")
+	if err != nil {
+		panic(err)
+	}
+	p.EnableCompaction, err = strconv.ParseBool(enable)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (p *ParamTable) initCompactionInterval() {
+	p.CompactionInterval = p.ParseInt64("datacoord.compaction.interval")
+}
+
+func (p *ParamTable) initCompactionTimeout() {
+	p.CompactionTimeout = p.ParseInt32("datacoord.compaction.timeout")
+}
+
+func (p *ParamTable) initCompactionSmallProportion() {
+	p.CompactionSmallProportion = p.ParseFloat("datacoord.compaction.smallProportion")
+}
+
+func (p *ParamTable) initCompactionDeleteRatio() {
+	p.CompactionDeleteRatio = p.ParseFloat("datacoord.compaction.deleteRatio")
+}
+
+func (p *ParamTable) initRetentionDuration() {
+	p.RetentionDuration = p.ParseInt64("common.retentionDuration")
+}
+
+func (p *ParamTable) initEnableGarbageCollection() {
+	enable, err := p.Load("datacoord.gc.enable")
+	if err != nil {
+		panic(err)
+	}
+	p.EnableGarbageCollection, err = strconv.ParseBool(enable)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (p *ParamTable) initGCInterval() {
+	p.GCInterval = p.ParseInt64("datacoord.gc.interval")
+}
+
+func (p *ParamTable) initGCMissingTolerance() {
+	p.GCMissingTolerance = p.ParseInt64("datacoord.gc.missingTolerance")
+}
+
+func (p *ParamTable) initGCDryRun() {
+	dryRun, err := p.Load("datacoord.gc.dryRun")
+	if err != nil {
+		panic(err)
+	}
+	p.GCDryRun, err = strconv.ParseBool(dryRun)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (p *ParamTable) initInsertChannelPrefixName() {
 	var err error
 	p.InsertChannelPrefixName, err = p.Load("msgChannel.chanNamePrefix.dataCoordInsertChannel")
@@ -69,9 +69,25 @@ func (s *SegmentsInfo) SetStartPosition(segmentID UniqueID, pos *internalpb.MsgP
 	}
 }
 
+func (s *SegmentsInfo) AddDeltalogs(segmentID UniqueID, deltalogs []*datapb.DeltaLogInfo) {
+	if segment, ok := s.segments[segmentID]; ok {
+		s.segments[segmentID] = s.Clone(segment, AddDeltalogs(deltalogs))
+	}
+}
+
+func (s *SegmentsInfo) AddBinlogSize(segmentID UniqueID, size int64) {
+	if segment, ok := s.segments[segmentID]; ok {
+		s.segments[segmentID] = s.Clone(segment, AddBinlogSize(size))
+	}
+}
+
 func (s *SegmentsInfo) Clone(segment *datapb.SegmentInfo, opts ...SegmentInfoOption) *datapb.SegmentInfo {
 	dmlPos := proto.Clone(segment.DmlPosition).(*internalpb.MsgPosition)
 	startPos := proto.Clone(segment.StartPosition).(*internalpb.MsgPosition)
+	deltalogs := make([]*datapb.DeltaLogInfo, 0, len(segment.Deltalogs))
+	for _, deltalog := range segment.Deltalogs {
+		deltalogs = append(deltalogs, proto.Clone(deltalog).(*datapb.DeltaLogInfo))
+	}
 	cloned := &datapb.SegmentInfo{
 		ID:             segment.ID,
 		CollectionID:   segment.CollectionID,
@@ -83,6 +99,9 @@ func (s *SegmentsInfo) Clone(segment *datapb.SegmentInfo, opts ...SegmentInfoOpt
 		MaxRowNum:      segment.MaxRowNum,
 		LastExpireTime: segment.LastExpireTime,
 		StartPosition:  startPos,
+		Deltalogs:      deltalogs,
+		CompactionFrom: append([]UniqueID(nil), segment.CompactionFrom...),
+		BinlogSize:     segment.BinlogSize,
 	}
 	for _, opt := range opts {
 		opt(cloned)
@@ -102,6 +121,9 @@ func (s *SegmentsInfo) ShadowClone(segment *datapb.SegmentInfo, opts ...SegmentI
 		MaxRowNum:      segment.MaxRowNum,
 		LastExpireTime: segment.LastExpireTime,
 		StartPosition:  segment.StartPosition,
+		Deltalogs:      segment.Deltalogs,
+		CompactionFrom: segment.CompactionFrom,
+		BinlogSize:     segment.BinlogSize,
 	}
 
 	for _, opt := range opts {
@@ -141,3 +163,15 @@ func SetStartPosition(pos *internalpb.MsgPosition) SegmentInfoOption {
 		segment.StartPosition = pos
 	}
 }
+
+func AddDeltalogs(deltalogs []*datapb.DeltaLogInfo) SegmentInfoOption {
+	return func(segment *datapb.SegmentInfo) {
+		segment.Deltalogs = append(segment.Deltalogs, deltalogs...)
+	}
+}
+
+func AddBinlogSize(size int64) SegmentInfoOption {
+	return func(segment *datapb.SegmentInfo) {
+		segment.BinlogSize += size
+	}
+}
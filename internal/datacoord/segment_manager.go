@@ -37,6 +37,8 @@ var errRemainInSufficient = func(requestRows int64) error {
 type Manager interface {
 	// AllocSegment allocate rows and record the allocation.
 	AllocSegment(ctx context.Context, collectionID, partitionID UniqueID, channelName string, requestRows int64) (UniqueID, int64, Timestamp, error)
+	// AllocImportSegment opens a segment for the rows of an import task and returns the number of rows it holds.
+	AllocImportSegment(ctx context.Context, collectionID, partitionID UniqueID, channelName string, importTaskID UniqueID, requestRows int64) (UniqueID, int64, error)
 	// DropSegment drop the segment from allocator.
 	DropSegment(ctx context.Context, segmentID UniqueID)
 	// SealAllSegments sealed all segmetns of collection with collectionID and return sealed segments
@@ -192,6 +194,10 @@ func (s *SegmentManager) loadSegmentsFromMeta() {
 	segments := s.meta.GetUnFlushedSegments()
 	ids := make([]UniqueID, 0, len(segments))
 	for _, seg := range segments {
+		// segments of import tasks are written by datanodes directly, not allocated to inserts
+		if seg.GetImportTaskID() != 0 {
+			continue
+		}
 		ids = append(ids, seg.ID)
 		stat := &segmentStatus{
 			id:          seg.ID,
@@ -281,6 +287,51 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 	return
 }
 
+// AllocImportSegment opens a segment for the rows of an import task, the segment is not allocated to
+// inserts and gets flushed when the datanode running the task reports its binlogs.
+// The number of rows returned is capped by the max row number of the segment.
+func (s *SegmentManager) AllocImportSegment(ctx context.Context, collectionID UniqueID, partitionID UniqueID,
+	channelName string, importTaskID UniqueID, requestRows int64) (UniqueID, int64, error) {
+	sp, _ := trace.StartSpanFromContext(ctx)
+	defer sp.Finish()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, err := s.allocator.allocID()
+	if err != nil {
+		return 0, 0, err
+	}
+	maxNumOfRows, err := s.estimateMaxNumOfRows(collectionID)
+	if err != nil {
+		return 0, 0, err
+	}
+	segmentInfo := &datapb.SegmentInfo{
+		ID:            id,
+		CollectionID:  collectionID,
+		PartitionID:   partitionID,
+		InsertChannel: channelName,
+		NumOfRows:     0,
+		State:         commonpb.SegmentState_Growing,
+		MaxRowNum:     int64(maxNumOfRows),
+		ImportTaskID:  importTaskID,
+	}
+	if err := s.meta.AddSegment(segmentInfo); err != nil {
+		return 0, 0, err
+	}
+	log.Debug("datacoord: open import segment",
+		zap.Int64("CollectionID", collectionID),
+		zap.Int64("SegmentID", id),
+		zap.Int64("ImportTaskID", importTaskID),
+		zap.Int("Rows", maxNumOfRows),
+		zap.String("Channel", channelName))
+
+	retCount := requestRows
+	if retCount > int64(maxNumOfRows) {
+		retCount = int64(maxNumOfRows)
+	}
+	return id, retCount, nil
+}
+
 func (s *SegmentManager) alloc(status *segmentStatus, info *datapb.SegmentInfo, numOfRows int64) (bool, error) {
 	var allocSize int64
 	for _, allocItem := range status.allocations {
@@ -17,13 +17,16 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	datanodeclient "github.com/milvus-io/milvus/internal/distributed/datanode/client"
+	indexcoordclient "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
 	rootcoordclient "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/logutil"
 	"go.etcd.io/etcd/clientv3"
 	"go.uber.org/zap"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/types"
@@ -61,6 +64,7 @@ const (
 
 type dataNodeCreatorFunc func(ctx context.Context, addr string) (types.DataNode, error)
 type rootCoordCreatorFunc func(ctx context.Context, metaRootPath string, etcdEndpoints []string) (types.RootCoord, error)
+type indexCoordCreatorFunc func(ctx context.Context, metaRootPath string, etcdEndpoints []string) (types.IndexCoord, error)
 
 // Server implements `types.Datacoord`
 // handles Data Cooridinator related jobs
@@ -76,18 +80,25 @@ type Server struct {
 	segmentManager  Manager
 	allocator       allocator
 	cluster         *Cluster
-	rootCoordClient types.RootCoord
-	ddChannelName   string
+	rootCoordClient  types.RootCoord
+	indexCoordClient types.IndexCoord
+	ddChannelName    string
 
 	flushCh   chan UniqueID
 	msFactory msgstream.Factory
 
+	compactionHandler *compactionPlanHandler
+	compactionTrigger *compactionTrigger
+	garbageCollector  *garbageCollector
+	importManager     *importManager
+
 	session  *sessionutil.Session
 	activeCh <-chan bool
 	eventCh  <-chan *sessionutil.SessionEvent
 
-	dataClientCreator      dataNodeCreatorFunc
-	rootCoordClientCreator rootCoordCreatorFunc
+	dataClientCreator       dataNodeCreatorFunc
+	rootCoordClientCreator  rootCoordCreatorFunc
+	indexCoordClientCreator indexCoordCreatorFunc
 }
 
 // CreateServer create `Server` instance
@@ -96,9 +107,10 @@ func CreateServer(ctx context.Context, factory msgstream.Factory) (*Server, erro
 	s := &Server{
 		ctx:                    ctx,
 		msFactory:              factory,
-		flushCh:                make(chan UniqueID, 1024),
-		dataClientCreator:      defaultDataNodeCreatorFunc,
-		rootCoordClientCreator: defaultRootCoordCreatorFunc,
+		flushCh:                 make(chan UniqueID, 1024),
+		dataClientCreator:       defaultDataNodeCreatorFunc,
+		rootCoordClientCreator:  defaultRootCoordCreatorFunc,
+		indexCoordClientCreator: defaultIndexCoordCreatorFunc,
 	}
 	return s, nil
 }
@@ -111,6 +123,10 @@ func defaultRootCoordCreatorFunc(ctx context.Context, metaRootPath string, etcdE
 	return rootcoordclient.NewClient(ctx, metaRootPath, etcdEndpoints)
 }
 
+func defaultIndexCoordCreatorFunc(ctx context.Context, metaRootPath string, etcdEndpoints []string) (types.IndexCoord, error) {
+	return indexcoordclient.NewClient(ctx, metaRootPath, etcdEndpoints)
+}
+
 // Register register data service at etcd
 func (s *Server) Register() error {
 	s.session = sessionutil.NewSession(s.ctx, Params.MetaRootPath, Params.EtcdEndpoints)
@@ -157,6 +173,12 @@ func (s *Server) Start() error {
 	s.allocator = newRootCoordAllocator(s.ctx, s.rootCoordClient)
 
 	s.startSegmentManager()
+	s.initCompaction()
+	if Params.EnableGarbageCollection {
+		if err = s.initGarbageCollection(); err != nil {
+			return err
+		}
+	}
 	if err = s.initServiceDiscovery(); err != nil {
 		return err
 	}
@@ -174,6 +196,31 @@ func (s *Server) initCluster() error {
 	return err
 }
 
+func (s *Server) initCompaction() {
+	s.compactionHandler = newCompactionPlanHandler(s.meta, s.cluster, s.flushCh)
+	s.compactionTrigger = newCompactionTrigger(s.meta, s.allocator, s.compactionHandler, s.getSegmentFieldBinlogs)
+}
+
+func (s *Server) initGarbageCollection() error {
+	cli, err := miniokv.NewMinIOKV(s.ctx, &miniokv.Option{
+		Address:           Params.MinioAddress,
+		AccessKeyID:       Params.MinioAccessKeyID,
+		SecretAccessKeyID: Params.MinioSecretAccessKey,
+		UseSSL:            Params.MinioUseSSL,
+		CreateBucket:      true,
+		BucketName:        Params.MinioBucketName,
+	})
+	if err != nil {
+		return err
+	}
+	if err = s.initIndexCoordClient(); err != nil {
+		return err
+	}
+	s.garbageCollector = newGarbageCollector(cli, s.meta, s.rootCoordClient, s.indexCoordClient,
+		s.getSegmentFieldBinlogs, s.listSegmentBinlogKeys)
+	return nil
+}
+
 func (s *Server) initServiceDiscovery() error {
 	sessions, rev, err := s.session.GetSessions(typeutil.DataNodeRole)
 	if err != nil {
@@ -183,7 +230,9 @@ func (s *Server) initServiceDiscovery() error {
 	log.Debug("registered sessions", zap.Any("sessions", sessions))
 
 	datanodes := make([]*NodeInfo, 0, len(sessions))
+	nodeIDs := make(map[UniqueID]struct{})
 	for _, session := range sessions {
+		nodeIDs[session.ServerID] = struct{}{}
 		info := &datapb.DataNodeInfo{
 			Address:  session.Address,
 			Version:  session.ServerID,
@@ -194,6 +243,10 @@ func (s *Server) initServiceDiscovery() error {
 	}
 
 	s.cluster.Startup(datanodes)
+	s.failImportTasks(func(task *datapb.ImportTask) bool {
+		_, ok := nodeIDs[task.GetNodeID()]
+		return !ok
+	}, "datanode of the import task is offline")
 
 	s.eventCh = s.session.WatchServices(typeutil.DataNodeRole, rev+1)
 	return nil
@@ -235,6 +288,10 @@ func (s *Server) initMeta() error {
 		if err != nil {
 			return err
 		}
+		s.importManager, err = newImportManager(s.kvClient)
+		if err != nil {
+			return err
+		}
 		return nil
 	}
 	return retry.Do(s.ctx, connectEtcdFn, retry.Attempts(connEtcdMaxRetryTime))
@@ -248,6 +305,14 @@ func (s *Server) startServerLoop() {
 	go s.startWatchService(s.serverLoopCtx)
 	go s.startActiveCheck(s.serverLoopCtx)
 	go s.startFlushLoop(s.serverLoopCtx)
+	if Params.EnableCompaction {
+		s.serverLoopWg.Add(1)
+		go s.startCompactionLoop(s.serverLoopCtx)
+	}
+	if Params.EnableGarbageCollection {
+		s.serverLoopWg.Add(1)
+		go s.startGarbageCollectionLoop(s.serverLoopCtx)
+	}
 }
 
 func (s *Server) startStatsChannel(ctx context.Context) {
@@ -377,6 +442,9 @@ func (s *Server) startWatchService(ctx context.Context) {
 					zap.String("address", info.Address),
 					zap.Int64("serverID", info.Version))
 				s.cluster.UnRegister(node)
+				s.failImportTasks(func(task *datapb.ImportTask) bool {
+					return task.GetNodeID() == info.Version
+				}, fmt.Sprintf("datanode %d of the import task is offline", info.Version))
 			default:
 				log.Warn("receive unknown service event type",
 					zap.Any("type", event.EventType))
@@ -440,6 +508,84 @@ func (s *Server) startFlushLoop(ctx context.Context) {
 				continue
 			}
 			log.Debug("flush segment complete", zap.Int64("id", segmentID))
+			if err = s.handoffSegment(segmentID); err != nil {
+				log.Warn("failed to hand off flushed segment", zap.Int64("segmentID", segmentID), zap.Error(err))
+			}
+		}
+	}
+}
+
+// handoffSegment notifies query coord to replace the growing segment or the segments compacted
+// into segmentID with the flushed segment
+func (s *Server) handoffSegment(segmentID UniqueID) error {
+	segment := s.meta.GetSegment(segmentID)
+	if segment == nil {
+		return fmt.Errorf("segment %d not found", segmentID)
+	}
+	key := buildHandoffSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
+	return s.kvClient.Save(key, proto.MarshalTextString(segment))
+}
+
+// failImportTasks fails the unfinished import tasks matched by filter and drops their segments
+// which are not saved yet
+func (s *Server) failImportTasks(filter func(task *datapb.ImportTask) bool, reason string) {
+	for _, task := range s.importManager.failTasks(filter, reason) {
+		log.Warn("import task failed", zap.Int64("taskID", task.GetTaskID()), zap.String("reason", reason))
+		s.dropImportSegments(task.GetTaskID())
+	}
+}
+
+// dropImportSegments drops the segments allocated to an import task whose binlogs are not saved
+func (s *Server) dropImportSegments(taskID UniqueID) {
+	for _, segment := range s.meta.GetSegmentsOfImportTask(taskID) {
+		if segment.GetState() != commonpb.SegmentState_Growing {
+			continue
+		}
+		if err := s.meta.DropSegment(segment.GetID()); err != nil {
+			log.Warn("failed to drop import segment", zap.Int64("taskID", taskID),
+				zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		}
+	}
+}
+
+func (s *Server) startCompactionLoop(ctx context.Context) {
+	defer logutil.LogPanic()
+	defer s.serverLoopWg.Done()
+	ticker := time.NewTicker(time.Duration(Params.CompactionInterval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("compaction loop shutdown")
+			return
+		case <-ticker.C:
+			ts, err := s.allocator.allocTimestamp()
+			if err != nil {
+				log.Warn("failed to alloc timestamp for compaction", zap.Error(err))
+				continue
+			}
+			s.compactionHandler.expireTimeout(ts)
+			if err := s.compactionTrigger.triggerCompaction(); err != nil {
+				log.Warn("failed to trigger compaction", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (s *Server) startGarbageCollectionLoop(ctx context.Context) {
+	defer logutil.LogPanic()
+	defer s.serverLoopWg.Done()
+	ticker := time.NewTicker(time.Duration(Params.GCInterval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("garbage collection loop shutdown")
+			return
+		case <-ticker.C:
+			if _, err := s.garbageCollector.collect(ctx); err != nil {
+				log.Warn("failed to collect garbage binlogs", zap.Error(err))
+			}
 		}
 	}
 }
@@ -466,6 +612,17 @@ func (s *Server) initRootCoordClient() error {
 	return s.rootCoordClient.Start()
 }
 
+func (s *Server) initIndexCoordClient() error {
+	var err error
+	if s.indexCoordClient, err = s.indexCoordClientCreator(s.ctx, Params.MetaRootPath, Params.EtcdEndpoints); err != nil {
+		return err
+	}
+	if err = s.indexCoordClient.Init(); err != nil {
+		return err
+	}
+	return s.indexCoordClient.Start()
+}
+
 // Stop do the Server finalize processes
 // it checks the server status is healthy, if not, just quit
 // if Server is healthy, set server state to stopped, release etcd session,
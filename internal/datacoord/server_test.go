@@ -447,6 +447,61 @@ func TestSaveBinlogPaths(t *testing.T) {
 		assert.EqualValues(t, segmentInfo.DmlPosition.MsgID, []byte{1, 2, 3})
 		assert.EqualValues(t, segmentInfo.NumOfRows, 10)
 	})
+	t.Run("SaveRequest with deltalogs", func(t *testing.T) {
+		ctx := context.Background()
+		resp, err := svr.SaveBinlogPaths(ctx, &datapb.SaveBinlogPathsRequest{
+			Base: &commonpb.MsgBase{
+				Timestamp: uint64(time.Now().Unix()),
+			},
+			SegmentID:    3,
+			CollectionID: 1,
+			Deltalogs: []*datapb.DeltaLogInfo{
+				{
+					RecordEntries: 2,
+					TimestampFrom: 100,
+					TimestampTo:   200,
+					DeltaLogPath:  "/by-dev/test/delta_log/1/1/3/1",
+					DeltaLogSize:  64,
+				},
+			},
+			Flushed: false,
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, resp.ErrorCode, commonpb.ErrorCode_Success)
+
+		segmentInfo := svr.meta.GetSegment(3)
+		assert.NotNil(t, segmentInfo)
+		if assert.EqualValues(t, 1, len(segmentInfo.Deltalogs)) {
+			assert.EqualValues(t, "/by-dev/test/delta_log/1/1/3/1", segmentInfo.Deltalogs[0].DeltaLogPath)
+			assert.EqualValues(t, 2, segmentInfo.Deltalogs[0].RecordEntries)
+		}
+	})
+	t.Run("SaveRequest with binlog size", func(t *testing.T) {
+		ctx := context.Background()
+		for i := 0; i < 2; i++ {
+			resp, err := svr.SaveBinlogPaths(ctx, &datapb.SaveBinlogPathsRequest{
+				Base: &commonpb.MsgBase{
+					Timestamp: uint64(time.Now().Unix()),
+				},
+				SegmentID:    1,
+				CollectionID: 0,
+				Field2BinlogPaths: []*datapb.ID2PathList{
+					{
+						ID:    1,
+						Paths: []string{"/by-dev/test/0/0/1/1/Allo" + strconv.Itoa(i)},
+					},
+				},
+				BinlogSize: 1024,
+				Flushed:    false,
+			})
+			assert.Nil(t, err)
+			assert.EqualValues(t, resp.ErrorCode, commonpb.ErrorCode_Success)
+		}
+
+		segmentInfo := svr.meta.GetSegment(1)
+		assert.NotNil(t, segmentInfo)
+		assert.EqualValues(t, 2048, segmentInfo.BinlogSize)
+	})
 	t.Run("Abnormal SaveRequest", func(t *testing.T) {
 		ctx := context.Background()
 		resp, err := svr.SaveBinlogPaths(ctx, &datapb.SaveBinlogPathsRequest{
@@ -775,6 +830,9 @@ func newTestServer(t *testing.T, receiveCh chan interface{}) *Server {
 	svr.rootCoordClientCreator = func(ctx context.Context, metaRootPath string, etcdEndpoints []string) (types.RootCoord, error) {
 		return newMockRootCoordService(), nil
 	}
+	svr.indexCoordClientCreator = func(ctx context.Context, metaRootPath string, etcdEndpoints []string) (types.IndexCoord, error) {
+		return &mockIndexCoord{}, nil
+	}
 	assert.Nil(t, err)
 	err = svr.Register()
 	assert.Nil(t, err)
@@ -0,0 +1,412 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/rootcoord"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// compactionTask merges the binlogs of the segments in a compaction plan into the target segment,
+// dropping the entities deleted before the time travel point of the plan.
+type compactionTask struct {
+	kv          kv.BaseKV
+	replica     Replica
+	idAllocator allocatorInterface
+	dataCoord   types.DataCoord
+	plan        *datapb.CompactionPlan
+}
+
+func newCompactionTask(kv kv.BaseKV, replica Replica, idAllocator allocatorInterface,
+	dc types.DataCoord, plan *datapb.CompactionPlan) *compactionTask {
+	return &compactionTask{
+		kv:          kv,
+		replica:     replica,
+		idAllocator: idAllocator,
+		dataCoord:   dc,
+		plan:        plan,
+	}
+}
+
+// compact executes the plan and reports the result segment to DataCoord
+func (t *compactionTask) compact(ctx context.Context) error {
+	if t.plan.GetTimeoutInSeconds() > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(t.plan.GetTimeoutInSeconds())*time.Second)
+		defer cancel()
+	}
+
+	collID, partID := t.plan.GetCollectionID(), t.plan.GetPartitionID()
+	schema, err := t.replica.getCollectionSchema(collID, 0)
+	if err != nil {
+		return err
+	}
+	pkFieldID := getPKFieldID(schema)
+
+	deleted, remainDeletes, err := t.loadDeletes()
+	if err != nil {
+		return err
+	}
+
+	merged, err := t.mergeInsertData(schema, pkFieldID, deleted)
+	if err != nil {
+		return err
+	}
+
+	result := &datapb.CompactionResult{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.NodeID,
+		},
+		PlanID:    t.plan.GetPlanID(),
+		SegmentID: t.plan.GetTargetSegmentID(),
+	}
+	minPK, maxPK := int64(math.MaxInt64), int64(math.MinInt64)
+	kvs := make(map[string]string)
+	if merged != nil {
+		switch pks := merged.Data[pkFieldID].(type) {
+		case *storage.Int64FieldData:
+			result.NumOfRows = int64(pks.NumRows)
+			for _, pk := range pks.Data {
+				if pk < minPK {
+					minPK = pk
+				}
+				if pk > maxPK {
+					maxPK = pk
+				}
+			}
+		case *storage.StringFieldData:
+			result.NumOfRows = int64(pks.NumRows)
+		}
+		collMeta := &etcdpb.CollectionMeta{ID: collID, Schema: schema}
+		result.InsertLogs, result.BinlogSize, err = genInsertBinlogs(t.idAllocator, collMeta, partID, t.plan.GetTargetSegmentID(), merged, kvs)
+		if err != nil {
+			return err
+		}
+	}
+	if remainDeletes.RowCount > 0 {
+		deltaLog, err := t.genDeltaLog(remainDeletes, kvs)
+		if err != nil {
+			return err
+		}
+		result.Deltalogs = append(result.Deltalogs, deltaLog)
+	}
+
+	paths := make([]string, 0, len(kvs))
+	for key := range kvs {
+		paths = append(paths, key)
+	}
+	if err := t.kv.MultiSave(kvs); err != nil {
+		_ = t.kv.MultiRemove(paths)
+		return err
+	}
+
+	status, err := t.dataCoord.CompleteCompaction(ctx, result)
+	if err == nil && status.GetErrorCode() != commonpb.ErrorCode_Success {
+		err = errors.New(status.GetReason())
+	}
+	if err != nil {
+		_ = t.kv.MultiRemove(paths)
+		return err
+	}
+
+	compactedFrom := make([]UniqueID, 0, len(t.plan.GetSegmentBinlogs()))
+	for _, segmentBinlogs := range t.plan.GetSegmentBinlogs() {
+		compactedFrom = append(compactedFrom, segmentBinlogs.GetSegmentID())
+	}
+	return t.replica.mergeFlushedSegments(result.GetSegmentID(), collID, partID, t.plan.GetChannel(),
+		result.GetNumOfRows(), minPK, maxPK, compactedFrom)
+}
+
+// loadDeletes loads the delta logs of the plan. Deletes no later than the time travel point are returned
+// as a map from primary key to the latest delete timestamp, the later ones are kept for the target segment.
+// The keys of the map are int64 or string primary keys.
+func (t *compactionTask) loadDeletes() (map[interface{}]Timestamp, *DeleteData, error) {
+	deleted := make(map[interface{}]Timestamp)
+	remain := &DeleteData{}
+	for _, segmentBinlogs := range t.plan.GetSegmentBinlogs() {
+		for _, deltalog := range segmentBinlogs.GetDeltalogs() {
+			value, err := t.kv.Load(deltalog.GetDeltaLogPath())
+			if err != nil {
+				return nil, nil, err
+			}
+			blob := &Blob{Key: deltalog.GetDeltaLogPath(), Value: []byte(value)}
+			_, _, ddata, err := storage.NewDeleteCodec().Deserialize([]*Blob{blob})
+			if err != nil {
+				return nil, nil, err
+			}
+			for i, pk := range ddata.Pks {
+				ts := ddata.Tss[i]
+				if ts > t.plan.GetTimetravel() {
+					remain.Append(pk, ts)
+					continue
+				}
+				if ts > deleted[pk] {
+					deleted[pk] = ts
+				}
+			}
+			for i, pk := range ddata.StrPks {
+				ts := ddata.Tss[i]
+				if ts > t.plan.GetTimetravel() {
+					remain.AppendString(pk, ts)
+					continue
+				}
+				if ts > deleted[pk] {
+					deleted[pk] = ts
+				}
+			}
+		}
+	}
+	return deleted, remain, nil
+}
+
+// mergeInsertData loads the insert binlogs of the plan and merges the entities not deleted,
+// nil is returned if no entity is left.
+func (t *compactionTask) mergeInsertData(schema *schemapb.CollectionSchema, pkFieldID UniqueID,
+	deleted map[interface{}]Timestamp) (*InsertData, error) {
+	var merged *InsertData
+	for _, segmentBinlogs := range t.plan.GetSegmentBinlogs() {
+		blobs := make([]*Blob, 0)
+		for _, fieldBinlogs := range segmentBinlogs.GetFieldBinlogs() {
+			values, err := t.kv.MultiLoad(fieldBinlogs.GetPaths())
+			if err != nil {
+				return nil, err
+			}
+			for i, value := range values {
+				blobs = append(blobs, &Blob{Key: fieldBinlogs.GetPaths()[i], Value: []byte(value)})
+			}
+		}
+		if len(blobs) == 0 {
+			continue
+		}
+
+		codec := storage.NewInsertCodec(&etcdpb.CollectionMeta{ID: t.plan.GetCollectionID(), Schema: schema})
+		_, _, data, err := codec.Deserialize(blobs)
+		if err != nil {
+			return nil, err
+		}
+		var pks []interface{}
+		switch pkData := data.Data[pkFieldID].(type) {
+		case *storage.Int64FieldData:
+			for _, pk := range pkData.Data {
+				pks = append(pks, pk)
+			}
+		case *storage.StringFieldData:
+			for _, pk := range pkData.Data {
+				pks = append(pks, pk)
+			}
+		default:
+			return nil, fmt.Errorf("primary key field %d not found in segment %d", pkFieldID, segmentBinlogs.GetSegmentID())
+		}
+		tss, ok := data.Data[rootcoord.TimeStampField].(*storage.Int64FieldData)
+		if !ok {
+			return nil, fmt.Errorf("timestamp field not found in segment %d", segmentBinlogs.GetSegmentID())
+		}
+
+		for i, pk := range pks {
+			// a delete hides the entities inserted before it, the entity upserted along with it is kept
+			if ts, ok := deleted[pk]; ok && Timestamp(tss.Data[i]) < ts {
+				continue
+			}
+			if merged == nil {
+				merged = &InsertData{Data: make(map[UniqueID]storage.FieldData)}
+			}
+			for fieldID, field := range data.Data {
+				merged.Data[fieldID] = appendFieldRow(merged.Data[fieldID], field, i)
+			}
+		}
+	}
+	return merged, nil
+}
+
+// genInsertBinlogs serializes the data into insert and stats binlogs of a segment,
+// the binlogs are put into kvs and the insert binlog paths and their total size are returned.
+func genInsertBinlogs(idAllocator allocatorInterface, collMeta *etcdpb.CollectionMeta, partID UniqueID, segID UniqueID,
+	data *InsertData, kvs map[string]string) ([]*datapb.ID2PathList, int64, error) {
+	collID := collMeta.GetID()
+	binLogs, statsBinlogs, err := storage.NewInsertCodec(collMeta).Serialize(partID, segID, data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	insertLogs := make([]*datapb.ID2PathList, 0, len(binLogs))
+	field2Logidx := make(map[UniqueID]UniqueID, len(binLogs))
+	var binlogSize int64
+	for _, blob := range binLogs {
+		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+		if err != nil {
+			return nil, 0, err
+		}
+		logidx, err := idAllocator.allocID()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// no error raise if alloc=false
+		k, _ := idAllocator.genKey(false, collID, partID, segID, fieldID, logidx)
+		key := path.Join(Params.InsertBinlogRootPath, k)
+		kvs[key] = string(blob.Value)
+		field2Logidx[fieldID] = logidx
+		binlogSize += int64(len(blob.Value))
+		insertLogs = append(insertLogs, &datapb.ID2PathList{ID: fieldID, Paths: []string{key}})
+	}
+
+	for _, blob := range statsBinlogs {
+		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// no error raise if alloc=false
+		k, _ := idAllocator.genKey(false, collID, partID, segID, fieldID, field2Logidx[fieldID])
+		key := path.Join(Params.StatsBinlogRootPath, k)
+		kvs[key] = string(blob.Value)
+	}
+	return insertLogs, binlogSize, nil
+}
+
+// genDeltaLog serializes the deletes later than the time travel point into a delta log of the target segment
+func (t *compactionTask) genDeltaLog(ddata *DeleteData, kvs map[string]string) (*datapb.DeltaLogInfo, error) {
+	collID, partID, segID := t.plan.GetCollectionID(), t.plan.GetPartitionID(), t.plan.GetTargetSegmentID()
+	blob, err := storage.NewDeleteCodec().Serialize(collID, partID, segID, ddata)
+	if err != nil {
+		return nil, err
+	}
+	logidx, err := t.idAllocator.allocID()
+	if err != nil {
+		return nil, err
+	}
+
+	// no error raise if alloc=false
+	k, _ := t.idAllocator.genKey(false, collID, partID, segID, logidx)
+	key := path.Join(Params.DeleteBinlogRootPath, k)
+	kvs[key] = string(blob.Value)
+
+	tsFrom, tsTo := ddata.Tss[0], ddata.Tss[0]
+	for _, ts := range ddata.Tss {
+		if ts < tsFrom {
+			tsFrom = ts
+		}
+		if ts > tsTo {
+			tsTo = ts
+		}
+	}
+	return &datapb.DeltaLogInfo{
+		RecordEntries: uint64(ddata.RowCount),
+		TimestampFrom: tsFrom,
+		TimestampTo:   tsTo,
+		DeltaLogPath:  key,
+		DeltaLogSize:  int64(len(blob.Value)),
+	}, nil
+}
+
+// getPKFieldID returns the primary key field of the schema, RowID field if no primary key is specified
+func getPKFieldID(schema *schemapb.CollectionSchema) UniqueID {
+	for _, field := range schema.GetFields() {
+		if field.GetIsPrimaryKey() {
+			return field.GetFieldID()
+		}
+	}
+	return rootcoord.RowIDField
+}
+
+// appendFieldRow appends the idx-th row of src to dst, dst is created with the type of src if it's nil
+func appendFieldRow(dst storage.FieldData, src storage.FieldData, idx int) storage.FieldData {
+	switch field := src.(type) {
+	case *storage.BoolFieldData:
+		if dst == nil {
+			dst = &storage.BoolFieldData{}
+		}
+		d := dst.(*storage.BoolFieldData)
+		d.Data = append(d.Data, field.Data[idx])
+		d.NumRows++
+	case *storage.Int8FieldData:
+		if dst == nil {
+			dst = &storage.Int8FieldData{}
+		}
+		d := dst.(*storage.Int8FieldData)
+		d.Data = append(d.Data, field.Data[idx])
+		d.NumRows++
+	case *storage.Int16FieldData:
+		if dst == nil {
+			dst = &storage.Int16FieldData{}
+		}
+		d := dst.(*storage.Int16FieldData)
+		d.Data = append(d.Data, field.Data[idx])
+		d.NumRows++
+	case *storage.Int32FieldData:
+		if dst == nil {
+			dst = &storage.Int32FieldData{}
+		}
+		d := dst.(*storage.Int32FieldData)
+		d.Data = append(d.Data, field.Data[idx])
+		d.NumRows++
+	case *storage.Int64FieldData:
+		if dst == nil {
+			dst = &storage.Int64FieldData{}
+		}
+		d := dst.(*storage.Int64FieldData)
+		d.Data = append(d.Data, field.Data[idx])
+		d.NumRows++
+	case *storage.FloatFieldData:
+		if dst == nil {
+			dst = &storage.FloatFieldData{}
+		}
+		d := dst.(*storage.FloatFieldData)
+		d.Data = append(d.Data, field.Data[idx])
+		d.NumRows++
+	case *storage.DoubleFieldData:
+		if dst == nil {
+			dst = &storage.DoubleFieldData{}
+		}
+		d := dst.(*storage.DoubleFieldData)
+		d.Data = append(d.Data, field.Data[idx])
+		d.NumRows++
+	case *storage.StringFieldData:
+		if dst == nil {
+			dst = &storage.StringFieldData{}
+		}
+		d := dst.(*storage.StringFieldData)
+		d.Data = append(d.Data, field.Data[idx])
+		d.NumRows++
+	case *storage.BinaryVectorFieldData:
+		if dst == nil {
+			dst = &storage.BinaryVectorFieldData{Dim: field.Dim}
+		}
+		d := dst.(*storage.BinaryVectorFieldData)
+		rowBytes := field.Dim / 8
+		d.Data = append(d.Data, field.Data[idx*rowBytes:(idx+1)*rowBytes]...)
+		d.NumRows++
+	case *storage.FloatVectorFieldData:
+		if dst == nil {
+			dst = &storage.FloatVectorFieldData{Dim: field.Dim}
+		}
+		d := dst.(*storage.FloatVectorFieldData)
+		d.Data = append(d.Data, field.Data[idx*field.Dim:(idx+1)*field.Dim]...)
+		d.NumRows++
+	}
+	return dst
+}
@@ -0,0 +1,163 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+type compactionDataCoord struct {
+	types.DataCoord
+	result *datapb.CompactionResult
+}
+
+func (dc *compactionDataCoord) CompleteCompaction(ctx context.Context, req *datapb.CompactionResult) (*commonpb.Status, error) {
+	dc.result = req
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+// genCompactionInsertData generates insert data of the schema made by MetaFactory
+func genCompactionInsertData(rowIDs []int64, tss []int64) *InsertData {
+	n := len(rowIDs)
+	data := &InsertData{Data: make(map[storage.FieldID]storage.FieldData)}
+	data.Data[0] = &storage.Int64FieldData{NumRows: n, Data: rowIDs}
+	data.Data[1] = &storage.Int64FieldData{NumRows: n, Data: tss}
+	data.Data[100] = &storage.FloatVectorFieldData{NumRows: n, Data: make([]float32, 2*n), Dim: 2}
+	data.Data[101] = &storage.BinaryVectorFieldData{NumRows: n, Data: make([]byte, 4*n), Dim: 32}
+	data.Data[102] = &storage.BoolFieldData{NumRows: n, Data: make([]bool, n)}
+	data.Data[103] = &storage.Int8FieldData{NumRows: n, Data: make([]int8, n)}
+	data.Data[104] = &storage.Int16FieldData{NumRows: n, Data: make([]int16, n)}
+	data.Data[105] = &storage.Int32FieldData{NumRows: n, Data: make([]int32, n)}
+	data.Data[106] = &storage.Int64FieldData{NumRows: n, Data: make([]int64, n)}
+	data.Data[107] = &storage.FloatFieldData{NumRows: n, Data: make([]float32, n)}
+	data.Data[108] = &storage.DoubleFieldData{NumRows: n, Data: make([]float64, n)}
+	for i := 0; i < n; i++ {
+		data.Data[100].(*storage.FloatVectorFieldData).Data[2*i] = float32(rowIDs[i])
+		data.Data[106].(*storage.Int64FieldData).Data[i] = rowIDs[i]
+	}
+	return data
+}
+
+func TestCompactionTask_Compact(t *testing.T) {
+	const collID = UniqueID(1)
+	const partID = UniqueID(2)
+	const channel = "compaction-test-channel"
+
+	kv := memkv.NewMemoryKV()
+	alloc := NewAllocatorFactory()
+	replica := newReplica(&RootCoordFactory{collectionID: collID}, collID)
+	collMeta := (&MetaFactory{}).CollectionMetaFactory(collID, "test_compaction")
+
+	saveSegment := func(segID UniqueID, rowIDs []int64, tss []int64) *datapb.CompactionSegmentBinlogs {
+		cp := &segmentCheckPoint{pos: internalpb.MsgPosition{ChannelName: channel}}
+		require.NoError(t, replica.addNormalSegment(segID, collID, partID, channel, int64(len(rowIDs)), cp))
+		replica.segmentFlushed(segID)
+
+		binlogs, _, err := storage.NewInsertCodec(collMeta).Serialize(partID, segID, genCompactionInsertData(rowIDs, tss))
+		require.NoError(t, err)
+		segmentBinlogs := &datapb.CompactionSegmentBinlogs{SegmentID: segID}
+		for _, blob := range binlogs {
+			fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+			require.NoError(t, err)
+			key := path.Join(Params.InsertBinlogRootPath, strconv.FormatInt(segID, 10), blob.GetKey())
+			require.NoError(t, kv.Save(key, string(blob.Value)))
+			segmentBinlogs.FieldBinlogs = append(segmentBinlogs.FieldBinlogs, &datapb.ID2PathList{ID: fieldID, Paths: []string{key}})
+		}
+		return segmentBinlogs
+	}
+	saveDeltaLog := func(segID UniqueID, pks []int64, tss []Timestamp) *datapb.DeltaLogInfo {
+		ddata := &DeleteData{}
+		for i, pk := range pks {
+			ddata.Append(pk, tss[i])
+		}
+		blob, err := storage.NewDeleteCodec().Serialize(collID, partID, segID, ddata)
+		require.NoError(t, err)
+		key := path.Join(Params.DeleteBinlogRootPath, strconv.FormatInt(segID, 10))
+		require.NoError(t, kv.Save(key, string(blob.Value)))
+		return &datapb.DeltaLogInfo{RecordEntries: uint64(len(pks)), DeltaLogPath: key}
+	}
+
+	seg1 := saveSegment(10, []int64{1, 2, 3}, []int64{10, 10, 10})
+	// 3 is upserted at 10, the delete of the upsert doesn't hide the entity upserted along with it
+	seg1.Deltalogs = []*datapb.DeltaLogInfo{saveDeltaLog(10, []int64{2, 3}, []Timestamp{100, 10})}
+	seg2 := saveSegment(11, []int64{4, 5, 6}, []int64{20, 20, 200})
+	// 5 is deleted after the time travel point, 6 is inserted again after deleted
+	seg2.Deltalogs = []*datapb.DeltaLogInfo{saveDeltaLog(11, []int64{5, 6}, []Timestamp{2000, 100})}
+
+	plan := &datapb.CompactionPlan{
+		PlanID:          1,
+		SegmentBinlogs:  []*datapb.CompactionSegmentBinlogs{seg1, seg2},
+		Type:            datapb.CompactionType_MergeCompaction,
+		Timetravel:      1000,
+		Channel:         channel,
+		CollectionID:    collID,
+		PartitionID:     partID,
+		TargetSegmentID: 20,
+	}
+	dc := &compactionDataCoord{}
+	task := newCompactionTask(kv, replica, alloc, dc, plan)
+	err := task.compact(context.Background())
+	require.NoError(t, err)
+
+	require.NotNil(t, dc.result)
+	assert.EqualValues(t, 20, dc.result.GetSegmentID())
+	assert.EqualValues(t, 5, dc.result.GetNumOfRows())
+	assert.EqualValues(t, len(collMeta.Schema.Fields), len(dc.result.GetInsertLogs()))
+	require.EqualValues(t, 1, len(dc.result.GetDeltalogs()))
+	assert.EqualValues(t, 1, dc.result.GetDeltalogs()[0].GetRecordEntries())
+
+	blobs := make([]*Blob, 0)
+	for _, fieldBinlogs := range dc.result.GetInsertLogs() {
+		value, err := kv.Load(fieldBinlogs.GetPaths()[0])
+		require.NoError(t, err)
+		blobs = append(blobs, &Blob{Key: fieldBinlogs.GetPaths()[0], Value: []byte(value)})
+	}
+	_, segID, merged, err := storage.NewInsertCodec(collMeta).Deserialize(blobs)
+	require.NoError(t, err)
+	assert.EqualValues(t, 20, segID)
+	assert.ElementsMatch(t, []int64{1, 3, 4, 5, 6}, merged.Data[0].(*storage.Int64FieldData).Data)
+	assert.ElementsMatch(t, []float32{1, 0, 3, 0, 4, 0, 5, 0, 6, 0}, merged.Data[100].(*storage.FloatVectorFieldData).Data)
+
+	assert.False(t, replica.hasSegment(10))
+	assert.False(t, replica.hasSegment(11))
+	assert.True(t, replica.isSegmentFlushed(20))
+	assert.EqualValues(t, []UniqueID{20}, replica.filterSegmentsByPK(partID, 5))
+	assert.Empty(t, replica.filterSegmentsByPK(partID, 7))
+}
+
+func TestAppendFieldRow(t *testing.T) {
+	src := genCompactionInsertData([]int64{1, 2}, []int64{10, 20})
+	merged := make(map[storage.FieldID]storage.FieldData)
+	for fieldID, field := range src.Data {
+		merged[fieldID] = appendFieldRow(merged[fieldID], field, 1)
+	}
+	assert.EqualValues(t, []int64{2}, merged[0].(*storage.Int64FieldData).Data)
+	assert.EqualValues(t, []int64{20}, merged[1].(*storage.Int64FieldData).Data)
+	assert.EqualValues(t, 2, merged[100].(*storage.FloatVectorFieldData).Dim)
+	assert.EqualValues(t, []float32{2, 0}, merged[100].(*storage.FloatVectorFieldData).Data)
+	assert.EqualValues(t, 4, len(merged[101].(*storage.BinaryVectorFieldData).Data))
+	assert.EqualValues(t, 1, merged[108].(*storage.DoubleFieldData).NumRows)
+}
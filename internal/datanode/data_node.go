@@ -27,6 +27,7 @@ import (
 
 	"go.uber.org/zap"
 
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
@@ -352,6 +353,96 @@ func (node *DataNode) ReadyToFlush() error {
 	return nil
 }
 
+// Compaction executes a compaction plan asynchronously on the flowgraph watching the channel of the plan,
+// the result segment is reported to DataCoord via CompleteCompaction when the compaction is done.
+func (node *DataNode) Compaction(ctx context.Context, req *datapb.CompactionPlan) (*commonpb.Status, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if err := node.ReadyToFlush(); err != nil {
+		status.Reason = err.Error()
+		return status, nil
+	}
+
+	node.chanMut.RLock()
+	ds, ok := node.vchan2SyncService[req.GetChannel()]
+	node.chanMut.RUnlock()
+	if !ok {
+		status.Reason = fmt.Sprintf("DataNode not watch channel %s", req.GetChannel())
+		return status, nil
+	}
+
+	option := &miniokv.Option{
+		Address:           Params.MinioAddress,
+		AccessKeyID:       Params.MinioAccessKeyID,
+		SecretAccessKeyID: Params.MinioSecretAccessKey,
+		UseSSL:            Params.MinioUseSSL,
+		CreateBucket:      true,
+		BucketName:        Params.MinioBucketName,
+	}
+	minIOKV, err := miniokv.NewMinIOKV(node.ctx, option)
+	if err != nil {
+		status.Reason = err.Error()
+		return status, nil
+	}
+
+	task := newCompactionTask(minIOKV, ds.replica, ds.idAllocator, node.dataCoord, req)
+	go func() {
+		if err := task.compact(node.ctx); err != nil {
+			log.Warn("compaction failed", zap.Int64("planID", req.GetPlanID()), zap.Error(err))
+			return
+		}
+		log.Debug("compaction done", zap.Int64("planID", req.GetPlanID()),
+			zap.Int64("targetSegmentID", req.GetTargetSegmentID()))
+	}()
+
+	status.ErrorCode = commonpb.ErrorCode_Success
+	return status, nil
+}
+
+// Import starts an import task, which writes the rows in the files of the task into segments
+// and reports the progress to DataCoord
+func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTask) (*commonpb.Status, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	if node.State.Load().(internalpb.StateCode) != internalpb.StateCode_Healthy {
+		status.Reason = "DataNode not in HEALTHY state"
+		return status, nil
+	}
+	log.Debug("Receive Import request", zap.Int64("taskID", req.GetTaskID()),
+		zap.Int64("collectionID", req.GetCollectionID()), zap.Strings("files", req.GetFiles()))
+
+	option := &miniokv.Option{
+		Address:           Params.MinioAddress,
+		AccessKeyID:       Params.MinioAccessKeyID,
+		SecretAccessKeyID: Params.MinioSecretAccessKey,
+		UseSSL:            Params.MinioUseSSL,
+		CreateBucket:      true,
+		BucketName:        Params.MinioBucketName,
+	}
+	minIOKV, err := miniokv.NewMinIOKV(node.ctx, option)
+	if err != nil {
+		status.Reason = err.Error()
+		return status, nil
+	}
+
+	getReplica := func(channel string) Replica {
+		node.chanMut.RLock()
+		defer node.chanMut.RUnlock()
+		if ds, ok := node.vchan2SyncService[channel]; ok {
+			return ds.replica
+		}
+		return nil
+	}
+	task := newImportTask(minIOKV, newAllocator(node.rootCoord), node.rootCoord, node.dataCoord, req, getReplica)
+	go task.execute(node.ctx)
+
+	status.ErrorCode = commonpb.ErrorCode_Success
+	return status, nil
+}
+
 // FlushSegments packs flush messages into flowgraph through flushChan.
 //   If DataNode receives a valid segment to flush, new flush message for the segment should be ignored.
 //   So if receiving calls to flush segment A, DataNode should guarantee the segment to be flushed.
@@ -89,8 +89,52 @@ func (dsService *dataSyncService) close() {
 	dsService.cancelFn()
 }
 
+// recoverFlushedSegments adds the flushed segments of the vchannel to the replica, with their partitions and row
+// counts from DataCoord
+func (dsService *dataSyncService) recoverFlushedSegments(vchanInfo *datapb.VchannelInfo) error {
+	if len(vchanInfo.GetFlushedSegments()) == 0 {
+		return nil
+	}
+	rsp, err := dsService.dataCoord.GetSegmentInfo(dsService.ctx, &datapb.GetSegmentInfoRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_SegmentInfo,
+			SourceID: Params.NodeID,
+		},
+		SegmentIDs: vchanInfo.GetFlushedSegments(),
+	})
+	if err != nil {
+		return err
+	}
+	if rsp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("get flushed segments info failed, reason = %s", rsp.GetStatus().GetReason())
+	}
+
+	for _, fs := range rsp.GetInfos() {
+		if fs.CollectionID != dsService.collectionID ||
+			fs.GetInsertChannel() != vchanInfo.ChannelName {
+			log.Warn("Collection ID or ChannelName not compact",
+				zap.Int64("Wanted ID", dsService.collectionID),
+				zap.Int64("Actual ID", fs.CollectionID),
+				zap.String("Wanted Channel Name", vchanInfo.ChannelName),
+				zap.String("Actual Channel Name", fs.GetInsertChannel()),
+			)
+			continue
+		}
+
+		log.Info("Recover flushed segment",
+			zap.String("InsertChannel", fs.GetInsertChannel()),
+			zap.Int64("SegmentID", fs.GetID()),
+			zap.Int64("NumOfRows", fs.GetNumOfRows()),
+		)
+		if err := dsService.replica.addFlushedSegment(fs.GetID(), fs.CollectionID, fs.PartitionID, fs.GetInsertChannel(),
+			fs.GetNumOfRows()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) error {
-	// TODO: add delete pipeline support
 	dsService.fg = flowgraph.NewTimeTickedFlowGraph(dsService.ctx)
 
 	m := map[string]interface{}{
@@ -134,8 +178,10 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 			SegmentID:         fu.segID,
 			CollectionID:      fu.collID,
 			Field2BinlogPaths: id2path,
+			BinlogSize:        fu.binlogSize,
 			CheckPoints:       checkPoints,
 			StartPositions:    fu.startPositions,
+			Deltalogs:         fu.deltaLogs,
 			Flushed:           fu.flushed,
 		}
 		rsp, err := dsService.dataCoord.SaveBinlogPaths(dsService.ctx, req)
@@ -189,6 +235,11 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 			us.GetNumOfRows(), &segmentCheckPoint{us.GetNumOfRows(), *us.GetDmlPosition()})
 	}
 
+	// recover flushed segments, the deletes of their rows are routed to them
+	if err := dsService.recoverFlushedSegments(vchanInfo); err != nil {
+		return err
+	}
+
 	dsService.fg.AddNode(dmStreamNode)
 	dsService.fg.AddNode(ddNode)
 	dsService.fg.AddNode(insertBufferNode)
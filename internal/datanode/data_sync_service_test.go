@@ -138,3 +138,28 @@ func TestDataSyncService_Start(t *testing.T) {
 
 	sync.close()
 }
+
+func TestDataSyncService_recoverFlushedSegments(t *testing.T) {
+	replica := newSegmentReplica(&RootCoordFactory{}, 1)
+	dataCoord := &DataCoordFactory{segmentInfos: map[UniqueID]*datapb.SegmentInfo{
+		1: {ID: 1, CollectionID: 1, PartitionID: 2, InsertChannel: "insert-01", NumOfRows: 10},
+		2: {ID: 2, CollectionID: 1, PartitionID: 2, InsertChannel: "insert-02", NumOfRows: 10},
+	}}
+	dsService := &dataSyncService{
+		ctx:          context.Background(),
+		replica:      replica,
+		collectionID: 1,
+		dataCoord:    dataCoord,
+	}
+
+	err := dsService.recoverFlushedSegments(&datapb.VchannelInfo{
+		CollectionID:    1,
+		ChannelName:     "insert-01",
+		FlushedSegments: []int64{1, 2},
+	})
+	assert.NoError(t, err)
+	assert.True(t, replica.isSegmentFlushed(1))
+	assert.False(t, replica.hasSegment(2))
+	// the deletes of the rows of the flushed segment are routed to it
+	assert.ElementsMatch(t, []UniqueID{1}, replica.filterSegmentsByPK(2, 100))
+}
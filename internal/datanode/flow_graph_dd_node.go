@@ -74,6 +74,7 @@ func (ddn *ddNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 	var iMsg = insertMsg{
 		insertMessages: make([]*msgstream.InsertMsg, 0),
+		deleteMessages: make([]*msgstream.DeleteMsg, 0),
 		timeRange: TimeRange{
 			timestampMin: msMsg.TimestampMin(),
 			timestampMax: msMsg.TimestampMax(),
@@ -101,6 +102,20 @@ func (ddn *ddNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 				}
 			}
 			iMsg.insertMessages = append(iMsg.insertMessages, msg.(*msgstream.InsertMsg))
+		case commonpb.MsgType_Delete:
+			dmsg := msg.(*msgstream.DeleteMsg)
+			if dmsg.GetCollectionID() != ddn.collectionID {
+				continue
+			}
+			log.Debug("DDNode with delete messages")
+			iMsg.deleteMessages = append(iMsg.deleteMessages, dmsg)
+		case commonpb.MsgType_AddField:
+			amsg := msg.(*msgstream.AddFieldMsg)
+			if amsg.GetCollectionID() != ddn.collectionID {
+				continue
+			}
+			log.Info("DDNode with add field messages", zap.Int64("collectionID", ddn.collectionID))
+			iMsg.addFieldMsgs = append(iMsg.addFieldMsgs, amsg)
 		}
 	}
 
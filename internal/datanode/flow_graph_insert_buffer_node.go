@@ -21,12 +21,14 @@ import (
 	"sync"
 	"unsafe"
 
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/kv"
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -46,12 +48,14 @@ const (
 
 type (
 	InsertData = storage.InsertData
+	DeleteData = storage.DeleteData
 	Blob       = storage.Blob
 )
 type insertBufferNode struct {
 	BaseNode
 	channelName  string
 	insertBuffer *insertBuffer
+	deleteBuffer map[UniqueID]*DeleteData // SegmentID to DeleteData
 	replica      Replica
 	idAllocator  allocatorInterface
 	flushMap     sync.Map
@@ -76,8 +80,10 @@ type segmentFlushUnit struct {
 	collID         UniqueID
 	segID          UniqueID
 	field2Path     map[UniqueID]string
+	binlogSize     int64 // size in bytes of the insert binlogs in field2Path
 	checkPoint     map[UniqueID]segmentCheckPoint
 	startPositions []*datapb.SegmentStartPosition
+	deltaLogs      []*datapb.DeltaLogInfo
 	flushed        bool
 }
 
@@ -154,6 +160,12 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		pos.ChannelName = ibNode.channelName
 	}
 
+	if len(iMsg.addFieldMsgs) > 0 {
+		if err := ibNode.saveDdlBinlog(iMsg.addFieldMsgs); err != nil {
+			log.Error("save ddl binlog wrong", zap.Error(err))
+		}
+	}
+
 	// Updating segment statistics
 	uniqueSeg := make(map[UniqueID]int64)
 	for _, msg := range iMsg.insertMessages {
@@ -217,7 +229,14 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			continue
 		}
 
-		// 1.2 Get Fields
+		// 1.2 Fill the fields added after the rows buffered with default values
+		if err := fillDefaultFields(idata, collSchema); err != nil {
+			log.Error("fill default fields wrong", zap.Error(err))
+			continue
+		}
+
+		// 1.3 Get Fields
+		pkFieldID := getPKFieldID(collSchema)
 		var pos int = 0 // Record position of blob
 		var fieldIDs []int64
 		var fieldTypes []schemapb.DataType
@@ -399,6 +418,9 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 				case 0: // rowIDs
 					fieldData.Data = append(fieldData.Data, msg.RowIDs...)
 					fieldData.NumRows += len(msg.RowIDs)
+					if pkFieldID == field.FieldID {
+						ibNode.replica.updateSegmentPKRange(currentSegID, msg.RowIDs)
+					}
 				case 1: // Timestamps
 					for _, ts := range msg.Timestamps {
 						fieldData.Data = append(fieldData.Data, int64(ts))
@@ -406,15 +428,20 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 					fieldData.NumRows += len(msg.Timestamps)
 				default:
 					var v int64
+					values := make([]int64, 0, len(msg.RowData))
 					for _, blob := range msg.RowData {
 						buf := bytes.NewBuffer(blob.GetValue()[pos:])
 						if err := binary.Read(buf, binary.LittleEndian, &v); err != nil {
 							log.Error("binary.Read int64 wrong", zap.Error(err))
 						}
-						fieldData.Data = append(fieldData.Data, v)
+						values = append(values, v)
 					}
+					fieldData.Data = append(fieldData.Data, values...)
 					pos += int(unsafe.Sizeof(*(&v)))
 					fieldData.NumRows += len(msg.RowIDs)
+					if pkFieldID == field.FieldID {
+						ibNode.replica.updateSegmentPKRange(currentSegID, values)
+					}
 				}
 
 			case schemapb.DataType_Float:
@@ -460,6 +487,42 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			}
 		}
 
+		// strings are appended after all the fixed-length fields, each prefixed with the uint32 length in bytes
+		varLenPos := make([]int, len(msg.RowData))
+		for i := range varLenPos {
+			varLenPos[i] = pos
+		}
+		for _, field := range collSchema.Fields {
+			if field.DataType != schemapb.DataType_String {
+				continue
+			}
+			if _, ok := idata.Data[field.FieldID]; !ok {
+				idata.Data[field.FieldID] = &storage.StringFieldData{
+					NumRows: 0,
+					Data:    make([]string, 0),
+				}
+			}
+
+			fieldData := idata.Data[field.FieldID].(*storage.StringFieldData)
+			strs := make([]string, 0, len(msg.RowData))
+			for i, blob := range msg.RowData {
+				var l uint32
+				buf := bytes.NewReader(blob.GetValue()[varLenPos[i]:])
+				if err := binary.Read(buf, binary.LittleEndian, &l); err != nil {
+					log.Error("binary.Read string length wrong", zap.Error(err))
+				}
+				varLenPos[i] += int(unsafe.Sizeof(l))
+				strs = append(strs, string(blob.GetValue()[varLenPos[i]:varLenPos[i]+int(l)]))
+				varLenPos[i] += int(l)
+			}
+			fieldData.Data = append(fieldData.Data, strs...)
+			fieldData.NumRows += len(msg.RowIDs)
+
+			if field.IsPrimaryKey {
+				ibNode.replica.updateSegmentStringPKs(currentSegID, strs)
+			}
+		}
+
 		// 1.3 store in buffer
 		ibNode.insertBuffer.insertData[currentSegID] = idata
 
@@ -467,6 +530,33 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		ibNode.replica.updateSegmentEndPosition(currentSegID, iMsg.endPositions[0])
 	}
 
+	// 2. iMsg.deleteMessages -> delete buffer
+	for _, msg := range iMsg.deleteMessages {
+		ibNode.bufferDeleteMsg(msg)
+	}
+
+	// deletes of flushed segments won't be flushed along with insert data, flush them right now
+	for segID := range ibNode.deleteBuffer {
+		if !ibNode.replica.isSegmentFlushed(segID) {
+			continue
+		}
+		deltaLogs, err := ibNode.flushDeleteData(segID)
+		if err != nil {
+			log.Error("flush delete data of flushed segment failed", zap.Int64("segmentID", segID), zap.Error(err))
+			continue
+		}
+		collID, _, _ := ibNode.getCollectionandPartitionIDbySegID(segID)
+		if err := ibNode.dsSaveBinlog(&segmentFlushUnit{
+			collID:     collID,
+			segID:      segID,
+			field2Path: map[UniqueID]string{},
+			checkPoint: ibNode.replica.listSegmentsCheckPoints(),
+			deltaLogs:  deltaLogs,
+		}); err != nil {
+			log.Error("data service save delta logs failed", zap.Int64("segmentID", segID), zap.Error(err))
+		}
+	}
+
 	if len(iMsg.insertMessages) > 0 {
 		log.Debug("---insert buffer status---")
 		var stopSign int = 0
@@ -517,6 +607,11 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		}
 		fu.checkPoint = ibNode.replica.listSegmentsCheckPoints()
 		fu.flushed = false
+		deltaLogs, err := ibNode.flushDeleteData(fu.segID)
+		if err != nil {
+			log.Error("flush delete data failed", zap.Int64("segmentID", fu.segID), zap.Error(err))
+		}
+		fu.deltaLogs = deltaLogs
 		if err := ibNode.dsSaveBinlog(&fu); err != nil {
 			log.Debug("data service save bin log path failed", zap.Error(err))
 		}
@@ -533,11 +628,16 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 		if ibNode.insertBuffer.size(currentSegID) <= 0 {
 			log.Debug(".. Buffer empty ...")
+			deltaLogs, err := ibNode.flushDeleteData(currentSegID)
+			if err != nil {
+				log.Error("flush delete data failed", zap.Int64("segmentID", currentSegID), zap.Error(err))
+			}
 			ibNode.dsSaveBinlog(&segmentFlushUnit{
 				collID:     fmsg.collectionID,
 				segID:      currentSegID,
 				field2Path: map[UniqueID]string{},
 				checkPoint: ibNode.replica.listSegmentsCheckPoints(),
+				deltaLogs:  deltaLogs,
 				flushed:    true,
 			})
 			ibNode.replica.segmentFlushed(currentSegID)
@@ -579,6 +679,11 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			if fu.field2Path != nil {
 				fu.checkPoint = ibNode.replica.listSegmentsCheckPoints()
 				fu.flushed = true
+				deltaLogs, err := ibNode.flushDeleteData(fu.segID)
+				if err != nil {
+					log.Error("flush delete data failed", zap.Int64("segmentID", fu.segID), zap.Error(err))
+				}
+				fu.deltaLogs = deltaLogs
 				if err := ibNode.dsSaveBinlog(&fu); err != nil {
 					log.Debug("Data service save binlog path failed", zap.Error(err))
 				} else {
@@ -648,6 +753,7 @@ func flushSegment(
 	kvs := make(map[string]string, len(binLogs))
 	paths := make([]string, 0, len(binLogs))
 	field2Logidx := make(map[UniqueID]UniqueID, len(binLogs))
+	var binlogSize int64
 
 	// write insert binlog
 	for _, blob := range binLogs {
@@ -673,6 +779,7 @@ func flushSegment(
 		paths = append(paths, key)
 		kvs[key] = string(blob.Value[:])
 		field2Path[fieldID] = key
+		binlogSize += int64(len(blob.Value))
 		field2Logidx[fieldID] = logidx
 	}
 
@@ -705,10 +812,145 @@ func flushSegment(
 
 	ibNode.replica.updateSegmentCheckPoint(segID)
 	startPos := ibNode.replica.listNewSegmentsStartPositions()
-	flushUnit <- segmentFlushUnit{collID: collID, segID: segID, field2Path: field2Path, startPositions: startPos}
+	flushUnit <- segmentFlushUnit{collID: collID, segID: segID, field2Path: field2Path, binlogSize: binlogSize, startPositions: startPos}
 	clearFn(true)
 }
 
+// fillDefaultFields fills the fields of the schema missing in the buffered rows with their default values,
+// which happens when the fields are added to the collection after the rows are buffered
+func fillDefaultFields(idata *InsertData, schema *schemapb.CollectionSchema) error {
+	tsFieldData, ok := idata.Data[rootcoord.TimeStampField].(*storage.Int64FieldData)
+	if !ok || tsFieldData.NumRows == 0 {
+		return nil
+	}
+	for _, field := range schema.GetFields() {
+		if _, ok := idata.Data[field.GetFieldID()]; ok || field.GetDefaultValue() == nil {
+			continue
+		}
+		fieldData, err := storage.GenDefaultFieldData(field, tsFieldData.NumRows)
+		if err != nil {
+			return err
+		}
+		idata.Data[field.GetFieldID()] = fieldData
+	}
+	return nil
+}
+
+// saveDdlBinlog records the add field DDLs in the DDL binlog of the collection. The binlog of a DDL is
+// named after its timestamp, so the DML channels of the collection write the same binlog.
+func (ibNode *insertBufferNode) saveDdlBinlog(msgs []*msgstream.AddFieldMsg) error {
+	collID := ibNode.replica.getCollectionID()
+	codec := storage.NewDataDefinitionCodec(collID)
+	kvs := make(map[string]string)
+	for _, msg := range msgs {
+		req, err := proto.Marshal(&msg.AddFieldRequest)
+		if err != nil {
+			return err
+		}
+		blobs, err := codec.Serialize([]Timestamp{msg.BeginTs()}, []string{string(req)},
+			[]storage.EventTypeCode{storage.AddFieldEventType})
+		if err != nil {
+			return err
+		}
+		for _, blob := range blobs {
+			key := path.Join(Params.DdlBinlogRootPath, strconv.FormatInt(collID, 10), blob.Key,
+				strconv.FormatUint(msg.BeginTs(), 10))
+			kvs[key] = string(blob.Value)
+		}
+	}
+	log.Debug("save ddl binlog to MinIO/S3", zap.Int64("collectionID", collID), zap.Int("ddl count", len(msgs)))
+	return ibNode.minIOKV.MultiSave(kvs)
+}
+
+// bufferDeleteMsg buffers the deleted primary keys for each segment which may contain them
+func (ibNode *insertBufferNode) bufferDeleteMsg(msg *msgstream.DeleteMsg) {
+	if len(msg.StringPrimaryKeys) > 0 {
+		ibNode.bufferStringPKDeleteMsg(msg)
+		return
+	}
+	if len(msg.PrimaryKeys) != len(msg.Timestamps) {
+		log.Error("misaligned delete messages detected")
+		return
+	}
+	for i, pk := range msg.PrimaryKeys {
+		for _, segID := range ibNode.replica.filterSegmentsByPK(msg.PartitionID, pk) {
+			ddata, ok := ibNode.deleteBuffer[segID]
+			if !ok {
+				ddata = &DeleteData{}
+				ibNode.deleteBuffer[segID] = ddata
+			}
+			ddata.Append(pk, msg.Timestamps[i])
+		}
+	}
+}
+
+// bufferStringPKDeleteMsg buffers the deleted string primary keys for each segment which may contain them
+func (ibNode *insertBufferNode) bufferStringPKDeleteMsg(msg *msgstream.DeleteMsg) {
+	if len(msg.StringPrimaryKeys) != len(msg.Timestamps) {
+		log.Error("misaligned delete messages detected")
+		return
+	}
+	for i, pk := range msg.StringPrimaryKeys {
+		for _, segID := range ibNode.replica.filterSegmentsByStringPK(msg.PartitionID, pk) {
+			ddata, ok := ibNode.deleteBuffer[segID]
+			if !ok {
+				ddata = &DeleteData{}
+				ibNode.deleteBuffer[segID] = ddata
+			}
+			ddata.AppendString(pk, msg.Timestamps[i])
+		}
+	}
+}
+
+// flushDeleteData saves the buffered delete data of a segment to MinIO/S3 as a delta log
+func (ibNode *insertBufferNode) flushDeleteData(segID UniqueID) ([]*datapb.DeltaLogInfo, error) {
+	ddata, ok := ibNode.deleteBuffer[segID]
+	if !ok || ddata.RowCount == 0 {
+		return nil, nil
+	}
+
+	collID, partitionID, err := ibNode.getCollectionandPartitionIDbySegID(segID)
+	if err != nil {
+		return nil, err
+	}
+
+	blob, err := storage.NewDeleteCodec().Serialize(collID, partitionID, segID, ddata)
+	if err != nil {
+		return nil, err
+	}
+
+	logidx, err := ibNode.idAllocator.allocID()
+	if err != nil {
+		return nil, err
+	}
+
+	// no error raise if alloc=false
+	k, _ := ibNode.idAllocator.genKey(false, collID, partitionID, segID, logidx)
+	key := path.Join(Params.DeleteBinlogRootPath, k)
+	if err := ibNode.minIOKV.Save(key, string(blob.Value)); err != nil {
+		return nil, err
+	}
+	delete(ibNode.deleteBuffer, segID)
+
+	tsFrom, tsTo := ddata.Tss[0], ddata.Tss[0]
+	for _, ts := range ddata.Tss {
+		if ts < tsFrom {
+			tsFrom = ts
+		}
+		if ts > tsTo {
+			tsTo = ts
+		}
+	}
+	log.Debug("save delta log to MinIO/S3", zap.Int64("segmentID", segID), zap.String("path", key))
+	return []*datapb.DeltaLogInfo{{
+		RecordEntries: uint64(ddata.RowCount),
+		TimestampFrom: tsFrom,
+		TimestampTo:   tsTo,
+		DeltaLogPath:  key,
+		DeltaLogSize:  int64(len(blob.Value)),
+	}}, nil
+}
+
 func (ibNode *insertBufferNode) writeHardTimeTick(ts Timestamp) error {
 	msgPack := msgstream.MsgPack{}
 	timeTickMsg := msgstream.DataNodeTtMsg{
@@ -849,6 +1091,7 @@ func newInsertBufferNode(
 	return &insertBufferNode{
 		BaseNode:     baseNode,
 		insertBuffer: iBuffer,
+		deleteBuffer: make(map[UniqueID]*DeleteData),
 		minIOKV:      minIOKV,
 		channelName:  channelName,
 
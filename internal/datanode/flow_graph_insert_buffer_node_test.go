@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 )
@@ -484,3 +485,24 @@ func TestFlowGraphInsertBufferNode_AutoFlush(t *testing.T) {
 
 	})
 }
+
+func TestFillDefaultFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: rootcoord.TimeStampField, Name: rootcoord.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int16,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 18}}},
+		},
+	}
+
+	// nothing to fill before any row is buffered
+	idata := &InsertData{Data: make(map[UniqueID]storage.FieldData)}
+	assert.NoError(t, fillDefaultFields(idata, schema))
+	assert.Equal(t, 0, len(idata.Data))
+
+	idata.Data[rootcoord.TimeStampField] = &storage.Int64FieldData{NumRows: 2, Data: []int64{1, 2}}
+	idata.Data[100] = &storage.Int64FieldData{NumRows: 2, Data: []int64{10, 20}}
+	assert.NoError(t, fillDefaultFields(idata, schema))
+	assert.Equal(t, &storage.Int16FieldData{NumRows: 2, Data: []int16{18, 18}}, idata.Data[101])
+}
@@ -0,0 +1,678 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datanode
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/rootcoord"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// Files to import are either row-based JSON files, each of which holds rows as
+// {"rows": [{"field1": value, "field2": value, ...}, ...]}, or column-based NumPy or Parquet files,
+// each of which holds a field and is named after the field, e.g. "path/to/age.npy". A Parquet file
+// holds a single column, either in the format of the binlog payloads written by storage.PayloadWriter,
+// or as written by the other Parquet writers, e.g. pyarrow, in which the vectors are lists of float or
+// uint8 of the dim of the field. Null values and the types not matching the field, e.g. int64 for an
+// int32 field or large_string, are rejected. JSON and NumPy files are read as streams, while a Parquet
+// file is read into memory whole since its footer is read first.
+const (
+	jsonFileExt    = ".json"
+	numpyFileExt   = ".npy"
+	parquetFileExt = ".parquet"
+)
+
+// getFieldDim returns the dim of a vector field
+func getFieldDim(field *schemapb.FieldSchema) (int, error) {
+	for _, t := range field.GetTypeParams() {
+		if t.Key == "dim" {
+			dim, err := strconv.Atoi(t.Value)
+			if err != nil {
+				return 0, fmt.Errorf("invalid dim of field %s: %w", field.GetName(), err)
+			}
+			if dim <= 0 {
+				return 0, fmt.Errorf("invalid dim %d of field %s", dim, field.GetName())
+			}
+			return dim, nil
+		}
+	}
+	return 0, fmt.Errorf("dim of field %s not found", field.GetName())
+}
+
+// getImportFields returns the fields expected in the files to import, which are the user fields
+// except an auto ID primary key
+func getImportFields(schema *schemapb.CollectionSchema) []*schemapb.FieldSchema {
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() < rootcoord.StartOfUserFieldID || (field.GetIsPrimaryKey() && field.GetAutoID()) {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// newImportFieldData returns an empty field data of the type of field
+func newImportFieldData(field *schemapb.FieldSchema) (storage.FieldData, error) {
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return &storage.BoolFieldData{}, nil
+	case schemapb.DataType_Int8:
+		return &storage.Int8FieldData{}, nil
+	case schemapb.DataType_Int16:
+		return &storage.Int16FieldData{}, nil
+	case schemapb.DataType_Int32:
+		return &storage.Int32FieldData{}, nil
+	case schemapb.DataType_Int64:
+		return &storage.Int64FieldData{}, nil
+	case schemapb.DataType_Float:
+		return &storage.FloatFieldData{}, nil
+	case schemapb.DataType_Double:
+		return &storage.DoubleFieldData{}, nil
+	case schemapb.DataType_String:
+		return &storage.StringFieldData{}, nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := getFieldDim(field)
+		if err != nil {
+			return nil, err
+		}
+		if dim%8 != 0 {
+			return nil, fmt.Errorf("dim %d of binary vector field %s is not a multiple of 8", dim, field.GetName())
+		}
+		return &storage.BinaryVectorFieldData{Dim: dim}, nil
+	case schemapb.DataType_FloatVector:
+		dim, err := getFieldDim(field)
+		if err != nil {
+			return nil, err
+		}
+		return &storage.FloatVectorFieldData{Dim: dim}, nil
+	}
+	return nil, fmt.Errorf("data type %s of field %s is not supported by import", field.GetDataType().String(), field.GetName())
+}
+
+// getFieldDataRows returns the number of rows in a field data
+func getFieldDataRows(data storage.FieldData) int {
+	switch fd := data.(type) {
+	case *storage.BoolFieldData:
+		return fd.NumRows
+	case *storage.Int8FieldData:
+		return fd.NumRows
+	case *storage.Int16FieldData:
+		return fd.NumRows
+	case *storage.Int32FieldData:
+		return fd.NumRows
+	case *storage.Int64FieldData:
+		return fd.NumRows
+	case *storage.FloatFieldData:
+		return fd.NumRows
+	case *storage.DoubleFieldData:
+		return fd.NumRows
+	case *storage.StringFieldData:
+		return fd.NumRows
+	case *storage.BinaryVectorFieldData:
+		return fd.NumRows
+	case *storage.FloatVectorFieldData:
+		return fd.NumRows
+	}
+	return 0
+}
+
+// parseJSONRows parses a JSON file of rows and appends the rows to the field data of fields,
+// the number of rows parsed is returned. The rows are decoded one by one from the stream.
+func parseJSONRows(r io.Reader, fields []*schemapb.FieldSchema, data map[UniqueID]storage.FieldData) (int, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	if err := expectJSONDelim(decoder, '{'); err != nil {
+		return 0, err
+	}
+
+	names := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		names[field.GetName()] = struct{}{}
+	}
+	rows := 0
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return 0, fmt.Errorf("invalid JSON file: %w", err)
+		}
+		if key != "rows" {
+			// the other keys are ignored
+			var ignored json.RawMessage
+			if err := decoder.Decode(&ignored); err != nil {
+				return 0, fmt.Errorf("invalid JSON file: %w", err)
+			}
+			continue
+		}
+		if err := expectJSONDelim(decoder, '['); err != nil {
+			return 0, err
+		}
+		for decoder.More() {
+			var row map[string]interface{}
+			if err := decoder.Decode(&row); err != nil {
+				return 0, fmt.Errorf("invalid JSON file: %w", err)
+			}
+			if err := appendJSONRow(row, fields, names, data); err != nil {
+				return 0, fmt.Errorf("row %d: %w", rows, err)
+			}
+			rows++
+		}
+		if err := expectJSONDelim(decoder, ']'); err != nil {
+			return 0, err
+		}
+	}
+	if err := expectJSONDelim(decoder, '}'); err != nil {
+		return 0, err
+	}
+	return rows, nil
+}
+
+// expectJSONDelim reads the next token of the decoder, which should be delim
+func expectJSONDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("invalid JSON file: %w", err)
+	}
+	if token != delim {
+		return fmt.Errorf("invalid JSON file: %v found where %v is expected", token, delim)
+	}
+	return nil
+}
+
+// appendJSONRow appends a row decoded from JSON to the field data of fields, names are the names of fields
+func appendJSONRow(row map[string]interface{}, fields []*schemapb.FieldSchema, names map[string]struct{},
+	data map[UniqueID]storage.FieldData) error {
+	for name := range row {
+		if _, ok := names[name]; !ok {
+			return fmt.Errorf("field %s is not in the schema or is auto ID", name)
+		}
+	}
+	for _, field := range fields {
+		value, ok := row[field.GetName()]
+		if !ok {
+			return fmt.Errorf("field %s is missing", field.GetName())
+		}
+		if err := appendJSONValue(data[field.GetFieldID()], value); err != nil {
+			return fmt.Errorf("field %s: %w", field.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func parseJSONInt(value interface{}, bits int) (int64, error) {
+	num, ok := value.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("%v is not an integer", value)
+	}
+	v, err := strconv.ParseInt(string(num), 10, bits)
+	if err != nil {
+		return 0, fmt.Errorf("%v is not an int%d", value, bits)
+	}
+	return v, nil
+}
+
+func parseJSONFloat(value interface{}, bits int) (float64, error) {
+	num, ok := value.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("%v is not a number", value)
+	}
+	v, err := strconv.ParseFloat(string(num), bits)
+	if err != nil {
+		return 0, fmt.Errorf("%v is not a float%d", value, bits)
+	}
+	return v, nil
+}
+
+func parseJSONArray(value interface{}, length int) ([]interface{}, error) {
+	arr, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%v is not an array", value)
+	}
+	if len(arr) != length {
+		return nil, fmt.Errorf("array length %d doesn't match %d", len(arr), length)
+	}
+	return arr, nil
+}
+
+// appendJSONValue appends a value decoded from JSON to the field data
+func appendJSONValue(data storage.FieldData, value interface{}) error {
+	switch fd := data.(type) {
+	case *storage.BoolFieldData:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("%v is not a bool", value)
+		}
+		fd.Data = append(fd.Data, v)
+		fd.NumRows++
+	case *storage.Int8FieldData:
+		v, err := parseJSONInt(value, 8)
+		if err != nil {
+			return err
+		}
+		fd.Data = append(fd.Data, int8(v))
+		fd.NumRows++
+	case *storage.Int16FieldData:
+		v, err := parseJSONInt(value, 16)
+		if err != nil {
+			return err
+		}
+		fd.Data = append(fd.Data, int16(v))
+		fd.NumRows++
+	case *storage.Int32FieldData:
+		v, err := parseJSONInt(value, 32)
+		if err != nil {
+			return err
+		}
+		fd.Data = append(fd.Data, int32(v))
+		fd.NumRows++
+	case *storage.Int64FieldData:
+		v, err := parseJSONInt(value, 64)
+		if err != nil {
+			return err
+		}
+		fd.Data = append(fd.Data, v)
+		fd.NumRows++
+	case *storage.FloatFieldData:
+		v, err := parseJSONFloat(value, 32)
+		if err != nil {
+			return err
+		}
+		fd.Data = append(fd.Data, float32(v))
+		fd.NumRows++
+	case *storage.DoubleFieldData:
+		v, err := parseJSONFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		fd.Data = append(fd.Data, v)
+		fd.NumRows++
+	case *storage.StringFieldData:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%v is not a string", value)
+		}
+		fd.Data = append(fd.Data, v)
+		fd.NumRows++
+	case *storage.BinaryVectorFieldData:
+		arr, err := parseJSONArray(value, fd.Dim/8)
+		if err != nil {
+			return err
+		}
+		for _, elem := range arr {
+			v, err := parseJSONInt(elem, 16)
+			if err != nil || v < 0 || v > math.MaxUint8 {
+				return fmt.Errorf("%v is not a byte of binary vector", elem)
+			}
+			fd.Data = append(fd.Data, byte(v))
+		}
+		fd.NumRows++
+	case *storage.FloatVectorFieldData:
+		arr, err := parseJSONArray(value, fd.Dim)
+		if err != nil {
+			return err
+		}
+		for _, elem := range arr {
+			v, err := parseJSONFloat(elem, 32)
+			if err != nil {
+				return err
+			}
+			fd.Data = append(fd.Data, float32(v))
+		}
+		fd.NumRows++
+	default:
+		return errors.New("unsupported field type")
+	}
+	return nil
+}
+
+// numpyArray is an array in the NumPy .npy format, data is in C order and is read from the stream
+// following the header
+type numpyArray struct {
+	descr string
+	shape []int
+	data  io.Reader
+}
+
+var (
+	numpyMagic        = []byte("\x93NUMPY")
+	numpyDescrRegexp  = regexp.MustCompile(`'descr':\s*'([^']*)'`)
+	numpyFortranRegex = regexp.MustCompile(`'fortran_order':\s*(True|False)`)
+	numpyShapeRegexp  = regexp.MustCompile(`'shape':\s*\(([^)]*)\)`)
+)
+
+// parseNumpy parses the header of a .npy file, see https://numpy.org/doc/stable/reference/generated/numpy.lib.format.html
+func parseNumpy(r io.Reader) (*numpyArray, error) {
+	prefix := make([]byte, len(numpyMagic)+2)
+	if _, err := io.ReadFull(r, prefix); err != nil || !bytes.Equal(prefix[:len(numpyMagic)], numpyMagic) {
+		return nil, errors.New("invalid NumPy file, magic string not found")
+	}
+	major := prefix[len(numpyMagic)]
+	var headerLen int
+	switch major {
+	case 1:
+		var n uint16
+		if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+			return nil, errors.New("invalid NumPy file, header truncated")
+		}
+		headerLen = int(n)
+	case 2, 3:
+		var n uint32
+		if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+			return nil, errors.New("invalid NumPy file, header truncated")
+		}
+		headerLen = int(n)
+	default:
+		return nil, fmt.Errorf("unsupported NumPy file version %d", major)
+	}
+	headerBytes := make([]byte, headerLen)
+	if _, err := io.ReadFull(r, headerBytes); err != nil {
+		return nil, errors.New("invalid NumPy file, header truncated")
+	}
+	header := string(headerBytes)
+
+	arr := &numpyArray{data: r}
+	match := numpyDescrRegexp.FindStringSubmatch(header)
+	if match == nil {
+		return nil, errors.New("invalid NumPy header, descr not found")
+	}
+	arr.descr = match[1]
+	match = numpyFortranRegex.FindStringSubmatch(header)
+	if match == nil {
+		return nil, errors.New("invalid NumPy header, fortran_order not found")
+	}
+	if match[1] == "True" {
+		return nil, errors.New("NumPy array in fortran order is not supported")
+	}
+	match = numpyShapeRegexp.FindStringSubmatch(header)
+	if match == nil {
+		return nil, errors.New("invalid NumPy header, shape not found")
+	}
+	for _, s := range strings.Split(match[1], ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		dim, err := strconv.Atoi(s)
+		if err != nil || dim < 0 {
+			return nil, fmt.Errorf("invalid NumPy shape (%s)", match[1])
+		}
+		arr.shape = append(arr.shape, dim)
+	}
+	if len(arr.shape) == 0 {
+		return nil, errors.New("NumPy scalar is not supported, should be an array")
+	}
+	return arr, nil
+}
+
+// normalizeNumpyDescr strips the little endian ('<'), native ('=') and not applicable ('|') byte orders
+// from a NumPy type, the native byte order is little endian on the platforms supported
+func normalizeNumpyDescr(descr string) string {
+	return strings.TrimLeft(descr, "<=|")
+}
+
+// checkNumpyType checks the element type and the shape of the array, which has rows of
+// elemsPerRow elements, or a scalar per row if elemsPerRow is 0. The number of rows is returned.
+func (arr *numpyArray) checkNumpyType(descr string, elemsPerRow int) (int, error) {
+	if normalizeNumpyDescr(arr.descr) != normalizeNumpyDescr(descr) {
+		return 0, fmt.Errorf("NumPy type %s doesn't match %s", arr.descr, descr)
+	}
+	rows := arr.shape[0]
+	if elemsPerRow == 0 {
+		if len(arr.shape) != 1 {
+			return 0, fmt.Errorf("NumPy shape %v should be one-dimensional", arr.shape)
+		}
+	} else if len(arr.shape) != 2 || arr.shape[1] != elemsPerRow {
+		return 0, fmt.Errorf("NumPy shape %v doesn't match (%d, %d)", arr.shape, rows, elemsPerRow)
+	}
+	return rows, nil
+}
+
+// readData reads the data of the array into v, which holds as many elements as the shape, and checks
+// that no data follows
+func (arr *numpyArray) readData(v interface{}) error {
+	if err := binary.Read(arr.data, binary.LittleEndian, v); err != nil {
+		return fmt.Errorf("NumPy data doesn't match shape %v: %w", arr.shape, err)
+	}
+	return arr.checkDataEnd()
+}
+
+// checkDataEnd checks that all the data of the array is read
+func (arr *numpyArray) checkDataEnd() error {
+	_, err := io.ReadFull(arr.data, make([]byte, 1))
+	if err == nil {
+		return fmt.Errorf("NumPy data is longer than shape %v", arr.shape)
+	}
+	if err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+// numpyToFieldData converts the array in a NumPy file to the field data of field
+func numpyToFieldData(field *schemapb.FieldSchema, arr *numpyArray) (storage.FieldData, error) {
+	data, err := newImportFieldData(field)
+	if err != nil {
+		return nil, err
+	}
+	switch fd := data.(type) {
+	case *storage.BoolFieldData:
+		if fd.NumRows, err = arr.checkNumpyType("|b1", 0); err != nil {
+			return nil, err
+		}
+		fd.Data = make([]bool, fd.NumRows)
+		err = arr.readData(fd.Data)
+	case *storage.Int8FieldData:
+		if fd.NumRows, err = arr.checkNumpyType("|i1", 0); err != nil {
+			return nil, err
+		}
+		fd.Data = make([]int8, fd.NumRows)
+		err = arr.readData(fd.Data)
+	case *storage.Int16FieldData:
+		if fd.NumRows, err = arr.checkNumpyType("<i2", 0); err != nil {
+			return nil, err
+		}
+		fd.Data = make([]int16, fd.NumRows)
+		err = arr.readData(fd.Data)
+	case *storage.Int32FieldData:
+		if fd.NumRows, err = arr.checkNumpyType("<i4", 0); err != nil {
+			return nil, err
+		}
+		fd.Data = make([]int32, fd.NumRows)
+		err = arr.readData(fd.Data)
+	case *storage.Int64FieldData:
+		if fd.NumRows, err = arr.checkNumpyType("<i8", 0); err != nil {
+			return nil, err
+		}
+		fd.Data = make([]int64, fd.NumRows)
+		err = arr.readData(fd.Data)
+	case *storage.FloatFieldData:
+		if fd.NumRows, err = arr.checkNumpyType("<f4", 0); err != nil {
+			return nil, err
+		}
+		fd.Data = make([]float32, fd.NumRows)
+		err = arr.readData(fd.Data)
+	case *storage.DoubleFieldData:
+		if fd.NumRows, err = arr.checkNumpyType("<f8", 0); err != nil {
+			return nil, err
+		}
+		fd.Data = make([]float64, fd.NumRows)
+		err = arr.readData(fd.Data)
+	case *storage.StringFieldData:
+		// strings are fixed-length UTF-32 in NumPy, padded with zeros
+		descr := normalizeNumpyDescr(arr.descr)
+		if !strings.HasPrefix(descr, "U") {
+			return nil, fmt.Errorf("NumPy type %s doesn't match <U", arr.descr)
+		}
+		maxLen, err := strconv.Atoi(descr[1:])
+		if err != nil || maxLen <= 0 {
+			return nil, fmt.Errorf("invalid NumPy type %s", arr.descr)
+		}
+		if fd.NumRows, err = arr.checkNumpyType(arr.descr, 0); err != nil {
+			return nil, err
+		}
+		fd.Data = make([]string, 0, fd.NumRows)
+		row := make([]byte, 4*maxLen)
+		for i := 0; i < fd.NumRows; i++ {
+			if _, err := io.ReadFull(arr.data, row); err != nil {
+				return nil, fmt.Errorf("NumPy data doesn't match shape %v: %w", arr.shape, err)
+			}
+			var sb strings.Builder
+			for j := 0; j < maxLen; j++ {
+				r := rune(binary.LittleEndian.Uint32(row[j*4:]))
+				if r == 0 {
+					break
+				}
+				if !utf8.ValidRune(r) {
+					return nil, fmt.Errorf("invalid unicode %d in row %d", r, i)
+				}
+				sb.WriteRune(r)
+			}
+			fd.Data = append(fd.Data, sb.String())
+		}
+		if err := arr.checkDataEnd(); err != nil {
+			return nil, err
+		}
+	case *storage.BinaryVectorFieldData:
+		if fd.NumRows, err = arr.checkNumpyType("|u1", fd.Dim/8); err != nil {
+			return nil, err
+		}
+		fd.Data = make([]byte, fd.NumRows*fd.Dim/8)
+		err = arr.readData(fd.Data)
+	case *storage.FloatVectorFieldData:
+		if fd.NumRows, err = arr.checkNumpyType("<f4", fd.Dim); err != nil {
+			return nil, err
+		}
+		fd.Data = make([]float32, fd.NumRows*fd.Dim)
+		err = arr.readData(fd.Data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// parquetToFieldData converts the column in a Parquet file to the field data of field, the column is
+// read by the payload reader of the binlogs and copied out before the reader is closed
+func parquetToFieldData(field *schemapb.FieldSchema, content []byte) (storage.FieldData, error) {
+	data, err := newImportFieldData(field)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := storage.NewPayloadReader(field.GetDataType(), content)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	rows, err := reader.GetPayloadLengthFromReader()
+	if err != nil {
+		return nil, err
+	}
+
+	var dim int
+	switch fd := data.(type) {
+	case *storage.BoolFieldData:
+		var v []bool
+		v, err = reader.GetBoolFromPayload()
+		fd.NumRows, fd.Data = rows, append([]bool{}, v...)
+	case *storage.Int8FieldData:
+		var v []int8
+		v, err = reader.GetInt8FromPayload()
+		fd.NumRows, fd.Data = rows, append([]int8{}, v...)
+	case *storage.Int16FieldData:
+		var v []int16
+		v, err = reader.GetInt16FromPayload()
+		fd.NumRows, fd.Data = rows, append([]int16{}, v...)
+	case *storage.Int32FieldData:
+		var v []int32
+		v, err = reader.GetInt32FromPayload()
+		fd.NumRows, fd.Data = rows, append([]int32{}, v...)
+	case *storage.Int64FieldData:
+		var v []int64
+		v, err = reader.GetInt64FromPayload()
+		fd.NumRows, fd.Data = rows, append([]int64{}, v...)
+	case *storage.FloatFieldData:
+		var v []float32
+		v, err = reader.GetFloatFromPayload()
+		fd.NumRows, fd.Data = rows, append([]float32{}, v...)
+	case *storage.DoubleFieldData:
+		var v []float64
+		v, err = reader.GetDoubleFromPayload()
+		fd.NumRows, fd.Data = rows, append([]float64{}, v...)
+	case *storage.StringFieldData:
+		fd.NumRows = rows
+		fd.Data = make([]string, 0, rows)
+		for i := 0; i < rows; i++ {
+			v, err := reader.GetOneStringFromPayload(i)
+			if err != nil {
+				return nil, err
+			}
+			fd.Data = append(fd.Data, v)
+		}
+	case *storage.BinaryVectorFieldData:
+		var v []byte
+		v, dim, err = reader.GetBinaryVectorFromPayload()
+		fd.NumRows, fd.Data = rows, append([]byte{}, v...)
+		if err == nil && dim != fd.Dim {
+			err = fmt.Errorf("dim %d of Parquet column doesn't match dim %d of field %s", dim, fd.Dim, field.GetName())
+		}
+	case *storage.FloatVectorFieldData:
+		var v []float32
+		v, dim, err = reader.GetFloatVectorFromPayload()
+		fd.NumRows, fd.Data = rows, append([]float32{}, v...)
+		if err == nil && dim != fd.Dim {
+			err = fmt.Errorf("dim %d of Parquet column doesn't match dim %d of field %s", dim, fd.Dim, field.GetName())
+		}
+	default:
+		err = errors.New("unsupported field type")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// loadColumnFile converts the NumPy or Parquet file read from r to the field data of field
+func loadColumnFile(field *schemapb.FieldSchema, file string, r io.Reader) (storage.FieldData, error) {
+	if strings.ToLower(path.Ext(file)) == parquetFileExt {
+		// the Parquet reader seeks to the footer first, so the file is read whole
+		content, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		return parquetToFieldData(field, content)
+	}
+	arr, err := parseNumpy(r)
+	if err != nil {
+		return nil, err
+	}
+	return numpyToFieldData(field, arr)
+}
+
+// getColumnFieldName returns the name of the field in a NumPy or Parquet file, which is the file name
+// without extension
+func getColumnFieldName(file string) string {
+	return strings.TrimSuffix(path.Base(file), path.Ext(file))
+}
@@ -0,0 +1,208 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datanode
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func newImportTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "import",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 0, Name: "RowID", DataType: schemapb.DataType_Int64},
+			{FieldID: 1, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, AutoID: true},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32},
+			{FieldID: 102, Name: "name", DataType: schemapb.DataType_String},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+}
+
+func newImportTestData(t *testing.T, fields []*schemapb.FieldSchema) map[UniqueID]storage.FieldData {
+	data := make(map[UniqueID]storage.FieldData)
+	for _, field := range fields {
+		fd, err := newImportFieldData(field)
+		require.NoError(t, err)
+		data[field.GetFieldID()] = fd
+	}
+	return data
+}
+
+// newNumpyFile returns the content of a version 1 .npy file
+func newNumpyFile(descr string, shape string, data interface{}) []byte {
+	header := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%s), }", descr, shape)
+	buf := &bytes.Buffer{}
+	buf.Write(numpyMagic)
+	buf.Write([]byte{1, 0})
+	_ = binary.Write(buf, binary.LittleEndian, uint16(len(header)))
+	buf.WriteString(header)
+	_ = binary.Write(buf, binary.LittleEndian, data)
+	return buf.Bytes()
+}
+
+func TestImportParser_getImportFields(t *testing.T) {
+	fields := getImportFields(newImportTestSchema())
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, field.GetName())
+	}
+	assert.Equal(t, []string{"age", "name", "vec"}, names)
+}
+
+func TestImportParser_parseJSONRows(t *testing.T) {
+	fields := getImportFields(newImportTestSchema())
+	data := newImportTestData(t, fields)
+	content := []byte(`{"version": {"major": 1}, "rows": [
+		{"age": 10, "name": "a", "vec": [1.0, 2.0]},
+		{"age": 20, "name": "b", "vec": [3, 4.5]}
+	]}`)
+	rows, err := parseJSONRows(bytes.NewReader(content), fields, data)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, []int32{10, 20}, data[101].(*storage.Int32FieldData).Data)
+	assert.Equal(t, []string{"a", "b"}, data[102].(*storage.StringFieldData).Data)
+	assert.Equal(t, []float32{1, 2, 3, 4.5}, data[103].(*storage.FloatVectorFieldData).Data)
+	assert.Equal(t, 2, getFieldDataRows(data[103]))
+
+	errCases := map[string]string{
+		"invalid json":  `{"rows": [`,
+		"not an object": `[{"age": 10, "name": "a", "vec": [1, 2]}]`,
+		"not an array":  `{"rows": {"age": 10, "name": "a", "vec": [1, 2]}}`,
+		"missing field": `{"rows": [{"age": 10, "vec": [1, 2]}]}`,
+		"unknown field": `{"rows": [{"age": 10, "name": "a", "vec": [1, 2], "other": 1}]}`,
+		"auto id":       `{"rows": [{"pk": 1, "age": 10, "name": "a", "vec": [1, 2]}]}`,
+		"wrong type":    `{"rows": [{"age": "10", "name": "a", "vec": [1, 2]}]}`,
+		"int overflow":  `{"rows": [{"age": 10000000000, "name": "a", "vec": [1, 2]}]}`,
+		"float as int":  `{"rows": [{"age": 1.5, "name": "a", "vec": [1, 2]}]}`,
+		"wrong dim":     `{"rows": [{"age": 10, "name": "a", "vec": [1, 2, 3]}]}`,
+	}
+	for name, content := range errCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseJSONRows(strings.NewReader(content), fields, newImportTestData(t, fields))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestImportParser_parseJSONBinaryVector(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "bin", DataType: schemapb.DataType_BinaryVector,
+			TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "16"}}},
+	}
+	data := newImportTestData(t, fields)
+	rows, err := parseJSONRows(strings.NewReader(`{"rows": [{"bin": [1, 255]}]}`), fields, data)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, rows)
+	assert.Equal(t, []byte{1, 255}, data[100].(*storage.BinaryVectorFieldData).Data)
+
+	_, err = parseJSONRows(strings.NewReader(`{"rows": [{"bin": [1, 256]}]}`), fields, newImportTestData(t, fields))
+	assert.Error(t, err)
+}
+
+func TestImportParser_parseNumpy(t *testing.T) {
+	schema := newImportTestSchema()
+	age, name, vec := schema.Fields[3], schema.Fields[4], schema.Fields[5]
+
+	arr, err := parseNumpy(bytes.NewReader(newNumpyFile("<i4", "3,", []int32{1, 2, 3})))
+	require.NoError(t, err)
+	assert.Equal(t, []int{3}, arr.shape)
+	fd, err := numpyToFieldData(age, arr)
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{1, 2, 3}, fd.(*storage.Int32FieldData).Data)
+
+	arr, err = parseNumpy(bytes.NewReader(newNumpyFile("<f4", "2, 2", []float32{1, 2, 3, 4})))
+	require.NoError(t, err)
+	fd, err = numpyToFieldData(vec, arr)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, getFieldDataRows(fd))
+	assert.Equal(t, []float32{1, 2, 3, 4}, fd.(*storage.FloatVectorFieldData).Data)
+
+	// strings are zero padded UTF-32
+	arr, err = parseNumpy(bytes.NewReader(newNumpyFile("<U2", "2,", []uint32{'a', 0, 'b', 'c'})))
+	require.NoError(t, err)
+	fd, err = numpyToFieldData(name, arr)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "bc"}, fd.(*storage.StringFieldData).Data)
+
+	// mismatches of type, shape and data size
+	arr, err = parseNumpy(bytes.NewReader(newNumpyFile("<i8", "3,", []int64{1, 2, 3})))
+	require.NoError(t, err)
+	_, err = numpyToFieldData(age, arr)
+	assert.Error(t, err)
+	arr, err = parseNumpy(bytes.NewReader(newNumpyFile("<f4", "1, 4", []float32{1, 2, 3, 4})))
+	require.NoError(t, err)
+	_, err = numpyToFieldData(vec, arr)
+	assert.Error(t, err)
+	arr, err = parseNumpy(bytes.NewReader(newNumpyFile("<i4", "4,", []int32{1, 2, 3})))
+	require.NoError(t, err)
+	_, err = numpyToFieldData(age, arr)
+	assert.Error(t, err)
+	arr, err = parseNumpy(bytes.NewReader(newNumpyFile("<i4", "2,", []int32{1, 2, 3})))
+	require.NoError(t, err)
+	_, err = numpyToFieldData(age, arr)
+	assert.Error(t, err)
+
+	_, err = parseNumpy(strings.NewReader("not a numpy file"))
+	assert.Error(t, err)
+	_, err = parseNumpy(bytes.NewReader(bytes.Replace(newNumpyFile("<i4", "1,", []int32{1}), []byte("False"), []byte("True "), 1)))
+	assert.Error(t, err)
+	_, err = parseNumpy(bytes.NewReader(newNumpyFile("<i4", "", []int32{1})))
+	assert.Error(t, err)
+
+	assert.Equal(t, "age", getColumnFieldName("a/b/age.npy"))
+	assert.Equal(t, "age", getColumnFieldName("a/b/age.parquet"))
+}
+
+func TestImportParser_parquetToFieldData(t *testing.T) {
+	newParquetFile := func(dataType schemapb.DataType, msgs interface{}, dim ...int) []byte {
+		w, err := storage.NewPayloadWriter(dataType, schemapb.CompressionType_Uncompressed)
+		require.NoError(t, err)
+		defer w.Close()
+		require.NoError(t, w.AddDataToPayload(msgs, dim...))
+		require.NoError(t, w.FinishPayloadWriter())
+		buf, err := w.GetPayloadBufferFromWriter()
+		require.NoError(t, err)
+		return append([]byte{}, buf...)
+	}
+	dimParams := []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}
+
+	fd, err := parquetToFieldData(&schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int64},
+		newParquetFile(schemapb.DataType_Int64, []int64{1, 2, 3}))
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.Int64FieldData{NumRows: 3, Data: []int64{1, 2, 3}}, fd)
+
+	fd, err = parquetToFieldData(&schemapb.FieldSchema{Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: dimParams},
+		newParquetFile(schemapb.DataType_FloatVector, []float32{1, 2, 3, 4}, 2))
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.FloatVectorFieldData{NumRows: 2, Data: []float32{1, 2, 3, 4}, Dim: 2}, fd)
+
+	// the dim of the column doesn't match the field
+	_, err = parquetToFieldData(&schemapb.FieldSchema{Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: dimParams},
+		newParquetFile(schemapb.DataType_FloatVector, []float32{1, 2, 3, 4}, 4))
+	assert.Error(t, err)
+	_, err = parquetToFieldData(&schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int64}, []byte("not a parquet file"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,403 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datanode
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/rootcoord"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// importKV is the object storage of the files to import and of the binlogs written, the files to
+// import are opened as streams rather than loaded whole
+type importKV interface {
+	kv.BaseKV
+	LoadReader(key string) (io.ReadCloser, error)
+}
+
+// importTask loads the files of an import task from object storage, validates the rows against
+// the collection schema and writes them into new segments as binlogs. The rows are hashed to the
+// channels of the task by primary key as inserts are. Each segment is reported to DataCoord once
+// its binlogs are written, and DataCoord flushes it.
+type importTask struct {
+	kv          importKV
+	idAllocator allocatorInterface
+	rootCoord   types.RootCoord
+	dataCoord   types.DataCoord
+	task        *datapb.ImportTask
+	// getReplica returns the replica of a channel watched by the datanode, nil if not watched.
+	// The segments imported are added to the replica so that deletes reach them.
+	getReplica func(channel string) Replica
+}
+
+func newImportTask(kv importKV, idAllocator allocatorInterface, rc types.RootCoord, dc types.DataCoord,
+	task *datapb.ImportTask, getReplica func(channel string) Replica) *importTask {
+	return &importTask{
+		kv:          kv,
+		idAllocator: idAllocator,
+		rootCoord:   rc,
+		dataCoord:   dc,
+		task:        task,
+		getReplica:  getReplica,
+	}
+}
+
+// execute runs the task and reports the failure to DataCoord if any
+func (t *importTask) execute(ctx context.Context) {
+	if err := t.run(ctx); err != nil {
+		log.Warn("import task failed", zap.Int64("taskID", t.task.GetTaskID()), zap.Error(err))
+		if err := t.report(ctx, commonpb.ImportState_ImportFailed, nil, err.Error()); err != nil {
+			log.Warn("failed to report import task", zap.Int64("taskID", t.task.GetTaskID()), zap.Error(err))
+		}
+		return
+	}
+	log.Debug("import task completed", zap.Int64("taskID", t.task.GetTaskID()))
+}
+
+func (t *importTask) run(ctx context.Context) error {
+	if len(t.task.GetChannelNames()) == 0 {
+		return errors.New("no channel of the collection")
+	}
+	if err := t.report(ctx, commonpb.ImportState_ImportRunning, nil, ""); err != nil {
+		return err
+	}
+	schema, err := t.getSchema(ctx)
+	if err != nil {
+		return err
+	}
+	data, rows, err := t.loadFiles(schema)
+	if err != nil {
+		return err
+	}
+	if rows > 0 {
+		if err := t.fillSystemFields(ctx, schema, data, rows); err != nil {
+			return err
+		}
+		collMeta := &etcdpb.CollectionMeta{ID: t.task.GetCollectionID(), Schema: schema}
+		for i, offsets := range hashImportRows(schema, data, rows, len(t.task.GetChannelNames())) {
+			if err := t.importChannel(ctx, collMeta, t.task.GetChannelNames()[i], data, offsets); err != nil {
+				return err
+			}
+		}
+	}
+	return t.report(ctx, commonpb.ImportState_ImportCompleted, nil, "")
+}
+
+func (t *importTask) report(ctx context.Context, state commonpb.ImportState, segments []*datapb.ImportSegment, reason string) error {
+	status, err := t.dataCoord.ReportImport(ctx, &datapb.ImportResult{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_Import,
+			SourceID: Params.NodeID,
+		},
+		TaskID:   t.task.GetTaskID(),
+		State:    state,
+		Segments: segments,
+		Reason:   reason,
+	})
+	if err == nil && status.GetErrorCode() != commonpb.ErrorCode_Success {
+		err = errors.New(status.GetReason())
+	}
+	return err
+}
+
+func (t *importTask) getSchema(ctx context.Context) (*schemapb.CollectionSchema, error) {
+	resp, err := t.rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_DescribeCollection,
+			SourceID: Params.NodeID,
+		},
+		CollectionID: t.task.GetCollectionID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	return resp.GetSchema(), nil
+}
+
+// loadFiles loads and validates the files of the task, either all JSON files or all NumPy and Parquet
+// files, the field data of the user fields and the number of rows are returned
+func (t *importTask) loadFiles(schema *schemapb.CollectionSchema) (map[UniqueID]storage.FieldData, int, error) {
+	fields := getImportFields(schema)
+	data := make(map[UniqueID]storage.FieldData, len(fields))
+	for _, field := range fields {
+		fd, err := newImportFieldData(field)
+		if err != nil {
+			return nil, 0, err
+		}
+		data[field.GetFieldID()] = fd
+	}
+
+	var jsonFiles, columnFiles []string
+	for _, file := range t.task.GetFiles() {
+		switch strings.ToLower(path.Ext(file)) {
+		case jsonFileExt:
+			jsonFiles = append(jsonFiles, file)
+		case numpyFileExt, parquetFileExt:
+			columnFiles = append(columnFiles, file)
+		default:
+			return nil, 0, fmt.Errorf("file %s: unsupported file type, should be %s, %s or %s",
+				file, jsonFileExt, numpyFileExt, parquetFileExt)
+		}
+	}
+	if len(jsonFiles) > 0 && len(columnFiles) > 0 {
+		return nil, 0, errors.New("row-based JSON files and column-based NumPy or Parquet files can't be imported together")
+	}
+
+	rows := 0
+	for _, file := range jsonFiles {
+		var n int
+		err := t.readFile(file, func(r io.Reader) (err error) {
+			n, err = parseJSONRows(r, fields, data)
+			return err
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		rows += n
+	}
+
+	if len(columnFiles) > 0 {
+		name2Field := make(map[string]*schemapb.FieldSchema, len(fields))
+		for _, field := range fields {
+			name2Field[field.GetName()] = field
+		}
+		rows = -1
+		for _, file := range columnFiles {
+			field, ok := name2Field[getColumnFieldName(file)]
+			if !ok {
+				return nil, 0, fmt.Errorf("file %s: field %s is not in the schema, is auto ID or has another file", file, getColumnFieldName(file))
+			}
+			delete(name2Field, field.GetName())
+			var fd storage.FieldData
+			err := t.readFile(file, func(r io.Reader) (err error) {
+				fd, err = loadColumnFile(field, file, r)
+				return err
+			})
+			if err != nil {
+				return nil, 0, err
+			}
+			if n := getFieldDataRows(fd); rows >= 0 && n != rows {
+				return nil, 0, fmt.Errorf("file %s: %d rows, but %d rows in the other files", file, n, rows)
+			}
+			rows = getFieldDataRows(fd)
+			data[field.GetFieldID()] = fd
+		}
+		if len(name2Field) > 0 {
+			missing := make([]string, 0, len(name2Field))
+			for name := range name2Field {
+				missing = append(missing, name)
+			}
+			return nil, 0, fmt.Errorf("files of fields %v are missing", missing)
+		}
+	}
+	return data, rows, nil
+}
+
+// readFile opens a file to import as a stream and reads it by read, the errors are prefixed by the file
+func (t *importTask) readFile(file string, read func(r io.Reader) error) error {
+	reader, err := t.kv.LoadReader(file)
+	if err != nil {
+		return fmt.Errorf("file %s: %w", file, err)
+	}
+	defer reader.Close()
+	if err := read(bufio.NewReader(reader)); err != nil {
+		return fmt.Errorf("file %s: %w", file, err)
+	}
+	return nil
+}
+
+// fillSystemFields allocates the row IDs and the timestamp of the rows, an auto ID primary key is
+// filled with the row IDs
+func (t *importTask) fillSystemFields(ctx context.Context, schema *schemapb.CollectionSchema,
+	data map[UniqueID]storage.FieldData, rows int) error {
+	rowIDs := make([]int64, 0, rows)
+	for len(rowIDs) < rows {
+		start, count, err := t.idAllocator.allocIDBatch(uint32(rows - len(rowIDs)))
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return errors.New("failed to allocate row IDs")
+		}
+		for i := int64(0); i < int64(count); i++ {
+			rowIDs = append(rowIDs, start+i)
+		}
+	}
+
+	resp, err := t.rootCoord.AllocTimestamp(ctx, &rootcoordpb.AllocTimestampRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_RequestTSO,
+			SourceID: Params.NodeID,
+		},
+		Count: 1,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(resp.GetStatus().GetReason())
+	}
+	tss := make([]int64, rows)
+	for i := range tss {
+		tss[i] = int64(resp.GetTimestamp())
+	}
+
+	data[rootcoord.RowIDField] = &storage.Int64FieldData{NumRows: rows, Data: rowIDs}
+	data[rootcoord.TimeStampField] = &storage.Int64FieldData{NumRows: rows, Data: tss}
+	for _, field := range schema.GetFields() {
+		if !field.GetIsPrimaryKey() || !field.GetAutoID() {
+			continue
+		}
+		if field.GetDataType() != schemapb.DataType_Int64 {
+			return fmt.Errorf("auto ID primary key %s should be of int64 type", field.GetName())
+		}
+		data[field.GetFieldID()] = &storage.Int64FieldData{NumRows: rows, Data: rowIDs}
+	}
+	return nil
+}
+
+// hashImportRows returns the offsets of the rows of each channel, the rows are hashed by primary key
+// as the proxy does for inserts
+func hashImportRows(schema *schemapb.CollectionSchema, data map[UniqueID]storage.FieldData, rows int, channelNum int) [][]int {
+	offsets := make([][]int, channelNum)
+	for i := 0; i < rows; i++ {
+		var hash uint32
+		switch pks := data[getPKFieldID(schema)].(type) {
+		case *storage.Int64FieldData:
+			hash, _ = typeutil.Hash32Int64(pks.Data[i])
+		case *storage.StringFieldData:
+			h, _ := typeutil.Hash32String(pks.Data[i])
+			hash = uint32(h)
+		}
+		idx := int(hash % uint32(channelNum))
+		offsets[idx] = append(offsets[idx], i)
+	}
+	return offsets
+}
+
+// importChannel writes the rows at offsets into the segments of a channel, which are allocated
+// by DataCoord for the task
+func (t *importTask) importChannel(ctx context.Context, collMeta *etcdpb.CollectionMeta, channel string,
+	data map[UniqueID]storage.FieldData, offsets []int) error {
+	collID, partID := t.task.GetCollectionID(), t.task.GetPartitionID()
+	pkFieldID := getPKFieldID(collMeta.GetSchema())
+	for len(offsets) > 0 {
+		segID, count, err := t.allocSegment(ctx, channel, len(offsets))
+		if err != nil {
+			return err
+		}
+		segData := &InsertData{Data: make(map[UniqueID]storage.FieldData, len(data))}
+		minPK, maxPK := int64(math.MaxInt64), int64(math.MinInt64)
+		for _, offset := range offsets[:count] {
+			for fieldID, field := range data {
+				segData.Data[fieldID] = appendFieldRow(segData.Data[fieldID], field, offset)
+			}
+			if pks, ok := data[pkFieldID].(*storage.Int64FieldData); ok {
+				if pk := pks.Data[offset]; pk < minPK {
+					minPK = pk
+				}
+				if pk := pks.Data[offset]; pk > maxPK {
+					maxPK = pk
+				}
+			}
+		}
+
+		kvs := make(map[string]string)
+		insertLogs, _, err := genInsertBinlogs(t.idAllocator, collMeta, partID, segID, segData, kvs)
+		if err != nil {
+			return err
+		}
+		paths := make([]string, 0, len(kvs))
+		for key := range kvs {
+			paths = append(paths, key)
+		}
+		if err := t.kv.MultiSave(kvs); err != nil {
+			_ = t.kv.MultiRemove(paths)
+			return err
+		}
+		segment := &datapb.ImportSegment{
+			SegmentID:  segID,
+			NumOfRows:  int64(count),
+			InsertLogs: insertLogs,
+		}
+		if err := t.report(ctx, commonpb.ImportState_ImportRunning, []*datapb.ImportSegment{segment}, ""); err != nil {
+			_ = t.kv.MultiRemove(paths)
+			return err
+		}
+		log.Debug("import segment saved", zap.Int64("taskID", t.task.GetTaskID()),
+			zap.Int64("segmentID", segID), zap.String("channel", channel), zap.Int("rows", count))
+
+		if replica := t.getReplica(channel); replica != nil {
+			if err := replica.mergeFlushedSegments(segID, collID, partID, channel, int64(count), minPK, maxPK, nil); err != nil {
+				log.Warn("failed to add import segment to replica", zap.Int64("segmentID", segID), zap.Error(err))
+			}
+		}
+		offsets = offsets[count:]
+	}
+	return nil
+}
+
+// allocSegment asks DataCoord for a segment of the task, which holds at most the returned count of rows
+func (t *importTask) allocSegment(ctx context.Context, channel string, rows int) (UniqueID, int, error) {
+	resp, err := t.dataCoord.AssignSegmentID(ctx, &datapb.AssignSegmentIDRequest{
+		NodeID: Params.NodeID,
+		SegmentIDRequests: []*datapb.SegmentIDRequest{
+			{
+				Count:        uint32(rows),
+				ChannelName:  channel,
+				CollectionID: t.task.GetCollectionID(),
+				PartitionID:  t.task.GetPartitionID(),
+				ImportTaskID: t.task.GetTaskID(),
+			},
+		},
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return 0, 0, errors.New(resp.GetStatus().GetReason())
+	}
+	if len(resp.GetSegIDAssignments()) != 1 {
+		return 0, 0, fmt.Errorf("expect 1 segment assignment, got %d", len(resp.GetSegIDAssignments()))
+	}
+	assign := resp.GetSegIDAssignments()[0]
+	if assign.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return 0, 0, errors.New(assign.GetStatus().GetReason())
+	}
+	if assign.GetCount() == 0 {
+		return 0, 0, fmt.Errorf("segment %d holds no row", assign.GetSegID())
+	}
+	return assign.GetSegID(), int(assign.GetCount()), nil
+}
@@ -33,6 +33,8 @@ type ParamTable struct {
 	FlushInsertBufferSize   int32
 	InsertBinlogRootPath    string
 	StatsBinlogRootPath     string
+	DeleteBinlogRootPath    string
+	DdlBinlogRootPath       string
 	Log                     log.Config
 	Alias                   string // Different datanode in one machine
 
@@ -85,6 +87,8 @@ func (p *ParamTable) Init() {
 		p.initFlushInsertBufferSize()
 		p.initInsertBinlogRootPath()
 		p.initStatsBinlogRootPath()
+		p.initDeleteBinlogRootPath()
+		p.initDdlBinlogRootPath()
 		p.initLogCfg()
 
 		// === DataNode External Components Configs ===
@@ -144,6 +148,22 @@ func (p *ParamTable) initStatsBinlogRootPath() {
 	p.StatsBinlogRootPath = path.Join(rootPath, "stats_log")
 }
 
+func (p *ParamTable) initDeleteBinlogRootPath() {
+	rootPath, err := p.Load("etcd.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.DeleteBinlogRootPath = path.Join(rootPath, "delta_log")
+}
+
+func (p *ParamTable) initDdlBinlogRootPath() {
+	rootPath, err := p.Load("etcd.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.DdlBinlogRootPath = path.Join(rootPath, "data_definition_log")
+}
+
 // ---- Pulsar ----
 func (p *ParamTable) initPulsarAddress() {
 	url, err := p.Load("_PulsarAddress")
@@ -49,6 +49,11 @@ func TestParamTable_DataNode(t *testing.T) {
 		log.Println("InsertBinlogRootPath:", path)
 	})
 
+	t.Run("Test DeleteBinlogRootPath", func(t *testing.T) {
+		path := Params.DeleteBinlogRootPath
+		log.Println("DeleteBinlogRootPath:", path)
+	})
+
 	t.Run("Test PulsarAddress", func(t *testing.T) {
 		address := Params.PulsarAddress
 		log.Println("PulsarAddress:", address)
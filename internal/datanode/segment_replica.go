@@ -14,6 +14,7 @@ package datanode
 import (
 	"context"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 
@@ -24,6 +25,13 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/bloomfilter"
+)
+
+const (
+	// capacity and false positive rate of the bloom filter of string primary keys in a segment
+	stringPKFilterCapacity = 100000
+	stringPKFilterFpRate   = 0.005
 )
 
 type Replica interface {
@@ -33,10 +41,17 @@ type Replica interface {
 
 	addNewSegment(segID, collID, partitionID UniqueID, channelName string, startPos, endPos *internalpb.MsgPosition) error
 	addNormalSegment(segID, collID, partitionID UniqueID, channelName string, numOfRows int64, cp *segmentCheckPoint) error
+	addFlushedSegment(segID, collID, partitionID UniqueID, channelName string, numOfRows int64) error
+	mergeFlushedSegments(segID, collID, partitionID UniqueID, channelName string, numOfRows int64, minPK, maxPK int64, compactedFrom []UniqueID) error
 	listNewSegmentsStartPositions() []*datapb.SegmentStartPosition
 	listSegmentsCheckPoints() map[UniqueID]segmentCheckPoint
 	updateSegmentEndPosition(segID UniqueID, endPos *internalpb.MsgPosition)
 	updateSegmentCheckPoint(segID UniqueID)
+	updateSegmentPKRange(segID UniqueID, pks []int64)
+	filterSegmentsByPK(partitionID UniqueID, pk int64) []UniqueID
+	updateSegmentStringPKs(segID UniqueID, pks []string)
+	filterSegmentsByStringPK(partitionID UniqueID, pk string) []UniqueID
+	isSegmentFlushed(segID UniqueID) bool
 	hasSegment(segID UniqueID) bool
 
 	updateStatistics(segID UniqueID, numRows int64) error
@@ -58,6 +73,12 @@ type Segment struct {
 	checkPoint segmentCheckPoint
 	startPos   *internalpb.MsgPosition // TODO readonly
 	endPos     *internalpb.MsgPosition
+
+	// range of primary keys in the segment, used to route delete messages
+	minPK int64
+	maxPK int64
+	// bloom filter of string primary keys in the segment, nil means it may contain any string primary key
+	strPKFilter *bloomfilter.BloomFilter
 }
 
 // SegmentReplica is the data replication of persistent data in datanode.
@@ -145,6 +166,10 @@ func (replica *SegmentReplica) getCollectionAndPartitionID(segID UniqueID) (coll
 		return seg.collectionID, seg.partitionID, nil
 	}
 
+	if seg, ok := replica.flushedSegments[segID]; ok {
+		return seg.collectionID, seg.partitionID, nil
+	}
+
 	return 0, 0, fmt.Errorf("Cannot find segment, id = %v", segID)
 }
 
@@ -176,6 +201,10 @@ func (replica *SegmentReplica) addNewSegment(segID, collID, partitionID UniqueID
 		checkPoint: segmentCheckPoint{0, *startPos},
 		startPos:   startPos,
 		endPos:     endPos,
+
+		minPK:       math.MaxInt64,
+		maxPK:       math.MinInt64,
+		strPKFilter: bloomfilter.New(stringPKFilterCapacity, stringPKFilterFpRate),
 	}
 
 	seg.isNew.Store(true)
@@ -211,6 +240,10 @@ func (replica *SegmentReplica) addNormalSegment(segID, collID, partitionID Uniqu
 
 		checkPoint: *cp,
 		endPos:     &cp.pos,
+
+		// primary keys of a recovered segment are unknown, so it may contain any primary key
+		minPK: math.MinInt64,
+		maxPK: math.MaxInt64,
 	}
 
 	seg.isNew.Store(false)
@@ -220,6 +253,83 @@ func (replica *SegmentReplica) addNormalSegment(segID, collID, partitionID Uniqu
 	return nil
 }
 
+// addFlushedSegment adds a *Flushed* segment recovered from DataCoord
+func (replica *SegmentReplica) addFlushedSegment(segID, collID, partitionID UniqueID, channelName string, numOfRows int64) error {
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+
+	if collID != replica.collectionID {
+		log.Warn("Mismatch collection", zap.Int64("ID", collID))
+		return fmt.Errorf("Mismatch collection, ID=%d", collID)
+	}
+
+	log.Debug("Add Flushed segment",
+		zap.Int64("segment ID", segID),
+		zap.Int64("collection ID", collID),
+		zap.Int64("partition ID", partitionID),
+		zap.String("channel name", channelName),
+	)
+
+	seg := &Segment{
+		collectionID: collID,
+		partitionID:  partitionID,
+		segmentID:    segID,
+		channelName:  channelName,
+		numRows:      numOfRows,
+
+		// primary keys of a recovered segment are unknown, so it may contain any primary key
+		minPK: math.MinInt64,
+		maxPK: math.MaxInt64,
+	}
+
+	seg.isNew.Store(false)
+	seg.isFlushed.Store(true)
+
+	replica.flushedSegments[segID] = seg
+	return nil
+}
+
+// mergeFlushedSegments replaces the *Flushed* segments compactedFrom with a *Flushed* segment compacted from them
+func (replica *SegmentReplica) mergeFlushedSegments(segID, collID, partitionID UniqueID, channelName string,
+	numOfRows int64, minPK, maxPK int64, compactedFrom []UniqueID) error {
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+
+	if collID != replica.collectionID {
+		log.Warn("Mismatch collection", zap.Int64("ID", collID))
+		return fmt.Errorf("Mismatch collection, ID=%d", collID)
+	}
+
+	log.Debug("Merge flushed segments",
+		zap.Int64("segment ID", segID),
+		zap.Int64s("compacted from", compactedFrom),
+		zap.Int64("partition ID", partitionID),
+		zap.String("channel name", channelName),
+	)
+
+	for _, id := range compactedFrom {
+		delete(replica.flushedSegments, id)
+	}
+
+	seg := &Segment{
+		collectionID: collID,
+		partitionID:  partitionID,
+		segmentID:    segID,
+		channelName:  channelName,
+		numRows:      numOfRows,
+
+		// string primary keys of the compacted segment are not tracked, strPKFilter is left nil
+		minPK: minPK,
+		maxPK: maxPK,
+	}
+
+	seg.isNew.Store(false)
+	seg.isFlushed.Store(true)
+
+	replica.flushedSegments[segID] = seg
+	return nil
+}
+
 // listNewSegmentsStartPositions gets all *New Segments* start positions and
 //   transfer segments states from *New* to *Normal*.
 func (replica *SegmentReplica) listNewSegmentsStartPositions() []*datapb.SegmentStartPosition {
@@ -278,6 +388,101 @@ func (replica *SegmentReplica) updateSegmentEndPosition(segID UniqueID, endPos *
 	log.Warn("No match segment", zap.Int64("ID", segID))
 }
 
+// updateSegmentPKRange extends the primary key range of a *New* or *Normal* segment with the int64 primary keys.
+func (replica *SegmentReplica) updateSegmentPKRange(segID UniqueID, pks []int64) {
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+
+	seg, ok := replica.newSegments[segID]
+	if !ok {
+		seg, ok = replica.normalSegments[segID]
+	}
+	if !ok {
+		log.Warn("No match segment", zap.Int64("ID", segID))
+		return
+	}
+
+	for _, pk := range pks {
+		if pk < seg.minPK {
+			seg.minPK = pk
+		}
+		if pk > seg.maxPK {
+			seg.maxPK = pk
+		}
+	}
+}
+
+// filterSegmentsByPK returns the IDs of segments whose primary key range covers pk.
+// PartitionID 0 means segments of all partitions.
+func (replica *SegmentReplica) filterSegmentsByPK(partitionID UniqueID, pk int64) []UniqueID {
+	replica.segMu.RLock()
+	defer replica.segMu.RUnlock()
+
+	result := make([]UniqueID, 0)
+	for _, segments := range []map[UniqueID]*Segment{replica.newSegments, replica.normalSegments, replica.flushedSegments} {
+		for id, seg := range segments {
+			if partitionID != 0 && seg.partitionID != partitionID {
+				continue
+			}
+			if pk >= seg.minPK && pk <= seg.maxPK {
+				result = append(result, id)
+			}
+		}
+	}
+	return result
+}
+
+// updateSegmentStringPKs adds the string primary keys to the bloom filter of a *New* or *Normal* segment.
+func (replica *SegmentReplica) updateSegmentStringPKs(segID UniqueID, pks []string) {
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+
+	seg, ok := replica.newSegments[segID]
+	if !ok {
+		seg, ok = replica.normalSegments[segID]
+	}
+	if !ok {
+		log.Warn("No match segment", zap.Int64("ID", segID))
+		return
+	}
+	if seg.strPKFilter == nil {
+		return
+	}
+
+	for _, pk := range pks {
+		seg.strPKFilter.AddString(pk)
+	}
+}
+
+// filterSegmentsByStringPK returns the IDs of segments which may contain the string primary key pk.
+// PartitionID 0 means segments of all partitions.
+func (replica *SegmentReplica) filterSegmentsByStringPK(partitionID UniqueID, pk string) []UniqueID {
+	replica.segMu.RLock()
+	defer replica.segMu.RUnlock()
+
+	result := make([]UniqueID, 0)
+	for _, segments := range []map[UniqueID]*Segment{replica.newSegments, replica.normalSegments, replica.flushedSegments} {
+		for id, seg := range segments {
+			if partitionID != 0 && seg.partitionID != partitionID {
+				continue
+			}
+			if seg.strPKFilter == nil || seg.strPKFilter.TestString(pk) {
+				result = append(result, id)
+			}
+		}
+	}
+	return result
+}
+
+// isSegmentFlushed checks whether a segment is *Flushed* in this replica.
+func (replica *SegmentReplica) isSegmentFlushed(segID UniqueID) bool {
+	replica.segMu.RLock()
+	defer replica.segMu.RUnlock()
+
+	_, ok := replica.flushedSegments[segID]
+	return ok
+}
+
 func (replica *SegmentReplica) removeSegment(segID UniqueID) error {
 	return nil
 }
@@ -189,4 +189,71 @@ func TestSegmentReplica(t *testing.T) {
 		replica.updateSegmentCheckPoint(1)
 		assert.Equal(t, int64(20), replica.normalSegments[UniqueID(1)].checkPoint.numRows)
 	})
+
+	t.Run("Test primary key range", func(t *testing.T) {
+		replica := newSegmentReplica(rc, collID)
+
+		startPos := &internalpb.MsgPosition{ChannelName: "insert-01", Timestamp: Timestamp(100)}
+		endPos := &internalpb.MsgPosition{ChannelName: "insert-01", Timestamp: Timestamp(200)}
+		err := replica.addNewSegment(0, 1, 2, "insert-01", startPos, endPos)
+		assert.NoError(t, err)
+		err = replica.addNewSegment(1, 1, 3, "insert-01", startPos, endPos)
+		assert.NoError(t, err)
+
+		// an empty new segment contains no primary key
+		assert.Empty(t, replica.filterSegmentsByPK(0, 10))
+
+		replica.updateSegmentPKRange(0, []int64{10, 5, 20})
+		replica.updateSegmentPKRange(1, []int64{15, 30})
+		assert.ElementsMatch(t, []UniqueID{0}, replica.filterSegmentsByPK(0, 5))
+		assert.ElementsMatch(t, []UniqueID{0, 1}, replica.filterSegmentsByPK(0, 18))
+		assert.ElementsMatch(t, []UniqueID{1}, replica.filterSegmentsByPK(3, 18))
+		assert.Empty(t, replica.filterSegmentsByPK(0, 31))
+
+		// a recovered normal segment may contain any primary key
+		cp := &segmentCheckPoint{int64(10), *startPos}
+		err = replica.addNormalSegment(2, 1, 2, "insert-01", int64(10), cp)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []UniqueID{2}, replica.filterSegmentsByPK(2, 100))
+
+		assert.False(t, replica.isSegmentFlushed(0))
+		replica.segmentFlushed(0)
+		assert.True(t, replica.isSegmentFlushed(0))
+		assert.ElementsMatch(t, []UniqueID{0, 2}, replica.filterSegmentsByPK(2, 10))
+
+		// a recovered flushed segment may contain any primary key too
+		err = replica.addFlushedSegment(3, 1, 2, "insert-01", int64(10))
+		assert.NoError(t, err)
+		assert.True(t, replica.isSegmentFlushed(3))
+		_, partitionID, err := replica.getCollectionAndPartitionID(3)
+		assert.NoError(t, err)
+		assert.Equal(t, UniqueID(2), partitionID)
+		assert.ElementsMatch(t, []UniqueID{2, 3}, replica.filterSegmentsByPK(2, 100))
+		assert.Contains(t, replica.filterSegmentsByStringPK(2, "a"), UniqueID(3))
+		assert.Error(t, replica.addFlushedSegment(4, 2, 2, "insert-01", int64(10)))
+	})
+
+	t.Run("Test string primary keys", func(t *testing.T) {
+		replica := newSegmentReplica(rc, collID)
+
+		startPos := &internalpb.MsgPosition{ChannelName: "insert-01", Timestamp: Timestamp(100)}
+		endPos := &internalpb.MsgPosition{ChannelName: "insert-01", Timestamp: Timestamp(200)}
+		err := replica.addNewSegment(0, 1, 2, "insert-01", startPos, endPos)
+		assert.NoError(t, err)
+		err = replica.addNewSegment(1, 1, 3, "insert-01", startPos, endPos)
+		assert.NoError(t, err)
+		assert.Empty(t, replica.filterSegmentsByStringPK(0, "a"))
+
+		replica.updateSegmentStringPKs(0, []string{"a", "b"})
+		replica.updateSegmentStringPKs(1, []string{"c"})
+		assert.ElementsMatch(t, []UniqueID{0}, replica.filterSegmentsByStringPK(0, "a"))
+		assert.ElementsMatch(t, []UniqueID{1}, replica.filterSegmentsByStringPK(0, "c"))
+		assert.Empty(t, replica.filterSegmentsByStringPK(2, "c"))
+
+		// a recovered normal segment may contain any primary key
+		cp := &segmentCheckPoint{int64(10), *startPos}
+		err = replica.addNormalSegment(2, 1, 2, "insert-01", int64(10), cp)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []UniqueID{0, 2}, replica.filterSegmentsByStringPK(2, "b"))
+	})
 }
@@ -251,3 +251,31 @@ func (c *Client) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedS
 	})
 	return ret.(*datapb.GetFlushedSegmentsResponse), err
 }
+
+func (c *Client) CompleteCompaction(ctx context.Context, req *datapb.CompactionResult) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.CompleteCompaction(ctx, req)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *Client) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*milvuspb.ImportResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.Import(ctx, req)
+	})
+	return ret.(*milvuspb.ImportResponse), err
+}
+
+func (c *Client) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.GetImportState(ctx, req)
+	})
+	return ret.(*milvuspb.GetImportStateResponse), err
+}
+
+func (c *Client) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.ReportImport(ctx, req)
+	})
+	return ret.(*commonpb.Status), err
+}
@@ -237,3 +237,23 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
 	return s.dataCoord.GetFlushedSegments(ctx, req)
 }
+
+//CompleteCompaction implement DataCoordServer, saves the result of a compaction plan executed by datanode
+func (s *Server) CompleteCompaction(ctx context.Context, req *datapb.CompactionResult) (*commonpb.Status, error) {
+	return s.dataCoord.CompleteCompaction(ctx, req)
+}
+
+//Import implement DataCoordServer, starts an import task of files in object storage
+func (s *Server) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*milvuspb.ImportResponse, error) {
+	return s.dataCoord.Import(ctx, req)
+}
+
+//GetImportState implement DataCoordServer, returns the state of an import task
+func (s *Server) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return s.dataCoord.GetImportState(ctx, req)
+}
+
+//ReportImport implement DataCoordServer, saves the progress of an import task executed by datanode
+func (s *Server) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	return s.dataCoord.ReportImport(ctx, req)
+}
@@ -165,3 +165,17 @@ func (c *Client) FlushSegments(ctx context.Context, req *datapb.FlushSegmentsReq
 	})
 	return ret.(*commonpb.Status), err
 }
+
+func (c *Client) Compaction(ctx context.Context, req *datapb.CompactionPlan) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpc.Compaction(ctx, req)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *Client) Import(ctx context.Context, req *datapb.ImportTask) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpc.Import(ctx, req)
+	})
+	return ret.(*commonpb.Status), err
+}
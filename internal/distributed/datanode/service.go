@@ -273,3 +273,11 @@ func (s *Server) FlushSegments(ctx context.Context, req *datapb.FlushSegmentsReq
 	}
 	return s.datanode.FlushSegments(ctx, req)
 }
+
+func (s *Server) Compaction(ctx context.Context, req *datapb.CompactionPlan) (*commonpb.Status, error) {
+	return s.datanode.Compaction(ctx, req)
+}
+
+func (s *Server) Import(ctx context.Context, req *datapb.ImportTask) (*commonpb.Status, error) {
+	return s.datanode.Import(ctx, req)
+}
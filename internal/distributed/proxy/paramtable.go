@@ -26,9 +26,10 @@ type ParamTable struct {
 	DataCoordAddress  string
 	QueryCoordAddress string
 
-	IP      string
-	Port    int
-	Address string
+	IP           string
+	Port         int
+	InternalPort int
+	Address      string // the address of the internal port, which the other components call
 }
 
 var Params ParamTable
@@ -51,6 +52,7 @@ func (pt *ParamTable) LoadFromEnv() {
 
 func (pt *ParamTable) initParams() {
 	pt.initPort()
+	pt.initInternalPort()
 	pt.initRootCoordAddress()
 	pt.initIndexCoordAddress()
 	pt.initDataCoordAddress()
@@ -97,3 +99,7 @@ func (pt *ParamTable) initPort() {
 	port := pt.ParseInt("proxy.port")
 	pt.Port = port
 }
+
+func (pt *ParamTable) initInternalPort() {
+	pt.InternalPort = pt.ParseInt("proxy.internalPort")
+}
@@ -29,6 +29,7 @@ import (
 	grpcquerycoordclient "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
@@ -46,11 +47,14 @@ const (
 	GRPCMaxMagSize = 2 << 30
 )
 
+// Server serves MilvusService for the clients on Params.Port, on which the clients are authenticated when
+// authorization is enabled, and serves ProxyService for the other components on Params.InternalPort
 type Server struct {
-	ctx        context.Context
-	wg         sync.WaitGroup
-	proxy      *proxy.Proxy
-	grpcServer *grpc.Server
+	ctx                context.Context
+	wg                 sync.WaitGroup
+	proxy              *proxy.Proxy
+	grpcServer         *grpc.Server
+	grpcInternalServer *grpc.Server
 
 	grpcErrChan chan error
 
@@ -67,8 +71,9 @@ func NewServer(ctx context.Context, factory msgstream.Factory) (*Server, error)
 
 	var err error
 	server := &Server{
-		ctx:         ctx,
-		grpcErrChan: make(chan error),
+		ctx: ctx,
+		// both of the grpc loops report whether they are serving
+		grpcErrChan: make(chan error, 2),
 	}
 
 	server.proxy, err = proxy.NewProxy(server.ctx, factory)
@@ -78,7 +83,7 @@ func NewServer(ctx context.Context, factory msgstream.Factory) (*Server, error)
 	return server, err
 }
 
-func (s *Server) startGrpcLoop(grpcPort int) {
+func (s *Server) startGrpcLoop(grpcPort int, grpcServer *grpc.Server) {
 
 	defer s.wg.Done()
 
@@ -93,23 +98,48 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 
+	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
+	if err := grpcServer.Serve(lis); err != nil {
+		s.grpcErrChan <- err
+	}
+
+}
+
+// startGrpc starts the grpc servers of the clients and of the other components, ProxyService isn't served on
+// the port of the clients, so it needn't be authenticated
+func (s *Server) startGrpc() error {
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
 		grpc.MaxRecvMsgSize(math.MaxInt32),
 		grpc.MaxSendMsgSize(math.MaxInt32),
 		grpc.MaxRecvMsgSize(GRPCMaxMagSize),
-		grpc.UnaryInterceptor(
-			grpc_opentracing.UnaryServerInterceptor(opts...)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			grpc_opentracing.UnaryServerInterceptor(opts...),
+			proxy.AuthenticationInterceptor)),
 		grpc.StreamInterceptor(
 			grpc_opentracing.StreamServerInterceptor(opts...)))
-	proxypb.RegisterProxyServer(s.grpcServer, s)
 	milvuspb.RegisterMilvusServiceServer(s.grpcServer, s)
 
-	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
-	if err := s.grpcServer.Serve(lis); err != nil {
-		s.grpcErrChan <- err
+	s.grpcInternalServer = grpc.NewServer(
+		grpc.MaxRecvMsgSize(math.MaxInt32),
+		grpc.MaxSendMsgSize(math.MaxInt32),
+		grpc.MaxRecvMsgSize(GRPCMaxMagSize),
+		grpc.UnaryInterceptor(
+			grpc_opentracing.UnaryServerInterceptor(opts...)),
+		grpc.StreamInterceptor(
+			grpc_opentracing.StreamServerInterceptor(opts...)))
+	proxypb.RegisterProxyServer(s.grpcInternalServer, s)
+
+	s.wg.Add(2)
+	go s.startGrpcLoop(Params.Port, s.grpcServer)
+	go s.startGrpcLoop(Params.InternalPort, s.grpcInternalServer)
+	// wait for both of the grpc server loops to start
+	for i := 0; i < 2; i++ {
+		if err := <-s.grpcErrChan; err != nil {
+			return err
+		}
 	}
-
+	return nil
 }
 
 func (s *Server) Run() error {
@@ -133,9 +163,14 @@ func (s *Server) init() error {
 		Params.Port = funcutil.GetAvailablePort()
 		log.Warn("Proxy init", zap.Any("Port", Params.Port))
 	}
+	if !funcutil.CheckPortAvailable(Params.InternalPort) {
+		Params.InternalPort = funcutil.GetAvailablePort()
+		log.Warn("Proxy init", zap.Any("InternalPort", Params.InternalPort))
+	}
 	Params.LoadFromEnv()
 	Params.LoadFromArgs()
-	Params.Address = Params.IP + ":" + strconv.FormatInt(int64(Params.Port), 10)
+	// the other components find the proxy by the address in its session, which is of the internal port
+	Params.Address = Params.IP + ":" + strconv.FormatInt(int64(Params.InternalPort), 10)
 
 	proxy.Params.Init()
 	log.Debug("init params done ...")
@@ -150,6 +185,7 @@ func (s *Server) init() error {
 
 	log.Debug("proxy", zap.String("proxy host", Params.IP))
 	log.Debug("proxy", zap.Int("proxy port", Params.Port))
+	log.Debug("proxy", zap.Int("proxy internal port", Params.InternalPort))
 	log.Debug("proxy", zap.String("proxy address", Params.Address))
 
 	err = s.proxy.Register()
@@ -158,10 +194,7 @@ func (s *Server) init() error {
 		return err
 	}
 
-	s.wg.Add(1)
-	go s.startGrpcLoop(Params.Port)
-	// wait for grpc server loop start
-	err = <-s.grpcErrChan
+	err = s.startGrpc()
 	log.Debug("create grpc server ...")
 	if err != nil {
 		return err
@@ -258,6 +291,9 @@ func (s *Server) Stop() error {
 	if s.grpcServer != nil {
 		s.grpcServer.GracefulStop()
 	}
+	if s.grpcInternalServer != nil {
+		s.grpcInternalServer.GracefulStop()
+	}
 
 	err = s.proxy.Stop()
 	if err != nil {
@@ -285,6 +321,38 @@ func (s *Server) ReleaseDQLMessageStream(ctx context.Context, request *proxypb.R
 	return s.proxy.ReleaseDQLMessageStream(ctx, request)
 }
 
+func (s *Server) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return s.proxy.InvalidateCredentialCache(ctx, request)
+}
+
+func (s *Server) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateDatabase(ctx, request)
+}
+
+func (s *Server) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.DropDatabase(ctx, request)
+}
+
+func (s *Server) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return s.proxy.ListDatabases(ctx, request)
+}
+
+func (s *Server) CreateUser(ctx context.Context, request *milvuspb.CreateUserRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateUser(ctx, request)
+}
+
+func (s *Server) GrantRole(ctx context.Context, request *milvuspb.GrantRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.GrantRole(ctx, request)
+}
+
+func (s *Server) RevokeRole(ctx context.Context, request *milvuspb.RevokeRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.RevokeRole(ctx, request)
+}
+
+func (s *Server) ListGrants(ctx context.Context, request *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	return s.proxy.ListGrants(ctx, request)
+}
+
 func (s *Server) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	return s.proxy.CreateCollection(ctx, request)
 }
@@ -317,6 +385,22 @@ func (s *Server) ShowCollections(ctx context.Context, request *milvuspb.ShowColl
 	return s.proxy.ShowCollections(ctx, request)
 }
 
+func (s *Server) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.AddField(ctx, request)
+}
+
+func (s *Server) CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateAlias(ctx, request)
+}
+
+func (s *Server) DropAlias(ctx context.Context, request *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+	return s.proxy.DropAlias(ctx, request)
+}
+
+func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterAlias(ctx, request)
+}
+
 func (s *Server) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	return s.proxy.CreatePartition(ctx, request)
 }
@@ -371,10 +455,22 @@ func (s *Server) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*
 	return s.proxy.Insert(ctx, request)
 }
 
+func (s *Server) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
+	return s.proxy.Delete(ctx, request)
+}
+
+func (s *Server) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	return s.proxy.Upsert(ctx, request)
+}
+
 func (s *Server) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	return s.proxy.Search(ctx, request)
 }
 
+func (s *Server) HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	return s.proxy.HybridSearch(ctx, request)
+}
+
 func (s *Server) Retrieve(ctx context.Context, request *milvuspb.RetrieveRequest) (*milvuspb.RetrieveResults, error) {
 	return s.proxy.Retrieve(ctx, request)
 }
@@ -383,6 +479,14 @@ func (s *Server) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*mi
 	return s.proxy.Flush(ctx, request)
 }
 
+func (s *Server) Import(ctx context.Context, request *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+	return s.proxy.Import(ctx, request)
+}
+
+func (s *Server) GetImportState(ctx context.Context, request *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return s.proxy.GetImportState(ctx, request)
+}
+
 func (s *Server) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
 	return s.proxy.Query(ctx, request)
 }
@@ -243,3 +243,10 @@ func (c *Client) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfo
 	})
 	return ret.(*querypb.GetSegmentInfoResponse), err
 }
+
+func (c *Client) GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.GetReplicas(ctx, req)
+	})
+	return ret.(*querypb.GetReplicasResponse), err
+}
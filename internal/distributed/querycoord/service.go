@@ -22,6 +22,7 @@ import (
 
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	dsc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
+	isc "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
@@ -50,8 +51,9 @@ type Server struct {
 
 	msFactory msgstream.Factory
 
-	dataCoord *dsc.Client
-	rootCoord *rcc.GrpcClient
+	dataCoord  *dsc.Client
+	rootCoord  *rcc.GrpcClient
+	indexCoord *isc.Client
 
 	closer io.Closer
 }
@@ -162,6 +164,32 @@ func (s *Server) init() error {
 	}
 	log.Debug("QueryCoord report DataCoord ready")
 
+	// --- IndexCoord ---
+	log.Debug("QueryCoord try to new IndexCoord client", zap.Any("IndexCoordAddress", Params.IndexCoordAddress))
+	indexCoord, err := isc.NewClient(s.loopCtx, qc.Params.MetaRootPath, qc.Params.EtcdEndpoints)
+	if err != nil {
+		log.Debug("QueryCoord try to new IndexCoord client failed", zap.Error(err))
+		panic(err)
+	}
+	if err = indexCoord.Init(); err != nil {
+		log.Debug("QueryCoord IndexCoordClient Init failed", zap.Error(err))
+		panic(err)
+	}
+	if err = indexCoord.Start(); err != nil {
+		log.Debug("QueryCoord IndexCoordClient Start failed", zap.Error(err))
+		panic(err)
+	}
+	log.Debug("QueryCoord try to wait for IndexCoord ready")
+	err = funcutil.WaitForComponentHealthy(s.loopCtx, indexCoord, "IndexCoord", 1000000, time.Millisecond*200)
+	if err != nil {
+		log.Debug("QueryCoord wait for IndexCoord ready failed", zap.Error(err))
+		panic(err)
+	}
+	if err := s.SetIndexCoord(indexCoord); err != nil {
+		panic(err)
+	}
+	log.Debug("QueryCoord report IndexCoord ready")
+
 	s.queryCoord.UpdateStateCode(internalpb.StateCode_Initializing)
 	log.Debug("QueryCoord", zap.Any("State", internalpb.StateCode_Initializing))
 	if err := s.queryCoord.Init(); err != nil {
@@ -229,6 +257,11 @@ func (s *Server) SetDataCoord(d types.DataCoord) error {
 	return nil
 }
 
+func (s *Server) SetIndexCoord(d types.IndexCoord) error {
+	s.queryCoord.SetIndexCoord(d)
+	return nil
+}
+
 func (s *Server) GetComponentStates(ctx context.Context, req *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
 	return s.queryCoord.GetComponentStates(ctx)
 }
@@ -276,3 +309,7 @@ func (s *Server) CreateQueryChannel(ctx context.Context, req *querypb.CreateQuer
 func (s *Server) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	return s.queryCoord.GetSegmentInfo(ctx, req)
 }
+
+func (s *Server) GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+	return s.queryCoord.GetReplicas(ctx, req)
+}
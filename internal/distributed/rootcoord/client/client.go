@@ -190,6 +190,62 @@ func (c *GrpcClient) GetStatisticsChannel(ctx context.Context) (*milvuspb.String
 }
 
 //DDL request
+func (c *GrpcClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.CreateDatabase(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.DropDatabase(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.ListDatabases(ctx, in)
+	})
+	return ret.(*milvuspb.ListDatabasesResponse), err
+}
+
+func (c *GrpcClient) CreateUser(ctx context.Context, in *milvuspb.CreateUserRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.CreateUser(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) GrantRole(ctx context.Context, in *milvuspb.GrantRoleRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.GrantRole(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) RevokeRole(ctx context.Context, in *milvuspb.RevokeRoleRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.RevokeRole(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) ListGrants(ctx context.Context, in *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.ListGrants(ctx, in)
+	})
+	return ret.(*milvuspb.ListGrantsResponse), err
+}
+
+func (c *GrpcClient) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.GetCredential(ctx, in)
+	})
+	return ret.(*rootcoordpb.GetCredentialResponse), err
+}
+
 func (c *GrpcClient) CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
 		return c.grpcClient.CreateCollection(ctx, in)
@@ -223,6 +279,35 @@ func (c *GrpcClient) ShowCollections(ctx context.Context, in *milvuspb.ShowColle
 	})
 	return ret.(*milvuspb.ShowCollectionsResponse), err
 }
+
+func (c *GrpcClient) AddField(ctx context.Context, in *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.AddField(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.CreateAlias(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.DropAlias(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.AlterAlias(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
 func (c *GrpcClient) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
 		return c.grpcClient.CreatePartition(ctx, in)
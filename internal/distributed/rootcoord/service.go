@@ -276,6 +276,38 @@ func (s *Server) GetStatisticsChannel(ctx context.Context, req *internalpb.GetSt
 }
 
 //DDL request
+func (s *Server) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateDatabase(ctx, in)
+}
+
+func (s *Server) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropDatabase(ctx, in)
+}
+
+func (s *Server) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return s.rootCoord.ListDatabases(ctx, in)
+}
+
+func (s *Server) CreateUser(ctx context.Context, in *milvuspb.CreateUserRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateUser(ctx, in)
+}
+
+func (s *Server) GrantRole(ctx context.Context, in *milvuspb.GrantRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.GrantRole(ctx, in)
+}
+
+func (s *Server) RevokeRole(ctx context.Context, in *milvuspb.RevokeRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RevokeRole(ctx, in)
+}
+
+func (s *Server) ListGrants(ctx context.Context, in *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	return s.rootCoord.ListGrants(ctx, in)
+}
+
+func (s *Server) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	return s.rootCoord.GetCredential(ctx, in)
+}
+
 func (s *Server) CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.CreateCollection(ctx, in)
 }
@@ -296,6 +328,22 @@ func (s *Server) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectio
 	return s.rootCoord.ShowCollections(ctx, in)
 }
 
+func (s *Server) AddField(ctx context.Context, in *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AddField(ctx, in)
+}
+
+func (s *Server) CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateAlias(ctx, in)
+}
+
+func (s *Server) DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropAlias(ctx, in)
+}
+
+func (s *Server) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AlterAlias(ctx, in)
+}
+
 func (s *Server) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.CreatePartition(ctx, in)
 }
@@ -312,6 +312,33 @@ func TestGrpcService(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
 	})
 
+	t.Run("create database", func(t *testing.T) {
+		req := &milvuspb.CreateDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_CreateDatabase,
+				MsgID:     90,
+				Timestamp: 90,
+				SourceID:  90,
+			},
+			DbName: dbName,
+		}
+		status, err := cli.CreateDatabase(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		rsp, err := cli.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_ListDatabases,
+				MsgID:     91,
+				Timestamp: 91,
+				SourceID:  91,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Contains(t, rsp.DbNames, dbName)
+	})
+
 	t.Run("create collection", func(t *testing.T) {
 		schema := schemapb.CollectionSchema{
 			Name:   collName,
@@ -410,7 +437,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 110,
 				SourceID:  110,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 		rsp, err := cli.HasCollection(ctx, req)
@@ -425,7 +452,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 111,
 				SourceID:  111,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: "testColl2",
 		}
 		rsp, err = cli.HasCollection(ctx, req)
@@ -441,7 +468,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 111,
 				SourceID:  111,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: "testColl2",
 		}
 		rsp, err = cli.HasCollection(ctx, req)
@@ -451,7 +478,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -460,7 +487,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 120,
 				SourceID:  120,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 		rsp, err := cli.DescribeCollection(ctx, req)
@@ -478,7 +505,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 130,
 				SourceID:  130,
 			},
-			DbName: "testDb",
+			DbName: dbName,
 		}
 		rsp, err := cli.ShowCollections(ctx, req)
 		assert.Nil(t, err)
@@ -502,7 +529,7 @@ func TestGrpcService(t *testing.T) {
 		status, err := cli.CreatePartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.PartitionIDs))
 		partName2, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[1], 0)
@@ -530,7 +557,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("show partition", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.ShowPartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -539,7 +566,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 160,
 				SourceID:  160,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 			CollectionID:   coll.ID,
 		}
@@ -551,7 +578,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("show segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		_, err = core.MetaTable.GetPartitionNameByID(coll.ID, partID, 0)
@@ -596,13 +623,13 @@ func TestGrpcService(t *testing.T) {
 				},
 			},
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Zero(t, len(collMeta.FieldIndexes))
 		rsp, err := cli.CreateIndex(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 
@@ -618,7 +645,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("describe segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 
 		req := &milvuspb.DescribeSegmentRequest{
@@ -658,7 +685,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("flush segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		_, err = core.MetaTable.GetPartitionNameByID(coll.ID, partID, 0)
@@ -719,7 +746,7 @@ func TestGrpcService(t *testing.T) {
 			FieldName:      fieldName,
 			IndexName:      rootcoord.Params.DefaultIndexName,
 		}
-		_, idx, err := core.MetaTable.GetIndexByName(collName, rootcoord.Params.DefaultIndexName)
+		_, idx, err := core.MetaTable.GetIndexByName(dbName, collName, rootcoord.Params.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, len(idx), 1)
 		rsp, err := cli.DropIndex(ctx, req)
@@ -747,7 +774,7 @@ func TestGrpcService(t *testing.T) {
 		status, err := cli.DropPartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		partName, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[0], 0)
@@ -764,7 +791,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 230,
 				SourceID:  230,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 
@@ -784,7 +811,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 231,
 				SourceID:  231,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 		status, err = cli.DropCollection(ctx, req)
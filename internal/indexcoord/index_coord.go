@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"math/rand"
+	"path"
 	"strconv"
 	"strings"
 	"sync"
@@ -450,7 +451,7 @@ func (i *IndexCoord) recycleUnusedIndexFiles() {
 			metas := i.metaTable.GetUnusedIndexFiles(recycleIndexLimit)
 			for _, meta := range metas {
 				if meta.indexMeta.MarkDeleted {
-					unusedIndexFilePathPrefix := strconv.Itoa(int(meta.indexMeta.IndexBuildID))
+					unusedIndexFilePathPrefix := path.Join(Params.IndexFileRootPath, strconv.Itoa(int(meta.indexMeta.IndexBuildID)))
 					if err := i.kv.RemoveWithPrefix(unusedIndexFilePathPrefix); err != nil {
 						log.Debug("IndexCoord recycleUnusedIndexFiles Remove index files failed",
 							zap.Any("MarkDeleted", true), zap.Error(err))
@@ -458,7 +459,7 @@ func (i *IndexCoord) recycleUnusedIndexFiles() {
 					i.metaTable.DeleteIndex(meta.indexMeta.IndexBuildID)
 				} else {
 					for j := 1; j < int(meta.indexMeta.Version); j++ {
-						unusedIndexFilePathPrefix := strconv.Itoa(int(meta.indexMeta.IndexBuildID)) + "/" + strconv.Itoa(j)
+						unusedIndexFilePathPrefix := path.Join(Params.IndexFileRootPath, strconv.Itoa(int(meta.indexMeta.IndexBuildID)), strconv.Itoa(j))
 						if err := i.kv.RemoveWithPrefix(unusedIndexFilePathPrefix); err != nil {
 							log.Debug("IndexCoord recycleUnusedIndexFiles Remove index files failed",
 								zap.Any("MarkDeleted", false), zap.Error(err))
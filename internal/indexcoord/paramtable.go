@@ -38,6 +38,7 @@ type ParamTable struct {
 	MinIOSecretAccessKey string
 	MinIOUseSSL          bool
 	MinioBucketName      string
+	IndexFileRootPath    string
 
 	Log log.Config
 }
@@ -58,6 +59,7 @@ func (pt *ParamTable) Init() {
 		pt.initMinIOSecretAccessKey()
 		pt.initMinIOUseSSL()
 		pt.initMinioBucketName()
+		pt.initIndexFileRootPath()
 	})
 }
 
@@ -144,6 +146,16 @@ func (pt *ParamTable) initMinioBucketName() {
 	pt.MinioBucketName = bucketName
 }
 
+// initIndexFileRootPath sets the root path of the index files in MinIO, it is the
+// same as the one DataCoord collects the unreferenced index files from
+func (pt *ParamTable) initIndexFileRootPath() {
+	rootPath, err := pt.Load("etcd.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	pt.IndexFileRootPath = path.Join(rootPath, "index_files")
+}
+
 func (pt *ParamTable) initLogCfg() {
 	pt.Log = log.Config{}
 	format, err := pt.Load("log.format")
@@ -52,6 +52,7 @@ type ParamTable struct {
 	MinIOSecretAccessKey string
 	MinIOUseSSL          bool
 	MinioBucketName      string
+	IndexFileRootPath    string
 
 	Log log.Config
 }
@@ -77,6 +78,7 @@ func (pt *ParamTable) initParams() {
 	pt.initMinIOSecretAccessKey()
 	pt.initMinIOUseSSL()
 	pt.initMinioBucketName()
+	pt.initIndexFileRootPath()
 	pt.initEtcdEndpoints()
 	pt.initMetaRootPath()
 }
@@ -193,6 +195,16 @@ func (pt *ParamTable) initMinioBucketName() {
 	pt.MinioBucketName = bucketName
 }
 
+// initIndexFileRootPath sets the root path of the index files in MinIO, it is the
+// same as the one DataCoord collects the unreferenced index files from
+func (pt *ParamTable) initIndexFileRootPath() {
+	rootPath, err := pt.Load("etcd.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	pt.IndexFileRootPath = path.Join(rootPath, "index_files")
+}
+
 func (pt *ParamTable) initLogCfg() {
 	pt.Log = log.Config{}
 	format, err := pt.Load("log.format")
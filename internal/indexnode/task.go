@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 	"runtime"
 	"strconv"
 
@@ -89,6 +90,7 @@ type IndexBuildTask struct {
 	kv        kv.BaseKV
 	etcdKV    *etcdkv.EtcdKV
 	savePaths []string
+	saveSize  int64 // total size in bytes of the saved index files
 	req       *indexpb.CreateIndexRequest
 	nodeID    UniqueID
 }
@@ -148,6 +150,7 @@ func (it *IndexBuildTask) checkIndexMeta(ctx context.Context, pre bool) error {
 			return nil
 		}
 		indexMeta.IndexFilePaths = it.savePaths
+		indexMeta.SerializedSize = it.saveSize
 		indexMeta.State = commonpb.IndexState_Finished
 		if it.err != nil {
 			indexMeta.State = commonpb.IndexState_Failed
@@ -333,13 +336,18 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 
 		getSavePathByKey := func(key string) string {
 			// TODO: fix me, use more reasonable method
-			return strconv.Itoa(int(it.req.IndexBuildID)) + "/" + strconv.Itoa(int(it.req.Version)) + "/" + strconv.Itoa(int(partitionID)) + "/" + strconv.Itoa(int(segmentID)) + "/" + key
+			return path.Join(Params.IndexFileRootPath, strconv.Itoa(int(it.req.IndexBuildID)), strconv.Itoa(int(it.req.Version)),
+				strconv.Itoa(int(partitionID)), strconv.Itoa(int(segmentID)), key)
 		}
 		saveBlob := func(path string, value []byte) error {
 			return it.kv.Save(path, string(value))
 		}
 
 		it.savePaths = make([]string, len(serializedIndexBlobs))
+		it.saveSize = 0
+		for _, blob := range serializedIndexBlobs {
+			it.saveSize += int64(len(blob.Value))
+		}
 		saveIndexFile := func(idx int) error {
 			blob := serializedIndexBlobs[idx]
 			key, value := blob.Key, blob.Value
@@ -18,6 +18,7 @@ import (
 
 	"io"
 	"strings"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -103,6 +104,23 @@ func (kv *MinIOKV) LoadWithPrefix(key string) ([]string, []string, error) {
 	return objectsKeys, objectsValues, nil
 }
 
+// ListObjectsWithPrefix lists the keys and last modified times of the objects under the prefix recursively,
+// object values are not loaded.
+func (kv *MinIOKV) ListObjectsWithPrefix(prefix string) ([]string, []time.Time, error) {
+	objects := kv.minioClient.ListObjects(kv.ctx, kv.bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true})
+
+	var objectsKeys []string
+	var modTimes []time.Time
+	for object := range objects {
+		if object.Err != nil {
+			return nil, nil, object.Err
+		}
+		objectsKeys = append(objectsKeys, object.Key)
+		modTimes = append(modTimes, object.LastModified)
+	}
+	return objectsKeys, modTimes, nil
+}
+
 func (kv *MinIOKV) Load(key string) (string, error) {
 	object, err := kv.minioClient.GetObject(kv.ctx, kv.bucketName, key, minio.GetObjectOptions{})
 	if err != nil {
@@ -117,6 +135,11 @@ func (kv *MinIOKV) Load(key string) (string, error) {
 	return buf.String(), nil
 }
 
+// LoadReader opens the object of key as a stream, which is read on demand rather than loaded whole
+func (kv *MinIOKV) LoadReader(key string) (io.ReadCloser, error) {
+	return kv.minioClient.GetObject(kv.ctx, kv.bucketName, key, minio.GetObjectOptions{})
+}
+
 // FGetObject download file from minio to local storage system.
 func (kv *MinIOKV) FGetObject(key, localPath string) error {
 	err := kv.minioClient.FGetObject(kv.ctx, kv.bucketName, key, localPath+key, minio.GetObjectOptions{})
@@ -123,6 +123,34 @@ func TestMinIOKV_MultiSave(t *testing.T) {
 	assert.Equal(t, val, "123")
 }
 
+func TestMinIOKV_ListObjectsWithPrefix(t *testing.T) {
+	Params.Init()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bucketName := "fantastic-tech-test"
+	MinIOKV, err := newMinIOKVClient(ctx, bucketName)
+	assert.Nil(t, err)
+
+	defer MinIOKV.RemoveWithPrefix("")
+
+	kvs := map[string]string{
+		"list/a/key_1":   "123",
+		"list/a/b/key_2": "456",
+		"other/key_3":    "789",
+	}
+	err = MinIOKV.MultiSave(kvs)
+	assert.Nil(t, err)
+
+	keys, modTimes, err := MinIOKV.ListObjectsWithPrefix("list/")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"list/a/key_1", "list/a/b/key_2"}, keys)
+	assert.Equal(t, len(keys), len(modTimes))
+	for _, modTime := range modTimes {
+		assert.False(t, modTime.IsZero())
+	}
+}
+
 func TestMinIOKV_Remove(t *testing.T) {
 	Params.Init()
 
@@ -189,6 +217,36 @@ func TestMinIOKV_Remove(t *testing.T) {
 	assert.Empty(t, val)
 }
 
+func TestMinIOKV_LoadReader(t *testing.T) {
+	Params.Init()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bucketName := "fantastic-tech-test"
+	MinIOKV, err := newMinIOKVClient(ctx, bucketName)
+	assert.Nil(t, err)
+	defer MinIOKV.RemoveWithPrefix("")
+
+	err = MinIOKV.Save("reader/key_1", "123")
+	assert.Nil(t, err)
+
+	reader, err := MinIOKV.LoadReader("reader/key_1")
+	assert.Nil(t, err)
+	content, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, "123", string(content))
+	assert.Nil(t, reader.Close())
+
+	// the object is read lazily, a missing object fails on read
+	reader, err = MinIOKV.LoadReader("reader/not_exist")
+	if err == nil {
+		_, err = ioutil.ReadAll(reader)
+		reader.Close()
+	}
+	assert.NotNil(t, err)
+}
+
 func TestMinIOKV_FGetObject(t *testing.T) {
 	Params.Init()
 	path := "/tmp/milvus/data"
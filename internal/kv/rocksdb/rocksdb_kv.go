@@ -61,22 +61,19 @@ func (kv *RocksdbKV) Load(key string) (string, error) {
 	return string(value.Data()), err
 }
 
-func (kv *RocksdbKV) LoadWithPrefix(key string) ([]string, []string, error) {
-	kv.readOptions.SetPrefixSameAsStart(true)
-	kv.db.Close()
-	kv.opts.SetPrefixExtractor(gorocksdb.NewFixedPrefixTransform(len(key)))
-	var err error
-	kv.db, err = gorocksdb.OpenDb(kv.opts, kv.GetName())
-	if err != nil {
-		return nil, nil, err
-	}
-
-	iter := kv.db.NewIterator(kv.readOptions)
+// LoadWithPrefix returns the keys and values starting with prefix. The keys are iterated in total
+// order, the db is not reopened, so it is safe to call it concurrently with the other methods.
+func (kv *RocksdbKV) LoadWithPrefix(prefix string) ([]string, []string, error) {
+	option := gorocksdb.NewDefaultReadOptions()
+	defer option.Destroy()
+	option.SetFillCache(false)
+
+	iter := kv.db.NewIterator(option)
 	defer iter.Close()
 	keys := make([]string, 0)
 	values := make([]string, 0)
-	iter.Seek([]byte(key))
-	for ; iter.Valid(); iter.Next() {
+	iter.Seek([]byte(prefix))
+	for ; iter.ValidForPrefix([]byte(prefix)); iter.Next() {
 		key := iter.Key()
 		value := iter.Value()
 		keys = append(keys, string(key.Data()))
@@ -117,30 +114,13 @@ func (kv *RocksdbKV) MultiSave(kvs map[string]string) error {
 	return err
 }
 
+// RemoveWithPrefix removes the keys starting with prefix in one write batch
 func (kv *RocksdbKV) RemoveWithPrefix(prefix string) error {
-	kv.readOptions.SetPrefixSameAsStart(true)
-	kv.db.Close()
-	kv.opts.SetPrefixExtractor(gorocksdb.NewFixedPrefixTransform(len(prefix)))
-	var err error
-	kv.db, err = gorocksdb.OpenDb(kv.opts, kv.GetName())
+	keys, _, err := kv.LoadWithPrefix(prefix)
 	if err != nil {
 		return err
 	}
-
-	iter := kv.db.NewIterator(kv.readOptions)
-	defer iter.Close()
-	iter.Seek([]byte(prefix))
-	for ; iter.Valid(); iter.Next() {
-		key := iter.Key()
-		err := kv.db.Delete(kv.writeOptions, key.Data())
-		if err != nil {
-			return nil
-		}
-	}
-	if err := iter.Err(); err != nil {
-		return err
-	}
-	return nil
+	return kv.MultiRemove(keys)
 }
 
 func (kv *RocksdbKV) Remove(key string) error {
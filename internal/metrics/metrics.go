@@ -43,6 +43,78 @@ var (
 	////////////////////////////////////////////////////////////////////////////
 	// for grpc
 
+	// RootCoordCreateDatabaseCounter used to count the num of calls of CreateDatabase
+	RootCoordCreateDatabaseCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "create_database_total",
+			Help:      "Counter of create database",
+		}, []string{"client_id", "type"})
+
+	// RootCoordDropDatabaseCounter used to count the num of calls of DropDatabase
+	RootCoordDropDatabaseCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "drop_database_total",
+			Help:      "Counter of drop database",
+		}, []string{"client_id", "type"})
+
+	// RootCoordListDatabasesCounter used to count the num of calls of ListDatabases
+	RootCoordListDatabasesCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "list_databases_total",
+			Help:      "Counter of list databases",
+		}, []string{"client_id", "type"})
+
+	// RootCoordCreateUserCounter used to count the num of calls of CreateUser
+	RootCoordCreateUserCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "create_user_total",
+			Help:      "Counter of create user",
+		}, []string{"client_id", "type"})
+
+	// RootCoordGrantRoleCounter used to count the num of calls of GrantRole
+	RootCoordGrantRoleCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "grant_role_total",
+			Help:      "Counter of grant role",
+		}, []string{"client_id", "type"})
+
+	// RootCoordRevokeRoleCounter used to count the num of calls of RevokeRole
+	RootCoordRevokeRoleCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "revoke_role_total",
+			Help:      "Counter of revoke role",
+		}, []string{"client_id", "type"})
+
+	// RootCoordListGrantsCounter used to count the num of calls of ListGrants
+	RootCoordListGrantsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "list_grants_total",
+			Help:      "Counter of list grants",
+		}, []string{"client_id", "type"})
+
+	// RootCoordGetCredentialCounter used to count the num of calls of GetCredential
+	RootCoordGetCredentialCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "get_credential_total",
+			Help:      "Counter of get credential",
+		}, []string{"client_id", "type"})
+
 	// RootCoordCreateCollectionCounter used to count the num of calls of CreateCollection
 	RootCoordCreateCollectionCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -88,6 +160,42 @@ var (
 			Help:      "Counter of show collections",
 		}, []string{"client_id", "type"})
 
+	// RootCoordCreateAliasCounter used to count the num of calls of CreateAlias
+	RootCoordCreateAliasCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "create_alias_total",
+			Help:      "Counter of create alias",
+		}, []string{"client_id", "type"})
+
+	// RootCoordDropAliasCounter used to count the num of calls of DropAlias
+	RootCoordDropAliasCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "drop_alias_total",
+			Help:      "Counter of drop alias",
+		}, []string{"client_id", "type"})
+
+	// RootCoordAlterAliasCounter used to count the num of calls of AlterAlias
+	RootCoordAlterAliasCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "alter_alias_total",
+			Help:      "Counter of alter alias",
+		}, []string{"client_id", "type"})
+
+	// RootCoordAddFieldCounter used to count the num of calls of AddField
+	RootCoordAddFieldCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "add_field_total",
+			Help:      "Counter of add field",
+		}, []string{"client_id", "type"})
+
 	// RootCoordCreatePartitionCounter used to count the num of calls of CreatePartition
 	RootCoordCreatePartitionCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -196,11 +304,23 @@ func RegisterRootCoord() {
 	prometheus.MustRegister(RootCoordProxyLister)
 
 	// for grpc
+	prometheus.MustRegister(RootCoordCreateDatabaseCounter)
+	prometheus.MustRegister(RootCoordDropDatabaseCounter)
+	prometheus.MustRegister(RootCoordListDatabasesCounter)
+	prometheus.MustRegister(RootCoordCreateUserCounter)
+	prometheus.MustRegister(RootCoordGrantRoleCounter)
+	prometheus.MustRegister(RootCoordRevokeRoleCounter)
+	prometheus.MustRegister(RootCoordListGrantsCounter)
+	prometheus.MustRegister(RootCoordGetCredentialCounter)
 	prometheus.MustRegister(RootCoordCreateCollectionCounter)
 	prometheus.MustRegister(RootCoordDropCollectionCounter)
 	prometheus.MustRegister(RootCoordHasCollectionCounter)
 	prometheus.MustRegister(RootCoordDescribeCollectionCounter)
 	prometheus.MustRegister(RootCoordShowCollectionsCounter)
+	prometheus.MustRegister(RootCoordCreateAliasCounter)
+	prometheus.MustRegister(RootCoordDropAliasCounter)
+	prometheus.MustRegister(RootCoordAlterAliasCounter)
+	prometheus.MustRegister(RootCoordAddFieldCounter)
 	prometheus.MustRegister(RootCoordCreatePartitionCounter)
 	prometheus.MustRegister(RootCoordDropPartitionCounter)
 	prometheus.MustRegister(RootCoordHasPartitionCounter)
@@ -523,6 +643,15 @@ var (
 			Name:      "release_dql_message_stream_total",
 			Help:      "Counter of release dql message stream",
 		}, []string{"status"})
+
+	// ProxyThrottledCounter used to count the num of requests rejected by rate limits or quotas
+	ProxyThrottledCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "throttled_total",
+			Help:      "Counter of requests rejected by rate limits or quotas",
+		}, []string{"type"})
 )
 
 //RegisterProxy register Proxy metrics
@@ -570,6 +699,8 @@ func RegisterProxy() {
 	prometheus.MustRegister(ProxyGetDdChannelCounter)
 
 	prometheus.MustRegister(ProxyReleaseDQLMessageStreamCounter)
+
+	prometheus.MustRegister(ProxyThrottledCounter)
 }
 
 //RegisterQueryCoord register QueryCoord metrics
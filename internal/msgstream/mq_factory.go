@@ -13,6 +13,7 @@ package msgstream
 
 import (
 	"context"
+	"sync"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/milvus-io/milvus/internal/log"
@@ -117,3 +118,65 @@ func NewRmsFactory(rocksmqPath string) Factory {
 	rocksmqserver.InitRocksMQ(rocksmqPath)
 	return f
 }
+
+// KmsFactory builds msgstreams on kafka, the streams share one kafka client
+type KmsFactory struct {
+	dispatcherFactory ProtoUDFactory
+	brokers           []string
+	clientMu          sync.Mutex
+	client            *mqclient.KafkaClient
+	// the following members must be public, so that mapstructure.Decode() can access them
+	ReceiveBufSize int64
+	KafkaBufSize   int64
+}
+
+func (f *KmsFactory) SetParams(params map[string]interface{}) error {
+	err := mapstructure.Decode(params, f)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (f *KmsFactory) getClient() (*mqclient.KafkaClient, error) {
+	f.clientMu.Lock()
+	defer f.clientMu.Unlock()
+	if f.client == nil {
+		kafkaClient, err := mqclient.NewKafkaClient(f.brokers)
+		if err != nil {
+			return nil, err
+		}
+		f.client = kafkaClient
+	}
+	return f.client, nil
+}
+
+func (f *KmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
+	kafkaClient, err := f.getClient()
+	if err != nil {
+		return nil, err
+	}
+	return NewMqMsgStream(ctx, f.ReceiveBufSize, f.KafkaBufSize, kafkaClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+func (f *KmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
+	kafkaClient, err := f.getClient()
+	if err != nil {
+		return nil, err
+	}
+	return NewMqTtMsgStream(ctx, f.ReceiveBufSize, f.KafkaBufSize, kafkaClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+func (f *KmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
+	return f.NewMsgStream(ctx)
+}
+
+func NewKmsFactory(brokers []string) Factory {
+	f := &KmsFactory{
+		dispatcherFactory: ProtoUDFactory{},
+		brokers:           brokers,
+		ReceiveBufSize:    64,
+		KafkaBufSize:      64,
+	}
+	return f
+}
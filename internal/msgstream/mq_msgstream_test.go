@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Shopify/sarama"
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/stretchr/testify/assert"
 	"go.etcd.io/etcd/clientv3"
@@ -1050,6 +1051,8 @@ func Close(rocksdbName string, intputStream, outputStream MsgStream, etcdKV *etc
 	etcdKV.Close()
 	err := os.RemoveAll(rocksdbName)
 	log.Println(err)
+	err = os.RemoveAll(rocksdbName + "_meta_kv")
+	log.Println(err)
 }
 
 func initRmqStream(producerChannels []string,
@@ -1156,3 +1159,124 @@ func TestStream_RmqTtMsgStream_Insert(t *testing.T) {
 	receiveMsg(outputStream, len(msgPack1.Msgs))
 	Close(rocksdbName, inputStream, outputStream, etcdKV)
 }
+
+// newKafkaMockBroker starts an in-process kafka broker serving msgs of channel from offset 0,
+// the subscriptions of subNames have no committed offset.
+func newKafkaMockBroker(t *testing.T, channel string, msgs []TsMsg, subNames []string) *sarama.MockBroker {
+	broker := sarama.NewMockBroker(t, 1)
+
+	fetchResponse := sarama.NewMockFetchResponse(t, len(msgs)+1).SetVersion(7).
+		SetHighWaterMark(channel, 0, int64(len(msgs)))
+	for i, msg := range msgs {
+		mb, err := msg.Marshal(msg)
+		assert.Nil(t, err)
+		payload, err := ConvertToByteArray(mb)
+		assert.Nil(t, err)
+		fetchResponse.SetMessage(channel, 0, int64(i), sarama.ByteEncoder(payload))
+	}
+	offsetFetchResponse := sarama.NewMockOffsetFetchResponse(t)
+	findCoordinatorResponse := sarama.NewMockFindCoordinatorResponse(t)
+	for _, subName := range subNames {
+		offsetFetchResponse.SetOffset(subName, channel, 0, -1, "", sarama.ErrNoError)
+		findCoordinatorResponse.SetCoordinator(sarama.CoordinatorGroup, subName, broker)
+	}
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader(channel, 0, broker.BrokerID()),
+		"ProduceRequest": sarama.NewMockProduceResponse(t).SetVersion(3),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).SetVersion(1).
+			SetOffset(channel, 0, sarama.OffsetOldest, 0).
+			SetOffset(channel, 0, sarama.OffsetNewest, int64(len(msgs))),
+		"FetchRequest":           fetchResponse,
+		"FindCoordinatorRequest": findCoordinatorResponse,
+		"OffsetFetchRequest":     offsetFetchResponse,
+		"OffsetCommitRequest":    sarama.NewMockOffsetCommitResponse(t),
+	})
+	return broker
+}
+
+func TestStream_KafkaMsgStream_Seek(t *testing.T) {
+	channel := funcutil.RandomString(8)
+	subName := funcutil.RandomString(8)
+	msgs := []TsMsg{
+		getTsMsg(commonpb.MsgType_Insert, 1),
+		getTsMsg(commonpb.MsgType_Insert, 2),
+		getTsMsg(commonpb.MsgType_Insert, 3),
+	}
+	broker := newKafkaMockBroker(t, channel, msgs, []string{subName})
+	defer broker.Close()
+
+	factory := NewKmsFactory([]string{broker.Addr()})
+	err := factory.SetParams(map[string]interface{}{"ReceiveBufSize": 16, "KafkaBufSize": 16})
+	assert.Nil(t, err)
+
+	inputStream, err := factory.NewMsgStream(context.Background())
+	assert.Nil(t, err)
+	inputStream.AsProducer([]string{channel})
+	inputStream.Start()
+	err = inputStream.Produce(getInsertMsgPack([]int{4}))
+	assert.Nil(t, err)
+	inputStream.Close()
+
+	outputStream, err := factory.NewMsgStream(context.Background())
+	assert.Nil(t, err)
+	outputStream.AsConsumer([]string{channel}, subName)
+	err = outputStream.Seek([]*MsgPosition{{ChannelName: channel, MsgID: mqclient.SerializeKafkaID(0, 0)}})
+	assert.Nil(t, err)
+	outputStream.Start()
+	defer outputStream.Close()
+
+	// consumption continues after the message of the position
+	received := make([]Timestamp, 0)
+	for len(received) < 2 {
+		msgPack := outputStream.Consume()
+		for _, msg := range msgPack.Msgs {
+			received = append(received, msg.BeginTs())
+		}
+	}
+	assert.Equal(t, []Timestamp{2, 3}, received)
+}
+
+func TestStream_KafkaTtMsgStream_Seek(t *testing.T) {
+	channel := funcutil.RandomString(8)
+	subName := funcutil.RandomString(8)
+	msgs := []TsMsg{
+		getTimeTickMsg(0),
+		getTsMsg(commonpb.MsgType_Insert, 1),
+		getTsMsg(commonpb.MsgType_Insert, 3),
+		getTimeTickMsg(5),
+		getTsMsg(commonpb.MsgType_Insert, 6),
+		getTimeTickMsg(10),
+	}
+	broker := newKafkaMockBroker(t, channel, msgs, []string{subName})
+	defer broker.Close()
+	factory := NewKmsFactory([]string{broker.Addr()})
+
+	outputStream, err := factory.NewTtMsgStream(context.Background())
+	assert.Nil(t, err)
+	outputStream.AsConsumer([]string{channel}, subName)
+	outputStream.Start()
+	msgPack := outputStream.Consume()
+	assert.EqualValues(t, 5, msgPack.EndTs)
+	assert.Equal(t, 2, len(msgPack.Msgs))
+	outputStream.Close()
+
+	// seek to the time tick of 5, messages before it are not consumed again
+	seekStream, err := factory.NewTtMsgStream(context.Background())
+	assert.Nil(t, err)
+	seekStream.AsConsumer([]string{channel}, subName)
+	err = seekStream.Seek([]*MsgPosition{{
+		ChannelName: channel,
+		MsgID:       mqclient.SerializeKafkaID(0, 3),
+		Timestamp:   5,
+	}})
+	assert.Nil(t, err)
+	seekStream.Start()
+	defer seekStream.Close()
+	msgPack = seekStream.Consume()
+	assert.EqualValues(t, 10, msgPack.EndTs)
+	assert.Equal(t, 1, len(msgPack.Msgs))
+	assert.EqualValues(t, 6, msgPack.Msgs[0].BeginTs())
+}
@@ -854,6 +854,59 @@ func (dp *DropPartitionMsg) Unmarshal(input MarshalType) (TsMsg, error) {
 	return dropPartitionMsg, nil
 }
 
+/////////////////////////////////////////AddField//////////////////////////////////////////
+type AddFieldMsg struct {
+	BaseMsg
+	internalpb.AddFieldRequest
+}
+
+func (af *AddFieldMsg) TraceCtx() context.Context {
+	return af.BaseMsg.Ctx
+}
+
+func (af *AddFieldMsg) SetTraceCtx(ctx context.Context) {
+	af.BaseMsg.Ctx = ctx
+}
+
+func (af *AddFieldMsg) ID() UniqueID {
+	return af.Base.MsgID
+}
+
+func (af *AddFieldMsg) Type() MsgType {
+	return af.Base.MsgType
+}
+
+func (af *AddFieldMsg) SourceID() int64 {
+	return af.Base.SourceID
+}
+
+func (af *AddFieldMsg) Marshal(input TsMsg) (MarshalType, error) {
+	addFieldMsg := input.(*AddFieldMsg)
+	addFieldRequest := &addFieldMsg.AddFieldRequest
+	mb, err := proto.Marshal(addFieldRequest)
+	if err != nil {
+		return nil, err
+	}
+	return mb, nil
+}
+
+func (af *AddFieldMsg) Unmarshal(input MarshalType) (TsMsg, error) {
+	addFieldRequest := internalpb.AddFieldRequest{}
+	in, err := ConvertToByteArray(input)
+	if err != nil {
+		return nil, err
+	}
+	err = proto.Unmarshal(in, &addFieldRequest)
+	if err != nil {
+		return nil, err
+	}
+	addFieldMsg := &AddFieldMsg{AddFieldRequest: addFieldRequest}
+	addFieldMsg.BeginTimestamp = addFieldMsg.Base.Timestamp
+	addFieldMsg.EndTimestamp = addFieldMsg.Base.Timestamp
+
+	return addFieldMsg, nil
+}
+
 /////////////////////////////////////////LoadIndex//////////////////////////////////////////
 type LoadIndexMsg struct {
 	BaseMsg
@@ -84,7 +84,7 @@ func DeleteRepackFunc(tsMsgs []TsMsg, hashKeys [][]int32) (map[int32]*MsgPack, e
 		keys := hashKeys[i]
 
 		timestampLen := len(deleteRequest.Timestamps)
-		primaryKeysLen := len(deleteRequest.PrimaryKeys)
+		primaryKeysLen := len(deleteRequest.PrimaryKeys) + len(deleteRequest.StringPrimaryKeys)
 		keysLen := len(keys)
 
 		if keysLen != timestampLen || keysLen != primaryKeysLen {
@@ -108,7 +108,16 @@ func DeleteRepackFunc(tsMsgs []TsMsg, hashKeys [][]int32) (map[int32]*MsgPack, e
 				CollectionName: deleteRequest.CollectionName,
 				ChannelID:      deleteRequest.ChannelID,
 				Timestamps:     []uint64{deleteRequest.Timestamps[index]},
-				PrimaryKeys:    []int64{deleteRequest.PrimaryKeys[index]},
+				DbName:         deleteRequest.DbName,
+				PartitionName:  deleteRequest.PartitionName,
+				DbID:           deleteRequest.DbID,
+				CollectionID:   deleteRequest.CollectionID,
+				PartitionID:    deleteRequest.PartitionID,
+			}
+			if len(deleteRequest.StringPrimaryKeys) > 0 {
+				sliceRequest.StringPrimaryKeys = []string{deleteRequest.StringPrimaryKeys[index]}
+			} else {
+				sliceRequest.PrimaryKeys = []int64{deleteRequest.PrimaryKeys[index]}
 			}
 
 			deleteMsg := &DeleteMsg{
@@ -60,6 +60,7 @@ func (pudf *ProtoUDFactory) NewUnmarshalDispatcher() *ProtoUnmarshalDispatcher {
 	dropCollectionMsg := DropCollectionMsg{}
 	createPartitionMsg := CreatePartitionMsg{}
 	dropPartitionMsg := DropPartitionMsg{}
+	addFieldMsg := AddFieldMsg{}
 	loadIndexMsg := LoadIndexMsg{}
 	segmentInfoMsg := SegmentInfoMsg{}
 	flushCompletedMsg := FlushCompletedMsg{}
@@ -82,6 +83,7 @@ func (pudf *ProtoUDFactory) NewUnmarshalDispatcher() *ProtoUnmarshalDispatcher {
 	p.TempMap[commonpb.MsgType_DropCollection] = dropCollectionMsg.Unmarshal
 	p.TempMap[commonpb.MsgType_CreatePartition] = createPartitionMsg.Unmarshal
 	p.TempMap[commonpb.MsgType_DropPartition] = dropPartitionMsg.Unmarshal
+	p.TempMap[commonpb.MsgType_AddField] = addFieldMsg.Unmarshal
 	p.TempMap[commonpb.MsgType_LoadIndex] = loadIndexMsg.Unmarshal
 	p.TempMap[commonpb.MsgType_SegmentInfo] = segmentInfoMsg.Unmarshal
 	p.TempMap[commonpb.MsgType_SegmentFlushDone] = flushCompletedMsg.Unmarshal
@@ -23,32 +23,35 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type ErrorCode int32
 
 const (
-	ErrorCode_Success               ErrorCode = 0
-	ErrorCode_UnexpectedError       ErrorCode = 1
-	ErrorCode_ConnectFailed         ErrorCode = 2
-	ErrorCode_PermissionDenied      ErrorCode = 3
-	ErrorCode_CollectionNotExists   ErrorCode = 4
-	ErrorCode_IllegalArgument       ErrorCode = 5
-	ErrorCode_IllegalDimension      ErrorCode = 7
-	ErrorCode_IllegalIndexType      ErrorCode = 8
-	ErrorCode_IllegalCollectionName ErrorCode = 9
-	ErrorCode_IllegalTOPK           ErrorCode = 10
-	ErrorCode_IllegalRowRecord      ErrorCode = 11
-	ErrorCode_IllegalVectorID       ErrorCode = 12
-	ErrorCode_IllegalSearchResult   ErrorCode = 13
-	ErrorCode_FileNotFound          ErrorCode = 14
-	ErrorCode_MetaFailed            ErrorCode = 15
-	ErrorCode_CacheFailed           ErrorCode = 16
-	ErrorCode_CannotCreateFolder    ErrorCode = 17
-	ErrorCode_CannotCreateFile      ErrorCode = 18
-	ErrorCode_CannotDeleteFolder    ErrorCode = 19
-	ErrorCode_CannotDeleteFile      ErrorCode = 20
-	ErrorCode_BuildIndexError       ErrorCode = 21
-	ErrorCode_IllegalNLIST          ErrorCode = 22
-	ErrorCode_IllegalMetricType     ErrorCode = 23
-	ErrorCode_OutOfMemory           ErrorCode = 24
-	ErrorCode_IndexNotExist         ErrorCode = 25
-	ErrorCode_EmptyCollection       ErrorCode = 26
+	ErrorCode_Success                  ErrorCode = 0
+	ErrorCode_UnexpectedError          ErrorCode = 1
+	ErrorCode_ConnectFailed            ErrorCode = 2
+	ErrorCode_PermissionDenied         ErrorCode = 3
+	ErrorCode_CollectionNotExists      ErrorCode = 4
+	ErrorCode_IllegalArgument          ErrorCode = 5
+	ErrorCode_IllegalDimension         ErrorCode = 7
+	ErrorCode_IllegalIndexType         ErrorCode = 8
+	ErrorCode_IllegalCollectionName    ErrorCode = 9
+	ErrorCode_IllegalTOPK              ErrorCode = 10
+	ErrorCode_IllegalRowRecord         ErrorCode = 11
+	ErrorCode_IllegalVectorID          ErrorCode = 12
+	ErrorCode_IllegalSearchResult      ErrorCode = 13
+	ErrorCode_FileNotFound             ErrorCode = 14
+	ErrorCode_MetaFailed               ErrorCode = 15
+	ErrorCode_CacheFailed              ErrorCode = 16
+	ErrorCode_CannotCreateFolder       ErrorCode = 17
+	ErrorCode_CannotCreateFile         ErrorCode = 18
+	ErrorCode_CannotDeleteFolder       ErrorCode = 19
+	ErrorCode_CannotDeleteFile         ErrorCode = 20
+	ErrorCode_BuildIndexError          ErrorCode = 21
+	ErrorCode_IllegalNLIST             ErrorCode = 22
+	ErrorCode_IllegalMetricType        ErrorCode = 23
+	ErrorCode_OutOfMemory              ErrorCode = 24
+	ErrorCode_IndexNotExist            ErrorCode = 25
+	ErrorCode_EmptyCollection          ErrorCode = 26
+	ErrorCode_RateLimit                ErrorCode = 27
+	ErrorCode_QuotaExceeded            ErrorCode = 28
+	ErrorCode_InsufficientMemoryToLoad ErrorCode = 29
 	// internal error code.
 	ErrorCode_DDRequestRace ErrorCode = 1000
 )
@@ -80,37 +83,43 @@ var ErrorCode_name = map[int32]string{
 	24:   "OutOfMemory",
 	25:   "IndexNotExist",
 	26:   "EmptyCollection",
+	27:   "RateLimit",
+	28:   "QuotaExceeded",
+	29:   "InsufficientMemoryToLoad",
 	1000: "DDRequestRace",
 }
 
 var ErrorCode_value = map[string]int32{
-	"Success":               0,
-	"UnexpectedError":       1,
-	"ConnectFailed":         2,
-	"PermissionDenied":      3,
-	"CollectionNotExists":   4,
-	"IllegalArgument":       5,
-	"IllegalDimension":      7,
-	"IllegalIndexType":      8,
-	"IllegalCollectionName": 9,
-	"IllegalTOPK":           10,
-	"IllegalRowRecord":      11,
-	"IllegalVectorID":       12,
-	"IllegalSearchResult":   13,
-	"FileNotFound":          14,
-	"MetaFailed":            15,
-	"CacheFailed":           16,
-	"CannotCreateFolder":    17,
-	"CannotCreateFile":      18,
-	"CannotDeleteFolder":    19,
-	"CannotDeleteFile":      20,
-	"BuildIndexError":       21,
-	"IllegalNLIST":          22,
-	"IllegalMetricType":     23,
-	"OutOfMemory":           24,
-	"IndexNotExist":         25,
-	"EmptyCollection":       26,
-	"DDRequestRace":         1000,
+	"Success":                  0,
+	"UnexpectedError":          1,
+	"ConnectFailed":            2,
+	"PermissionDenied":         3,
+	"CollectionNotExists":      4,
+	"IllegalArgument":          5,
+	"IllegalDimension":         7,
+	"IllegalIndexType":         8,
+	"IllegalCollectionName":    9,
+	"IllegalTOPK":              10,
+	"IllegalRowRecord":         11,
+	"IllegalVectorID":          12,
+	"IllegalSearchResult":      13,
+	"FileNotFound":             14,
+	"MetaFailed":               15,
+	"CacheFailed":              16,
+	"CannotCreateFolder":       17,
+	"CannotCreateFile":         18,
+	"CannotDeleteFolder":       19,
+	"CannotDeleteFile":         20,
+	"BuildIndexError":          21,
+	"IllegalNLIST":             22,
+	"IllegalMetricType":        23,
+	"OutOfMemory":              24,
+	"IndexNotExist":            25,
+	"EmptyCollection":          26,
+	"RateLimit":                27,
+	"QuotaExceeded":            28,
+	"InsufficientMemoryToLoad": 29,
+	"DDRequestRace":            1000,
 }
 
 func (x ErrorCode) String() string {
@@ -155,6 +164,37 @@ func (IndexState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_555bd8c177793206, []int{1}
 }
 
+type ImportState int32
+
+const (
+	ImportState_ImportPending   ImportState = 0
+	ImportState_ImportRunning   ImportState = 1
+	ImportState_ImportCompleted ImportState = 2
+	ImportState_ImportFailed    ImportState = 3
+)
+
+var ImportState_name = map[int32]string{
+	0: "ImportPending",
+	1: "ImportRunning",
+	2: "ImportCompleted",
+	3: "ImportFailed",
+}
+
+var ImportState_value = map[string]int32{
+	"ImportPending":   0,
+	"ImportRunning":   1,
+	"ImportCompleted": 2,
+	"ImportFailed":    3,
+}
+
+func (x ImportState) String() string {
+	return proto.EnumName(ImportState_name, int32(x))
+}
+
+func (ImportState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{2}
+}
+
 type SegmentState int32
 
 const (
@@ -189,13 +229,17 @@ func (x SegmentState) String() string {
 }
 
 func (SegmentState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_555bd8c177793206, []int{2}
+	return fileDescriptor_555bd8c177793206, []int{3}
 }
 
 type MsgType int32
 
 const (
 	MsgType_Undefined MsgType = 0
+	// DEFINITION REQUESTS: DATABASE
+	MsgType_CreateDatabase MsgType = 50
+	MsgType_DropDatabase   MsgType = 51
+	MsgType_ListDatabases  MsgType = 52
 	// DEFINITION REQUESTS: COLLECTION
 	MsgType_CreateCollection   MsgType = 100
 	MsgType_DropCollection     MsgType = 101
@@ -205,6 +249,10 @@ const (
 	MsgType_GetSystemConfigs   MsgType = 105
 	MsgType_LoadCollection     MsgType = 106
 	MsgType_ReleaseCollection  MsgType = 107
+	MsgType_CreateAlias        MsgType = 108
+	MsgType_DropAlias          MsgType = 109
+	MsgType_AlterAlias         MsgType = 110
+	MsgType_AddField           MsgType = 111
 	// DEFINITION REQUESTS: PARTITION
 	MsgType_CreatePartition   MsgType = 200
 	MsgType_DropPartition     MsgType = 201
@@ -228,6 +276,8 @@ const (
 	MsgType_Insert MsgType = 400
 	MsgType_Delete MsgType = 401
 	MsgType_Flush  MsgType = 402
+	MsgType_Import MsgType = 403
+	MsgType_Upsert MsgType = 404
 	// QUERY
 	MsgType_Search                  MsgType = 500
 	MsgType_SearchResult            MsgType = 501
@@ -241,8 +291,17 @@ const (
 	MsgType_RemoveDmChannels        MsgType = 509
 	MsgType_WatchQueryChannels      MsgType = 510
 	MsgType_RemoveQueryChannels     MsgType = 511
+	MsgType_GetImportState          MsgType = 512
+	MsgType_GetReplicas             MsgType = 513
 	// DATA SERVICE
 	MsgType_SegmentInfo MsgType = 600
+	// CREDENTIAL
+	MsgType_CreateUser                MsgType = 1100
+	MsgType_GrantRole                 MsgType = 1101
+	MsgType_RevokeRole                MsgType = 1102
+	MsgType_ListGrants                MsgType = 1103
+	MsgType_GetCredential             MsgType = 1104
+	MsgType_InvalidateCredentialCache MsgType = 1105
 	// SYSTEM CONTROL
 	MsgType_TimeTick          MsgType = 1200
 	MsgType_QueryNodeStats    MsgType = 1201
@@ -257,6 +316,9 @@ const (
 
 var MsgType_name = map[int32]string{
 	0:    "Undefined",
+	50:   "CreateDatabase",
+	51:   "DropDatabase",
+	52:   "ListDatabases",
 	100:  "CreateCollection",
 	101:  "DropCollection",
 	102:  "HasCollection",
@@ -265,6 +327,10 @@ var MsgType_name = map[int32]string{
 	105:  "GetSystemConfigs",
 	106:  "LoadCollection",
 	107:  "ReleaseCollection",
+	108:  "CreateAlias",
+	109:  "DropAlias",
+	110:  "AlterAlias",
+	111:  "AddField",
 	200:  "CreatePartition",
 	201:  "DropPartition",
 	202:  "HasPartition",
@@ -284,6 +350,8 @@ var MsgType_name = map[int32]string{
 	400:  "Insert",
 	401:  "Delete",
 	402:  "Flush",
+	403:  "Import",
+	404:  "Upsert",
 	500:  "Search",
 	501:  "SearchResult",
 	502:  "GetIndexState",
@@ -296,7 +364,15 @@ var MsgType_name = map[int32]string{
 	509:  "RemoveDmChannels",
 	510:  "WatchQueryChannels",
 	511:  "RemoveQueryChannels",
+	512:  "GetImportState",
+	513:  "GetReplicas",
 	600:  "SegmentInfo",
+	1100: "CreateUser",
+	1101: "GrantRole",
+	1102: "RevokeRole",
+	1103: "ListGrants",
+	1104: "GetCredential",
+	1105: "InvalidateCredentialCache",
 	1200: "TimeTick",
 	1201: "QueryNodeStats",
 	1202: "LoadIndex",
@@ -309,56 +385,73 @@ var MsgType_name = map[int32]string{
 }
 
 var MsgType_value = map[string]int32{
-	"Undefined":               0,
-	"CreateCollection":        100,
-	"DropCollection":          101,
-	"HasCollection":           102,
-	"DescribeCollection":      103,
-	"ShowCollections":         104,
-	"GetSystemConfigs":        105,
-	"LoadCollection":          106,
-	"ReleaseCollection":       107,
-	"CreatePartition":         200,
-	"DropPartition":           201,
-	"HasPartition":            202,
-	"DescribePartition":       203,
-	"ShowPartitions":          204,
-	"LoadPartitions":          205,
-	"ReleasePartitions":       206,
-	"ShowSegments":            250,
-	"DescribeSegment":         251,
-	"LoadSegments":            252,
-	"ReleaseSegments":         253,
-	"HandoffSegments":         254,
-	"LoadBalanceSegments":     255,
-	"CreateIndex":             300,
-	"DescribeIndex":           301,
-	"DropIndex":               302,
-	"Insert":                  400,
-	"Delete":                  401,
-	"Flush":                   402,
-	"Search":                  500,
-	"SearchResult":            501,
-	"GetIndexState":           502,
-	"GetIndexBuildProgress":   503,
-	"GetCollectionStatistics": 504,
-	"GetPartitionStatistics":  505,
-	"Retrieve":                506,
-	"RetrieveResult":          507,
-	"WatchDmChannels":         508,
-	"RemoveDmChannels":        509,
-	"WatchQueryChannels":      510,
-	"RemoveQueryChannels":     511,
-	"SegmentInfo":             600,
-	"TimeTick":                1200,
-	"QueryNodeStats":          1201,
-	"LoadIndex":               1202,
-	"RequestID":               1203,
-	"RequestTSO":              1204,
-	"AllocateSegment":         1205,
-	"SegmentStatistics":       1206,
-	"SegmentFlushDone":        1207,
-	"DataNodeTt":              1208,
+	"Undefined":                 0,
+	"CreateDatabase":            50,
+	"DropDatabase":              51,
+	"ListDatabases":             52,
+	"CreateCollection":          100,
+	"DropCollection":            101,
+	"HasCollection":             102,
+	"DescribeCollection":        103,
+	"ShowCollections":           104,
+	"GetSystemConfigs":          105,
+	"LoadCollection":            106,
+	"ReleaseCollection":         107,
+	"CreateAlias":               108,
+	"DropAlias":                 109,
+	"AlterAlias":                110,
+	"AddField":                  111,
+	"CreatePartition":           200,
+	"DropPartition":             201,
+	"HasPartition":              202,
+	"DescribePartition":         203,
+	"ShowPartitions":            204,
+	"LoadPartitions":            205,
+	"ReleasePartitions":         206,
+	"ShowSegments":              250,
+	"DescribeSegment":           251,
+	"LoadSegments":              252,
+	"ReleaseSegments":           253,
+	"HandoffSegments":           254,
+	"LoadBalanceSegments":       255,
+	"CreateIndex":               300,
+	"DescribeIndex":             301,
+	"DropIndex":                 302,
+	"Insert":                    400,
+	"Delete":                    401,
+	"Flush":                     402,
+	"Import":                    403,
+	"Upsert":                    404,
+	"Search":                    500,
+	"SearchResult":              501,
+	"GetIndexState":             502,
+	"GetIndexBuildProgress":     503,
+	"GetCollectionStatistics":   504,
+	"GetPartitionStatistics":    505,
+	"Retrieve":                  506,
+	"RetrieveResult":            507,
+	"WatchDmChannels":           508,
+	"RemoveDmChannels":          509,
+	"WatchQueryChannels":        510,
+	"RemoveQueryChannels":       511,
+	"GetImportState":            512,
+	"GetReplicas":               513,
+	"SegmentInfo":               600,
+	"CreateUser":                1100,
+	"GrantRole":                 1101,
+	"RevokeRole":                1102,
+	"ListGrants":                1103,
+	"GetCredential":             1104,
+	"InvalidateCredentialCache": 1105,
+	"TimeTick":                  1200,
+	"QueryNodeStats":            1201,
+	"LoadIndex":                 1202,
+	"RequestID":                 1203,
+	"RequestTSO":                1204,
+	"AllocateSegment":           1205,
+	"SegmentStatistics":         1206,
+	"SegmentFlushDone":          1207,
+	"DataNodeTt":                1208,
 }
 
 func (x MsgType) String() string {
@@ -366,7 +459,7 @@ func (x MsgType) String() string {
 }
 
 func (MsgType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_555bd8c177793206, []int{3}
+	return fileDescriptor_555bd8c177793206, []int{4}
 }
 
 type DslType int32
@@ -391,7 +484,75 @@ func (x DslType) String() string {
 }
 
 func (DslType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_555bd8c177793206, []int{4}
+	return fileDescriptor_555bd8c177793206, []int{5}
+}
+
+type Privilege int32
+
+const (
+	Privilege_PrivilegeNone   Privilege = 0
+	Privilege_PrivilegeDDL    Privilege = 1
+	Privilege_PrivilegeInsert Privilege = 2
+	Privilege_PrivilegeSearch Privilege = 3
+	Privilege_PrivilegeQuery  Privilege = 4
+	Privilege_PrivilegeAdmin  Privilege = 5
+)
+
+var Privilege_name = map[int32]string{
+	0: "PrivilegeNone",
+	1: "PrivilegeDDL",
+	2: "PrivilegeInsert",
+	3: "PrivilegeSearch",
+	4: "PrivilegeQuery",
+	5: "PrivilegeAdmin",
+}
+
+var Privilege_value = map[string]int32{
+	"PrivilegeNone":   0,
+	"PrivilegeDDL":    1,
+	"PrivilegeInsert": 2,
+	"PrivilegeSearch": 3,
+	"PrivilegeQuery":  4,
+	"PrivilegeAdmin":  5,
+}
+
+func (x Privilege) String() string {
+	return proto.EnumName(Privilege_name, int32(x))
+}
+
+func (Privilege) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{6}
+}
+
+type ConsistencyLevel int32
+
+const (
+	ConsistencyLevel_Strong     ConsistencyLevel = 0
+	ConsistencyLevel_Session    ConsistencyLevel = 1
+	ConsistencyLevel_Bounded    ConsistencyLevel = 2
+	ConsistencyLevel_Eventually ConsistencyLevel = 3
+)
+
+var ConsistencyLevel_name = map[int32]string{
+	0: "Strong",
+	1: "Session",
+	2: "Bounded",
+	3: "Eventually",
+}
+
+var ConsistencyLevel_value = map[string]int32{
+	"Strong":     0,
+	"Session":    1,
+	"Bounded":    2,
+	"Eventually": 3,
+}
+
+func (x ConsistencyLevel) String() string {
+	return proto.EnumName(ConsistencyLevel_name, int32(x))
+}
+
+func (ConsistencyLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{7}
 }
 
 type Status struct {
@@ -680,9 +841,12 @@ func (m *MsgHeader) GetBase() *MsgBase {
 func init() {
 	proto.RegisterEnum("milvus.proto.common.ErrorCode", ErrorCode_name, ErrorCode_value)
 	proto.RegisterEnum("milvus.proto.common.IndexState", IndexState_name, IndexState_value)
+	proto.RegisterEnum("milvus.proto.common.ImportState", ImportState_name, ImportState_value)
 	proto.RegisterEnum("milvus.proto.common.SegmentState", SegmentState_name, SegmentState_value)
 	proto.RegisterEnum("milvus.proto.common.MsgType", MsgType_name, MsgType_value)
 	proto.RegisterEnum("milvus.proto.common.DslType", DslType_name, DslType_value)
+	proto.RegisterEnum("milvus.proto.common.Privilege", Privilege_name, Privilege_value)
+	proto.RegisterEnum("milvus.proto.common.ConsistencyLevel", ConsistencyLevel_name, ConsistencyLevel_value)
 	proto.RegisterType((*Status)(nil), "milvus.proto.common.Status")
 	proto.RegisterType((*KeyValuePair)(nil), "milvus.proto.common.KeyValuePair")
 	proto.RegisterType((*Blob)(nil), "milvus.proto.common.Blob")
@@ -694,86 +858,107 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 1288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xd9, 0x6e, 0xdb, 0x46,
-	0x17, 0xb6, 0x44, 0xd9, 0x32, 0xc7, 0xb2, 0x3d, 0x1e, 0x2f, 0x71, 0xf2, 0x1b, 0x3f, 0x02, 0x5f,
-	0x05, 0x06, 0x62, 0xb7, 0x0d, 0xda, 0x5e, 0xe5, 0x22, 0x16, 0xbd, 0x08, 0x89, 0x97, 0x52, 0x4e,
-	0x5a, 0xf4, 0x26, 0x18, 0x93, 0x47, 0xd2, 0x34, 0xe4, 0x8c, 0x3a, 0x33, 0x74, 0xac, 0xfb, 0x3e,
-	0x40, 0x9b, 0xe7, 0x68, 0x8b, 0xee, 0x2d, 0xfa, 0x04, 0xdd, 0xaf, 0xfb, 0x08, 0x7d, 0x80, 0xae,
-	0x59, 0x8b, 0x33, 0xa4, 0x24, 0x06, 0x48, 0xef, 0x78, 0xbe, 0xb3, 0x7d, 0xf3, 0x9d, 0x39, 0x43,
-	0xd2, 0x88, 0x54, 0x9a, 0x2a, 0xb9, 0xd9, 0xd7, 0xca, 0x2a, 0xb6, 0x98, 0x8a, 0xe4, 0x2c, 0x33,
-	0xb9, 0xb5, 0x99, 0xbb, 0xd6, 0xef, 0x92, 0xa9, 0xb6, 0xe5, 0x36, 0x33, 0xec, 0x3a, 0x21, 0xa0,
-	0xb5, 0xd2, 0x77, 0x23, 0x15, 0xc3, 0x6a, 0xe5, 0x72, 0xe5, 0xca, 0xdc, 0x2b, 0xff, 0xdf, 0x7c,
-	0x41, 0xce, 0xe6, 0x0e, 0x86, 0x35, 0x55, 0x0c, 0xa1, 0x0f, 0xc3, 0x4f, 0xb6, 0x42, 0xa6, 0x34,
-	0x70, 0xa3, 0xe4, 0x6a, 0xf5, 0x72, 0xe5, 0x8a, 0x1f, 0x16, 0xd6, 0xfa, 0x6b, 0xa4, 0x71, 0x13,
-	0x06, 0x77, 0x78, 0x92, 0xc1, 0x31, 0x17, 0x9a, 0x51, 0xe2, 0xdd, 0x83, 0x81, 0xab, 0xef, 0x87,
-	0xf8, 0xc9, 0x96, 0xc8, 0xe4, 0x19, 0xba, 0x8b, 0xc4, 0xdc, 0x58, 0x5f, 0x23, 0xb5, 0xed, 0x44,
-	0x9d, 0x8e, 0xbd, 0x98, 0xd1, 0x18, 0x7a, 0xaf, 0x92, 0xfa, 0x8d, 0x38, 0xd6, 0x60, 0x0c, 0x9b,
-	0x23, 0x55, 0xd1, 0x2f, 0xea, 0x55, 0x45, 0x9f, 0x31, 0x52, 0xeb, 0x2b, 0x6d, 0x5d, 0x35, 0x2f,
-	0x74, 0xdf, 0xeb, 0x0f, 0x2a, 0xa4, 0x7e, 0x60, 0xba, 0xdb, 0xdc, 0x00, 0x7b, 0x9d, 0x4c, 0xa7,
-	0xa6, 0x7b, 0xd7, 0x0e, 0xfa, 0xc3, 0x53, 0xae, 0xbd, 0xf0, 0x94, 0x07, 0xa6, 0x7b, 0x32, 0xe8,
-	0x43, 0x58, 0x4f, 0xf3, 0x0f, 0x64, 0x92, 0x9a, 0x6e, 0x2b, 0x28, 0x2a, 0xe7, 0x06, 0x5b, 0x23,
-	0xbe, 0x15, 0x29, 0x18, 0xcb, 0xd3, 0xfe, 0xaa, 0x77, 0xb9, 0x72, 0xa5, 0x16, 0x8e, 0x01, 0x76,
-	0x89, 0x4c, 0x1b, 0x95, 0xe9, 0x08, 0x5a, 0xc1, 0x6a, 0xcd, 0xa5, 0x8d, 0xec, 0xf5, 0xeb, 0xc4,
-	0x3f, 0x30, 0xdd, 0x7d, 0xe0, 0x31, 0x68, 0xf6, 0x12, 0xa9, 0x9d, 0x72, 0x93, 0x33, 0x9a, 0xf9,
-	0x6f, 0x46, 0x78, 0x82, 0xd0, 0x45, 0x6e, 0x7c, 0x5b, 0x23, 0xfe, 0x68, 0x12, 0x6c, 0x86, 0xd4,
-	0xdb, 0x59, 0x14, 0x81, 0x31, 0x74, 0x82, 0x2d, 0x92, 0xf9, 0xdb, 0x12, 0xce, 0xfb, 0x10, 0x59,
-	0x88, 0x5d, 0x0c, 0xad, 0xb0, 0x05, 0x32, 0xdb, 0x54, 0x52, 0x42, 0x64, 0x77, 0xb9, 0x48, 0x20,
-	0xa6, 0x55, 0xb6, 0x44, 0xe8, 0x31, 0xe8, 0x54, 0x18, 0x23, 0x94, 0x0c, 0x40, 0x0a, 0x88, 0xa9,
-	0xc7, 0x2e, 0x90, 0xc5, 0xa6, 0x4a, 0x12, 0x88, 0xac, 0x50, 0xf2, 0x50, 0xd9, 0x9d, 0x73, 0x61,
-	0xac, 0xa1, 0x35, 0x2c, 0xdb, 0x4a, 0x12, 0xe8, 0xf2, 0xe4, 0x86, 0xee, 0x66, 0x29, 0x48, 0x4b,
-	0x27, 0xb1, 0x46, 0x01, 0x06, 0x22, 0x05, 0x89, 0x95, 0x68, 0xbd, 0x84, 0xb6, 0x64, 0x0c, 0xe7,
-	0xa8, 0x1f, 0x9d, 0x66, 0x17, 0xc9, 0x72, 0x81, 0x96, 0x1a, 0xf0, 0x14, 0xa8, 0xcf, 0xe6, 0xc9,
-	0x4c, 0xe1, 0x3a, 0x39, 0x3a, 0xbe, 0x49, 0x49, 0xa9, 0x42, 0xa8, 0xee, 0x87, 0x10, 0x29, 0x1d,
-	0xd3, 0x99, 0x12, 0x85, 0x3b, 0x10, 0x59, 0xa5, 0x5b, 0x01, 0x6d, 0x20, 0xe1, 0x02, 0x6c, 0x03,
-	0xd7, 0x51, 0x2f, 0x04, 0x93, 0x25, 0x96, 0xce, 0x32, 0x4a, 0x1a, 0xbb, 0x22, 0x81, 0x43, 0x65,
-	0x77, 0x55, 0x26, 0x63, 0x3a, 0xc7, 0xe6, 0x08, 0x39, 0x00, 0xcb, 0x0b, 0x05, 0xe6, 0xb1, 0x6d,
-	0x93, 0x47, 0x3d, 0x28, 0x00, 0xca, 0x56, 0x08, 0x6b, 0x72, 0x29, 0x95, 0x6d, 0x6a, 0xe0, 0x16,
-	0x76, 0x55, 0x12, 0x83, 0xa6, 0x0b, 0x48, 0xe7, 0x39, 0x5c, 0x24, 0x40, 0xd9, 0x38, 0x3a, 0x80,
-	0x04, 0x46, 0xd1, 0x8b, 0xe3, 0xe8, 0x02, 0xc7, 0xe8, 0x25, 0x24, 0xbf, 0x9d, 0x89, 0x24, 0x76,
-	0x92, 0xe4, 0x63, 0x59, 0x46, 0x8e, 0x05, 0xf9, 0xc3, 0x5b, 0xad, 0xf6, 0x09, 0x5d, 0x61, 0xcb,
-	0x64, 0xa1, 0x40, 0x0e, 0xc0, 0x6a, 0x11, 0x39, 0xf1, 0x2e, 0x20, 0xd5, 0xa3, 0xcc, 0x1e, 0x75,
-	0x0e, 0x20, 0x55, 0x7a, 0x40, 0x57, 0x71, 0xa0, 0xae, 0xd2, 0x70, 0x44, 0xf4, 0x22, 0x76, 0xd8,
-	0x49, 0xfb, 0x76, 0x30, 0x96, 0x97, 0x5e, 0x62, 0x8c, 0xcc, 0x06, 0x41, 0x08, 0xef, 0x66, 0x60,
-	0x6c, 0xc8, 0x23, 0xa0, 0xbf, 0xd5, 0x37, 0xde, 0x22, 0xc4, 0xe5, 0xe2, 0xee, 0x03, 0x63, 0x64,
-	0x6e, 0x6c, 0x1d, 0x2a, 0x09, 0x74, 0x82, 0x35, 0xc8, 0xf4, 0x6d, 0x29, 0x8c, 0xc9, 0x20, 0xa6,
-	0x15, 0xd4, 0xad, 0x25, 0x8f, 0xb5, 0xea, 0xe2, 0xca, 0xd1, 0x2a, 0x7a, 0x77, 0x85, 0x14, 0xa6,
-	0xe7, 0x6e, 0x0c, 0x21, 0x53, 0x85, 0x80, 0xb5, 0x8d, 0x0e, 0x69, 0xb4, 0xa1, 0x8b, 0x97, 0x23,
-	0xaf, 0xbd, 0x44, 0x68, 0xd9, 0x1e, 0x57, 0x1f, 0xd1, 0xae, 0xe0, 0xe5, 0xdd, 0xd3, 0xea, 0xbe,
-	0x90, 0x5d, 0x5a, 0xc5, 0x62, 0x6d, 0xe0, 0x89, 0x2b, 0x3c, 0x43, 0xea, 0xbb, 0x49, 0xe6, 0xba,
-	0xd4, 0x5c, 0x4f, 0x34, 0x30, 0x6c, 0x72, 0xe3, 0xbd, 0x69, 0xb7, 0xd2, 0x6e, 0x33, 0x67, 0x89,
-	0x7f, 0x5b, 0xc6, 0xd0, 0x11, 0x12, 0x62, 0x3a, 0xe1, 0xd4, 0x77, 0x53, 0x2a, 0xc9, 0x10, 0xe3,
-	0x21, 0x03, 0xad, 0xfa, 0x25, 0x0c, 0x50, 0xc2, 0x7d, 0x6e, 0x4a, 0x50, 0x07, 0x47, 0x1a, 0x80,
-	0x89, 0xb4, 0x38, 0x2d, 0xa7, 0x77, 0x51, 0xda, 0x76, 0x4f, 0xdd, 0x1f, 0x63, 0x86, 0xf6, 0xb0,
-	0xd3, 0x1e, 0xd8, 0xf6, 0xc0, 0x58, 0x48, 0x9b, 0x4a, 0x76, 0x44, 0xd7, 0x50, 0x81, 0x9d, 0x6e,
-	0x29, 0x1e, 0x97, 0xd2, 0xdf, 0xc1, 0xa1, 0x86, 0x90, 0x00, 0x37, 0xe5, 0xaa, 0xf7, 0xd8, 0x12,
-	0x99, 0xcf, 0xa9, 0x1e, 0x73, 0x6d, 0x85, 0x03, 0xbf, 0xab, 0xb8, 0x89, 0x69, 0xd5, 0x1f, 0x63,
-	0xdf, 0xe3, 0xfa, 0x36, 0xf6, 0xb9, 0x19, 0x43, 0x3f, 0x54, 0xd8, 0x0a, 0x59, 0x18, 0x52, 0x1d,
-	0xe3, 0x3f, 0x56, 0xd8, 0x22, 0x99, 0x43, 0xaa, 0x23, 0xcc, 0xd0, 0x9f, 0x1c, 0x88, 0xa4, 0x4a,
-	0xe0, 0xcf, 0xae, 0x42, 0xc1, 0xaa, 0x84, 0xff, 0xe2, 0x9a, 0x61, 0x85, 0x62, 0x70, 0x86, 0x3e,
-	0xac, 0x20, 0xd3, 0x61, 0xb3, 0x02, 0xa6, 0x8f, 0x5c, 0x20, 0x56, 0x1d, 0x05, 0x3e, 0x76, 0x81,
-	0x45, 0xcd, 0x11, 0xfa, 0xc4, 0xa1, 0xfb, 0x5c, 0xc6, 0xaa, 0xd3, 0x19, 0xa1, 0x4f, 0x2b, 0x6c,
-	0x95, 0x2c, 0x62, 0xfa, 0x36, 0x4f, 0xb8, 0x8c, 0xc6, 0xf1, 0xcf, 0x2a, 0x8c, 0x92, 0x99, 0x5c,
-	0x18, 0x77, 0x31, 0xe9, 0x87, 0x55, 0x27, 0x4a, 0x41, 0x20, 0xc7, 0x3e, 0xaa, 0xb2, 0x39, 0xe2,
-	0xa3, 0x50, 0xb9, 0xfd, 0x71, 0x95, 0xcd, 0x90, 0xa9, 0x96, 0x34, 0xa0, 0x2d, 0x7d, 0x1f, 0x2f,
-	0xcf, 0x54, 0xbe, 0x7e, 0xf4, 0x03, 0xbc, 0xa2, 0x93, 0xee, 0xf2, 0xd0, 0x07, 0xce, 0x91, 0x3f,
-	0x14, 0xf4, 0x77, 0xcf, 0x1d, 0xb5, 0xfc, 0x6a, 0xfc, 0xe1, 0x61, 0xa7, 0x3d, 0xb0, 0xe3, 0x8d,
-	0xa0, 0x7f, 0x7a, 0xec, 0x12, 0x59, 0x1e, 0x62, 0x6e, 0x87, 0x47, 0xbb, 0xf0, 0x97, 0xc7, 0xd6,
-	0xc8, 0x85, 0x3d, 0xb0, 0xe3, 0xb9, 0x62, 0x92, 0x30, 0x56, 0x44, 0x86, 0xfe, 0xed, 0xb1, 0xff,
-	0x91, 0x95, 0x3d, 0xb0, 0x23, 0x7d, 0x4b, 0xce, 0x7f, 0x3c, 0x36, 0x4b, 0xa6, 0x43, 0x5c, 0x72,
-	0x38, 0x03, 0xfa, 0xd0, 0xc3, 0x21, 0x0d, 0xcd, 0x82, 0xce, 0x23, 0x0f, 0xa5, 0x7b, 0x93, 0xdb,
-	0xa8, 0x17, 0xa4, 0xcd, 0x1e, 0x97, 0x12, 0x12, 0x43, 0x1f, 0x7b, 0x6c, 0x99, 0xd0, 0x10, 0x52,
-	0x75, 0x06, 0x25, 0xf8, 0x09, 0x3e, 0xde, 0xcc, 0x05, 0xbf, 0x91, 0x81, 0x1e, 0x8c, 0x1c, 0x4f,
-	0x3d, 0x94, 0x3a, 0x8f, 0x7f, 0xde, 0xf3, 0xcc, 0x43, 0xa9, 0x0b, 0xe5, 0x5b, 0xb2, 0xa3, 0xe8,
-	0xaf, 0x35, 0x64, 0x75, 0x22, 0x52, 0x38, 0x11, 0xd1, 0x3d, 0xfa, 0x89, 0x8f, 0xac, 0x5c, 0xd2,
-	0xa1, 0x8a, 0x01, 0xe9, 0x1b, 0xfa, 0xa9, 0x8f, 0xd2, 0xe3, 0xe8, 0x72, 0xe9, 0x3f, 0x73, 0x76,
-	0xf1, 0xc6, 0xb4, 0x02, 0xfa, 0x39, 0x3e, 0xe8, 0xa4, 0xb0, 0x4f, 0xda, 0x47, 0xf4, 0x0b, 0x1f,
-	0x8f, 0x71, 0x23, 0x49, 0x54, 0xc4, 0xed, 0xe8, 0x02, 0x7d, 0xe9, 0xe3, 0x0d, 0x2c, 0x3d, 0x0f,
-	0x85, 0x30, 0x5f, 0xf9, 0x78, 0xbc, 0x02, 0x77, 0x63, 0x0b, 0xf0, 0xd9, 0xf8, 0xda, 0x55, 0x0d,
-	0xb8, 0xe5, 0xc8, 0xe4, 0xc4, 0xd2, 0x6f, 0xfc, 0x8d, 0x75, 0x52, 0x0f, 0x4c, 0xe2, 0x5e, 0x81,
-	0x3a, 0xf1, 0x02, 0x93, 0xd0, 0x09, 0x7c, 0xac, 0xb6, 0x95, 0x4a, 0x76, 0xce, 0xfb, 0xfa, 0xce,
-	0xcb, 0xb4, 0xb2, 0xfd, 0xea, 0xdb, 0xd7, 0xba, 0xc2, 0xf6, 0xb2, 0x53, 0xfc, 0x91, 0x6e, 0xe5,
-	0x7f, 0xd6, 0xab, 0x42, 0x15, 0x5f, 0x5b, 0x42, 0x5a, 0xd0, 0x92, 0x27, 0x5b, 0xee, 0x67, 0xbb,
-	0x95, 0xff, 0x6c, 0xfb, 0xa7, 0xa7, 0x53, 0xce, 0xbe, 0xf6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe0, 0x24, 0x61, 0xe5, 0x46, 0x09, 0x00, 0x00,
+	// 1625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x56, 0x59, 0x73, 0x1c, 0x49,
+	0x11, 0xf6, 0x1c, 0x92, 0x66, 0x4a, 0x57, 0xba, 0x74, 0x58, 0xf6, 0x8a, 0x0d, 0x87, 0x9e, 0x1c,
+	0x8a, 0x58, 0x1b, 0xd6, 0x1c, 0x4f, 0xfb, 0x20, 0x4d, 0xeb, 0x98, 0x58, 0x49, 0xd6, 0xf6, 0x48,
+	0x86, 0xd8, 0x17, 0x47, 0xa9, 0x3b, 0x67, 0x54, 0xb8, 0xba, 0x6a, 0xa8, 0xaa, 0x1e, 0x6b, 0xde,
+	0x80, 0x5f, 0x00, 0x0b, 0x3f, 0x03, 0x08, 0x60, 0xb9, 0x7e, 0x02, 0xc7, 0xee, 0x02, 0x6f, 0xfc,
+	0x04, 0x7e, 0x00, 0xa7, 0xf7, 0x22, 0xb2, 0xba, 0xa7, 0x67, 0x1c, 0xb1, 0xbc, 0x75, 0x7e, 0x95,
+	0x99, 0x95, 0xf9, 0xe5, 0xd1, 0xc5, 0x96, 0x12, 0x93, 0x65, 0x46, 0x3f, 0x1c, 0x5a, 0xe3, 0x0d,
+	0x5f, 0xcb, 0xa4, 0x1a, 0xe5, 0xae, 0x90, 0x1e, 0x16, 0x47, 0x3b, 0xcf, 0xd8, 0x7c, 0xcf, 0x0b,
+	0x9f, 0x3b, 0xfe, 0x16, 0x63, 0x68, 0xad, 0xb1, 0xcf, 0x12, 0x93, 0xe2, 0x56, 0xed, 0x7e, 0xed,
+	0xc1, 0xca, 0x9b, 0xaf, 0x3f, 0xfc, 0x02, 0x9b, 0x87, 0x07, 0xa4, 0xd6, 0x31, 0x29, 0xc6, 0x6d,
+	0x9c, 0x7c, 0xf2, 0x4d, 0x36, 0x6f, 0x51, 0x38, 0xa3, 0xb7, 0xea, 0xf7, 0x6b, 0x0f, 0xda, 0x71,
+	0x29, 0xed, 0x7c, 0x9d, 0x2d, 0xbd, 0x8d, 0xe3, 0xa7, 0x42, 0xe5, 0x78, 0x2e, 0xa4, 0xe5, 0xc0,
+	0x1a, 0xcf, 0x71, 0x1c, 0xfc, 0xb7, 0x63, 0xfa, 0xe4, 0xeb, 0x6c, 0x6e, 0x44, 0xc7, 0xa5, 0x61,
+	0x21, 0xec, 0x6c, 0xb3, 0xe6, 0xbe, 0x32, 0x57, 0xd3, 0x53, 0xb2, 0x58, 0x9a, 0x9c, 0xbe, 0xc1,
+	0x16, 0xf6, 0xd2, 0xd4, 0xa2, 0x73, 0x7c, 0x85, 0xd5, 0xe5, 0xb0, 0xf4, 0x57, 0x97, 0x43, 0xce,
+	0x59, 0x73, 0x68, 0xac, 0x0f, 0xde, 0x1a, 0x71, 0xf8, 0xde, 0x79, 0xaf, 0xc6, 0x16, 0x4e, 0xdd,
+	0x60, 0x5f, 0x38, 0xe4, 0xdf, 0x60, 0xad, 0xcc, 0x0d, 0x9e, 0xf9, 0xf1, 0x70, 0x92, 0xe5, 0xf6,
+	0x17, 0x66, 0x79, 0xea, 0x06, 0x17, 0xe3, 0x21, 0xc6, 0x0b, 0x59, 0xf1, 0x41, 0x91, 0x64, 0x6e,
+	0xd0, 0x8d, 0x4a, 0xcf, 0x85, 0xc0, 0xb7, 0x59, 0xdb, 0xcb, 0x0c, 0x9d, 0x17, 0xd9, 0x70, 0xab,
+	0x71, 0xbf, 0xf6, 0xa0, 0x19, 0x4f, 0x01, 0x7e, 0x8f, 0xb5, 0x9c, 0xc9, 0x6d, 0x82, 0xdd, 0x68,
+	0xab, 0x19, 0xcc, 0x2a, 0x79, 0xe7, 0x2d, 0xd6, 0x3e, 0x75, 0x83, 0x63, 0x14, 0x29, 0x5a, 0xfe,
+	0x65, 0xd6, 0xbc, 0x12, 0xae, 0x88, 0x68, 0xf1, 0xff, 0x47, 0x44, 0x19, 0xc4, 0x41, 0x73, 0xf7,
+	0x65, 0x93, 0xb5, 0xab, 0x4a, 0xf0, 0x45, 0xb6, 0xd0, 0xcb, 0x93, 0x04, 0x9d, 0x83, 0x5b, 0x7c,
+	0x8d, 0xad, 0x5e, 0x6a, 0xbc, 0x19, 0x62, 0xe2, 0x31, 0x0d, 0x3a, 0x50, 0xe3, 0xb7, 0xd9, 0x72,
+	0xc7, 0x68, 0x8d, 0x89, 0x3f, 0x14, 0x52, 0x61, 0x0a, 0x75, 0xbe, 0xce, 0xe0, 0x1c, 0x6d, 0x26,
+	0x9d, 0x93, 0x46, 0x47, 0xa8, 0x25, 0xa6, 0xd0, 0xe0, 0x77, 0xd8, 0x5a, 0xc7, 0x28, 0x85, 0x89,
+	0x97, 0x46, 0x9f, 0x19, 0x7f, 0x70, 0x23, 0x9d, 0x77, 0xd0, 0x24, 0xb7, 0x5d, 0xa5, 0x70, 0x20,
+	0xd4, 0x9e, 0x1d, 0xe4, 0x19, 0x6a, 0x0f, 0x73, 0xe4, 0xa3, 0x04, 0x23, 0x99, 0xa1, 0x26, 0x4f,
+	0xb0, 0x30, 0x83, 0x76, 0x75, 0x8a, 0x37, 0xc4, 0x1f, 0xb4, 0xf8, 0x5d, 0xb6, 0x51, 0xa2, 0x33,
+	0x17, 0x88, 0x0c, 0xa1, 0xcd, 0x57, 0xd9, 0x62, 0x79, 0x74, 0xf1, 0xe4, 0xfc, 0x6d, 0x60, 0x33,
+	0x1e, 0x62, 0xf3, 0x22, 0xc6, 0xc4, 0xd8, 0x14, 0x16, 0x67, 0x42, 0x78, 0x8a, 0x89, 0x37, 0xb6,
+	0x1b, 0xc1, 0x12, 0x05, 0x5c, 0x82, 0x3d, 0x14, 0x36, 0xb9, 0x8e, 0xd1, 0xe5, 0xca, 0xc3, 0x32,
+	0x07, 0xb6, 0x74, 0x28, 0x15, 0x9e, 0x19, 0x7f, 0x68, 0x72, 0x9d, 0xc2, 0x0a, 0x5f, 0x61, 0xec,
+	0x14, 0xbd, 0x28, 0x19, 0x58, 0xa5, 0x6b, 0x3b, 0x22, 0xb9, 0xc6, 0x12, 0x00, 0xbe, 0xc9, 0x78,
+	0x47, 0x68, 0x6d, 0x7c, 0xc7, 0xa2, 0xf0, 0x78, 0x68, 0x54, 0x8a, 0x16, 0x6e, 0x53, 0x38, 0xaf,
+	0xe0, 0x52, 0x21, 0xf0, 0xa9, 0x76, 0x84, 0x0a, 0x2b, 0xed, 0xb5, 0xa9, 0x76, 0x89, 0x93, 0xf6,
+	0x3a, 0x05, 0xbf, 0x9f, 0x4b, 0x95, 0x06, 0x4a, 0x8a, 0xb2, 0x6c, 0x50, 0x8c, 0x65, 0xf0, 0x67,
+	0x27, 0xdd, 0xde, 0x05, 0x6c, 0xf2, 0x0d, 0x76, 0xbb, 0x44, 0x4e, 0xd1, 0x5b, 0x99, 0x04, 0xf2,
+	0xee, 0x50, 0xa8, 0x4f, 0x72, 0xff, 0xa4, 0x7f, 0x8a, 0x99, 0xb1, 0x63, 0xd8, 0xa2, 0x82, 0x06,
+	0x4f, 0x93, 0x12, 0xc1, 0x5d, 0xba, 0xe1, 0x20, 0x1b, 0xfa, 0xf1, 0x94, 0x5e, 0xb8, 0xc7, 0x97,
+	0x59, 0x3b, 0x16, 0x1e, 0x4f, 0x64, 0x26, 0x3d, 0xbc, 0x46, 0x66, 0xef, 0xe4, 0xc6, 0x8b, 0x83,
+	0x9b, 0x04, 0x31, 0xc5, 0x14, 0xb6, 0xf9, 0x36, 0xdb, 0xea, 0x6a, 0x97, 0xf7, 0xfb, 0x32, 0x91,
+	0xa8, 0x7d, 0x71, 0xc3, 0x85, 0x39, 0x31, 0x22, 0x85, 0x2f, 0x71, 0xce, 0x96, 0xa3, 0x28, 0xc6,
+	0xef, 0xe4, 0xe8, 0x7c, 0x2c, 0x12, 0x84, 0xbf, 0x2f, 0xec, 0x7e, 0x8b, 0xb1, 0x70, 0x37, 0xed,
+	0x0e, 0xe4, 0x9c, 0xad, 0x4c, 0xa5, 0x33, 0xa3, 0x11, 0x6e, 0xf1, 0x25, 0xd6, 0xba, 0xd4, 0xd2,
+	0xb9, 0x1c, 0x53, 0xa8, 0x11, 0xef, 0x5d, 0x7d, 0x6e, 0xcd, 0x80, 0x46, 0x16, 0xea, 0x74, 0x7a,
+	0x28, 0xb5, 0x74, 0xd7, 0xa1, 0xe3, 0x18, 0x9b, 0x2f, 0x0b, 0xd0, 0xdc, 0x7d, 0x97, 0x2d, 0x76,
+	0x33, 0x1a, 0xda, 0xc2, 0x35, 0x25, 0x19, 0xc4, 0x73, 0xd4, 0xa9, 0xd4, 0x03, 0xb8, 0x35, 0x85,
+	0xe2, 0x5c, 0x6b, 0x82, 0x6a, 0xa1, 0x2d, 0x02, 0xd4, 0x31, 0xd9, 0x90, 0x18, 0xa7, 0xee, 0x26,
+	0x66, 0x03, 0x58, 0xfa, 0x6e, 0xec, 0xf6, 0xd9, 0x52, 0x0f, 0x07, 0xd4, 0xb8, 0x85, 0xf3, 0x75,
+	0x06, 0xb3, 0xf2, 0x34, 0xf2, 0x8a, 0xd2, 0x1a, 0x0d, 0xd6, 0x91, 0x35, 0x2f, 0xe8, 0x9e, 0x3a,
+	0x05, 0xda, 0x43, 0x11, 0x9c, 0xd1, 0xc1, 0xa1, 0xca, 0x43, 0x06, 0xcd, 0x90, 0x0f, 0x09, 0xa4,
+	0x36, 0xb7, 0xfb, 0x3e, 0x0b, 0xeb, 0x26, 0x6c, 0x8d, 0x65, 0xd6, 0xbe, 0xd4, 0x29, 0xf6, 0xa5,
+	0xc6, 0x14, 0x6e, 0x11, 0x55, 0x45, 0x07, 0x45, 0xc2, 0x0b, 0x9a, 0x63, 0x78, 0x93, 0x02, 0x8d,
+	0xac, 0x19, 0x56, 0xc8, 0x63, 0x4a, 0xf1, 0x44, 0x3a, 0x3f, 0x41, 0x1c, 0x7c, 0x35, 0xb4, 0x54,
+	0x30, 0x9c, 0xa9, 0x6d, 0x4a, 0xee, 0xc8, 0x74, 0x06, 0x0b, 0x94, 0x1d, 0x0b, 0x37, 0x03, 0xf5,
+	0xa9, 0x4f, 0x23, 0x74, 0x89, 0x95, 0x57, 0xb3, 0xe6, 0x03, 0xe2, 0xad, 0x77, 0x6d, 0x5e, 0x4c,
+	0x31, 0x07, 0xd7, 0x74, 0xd3, 0x11, 0xfa, 0xde, 0xd8, 0x79, 0xcc, 0x3a, 0x46, 0xf7, 0xe5, 0xc0,
+	0x81, 0xa4, 0x9b, 0xa8, 0x1f, 0x66, 0xcc, 0xbf, 0x4d, 0x9d, 0x1a, 0xa3, 0x42, 0xe1, 0x66, 0xbd,
+	0x3e, 0x0f, 0x43, 0x15, 0x42, 0xdd, 0x53, 0x52, 0x38, 0x50, 0xc4, 0x01, 0x45, 0x59, 0x88, 0x19,
+	0x35, 0xc3, 0x9e, 0xf2, 0x68, 0x0b, 0x59, 0x13, 0x79, 0x7b, 0x69, 0x7a, 0x28, 0x51, 0xa5, 0x60,
+	0xf8, 0x3a, 0x5b, 0x2d, 0xac, 0xcf, 0x85, 0xf5, 0x32, 0xb8, 0xfc, 0x7d, 0x2d, 0x34, 0xa1, 0x35,
+	0xc3, 0x29, 0xf6, 0x07, 0xda, 0x68, 0x4b, 0xc7, 0xc2, 0x4d, 0xa1, 0x3f, 0xd6, 0xf8, 0x26, 0xbb,
+	0x3d, 0x49, 0x74, 0x8a, 0xff, 0x89, 0x1a, 0x64, 0x85, 0x12, 0xad, 0x30, 0x07, 0x1f, 0x04, 0x90,
+	0x52, 0x9a, 0x01, 0x3f, 0x0c, 0x1e, 0xca, 0x9c, 0x66, 0xf0, 0x8f, 0xc2, 0x65, 0xe4, 0xa1, 0xec,
+	0x17, 0x07, 0x2f, 0x6b, 0x14, 0xe9, 0xe4, 0xb2, 0x12, 0x86, 0x8f, 0x83, 0x22, 0x79, 0xad, 0x14,
+	0x3f, 0x09, 0x8a, 0xa5, 0xcf, 0x0a, 0xfd, 0x34, 0xa0, 0xc7, 0x42, 0xa7, 0xa6, 0xdf, 0xaf, 0xd0,
+	0xcf, 0x6a, 0x7c, 0x8b, 0xad, 0x91, 0xf9, 0xbe, 0x50, 0x42, 0x27, 0x53, 0xfd, 0xcf, 0x6b, 0x1c,
+	0x26, 0xb4, 0x86, 0x59, 0x83, 0x9f, 0xd4, 0x03, 0x29, 0x65, 0x00, 0x05, 0xf6, 0xd3, 0x3a, 0x5f,
+	0x29, 0xb8, 0x2e, 0xe4, 0x9f, 0xd5, 0xf9, 0x22, 0x9b, 0xef, 0x6a, 0x87, 0xd6, 0xc3, 0x0f, 0xa8,
+	0x67, 0xe7, 0x8b, 0x8d, 0x04, 0x3f, 0xa4, 0xa9, 0x9b, 0x0b, 0x3d, 0x0b, 0xef, 0x85, 0x83, 0x62,
+	0x56, 0xe0, 0x47, 0x41, 0xb8, 0x1c, 0x06, 0x93, 0x1f, 0x07, 0xa1, 0xd8, 0xaa, 0xf0, 0x8f, 0x46,
+	0x20, 0x61, 0x76, 0xc5, 0xfe, 0xb3, 0x41, 0x31, 0x1c, 0xa1, 0x9f, 0x8e, 0x3f, 0xfc, 0xab, 0xc1,
+	0xef, 0xb1, 0x8d, 0x09, 0x16, 0x16, 0x5e, 0x35, 0xf8, 0xff, 0x6e, 0xf0, 0x6d, 0x76, 0xe7, 0x08,
+	0xfd, 0xb4, 0x5f, 0xc8, 0x48, 0x3a, 0x2f, 0x13, 0x07, 0xff, 0x69, 0xf0, 0xd7, 0xd8, 0xe6, 0x11,
+	0xfa, 0x8a, 0xf9, 0x99, 0xc3, 0xff, 0x36, 0xf8, 0x32, 0x6b, 0xc5, 0xb4, 0x11, 0x71, 0x84, 0xf0,
+	0xb2, 0x41, 0xe5, 0x9b, 0x88, 0x65, 0x38, 0x1f, 0x37, 0x88, 0xd4, 0x6f, 0x0a, 0x9f, 0x5c, 0x47,
+	0x59, 0xe7, 0x5a, 0x68, 0x8d, 0xca, 0xc1, 0x27, 0x0d, 0xbe, 0xc1, 0x20, 0xc6, 0xcc, 0x8c, 0x70,
+	0x06, 0xfe, 0x94, 0xfe, 0x74, 0x3c, 0x28, 0xbf, 0x93, 0xa3, 0x1d, 0x57, 0x07, 0x9f, 0x35, 0xa8,
+	0x08, 0x85, 0xfe, 0xab, 0x27, 0x9f, 0x87, 0x4b, 0x29, 0xb5, 0xe9, 0x86, 0x82, 0xef, 0x36, 0xa9,
+	0x32, 0x47, 0xe8, 0x63, 0x1c, 0x2a, 0x99, 0x08, 0x07, 0xdf, 0x0b, 0x48, 0x59, 0xba, 0xae, 0xee,
+	0x1b, 0xf8, 0x5b, 0x93, 0xaf, 0x32, 0x56, 0x54, 0xef, 0xd2, 0xa1, 0x85, 0x0f, 0x5a, 0x54, 0xa8,
+	0x23, 0x2b, 0xb4, 0x8f, 0x8d, 0x42, 0xf8, 0xb0, 0x45, 0x0a, 0x31, 0x8e, 0xcc, 0x73, 0x0c, 0xc0,
+	0x47, 0x01, 0xa0, 0x25, 0x10, 0x94, 0x1c, 0xfc, 0xb9, 0x55, 0x52, 0xdd, 0xb1, 0x98, 0xa2, 0xf6,
+	0x52, 0x28, 0xf8, 0x4b, 0x8b, 0xbf, 0xce, 0xee, 0x76, 0xf5, 0x48, 0x28, 0x99, 0xd2, 0x6a, 0xa8,
+	0x8e, 0xc2, 0x4f, 0x0d, 0xfe, 0xda, 0x22, 0xce, 0x2e, 0x64, 0x86, 0x17, 0x32, 0x79, 0x0e, 0x3f,
+	0x6f, 0x53, 0xf8, 0x21, 0xa5, 0x33, 0x93, 0x22, 0x85, 0xef, 0xe0, 0x17, 0x6d, 0x8a, 0x84, 0x5a,
+	0xae, 0x68, 0x99, 0x5f, 0x06, 0xb9, 0x5c, 0xf7, 0xdd, 0x08, 0xde, 0x6f, 0x17, 0x91, 0x05, 0xf9,
+	0xa2, 0xf7, 0x04, 0x7e, 0xd5, 0x26, 0x92, 0xf7, 0x94, 0x32, 0x89, 0xf0, 0x55, 0xe3, 0xff, 0xba,
+	0x4d, 0x93, 0x33, 0xb3, 0x4d, 0xcb, 0xb2, 0xfd, 0xa6, 0x4d, 0xe4, 0x97, 0x78, 0x68, 0xb7, 0x88,
+	0xb6, 0xec, 0x6f, 0x83, 0x57, 0xda, 0x6f, 0x14, 0xc9, 0x85, 0x87, 0xdf, 0xb5, 0x77, 0x77, 0xd8,
+	0x42, 0xe4, 0x54, 0x58, 0x9a, 0x0b, 0xac, 0x11, 0x39, 0x05, 0xb7, 0x68, 0x55, 0xec, 0x1b, 0xa3,
+	0x0e, 0x6e, 0x86, 0xf6, 0xe9, 0x57, 0xa0, 0xb6, 0xfb, 0xfd, 0x1a, 0x6b, 0x9f, 0x5b, 0x39, 0x92,
+	0x0a, 0x07, 0x61, 0xd3, 0x55, 0x42, 0xb9, 0xbc, 0x81, 0x2d, 0x55, 0x50, 0x14, 0x9d, 0x14, 0xff,
+	0x86, 0x0a, 0x29, 0x27, 0xa1, 0xfe, 0x0a, 0x58, 0xb6, 0x37, 0xb5, 0xf2, 0x4a, 0x05, 0x06, 0x96,
+	0xa0, 0xf9, 0x0a, 0xb6, 0x97, 0x66, 0x52, 0xc3, 0xdc, 0xee, 0x31, 0x83, 0x8e, 0xd1, 0x4e, 0x3a,
+	0x8f, 0x3a, 0x19, 0x9f, 0xe0, 0x08, 0x55, 0xf8, 0x33, 0x78, 0x6b, 0xc2, 0x0f, 0x8a, 0xde, 0x62,
+	0x18, 0xde, 0x54, 0xc5, 0xff, 0x63, 0x9f, 0x1e, 0x1f, 0xe1, 0x97, 0xb4, 0xc2, 0xd8, 0xc1, 0x08,
+	0xb5, 0xcf, 0x85, 0x52, 0x63, 0x68, 0xec, 0x7f, 0xed, 0xdd, 0xc7, 0x03, 0xe9, 0xaf, 0xf3, 0x2b,
+	0x7a, 0xe2, 0x3d, 0x2a, 0xde, 0x7c, 0x6f, 0x48, 0x53, 0x7e, 0x3d, 0x92, 0xda, 0xa3, 0xd5, 0x42,
+	0x3d, 0x0a, 0xcf, 0xc0, 0x47, 0xc5, 0x33, 0x70, 0x78, 0x75, 0x35, 0x1f, 0xe4, 0xc7, 0xff, 0x1b,
+	0x00, 0xbb, 0x83, 0xb5, 0xac, 0xe0, 0x0b, 0x00, 0x00,
 }
@@ -53,6 +53,34 @@ func (ChannelWatchState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_82cd95f524594f49, []int{0}
 }
 
+type CompactionType int32
+
+const (
+	CompactionType_UndefinedCompaction CompactionType = 0
+	CompactionType_InnerCompaction     CompactionType = 1
+	CompactionType_MergeCompaction     CompactionType = 2
+)
+
+var CompactionType_name = map[int32]string{
+	0: "UndefinedCompaction",
+	1: "InnerCompaction",
+	2: "MergeCompaction",
+}
+
+var CompactionType_value = map[string]int32{
+	"UndefinedCompaction": 0,
+	"InnerCompaction":     1,
+	"MergeCompaction":     2,
+}
+
+func (x CompactionType) String() string {
+	return proto.EnumName(CompactionType_name, int32(x))
+}
+
+func (CompactionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{1}
+}
+
 type FlushRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -176,6 +204,7 @@ type SegmentIDRequest struct {
 	ChannelName          string   `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
 	CollectionID         int64    `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,4,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	ImportTaskID         int64    `protobuf:"varint,5,opt,name=import_taskID,json=importTaskID,proto3" json:"import_taskID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -234,6 +263,13 @@ func (m *SegmentIDRequest) GetPartitionID() int64 {
 	return 0
 }
 
+func (m *SegmentIDRequest) GetImportTaskID() int64 {
+	if m != nil {
+		return m.ImportTaskID
+	}
+	return 0
+}
+
 type AssignSegmentIDRequest struct {
 	NodeID               int64               `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	PeerRole             string              `protobuf:"bytes,2,opt,name=peer_role,json=peerRole,proto3" json:"peer_role,omitempty"`
@@ -1566,6 +1602,11 @@ type SegmentInfo struct {
 	MaxRowNum            int64                   `protobuf:"varint,8,opt,name=max_row_num,json=maxRowNum,proto3" json:"max_row_num,omitempty"`
 	LastExpireTime       uint64                  `protobuf:"varint,9,opt,name=last_expire_time,json=lastExpireTime,proto3" json:"last_expire_time,omitempty"`
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,10,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	Deltalogs            []*DeltaLogInfo         `protobuf:"bytes,11,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	CompactionFrom       []int64                 `protobuf:"varint,12,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
+	ImportTaskID         int64                   `protobuf:"varint,13,opt,name=import_taskID,json=importTaskID,proto3" json:"import_taskID,omitempty"`
+	BinlogSize           int64                   `protobuf:"varint,14,opt,name=binlog_size,json=binlogSize,proto3" json:"binlog_size,omitempty"`
+	DropTime             uint64                  `protobuf:"varint,15,opt,name=drop_time,json=dropTime,proto3" json:"drop_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1666,6 +1707,41 @@ func (m *SegmentInfo) GetStartPosition() *internalpb.MsgPosition {
 	return nil
 }
 
+func (m *SegmentInfo) GetDeltalogs() []*DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetCompactionFrom() []int64 {
+	if m != nil {
+		return m.CompactionFrom
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetImportTaskID() int64 {
+	if m != nil {
+		return m.ImportTaskID
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetBinlogSize() int64 {
+	if m != nil {
+		return m.BinlogSize
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetDropTime() uint64 {
+	if m != nil {
+		return m.DropTime
+	}
+	return 0
+}
+
 type ID2PathList struct {
 	ID                   int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	Paths                []string `protobuf:"bytes,2,rep,name=Paths,proto3" json:"Paths,omitempty"`
@@ -1768,6 +1844,8 @@ type SaveBinlogPathsRequest struct {
 	CheckPoints          []*CheckPoint           `protobuf:"bytes,5,rep,name=checkPoints,proto3" json:"checkPoints,omitempty"`
 	StartPositions       []*SegmentStartPosition `protobuf:"bytes,6,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
 	Flushed              bool                    `protobuf:"varint,7,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	Deltalogs            []*DeltaLogInfo         `protobuf:"bytes,8,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	BinlogSize           int64                   `protobuf:"varint,9,opt,name=binlog_size,json=binlogSize,proto3" json:"binlog_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1847,6 +1925,20 @@ func (m *SaveBinlogPathsRequest) GetFlushed() bool {
 	return false
 }
 
+func (m *SaveBinlogPathsRequest) GetDeltalogs() []*DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *SaveBinlogPathsRequest) GetBinlogSize() int64 {
+	if m != nil {
+		return m.BinlogSize
+	}
+	return 0
+}
+
 type CheckPoint struct {
 	SegmentID            int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	Position             *internalpb.MsgPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
@@ -2068,11 +2160,12 @@ func (m *DataNodeInfo) GetChannels() []*ChannelStatus {
 }
 
 type SegmentBinlogs struct {
-	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	FieldBinlogs         []*FieldBinlog `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	SegmentID            int64           `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldBinlogs         []*FieldBinlog  `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
+	Deltalogs            []*DeltaLogInfo `protobuf:"bytes,3,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *SegmentBinlogs) Reset()         { *m = SegmentBinlogs{} }
@@ -2114,6 +2207,13 @@ func (m *SegmentBinlogs) GetFieldBinlogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *SegmentBinlogs) GetDeltalogs() []*DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
 type FieldBinlog struct {
 	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	Binlogs              []string `protobuf:"bytes,2,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
@@ -2161,6 +2261,77 @@ func (m *FieldBinlog) GetBinlogs() []string {
 	return nil
 }
 
+type DeltaLogInfo struct {
+	RecordEntries        uint64   `protobuf:"varint,1,opt,name=record_entries,json=recordEntries,proto3" json:"record_entries,omitempty"`
+	TimestampFrom        uint64   `protobuf:"varint,2,opt,name=timestamp_from,json=timestampFrom,proto3" json:"timestamp_from,omitempty"`
+	TimestampTo          uint64   `protobuf:"varint,3,opt,name=timestamp_to,json=timestampTo,proto3" json:"timestamp_to,omitempty"`
+	DeltaLogPath         string   `protobuf:"bytes,4,opt,name=delta_log_path,json=deltaLogPath,proto3" json:"delta_log_path,omitempty"`
+	DeltaLogSize         int64    `protobuf:"varint,5,opt,name=delta_log_size,json=deltaLogSize,proto3" json:"delta_log_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeltaLogInfo) Reset()         { *m = DeltaLogInfo{} }
+func (m *DeltaLogInfo) String() string { return proto.CompactTextString(m) }
+func (*DeltaLogInfo) ProtoMessage()    {}
+func (*DeltaLogInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{38}
+}
+
+func (m *DeltaLogInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeltaLogInfo.Unmarshal(m, b)
+}
+func (m *DeltaLogInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeltaLogInfo.Marshal(b, m, deterministic)
+}
+func (m *DeltaLogInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeltaLogInfo.Merge(m, src)
+}
+func (m *DeltaLogInfo) XXX_Size() int {
+	return xxx_messageInfo_DeltaLogInfo.Size(m)
+}
+func (m *DeltaLogInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeltaLogInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeltaLogInfo proto.InternalMessageInfo
+
+func (m *DeltaLogInfo) GetRecordEntries() uint64 {
+	if m != nil {
+		return m.RecordEntries
+	}
+	return 0
+}
+
+func (m *DeltaLogInfo) GetTimestampFrom() uint64 {
+	if m != nil {
+		return m.TimestampFrom
+	}
+	return 0
+}
+
+func (m *DeltaLogInfo) GetTimestampTo() uint64 {
+	if m != nil {
+		return m.TimestampTo
+	}
+	return 0
+}
+
+func (m *DeltaLogInfo) GetDeltaLogPath() string {
+	if m != nil {
+		return m.DeltaLogPath
+	}
+	return ""
+}
+
+func (m *DeltaLogInfo) GetDeltaLogSize() int64 {
+	if m != nil {
+		return m.DeltaLogSize
+	}
+	return 0
+}
+
 type GetRecoveryInfoResponse struct {
 	Status               *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Channels             []*VchannelInfo   `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
@@ -2174,7 +2345,7 @@ func (m *GetRecoveryInfoResponse) Reset()         { *m = GetRecoveryInfoResponse
 func (m *GetRecoveryInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoResponse) ProtoMessage()    {}
 func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{38}
+	return fileDescriptor_82cd95f524594f49, []int{39}
 }
 
 func (m *GetRecoveryInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -2229,7 +2400,7 @@ func (m *GetRecoveryInfoRequest) Reset()         { *m = GetRecoveryInfoRequest{}
 func (m *GetRecoveryInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoRequest) ProtoMessage()    {}
 func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{39}
+	return fileDescriptor_82cd95f524594f49, []int{40}
 }
 
 func (m *GetRecoveryInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -2284,7 +2455,7 @@ func (m *GetFlushedSegmentsRequest) Reset()         { *m = GetFlushedSegmentsReq
 func (m *GetFlushedSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsRequest) ProtoMessage()    {}
 func (*GetFlushedSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{40}
+	return fileDescriptor_82cd95f524594f49, []int{41}
 }
 
 func (m *GetFlushedSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2338,7 +2509,7 @@ func (m *GetFlushedSegmentsResponse) Reset()         { *m = GetFlushedSegmentsRe
 func (m *GetFlushedSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsResponse) ProtoMessage()    {}
 func (*GetFlushedSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{41}
+	return fileDescriptor_82cd95f524594f49, []int{42}
 }
 
 func (m *GetFlushedSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -2385,7 +2556,7 @@ func (m *SegmentFlushCompletedMsg) Reset()         { *m = SegmentFlushCompletedM
 func (m *SegmentFlushCompletedMsg) String() string { return proto.CompactTextString(m) }
 func (*SegmentFlushCompletedMsg) ProtoMessage()    {}
 func (*SegmentFlushCompletedMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{42}
+	return fileDescriptor_82cd95f524594f49, []int{43}
 }
 
 func (m *SegmentFlushCompletedMsg) XXX_Unmarshal(b []byte) error {
@@ -2420,277 +2591,921 @@ func (m *SegmentFlushCompletedMsg) GetSegment() *SegmentInfo {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
-	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
-	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
-	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
-	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
-	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
-	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
-	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
-	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
-	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
-	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
-	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
-	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
-	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
-	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
-	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
-	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
-	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
-	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
-	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
-	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
-	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
-	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
-	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
-	proto.RegisterType((*DDLBinlogMeta)(nil), "milvus.proto.data.DDLBinlogMeta")
-	proto.RegisterType((*FieldFlushMeta)(nil), "milvus.proto.data.FieldFlushMeta")
-	proto.RegisterType((*SegmentFlushMeta)(nil), "milvus.proto.data.SegmentFlushMeta")
-	proto.RegisterType((*DDLFlushMeta)(nil), "milvus.proto.data.DDLFlushMeta")
-	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.data.CollectionInfo")
-	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
-	proto.RegisterType((*ID2PathList)(nil), "milvus.proto.data.ID2PathList")
-	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
-	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
-	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
-	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
-	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
-	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
-	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
-	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
-	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
-	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
-	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
-	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
-	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
+type CompactionSegmentBinlogs struct {
+	SegmentID            int64           `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldBinlogs         []*ID2PathList  `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
+	Deltalogs            []*DeltaLogInfo `protobuf:"bytes,3,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
+func (m *CompactionSegmentBinlogs) Reset()         { *m = CompactionSegmentBinlogs{} }
+func (m *CompactionSegmentBinlogs) String() string { return proto.CompactTextString(m) }
+func (*CompactionSegmentBinlogs) ProtoMessage()    {}
+func (*CompactionSegmentBinlogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{44}
+}
 
-var fileDescriptor_82cd95f524594f49 = []byte{
-	// 2025 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x1a, 0x5b, 0x6f, 0x1b, 0x59,
-	0xb9, 0xe3, 0x89, 0x13, 0xfb, 0xf3, 0xd8, 0x49, 0x0f, 0x21, 0x6b, 0xdc, 0x36, 0x4d, 0x87, 0xdd,
-	0x6e, 0xb6, 0xb0, 0xc9, 0xd6, 0x45, 0xe2, 0x52, 0x16, 0xb4, 0x8d, 0xdb, 0xc8, 0x22, 0x29, 0xe1,
-	0xa4, 0xbb, 0x2b, 0xb1, 0x42, 0xd6, 0xc4, 0x73, 0xe2, 0x0c, 0x9d, 0x8b, 0x77, 0xce, 0x38, 0x4d,
-	0x9f, 0x76, 0xb5, 0x48, 0x20, 0x10, 0xe2, 0x22, 0xc4, 0x1b, 0x0f, 0x80, 0x84, 0x84, 0xc4, 0x0b,
-	0xff, 0x80, 0x57, 0x7e, 0x16, 0x9a, 0x73, 0xce, 0x9c, 0xb9, 0xda, 0x9e, 0x3a, 0x74, 0xf3, 0x96,
-	0x73, 0xe6, 0xbb, 0x9d, 0xef, 0xfe, 0x7d, 0x0e, 0xac, 0x99, 0x46, 0x60, 0x0c, 0x86, 0x9e, 0xe7,
-	0x9b, 0x3b, 0x63, 0xdf, 0x0b, 0x3c, 0x74, 0xdd, 0xb1, 0xec, 0xf3, 0x09, 0xe5, 0xa7, 0x9d, 0xf0,
-	0x73, 0x47, 0x1b, 0x7a, 0x8e, 0xe3, 0xb9, 0xfc, 0xaa, 0xd3, 0xb2, 0xdc, 0x80, 0xf8, 0xae, 0x61,
-	0x8b, 0xb3, 0x96, 0x44, 0xe8, 0x68, 0x74, 0x78, 0x46, 0x1c, 0x83, 0x9f, 0xf4, 0x0b, 0xd0, 0x9e,
-	0xd8, 0x13, 0x7a, 0x86, 0xc9, 0xa7, 0x13, 0x42, 0x03, 0xf4, 0x1e, 0x2c, 0x9d, 0x18, 0x94, 0xb4,
-	0x95, 0x2d, 0x65, 0xbb, 0xd1, 0xbd, 0xb9, 0x93, 0xe2, 0x25, 0xb8, 0x1c, 0xd2, 0xd1, 0x23, 0x83,
-	0x12, 0xcc, 0x20, 0x11, 0x82, 0x25, 0xf3, 0xa4, 0xdf, 0x6b, 0x57, 0xb6, 0x94, 0x6d, 0x15, 0xb3,
-	0xbf, 0x91, 0x0e, 0xda, 0xd0, 0xb3, 0x6d, 0x32, 0x0c, 0x2c, 0xcf, 0xed, 0xf7, 0xda, 0x4b, 0xec,
-	0x5b, 0xea, 0x4e, 0xff, 0x8b, 0x02, 0x4d, 0xc1, 0x9a, 0x8e, 0x3d, 0x97, 0x12, 0xf4, 0x00, 0x96,
-	0x69, 0x60, 0x04, 0x13, 0x2a, 0xb8, 0xdf, 0x28, 0xe4, 0x7e, 0xcc, 0x40, 0xb0, 0x00, 0x2d, 0xc5,
-	0x5e, 0xcd, 0xb3, 0x47, 0x9b, 0x00, 0x94, 0x8c, 0x1c, 0xe2, 0x06, 0xfd, 0x1e, 0x6d, 0x2f, 0x6d,
-	0xa9, 0xdb, 0x2a, 0x4e, 0xdc, 0xe8, 0x7f, 0x54, 0x60, 0xed, 0x38, 0x3a, 0x46, 0xda, 0x59, 0x87,
-	0xea, 0xd0, 0x9b, 0xb8, 0x01, 0x13, 0xb0, 0x89, 0xf9, 0x01, 0xdd, 0x01, 0x6d, 0x78, 0x66, 0xb8,
-	0x2e, 0xb1, 0x07, 0xae, 0xe1, 0x10, 0x26, 0x4a, 0x1d, 0x37, 0xc4, 0xdd, 0x53, 0xc3, 0x21, 0xa5,
-	0x24, 0xda, 0x82, 0xc6, 0xd8, 0xf0, 0x03, 0x2b, 0xa5, 0xb3, 0xe4, 0x95, 0xfe, 0x57, 0x05, 0x36,
-	0x3e, 0xa0, 0xd4, 0x1a, 0xb9, 0x39, 0xc9, 0x36, 0x60, 0xd9, 0xf5, 0x4c, 0xd2, 0xef, 0x31, 0xd1,
-	0x54, 0x2c, 0x4e, 0xe8, 0x06, 0xd4, 0xc7, 0x84, 0xf8, 0x03, 0xdf, 0xb3, 0x23, 0xc1, 0x6a, 0xe1,
-	0x05, 0xf6, 0x6c, 0x82, 0x7e, 0x02, 0xd7, 0x69, 0x86, 0x10, 0x6d, 0xab, 0x5b, 0xea, 0x76, 0xa3,
-	0xfb, 0xf5, 0x9d, 0x9c, 0x97, 0xed, 0x64, 0x99, 0xe2, 0x3c, 0xb6, 0xfe, 0x79, 0x05, 0xbe, 0x22,
-	0xe1, 0xb8, 0xac, 0xe1, 0xdf, 0xa1, 0xe6, 0x28, 0x19, 0x49, 0xf1, 0xf8, 0xa1, 0x8c, 0xe6, 0xa4,
-	0xca, 0xd5, 0xa4, 0xca, 0x4b, 0x38, 0x58, 0x56, 0x9f, 0xd5, 0x9c, 0x3e, 0xd1, 0x6d, 0x68, 0x90,
-	0x8b, 0xb1, 0xe5, 0x93, 0x41, 0x60, 0x39, 0xa4, 0xbd, 0xbc, 0xa5, 0x6c, 0x2f, 0x61, 0xe0, 0x57,
-	0xcf, 0x2c, 0x27, 0xe9, 0x91, 0x2b, 0xa5, 0x3d, 0x52, 0xff, 0xbb, 0x02, 0x6f, 0xe4, 0xac, 0x24,
-	0x5c, 0x1c, 0xc3, 0x1a, 0x7b, 0x79, 0xac, 0x99, 0xd0, 0xd9, 0x43, 0x85, 0xdf, 0x9d, 0xa5, 0xf0,
-	0x18, 0x1c, 0xe7, 0xf0, 0x13, 0x42, 0x56, 0xca, 0x0b, 0xf9, 0x1c, 0xde, 0xd8, 0x27, 0x81, 0x60,
-	0x10, 0x7e, 0x23, 0x74, 0xf1, 0x14, 0x90, 0x8e, 0xa5, 0x4a, 0x2e, 0x96, 0xfe, 0x5d, 0x91, 0xb1,
-	0xc4, 0x58, 0xf5, 0xdd, 0x53, 0x0f, 0xdd, 0x84, 0xba, 0x04, 0x11, 0x5e, 0x11, 0x5f, 0xa0, 0x6f,
-	0x43, 0x35, 0x94, 0x94, 0xbb, 0x44, 0xab, 0x7b, 0xa7, 0xf8, 0x4d, 0x09, 0x9a, 0x98, 0xc3, 0xa3,
-	0x3e, 0xb4, 0x68, 0x60, 0xf8, 0xc1, 0x60, 0xec, 0x51, 0x66, 0x67, 0xe6, 0x38, 0x8d, 0xae, 0x9e,
-	0xa6, 0x20, 0x53, 0xe4, 0x21, 0x1d, 0x1d, 0x09, 0x48, 0xdc, 0x64, 0x98, 0xd1, 0x11, 0x3d, 0x06,
-	0x8d, 0xb8, 0x66, 0x4c, 0x68, 0xa9, 0x34, 0xa1, 0x06, 0x71, 0x4d, 0x49, 0x26, 0xb6, 0x4f, 0xb5,
-	0xbc, 0x7d, 0x7e, 0xab, 0x40, 0x3b, 0x6f, 0xa0, 0xcb, 0x24, 0xca, 0x87, 0x1c, 0x89, 0x70, 0x03,
-	0xcd, 0x8c, 0x70, 0x69, 0x24, 0x2c, 0x50, 0x74, 0x0b, 0xbe, 0x1a, 0x4b, 0xc3, 0xbe, 0xbc, 0x36,
-	0x67, 0xf9, 0x85, 0x02, 0x1b, 0x59, 0x5e, 0x97, 0x79, 0xf7, 0xb7, 0xa0, 0x6a, 0xb9, 0xa7, 0x5e,
-	0xf4, 0xec, 0xcd, 0x19, 0x71, 0x16, 0xf2, 0xe2, 0xc0, 0xba, 0x03, 0x37, 0xf6, 0x49, 0xd0, 0x77,
-	0x29, 0xf1, 0x83, 0x47, 0x96, 0x6b, 0x7b, 0xa3, 0x23, 0x23, 0x38, 0xbb, 0x44, 0x8c, 0xa4, 0xdc,
-	0xbd, 0x92, 0x71, 0x77, 0xfd, 0x9f, 0x0a, 0xdc, 0x2c, 0xe6, 0x27, 0x9e, 0xde, 0x81, 0xda, 0xa9,
-	0x45, 0x6c, 0x33, 0xd4, 0x99, 0xc2, 0x74, 0x26, 0xcf, 0x61, 0xac, 0x8c, 0x43, 0x60, 0xf1, 0xc2,
-	0x3b, 0x53, 0x1c, 0xf4, 0x38, 0xf0, 0x2d, 0x77, 0x74, 0x60, 0xd1, 0x00, 0x73, 0xf8, 0x84, 0x3e,
-	0xd5, 0xf2, 0x9e, 0xf9, 0x1b, 0x05, 0x36, 0xf7, 0x49, 0xb0, 0x27, 0x53, 0x6d, 0xf8, 0xdd, 0xa2,
-	0x81, 0x35, 0xa4, 0xaf, 0xb7, 0x89, 0x28, 0xa8, 0x99, 0xfa, 0xef, 0x15, 0xb8, 0x3d, 0x55, 0x18,
-	0xa1, 0x3a, 0x91, 0x4a, 0xa2, 0x44, 0x5b, 0x9c, 0x4a, 0x7e, 0x44, 0x5e, 0x7e, 0x64, 0xd8, 0x13,
-	0x72, 0x64, 0x58, 0x3e, 0x4f, 0x25, 0x0b, 0x26, 0xd6, 0x7f, 0x29, 0x70, 0x6b, 0x9f, 0x04, 0x47,
-	0x51, 0x99, 0xb9, 0x42, 0xed, 0x94, 0xe8, 0x28, 0x7e, 0xc7, 0x8d, 0x59, 0x28, 0xed, 0x95, 0xa8,
-	0x6f, 0x93, 0xc5, 0x41, 0x22, 0x20, 0xf7, 0x78, 0x2f, 0x20, 0x94, 0xa7, 0xff, 0xb9, 0x02, 0xda,
-	0x47, 0xa2, 0x3f, 0x60, 0x65, 0x24, 0xab, 0x07, 0xa5, 0x58, 0x0f, 0x89, 0x96, 0xa2, 0xa8, 0xcb,
-	0xd8, 0x87, 0x26, 0x25, 0xe4, 0xf9, 0x22, 0x45, 0x43, 0x0b, 0x11, 0x65, 0xb2, 0x3f, 0x80, 0xeb,
-	0x13, 0xf7, 0x34, 0x6c, 0x6b, 0x89, 0x29, 0x5e, 0xc1, 0xbb, 0xcb, 0xf9, 0x99, 0x27, 0x8f, 0x88,
-	0xb6, 0x61, 0x35, 0x4b, 0xab, 0xca, 0x82, 0x3f, 0x7b, 0xad, 0xff, 0x5a, 0x81, 0x8d, 0x8f, 0x8d,
-	0x60, 0x78, 0xd6, 0x73, 0x84, 0xc6, 0x2e, 0xe1, 0x6f, 0xef, 0x43, 0xfd, 0x5c, 0x68, 0x27, 0x4a,
-	0x2a, 0xb7, 0x0b, 0x84, 0x4f, 0xda, 0x01, 0xc7, 0x18, 0x61, 0x9b, 0xba, 0xce, 0x3a, 0xfb, 0x48,
-	0xba, 0x2f, 0xdf, 0xf3, 0xe7, 0x75, 0xf7, 0x17, 0x00, 0x42, 0xb8, 0x43, 0x3a, 0x5a, 0x40, 0xae,
-	0xef, 0xc0, 0x8a, 0xa0, 0x26, 0x9c, 0x7b, 0x9e, 0x71, 0x23, 0x70, 0xfd, 0x18, 0x36, 0xc4, 0xfd,
-	0x93, 0x30, 0x7f, 0xf3, 0x5c, 0x7f, 0x48, 0x02, 0x03, 0xb5, 0x61, 0x45, 0xa4, 0x74, 0xe1, 0xc4,
-	0xd1, 0x31, 0xec, 0x53, 0x4f, 0x18, 0xdc, 0x20, 0xcc, 0xdb, 0xc2, 0x7f, 0xe1, 0x44, 0x96, 0x09,
-	0xfd, 0x67, 0xd0, 0xec, 0xf5, 0x0e, 0x12, 0xb4, 0xee, 0xc2, 0xaa, 0x69, 0xda, 0x83, 0x24, 0x96,
-	0xc2, 0xb0, 0x9a, 0xa6, 0x69, 0xc7, 0xf5, 0x05, 0xbd, 0x09, 0xad, 0x80, 0x0e, 0xf2, 0xc4, 0xb5,
-	0x80, 0xc6, 0x50, 0xfa, 0x21, 0xb4, 0x98, 0xb0, 0xcc, 0xa8, 0x73, 0x64, 0xbd, 0x03, 0x5a, 0x82,
-	0x1c, 0x77, 0x9f, 0x3a, 0x6e, 0xc4, 0xc2, 0xb2, 0x0a, 0x12, 0xb5, 0x83, 0x31, 0xc5, 0xd9, 0xed,
-	0xe0, 0x2d, 0x00, 0x8b, 0x0e, 0x84, 0xd3, 0x33, 0x19, 0x6b, 0xb8, 0x6e, 0xd1, 0x27, 0xfc, 0x02,
-	0x7d, 0x17, 0x96, 0x19, 0x7f, 0x1e, 0x1e, 0xb9, 0x24, 0xc5, 0xac, 0x91, 0x7e, 0x01, 0x16, 0x08,
-	0xfa, 0x87, 0xa0, 0xf5, 0x7a, 0x07, 0xb1, 0x1c, 0x65, 0xf2, 0x49, 0x89, 0x37, 0x7e, 0x06, 0xad,
-	0xb8, 0x28, 0xb1, 0x44, 0xd5, 0x82, 0x8a, 0x24, 0x57, 0xe9, 0xf7, 0xd0, 0xfb, 0xb0, 0xcc, 0x27,
-	0x71, 0xe1, 0x41, 0x6f, 0xa5, 0x65, 0x16, 0x53, 0x7a, 0xa2, 0xb2, 0xb1, 0x0b, 0x2c, 0x90, 0x42,
-	0x0f, 0x97, 0x89, 0x9c, 0x0f, 0x6d, 0x2a, 0x4e, 0xdc, 0xe8, 0xff, 0x51, 0xa1, 0x91, 0x70, 0xc0,
-	0x1c, 0xfb, 0xec, 0x3b, 0x2b, 0xf3, 0xeb, 0x87, 0x9a, 0x9f, 0xa0, 0xde, 0x82, 0x96, 0xc5, 0x7a,
-	0x96, 0x81, 0x88, 0x7e, 0x56, 0x64, 0xea, 0xb8, 0xc9, 0x6f, 0x45, 0x2a, 0x42, 0x9b, 0xd0, 0x70,
-	0x27, 0xce, 0xc0, 0x3b, 0x1d, 0xf8, 0xde, 0x0b, 0x2a, 0x46, 0xb1, 0xba, 0x3b, 0x71, 0x7e, 0x7c,
-	0x8a, 0xbd, 0x17, 0x34, 0xee, 0xf6, 0x97, 0x5f, 0xb1, 0xdb, 0x7f, 0x0c, 0x9a, 0xe9, 0xd8, 0x71,
-	0xda, 0x5e, 0x29, 0xdf, 0xa2, 0x9b, 0x8e, 0x2d, 0xb3, 0xf6, 0x26, 0x34, 0x1c, 0xe3, 0x22, 0x14,
-	0x6e, 0xe0, 0x4e, 0x9c, 0x76, 0x8d, 0xcb, 0xe7, 0x18, 0x17, 0xd8, 0x7b, 0xf1, 0x74, 0xe2, 0xa0,
-	0x6d, 0x58, 0xb3, 0x0d, 0x1a, 0x0c, 0x92, 0xd3, 0x62, 0x9d, 0x4d, 0x8b, 0xad, 0xf0, 0xfe, 0x71,
-	0x3c, 0x31, 0xe6, 0xc7, 0x0f, 0x58, 0x70, 0xfc, 0xd0, 0x1f, 0x40, 0xa3, 0xdf, 0xeb, 0x86, 0xee,
-	0x14, 0xf6, 0x6c, 0x39, 0x03, 0xae, 0x43, 0xf5, 0x28, 0xe1, 0x7d, 0xd5, 0xc8, 0xef, 0xd6, 0x63,
-	0x3d, 0x25, 0x66, 0x99, 0xbc, 0x5c, 0xca, 0xa2, 0x63, 0xd1, 0xec, 0x4e, 0xf6, 0x57, 0x2a, 0x6c,
-	0x1c, 0x1b, 0xe7, 0xe4, 0xf5, 0x37, 0xcd, 0xa5, 0x0a, 0xc1, 0x01, 0x5c, 0x67, 0x81, 0xde, 0x4d,
-	0xc8, 0x33, 0xa3, 0x1e, 0x27, 0x14, 0x8e, 0xf3, 0x88, 0xe8, 0x87, 0x61, 0x23, 0x41, 0x86, 0xcf,
-	0x8f, 0x3c, 0x2b, 0xaa, 0xc5, 0x8d, 0xee, 0xad, 0x02, 0x3a, 0x7b, 0x12, 0x0a, 0x27, 0x31, 0xd0,
-	0x11, 0xac, 0xa6, 0xcd, 0x40, 0xdb, 0xcb, 0x8c, 0xc8, 0xdb, 0x33, 0xa7, 0xb1, 0x58, 0xfb, 0xb8,
-	0x95, 0x32, 0x06, 0x65, 0x99, 0x58, 0xa4, 0xc5, 0x15, 0x96, 0x16, 0xa3, 0x63, 0x98, 0x66, 0x21,
-	0x96, 0x63, 0x4e, 0x82, 0xfd, 0x01, 0xd4, 0xa4, 0x67, 0x54, 0x4a, 0x7b, 0x86, 0xc4, 0xc9, 0x46,
-	0xb8, 0x9a, 0x89, 0x70, 0xfd, 0x0b, 0x05, 0x9a, 0x3d, 0x23, 0x30, 0x9e, 0x7a, 0x26, 0x79, 0xb6,
-	0x60, 0xd1, 0x2d, 0xb1, 0x2d, 0xba, 0x09, 0xf5, 0x30, 0x38, 0x69, 0x60, 0x38, 0x63, 0x26, 0xc4,
-	0x12, 0x8e, 0x2f, 0xc2, 0xd1, 0xb2, 0x29, 0x52, 0xd2, 0xb1, 0xdc, 0x1e, 0x32, 0x52, 0xbc, 0x38,
-	0xb2, 0xbf, 0xd1, 0xf7, 0xd2, 0xab, 0x87, 0x37, 0x0b, 0xcd, 0xcb, 0x88, 0xb0, 0x86, 0x2b, 0x95,
-	0x8f, 0xca, 0xcc, 0x2c, 0x9f, 0x2b, 0xa0, 0x45, 0xaa, 0x60, 0xa9, 0xb9, 0x0d, 0x2b, 0x86, 0x69,
-	0xfa, 0x84, 0x52, 0x21, 0x47, 0x74, 0x0c, 0xbf, 0x9c, 0x13, 0x9f, 0x46, 0x46, 0x51, 0x71, 0x74,
-	0x44, 0xdf, 0x87, 0x9a, 0xec, 0xd0, 0xf8, 0xc6, 0x6e, 0x6b, 0xba, 0x9c, 0xa2, 0xc7, 0x96, 0x18,
-	0xba, 0x0f, 0x2d, 0xe1, 0x5c, 0xdc, 0xbb, 0xe9, 0x1c, 0xef, 0x78, 0x04, 0xda, 0x69, 0xdc, 0xad,
-	0xcc, 0x1a, 0xa5, 0x13, 0x4d, 0x0d, 0x4e, 0xe1, 0xe8, 0x1f, 0x40, 0x23, 0xf1, 0x71, 0x46, 0x07,
-	0xd1, 0x86, 0x95, 0x93, 0x04, 0x9f, 0x3a, 0x8e, 0x8e, 0xfa, 0x7f, 0x15, 0xb6, 0xb5, 0xc2, 0x64,
-	0xe8, 0x9d, 0x13, 0xff, 0xe5, 0xe5, 0x77, 0x03, 0x0f, 0x13, 0x5a, 0x2c, 0xd9, 0xe7, 0x4a, 0x04,
-	0xf4, 0x30, 0x96, 0x53, 0x9d, 0xda, 0x75, 0xa4, 0xd5, 0x1c, 0x3f, 0xe5, 0x0f, 0x7c, 0xcb, 0x91,
-	0x7e, 0xca, 0xa2, 0x69, 0xf2, 0xff, 0x52, 0xcb, 0xf5, 0x3f, 0x29, 0xf0, 0xb5, 0x7d, 0x12, 0x3c,
-	0x49, 0x4f, 0x16, 0x57, 0x2d, 0x95, 0x03, 0x9d, 0x22, 0xa1, 0x2e, 0x63, 0xf5, 0x0e, 0xd4, 0x68,
-	0x34, 0x4e, 0xf1, 0xfd, 0x93, 0x3c, 0xeb, 0xbf, 0x54, 0xa0, 0x9d, 0xec, 0x4d, 0xf7, 0x3c, 0x67,
-	0x6c, 0x93, 0x80, 0x98, 0x5f, 0xf2, 0x9c, 0x70, 0xef, 0x3e, 0x5c, 0xcf, 0x65, 0x19, 0xd4, 0x02,
-	0xf8, 0xd0, 0x1d, 0x0a, 0x91, 0xd6, 0xae, 0x21, 0x0d, 0x6a, 0x91, 0x80, 0x6b, 0x4a, 0xf7, 0x6f,
-	0x1a, 0xd4, 0xc3, 0xc4, 0xb2, 0xe7, 0x79, 0xbe, 0x89, 0xc6, 0x80, 0xd8, 0x66, 0xc4, 0x19, 0x7b,
-	0xae, 0x5c, 0x21, 0xa2, 0xf7, 0xa6, 0x64, 0xf5, 0x3c, 0xa8, 0x30, 0x7c, 0xe7, 0xee, 0x14, 0x8c,
-	0x0c, 0xb8, 0x7e, 0x0d, 0x39, 0x8c, 0x63, 0xd8, 0x06, 0x3d, 0xb3, 0x86, 0xcf, 0xa3, 0xde, 0x6f,
-	0x06, 0xc7, 0x0c, 0x68, 0xc4, 0x31, 0xb3, 0x99, 0x14, 0x07, 0xbe, 0xbe, 0x8a, 0x2c, 0xaf, 0x5f,
-	0x43, 0x9f, 0xc2, 0xfa, 0x3e, 0x09, 0xe2, 0x8d, 0x45, 0xc4, 0xb0, 0x3b, 0x9d, 0x61, 0x0e, 0xf8,
-	0x15, 0x59, 0x1e, 0x40, 0x95, 0x79, 0x05, 0x2a, 0x4a, 0x13, 0xc9, 0xdf, 0xd1, 0x3a, 0x5b, 0xd3,
-	0x01, 0x24, 0xb5, 0x9f, 0xc3, 0x6a, 0xe6, 0x77, 0x02, 0xf4, 0x4e, 0x01, 0x5a, 0xf1, 0x2f, 0x3e,
-	0x9d, 0x7b, 0x65, 0x40, 0x25, 0xaf, 0x11, 0xb4, 0xd2, 0x7b, 0x15, 0xb4, 0x5d, 0x80, 0x5f, 0xb8,
-	0xe3, 0xed, 0xbc, 0x53, 0x02, 0x52, 0x32, 0x72, 0x60, 0x2d, 0xbb, 0xb7, 0x46, 0xf7, 0x66, 0x12,
-	0x48, 0xbb, 0xdb, 0x37, 0x4a, 0xc1, 0x4a, 0x76, 0x2f, 0x99, 0x13, 0xe4, 0xf6, 0xa6, 0x68, 0xa7,
-	0x98, 0xcc, 0xb4, 0x85, 0x6e, 0x67, 0xb7, 0x34, 0xbc, 0x64, 0xfd, 0x05, 0xaf, 0x46, 0x45, 0xbb,
-	0x47, 0x74, 0xbf, 0x98, 0xdc, 0x8c, 0xa5, 0x69, 0xa7, 0xfb, 0x2a, 0x28, 0x52, 0x88, 0xcf, 0x58,
-	0x19, 0x29, 0xd8, 0xdf, 0x65, 0xe3, 0x2e, 0xa2, 0x37, 0x7d, 0x31, 0xd9, 0xb9, 0xff, 0x0a, 0x18,
-	0x52, 0x00, 0x2f, 0xfb, 0xcb, 0x40, 0x14, 0x86, 0xbb, 0x73, 0xbd, 0x66, 0xb1, 0x18, 0xfc, 0x04,
-	0x56, 0x33, 0xf3, 0x45, 0x61, 0xd4, 0x14, 0xcf, 0x20, 0x9d, 0x59, 0x05, 0x82, 0x87, 0x64, 0xa6,
-	0x2a, 0xa3, 0x29, 0xde, 0x5f, 0x50, 0xb9, 0x3b, 0xf7, 0xca, 0x80, 0xca, 0x87, 0x50, 0x96, 0x2e,
-	0x33, 0x95, 0x0d, 0x7d, 0xb3, 0x98, 0x46, 0x71, 0x55, 0xee, 0xbc, 0x5b, 0x12, 0x3a, 0x62, 0xda,
-	0xfd, 0x87, 0x0a, 0xb5, 0xa8, 0xf9, 0xbc, 0x82, 0x12, 0x71, 0x05, 0x39, 0xfb, 0x13, 0x58, 0xcd,
-	0x2c, 0x46, 0x0b, 0x4d, 0x5a, 0xbc, 0x3c, 0x9d, 0xe7, 0x2f, 0x1f, 0x8b, 0xff, 0x61, 0x90, 0xe6,
-	0x7b, 0x7b, 0x5a, 0xde, 0xcf, 0x5a, 0x6e, 0x36, 0xe1, 0x47, 0x0f, 0x7e, 0x7a, 0x7f, 0x64, 0x05,
-	0x67, 0x93, 0x93, 0xf0, 0xcb, 0x2e, 0x07, 0x7d, 0xd7, 0xf2, 0xc4, 0x5f, 0xbb, 0x91, 0x82, 0x76,
-	0x19, 0xf6, 0x6e, 0xc8, 0x66, 0x7c, 0x72, 0xb2, 0xcc, 0x4e, 0x0f, 0xfe, 0x17, 0x00, 0x00, 0xff,
-	0xff, 0x9c, 0xc7, 0x52, 0x4b, 0x34, 0x22, 0x00, 0x00,
+func (m *CompactionSegmentBinlogs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionSegmentBinlogs.Unmarshal(m, b)
+}
+func (m *CompactionSegmentBinlogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionSegmentBinlogs.Marshal(b, m, deterministic)
+}
+func (m *CompactionSegmentBinlogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionSegmentBinlogs.Merge(m, src)
+}
+func (m *CompactionSegmentBinlogs) XXX_Size() int {
+	return xxx_messageInfo_CompactionSegmentBinlogs.Size(m)
+}
+func (m *CompactionSegmentBinlogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionSegmentBinlogs.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_CompactionSegmentBinlogs proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *CompactionSegmentBinlogs) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
 
-// DataCoordClient is the client API for DataCoord service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DataCoordClient interface {
-	GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error)
-	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
-	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
-	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
-	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
-	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
-	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
-	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
-	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
-	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
+func (m *CompactionSegmentBinlogs) GetFieldBinlogs() []*ID2PathList {
+	if m != nil {
+		return m.FieldBinlogs
+	}
+	return nil
 }
 
-type dataCoordClient struct {
-	cc *grpc.ClientConn
+func (m *CompactionSegmentBinlogs) GetDeltalogs() []*DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
 }
 
-func NewDataCoordClient(cc *grpc.ClientConn) DataCoordClient {
-	return &dataCoordClient{cc}
+type CompactionPlan struct {
+	Base                 *commonpb.MsgBase           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	PlanID               int64                       `protobuf:"varint,2,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentBinlogs       []*CompactionSegmentBinlogs `protobuf:"bytes,3,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
+	StartTime            uint64                      `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TimeoutInSeconds     int32                       `protobuf:"varint,5,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
+	Type                 CompactionType              `protobuf:"varint,6,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
+	Timetravel           uint64                      `protobuf:"varint,7,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	Channel              string                      `protobuf:"bytes,8,opt,name=channel,proto3" json:"channel,omitempty"`
+	CollectionID         int64                       `protobuf:"varint,9,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64                       `protobuf:"varint,10,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	TargetSegmentID      int64                       `protobuf:"varint,11,opt,name=targetSegmentID,proto3" json:"targetSegmentID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
-func (c *dataCoordClient) GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error) {
-	out := new(internalpb.ComponentStates)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetComponentStates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CompactionPlan) Reset()         { *m = CompactionPlan{} }
+func (m *CompactionPlan) String() string { return proto.CompactTextString(m) }
+func (*CompactionPlan) ProtoMessage()    {}
+func (*CompactionPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{45}
 }
 
-func (c *dataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTimeTickChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CompactionPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionPlan.Unmarshal(m, b)
+}
+func (m *CompactionPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionPlan.Marshal(b, m, deterministic)
+}
+func (m *CompactionPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionPlan.Merge(m, src)
+}
+func (m *CompactionPlan) XXX_Size() int {
+	return xxx_messageInfo_CompactionPlan.Size(m)
+}
+func (m *CompactionPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionPlan.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStatisticsChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CompactionPlan proto.InternalMessageInfo
+
+func (m *CompactionPlan) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
-	out := new(FlushResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Flush", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CompactionPlan) GetPlanID() int64 {
+	if m != nil {
+		return m.PlanID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error) {
-	out := new(AssignSegmentIDResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AssignSegmentID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CompactionPlan) GetSegmentBinlogs() []*CompactionSegmentBinlogs {
+	if m != nil {
+		return m.SegmentBinlogs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
-	out := new(GetSegmentInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CompactionPlan) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error) {
+func (m *CompactionPlan) GetTimeoutInSeconds() int32 {
+	if m != nil {
+		return m.TimeoutInSeconds
+	}
+	return 0
+}
+
+func (m *CompactionPlan) GetType() CompactionType {
+	if m != nil {
+		return m.Type
+	}
+	return CompactionType_UndefinedCompaction
+}
+
+func (m *CompactionPlan) GetTimetravel() uint64 {
+	if m != nil {
+		return m.Timetravel
+	}
+	return 0
+}
+
+func (m *CompactionPlan) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *CompactionPlan) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CompactionPlan) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *CompactionPlan) GetTargetSegmentID() int64 {
+	if m != nil {
+		return m.TargetSegmentID
+	}
+	return 0
+}
+
+type CompactionResult struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	PlanID               int64             `protobuf:"varint,2,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentID            int64             `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows            int64             `protobuf:"varint,4,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs           []*ID2PathList    `protobuf:"bytes,5,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Deltalogs            []*DeltaLogInfo   `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	BinlogSize           int64             `protobuf:"varint,7,opt,name=binlog_size,json=binlogSize,proto3" json:"binlog_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CompactionResult) Reset()         { *m = CompactionResult{} }
+func (m *CompactionResult) String() string { return proto.CompactTextString(m) }
+func (*CompactionResult) ProtoMessage()    {}
+func (*CompactionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{46}
+}
+
+func (m *CompactionResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionResult.Unmarshal(m, b)
+}
+func (m *CompactionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionResult.Marshal(b, m, deterministic)
+}
+func (m *CompactionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionResult.Merge(m, src)
+}
+func (m *CompactionResult) XXX_Size() int {
+	return xxx_messageInfo_CompactionResult.Size(m)
+}
+func (m *CompactionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionResult proto.InternalMessageInfo
+
+func (m *CompactionResult) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CompactionResult) GetPlanID() int64 {
+	if m != nil {
+		return m.PlanID
+	}
+	return 0
+}
+
+func (m *CompactionResult) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *CompactionResult) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *CompactionResult) GetInsertLogs() []*ID2PathList {
+	if m != nil {
+		return m.InsertLogs
+	}
+	return nil
+}
+
+func (m *CompactionResult) GetDeltalogs() []*DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *CompactionResult) GetBinlogSize() int64 {
+	if m != nil {
+		return m.BinlogSize
+	}
+	return 0
+}
+
+type ImportTaskRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	ChannelNames         []string          `protobuf:"bytes,4,rep,name=channel_names,json=channelNames,proto3" json:"channel_names,omitempty"`
+	Files                []string          `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ImportTaskRequest) Reset()         { *m = ImportTaskRequest{} }
+func (m *ImportTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportTaskRequest) ProtoMessage()    {}
+func (*ImportTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{47}
+}
+
+func (m *ImportTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTaskRequest.Unmarshal(m, b)
+}
+func (m *ImportTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTaskRequest.Merge(m, src)
+}
+func (m *ImportTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportTaskRequest.Size(m)
+}
+func (m *ImportTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportTaskRequest proto.InternalMessageInfo
+
+func (m *ImportTaskRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ImportTaskRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ImportTaskRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *ImportTaskRequest) GetChannelNames() []string {
+	if m != nil {
+		return m.ChannelNames
+	}
+	return nil
+}
+
+func (m *ImportTaskRequest) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+type ImportTask struct {
+	Base                 *commonpb.MsgBase    `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskID               int64                `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	CollectionID         int64                `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64                `protobuf:"varint,4,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	ChannelNames         []string             `protobuf:"bytes,5,rep,name=channel_names,json=channelNames,proto3" json:"channel_names,omitempty"`
+	Files                []string             `protobuf:"bytes,6,rep,name=files,proto3" json:"files,omitempty"`
+	State                commonpb.ImportState `protobuf:"varint,7,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	RowCount             int64                `protobuf:"varint,8,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	SegmentIDs           []int64              `protobuf:"varint,9,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	Reason               string               `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	NodeID               int64                `protobuf:"varint,11,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	CreateTs             uint64               `protobuf:"varint,12,opt,name=create_ts,json=createTs,proto3" json:"create_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ImportTask) Reset()         { *m = ImportTask{} }
+func (m *ImportTask) String() string { return proto.CompactTextString(m) }
+func (*ImportTask) ProtoMessage()    {}
+func (*ImportTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{48}
+}
+
+func (m *ImportTask) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTask.Unmarshal(m, b)
+}
+func (m *ImportTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTask.Marshal(b, m, deterministic)
+}
+func (m *ImportTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTask.Merge(m, src)
+}
+func (m *ImportTask) XXX_Size() int {
+	return xxx_messageInfo_ImportTask.Size(m)
+}
+func (m *ImportTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportTask proto.InternalMessageInfo
+
+func (m *ImportTask) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ImportTask) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *ImportTask) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ImportTask) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *ImportTask) GetChannelNames() []string {
+	if m != nil {
+		return m.ChannelNames
+	}
+	return nil
+}
+
+func (m *ImportTask) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ImportTask) GetState() commonpb.ImportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ImportState_ImportPending
+}
+
+func (m *ImportTask) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ImportTask) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *ImportTask) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ImportTask) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ImportTask) GetCreateTs() uint64 {
+	if m != nil {
+		return m.CreateTs
+	}
+	return 0
+}
+
+type ImportSegment struct {
+	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows            int64          `protobuf:"varint,2,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs           []*ID2PathList `protobuf:"bytes,3,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ImportSegment) Reset()         { *m = ImportSegment{} }
+func (m *ImportSegment) String() string { return proto.CompactTextString(m) }
+func (*ImportSegment) ProtoMessage()    {}
+func (*ImportSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{49}
+}
+
+func (m *ImportSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportSegment.Unmarshal(m, b)
+}
+func (m *ImportSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportSegment.Marshal(b, m, deterministic)
+}
+func (m *ImportSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportSegment.Merge(m, src)
+}
+func (m *ImportSegment) XXX_Size() int {
+	return xxx_messageInfo_ImportSegment.Size(m)
+}
+func (m *ImportSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportSegment proto.InternalMessageInfo
+
+func (m *ImportSegment) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *ImportSegment) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *ImportSegment) GetInsertLogs() []*ID2PathList {
+	if m != nil {
+		return m.InsertLogs
+	}
+	return nil
+}
+
+type ImportResult struct {
+	Base                 *commonpb.MsgBase    `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskID               int64                `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	State                commonpb.ImportState `protobuf:"varint,3,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	Segments             []*ImportSegment     `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`
+	Reason               string               `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ImportResult) Reset()         { *m = ImportResult{} }
+func (m *ImportResult) String() string { return proto.CompactTextString(m) }
+func (*ImportResult) ProtoMessage()    {}
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{50}
+}
+
+func (m *ImportResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResult.Unmarshal(m, b)
+}
+func (m *ImportResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResult.Marshal(b, m, deterministic)
+}
+func (m *ImportResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResult.Merge(m, src)
+}
+func (m *ImportResult) XXX_Size() int {
+	return xxx_messageInfo_ImportResult.Size(m)
+}
+func (m *ImportResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResult proto.InternalMessageInfo
+
+func (m *ImportResult) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ImportResult) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *ImportResult) GetState() commonpb.ImportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ImportState_ImportPending
+}
+
+func (m *ImportResult) GetSegments() []*ImportSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ImportResult) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
+	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
+	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
+	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
+	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
+	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
+	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
+	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
+	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
+	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
+	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
+	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
+	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
+	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
+	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
+	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
+	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
+	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
+	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
+	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
+	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
+	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
+	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
+	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
+	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
+	proto.RegisterType((*DDLBinlogMeta)(nil), "milvus.proto.data.DDLBinlogMeta")
+	proto.RegisterType((*FieldFlushMeta)(nil), "milvus.proto.data.FieldFlushMeta")
+	proto.RegisterType((*SegmentFlushMeta)(nil), "milvus.proto.data.SegmentFlushMeta")
+	proto.RegisterType((*DDLFlushMeta)(nil), "milvus.proto.data.DDLFlushMeta")
+	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.data.CollectionInfo")
+	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
+	proto.RegisterType((*ID2PathList)(nil), "milvus.proto.data.ID2PathList")
+	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
+	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
+	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
+	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
+	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
+	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
+	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
+	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
+	proto.RegisterType((*DeltaLogInfo)(nil), "milvus.proto.data.DeltaLogInfo")
+	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
+	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
+	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
+	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
+	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
+	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
+	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
+	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
+	proto.RegisterType((*ImportTaskRequest)(nil), "milvus.proto.data.ImportTaskRequest")
+	proto.RegisterType((*ImportTask)(nil), "milvus.proto.data.ImportTask")
+	proto.RegisterType((*ImportSegment)(nil), "milvus.proto.data.ImportSegment")
+	proto.RegisterType((*ImportResult)(nil), "milvus.proto.data.ImportResult")
+}
+
+func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
+
+var fileDescriptor_82cd95f524594f49 = []byte{
+	// 2759 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x1a, 0xcb, 0x6e, 0x24, 0x57,
+	0x75, 0xaa, 0x5f, 0xee, 0x3e, 0xfd, 0xb0, 0xe7, 0x66, 0x70, 0x9a, 0x9e, 0x97, 0xa7, 0x92, 0x4c,
+	0x1c, 0x27, 0xb1, 0x33, 0x1e, 0xde, 0xc9, 0x10, 0x65, 0xdc, 0x63, 0xab, 0x85, 0x3d, 0x98, 0xb2,
+	0x27, 0x41, 0x44, 0xa8, 0x55, 0xee, 0xba, 0x6e, 0x17, 0xae, 0x47, 0xa7, 0x6e, 0xb5, 0x67, 0x26,
+	0x9b, 0x44, 0x41, 0x42, 0x0a, 0x42, 0x04, 0x09, 0xb1, 0x40, 0x62, 0x81, 0x58, 0x21, 0xb1, 0x81,
+	0x0d, 0x9b, 0x88, 0x2d, 0x42, 0xe2, 0x1b, 0xd8, 0xc2, 0x6f, 0xa0, 0xfb, 0xa8, 0xaa, 0x5b, 0x8f,
+	0xee, 0x2e, 0xdb, 0x33, 0xf1, 0xae, 0xee, 0xa9, 0x73, 0xcf, 0x39, 0xf7, 0xbc, 0xcf, 0xad, 0x82,
+	0x05, 0x43, 0xf7, 0xf5, 0xfe, 0xc0, 0x75, 0x3d, 0x63, 0x75, 0xe4, 0xb9, 0xbe, 0x8b, 0x2e, 0xdb,
+	0xa6, 0x75, 0x32, 0x26, 0x7c, 0xb5, 0x4a, 0x5f, 0x77, 0x1a, 0x03, 0xd7, 0xb6, 0x5d, 0x87, 0x83,
+	0x3a, 0x2d, 0xd3, 0xf1, 0xb1, 0xe7, 0xe8, 0x96, 0x58, 0x37, 0xe4, 0x0d, 0x9d, 0x06, 0x19, 0x1c,
+	0x61, 0x5b, 0xe7, 0x2b, 0xf5, 0x09, 0x34, 0x36, 0xad, 0x31, 0x39, 0xd2, 0xf0, 0x47, 0x63, 0x4c,
+	0x7c, 0xf4, 0x16, 0x94, 0x0e, 0x74, 0x82, 0xdb, 0xca, 0x92, 0xb2, 0x5c, 0x5f, 0xbf, 0xb6, 0x1a,
+	0xe3, 0x25, 0xb8, 0xec, 0x90, 0xe1, 0x7d, 0x9d, 0x60, 0x8d, 0x61, 0x22, 0x04, 0x25, 0xe3, 0xa0,
+	0xd7, 0x6d, 0x17, 0x96, 0x94, 0xe5, 0xa2, 0xc6, 0x9e, 0x91, 0x0a, 0x8d, 0x81, 0x6b, 0x59, 0x78,
+	0xe0, 0x9b, 0xae, 0xd3, 0xeb, 0xb6, 0x4b, 0xec, 0x5d, 0x0c, 0xa6, 0xfe, 0x41, 0x81, 0xa6, 0x60,
+	0x4d, 0x46, 0xae, 0x43, 0x30, 0xba, 0x0b, 0x15, 0xe2, 0xeb, 0xfe, 0x98, 0x08, 0xee, 0x57, 0x33,
+	0xb9, 0xef, 0x31, 0x14, 0x4d, 0xa0, 0xe6, 0x62, 0x5f, 0x4c, 0xb3, 0x47, 0x37, 0x00, 0x08, 0x1e,
+	0xda, 0xd8, 0xf1, 0x7b, 0x5d, 0xd2, 0x2e, 0x2d, 0x15, 0x97, 0x8b, 0x9a, 0x04, 0x51, 0xff, 0xae,
+	0xc0, 0xc2, 0x5e, 0xb0, 0x0c, 0xb4, 0x73, 0x05, 0xca, 0x03, 0x77, 0xec, 0xf8, 0x4c, 0xc0, 0xa6,
+	0xc6, 0x17, 0xe8, 0x16, 0x34, 0x06, 0x47, 0xba, 0xe3, 0x60, 0xab, 0xef, 0xe8, 0x36, 0x66, 0xa2,
+	0xd4, 0xb4, 0xba, 0x80, 0x3d, 0xd4, 0x6d, 0x9c, 0x4b, 0xa2, 0x25, 0xa8, 0x8f, 0x74, 0xcf, 0x37,
+	0x63, 0x3a, 0x93, 0x41, 0xe8, 0x25, 0x68, 0x9a, 0xf6, 0xc8, 0xf5, 0xfc, 0xbe, 0xaf, 0x93, 0xe3,
+	0x5e, 0xb7, 0x5d, 0xe6, 0x64, 0x38, 0x70, 0x9f, 0xc1, 0xd4, 0x3f, 0x2a, 0xb0, 0xf8, 0x1e, 0x21,
+	0xe6, 0xd0, 0x49, 0x89, 0xbf, 0x08, 0x15, 0xc7, 0x35, 0x70, 0xaf, 0xcb, 0xe4, 0x2f, 0x6a, 0x62,
+	0x85, 0xae, 0x42, 0x6d, 0x84, 0xb1, 0xd7, 0xf7, 0x5c, 0x2b, 0x90, 0xbe, 0x4a, 0x01, 0x9a, 0x6b,
+	0x61, 0xf4, 0x23, 0xb8, 0x4c, 0x12, 0x84, 0x48, 0xbb, 0xb8, 0x54, 0x5c, 0xae, 0xaf, 0xbf, 0xb4,
+	0x9a, 0x72, 0xc5, 0xd5, 0x24, 0x53, 0x2d, 0xbd, 0x5b, 0xfd, 0xb4, 0x00, 0x2f, 0x84, 0x78, 0x5c,
+	0x56, 0xfa, 0x4c, 0xd5, 0x4b, 0xf0, 0x30, 0x14, 0x8f, 0x2f, 0xf2, 0xa8, 0x37, 0xb4, 0x4b, 0x51,
+	0xb6, 0x4b, 0x0e, 0x2f, 0x4c, 0x2a, 0xbd, 0x9c, 0x56, 0xfa, 0x4d, 0xa8, 0xe3, 0x27, 0x23, 0xd3,
+	0xc3, 0x7d, 0xdf, 0xb4, 0x71, 0xbb, 0xb2, 0xa4, 0x2c, 0x97, 0x34, 0xe0, 0xa0, 0x7d, 0xd3, 0x96,
+	0xdd, 0x76, 0x2e, 0xb7, 0xdb, 0xaa, 0x7f, 0x52, 0xe0, 0xc5, 0x94, 0x95, 0x44, 0x1c, 0x68, 0xb0,
+	0xc0, 0x4e, 0x1e, 0x69, 0x86, 0x46, 0x04, 0x55, 0xf8, 0xed, 0x69, 0x0a, 0x8f, 0xd0, 0xb5, 0xd4,
+	0x7e, 0x49, 0xc8, 0x42, 0x7e, 0x21, 0x8f, 0xe1, 0xc5, 0x2d, 0xec, 0x0b, 0x06, 0xf4, 0x1d, 0x26,
+	0x67, 0xcf, 0x13, 0xf1, 0x80, 0x2b, 0xa4, 0x02, 0xee, 0xaf, 0x05, 0x58, 0x90, 0x59, 0xf5, 0x9c,
+	0x43, 0x17, 0x5d, 0x83, 0x5a, 0x88, 0x22, 0xbc, 0x22, 0x02, 0xa0, 0x6f, 0x43, 0x99, 0x4a, 0xca,
+	0x5d, 0xa2, 0xb5, 0x7e, 0x2b, 0xfb, 0x4c, 0x12, 0x4d, 0x8d, 0xe3, 0xa3, 0x1e, 0xb4, 0x88, 0xaf,
+	0x7b, 0x7e, 0x7f, 0xe4, 0x12, 0x66, 0x67, 0xe6, 0x38, 0xf5, 0x75, 0x35, 0x4e, 0x21, 0xcc, 0xa3,
+	0x3b, 0x64, 0xb8, 0x2b, 0x30, 0xb5, 0x26, 0xdb, 0x19, 0x2c, 0xd1, 0x03, 0x68, 0x60, 0xc7, 0x88,
+	0x08, 0x95, 0x72, 0x13, 0xaa, 0x63, 0xc7, 0x08, 0xc9, 0x44, 0xf6, 0x29, 0xe7, 0xb7, 0xcf, 0xaf,
+	0x14, 0x68, 0xa7, 0x0d, 0x74, 0x9e, 0x6c, 0xfa, 0x36, 0xdf, 0x84, 0xb9, 0x81, 0xa6, 0x46, 0x78,
+	0x68, 0x24, 0x4d, 0x6c, 0x51, 0x4d, 0xf8, 0x5a, 0x24, 0x0d, 0x7b, 0xf3, 0xdc, 0x9c, 0xe5, 0xe7,
+	0x0a, 0x2c, 0x26, 0x79, 0x9d, 0xe7, 0xdc, 0xdf, 0x80, 0xb2, 0xe9, 0x1c, 0xba, 0xc1, 0xb1, 0x6f,
+	0x4c, 0x89, 0x33, 0xca, 0x8b, 0x23, 0xab, 0x36, 0x5c, 0xdd, 0xc2, 0x7e, 0xcf, 0x21, 0xd8, 0xf3,
+	0xef, 0x9b, 0x8e, 0xe5, 0x0e, 0x77, 0x75, 0xff, 0xe8, 0x1c, 0x31, 0x12, 0x73, 0xf7, 0x42, 0xc2,
+	0xdd, 0xd5, 0x3f, 0x2b, 0x70, 0x2d, 0x9b, 0x9f, 0x38, 0x7a, 0x07, 0xaa, 0x87, 0x26, 0xb6, 0x8c,
+	0x5e, 0x97, 0x27, 0x8c, 0xa2, 0x16, 0xae, 0x69, 0xac, 0x8c, 0x28, 0xb2, 0x38, 0xe1, 0xad, 0x09,
+	0x0e, 0xba, 0xe7, 0x7b, 0xa6, 0x33, 0xdc, 0x36, 0x89, 0xaf, 0x71, 0x7c, 0x49, 0x9f, 0xc5, 0xfc,
+	0x9e, 0xf9, 0x4b, 0x05, 0x6e, 0x6c, 0x61, 0x7f, 0x23, 0x4c, 0xb5, 0xf4, 0xbd, 0x49, 0x7c, 0x73,
+	0x40, 0x9e, 0x6f, 0xa7, 0x91, 0x51, 0x58, 0xd5, 0x2f, 0x14, 0xb8, 0x39, 0x51, 0x18, 0xa1, 0x3a,
+	0x91, 0x4a, 0x82, 0x44, 0x9b, 0x9d, 0x4a, 0x7e, 0x80, 0x9f, 0xbe, 0xaf, 0x5b, 0x63, 0xbc, 0xab,
+	0x9b, 0x1e, 0x4f, 0x25, 0x67, 0x4c, 0xac, 0x7f, 0x51, 0xe0, 0xfa, 0x16, 0xf6, 0x77, 0x83, 0x32,
+	0x73, 0x81, 0xda, 0x99, 0xdd, 0x76, 0xa8, 0xbf, 0xe6, 0xc6, 0xcc, 0x94, 0xf6, 0x42, 0xd4, 0x77,
+	0x83, 0xc5, 0x81, 0x14, 0x90, 0x1b, 0xbc, 0x17, 0x10, 0xca, 0x53, 0x7f, 0x57, 0x80, 0xc6, 0xfb,
+	0xa2, 0x3f, 0xa0, 0xaf, 0x53, 0x7a, 0x50, 0xb2, 0xf5, 0x20, 0xb5, 0x14, 0x59, 0x5d, 0xc6, 0x16,
+	0x34, 0x09, 0xc6, 0xc7, 0x67, 0x29, 0x1a, 0x0d, 0xba, 0x31, 0x58, 0xa1, 0x6d, 0xb8, 0x3c, 0x76,
+	0x0e, 0x69, 0xef, 0x8b, 0x0d, 0x71, 0x0a, 0xde, 0x82, 0xce, 0xce, 0x3c, 0xe9, 0x8d, 0x68, 0x19,
+	0xe6, 0x93, 0xb4, 0xca, 0x2c, 0xf8, 0x93, 0x60, 0xf5, 0x73, 0x05, 0x16, 0x3f, 0xd0, 0xfd, 0xc1,
+	0x51, 0xd7, 0x16, 0x1a, 0x3b, 0x87, 0xbf, 0xdd, 0x83, 0xda, 0x89, 0xd0, 0x4e, 0x90, 0x54, 0x6e,
+	0x66, 0x08, 0x2f, 0xdb, 0x41, 0x8b, 0x76, 0xd0, 0x36, 0xf5, 0x0a, 0x6b, 0xff, 0x03, 0xe9, 0xbe,
+	0x7a, 0xcf, 0x9f, 0x35, 0x02, 0x3c, 0x01, 0x10, 0xc2, 0xed, 0x90, 0xe1, 0x19, 0xe4, 0xfa, 0x0e,
+	0xcc, 0x09, 0x6a, 0xc2, 0xb9, 0x67, 0x19, 0x37, 0x40, 0x57, 0xf7, 0x60, 0x51, 0xc0, 0x37, 0x69,
+	0xfe, 0xe6, 0xb9, 0x7e, 0x07, 0xfb, 0x3a, 0x6a, 0xc3, 0x9c, 0x48, 0xe9, 0xc2, 0x89, 0x83, 0x25,
+	0xed, 0x53, 0x0f, 0x18, 0x5e, 0x9f, 0xe6, 0x6d, 0xe1, 0xbf, 0x70, 0x10, 0x96, 0x09, 0xf5, 0xa7,
+	0xd0, 0xec, 0x76, 0xb7, 0x25, 0x5a, 0xb7, 0x61, 0xde, 0x30, 0xac, 0xbe, 0xbc, 0x4b, 0x61, 0xbb,
+	0x9a, 0x86, 0x61, 0x45, 0xf5, 0x05, 0xbd, 0x0c, 0x2d, 0x9f, 0xf4, 0xd3, 0xc4, 0x1b, 0x3e, 0x89,
+	0xb0, 0xd4, 0x1d, 0x68, 0x31, 0x61, 0x99, 0x51, 0x67, 0xc8, 0x7a, 0x0b, 0x1a, 0x12, 0x39, 0xee,
+	0x3e, 0x35, 0xad, 0x1e, 0x09, 0xcb, 0x2a, 0x48, 0xd0, 0x0e, 0x46, 0x14, 0xa7, 0xb7, 0x83, 0xd7,
+	0x01, 0x4c, 0xd2, 0x17, 0x4e, 0xcf, 0x64, 0xac, 0x6a, 0x35, 0x93, 0x6c, 0x72, 0x00, 0xfa, 0x2e,
+	0x54, 0x18, 0x7f, 0x1e, 0x1e, 0xa9, 0x24, 0xc5, 0xac, 0x11, 0x3f, 0x81, 0x26, 0x36, 0xa8, 0x8f,
+	0xa0, 0xd1, 0xed, 0x6e, 0x47, 0x72, 0xe4, 0xc9, 0x27, 0x39, 0xce, 0xf8, 0x09, 0xb4, 0xa2, 0xa2,
+	0xc4, 0x12, 0x55, 0x0b, 0x0a, 0x21, 0xb9, 0x42, 0xaf, 0x8b, 0xee, 0x41, 0x85, 0x8f, 0xeb, 0xc2,
+	0x83, 0x5e, 0x89, 0xcb, 0xcc, 0xdf, 0xad, 0x4a, 0x95, 0x8d, 0x01, 0x34, 0xb1, 0x89, 0x7a, 0x78,
+	0x98, 0xc8, 0xf9, 0xd0, 0x56, 0xd4, 0x24, 0x88, 0xfa, 0x79, 0x19, 0xea, 0x92, 0x03, 0xa6, 0xd8,
+	0x27, 0xcf, 0x59, 0x98, 0x5d, 0x3f, 0x8a, 0xe9, 0x09, 0xea, 0x15, 0x68, 0x99, 0xac, 0x67, 0xe9,
+	0x8b, 0xe8, 0x67, 0x45, 0xa6, 0xa6, 0x35, 0x39, 0x54, 0xa4, 0x22, 0x74, 0x03, 0xea, 0xce, 0xd8,
+	0xee, 0xbb, 0x87, 0x7d, 0xcf, 0x7d, 0x4c, 0xc4, 0x28, 0x56, 0x73, 0xc6, 0xf6, 0x0f, 0x0f, 0x35,
+	0xf7, 0x31, 0x89, 0xba, 0xfd, 0xca, 0x29, 0xbb, 0xfd, 0x07, 0xd0, 0x30, 0x6c, 0x2b, 0x4a, 0xdb,
+	0x73, 0xf9, 0x5b, 0x74, 0xc3, 0xb6, 0x82, 0x05, 0x95, 0xcf, 0xd6, 0x9f, 0x50, 0xe1, 0xfa, 0xce,
+	0xd8, 0x6e, 0x57, 0xb9, 0x7c, 0xb6, 0xfe, 0x44, 0x73, 0x1f, 0x3f, 0x1c, 0xdb, 0x68, 0x19, 0x16,
+	0x2c, 0x9d, 0xf8, 0x7d, 0x79, 0x5a, 0xac, 0xb1, 0x69, 0xb1, 0x45, 0xe1, 0x0f, 0xa2, 0x89, 0x31,
+	0x3d, 0x7e, 0xc0, 0x59, 0xc7, 0x8f, 0x7b, 0x50, 0x33, 0xb0, 0xe5, 0xeb, 0x96, 0x3b, 0x24, 0xed,
+	0xfa, 0xc4, 0x2c, 0xdc, 0xa5, 0x38, 0xdb, 0xee, 0x90, 0x67, 0xe1, 0x70, 0x07, 0xba, 0x0d, 0xad,
+	0x81, 0x6b, 0x8f, 0x74, 0x66, 0xcc, 0x4d, 0xcf, 0xb5, 0xdb, 0x0d, 0xe6, 0x24, 0x09, 0x68, 0xfa,
+	0xe6, 0xa1, 0x99, 0xbe, 0x79, 0x90, 0x32, 0x10, 0x31, 0x3f, 0xc6, 0xed, 0x16, 0x43, 0x11, 0x19,
+	0x68, 0xcf, 0xfc, 0x18, 0xd3, 0x7b, 0x06, 0xc3, 0x73, 0x47, 0x5c, 0x35, 0xf3, 0x4c, 0x35, 0x55,
+	0x0a, 0xa0, 0x4a, 0x51, 0xef, 0x42, 0xbd, 0xd7, 0x5d, 0xa7, 0x81, 0x41, 0xbb, 0xcf, 0x94, 0x2b,
+	0x5e, 0x81, 0xf2, 0xae, 0x14, 0x47, 0xe5, 0x20, 0x82, 0xae, 0x44, 0x16, 0x97, 0xd4, 0x92, 0xd6,
+	0xb0, 0x72, 0x56, 0x0d, 0x4f, 0xef, 0xc9, 0xff, 0x57, 0x84, 0xc5, 0x3d, 0xfd, 0x04, 0x3f, 0xff,
+	0xf6, 0x3f, 0x57, 0x49, 0xdb, 0x86, 0xcb, 0x2c, 0x65, 0xad, 0x4b, 0xf2, 0x4c, 0xe9, 0x2c, 0x24,
+	0x85, 0x6b, 0xe9, 0x8d, 0xe8, 0x5d, 0xda, 0x12, 0xe1, 0xc1, 0xf1, 0xae, 0x6b, 0x06, 0x5d, 0x45,
+	0x7d, 0xfd, 0x7a, 0x06, 0x9d, 0x8d, 0x10, 0x4b, 0x93, 0x77, 0xa0, 0x5d, 0x98, 0x8f, 0x9b, 0x81,
+	0xb4, 0x2b, 0x8c, 0xc8, 0xab, 0x53, 0xe7, 0xca, 0x48, 0xfb, 0x5a, 0x2b, 0x66, 0x0c, 0xc2, 0x6a,
+	0x8a, 0x48, 0xf0, 0x73, 0x2c, 0xc1, 0x07, 0xcb, 0x78, 0x24, 0x54, 0x4f, 0x1d, 0x09, 0x09, 0xe7,
+	0xad, 0x25, 0x9d, 0x97, 0x16, 0x24, 0x88, 0xce, 0x39, 0xa3, 0x14, 0x7d, 0x1f, 0xaa, 0xa1, 0xe7,
+	0x15, 0x72, 0x7b, 0x5e, 0x75, 0x24, 0xe5, 0x1a, 0x39, 0x17, 0x16, 0x13, 0xb9, 0x50, 0xfd, 0x4c,
+	0x81, 0x66, 0x57, 0xf7, 0xf5, 0x87, 0xae, 0x81, 0xf7, 0xcf, 0xd8, 0x9e, 0xe4, 0xb8, 0x57, 0xbb,
+	0x06, 0x35, 0x1a, 0xab, 0xc4, 0xd7, 0xed, 0x11, 0x13, 0xa2, 0xa4, 0x45, 0x00, 0x3a, 0x84, 0x37,
+	0x45, 0xf2, 0xde, 0x0b, 0x2f, 0x63, 0x19, 0x29, 0xde, 0x46, 0xb0, 0x67, 0xf4, 0xbd, 0xf8, 0x25,
+	0xcd, 0xcb, 0x99, 0xee, 0xc3, 0x88, 0xb0, 0xd6, 0x34, 0x96, 0xb9, 0xf3, 0x4c, 0x77, 0x9f, 0x2a,
+	0xd0, 0x08, 0x54, 0xc1, 0x8a, 0x58, 0x1b, 0xe6, 0x74, 0xc3, 0xf0, 0x30, 0x21, 0x42, 0x8e, 0x60,
+	0x49, 0xdf, 0x9c, 0x60, 0x8f, 0x04, 0x46, 0x29, 0x6a, 0xc1, 0x12, 0xbd, 0x03, 0xd5, 0xb0, 0x97,
+	0xe5, 0x77, 0x9b, 0x4b, 0x93, 0xe5, 0x14, 0xd3, 0x48, 0xb8, 0x43, 0xfd, 0x9b, 0x02, 0x2d, 0xe1,
+	0xbd, 0x3c, 0x7c, 0xc8, 0x0c, 0xf7, 0xb8, 0x0f, 0x8d, 0xc3, 0xa8, 0xb1, 0x9b, 0x76, 0xeb, 0x20,
+	0xf5, 0x7f, 0x5a, 0x6c, 0x4f, 0xdc, 0xdf, 0x8b, 0xa7, 0xf5, 0x77, 0xf5, 0x3d, 0xa8, 0x4b, 0xb4,
+	0xa7, 0xf4, 0x6a, 0x6d, 0x98, 0x3b, 0x90, 0xc4, 0xac, 0x69, 0xc1, 0x52, 0xfd, 0x37, 0xd5, 0xbc,
+	0x44, 0x9e, 0x16, 0x7a, 0x0f, 0x0f, 0x5c, 0xcf, 0xe8, 0x63, 0xc7, 0xf7, 0x4c, 0xcc, 0x0d, 0x50,
+	0xd2, 0x9a, 0x1c, 0xfa, 0x80, 0x03, 0x29, 0x5a, 0xe8, 0x44, 0xfd, 0x43, 0x5a, 0x74, 0x0a, 0x1c,
+	0x2d, 0x84, 0xb2, 0x9a, 0x73, 0x0b, 0x1a, 0x11, 0x9a, 0xef, 0x0a, 0xff, 0xab, 0x87, 0xb0, 0x7d,
+	0x97, 0x76, 0xa6, 0xec, 0x44, 0xfd, 0xb0, 0x33, 0xe5, 0x9d, 0x45, 0xc3, 0x10, 0x62, 0x05, 0xfd,
+	0x6b, 0x84, 0xc5, 0xa2, 0x5b, 0xdc, 0x9b, 0x07, 0x58, 0x2c, 0xbe, 0xff, 0xa5, 0xb0, 0xdb, 0x4e,
+	0x0d, 0x0f, 0xdc, 0x13, 0xec, 0x3d, 0x3d, 0xff, 0x9d, 0xd2, 0xdb, 0x92, 0x4f, 0xe5, 0x9c, 0x8f,
+	0xc2, 0x0d, 0xe8, 0xed, 0x48, 0xeb, 0xc5, 0x89, 0xdd, 0x6a, 0xdc, 0xe7, 0x22, 0xc3, 0xfc, 0x86,
+	0xdf, 0x8e, 0xc5, 0x8f, 0x72, 0xd6, 0xa2, 0xf4, 0x4c, 0x7a, 0x40, 0xf5, 0xb7, 0x0a, 0x7c, 0x7d,
+	0x0b, 0xfb, 0x9b, 0xf1, 0x89, 0xf4, 0xa2, 0xa5, 0xb2, 0xa1, 0x93, 0x25, 0xd4, 0x79, 0xac, 0xde,
+	0x81, 0x2a, 0x09, 0xc6, 0x70, 0x7e, 0x6f, 0x19, 0xae, 0xd5, 0x5f, 0x28, 0xd0, 0x96, 0x67, 0x9a,
+	0x0d, 0xd7, 0x1e, 0x59, 0xd8, 0xc7, 0xc6, 0x57, 0x3d, 0x5f, 0x7e, 0xa9, 0x40, 0x7b, 0x23, 0xec,
+	0xf0, 0x9e, 0x53, 0xea, 0x92, 0x9b, 0x8b, 0x67, 0x9a, 0xba, 0xfe, 0x53, 0x84, 0x56, 0x24, 0xfd,
+	0xae, 0xa5, 0x3b, 0x67, 0x50, 0xde, 0x22, 0x54, 0x46, 0x96, 0x1e, 0xb9, 0x8e, 0x58, 0xa1, 0x3d,
+	0x68, 0x91, 0x98, 0x3e, 0x84, 0x80, 0xaf, 0x67, 0xd5, 0x83, 0x09, 0x2a, 0xd4, 0x12, 0x24, 0xe8,
+	0x64, 0xca, 0xfb, 0x20, 0xd6, 0xf9, 0x96, 0x78, 0x21, 0x65, 0x10, 0x36, 0x0f, 0xbc, 0x01, 0x88,
+	0xbe, 0x70, 0xc7, 0x7e, 0xdf, 0x74, 0xfa, 0x04, 0x0f, 0x5c, 0xc7, 0xe0, 0x03, 0x50, 0x59, 0x5b,
+	0x10, 0x6f, 0x7a, 0xce, 0x1e, 0x87, 0xa3, 0x6f, 0x42, 0xc9, 0x7f, 0x3a, 0x9a, 0x30, 0x06, 0x25,
+	0xe4, 0xda, 0x7f, 0x3a, 0xc2, 0x1a, 0x43, 0xa7, 0xb3, 0x20, 0x25, 0xe5, 0x7b, 0xfa, 0x09, 0xb6,
+	0x58, 0xf3, 0x54, 0xd2, 0x24, 0x08, 0xcd, 0xf3, 0xc1, 0x78, 0x56, 0xe5, 0x65, 0x53, 0x2c, 0x53,
+	0xb1, 0x56, 0x9b, 0x1d, 0x6b, 0x90, 0x9e, 0x02, 0x97, 0x61, 0xde, 0xd7, 0xbd, 0x61, 0x74, 0x6f,
+	0xd7, 0x6d, 0xd7, 0x19, 0x56, 0x12, 0xac, 0x7e, 0x59, 0x80, 0x85, 0xe8, 0x08, 0x1a, 0x26, 0x63,
+	0xcb, 0x7f, 0x86, 0x16, 0x8e, 0xf9, 0x77, 0x31, 0xe9, 0xdf, 0x89, 0xce, 0xab, 0x94, 0x9c, 0x42,
+	0xdf, 0x85, 0xba, 0x18, 0x66, 0x99, 0x73, 0x94, 0x73, 0xb9, 0x3f, 0xf0, 0x2d, 0xdb, 0x29, 0xe7,
+	0xaf, 0x9c, 0xb7, 0x4f, 0x9d, 0x4b, 0xf5, 0xa9, 0xff, 0x54, 0xe0, 0x72, 0x2f, 0x1c, 0xcb, 0x2e,
+	0x38, 0xc3, 0xd2, 0xc1, 0x51, 0x6e, 0x32, 0xf9, 0x30, 0x52, 0xd3, 0x1a, 0x52, 0x97, 0x49, 0xe8,
+	0x6c, 0x77, 0x68, 0x5a, 0x98, 0x6b, 0xb3, 0xa6, 0xf1, 0x85, 0xfa, 0xfb, 0x22, 0x40, 0x74, 0x90,
+	0xb3, 0x39, 0x80, 0x98, 0x56, 0x85, 0x03, 0xf0, 0xd5, 0xb3, 0xfb, 0x18, 0x1f, 0x3f, 0x59, 0x79,
+	0xda, 0xc9, 0x2a, 0xd2, 0xc9, 0xd0, 0xb7, 0x82, 0x96, 0x78, 0x8e, 0x85, 0xf0, 0x52, 0xe6, 0x59,
+	0xf8, 0xd1, 0x63, 0xed, 0xf0, 0x55, 0xa8, 0xd1, 0xdb, 0x07, 0xfe, 0xa9, 0x9b, 0xdf, 0x3f, 0x54,
+	0x3d, 0xf7, 0xf1, 0x06, 0x5d, 0x27, 0x6e, 0x33, 0x6b, 0xc9, 0xdb, 0x4c, 0xaa, 0x0d, 0x0f, 0xeb,
+	0x44, 0x5c, 0x36, 0xd4, 0x34, 0xb1, 0x92, 0x7e, 0x0a, 0xa8, 0x27, 0x7f, 0x0a, 0x18, 0x78, 0x58,
+	0xf7, 0x71, 0xdf, 0x27, 0xed, 0x06, 0x1f, 0xd6, 0x39, 0x60, 0x9f, 0xd0, 0x4f, 0x02, 0x4d, 0x21,
+	0x20, 0xe7, 0x30, 0xa3, 0x6a, 0x24, 0xa2, 0xaa, 0x30, 0x23, 0xaa, 0x8a, 0xa7, 0x8d, 0x2a, 0xf5,
+	0xbf, 0x0a, 0x34, 0xb8, 0x40, 0xe7, 0xc9, 0x17, 0x99, 0xee, 0x12, 0x5a, 0xab, 0x78, 0x3a, 0x6b,
+	0xbd, 0x23, 0x75, 0x02, 0xa5, 0x89, 0x33, 0x45, 0x4c, 0x8b, 0x51, 0xaf, 0x20, 0x99, 0xab, 0x2c,
+	0x9b, 0x6b, 0xe5, 0x0e, 0x5c, 0x4e, 0x8d, 0x4b, 0xa8, 0x05, 0xf0, 0xc8, 0x19, 0x88, 0x6e, 0x62,
+	0xe1, 0x12, 0x6a, 0x40, 0x35, 0xe8, 0x2d, 0x16, 0x94, 0x95, 0x3d, 0x68, 0xc5, 0x2b, 0x02, 0x7a,
+	0x11, 0x5e, 0x78, 0xe4, 0x18, 0xf8, 0xd0, 0x74, 0xb0, 0x11, 0xbd, 0x5a, 0xb8, 0x84, 0x5e, 0x80,
+	0xf9, 0x9e, 0xe3, 0x60, 0x4f, 0x02, 0x2a, 0x14, 0xb8, 0x83, 0xbd, 0x21, 0x96, 0x80, 0x85, 0xf5,
+	0x2f, 0xe6, 0xa1, 0x46, 0xc7, 0xae, 0x0d, 0xd7, 0xf5, 0x0c, 0x34, 0x02, 0xc4, 0xbe, 0xb0, 0xd9,
+	0x23, 0xd7, 0x09, 0x3f, 0x45, 0xa3, 0xb7, 0x26, 0xcc, 0xbc, 0x69, 0x54, 0x91, 0xa6, 0x3a, 0xb7,
+	0x27, 0xec, 0x48, 0xa0, 0xab, 0x97, 0x90, 0xcd, 0x38, 0xd2, 0xf2, 0xb9, 0x6f, 0x0e, 0x8e, 0x83,
+	0x3b, 0xc4, 0x29, 0x1c, 0x13, 0xa8, 0x01, 0xc7, 0xc4, 0x17, 0x6e, 0xb1, 0xe0, 0x9f, 0x41, 0x83,
+	0x4e, 0x50, 0xbd, 0x84, 0x3e, 0x82, 0x2b, 0xf4, 0x93, 0x53, 0xf8, 0xe5, 0x2b, 0x60, 0xb8, 0x3e,
+	0x99, 0x61, 0x0a, 0xf9, 0x94, 0x2c, 0xb7, 0xa1, 0xcc, 0xba, 0x44, 0x94, 0x55, 0x1e, 0xe4, 0x9f,
+	0xb6, 0x3a, 0x4b, 0x93, 0x11, 0x42, 0x6a, 0x3f, 0x83, 0xf9, 0xc4, 0xff, 0x26, 0xe8, 0xb5, 0x8c,
+	0x6d, 0xd9, 0x7f, 0x0e, 0x75, 0x56, 0xf2, 0xa0, 0x86, 0xbc, 0x86, 0xd0, 0x8a, 0x7f, 0x9f, 0x43,
+	0xcb, 0x19, 0xfb, 0x33, 0xff, 0x15, 0xe8, 0xbc, 0x96, 0x03, 0x33, 0x64, 0x64, 0xc3, 0x42, 0xf2,
+	0xff, 0x07, 0xb4, 0x32, 0x95, 0x40, 0xdc, 0xdd, 0x5e, 0xcf, 0x85, 0x1b, 0xb2, 0x7b, 0x0a, 0x57,
+	0xb2, 0xbe, 0xbf, 0xa3, 0xd5, 0x6c, 0x32, 0x93, 0x7e, 0x0c, 0xe8, 0xac, 0xe5, 0xc6, 0x0f, 0x59,
+	0x7f, 0xc6, 0xa7, 0xd3, 0xac, 0x6f, 0xd8, 0xe8, 0x4e, 0x36, 0xb9, 0x29, 0x1f, 0xdf, 0x3b, 0xeb,
+	0xa7, 0xd9, 0x12, 0x0a, 0xf1, 0x09, 0x2c, 0x66, 0x7f, 0x07, 0x46, 0x6f, 0x65, 0xd3, 0x9b, 0xfc,
+	0x81, 0xbb, 0x73, 0xe7, 0x14, 0x3b, 0x42, 0x01, 0xdc, 0xe4, 0x1f, 0x26, 0x41, 0x18, 0xae, 0xcd,
+	0xf4, 0x9a, 0xb3, 0xc5, 0xe0, 0x87, 0x30, 0x9f, 0xb8, 0xdd, 0xcd, 0x8c, 0x9a, 0xec, 0x1b, 0xe0,
+	0xce, 0xb4, 0x81, 0x91, 0x87, 0x64, 0x62, 0x4a, 0x47, 0x13, 0xbc, 0x3f, 0x63, 0x92, 0xef, 0xac,
+	0xe4, 0x41, 0x0d, 0x0f, 0x42, 0x58, 0xba, 0x4c, 0x4c, 0xba, 0xe8, 0x8d, 0x6c, 0x1a, 0xd9, 0x53,
+	0x7a, 0xe7, 0xcd, 0x9c, 0xd8, 0x21, 0xd3, 0x1f, 0x03, 0x0a, 0xca, 0x50, 0x54, 0x3b, 0xd0, 0x4b,
+	0x53, 0x27, 0x16, 0x5e, 0xbe, 0x67, 0xa9, 0xee, 0x11, 0x54, 0x78, 0xe1, 0x44, 0x2f, 0x4f, 0xac,
+	0xa9, 0x52, 0xfb, 0x3b, 0xc1, 0xdc, 0x61, 0xc3, 0x10, 0x08, 0x7c, 0xcc, 0x12, 0x97, 0x54, 0xcb,
+	0xd1, 0x4a, 0xe6, 0xc6, 0x38, 0xd2, 0x84, 0x6c, 0x32, 0x01, 0x37, 0x64, 0xf6, 0x10, 0x1a, 0x1a,
+	0xa6, 0x2f, 0xc4, 0x49, 0x6e, 0x4e, 0x3c, 0x49, 0x2e, 0x9d, 0xac, 0xff, 0xa3, 0x04, 0xd5, 0xe0,
+	0x22, 0xf4, 0x02, 0x0a, 0xf2, 0x05, 0x54, 0xc8, 0x0f, 0x61, 0x3e, 0xf1, 0x3b, 0x43, 0x66, 0x00,
+	0x65, 0xff, 0xf2, 0x30, 0xcb, 0xc5, 0x3e, 0x10, 0xbf, 0x27, 0x87, 0xc1, 0xf2, 0xea, 0xa4, 0x2a,
+	0x9b, 0x8c, 0x93, 0x19, 0x84, 0x1f, 0x02, 0x48, 0xd1, 0x30, 0x7d, 0x7e, 0xa7, 0x97, 0x1b, 0xb3,
+	0xe8, 0x6d, 0x86, 0xb1, 0x70, 0x7d, 0x6a, 0x2c, 0xcc, 0xa0, 0x73, 0xff, 0xee, 0x4f, 0xee, 0x0c,
+	0x4d, 0xff, 0x68, 0x7c, 0x40, 0xdf, 0xac, 0x71, 0xd4, 0x37, 0x4d, 0x57, 0x3c, 0xad, 0x05, 0x86,
+	0x5b, 0x63, 0xbb, 0xd7, 0x28, 0xf1, 0xd1, 0xc1, 0x41, 0x85, 0xad, 0xee, 0xfe, 0x7f, 0x00, 0xf0,
+	0x62, 0x9d, 0x8a, 0xa7, 0x2e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DataCoordClient is the client API for DataCoord service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DataCoordClient interface {
+	GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error)
+	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
+	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
+	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
+	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
+	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
+	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
+	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
+	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
+	CompleteCompaction(ctx context.Context, in *CompactionResult, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*milvuspb.ImportResponse, error)
+	GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error)
+	ReportImport(ctx context.Context, in *ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error)
+}
+
+type dataCoordClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDataCoordClient(cc *grpc.ClientConn) DataCoordClient {
+	return &dataCoordClient{cc}
+}
+
+func (c *dataCoordClient) GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error) {
+	out := new(internalpb.ComponentStates)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetComponentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTimeTickChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStatisticsChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
+	out := new(FlushResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Flush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error) {
+	out := new(AssignSegmentIDResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AssignSegmentID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
+	out := new(GetSegmentInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error) {
 	out := new(GetSegmentStatesResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentStates", in, out, opts...)
 	if err != nil {
@@ -2762,6 +3577,42 @@ func (c *dataCoordClient) GetFlushedSegments(ctx context.Context, in *GetFlushed
 	return out, nil
 }
 
+func (c *dataCoordClient) CompleteCompaction(ctx context.Context, in *CompactionResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CompleteCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*milvuspb.ImportResponse, error) {
+	out := new(milvuspb.ImportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error) {
+	out := new(milvuspb.GetImportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetImportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ReportImport(ctx context.Context, in *ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ReportImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataCoordServer is the server API for DataCoord service.
 type DataCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -2778,6 +3629,10 @@ type DataCoordServer interface {
 	SaveBinlogPaths(context.Context, *SaveBinlogPathsRequest) (*commonpb.Status, error)
 	GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error)
 	GetFlushedSegments(context.Context, *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error)
+	CompleteCompaction(context.Context, *CompactionResult) (*commonpb.Status, error)
+	Import(context.Context, *ImportTaskRequest) (*milvuspb.ImportResponse, error)
+	GetImportState(context.Context, *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error)
+	ReportImport(context.Context, *ImportResult) (*commonpb.Status, error)
 }
 
 // UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
@@ -2826,6 +3681,18 @@ func (*UnimplementedDataCoordServer) GetRecoveryInfo(ctx context.Context, req *G
 func (*UnimplementedDataCoordServer) GetFlushedSegments(ctx context.Context, req *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFlushedSegments not implemented")
 }
+func (*UnimplementedDataCoordServer) CompleteCompaction(ctx context.Context, req *CompactionResult) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteCompaction not implemented")
+}
+func (*UnimplementedDataCoordServer) Import(ctx context.Context, req *ImportTaskRequest) (*milvuspb.ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (*UnimplementedDataCoordServer) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportState not implemented")
+}
+func (*UnimplementedDataCoordServer) ReportImport(ctx context.Context, req *ImportResult) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportImport not implemented")
+}
 
 func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
 	s.RegisterService(&_DataCoord_serviceDesc, srv)
@@ -3083,6 +3950,78 @@ func _DataCoord_GetFlushedSegments_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_CompleteCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactionResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CompleteCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/CompleteCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CompleteCompaction(ctx, req.(*CompactionResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).Import(ctx, req.(*ImportTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetImportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetImportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetImportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetImportState(ctx, req.(*milvuspb.GetImportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ReportImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ReportImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ReportImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ReportImport(ctx, req.(*ImportResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataCoord",
 	HandlerType: (*DataCoordServer)(nil),
@@ -3143,6 +4082,22 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFlushedSegments",
 			Handler:    _DataCoord_GetFlushedSegments_Handler,
 		},
+		{
+			MethodName: "CompleteCompaction",
+			Handler:    _DataCoord_CompleteCompaction_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _DataCoord_Import_Handler,
+		},
+		{
+			MethodName: "GetImportState",
+			Handler:    _DataCoord_GetImportState_Handler,
+		},
+		{
+			MethodName: "ReportImport",
+			Handler:    _DataCoord_ReportImport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -3156,6 +4111,8 @@ type DataNodeClient interface {
 	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	WatchDmChannels(ctx context.Context, in *WatchDmChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	FlushSegments(ctx context.Context, in *FlushSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Compaction(ctx context.Context, in *CompactionPlan, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Import(ctx context.Context, in *ImportTask, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type dataNodeClient struct {
@@ -3202,12 +4159,32 @@ func (c *dataNodeClient) FlushSegments(ctx context.Context, in *FlushSegmentsReq
 	return out, nil
 }
 
+func (c *dataNodeClient) Compaction(ctx context.Context, in *CompactionPlan, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/Compaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataNodeClient) Import(ctx context.Context, in *ImportTask, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataNodeServer is the server API for DataNode service.
 type DataNodeServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
 	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
 	WatchDmChannels(context.Context, *WatchDmChannelsRequest) (*commonpb.Status, error)
 	FlushSegments(context.Context, *FlushSegmentsRequest) (*commonpb.Status, error)
+	Compaction(context.Context, *CompactionPlan) (*commonpb.Status, error)
+	Import(context.Context, *ImportTask) (*commonpb.Status, error)
 }
 
 // UnimplementedDataNodeServer can be embedded to have forward compatible implementations.
@@ -3226,6 +4203,12 @@ func (*UnimplementedDataNodeServer) WatchDmChannels(ctx context.Context, req *Wa
 func (*UnimplementedDataNodeServer) FlushSegments(ctx context.Context, req *FlushSegmentsRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FlushSegments not implemented")
 }
+func (*UnimplementedDataNodeServer) Compaction(ctx context.Context, req *CompactionPlan) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Compaction not implemented")
+}
+func (*UnimplementedDataNodeServer) Import(ctx context.Context, req *ImportTask) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
 
 func RegisterDataNodeServer(s *grpc.Server, srv DataNodeServer) {
 	s.RegisterService(&_DataNode_serviceDesc, srv)
@@ -3303,6 +4286,42 @@ func _DataNode_FlushSegments_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_Compaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactionPlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).Compaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/Compaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).Compaction(ctx, req.(*CompactionPlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataNode_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).Import(ctx, req.(*ImportTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataNode",
 	HandlerType: (*DataNodeServer)(nil),
@@ -3323,6 +4342,14 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FlushSegments",
 			Handler:    _DataNode_FlushSegments_Handler,
 		},
+		{
+			MethodName: "Compaction",
+			Handler:    _DataNode_Compaction_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _DataNode_Import_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
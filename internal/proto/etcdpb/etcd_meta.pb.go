@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	commonpb "github.com/milvus-io/milvus/internal/proto/commonpb"
+	milvuspb "github.com/milvus-io/milvus/internal/proto/milvuspb"
 	schemapb "github.com/milvus-io/milvus/internal/proto/schemapb"
 	math "math"
 )
@@ -251,6 +252,8 @@ type CollectionInfo struct {
 	FieldIndexes         []*FieldIndexInfo          `protobuf:"bytes,6,rep,name=field_indexes,json=fieldIndexes,proto3" json:"field_indexes,omitempty"`
 	VirtualChannelNames  []string                   `protobuf:"bytes,7,rep,name=virtual_channel_names,json=virtualChannelNames,proto3" json:"virtual_channel_names,omitempty"`
 	PhysicalChannelNames []string                   `protobuf:"bytes,8,rep,name=physical_channel_names,json=physicalChannelNames,proto3" json:"physical_channel_names,omitempty"`
+	DbId                 int64                      `protobuf:"varint,9,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
+	DropTime             uint64                     `protobuf:"varint,10,opt,name=drop_time,json=dropTime,proto3" json:"drop_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -337,6 +340,249 @@ func (m *CollectionInfo) GetPhysicalChannelNames() []string {
 	return nil
 }
 
+func (m *CollectionInfo) GetDbId() int64 {
+	if m != nil {
+		return m.DbId
+	}
+	return 0
+}
+
+func (m *CollectionInfo) GetDropTime() uint64 {
+	if m != nil {
+		return m.DropTime
+	}
+	return 0
+}
+
+// DroppedPartitionInfo is the meta of a dropped partition which is kept within the retention duration
+type DroppedPartitionInfo struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	PartitionName        string   `protobuf:"bytes,3,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	DropTime             uint64   `protobuf:"varint,4,opt,name=drop_time,json=dropTime,proto3" json:"drop_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DroppedPartitionInfo) Reset()         { *m = DroppedPartitionInfo{} }
+func (m *DroppedPartitionInfo) String() string { return proto.CompactTextString(m) }
+func (*DroppedPartitionInfo) ProtoMessage()    {}
+func (*DroppedPartitionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{5}
+}
+
+func (m *DroppedPartitionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DroppedPartitionInfo.Unmarshal(m, b)
+}
+func (m *DroppedPartitionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DroppedPartitionInfo.Marshal(b, m, deterministic)
+}
+func (m *DroppedPartitionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DroppedPartitionInfo.Merge(m, src)
+}
+func (m *DroppedPartitionInfo) XXX_Size() int {
+	return xxx_messageInfo_DroppedPartitionInfo.Size(m)
+}
+func (m *DroppedPartitionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DroppedPartitionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DroppedPartitionInfo proto.InternalMessageInfo
+
+func (m *DroppedPartitionInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *DroppedPartitionInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *DroppedPartitionInfo) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *DroppedPartitionInfo) GetDropTime() uint64 {
+	if m != nil {
+		return m.DropTime
+	}
+	return 0
+}
+
+type DatabaseInfo struct {
+	ID                   int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreateTime           uint64   `protobuf:"varint,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatabaseInfo) Reset()         { *m = DatabaseInfo{} }
+func (m *DatabaseInfo) String() string { return proto.CompactTextString(m) }
+func (*DatabaseInfo) ProtoMessage()    {}
+func (*DatabaseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{6}
+}
+
+func (m *DatabaseInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseInfo.Unmarshal(m, b)
+}
+func (m *DatabaseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseInfo.Marshal(b, m, deterministic)
+}
+func (m *DatabaseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseInfo.Merge(m, src)
+}
+func (m *DatabaseInfo) XXX_Size() int {
+	return xxx_messageInfo_DatabaseInfo.Size(m)
+}
+func (m *DatabaseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseInfo proto.InternalMessageInfo
+
+func (m *DatabaseInfo) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *DatabaseInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DatabaseInfo) GetCreateTime() uint64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+type UserInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PasswordHash         string   `protobuf:"bytes,2,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
+	Roles                []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	CreateTime           uint64   `protobuf:"varint,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserInfo) Reset()         { *m = UserInfo{} }
+func (m *UserInfo) String() string { return proto.CompactTextString(m) }
+func (*UserInfo) ProtoMessage()    {}
+func (*UserInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{7}
+}
+
+func (m *UserInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserInfo.Unmarshal(m, b)
+}
+func (m *UserInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserInfo.Marshal(b, m, deterministic)
+}
+func (m *UserInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserInfo.Merge(m, src)
+}
+func (m *UserInfo) XXX_Size() int {
+	return xxx_messageInfo_UserInfo.Size(m)
+}
+func (m *UserInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserInfo proto.InternalMessageInfo
+
+func (m *UserInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UserInfo) GetPasswordHash() string {
+	if m != nil {
+		return m.PasswordHash
+	}
+	return ""
+}
+
+func (m *UserInfo) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *UserInfo) GetCreateTime() uint64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+type RoleInfo struct {
+	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Grants               []*milvuspb.Grant `protobuf:"bytes,2,rep,name=grants,proto3" json:"grants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RoleInfo) Reset()         { *m = RoleInfo{} }
+func (m *RoleInfo) String() string { return proto.CompactTextString(m) }
+func (*RoleInfo) ProtoMessage()    {}
+func (*RoleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{8}
+}
+
+func (m *RoleInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleInfo.Unmarshal(m, b)
+}
+func (m *RoleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleInfo.Marshal(b, m, deterministic)
+}
+func (m *RoleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleInfo.Merge(m, src)
+}
+func (m *RoleInfo) XXX_Size() int {
+	return xxx_messageInfo_RoleInfo.Size(m)
+}
+func (m *RoleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleInfo proto.InternalMessageInfo
+
+func (m *RoleInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RoleInfo) GetGrants() []*milvuspb.Grant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
 type SegmentIndexInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -354,7 +600,7 @@ func (m *SegmentIndexInfo) Reset()         { *m = SegmentIndexInfo{} }
 func (m *SegmentIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentIndexInfo) ProtoMessage()    {}
 func (*SegmentIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{5}
+	return fileDescriptor_975d306d62b73e88, []int{9}
 }
 
 func (m *SegmentIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -440,7 +686,7 @@ func (m *CollectionMeta) Reset()         { *m = CollectionMeta{} }
 func (m *CollectionMeta) String() string { return proto.CompactTextString(m) }
 func (*CollectionMeta) ProtoMessage()    {}
 func (*CollectionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{6}
+	return fileDescriptor_975d306d62b73e88, []int{10}
 }
 
 func (m *CollectionMeta) XXX_Unmarshal(b []byte) error {
@@ -509,6 +755,10 @@ func init() {
 	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.etcd.IndexInfo")
 	proto.RegisterType((*FieldIndexInfo)(nil), "milvus.proto.etcd.FieldIndexInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.etcd.CollectionInfo")
+	proto.RegisterType((*DroppedPartitionInfo)(nil), "milvus.proto.etcd.DroppedPartitionInfo")
+	proto.RegisterType((*DatabaseInfo)(nil), "milvus.proto.etcd.DatabaseInfo")
+	proto.RegisterType((*UserInfo)(nil), "milvus.proto.etcd.UserInfo")
+	proto.RegisterType((*RoleInfo)(nil), "milvus.proto.etcd.RoleInfo")
 	proto.RegisterType((*SegmentIndexInfo)(nil), "milvus.proto.etcd.SegmentIndexInfo")
 	proto.RegisterType((*CollectionMeta)(nil), "milvus.proto.etcd.CollectionMeta")
 }
@@ -516,47 +766,57 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 664 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x95, 0x93, 0x36, 0xa9, 0x6f, 0xdc, 0xb4, 0x9d, 0xef, 0x47, 0x56, 0xd5, 0xef, 0xc3, 0xb5,
-	0xd4, 0x12, 0x09, 0x91, 0x88, 0x16, 0xb1, 0x63, 0x01, 0xb5, 0x2a, 0x45, 0x88, 0xaa, 0xb8, 0x15,
-	0x0b, 0x36, 0xd6, 0xc4, 0xbe, 0x49, 0x46, 0xb2, 0xc7, 0xc1, 0x33, 0xae, 0x9a, 0x1d, 0x6b, 0x1e,
-	0x81, 0x17, 0x64, 0xc1, 0x3b, 0x20, 0xe4, 0x19, 0xdb, 0x71, 0xda, 0xb0, 0x64, 0xe7, 0x73, 0xee,
-	0xdc, 0xf1, 0xb9, 0xe7, 0x9e, 0x81, 0x3d, 0x94, 0x61, 0x14, 0x24, 0x28, 0xe9, 0x70, 0x91, 0xa5,
-	0x32, 0x25, 0x07, 0x09, 0x8b, 0xef, 0x72, 0xa1, 0xd1, 0xb0, 0xa8, 0x1e, 0x5a, 0x61, 0x9a, 0x24,
-	0x29, 0xd7, 0xd4, 0xa1, 0x25, 0xc2, 0x39, 0x26, 0xe5, 0x71, 0xf7, 0x9b, 0x01, 0x70, 0x8b, 0x9c,
-	0x72, 0xf9, 0x1e, 0x25, 0x25, 0x7d, 0x68, 0x8d, 0x3d, 0xdb, 0x70, 0x8c, 0x41, 0xdb, 0x6f, 0x8d,
-	0x3d, 0x72, 0x0a, 0x7b, 0x3c, 0x4f, 0x82, 0xcf, 0x39, 0x66, 0xcb, 0x80, 0xa7, 0x11, 0x0a, 0xbb,
-	0xa5, 0x8a, 0xbb, 0x3c, 0x4f, 0x3e, 0x14, 0xec, 0x55, 0x41, 0x92, 0x67, 0x70, 0xc0, 0xb8, 0xc0,
-	0x4c, 0x06, 0xe1, 0x9c, 0x72, 0x8e, 0xf1, 0xd8, 0x13, 0x76, 0xdb, 0x69, 0x0f, 0x4c, 0x7f, 0x5f,
-	0x17, 0x2e, 0x6a, 0x9e, 0x3c, 0x85, 0x3d, 0x7d, 0x61, 0x7d, 0xd6, 0xde, 0x72, 0x8c, 0x81, 0xe9,
-	0xf7, 0x15, 0x5d, 0x9f, 0x74, 0xbf, 0x18, 0x60, 0x5e, 0x67, 0xe9, 0xfd, 0x72, 0xa3, 0xb6, 0x57,
-	0xd0, 0xa5, 0x51, 0x94, 0xa1, 0xd0, 0x9a, 0x7a, 0x67, 0x47, 0xc3, 0xb5, 0xd9, 0xcb, 0xa9, 0xdf,
-	0xe8, 0x33, 0x7e, 0x75, 0xb8, 0xd0, 0x9a, 0xa1, 0xc8, 0xe3, 0x4d, 0x5a, 0x75, 0x61, 0xa5, 0xd5,
-	0xfd, 0x6a, 0x80, 0x39, 0xe6, 0x11, 0xde, 0x8f, 0xf9, 0x34, 0x25, 0xff, 0x01, 0xb0, 0x02, 0x04,
-	0x9c, 0x26, 0xa8, 0xa4, 0x98, 0xbe, 0xa9, 0x98, 0x2b, 0x9a, 0x20, 0xb1, 0xa1, 0xab, 0xc0, 0xd8,
-	0x2b, 0x5d, 0xaa, 0x20, 0xf1, 0xc0, 0xd2, 0x8d, 0x0b, 0x9a, 0xd1, 0x44, 0xff, 0xae, 0x77, 0x76,
-	0xbc, 0x51, 0xf0, 0x3b, 0x5c, 0x7e, 0xa4, 0x71, 0x8e, 0xd7, 0x94, 0x65, 0x7e, 0x4f, 0xb5, 0x5d,
-	0xab, 0x2e, 0xd7, 0x83, 0xfe, 0x25, 0xc3, 0x38, 0x5a, 0x09, 0xb2, 0xa1, 0x3b, 0x65, 0x31, 0x46,
-	0xb5, 0x31, 0x15, 0xfc, 0xbd, 0x16, 0xf7, 0x67, 0x0b, 0xfa, 0x17, 0x69, 0x1c, 0x63, 0x28, 0x59,
-	0xca, 0xd5, 0x35, 0x0f, 0xad, 0x7d, 0x0d, 0x1d, 0x9d, 0x92, 0xd2, 0xd9, 0x93, 0x75, 0xa1, 0x65,
-	0x82, 0x56, 0x97, 0xdc, 0x28, 0xc2, 0x2f, 0x9b, 0xc8, 0x13, 0xe8, 0x85, 0x19, 0x52, 0x89, 0x81,
-	0x64, 0x09, 0xda, 0x6d, 0xc7, 0x18, 0x6c, 0xf9, 0xa0, 0xa9, 0x5b, 0x96, 0x20, 0x71, 0xc1, 0x5a,
-	0xd0, 0x4c, 0x32, 0x25, 0xc0, 0x13, 0xf6, 0x96, 0xd3, 0x1e, 0xb4, 0xfd, 0x35, 0x8e, 0x9c, 0x42,
-	0xbf, 0xc6, 0x85, 0xbb, 0xc2, 0xde, 0x56, 0x3b, 0x7a, 0xc0, 0x92, 0x4b, 0xd8, 0x9d, 0x16, 0xa6,
-	0x04, 0x6a, 0x3e, 0x14, 0x76, 0x67, 0x93, 0xb7, 0xc5, 0x43, 0x18, 0xae, 0x9b, 0xe7, 0x5b, 0xd3,
-	0x1a, 0xa3, 0x20, 0x67, 0xf0, 0xcf, 0x1d, 0xcb, 0x64, 0x4e, 0xe3, 0x2a, 0x17, 0x6a, 0xcb, 0xc2,
-	0xee, 0xaa, 0xdf, 0xfe, 0x55, 0x16, 0xcb, 0x6c, 0xe8, 0x7f, 0xbf, 0x84, 0x7f, 0x17, 0xf3, 0xa5,
-	0x60, 0xe1, 0xa3, 0xa6, 0x1d, 0xd5, 0xf4, 0x77, 0x55, 0x6d, 0x76, 0xb9, 0xdf, 0x0d, 0xd8, 0xbf,
-	0xc1, 0x59, 0x82, 0x5c, 0xae, 0x36, 0xe9, 0x82, 0x15, 0xae, 0x96, 0x52, 0x2d, 0x63, 0x8d, 0x23,
-	0x0e, 0xf4, 0x1a, 0x16, 0x95, 0x7b, 0x6d, 0x52, 0xe4, 0x08, 0x4c, 0x51, 0xde, 0xec, 0x29, 0xdf,
-	0xdb, 0xfe, 0x8a, 0xd0, 0x69, 0x29, 0x46, 0xd6, 0x0f, 0x4e, 0xa5, 0x45, 0xc1, 0x66, 0x5a, 0xb6,
-	0xd7, 0x93, 0x6b, 0x43, 0x77, 0x92, 0x33, 0xd5, 0xd3, 0xd1, 0x95, 0x12, 0x92, 0x63, 0xb0, 0x90,
-	0xd3, 0x49, 0x8c, 0xda, 0x79, 0xbb, 0xeb, 0x18, 0x83, 0x1d, 0xbf, 0xa7, 0x39, 0x35, 0x98, 0xfb,
-	0xc3, 0x68, 0x46, 0x6d, 0xe3, 0x2b, 0xfe, 0xd3, 0x51, 0xfb, 0x1f, 0xa0, 0x36, 0xa0, 0x0a, 0x5a,
-	0x83, 0x21, 0x27, 0x8d, 0x98, 0x05, 0x92, 0xce, 0xaa, 0x98, 0xed, 0xd6, 0xec, 0x2d, 0x9d, 0x89,
-	0x47, 0x89, 0xed, 0x3c, 0x4e, 0xec, 0xdb, 0xf3, 0x4f, 0x2f, 0x66, 0x4c, 0xce, 0xf3, 0x49, 0xf1,
-	0x92, 0x47, 0x7a, 0x8c, 0xe7, 0x2c, 0x2d, 0xbf, 0x46, 0x8c, 0x4b, 0xcc, 0x38, 0x8d, 0x47, 0x6a,
-	0xb2, 0x51, 0x91, 0xc8, 0xc5, 0x64, 0xd2, 0x51, 0xe8, 0xfc, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x06, 0xbe, 0x60, 0xc9, 0xc9, 0x05, 0x00, 0x00,
+	// 830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x97, 0xeb, 0x34, 0x89, 0x5f, 0xdc, 0x74, 0x77, 0xb6, 0x20, 0xab, 0x2c, 0x90, 0x35, 0xda,
+	0x25, 0x12, 0xa2, 0x15, 0x5d, 0xc4, 0x8d, 0x03, 0xac, 0xb5, 0x10, 0x21, 0x56, 0x65, 0x5a, 0x38,
+	0x70, 0xb1, 0x26, 0xf1, 0x6b, 0x32, 0x92, 0x3d, 0x36, 0x33, 0xe3, 0x65, 0xcb, 0x89, 0x33, 0x1f,
+	0x81, 0x13, 0x1f, 0x81, 0x6f, 0xc5, 0x81, 0x2f, 0x81, 0x66, 0xc6, 0x4e, 0xe2, 0x26, 0x15, 0x17,
+	0xb8, 0xf9, 0xfd, 0xde, 0x9f, 0x79, 0xfe, 0xcd, 0x6f, 0x7e, 0x70, 0x8c, 0x7a, 0x91, 0xa5, 0x05,
+	0x6a, 0x76, 0x56, 0xc9, 0x52, 0x97, 0xe4, 0x61, 0xc1, 0xf3, 0xd7, 0xb5, 0x72, 0xd1, 0x99, 0xc9,
+	0x9e, 0x86, 0x8b, 0xb2, 0x28, 0x4a, 0xe1, 0xa0, 0xd3, 0x50, 0x2d, 0x56, 0x58, 0xb0, 0x36, 0xda,
+	0x2e, 0x8f, 0x7f, 0xf7, 0x00, 0xae, 0x51, 0x30, 0xa1, 0xbf, 0x45, 0xcd, 0xc8, 0x18, 0x0e, 0x66,
+	0x49, 0xe4, 0x4d, 0xbc, 0xa9, 0x4f, 0x0f, 0x66, 0x09, 0x79, 0x06, 0xc7, 0xa2, 0x2e, 0xd2, 0x9f,
+	0x6a, 0x94, 0xb7, 0xa9, 0x28, 0x33, 0x54, 0xd1, 0x81, 0x4d, 0x1e, 0x89, 0xba, 0xf8, 0xce, 0xa0,
+	0xaf, 0x0c, 0x48, 0x3e, 0x82, 0x87, 0x5c, 0x28, 0x94, 0x3a, 0x5d, 0xac, 0x98, 0x10, 0x98, 0xcf,
+	0x12, 0x15, 0xf9, 0x13, 0x7f, 0x1a, 0xd0, 0x07, 0x2e, 0xf1, 0x62, 0x8d, 0x93, 0x0f, 0xe1, 0xd8,
+	0x0d, 0x5c, 0xd7, 0x46, 0xbd, 0x89, 0x37, 0x0d, 0xe8, 0xd8, 0xc2, 0xeb, 0xca, 0xf8, 0x57, 0x0f,
+	0x82, 0x4b, 0x59, 0xbe, 0xb9, 0xdd, 0xbb, 0xdb, 0x67, 0x30, 0x60, 0x59, 0x26, 0x51, 0xb9, 0x9d,
+	0x46, 0x17, 0x8f, 0xcf, 0x3a, 0x4c, 0x34, 0x1c, 0x7c, 0xe1, 0x6a, 0x68, 0x5b, 0x6c, 0x76, 0x95,
+	0xa8, 0xea, 0x7c, 0xdf, 0xae, 0x2e, 0xb1, 0xd9, 0x35, 0xfe, 0xcd, 0x83, 0x60, 0x26, 0x32, 0x7c,
+	0x33, 0x13, 0x37, 0x25, 0x79, 0x17, 0x80, 0x9b, 0x20, 0x15, 0xac, 0x40, 0xbb, 0x4a, 0x40, 0x03,
+	0x8b, 0xbc, 0x62, 0x05, 0x92, 0x08, 0x06, 0x36, 0x98, 0x25, 0x0d, 0x4b, 0x6d, 0x48, 0x12, 0x08,
+	0x5d, 0x63, 0xc5, 0x24, 0x2b, 0xdc, 0x71, 0xa3, 0x8b, 0x27, 0x7b, 0x17, 0xfe, 0x06, 0x6f, 0x7f,
+	0x60, 0x79, 0x8d, 0x97, 0x8c, 0x4b, 0x3a, 0xb2, 0x6d, 0x97, 0xb6, 0x2b, 0x4e, 0x60, 0xfc, 0x92,
+	0x63, 0x9e, 0x6d, 0x16, 0x8a, 0x60, 0x70, 0xc3, 0x73, 0xcc, 0xd6, 0xc4, 0xb4, 0xe1, 0xfd, 0xbb,
+	0xc4, 0x7f, 0xfa, 0x30, 0x7e, 0x51, 0xe6, 0x39, 0x2e, 0x34, 0x2f, 0x85, 0x1d, 0x73, 0x97, 0xda,
+	0xcf, 0xa1, 0xef, 0x34, 0xd3, 0x30, 0xfb, 0xb4, 0xbb, 0x68, 0xa3, 0xa7, 0xcd, 0x90, 0x2b, 0x0b,
+	0xd0, 0xa6, 0x89, 0xbc, 0x0f, 0xa3, 0x85, 0x44, 0xa6, 0x31, 0xd5, 0xbc, 0xc0, 0xc8, 0x9f, 0x78,
+	0xd3, 0x1e, 0x05, 0x07, 0x5d, 0xf3, 0x02, 0x49, 0x0c, 0x61, 0xc5, 0xa4, 0xe6, 0x76, 0x81, 0x44,
+	0x45, 0xbd, 0x89, 0x3f, 0xf5, 0x69, 0x07, 0x23, 0xcf, 0x60, 0xbc, 0x8e, 0x0d, 0xbb, 0x2a, 0x3a,
+	0xb4, 0x77, 0x74, 0x07, 0x25, 0x2f, 0xe1, 0xe8, 0xc6, 0x90, 0x92, 0xda, 0xff, 0x43, 0x15, 0xf5,
+	0xf7, 0x71, 0x6b, 0x9e, 0xc5, 0x59, 0x97, 0x3c, 0x1a, 0xde, 0xac, 0x63, 0x54, 0xe4, 0x02, 0xde,
+	0x7a, 0xcd, 0xa5, 0xae, 0x59, 0xde, 0xea, 0xc2, 0xde, 0xb2, 0x8a, 0x06, 0xf6, 0xd8, 0x47, 0x4d,
+	0xb2, 0xd1, 0x86, 0x3b, 0xfb, 0x53, 0x78, 0xbb, 0x5a, 0xdd, 0x2a, 0xbe, 0xd8, 0x69, 0x1a, 0xda,
+	0xa6, 0x93, 0x36, 0xdb, 0xe9, 0x7a, 0x04, 0x87, 0xd9, 0x3c, 0xe5, 0x59, 0x14, 0x58, 0xc2, 0x7b,
+	0xd9, 0x7c, 0x96, 0x91, 0x77, 0x20, 0xc8, 0x64, 0x59, 0x39, 0xc6, 0xc0, 0x32, 0x36, 0x34, 0x80,
+	0xe1, 0x2b, 0xfe, 0xc3, 0x83, 0x93, 0x44, 0x96, 0x55, 0x85, 0xd9, 0xe5, 0x9a, 0x23, 0x73, 0x71,
+	0x31, 0x84, 0x8b, 0xcd, 0x55, 0xb6, 0x57, 0xd8, 0xc1, 0xc8, 0x04, 0x46, 0x5b, 0xc4, 0x36, 0x6a,
+	0xd8, 0x86, 0xc8, 0xd3, 0x2d, 0xaa, 0x9d, 0xb4, 0x7d, 0x2b, 0xed, 0xa3, 0x0e, 0xd5, 0xdd, 0x15,
+	0x7b, 0x77, 0x56, 0xbc, 0x82, 0x30, 0x61, 0x9a, 0xcd, 0x99, 0xc2, 0xbd, 0x92, 0x22, 0xd0, 0xb3,
+	0x93, 0x0f, 0xec, 0x64, 0xfb, 0xfd, 0xaf, 0x3a, 0x89, 0x7f, 0x81, 0xe1, 0xf7, 0x0a, 0xa5, 0x1d,
+	0xd8, 0x0e, 0xf0, 0xb6, 0x06, 0x7c, 0x00, 0x47, 0x15, 0x53, 0xea, 0xe7, 0x52, 0x66, 0xe9, 0x8a,
+	0xa9, 0x55, 0x33, 0x3d, 0x6c, 0xc1, 0xaf, 0x99, 0x5a, 0x91, 0x13, 0x38, 0x94, 0x65, 0x8e, 0xed,
+	0x1b, 0x77, 0xc1, 0xdd, 0xb3, 0x7b, 0x3b, 0x67, 0x53, 0x18, 0xd2, 0x32, 0xc7, 0x7b, 0xcf, 0xbe,
+	0x80, 0xfe, 0x52, 0x32, 0xa1, 0x8d, 0xfb, 0x18, 0xc1, 0x9d, 0x76, 0x05, 0xd7, 0x04, 0x5f, 0x99,
+	0x12, 0xda, 0x54, 0xc6, 0x7f, 0x79, 0xf0, 0xe0, 0x0a, 0x97, 0x05, 0x0a, 0xbd, 0x79, 0xc3, 0xff,
+	0xcd, 0x1d, 0x3e, 0x86, 0x40, 0x35, 0x93, 0x13, 0xcb, 0xa4, 0x4f, 0x37, 0x80, 0xf3, 0x09, 0x23,
+	0x76, 0x67, 0xb5, 0x3e, 0x6d, 0xc3, 0x6d, 0x9f, 0x38, 0xec, 0x7a, 0x56, 0x04, 0x83, 0x79, 0xcd,
+	0x6d, 0x4f, 0xdf, 0x65, 0x9a, 0x90, 0x3c, 0x81, 0x10, 0x05, 0x9b, 0xe7, 0xe8, 0xde, 0x5c, 0x34,
+	0x98, 0x78, 0xd3, 0x21, 0x1d, 0x39, 0xcc, 0xfe, 0x58, 0xfc, 0xb7, 0xb7, 0x6d, 0x32, 0x7b, 0xfd,
+	0xfb, 0xff, 0x36, 0x99, 0xf7, 0x00, 0xd6, 0x04, 0xb4, 0x16, 0xb3, 0x85, 0x74, 0x55, 0xaf, 0xd9,
+	0xb2, 0x35, 0x98, 0x8d, 0xea, 0xaf, 0xd9, 0x52, 0xed, 0x78, 0x55, 0x7f, 0xd7, 0xab, 0xbe, 0x7c,
+	0xfe, 0xe3, 0x27, 0x4b, 0xae, 0x57, 0xf5, 0xdc, 0x78, 0xf8, 0xb9, 0xfb, 0x8d, 0x8f, 0x79, 0xd9,
+	0x7c, 0x9d, 0x73, 0xa1, 0x51, 0x0a, 0x96, 0x9f, 0xdb, 0x3f, 0x3b, 0x37, 0x5e, 0x54, 0xcd, 0xe7,
+	0x7d, 0x1b, 0x3d, 0xff, 0x67, 0x00, 0x3a, 0xa2, 0xf3, 0xd0, 0xd1, 0x07, 0x00, 0x00,
 }
@@ -550,6 +550,7 @@ type IndexFilePathInfo struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IndexBuildID         int64            `protobuf:"varint,2,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
 	IndexFilePaths       []string         `protobuf:"bytes,3,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
+	SerializedSize       int64            `protobuf:"varint,4,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -601,6 +602,13 @@ func (m *IndexFilePathInfo) GetIndexFilePaths() []string {
 	return nil
 }
 
+func (m *IndexFilePathInfo) GetSerializedSize() int64 {
+	if m != nil {
+		return m.SerializedSize
+	}
+	return 0
+}
+
 type GetIndexFilePathsResponse struct {
 	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	FilePaths            []*IndexFilePathInfo `protobuf:"bytes,2,rep,name=file_paths,json=filePaths,proto3" json:"file_paths,omitempty"`
@@ -658,6 +666,7 @@ type IndexMeta struct {
 	NodeID               int64               `protobuf:"varint,7,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	Version              int64               `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
 	Recycled             bool                `protobuf:"varint,9,opt,name=recycled,proto3" json:"recycled,omitempty"`
+	SerializedSize       int64               `protobuf:"varint,10,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -751,6 +760,13 @@ func (m *IndexMeta) GetRecycled() bool {
 	return false
 }
 
+func (m *IndexMeta) GetSerializedSize() int64 {
+	if m != nil {
+		return m.SerializedSize
+	}
+	return 0
+}
+
 type DropIndexRequest struct {
 	IndexID              int64    `protobuf:"varint,1,opt,name=indexID,proto3" json:"indexID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -809,68 +825,70 @@ func init() {
 func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
 
 var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 970 bytes of a gzipped FileDescriptorProto
+	// 995 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xef, 0x7a, 0x1b, 0xff, 0x79, 0x36, 0x51, 0x33, 0x94, 0x6a, 0x71, 0xa9, 0xea, 0x2c, 0x05,
-	0x0c, 0x6a, 0x9d, 0xca, 0xa5, 0x70, 0x42, 0x82, 0xc4, 0x22, 0xb2, 0x50, 0xab, 0x68, 0x1a, 0x71,
-	0x40, 0x42, 0xd6, 0xc4, 0xfb, 0x92, 0x8c, 0xba, 0xff, 0xb2, 0x33, 0xae, 0xc8, 0x9d, 0x3b, 0x37,
-	0x10, 0x1f, 0x04, 0xf1, 0x39, 0x38, 0x70, 0xe2, 0xcb, 0xa0, 0x99, 0x9d, 0xdd, 0xee, 0xae, 0xd7,
-	0x89, 0x43, 0x0a, 0x27, 0x6e, 0xfb, 0xde, 0xbc, 0x37, 0xbf, 0x79, 0xbf, 0xf7, 0xe6, 0xb7, 0x03,
-	0x5b, 0x3c, 0xf4, 0xf0, 0x87, 0xd9, 0x3c, 0x8a, 0x12, 0x6f, 0x14, 0x27, 0x91, 0x8c, 0x08, 0x09,
-	0xb8, 0xff, 0x6a, 0x21, 0x52, 0x6b, 0xa4, 0xd7, 0xfb, 0xbd, 0x79, 0x14, 0x04, 0x51, 0x98, 0xfa,
-	0xfa, 0x9b, 0x3c, 0x94, 0x98, 0x84, 0xcc, 0x37, 0x76, 0xaf, 0x98, 0xe1, 0xfe, 0x62, 0xc1, 0xdb,
-	0x14, 0x4f, 0xb8, 0x90, 0x98, 0x3c, 0x8f, 0x3c, 0xa4, 0x78, 0xb6, 0x40, 0x21, 0xc9, 0x63, 0xb8,
-	0x79, 0xc4, 0x04, 0x3a, 0xd6, 0xc0, 0x1a, 0x76, 0xc7, 0xef, 0x8d, 0x4a, 0x30, 0x66, 0xff, 0x67,
-	0xe2, 0x64, 0x97, 0x09, 0xa4, 0x3a, 0x92, 0x7c, 0x06, 0x2d, 0xe6, 0x79, 0x09, 0x0a, 0xe1, 0x34,
-	0x2e, 0x48, 0xfa, 0x2a, 0x8d, 0xa1, 0x59, 0x30, 0xb9, 0x03, 0xcd, 0x30, 0xf2, 0x70, 0x3a, 0x71,
-	0xec, 0x81, 0x35, 0xb4, 0xa9, 0xb1, 0xdc, 0x9f, 0x2c, 0xb8, 0x5d, 0x3e, 0x99, 0x88, 0xa3, 0x50,
-	0x20, 0x79, 0x02, 0x4d, 0x21, 0x99, 0x5c, 0x08, 0x73, 0xb8, 0xbb, 0xb5, 0x38, 0x2f, 0x74, 0x08,
-	0x35, 0xa1, 0x64, 0x17, 0xba, 0x3c, 0xe4, 0x72, 0x16, 0xb3, 0x84, 0x05, 0xd9, 0x09, 0xb7, 0x47,
-	0x15, 0xf6, 0x0c, 0x51, 0xd3, 0x90, 0xcb, 0x03, 0x1d, 0x48, 0x81, 0xe7, 0xdf, 0xee, 0x17, 0xf0,
-	0xce, 0x3e, 0xca, 0xa9, 0xe2, 0x58, 0xed, 0x8e, 0x22, 0x23, 0xeb, 0x01, 0xbc, 0xa5, 0x99, 0xdf,
-	0x5d, 0x70, 0xdf, 0x9b, 0x4e, 0xd4, 0xc1, 0xec, 0xa1, 0x4d, 0xcb, 0x4e, 0xf7, 0x77, 0x0b, 0x3a,
-	0x3a, 0x79, 0x1a, 0x1e, 0x47, 0xe4, 0x29, 0x6c, 0xa8, 0xa3, 0xa5, 0x0c, 0x6f, 0x8e, 0xef, 0xd7,
-	0x16, 0xf1, 0x1a, 0x8b, 0xa6, 0xd1, 0xc4, 0x85, 0x5e, 0x71, 0x57, 0x5d, 0x88, 0x4d, 0x4b, 0x3e,
-	0xe2, 0x40, 0x4b, 0xdb, 0x39, 0xa5, 0x99, 0x49, 0xee, 0x01, 0xa4, 0x23, 0x14, 0xb2, 0x00, 0x9d,
-	0x9b, 0x03, 0x6b, 0xd8, 0xa1, 0x1d, 0xed, 0x79, 0xce, 0x02, 0x54, 0xad, 0x48, 0x90, 0x89, 0x28,
-	0x74, 0x36, 0xf4, 0x92, 0xb1, 0xdc, 0x1f, 0x2d, 0xb8, 0x53, 0xad, 0xfc, 0x3a, 0xcd, 0x78, 0x9a,
-	0x26, 0xa1, 0xea, 0x83, 0x3d, 0xec, 0x8e, 0xef, 0x8d, 0x96, 0xa7, 0x78, 0x94, 0x53, 0x45, 0x4d,
-	0xb0, 0xfb, 0x47, 0x03, 0xc8, 0x5e, 0x82, 0x4c, 0xa2, 0x5e, 0xcb, 0xd8, 0xaf, 0x52, 0x62, 0xd5,
-	0x50, 0x52, 0x2e, 0xbc, 0x51, 0x2d, 0x7c, 0x35, 0x63, 0x0e, 0xb4, 0x5e, 0x61, 0x22, 0x78, 0x14,
-	0x6a, 0xba, 0x6c, 0x9a, 0x99, 0xe4, 0x2e, 0x74, 0x02, 0x94, 0x6c, 0x16, 0x33, 0x79, 0x6a, 0xf8,
-	0x6a, 0x2b, 0xc7, 0x01, 0x93, 0xa7, 0x0a, 0xcf, 0x63, 0x66, 0x51, 0x38, 0xcd, 0x81, 0xad, 0xf0,
-	0x94, 0x47, 0xad, 0xea, 0x69, 0x94, 0xe7, 0x31, 0x66, 0xd3, 0xd8, 0xd2, 0x2c, 0x6c, 0xd7, 0x52,
-	0xf7, 0x0d, 0x9e, 0x7f, 0xcb, 0xfc, 0x05, 0x1e, 0x30, 0x9e, 0x50, 0x50, 0x59, 0xe9, 0x34, 0x92,
-	0x89, 0x29, 0x3b, 0xdb, 0xa4, 0xbd, 0xee, 0x26, 0x5d, 0x9d, 0x66, 0x66, 0xfa, 0xd7, 0x06, 0x6c,
-	0xa5, 0x24, 0xfd, 0x67, 0x94, 0x96, 0xb9, 0xd9, 0xb8, 0x84, 0x9b, 0xe6, 0x9b, 0xe0, 0xa6, 0xf5,
-	0x8f, 0xb8, 0x09, 0x80, 0x14, 0xa9, 0xb9, 0xce, 0xc4, 0xaf, 0x71, 0x6d, 0xdd, 0x2f, 0xc1, 0xc9,
-	0x2e, 0xd9, 0xd7, 0xdc, 0x47, 0xcd, 0xc6, 0xd5, 0x14, 0xe6, 0x67, 0x0b, 0xb6, 0x4a, 0xf9, 0x5a,
-	0x69, 0xfe, 0xad, 0x03, 0x93, 0x21, 0xdc, 0x4a, 0x59, 0x3e, 0xe6, 0x3e, 0x9a, 0x76, 0xda, 0xba,
-	0x9d, 0x9b, 0xbc, 0x54, 0x85, 0x3a, 0xd8, 0xbb, 0x35, 0xb5, 0x5d, 0x87, 0xd1, 0x09, 0x40, 0x01,
-	0x36, 0xd5, 0x91, 0x0f, 0x56, 0xea, 0x48, 0x91, 0x10, 0xda, 0x39, 0xce, 0x0f, 0xf6, 0x57, 0xc3,
-	0x68, 0xf2, 0x33, 0x94, 0x6c, 0xad, 0xb1, 0xcf, 0x75, 0xbb, 0x71, 0x25, 0xdd, 0xbe, 0x0f, 0xdd,
-	0x63, 0xc6, 0xfd, 0x99, 0xd1, 0x57, 0x5b, 0x5f, 0x17, 0x50, 0x2e, 0xaa, 0x3d, 0xe4, 0x73, 0xb0,
-	0x13, 0x3c, 0xd3, 0x22, 0xb3, 0xa2, 0x90, 0xa5, 0x6b, 0x4a, 0x55, 0x46, 0x6d, 0x17, 0x36, 0xea,
-	0xba, 0x40, 0xb6, 0xa1, 0x17, 0xb0, 0xe4, 0xe5, 0xcc, 0x43, 0x1f, 0x25, 0x7a, 0x4e, 0x73, 0x60,
-	0x0d, 0xdb, 0xb4, 0xab, 0x7c, 0x93, 0xd4, 0x55, 0xf8, 0x19, 0xb7, 0x8a, 0x3f, 0xe3, 0xa2, 0x0c,
-	0xb6, 0xcb, 0x32, 0xd8, 0x87, 0x76, 0x82, 0xf3, 0xf3, 0xb9, 0x8f, 0x9e, 0xd3, 0xd1, 0x1b, 0xe6,
-	0xb6, 0xfb, 0x10, 0x6e, 0x4d, 0x92, 0x28, 0x2e, 0x49, 0x4b, 0x41, 0x17, 0xac, 0x92, 0x2e, 0x8c,
-	0xff, 0x6c, 0x02, 0xe8, 0xd0, 0x3d, 0xf5, 0xbe, 0x21, 0x31, 0x90, 0x7d, 0x94, 0x7b, 0x51, 0x10,
-	0x47, 0x21, 0x86, 0x32, 0xfd, 0xef, 0x90, 0xc7, 0x2b, 0x7e, 0xd9, 0xcb, 0xa1, 0x06, 0xb0, 0xff,
-	0xe1, 0x8a, 0x8c, 0x4a, 0xb8, 0x7b, 0x83, 0x04, 0x1a, 0xf1, 0x90, 0x07, 0x78, 0xc8, 0xe7, 0x2f,
-	0xf7, 0x4e, 0x59, 0x18, 0xa2, 0x7f, 0x11, 0x62, 0x25, 0x34, 0x43, 0x7c, 0xbf, 0x9c, 0x61, 0x8c,
-	0x17, 0x32, 0xe1, 0xe1, 0x49, 0x36, 0xf4, 0xee, 0x0d, 0x72, 0x06, 0xb7, 0xf7, 0x51, 0xa3, 0x73,
-	0x21, 0xf9, 0x5c, 0x64, 0x80, 0xe3, 0xd5, 0x80, 0x4b, 0xc1, 0x57, 0x84, 0x9c, 0x43, 0xaf, 0xf8,
-	0xa4, 0x22, 0x1f, 0xd5, 0xcd, 0x59, 0xcd, 0x73, 0xb0, 0x3f, 0xbc, 0x3c, 0x30, 0x07, 0xf9, 0x1e,
-	0xe0, 0xf5, 0xa8, 0x92, 0xf5, 0x46, 0x79, 0xb9, 0x4b, 0xd5, 0xb0, 0x7c, 0x7b, 0x0e, 0x9b, 0xe5,
-	0xb7, 0x08, 0xf9, 0xb8, 0x2e, 0xb7, 0xf6, 0xa5, 0xd6, 0xff, 0x64, 0x9d, 0xd0, 0x1c, 0x2a, 0x81,
-	0xad, 0x25, 0xd5, 0x22, 0x0f, 0x2f, 0xda, 0xa2, 0x2a, 0xdc, 0xfd, 0x47, 0x6b, 0x46, 0xe7, 0x98,
-	0x07, 0xd0, 0xc9, 0xef, 0x0c, 0x79, 0x50, 0x97, 0x5d, 0xbd, 0x52, 0xfd, 0x8b, 0xf4, 0xd2, 0xbd,
-	0x31, 0xfe, 0xcd, 0x36, 0x1a, 0xa7, 0x5b, 0xfe, 0xff, 0xb5, 0x7a, 0xf3, 0xd7, 0xea, 0x10, 0xba,
-	0x85, 0x77, 0x29, 0xa9, 0x9d, 0xe5, 0xe5, 0x87, 0xeb, 0x25, 0x7d, 0xdb, 0xfd, 0xf4, 0xbb, 0xf1,
-	0x09, 0x97, 0xa7, 0x8b, 0x23, 0xb5, 0xb2, 0x93, 0x86, 0x3e, 0xe2, 0x91, 0xf9, 0xda, 0xc9, 0x0a,
-	0xd8, 0xd1, 0xd9, 0x3b, 0x1a, 0x25, 0x3e, 0x3a, 0x6a, 0x6a, 0xf3, 0xc9, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x85, 0x95, 0xb2, 0x56, 0x2c, 0x0e, 0x00, 0x00,
+	0x14, 0xcf, 0x7a, 0x1b, 0xff, 0x79, 0x36, 0xa6, 0x19, 0x4a, 0xb5, 0x6c, 0xa9, 0xea, 0x2e, 0xa5,
+	0x35, 0xa8, 0x75, 0x2a, 0x97, 0xc2, 0x09, 0x09, 0x12, 0x8b, 0xc8, 0x42, 0xad, 0xa2, 0x49, 0xc4,
+	0x01, 0x09, 0x59, 0x13, 0xef, 0x4b, 0x32, 0xea, 0xfe, 0xcb, 0xce, 0xb8, 0x22, 0x39, 0xf7, 0xce,
+	0x0d, 0xc4, 0x07, 0x41, 0x1c, 0xf9, 0x0c, 0x1c, 0xf8, 0x3e, 0x68, 0x67, 0x67, 0x37, 0xbb, 0xf6,
+	0x3a, 0x71, 0x48, 0xe1, 0xc4, 0x6d, 0xdf, 0x9b, 0xdf, 0x9b, 0xdf, 0xcc, 0xef, 0xfd, 0xd9, 0x81,
+	0x0d, 0x1e, 0xb8, 0xf8, 0xe3, 0x64, 0x1a, 0x86, 0xb1, 0x3b, 0x88, 0xe2, 0x50, 0x86, 0x84, 0xf8,
+	0xdc, 0x7b, 0x3d, 0x13, 0xa9, 0x35, 0x50, 0xeb, 0x76, 0x67, 0x1a, 0xfa, 0x7e, 0x18, 0xa4, 0x3e,
+	0xbb, 0xcb, 0x03, 0x89, 0x71, 0xc0, 0x3c, 0x6d, 0x77, 0x8a, 0x11, 0xce, 0x2f, 0x06, 0xbc, 0x47,
+	0xf1, 0x88, 0x0b, 0x89, 0xf1, 0xcb, 0xd0, 0x45, 0x8a, 0x27, 0x33, 0x14, 0x92, 0x3c, 0x85, 0x1b,
+	0x07, 0x4c, 0xa0, 0x65, 0xf4, 0x8c, 0x7e, 0x7b, 0xf8, 0xe1, 0xa0, 0x44, 0xa3, 0xf7, 0x7f, 0x21,
+	0x8e, 0xb6, 0x98, 0x40, 0xaa, 0x90, 0xe4, 0x73, 0x68, 0x30, 0xd7, 0x8d, 0x51, 0x08, 0xab, 0x76,
+	0x41, 0xd0, 0xd7, 0x29, 0x86, 0x66, 0x60, 0x72, 0x1b, 0xea, 0x41, 0xe8, 0xe2, 0x78, 0x64, 0x99,
+	0x3d, 0xa3, 0x6f, 0x52, 0x6d, 0x39, 0x3f, 0x19, 0x70, 0xab, 0x7c, 0x32, 0x11, 0x85, 0x81, 0x40,
+	0xf2, 0x0c, 0xea, 0x42, 0x32, 0x39, 0x13, 0xfa, 0x70, 0x77, 0x2a, 0x79, 0xf6, 0x14, 0x84, 0x6a,
+	0x28, 0xd9, 0x82, 0x36, 0x0f, 0xb8, 0x9c, 0x44, 0x2c, 0x66, 0x7e, 0x76, 0xc2, 0xfb, 0x83, 0x39,
+	0xf5, 0xb4, 0x50, 0xe3, 0x80, 0xcb, 0x5d, 0x05, 0xa4, 0xc0, 0xf3, 0x6f, 0xe7, 0x4b, 0x78, 0x7f,
+	0x07, 0xe5, 0x38, 0xd1, 0x38, 0xd9, 0x1d, 0x45, 0x26, 0xd6, 0x03, 0x78, 0x47, 0x29, 0xbf, 0x35,
+	0xe3, 0x9e, 0x3b, 0x1e, 0x25, 0x07, 0x33, 0xfb, 0x26, 0x2d, 0x3b, 0x9d, 0xdf, 0x0d, 0x68, 0xa9,
+	0xe0, 0x71, 0x70, 0x18, 0x92, 0xe7, 0xb0, 0x9e, 0x1c, 0x2d, 0x55, 0xb8, 0x3b, 0xbc, 0x57, 0x79,
+	0x89, 0x73, 0x2e, 0x9a, 0xa2, 0x89, 0x03, 0x9d, 0xe2, 0xae, 0xea, 0x22, 0x26, 0x2d, 0xf9, 0x88,
+	0x05, 0x0d, 0x65, 0xe7, 0x92, 0x66, 0x26, 0xb9, 0x0b, 0x90, 0x96, 0x50, 0xc0, 0x7c, 0xb4, 0x6e,
+	0xf4, 0x8c, 0x7e, 0x8b, 0xb6, 0x94, 0xe7, 0x25, 0xf3, 0x31, 0x49, 0x45, 0x8c, 0x4c, 0x84, 0x81,
+	0xb5, 0xae, 0x96, 0xb4, 0xe5, 0xbc, 0x31, 0xe0, 0xf6, 0xfc, 0xcd, 0xaf, 0x93, 0x8c, 0xe7, 0x69,
+	0x10, 0x26, 0x79, 0x30, 0xfb, 0xed, 0xe1, 0xdd, 0xc1, 0x62, 0x15, 0x0f, 0x72, 0xa9, 0xa8, 0x06,
+	0x3b, 0x7f, 0xd6, 0x80, 0x6c, 0xc7, 0xc8, 0x24, 0xaa, 0xb5, 0x4c, 0xfd, 0x79, 0x49, 0x8c, 0x0a,
+	0x49, 0xca, 0x17, 0xaf, 0xcd, 0x5f, 0x7c, 0xb9, 0x62, 0x16, 0x34, 0x5e, 0x63, 0x2c, 0x78, 0x18,
+	0x28, 0xb9, 0x4c, 0x9a, 0x99, 0xe4, 0x0e, 0xb4, 0x7c, 0x94, 0x6c, 0x12, 0x31, 0x79, 0xac, 0xf5,
+	0x6a, 0x26, 0x8e, 0x5d, 0x26, 0x8f, 0x13, 0x3e, 0x97, 0xe9, 0x45, 0x61, 0xd5, 0x7b, 0x66, 0xc2,
+	0xe7, 0xb2, 0x74, 0x55, 0x55, 0xa3, 0x3c, 0x8d, 0x30, 0xab, 0xc6, 0x46, 0xcf, 0x5c, 0xac, 0x46,
+	0x2d, 0xdd, 0xb7, 0x78, 0xfa, 0x1d, 0xf3, 0x66, 0xb8, 0xcb, 0x78, 0x4c, 0x21, 0x89, 0x4a, 0xab,
+	0x91, 0x8c, 0xf4, 0xb5, 0xb3, 0x4d, 0x9a, 0xab, 0x6e, 0xd2, 0x56, 0x61, 0xba, 0xa6, 0x7f, 0xad,
+	0xc1, 0x46, 0x2a, 0xd2, 0x7f, 0x26, 0x69, 0x59, 0x9b, 0xf5, 0x4b, 0xb4, 0xa9, 0xbf, 0x0d, 0x6d,
+	0x1a, 0xff, 0x48, 0x1b, 0x1f, 0x48, 0x51, 0x9a, 0xeb, 0x54, 0xfc, 0x0a, 0x6d, 0xeb, 0x7c, 0x05,
+	0x56, 0xd6, 0x64, 0xdf, 0x70, 0x0f, 0x95, 0x1a, 0x57, 0x9b, 0x30, 0x7f, 0x18, 0xb0, 0x51, 0x8a,
+	0x57, 0x93, 0xe6, 0xdf, 0x3a, 0x30, 0xe9, 0xc3, 0xcd, 0x54, 0xe5, 0x43, 0xee, 0xa1, 0x4e, 0xa7,
+	0xa9, 0xd2, 0xd9, 0xe5, 0xa5, 0x5b, 0x90, 0x47, 0xf0, 0xae, 0xc0, 0x98, 0x33, 0x8f, 0x9f, 0xa1,
+	0x3b, 0x11, 0xfc, 0x0c, 0x75, 0x37, 0x75, 0xcf, 0xdd, 0x7b, 0xfc, 0x0c, 0x9d, 0x9f, 0x0d, 0xf8,
+	0xa0, 0x42, 0x84, 0xeb, 0x48, 0x3f, 0x02, 0x28, 0x9c, 0x2f, 0x1d, 0x38, 0x1f, 0x2f, 0x1d, 0x38,
+	0x45, 0xe5, 0x68, 0xeb, 0x50, 0x5b, 0xc2, 0x79, 0x63, 0xea, 0xe1, 0xfd, 0x02, 0x25, 0x5b, 0xa9,
+	0x3f, 0xf2, 0x01, 0x5f, 0xbb, 0xd2, 0x80, 0xbf, 0x07, 0xed, 0x43, 0xc6, 0xbd, 0x89, 0x1e, 0xc4,
+	0xa6, 0xea, 0x2b, 0x48, 0x5c, 0x54, 0x79, 0xc8, 0x17, 0x60, 0xc6, 0x78, 0xa2, 0xf4, 0x5b, 0x72,
+	0x91, 0x85, 0x7e, 0xa6, 0x49, 0x44, 0x65, 0xba, 0xd6, 0x2b, 0xd3, 0x75, 0x1f, 0x3a, 0x3e, 0x8b,
+	0x5f, 0x4d, 0x5c, 0xf4, 0x50, 0xa2, 0x6b, 0xd5, 0x7b, 0x46, 0xbf, 0x49, 0xdb, 0x89, 0x6f, 0x94,
+	0xba, 0x0a, 0x7f, 0xed, 0x46, 0xf1, 0xaf, 0x5d, 0x9c, 0x97, 0xcd, 0xf2, 0xbc, 0xb4, 0xa1, 0x19,
+	0xe3, 0xf4, 0x74, 0xea, 0xa1, 0x6b, 0xb5, 0xd4, 0x86, 0xb9, 0x5d, 0x55, 0x1f, 0x50, 0x59, 0x1f,
+	0x8f, 0xe1, 0xe6, 0x28, 0x0e, 0xa3, 0xd2, 0xb0, 0x2a, 0x4c, 0x1a, 0xa3, 0x34, 0x69, 0x86, 0x7f,
+	0xd5, 0x01, 0x14, 0x74, 0x3b, 0x79, 0x31, 0x91, 0x08, 0xc8, 0x0e, 0xca, 0xed, 0xd0, 0x8f, 0xc2,
+	0x00, 0x03, 0x99, 0xfe, 0xc9, 0xc8, 0xd3, 0x25, 0x8f, 0x80, 0x45, 0xa8, 0x26, 0xb4, 0x1f, 0x2e,
+	0x89, 0x98, 0x83, 0x3b, 0x6b, 0xc4, 0x57, 0x8c, 0xfb, 0xdc, 0xc7, 0x7d, 0x3e, 0x7d, 0xb5, 0x7d,
+	0xcc, 0x82, 0x00, 0xbd, 0x8b, 0x18, 0xe7, 0xa0, 0x19, 0xe3, 0x47, 0xe5, 0x08, 0x6d, 0xec, 0xc9,
+	0x98, 0x07, 0x47, 0x59, 0x77, 0x38, 0x6b, 0xe4, 0x04, 0x6e, 0xed, 0xa0, 0x62, 0xe7, 0x42, 0xf2,
+	0xa9, 0xc8, 0x08, 0x87, 0xcb, 0x09, 0x17, 0xc0, 0x57, 0xa4, 0x9c, 0x42, 0xa7, 0xf8, 0x48, 0x23,
+	0x8f, 0xaa, 0x0a, 0xb2, 0xe2, 0x81, 0x69, 0xf7, 0x2f, 0x07, 0xe6, 0x24, 0x3f, 0x00, 0x9c, 0xd7,
+	0x34, 0x59, 0xad, 0xe6, 0xed, 0x87, 0x97, 0xc1, 0xf2, 0xed, 0x39, 0x74, 0xcb, 0xaf, 0x1b, 0xf2,
+	0x49, 0x55, 0x6c, 0xe5, 0xdb, 0xcf, 0xfe, 0x74, 0x15, 0x68, 0x4e, 0x15, 0xc3, 0xc6, 0xc2, 0x78,
+	0x23, 0x8f, 0x2f, 0xda, 0x62, 0xfe, 0x57, 0x60, 0x3f, 0x59, 0x11, 0x9d, 0x73, 0xee, 0x42, 0x2b,
+	0xef, 0x19, 0xf2, 0xa0, 0x2a, 0x7a, 0xbe, 0xa5, 0xec, 0x8b, 0x06, 0xab, 0xb3, 0x36, 0xfc, 0x2d,
+	0x1b, 0x86, 0x2a, 0xe5, 0xff, 0xb7, 0xd5, 0xdb, 0x6f, 0xab, 0x7d, 0x68, 0x17, 0x5e, 0xba, 0xa4,
+	0xb2, 0x96, 0x17, 0x9f, 0xc2, 0x97, 0xe4, 0x6d, 0xeb, 0xb3, 0xef, 0x87, 0x47, 0x5c, 0x1e, 0xcf,
+	0x0e, 0x92, 0x95, 0xcd, 0x14, 0xfa, 0x84, 0x87, 0xfa, 0x6b, 0x33, 0xbb, 0xc0, 0xa6, 0x8a, 0xde,
+	0x54, 0x2c, 0xd1, 0xc1, 0x41, 0x5d, 0x99, 0xcf, 0xfe, 0x1e, 0x00, 0x02, 0x77, 0x4f, 0x77, 0x7e,
+	0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -655,6 +655,94 @@ func (m *DropCollectionRequest) GetCollectionID() int64 {
 	return 0
 }
 
+type AddFieldRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	DbID           int64             `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID   int64             `protobuf:"varint,5,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// `schema` is the serialized `schema.FieldSchema` of the new field
+	Schema               []byte   `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
+	SchemaVersion        int64    `protobuf:"varint,7,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddFieldRequest) Reset()         { *m = AddFieldRequest{} }
+func (m *AddFieldRequest) String() string { return proto.CompactTextString(m) }
+func (*AddFieldRequest) ProtoMessage()    {}
+func (*AddFieldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{12}
+}
+
+func (m *AddFieldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddFieldRequest.Unmarshal(m, b)
+}
+func (m *AddFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddFieldRequest.Marshal(b, m, deterministic)
+}
+func (m *AddFieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFieldRequest.Merge(m, src)
+}
+func (m *AddFieldRequest) XXX_Size() int {
+	return xxx_messageInfo_AddFieldRequest.Size(m)
+}
+func (m *AddFieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFieldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddFieldRequest proto.InternalMessageInfo
+
+func (m *AddFieldRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AddFieldRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *AddFieldRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *AddFieldRequest) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *AddFieldRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *AddFieldRequest) GetSchema() []byte {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *AddFieldRequest) GetSchemaVersion() int64 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
 type CreatePartitionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -672,7 +760,7 @@ func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{}
 func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePartitionRequest) ProtoMessage()    {}
 func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{12}
+	return fileDescriptor_41f4a519b878ee3b, []int{13}
 }
 
 func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -759,7 +847,7 @@ func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
 func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*DropPartitionRequest) ProtoMessage()    {}
 func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{13}
+	return fileDescriptor_41f4a519b878ee3b, []int{14}
 }
 
 func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -847,7 +935,7 @@ func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
 func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateIndexRequest) ProtoMessage()    {}
 func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{14}
+	return fileDescriptor_41f4a519b878ee3b, []int{15}
 }
 
 func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -946,7 +1034,7 @@ func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
 func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
 func (*InsertRequest) ProtoMessage()    {}
 func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{15}
+	return fileDescriptor_41f4a519b878ee3b, []int{16}
 }
 
 func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
@@ -1065,6 +1153,7 @@ type SearchRequest struct {
 	OutputFieldsId       []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
 	TravelTimestamp      uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
 	GuaranteeTimestamp   uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	ReplicaID            int64            `protobuf:"varint,13,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -1074,7 +1163,7 @@ func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{16}
+	return fileDescriptor_41f4a519b878ee3b, []int{17}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -1179,6 +1268,13 @@ func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *SearchRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type SearchResults struct {
 	Base            *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status          *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1192,6 +1288,7 @@ type SearchResults struct {
 	SealedSegmentIDsSearched []int64  `protobuf:"varint,6,rep,packed,name=sealed_segmentIDs_searched,json=sealedSegmentIDsSearched,proto3" json:"sealed_segmentIDs_searched,omitempty"`
 	ChannelIDsSearched       []string `protobuf:"bytes,7,rep,name=channelIDs_searched,json=channelIDsSearched,proto3" json:"channelIDs_searched,omitempty"`
 	GlobalSealedSegmentIDs   []int64  `protobuf:"varint,8,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
+	ReplicaID                int64    `protobuf:"varint,12,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
 	XXX_unrecognized         []byte   `json:"-"`
 	XXX_sizecache            int32    `json:"-"`
@@ -1201,7 +1298,7 @@ func (m *SearchResults) Reset()         { *m = SearchResults{} }
 func (m *SearchResults) String() string { return proto.CompactTextString(m) }
 func (*SearchResults) ProtoMessage()    {}
 func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{17}
+	return fileDescriptor_41f4a519b878ee3b, []int{18}
 }
 
 func (m *SearchResults) XXX_Unmarshal(b []byte) error {
@@ -1299,6 +1396,13 @@ func (m *SearchResults) GetGlobalSealedSegmentIDs() []int64 {
 	return nil
 }
 
+func (m *SearchResults) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type RetrieveRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ResultChannelID      string            `protobuf:"bytes,2,opt,name=result_channelID,json=resultChannelID,proto3" json:"result_channelID,omitempty"`
@@ -1309,6 +1413,8 @@ type RetrieveRequest struct {
 	OutputFields         []string          `protobuf:"bytes,7,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
 	TravelTimestamp      uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
 	GuaranteeTimestamp   uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	Limit                int64             `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	ReplicaID            int64             `protobuf:"varint,11,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1318,7 +1424,7 @@ func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
 func (m *RetrieveRequest) String() string { return proto.CompactTextString(m) }
 func (*RetrieveRequest) ProtoMessage()    {}
 func (*RetrieveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{18}
+	return fileDescriptor_41f4a519b878ee3b, []int{19}
 }
 
 func (m *RetrieveRequest) XXX_Unmarshal(b []byte) error {
@@ -1402,6 +1508,20 @@ func (m *RetrieveRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *RetrieveRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *RetrieveRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type RetrieveResults struct {
 	Base                      *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                    *commonpb.Status      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1411,6 +1531,7 @@ type RetrieveResults struct {
 	SealedSegmentIDsRetrieved []int64               `protobuf:"varint,6,rep,packed,name=sealed_segmentIDs_retrieved,json=sealedSegmentIDsRetrieved,proto3" json:"sealed_segmentIDs_retrieved,omitempty"`
 	ChannelIDsRetrieved       []string              `protobuf:"bytes,7,rep,name=channelIDs_retrieved,json=channelIDsRetrieved,proto3" json:"channelIDs_retrieved,omitempty"`
 	GlobalSealedSegmentIDs    []int64               `protobuf:"varint,8,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
+	ReplicaID                 int64                 `protobuf:"varint,9,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}              `json:"-"`
 	XXX_unrecognized          []byte                `json:"-"`
 	XXX_sizecache             int32                 `json:"-"`
@@ -1420,7 +1541,7 @@ func (m *RetrieveResults) Reset()         { *m = RetrieveResults{} }
 func (m *RetrieveResults) String() string { return proto.CompactTextString(m) }
 func (*RetrieveResults) ProtoMessage()    {}
 func (*RetrieveResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{19}
+	return fileDescriptor_41f4a519b878ee3b, []int{20}
 }
 
 func (m *RetrieveResults) XXX_Unmarshal(b []byte) error {
@@ -1497,12 +1618,25 @@ func (m *RetrieveResults) GetGlobalSealedSegmentIDs() []int64 {
 	return nil
 }
 
+func (m *RetrieveResults) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type DeleteRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionName       string            `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	ChannelID            string            `protobuf:"bytes,3,opt,name=channelID,proto3" json:"channelID,omitempty"`
 	Timestamps           []uint64          `protobuf:"varint,4,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
 	PrimaryKeys          []int64           `protobuf:"varint,5,rep,packed,name=primary_keys,json=primaryKeys,proto3" json:"primary_keys,omitempty"`
+	DbName               string            `protobuf:"bytes,6,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,7,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	DbID                 int64             `protobuf:"varint,8,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID         int64             `protobuf:"varint,9,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64             `protobuf:"varint,10,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	StringPrimaryKeys    []string          `protobuf:"bytes,11,rep,name=string_primary_keys,json=stringPrimaryKeys,proto3" json:"string_primary_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1512,7 +1646,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{20}
+	return fileDescriptor_41f4a519b878ee3b, []int{21}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -1568,6 +1702,48 @@ func (m *DeleteRequest) GetPrimaryKeys() []int64 {
 	return nil
 }
 
+func (m *DeleteRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *DeleteRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *DeleteRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *DeleteRequest) GetStringPrimaryKeys() []string {
+	if m != nil {
+		return m.StringPrimaryKeys
+	}
+	return nil
+}
+
 type LoadBalanceSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegmentIDs           []int64           `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
@@ -1580,7 +1756,7 @@ func (m *LoadBalanceSegmentsRequest) Reset()         { *m = LoadBalanceSegmentsR
 func (m *LoadBalanceSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceSegmentsRequest) ProtoMessage()    {}
 func (*LoadBalanceSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{21}
+	return fileDescriptor_41f4a519b878ee3b, []int{22}
 }
 
 func (m *LoadBalanceSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1631,7 +1807,7 @@ func (m *LoadIndex) Reset()         { *m = LoadIndex{} }
 func (m *LoadIndex) String() string { return proto.CompactTextString(m) }
 func (*LoadIndex) ProtoMessage()    {}
 func (*LoadIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{22}
+	return fileDescriptor_41f4a519b878ee3b, []int{23}
 }
 
 func (m *LoadIndex) XXX_Unmarshal(b []byte) error {
@@ -1711,7 +1887,7 @@ func (m *SegmentStatisticsUpdates) Reset()         { *m = SegmentStatisticsUpdat
 func (m *SegmentStatisticsUpdates) String() string { return proto.CompactTextString(m) }
 func (*SegmentStatisticsUpdates) ProtoMessage()    {}
 func (*SegmentStatisticsUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{23}
+	return fileDescriptor_41f4a519b878ee3b, []int{24}
 }
 
 func (m *SegmentStatisticsUpdates) XXX_Unmarshal(b []byte) error {
@@ -1793,7 +1969,7 @@ func (m *SegmentStatistics) Reset()         { *m = SegmentStatistics{} }
 func (m *SegmentStatistics) String() string { return proto.CompactTextString(m) }
 func (*SegmentStatistics) ProtoMessage()    {}
 func (*SegmentStatistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{24}
+	return fileDescriptor_41f4a519b878ee3b, []int{25}
 }
 
 func (m *SegmentStatistics) XXX_Unmarshal(b []byte) error {
@@ -1840,7 +2016,7 @@ func (m *IndexStats) Reset()         { *m = IndexStats{} }
 func (m *IndexStats) String() string { return proto.CompactTextString(m) }
 func (*IndexStats) ProtoMessage()    {}
 func (*IndexStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{25}
+	return fileDescriptor_41f4a519b878ee3b, []int{26}
 }
 
 func (m *IndexStats) XXX_Unmarshal(b []byte) error {
@@ -1888,7 +2064,7 @@ func (m *FieldStats) Reset()         { *m = FieldStats{} }
 func (m *FieldStats) String() string { return proto.CompactTextString(m) }
 func (*FieldStats) ProtoMessage()    {}
 func (*FieldStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{26}
+	return fileDescriptor_41f4a519b878ee3b, []int{27}
 }
 
 func (m *FieldStats) XXX_Unmarshal(b []byte) error {
@@ -1944,7 +2120,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{27}
+	return fileDescriptor_41f4a519b878ee3b, []int{28}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -2006,7 +2182,7 @@ func (m *QueryNodeStats) Reset()         { *m = QueryNodeStats{} }
 func (m *QueryNodeStats) String() string { return proto.CompactTextString(m) }
 func (*QueryNodeStats) ProtoMessage()    {}
 func (*QueryNodeStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{28}
+	return fileDescriptor_41f4a519b878ee3b, []int{29}
 }
 
 func (m *QueryNodeStats) XXX_Unmarshal(b []byte) error {
@@ -2062,7 +2238,7 @@ func (m *MsgPosition) Reset()         { *m = MsgPosition{} }
 func (m *MsgPosition) String() string { return proto.CompactTextString(m) }
 func (*MsgPosition) ProtoMessage()    {}
 func (*MsgPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{29}
+	return fileDescriptor_41f4a519b878ee3b, []int{30}
 }
 
 func (m *MsgPosition) XXX_Unmarshal(b []byte) error {
@@ -2125,7 +2301,7 @@ func (m *ChannelTimeTickMsg) Reset()         { *m = ChannelTimeTickMsg{} }
 func (m *ChannelTimeTickMsg) String() string { return proto.CompactTextString(m) }
 func (*ChannelTimeTickMsg) ProtoMessage()    {}
 func (*ChannelTimeTickMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{30}
+	return fileDescriptor_41f4a519b878ee3b, []int{31}
 }
 
 func (m *ChannelTimeTickMsg) XXX_Unmarshal(b []byte) error {
@@ -2188,6 +2364,7 @@ func init() {
 	proto.RegisterType((*TimeTickMsg)(nil), "milvus.proto.internal.TimeTickMsg")
 	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.internal.CreateCollectionRequest")
 	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.internal.DropCollectionRequest")
+	proto.RegisterType((*AddFieldRequest)(nil), "milvus.proto.internal.AddFieldRequest")
 	proto.RegisterType((*CreatePartitionRequest)(nil), "milvus.proto.internal.CreatePartitionRequest")
 	proto.RegisterType((*DropPartitionRequest)(nil), "milvus.proto.internal.DropPartitionRequest")
 	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.internal.CreateIndexRequest")
@@ -2212,127 +2389,134 @@ func init() {
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 1938 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x73, 0x23, 0x47,
-	0xf5, 0xff, 0x8e, 0x46, 0xb6, 0xa4, 0xa7, 0xb1, 0xad, 0xed, 0xf5, 0x6e, 0xc6, 0xde, 0xcd, 0xc6,
-	0x99, 0xe4, 0x0b, 0x26, 0x5b, 0xac, 0x17, 0x07, 0x48, 0x8a, 0xa2, 0xd8, 0xc4, 0x56, 0x58, 0x54,
-	0x1b, 0x1b, 0xd3, 0xde, 0xa4, 0x0a, 0x2e, 0x53, 0xad, 0x99, 0xb6, 0x3c, 0x64, 0x7e, 0x31, 0xdd,
-	0xf2, 0xae, 0x72, 0xe2, 0xc0, 0x09, 0x0a, 0x0e, 0x54, 0xf1, 0x6f, 0x70, 0xe5, 0xc4, 0x8f, 0xe2,
-	0x44, 0x15, 0x7f, 0x01, 0x7f, 0x05, 0x47, 0x28, 0x4e, 0x54, 0xbf, 0xee, 0x19, 0x8d, 0x64, 0xd9,
-	0x78, 0xbd, 0x05, 0x84, 0x82, 0xdb, 0xf4, 0xe7, 0xbd, 0xfe, 0xf1, 0x3e, 0xef, 0x47, 0x3f, 0xb5,
-	0x60, 0x35, 0x4a, 0x25, 0x2f, 0x52, 0x16, 0x3f, 0xc8, 0x8b, 0x4c, 0x66, 0xe4, 0x56, 0x12, 0xc5,
-	0x67, 0x63, 0xa1, 0x47, 0x0f, 0x4a, 0xe1, 0xa6, 0x13, 0x64, 0x49, 0x92, 0xa5, 0x1a, 0xde, 0x74,
-	0x44, 0x70, 0xca, 0x13, 0xa6, 0x47, 0xde, 0x6f, 0x2c, 0x58, 0xd9, 0xcf, 0x92, 0x3c, 0x4b, 0x79,
-	0x2a, 0x07, 0xe9, 0x49, 0x46, 0x6e, 0xc3, 0x72, 0x9a, 0x85, 0x7c, 0xd0, 0x77, 0xad, 0x2d, 0x6b,
-	0xdb, 0xa6, 0x66, 0x44, 0x08, 0x34, 0x8b, 0x2c, 0xe6, 0x6e, 0x63, 0xcb, 0xda, 0xee, 0x50, 0xfc,
-	0x26, 0x8f, 0x00, 0x84, 0x64, 0x92, 0xfb, 0x41, 0x16, 0x72, 0xd7, 0xde, 0xb2, 0xb6, 0x57, 0x77,
-	0xb7, 0x1e, 0x2c, 0x3c, 0xc5, 0x83, 0x63, 0xa5, 0xb8, 0x9f, 0x85, 0x9c, 0x76, 0x44, 0xf9, 0x49,
-	0xde, 0x03, 0xe0, 0xcf, 0x65, 0xc1, 0xfc, 0x28, 0x3d, 0xc9, 0xdc, 0xe6, 0x96, 0xbd, 0xdd, 0xdd,
-	0x7d, 0x7d, 0x76, 0x01, 0x73, 0xf8, 0x27, 0x7c, 0xf2, 0x31, 0x8b, 0xc7, 0xfc, 0x88, 0x45, 0x05,
-	0xed, 0xe0, 0x24, 0x75, 0x5c, 0xef, 0x4f, 0x16, 0xac, 0x55, 0x06, 0xe0, 0x1e, 0x82, 0x7c, 0x0d,
-	0x96, 0x70, 0x0b, 0xb4, 0xa0, 0xbb, 0xfb, 0xe6, 0x05, 0x27, 0x9a, 0xb1, 0x9b, 0xea, 0x29, 0xe4,
-	0x23, 0xb8, 0x29, 0xc6, 0xc3, 0xa0, 0x14, 0xf9, 0x88, 0x0a, 0xb7, 0x81, 0x47, 0xbb, 0xda, 0x4a,
-	0xa4, 0xbe, 0x80, 0x39, 0xd2, 0xdb, 0xb0, 0xac, 0x56, 0x1a, 0x0b, 0x64, 0xa9, 0xbb, 0x7b, 0x67,
-	0xa1, 0x91, 0xc7, 0xa8, 0x42, 0x8d, 0xaa, 0x77, 0x07, 0x36, 0x1e, 0x73, 0x39, 0x67, 0x1d, 0xe5,
-	0x3f, 0x18, 0x73, 0x21, 0x8d, 0xf0, 0x69, 0x94, 0xf0, 0xa7, 0x51, 0xf0, 0xc9, 0xfe, 0x29, 0x4b,
-	0x53, 0x1e, 0x97, 0xc2, 0x57, 0xe1, 0xce, 0x63, 0x8e, 0x13, 0x22, 0x21, 0xa3, 0x40, 0xcc, 0x89,
-	0x6f, 0xc1, 0xcd, 0xc7, 0x5c, 0xf6, 0xc3, 0x39, 0xf8, 0x63, 0x68, 0x1f, 0x2a, 0x67, 0xab, 0x30,
-	0xf8, 0x2a, 0xb4, 0x58, 0x18, 0x16, 0x5c, 0x08, 0xc3, 0xe2, 0xdd, 0x85, 0x27, 0x7e, 0x5f, 0xeb,
-	0xd0, 0x52, 0x79, 0x51, 0x98, 0x78, 0xdf, 0x07, 0x18, 0xa4, 0x91, 0x3c, 0x62, 0x05, 0x4b, 0xc4,
-	0x85, 0x01, 0xd6, 0x07, 0x47, 0x48, 0x56, 0x48, 0x3f, 0x47, 0x3d, 0x43, 0xf9, 0x15, 0xa2, 0xa1,
-	0x8b, 0xd3, 0xf4, 0xea, 0xde, 0x77, 0x01, 0x8e, 0x65, 0x11, 0xa5, 0xa3, 0x0f, 0x23, 0x21, 0xd5,
-	0x5e, 0x67, 0x4a, 0x4f, 0x19, 0x61, 0x6f, 0x77, 0xa8, 0x19, 0xd5, 0xdc, 0xd1, 0xb8, 0xba, 0x3b,
-	0x1e, 0x41, 0xb7, 0xa4, 0xfb, 0x40, 0x8c, 0xc8, 0x43, 0x68, 0x0e, 0x99, 0xe0, 0x97, 0xd2, 0x73,
-	0x20, 0x46, 0x7b, 0x4c, 0x70, 0x8a, 0x9a, 0xde, 0x8f, 0x6d, 0x78, 0x65, 0xbf, 0xe0, 0x18, 0xfc,
-	0x71, 0xcc, 0x03, 0x19, 0x65, 0xa9, 0xe1, 0xfe, 0xc5, 0x57, 0x23, 0xaf, 0x40, 0x2b, 0x1c, 0xfa,
-	0x29, 0x4b, 0x4a, 0xb2, 0x97, 0xc3, 0xe1, 0x21, 0x4b, 0x38, 0xf9, 0x1c, 0xac, 0x06, 0xd5, 0xfa,
-	0x0a, 0xc1, 0x98, 0xeb, 0xd0, 0x39, 0x94, 0xbc, 0x09, 0x2b, 0x39, 0x2b, 0x64, 0x54, 0xa9, 0x35,
-	0x51, 0x6d, 0x16, 0x54, 0x0e, 0x0d, 0x87, 0x83, 0xbe, 0xbb, 0x84, 0xce, 0xc2, 0x6f, 0xe2, 0x81,
-	0x33, 0x5d, 0x6b, 0xd0, 0x77, 0x97, 0x51, 0x36, 0x83, 0x91, 0x2d, 0xe8, 0x56, 0x0b, 0x0d, 0xfa,
-	0x6e, 0x0b, 0x55, 0xea, 0x90, 0x72, 0x8e, 0xae, 0x45, 0x6e, 0x7b, 0xcb, 0xda, 0x76, 0xa8, 0x19,
-	0x91, 0x87, 0x70, 0xf3, 0x2c, 0x2a, 0xe4, 0x98, 0xc5, 0x26, 0x3e, 0xd5, 0x39, 0x84, 0xdb, 0x41,
-	0x0f, 0x2e, 0x12, 0x91, 0x5d, 0x58, 0xcf, 0x4f, 0x27, 0x22, 0x0a, 0xe6, 0xa6, 0x00, 0x4e, 0x59,
-	0x28, 0xf3, 0x7e, 0x6f, 0xc1, 0xad, 0x7e, 0x91, 0xe5, 0x9f, 0x09, 0x57, 0x94, 0x24, 0x37, 0x2f,
-	0x21, 0x79, 0xe9, 0x3c, 0xc9, 0xde, 0x4f, 0x1b, 0x70, 0x5b, 0x47, 0xd4, 0x51, 0x49, 0xec, 0x3f,
-	0xc1, 0x8a, 0xcf, 0xc3, 0xda, 0x74, 0x57, 0xad, 0xb0, 0xd8, 0x8c, 0xff, 0x87, 0xd5, 0xca, 0xc1,
-	0x5a, 0xef, 0x5f, 0x1b, 0x52, 0xde, 0x4f, 0x1a, 0xb0, 0xae, 0x9c, 0xfa, 0x3f, 0x36, 0x14, 0x1b,
-	0xbf, 0x6d, 0x00, 0xd1, 0xd1, 0x31, 0x48, 0x43, 0xfe, 0xfc, 0xdf, 0xc9, 0xc5, 0xab, 0x00, 0x27,
-	0x11, 0x8f, 0xc3, 0x3a, 0x0f, 0x1d, 0x44, 0x5e, 0x8a, 0x03, 0x17, 0x5a, 0xb8, 0x48, 0x65, 0x7f,
-	0x39, 0x54, 0xb7, 0x89, 0xee, 0x2c, 0xcc, 0x6d, 0xd2, 0xbe, 0xf2, 0x6d, 0x82, 0xd3, 0xcc, 0x6d,
-	0xf2, 0x4b, 0x1b, 0x56, 0x06, 0xa9, 0xe0, 0x85, 0xfc, 0x6f, 0x0e, 0x24, 0x72, 0x17, 0x3a, 0x82,
-	0x8f, 0x12, 0xd5, 0xe0, 0xf4, 0xb1, 0x58, 0xdb, 0x74, 0x0a, 0x28, 0x69, 0xa0, 0x2b, 0xeb, 0xa0,
-	0xef, 0x76, 0xb4, 0x6b, 0x2b, 0x80, 0xdc, 0x03, 0x90, 0x51, 0xc2, 0x85, 0x64, 0x49, 0xae, 0x2b,
-	0x72, 0x93, 0xd6, 0x10, 0x75, 0x0b, 0x14, 0xd9, 0xb3, 0x41, 0x5f, 0xb8, 0xdd, 0x2d, 0x5b, 0xb5,
-	0x03, 0x7a, 0x44, 0xbe, 0x0c, 0xed, 0x22, 0x7b, 0xe6, 0x87, 0x4c, 0x32, 0xd7, 0x41, 0xe7, 0x6d,
-	0x2c, 0x24, 0x7b, 0x2f, 0xce, 0x86, 0xb4, 0x55, 0x64, 0xcf, 0xfa, 0x4c, 0x32, 0xef, 0xaf, 0x36,
-	0xac, 0x1c, 0x73, 0x56, 0x04, 0xa7, 0xd7, 0x77, 0xd8, 0x17, 0xa0, 0x57, 0x70, 0x31, 0x8e, 0xa5,
-	0x3f, 0x35, 0x4b, 0x7b, 0x6e, 0x4d, 0xe3, 0xfb, 0x95, 0x71, 0x25, 0xe5, 0xf6, 0x25, 0x94, 0x37,
-	0x17, 0x50, 0xee, 0x81, 0x53, 0xe3, 0x57, 0xb8, 0x4b, 0x68, 0xfa, 0x0c, 0x46, 0x7a, 0x60, 0x87,
-	0x22, 0x46, 0x8f, 0x75, 0xa8, 0xfa, 0x24, 0xf7, 0xe1, 0x46, 0x1e, 0xb3, 0x80, 0x9f, 0x66, 0x71,
-	0xc8, 0x0b, 0x7f, 0x54, 0x64, 0xe3, 0x1c, 0xdd, 0xe5, 0xd0, 0x5e, 0x4d, 0xf0, 0x58, 0xe1, 0xe4,
-	0x1d, 0x68, 0x87, 0x22, 0xf6, 0xe5, 0x24, 0xe7, 0xe8, 0xb2, 0xd5, 0x0b, 0x6c, 0xef, 0x8b, 0xf8,
-	0xe9, 0x24, 0xe7, 0xb4, 0x15, 0xea, 0x0f, 0xf2, 0x10, 0xd6, 0x05, 0x2f, 0x22, 0x16, 0x47, 0x9f,
-	0xf2, 0xd0, 0xe7, 0xcf, 0xf3, 0xc2, 0xcf, 0x63, 0x96, 0xa2, 0x67, 0x1d, 0x4a, 0xa6, 0xb2, 0x0f,
-	0x9e, 0xe7, 0xc5, 0x51, 0xcc, 0x52, 0xb2, 0x0d, 0xbd, 0x6c, 0x2c, 0xf3, 0xb1, 0xf4, 0x31, 0xfb,
-	0x84, 0x1f, 0x85, 0xe8, 0x68, 0x9b, 0xae, 0x6a, 0xfc, 0x9b, 0x08, 0x0f, 0x42, 0x45, 0xad, 0x2c,
-	0xd8, 0x19, 0x8f, 0xfd, 0x2a, 0x02, 0xdc, 0xee, 0x96, 0xb5, 0xdd, 0xa4, 0x6b, 0x1a, 0x7f, 0x5a,
-	0xc2, 0x64, 0x07, 0x6e, 0x8e, 0xc6, 0xac, 0x60, 0xa9, 0xe4, 0xbc, 0xa6, 0xed, 0xa0, 0x36, 0xa9,
-	0x44, 0xd5, 0x04, 0xef, 0xcf, 0x35, 0xd7, 0x2b, 0x2f, 0x89, 0x6b, 0xb8, 0xfe, 0x3a, 0x7d, 0xe1,
-	0xc2, 0x78, 0xb1, 0x17, 0xc7, 0xcb, 0x6b, 0xd0, 0x4d, 0xb8, 0x2c, 0xa2, 0x40, 0xfb, 0x45, 0xa7,
-	0x31, 0x68, 0x08, 0xc9, 0x27, 0xd0, 0x3c, 0x8d, 0xa4, 0x0e, 0x08, 0x87, 0xe2, 0xb7, 0x9a, 0x24,
-	0xe2, 0x28, 0xe0, 0xa1, 0x3f, 0x8c, 0xb3, 0xa1, 0xf1, 0x03, 0x68, 0x48, 0x45, 0xbf, 0xe2, 0xdf,
-	0x28, 0xa4, 0xe3, 0xc4, 0x0f, 0xb2, 0x71, 0x2a, 0x5d, 0xc0, 0xa8, 0x5b, 0xd5, 0xf8, 0xe1, 0x38,
-	0xd9, 0x57, 0x28, 0x79, 0x03, 0x56, 0x8c, 0x66, 0x76, 0x72, 0x22, 0xb8, 0x44, 0xf2, 0x6d, 0xea,
-	0x68, 0xf0, 0xdb, 0x88, 0x91, 0xaf, 0xc3, 0xa6, 0xe0, 0x2c, 0xe6, 0xa1, 0x5f, 0xe5, 0xb8, 0xf0,
-	0x05, 0x32, 0xcb, 0x43, 0x77, 0x19, 0x1d, 0xeb, 0x6a, 0x8d, 0xe3, 0x4a, 0xe1, 0xd8, 0xc8, 0x95,
-	0xdf, 0x2a, 0x1a, 0x6a, 0xd3, 0x5a, 0xd8, 0x8a, 0x91, 0xa9, 0xa8, 0x9a, 0xf0, 0x2e, 0xb8, 0xa3,
-	0x38, 0x1b, 0xb2, 0xd8, 0x3f, 0xb7, 0x2b, 0x56, 0x6d, 0x9b, 0xde, 0xd6, 0xf2, 0xe3, 0xb9, 0x2d,
-	0xbd, 0xbf, 0x34, 0x60, 0x8d, 0x2a, 0xee, 0xf8, 0x19, 0xff, 0x8f, 0x4f, 0xf7, 0xb7, 0xc0, 0x8e,
-	0x42, 0x81, 0xe9, 0xde, 0xdd, 0x75, 0x67, 0xcf, 0x6d, 0x7e, 0xb2, 0x0f, 0xfa, 0x82, 0x2a, 0x25,
-	0xe5, 0xc6, 0x99, 0x84, 0x33, 0xec, 0x3a, 0xf5, 0x6c, 0x5b, 0x98, 0x6b, 0xed, 0x17, 0xca, 0xb5,
-	0xce, 0x85, 0xb9, 0xf6, 0x6b, 0xbb, 0xce, 0xfc, 0x67, 0x35, 0xdb, 0x0c, 0xa5, 0xcd, 0xab, 0x50,
-	0xfa, 0x08, 0xba, 0xa6, 0x78, 0xe1, 0x8d, 0xb3, 0x84, 0x37, 0xce, 0xbd, 0x85, 0x73, 0x90, 0x5f,
-	0x75, 0xdb, 0x50, 0xdd, 0xd3, 0x08, 0xf5, 0x4d, 0xbe, 0x01, 0x77, 0xce, 0x67, 0x4d, 0x61, 0x38,
-	0x2a, 0xd3, 0x66, 0x63, 0x3e, 0x6d, 0x4a, 0x12, 0x43, 0xf2, 0x25, 0x58, 0xaf, 0xe5, 0xcd, 0x74,
-	0xa2, 0x76, 0x6d, 0x2d, 0xa7, 0xa6, 0x53, 0xae, 0x9f, 0x39, 0x7f, 0xb4, 0x60, 0xa5, 0xcf, 0x63,
-	0x2e, 0x5f, 0x22, 0x6f, 0x16, 0xb4, 0x2f, 0x8d, 0x85, 0xed, 0xcb, 0x4c, 0x7f, 0x60, 0x5f, 0xde,
-	0x1f, 0x34, 0xcf, 0xf5, 0x07, 0xaf, 0x83, 0x93, 0x17, 0x51, 0xc2, 0x8a, 0x89, 0xff, 0x09, 0x9f,
-	0x94, 0xb9, 0xd3, 0x35, 0xd8, 0x13, 0x3e, 0x11, 0x5e, 0x0a, 0x9b, 0x1f, 0x66, 0x2c, 0xdc, 0x63,
-	0x31, 0x4b, 0x03, 0x6e, 0xcc, 0x14, 0xd7, 0xb7, 0xec, 0x1e, 0x40, 0x8d, 0xc9, 0x06, 0x6e, 0x58,
-	0x43, 0xbc, 0xbf, 0x59, 0xd0, 0x51, 0x1b, 0x62, 0x57, 0x7d, 0x8d, 0xf5, 0x67, 0xda, 0xa9, 0xc6,
-	0x82, 0x76, 0xaa, 0x6a, 0x8c, 0x4b, 0xba, 0xa6, 0x9d, 0x72, 0xad, 0xe3, 0x6d, 0xce, 0x76, 0xbc,
-	0xaf, 0x41, 0x37, 0x52, 0x07, 0xf2, 0x73, 0x26, 0x4f, 0x35, 0x4f, 0x1d, 0x0a, 0x08, 0x1d, 0x29,
-	0x44, 0xb5, 0xc4, 0xa5, 0x02, 0xb6, 0xc4, 0xcb, 0x57, 0x6e, 0x89, 0xcd, 0x22, 0xd8, 0x12, 0xff,
-	0xae, 0x01, 0xae, 0xa1, 0x78, 0xfa, 0xbe, 0xf4, 0x51, 0x1e, 0xe2, 0x33, 0xd7, 0x5d, 0xe8, 0x54,
-	0x51, 0x66, 0x9e, 0x77, 0xa6, 0x80, 0xe2, 0xf5, 0x80, 0x27, 0x59, 0x31, 0x39, 0x8e, 0x3e, 0xe5,
-	0xc6, 0xf0, 0x1a, 0xa2, 0x6c, 0x3b, 0x1c, 0x27, 0x34, 0x7b, 0x26, 0x4c, 0x85, 0x2d, 0x87, 0xca,
-	0xb6, 0x00, 0x7f, 0xc8, 0x60, 0x75, 0x42, 0xcb, 0x9b, 0x14, 0x34, 0xa4, 0xaa, 0x12, 0xd9, 0x80,
-	0x36, 0x4f, 0x43, 0x2d, 0x5d, 0x42, 0x69, 0x8b, 0xa7, 0x21, 0x8a, 0x06, 0xb0, 0x6a, 0xde, 0x95,
-	0x32, 0x81, 0xd5, 0xd6, 0xd4, 0x58, 0xef, 0x82, 0xc7, 0xbc, 0x03, 0x31, 0x3a, 0x32, 0x9a, 0x74,
-	0x45, 0x3f, 0x2d, 0x99, 0x21, 0xf9, 0x00, 0x1c, 0xb5, 0x4b, 0xb5, 0x50, 0xeb, 0xca, 0x0b, 0x75,
-	0x79, 0x1a, 0x96, 0x03, 0xef, 0xe7, 0x16, 0xdc, 0x38, 0x47, 0xe1, 0x35, 0xe2, 0xe8, 0x09, 0xb4,
-	0x8f, 0xf9, 0x48, 0x2d, 0x51, 0xbe, 0x96, 0xed, 0x5c, 0xf4, 0xf8, 0x7a, 0x81, 0xc3, 0x68, 0xb5,
-	0x80, 0xf7, 0x23, 0x0b, 0x00, 0x03, 0x1a, 0x87, 0xe7, 0x82, 0xc5, 0xba, 0x4e, 0xb0, 0xa8, 0x5e,
-	0x52, 0xb5, 0x24, 0x05, 0x8f, 0x99, 0x9c, 0xd6, 0x27, 0x61, 0x7c, 0x4f, 0xd2, 0x71, 0x42, 0xb5,
-	0xa8, 0x4c, 0x5a, 0xef, 0x67, 0x16, 0x00, 0x16, 0x58, 0x7d, 0x8c, 0xf9, 0xdb, 0xd5, 0xba, 0xfc,
-	0x47, 0x60, 0x63, 0x36, 0x25, 0xf6, 0xca, 0x94, 0x10, 0xc8, 0x91, 0xbd, 0xc8, 0x86, 0x8a, 0xa3,
-	0xa9, 0xf1, 0x26, 0x6b, 0x34, 0x2f, 0xbf, 0xb0, 0xc0, 0xa9, 0xd1, 0x27, 0x66, 0xb3, 0xd7, 0x9a,
-	0xcf, 0x5e, 0xec, 0xf0, 0x54, 0x44, 0xfb, 0xa2, 0x16, 0xe4, 0xc9, 0x34, 0xc8, 0x37, 0xa0, 0x8d,
-	0x94, 0xd4, 0xa2, 0x3c, 0x35, 0x51, 0x7e, 0x1f, 0x6e, 0x14, 0x3c, 0xe0, 0xa9, 0x8c, 0x27, 0x7e,
-	0x92, 0x85, 0xd1, 0x49, 0xc4, 0x43, 0x8c, 0xf5, 0x36, 0xed, 0x95, 0x82, 0x03, 0x83, 0x7b, 0x7f,
-	0xb0, 0x60, 0xf5, 0x3b, 0x63, 0x5e, 0x4c, 0x0e, 0xb3, 0x90, 0xeb, 0x93, 0xbd, 0x78, 0x04, 0xbd,
-	0x87, 0xb6, 0x18, 0x7a, 0x74, 0x08, 0xbd, 0xf1, 0x8f, 0x43, 0x48, 0xd0, 0xb6, 0x30, 0x61, 0xa3,
-	0x28, 0xd6, 0x3f, 0xec, 0xaf, 0x42, 0xf1, 0xd4, 0xb1, 0xe6, 0xea, 0xd4, 0x14, 0xff, 0xd0, 0x82,
-	0x6e, 0x2d, 0x59, 0x54, 0xc9, 0x37, 0xf7, 0x83, 0xbe, 0x56, 0x2c, 0x2c, 0x82, 0xdd, 0x60, 0xfa,
-	0x7c, 0x47, 0xd6, 0x61, 0x29, 0x11, 0x23, 0xe3, 0x71, 0x87, 0xea, 0x01, 0xd9, 0x84, 0x76, 0x22,
-	0x46, 0xf8, 0xfb, 0xc7, 0x54, 0xce, 0x6a, 0xac, 0xdc, 0x36, 0xed, 0x6c, 0x74, 0x01, 0x99, 0x02,
-	0xde, 0xaf, 0x2c, 0x20, 0xa6, 0x71, 0x78, 0xa9, 0x37, 0x5e, 0x0c, 0xd8, 0xfa, 0x13, 0x64, 0x43,
-	0x77, 0x66, 0x75, 0x6c, 0xee, 0xca, 0xb3, 0xcf, 0x5d, 0x79, 0xf7, 0xe1, 0x46, 0xc8, 0x4f, 0x98,
-	0xea, 0x71, 0xe6, 0x8f, 0xdc, 0x33, 0x82, 0xaa, 0x15, 0x7b, 0xeb, 0x5d, 0xe8, 0x54, 0x7f, 0xad,
-	0x90, 0x1e, 0x38, 0x83, 0x34, 0x92, 0xf8, 0x03, 0x2d, 0x4a, 0x47, 0xbd, 0xff, 0x23, 0x5d, 0x68,
-	0x7d, 0x8b, 0xb3, 0x58, 0x9e, 0x4e, 0x7a, 0x16, 0x71, 0xa0, 0xfd, 0xfe, 0x30, 0xcd, 0x8a, 0x84,
-	0xc5, 0xbd, 0xc6, 0xde, 0x3b, 0xdf, 0xfb, 0xca, 0x28, 0x92, 0xa7, 0xe3, 0xa1, 0xb2, 0x64, 0x47,
-	0x9b, 0xf6, 0xc5, 0x28, 0x33, 0x5f, 0x3b, 0xa5, 0xd7, 0x76, 0xd0, 0xda, 0x6a, 0x98, 0x0f, 0x87,
-	0xcb, 0x88, 0xbc, 0xfd, 0xf7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x93, 0x3f, 0x71, 0x80, 0x1a,
-	0x00, 0x00,
+	// 2053 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x73, 0x1c, 0x47,
+	0x15, 0x67, 0x76, 0x56, 0xda, 0xdd, 0xb7, 0xa3, 0xd5, 0xba, 0x2d, 0x3b, 0x23, 0xd9, 0x71, 0x36,
+	0x93, 0x04, 0x44, 0x5c, 0x48, 0x46, 0x01, 0x92, 0xa2, 0x28, 0x1c, 0x4b, 0x1b, 0xcc, 0x96, 0x23,
+	0x23, 0x46, 0x8e, 0xab, 0xe0, 0x32, 0xd5, 0x3b, 0xd3, 0x5a, 0x0d, 0x99, 0x7f, 0x4c, 0xf7, 0xca,
+	0xda, 0x9c, 0x38, 0x70, 0x82, 0x0a, 0x07, 0xaa, 0xc2, 0x97, 0xa0, 0x8a, 0x2b, 0x37, 0xa0, 0x38,
+	0xf1, 0x15, 0x28, 0xbe, 0x09, 0xc5, 0x81, 0xea, 0xd7, 0x3d, 0xb3, 0xb3, 0xab, 0x95, 0x22, 0xcb,
+	0x81, 0x84, 0x22, 0xb7, 0xee, 0xf7, 0x5e, 0xf7, 0xf4, 0x7b, 0xbf, 0xdf, 0xeb, 0x7e, 0xdd, 0x03,
+	0x9d, 0x30, 0x11, 0x2c, 0x4f, 0x68, 0xb4, 0x95, 0xe5, 0xa9, 0x48, 0xc9, 0x8d, 0x38, 0x8c, 0x4e,
+	0xc6, 0x5c, 0xf5, 0xb6, 0x0a, 0xe5, 0x86, 0xe5, 0xa7, 0x71, 0x9c, 0x26, 0x4a, 0xbc, 0x61, 0x71,
+	0xff, 0x98, 0xc5, 0x54, 0xf5, 0x9c, 0x3f, 0x19, 0xb0, 0xb2, 0x97, 0xc6, 0x59, 0x9a, 0xb0, 0x44,
+	0x0c, 0x92, 0xa3, 0x94, 0xdc, 0x84, 0xe5, 0x24, 0x0d, 0xd8, 0xa0, 0x6f, 0x1b, 0x3d, 0x63, 0xd3,
+	0x74, 0x75, 0x8f, 0x10, 0xa8, 0xe7, 0x69, 0xc4, 0xec, 0x5a, 0xcf, 0xd8, 0x6c, 0xb9, 0xd8, 0x26,
+	0xf7, 0x01, 0xb8, 0xa0, 0x82, 0x79, 0x7e, 0x1a, 0x30, 0xdb, 0xec, 0x19, 0x9b, 0x9d, 0x9d, 0xde,
+	0xd6, 0xc2, 0x55, 0x6c, 0x1d, 0x4a, 0xc3, 0xbd, 0x34, 0x60, 0x6e, 0x8b, 0x17, 0x4d, 0xf2, 0x2e,
+	0x00, 0x3b, 0x15, 0x39, 0xf5, 0xc2, 0xe4, 0x28, 0xb5, 0xeb, 0x3d, 0x73, 0xb3, 0xbd, 0xf3, 0xea,
+	0xec, 0x04, 0x7a, 0xf1, 0x8f, 0xd8, 0xe4, 0x29, 0x8d, 0xc6, 0xec, 0x80, 0x86, 0xb9, 0xdb, 0xc2,
+	0x41, 0x72, 0xb9, 0xce, 0xdf, 0x0d, 0x58, 0x2d, 0x1d, 0xc0, 0x6f, 0x70, 0xf2, 0x5d, 0x58, 0xc2,
+	0x4f, 0xa0, 0x07, 0xed, 0x9d, 0xd7, 0xcf, 0x59, 0xd1, 0x8c, 0xdf, 0xae, 0x1a, 0x42, 0x3e, 0x80,
+	0xeb, 0x7c, 0x3c, 0xf4, 0x0b, 0x95, 0x87, 0x52, 0x6e, 0xd7, 0x7a, 0xe6, 0xa5, 0x67, 0x22, 0xd5,
+	0x09, 0xf4, 0x92, 0xde, 0x82, 0x65, 0x39, 0xd3, 0x98, 0x63, 0x94, 0xda, 0x3b, 0xb7, 0x16, 0x3a,
+	0x79, 0x88, 0x26, 0xae, 0x36, 0x75, 0x6e, 0xc1, 0xfa, 0x43, 0x26, 0xe6, 0xbc, 0x73, 0xd9, 0xcf,
+	0xc7, 0x8c, 0x0b, 0xad, 0x7c, 0x12, 0xc6, 0xec, 0x49, 0xe8, 0x7f, 0xb8, 0x77, 0x4c, 0x93, 0x84,
+	0x45, 0x85, 0xf2, 0x65, 0xb8, 0xf5, 0x90, 0xe1, 0x80, 0x90, 0x8b, 0xd0, 0xe7, 0x73, 0xea, 0x1b,
+	0x70, 0xfd, 0x21, 0x13, 0xfd, 0x60, 0x4e, 0xfc, 0x14, 0x9a, 0x8f, 0x25, 0xd8, 0x92, 0x06, 0xdf,
+	0x81, 0x06, 0x0d, 0x82, 0x9c, 0x71, 0xae, 0xa3, 0x78, 0x7b, 0xe1, 0x8a, 0x1f, 0x28, 0x1b, 0xb7,
+	0x30, 0x5e, 0x44, 0x13, 0xe7, 0x67, 0x00, 0x83, 0x24, 0x14, 0x07, 0x34, 0xa7, 0x31, 0x3f, 0x97,
+	0x60, 0x7d, 0xb0, 0xb8, 0xa0, 0xb9, 0xf0, 0x32, 0xb4, 0xb3, 0x6b, 0x97, 0x65, 0x43, 0x1b, 0x87,
+	0xa9, 0xd9, 0x9d, 0x9f, 0x00, 0x1c, 0x8a, 0x3c, 0x4c, 0x46, 0xef, 0x87, 0x5c, 0xc8, 0x6f, 0x9d,
+	0x48, 0x3b, 0xe9, 0x84, 0xb9, 0xd9, 0x72, 0x75, 0xaf, 0x02, 0x47, 0xed, 0xf2, 0x70, 0xdc, 0x87,
+	0x76, 0x11, 0xee, 0x7d, 0x3e, 0x22, 0xf7, 0xa0, 0x3e, 0xa4, 0x9c, 0x5d, 0x18, 0x9e, 0x7d, 0x3e,
+	0xda, 0xa5, 0x9c, 0xb9, 0x68, 0xe9, 0xfc, 0xca, 0x84, 0x97, 0xf6, 0x72, 0x86, 0xe4, 0x8f, 0x22,
+	0xe6, 0x8b, 0x30, 0x4d, 0x74, 0xec, 0x9f, 0x7f, 0x36, 0xf2, 0x12, 0x34, 0x82, 0xa1, 0x97, 0xd0,
+	0xb8, 0x08, 0xf6, 0x72, 0x30, 0x7c, 0x4c, 0x63, 0x46, 0xbe, 0x0a, 0x1d, 0xbf, 0x9c, 0x5f, 0x4a,
+	0x90, 0x73, 0x2d, 0x77, 0x4e, 0x4a, 0x5e, 0x87, 0x95, 0x8c, 0xe6, 0x22, 0x2c, 0xcd, 0xea, 0x68,
+	0x36, 0x2b, 0x94, 0x80, 0x06, 0xc3, 0x41, 0xdf, 0x5e, 0x42, 0xb0, 0xb0, 0x4d, 0x1c, 0xb0, 0xa6,
+	0x73, 0x0d, 0xfa, 0xf6, 0x32, 0xea, 0x66, 0x64, 0xa4, 0x07, 0xed, 0x72, 0xa2, 0x41, 0xdf, 0x6e,
+	0xa0, 0x49, 0x55, 0x24, 0xc1, 0x51, 0x7b, 0x91, 0xdd, 0xec, 0x19, 0x9b, 0x96, 0xab, 0x7b, 0xe4,
+	0x1e, 0x5c, 0x3f, 0x09, 0x73, 0x31, 0xa6, 0x91, 0xe6, 0xa7, 0x5c, 0x07, 0xb7, 0x5b, 0x88, 0xe0,
+	0x22, 0x15, 0xd9, 0x81, 0xb5, 0xec, 0x78, 0xc2, 0x43, 0x7f, 0x6e, 0x08, 0xe0, 0x90, 0x85, 0x3a,
+	0xe7, 0xaf, 0x06, 0xdc, 0xe8, 0xe7, 0x69, 0xf6, 0x85, 0x80, 0xa2, 0x08, 0x72, 0xfd, 0x82, 0x20,
+	0x2f, 0x9d, 0x0d, 0xb2, 0xf3, 0x2f, 0x03, 0x56, 0x1f, 0x04, 0xc1, 0x0f, 0x42, 0x16, 0x05, 0xff,
+	0x81, 0xe5, 0x7f, 0x0d, 0x56, 0xa7, 0x9f, 0xf3, 0x92, 0xcf, 0x7c, 0xfd, 0x15, 0x0a, 0x2c, 0xcf,
+	0x50, 0xe0, 0x0d, 0xe8, 0xa8, 0x96, 0x77, 0xc2, 0x72, 0x1e, 0xa6, 0x89, 0xe6, 0xcf, 0x8a, 0x92,
+	0x3e, 0x55, 0x42, 0xe7, 0xe3, 0x1a, 0xdc, 0x54, 0x09, 0x75, 0x50, 0xf0, 0xea, 0xf3, 0x8c, 0xc2,
+	0x1b, 0xd0, 0x29, 0xf9, 0xad, 0xec, 0xfe, 0xbb, 0x19, 0xe5, 0xfc, 0xba, 0x06, 0x6b, 0x92, 0xd3,
+	0x5f, 0x46, 0x43, 0x46, 0xe3, 0xcf, 0x35, 0x20, 0x8a, 0x1d, 0x83, 0x24, 0x60, 0xa7, 0x9f, 0x67,
+	0x2c, 0x5e, 0x06, 0x38, 0x92, 0x39, 0x5a, 0x8d, 0x43, 0x0b, 0x25, 0x2f, 0x14, 0x03, 0x1b, 0x1a,
+	0x38, 0x49, 0xe9, 0x7f, 0xd1, 0x95, 0x87, 0xa9, 0x2a, 0xac, 0xf4, 0x61, 0xda, 0xbc, 0xf4, 0x61,
+	0x8a, 0xc3, 0xf4, 0x61, 0xfa, 0x07, 0x13, 0x56, 0x06, 0x09, 0x67, 0xb9, 0xf8, 0x7f, 0x26, 0x12,
+	0xb9, 0x0d, 0x2d, 0xce, 0x46, 0xb1, 0xac, 0xef, 0xfa, 0x78, 0x56, 0x99, 0xee, 0x54, 0x20, 0xb5,
+	0xbe, 0x3a, 0x58, 0x06, 0x7d, 0xbb, 0xa5, 0xa0, 0x2d, 0x05, 0xe4, 0x0e, 0x80, 0x08, 0x63, 0xc6,
+	0x05, 0x8d, 0x33, 0x75, 0x20, 0xd5, 0xdd, 0x8a, 0x44, 0xee, 0x80, 0x79, 0xfa, 0x6c, 0xd0, 0xe7,
+	0x76, 0xbb, 0x67, 0xca, 0x6a, 0x48, 0xf5, 0xc8, 0xb7, 0xa0, 0x99, 0xa7, 0xcf, 0xbc, 0x80, 0x0a,
+	0x6a, 0x5b, 0x08, 0xde, 0xfa, 0xc2, 0x60, 0xef, 0x46, 0xe9, 0xd0, 0x6d, 0xe4, 0xe9, 0xb3, 0x3e,
+	0x15, 0xd4, 0xf9, 0x5d, 0x1d, 0x56, 0x0e, 0x19, 0xcd, 0xfd, 0xe3, 0xab, 0x03, 0xf6, 0x75, 0xe8,
+	0xe6, 0x8c, 0x8f, 0x23, 0xe1, 0x4d, 0xdd, 0x52, 0xc8, 0xad, 0x2a, 0xf9, 0x5e, 0xe9, 0x5c, 0x11,
+	0x72, 0xf3, 0x82, 0x90, 0xd7, 0x17, 0x84, 0xdc, 0x01, 0xab, 0x12, 0x5f, 0x6e, 0x2f, 0xa1, 0xeb,
+	0x33, 0x32, 0xd2, 0x05, 0x33, 0xe0, 0x11, 0x22, 0xd6, 0x72, 0x65, 0x93, 0xdc, 0x85, 0x6b, 0x59,
+	0x44, 0x7d, 0x76, 0x9c, 0x46, 0x01, 0xcb, 0xbd, 0x51, 0x9e, 0x8e, 0x33, 0x84, 0xcb, 0x72, 0xbb,
+	0x15, 0xc5, 0x43, 0x29, 0x27, 0x6f, 0x43, 0x33, 0xe0, 0x91, 0x27, 0x26, 0x19, 0x43, 0xc8, 0x3a,
+	0xe7, 0xf8, 0xde, 0xe7, 0xd1, 0x93, 0x49, 0xc6, 0xdc, 0x46, 0xa0, 0x1a, 0xe4, 0x1e, 0xac, 0x71,
+	0x96, 0x87, 0x34, 0x0a, 0x3f, 0x62, 0x81, 0xc7, 0x4e, 0xb3, 0xdc, 0xcb, 0x22, 0x9a, 0x20, 0xb2,
+	0x96, 0x4b, 0xa6, 0xba, 0xf7, 0x4e, 0xb3, 0xfc, 0x20, 0xa2, 0x09, 0xd9, 0x84, 0x6e, 0x3a, 0x16,
+	0xd9, 0x58, 0x78, 0x98, 0x7d, 0xdc, 0x0b, 0x03, 0x04, 0xda, 0x74, 0x3b, 0x4a, 0x8e, 0xc7, 0x33,
+	0x1f, 0x04, 0x32, 0xb4, 0x22, 0xa7, 0x27, 0x2c, 0xf2, 0x4a, 0x06, 0xd8, 0xed, 0x9e, 0xb1, 0x59,
+	0x77, 0x57, 0x95, 0xfc, 0x49, 0x21, 0x26, 0xdb, 0x70, 0x7d, 0x34, 0xa6, 0x39, 0x4d, 0x04, 0x63,
+	0x15, 0x6b, 0x0b, 0xad, 0x49, 0xa9, 0x9a, 0x0e, 0xb8, 0x0d, 0xad, 0x9c, 0x65, 0x51, 0xe8, 0xd3,
+	0x41, 0xdf, 0x5e, 0x51, 0x24, 0x2d, 0x05, 0xce, 0xc7, 0x15, 0x62, 0x48, 0x0c, 0xf9, 0x15, 0x88,
+	0x71, 0x95, 0xa2, 0x79, 0x21, 0x9b, 0xcc, 0xc5, 0x6c, 0x7a, 0x05, 0xda, 0x31, 0x13, 0x79, 0xe8,
+	0x2b, 0xd4, 0x54, 0x92, 0x83, 0x12, 0x21, 0x34, 0x04, 0xea, 0xc7, 0xa1, 0x50, 0x74, 0xb1, 0x5c,
+	0x6c, 0xcb, 0x41, 0x3c, 0x0a, 0x7d, 0x16, 0x78, 0xc3, 0x28, 0x1d, 0x6a, 0x94, 0x40, 0x89, 0x64,
+	0x6e, 0x48, 0x74, 0xb4, 0x41, 0x32, 0x8e, 0x3d, 0x3f, 0x1d, 0x27, 0xc2, 0x06, 0x0c, 0x4f, 0x47,
+	0xc9, 0x1f, 0x8f, 0xe3, 0x3d, 0x29, 0x25, 0xaf, 0xc1, 0x8a, 0xb6, 0x4c, 0x8f, 0x8e, 0x38, 0x13,
+	0x08, 0x8d, 0xe9, 0x5a, 0x4a, 0xf8, 0x23, 0x94, 0x91, 0xef, 0xc1, 0x06, 0x67, 0x34, 0x62, 0x81,
+	0x57, 0xee, 0x00, 0xdc, 0xe3, 0x18, 0x59, 0x16, 0xd8, 0xcb, 0x08, 0xbb, 0xad, 0x2c, 0x0e, 0x4b,
+	0x83, 0x43, 0xad, 0x97, 0xa8, 0x96, 0x61, 0xa8, 0x0c, 0x6b, 0x60, 0x9d, 0x4a, 0xa6, 0xaa, 0x72,
+	0xc0, 0x3b, 0x60, 0x8f, 0xa2, 0x74, 0x48, 0x23, 0xef, 0xcc, 0x57, 0x71, 0x4f, 0x37, 0xdd, 0x9b,
+	0x4a, 0x7f, 0x38, 0xf7, 0xc9, 0x59, 0x3e, 0x58, 0xf3, 0x7c, 0xf8, 0xbd, 0x09, 0xab, 0xae, 0x8c,
+	0x2c, 0x3b, 0x61, 0xff, 0xf3, 0x5b, 0xc5, 0x9b, 0x60, 0x86, 0x01, 0xc7, 0xad, 0xa2, 0xbd, 0x63,
+	0xcf, 0xae, 0x5b, 0xbf, 0x76, 0x0c, 0xfa, 0xdc, 0x95, 0x46, 0x12, 0xe4, 0x99, 0x64, 0xd5, 0xb1,
+	0xb7, 0xaa, 0x99, 0xba, 0x30, 0x4f, 0x9b, 0xcf, 0x95, 0xa7, 0xad, 0x73, 0xf3, 0x74, 0x0d, 0x96,
+	0xa2, 0x30, 0x0e, 0x0b, 0x12, 0xaa, 0xce, 0x2c, 0x5a, 0xed, 0x79, 0xb4, 0xfe, 0x31, 0x83, 0xd6,
+	0x17, 0x35, 0x7f, 0x35, 0x0c, 0xf5, 0xcb, 0xc0, 0x70, 0x1f, 0xda, 0x7a, 0xb3, 0xc4, 0x13, 0x6e,
+	0x09, 0x4f, 0xb8, 0x3b, 0x0b, 0xc7, 0x20, 0x26, 0xf2, 0x74, 0x73, 0x55, 0x0d, 0xc5, 0x65, 0x9b,
+	0x7c, 0x1f, 0x6e, 0x9d, 0xcd, 0xc3, 0x5c, 0xc7, 0xa8, 0x48, 0xc4, 0xf5, 0xf9, 0x44, 0x2c, 0x82,
+	0x18, 0x90, 0x6f, 0xc2, 0x5a, 0x25, 0x13, 0xa7, 0x03, 0x15, 0x1d, 0x2a, 0x59, 0x3a, 0x1d, 0xf2,
+	0x19, 0xe5, 0x62, 0x6b, 0x1e, 0xdd, 0x4f, 0x4c, 0x58, 0xe9, 0xb3, 0x88, 0x89, 0x17, 0xc8, 0xc4,
+	0x05, 0xc5, 0x54, 0x6d, 0x61, 0x31, 0x35, 0x53, 0xad, 0x98, 0x17, 0x57, 0x2b, 0xf5, 0x33, 0xd5,
+	0xca, 0xab, 0x60, 0x65, 0x79, 0x18, 0xd3, 0x7c, 0xe2, 0x7d, 0xc8, 0x26, 0x45, 0x36, 0xb6, 0xb5,
+	0xec, 0x11, 0x9b, 0xf0, 0x6a, 0xbd, 0xb7, 0x3c, 0x53, 0xef, 0x9d, 0x2d, 0xe3, 0x1a, 0x17, 0x95,
+	0x71, 0xcd, 0x0b, 0x36, 0x8a, 0xd6, 0xa7, 0x97, 0x71, 0x70, 0xb6, 0x8c, 0xdb, 0x82, 0xeb, 0x1c,
+	0x9f, 0x86, 0xbc, 0x19, 0x1f, 0xda, 0x88, 0xf8, 0x35, 0xa5, 0x3a, 0x98, 0x7a, 0xe2, 0x24, 0xb0,
+	0xf1, 0x7e, 0x4a, 0x83, 0x5d, 0x1a, 0xd1, 0xc4, 0x67, 0x1a, 0x4e, 0x7e, 0x75, 0x8c, 0xee, 0x00,
+	0x54, 0x18, 0x53, 0xc3, 0xd0, 0x55, 0x24, 0xce, 0x3f, 0x0d, 0x68, 0xc9, 0x0f, 0xe2, 0x6d, 0xe5,
+	0x0a, 0xf3, 0xcf, 0x94, 0xa9, 0xb5, 0x05, 0x65, 0x6a, 0x79, 0xe1, 0x28, 0x80, 0x2f, 0x05, 0xd5,
+	0x9b, 0x44, 0x7d, 0xf6, 0x26, 0xf1, 0x0a, 0xb4, 0x43, 0xb9, 0x20, 0x2f, 0xa3, 0xe2, 0x58, 0x21,
+	0xde, 0x72, 0x01, 0x45, 0x07, 0x52, 0x22, 0xaf, 0x1a, 0x85, 0x01, 0x5e, 0x35, 0x96, 0x2f, 0x7d,
+	0xd5, 0xd0, 0x93, 0xe0, 0x55, 0xe3, 0x2f, 0x35, 0xb0, 0x75, 0x88, 0xa7, 0xcf, 0x96, 0x1f, 0x64,
+	0x01, 0xbe, 0x9e, 0xde, 0x86, 0x56, 0x99, 0x4d, 0xfa, 0xd5, 0x70, 0x2a, 0x90, 0x71, 0xdd, 0x67,
+	0x71, 0x9a, 0x4f, 0x0e, 0xc3, 0x8f, 0x98, 0x76, 0xbc, 0x22, 0x91, 0xbe, 0x3d, 0x1e, 0xc7, 0x6e,
+	0xfa, 0x8c, 0xeb, 0xd3, 0xa7, 0xe8, 0x4a, 0xdf, 0x7c, 0xbc, 0x20, 0xe2, 0xce, 0x8d, 0x9e, 0xd7,
+	0x5d, 0x50, 0x22, 0xb9, 0x63, 0x93, 0x75, 0x68, 0xb2, 0x24, 0x50, 0xda, 0x25, 0xd4, 0x36, 0x58,
+	0x12, 0xa0, 0x6a, 0x00, 0x1d, 0xfd, 0x5c, 0x99, 0x72, 0x64, 0x98, 0x3e, 0x7f, 0x9c, 0x73, 0xde,
+	0x88, 0xf7, 0xf9, 0xe8, 0x40, 0x5b, 0xba, 0x2b, 0xea, 0xc5, 0x52, 0x77, 0xc9, 0x7b, 0x60, 0xc9,
+	0xaf, 0x94, 0x13, 0x35, 0x2e, 0x3d, 0x51, 0x9b, 0x25, 0x41, 0xd1, 0x71, 0x7e, 0x6b, 0xc0, 0xb5,
+	0x33, 0x21, 0xbc, 0x02, 0x8f, 0x1e, 0x41, 0xf3, 0x90, 0x8d, 0xe4, 0x14, 0xc5, 0x23, 0xec, 0xf6,
+	0x79, 0x6f, 0xfa, 0xe7, 0x00, 0xe6, 0x96, 0x13, 0x38, 0xbf, 0x34, 0xe4, 0xe3, 0x6f, 0xc0, 0x4e,
+	0xb1, 0x7b, 0x86, 0x2c, 0xc6, 0x55, 0xc8, 0x22, 0x6b, 0x74, 0x59, 0xcc, 0xe5, 0x2c, 0xa2, 0x62,
+	0xba, 0x0f, 0x73, 0x8d, 0x3d, 0x49, 0xc6, 0xb1, 0xab, 0x54, 0x45, 0xd2, 0x3a, 0xbf, 0x31, 0x00,
+	0xf0, 0x20, 0x51, 0xcb, 0x98, 0xdf, 0x50, 0x8c, 0x8b, 0x2f, 0xd7, 0xb5, 0xd9, 0x94, 0xd8, 0x2d,
+	0x52, 0x82, 0x63, 0x8c, 0xcc, 0x45, 0x3e, 0x94, 0x31, 0x9a, 0x3a, 0xaf, 0xb3, 0x46, 0xc5, 0xe5,
+	0x13, 0x03, 0xac, 0x4a, 0xf8, 0xf8, 0x6c, 0xf6, 0x1a, 0xf3, 0xd9, 0x8b, 0xb5, 0xb1, 0x64, 0xb4,
+	0xc7, 0x2b, 0x24, 0x8f, 0xa7, 0x24, 0x5f, 0x87, 0x26, 0x86, 0xa4, 0xc2, 0xf2, 0x44, 0xb3, 0xfc,
+	0x2e, 0x5c, 0xcb, 0x99, 0xcf, 0x12, 0x11, 0x4d, 0xbc, 0x38, 0x0d, 0xc2, 0xa3, 0x90, 0x05, 0xc8,
+	0xf5, 0xa6, 0xdb, 0x2d, 0x14, 0xfb, 0x5a, 0xee, 0xfc, 0xcd, 0x80, 0xce, 0x8f, 0xc7, 0x2c, 0x9f,
+	0xc8, 0x3f, 0x01, 0x6a, 0x65, 0xcf, 0xcf, 0xa0, 0x77, 0xd1, 0x17, 0x8f, 0x57, 0x28, 0xf4, 0xda,
+	0xa7, 0x53, 0x88, 0xbb, 0x4d, 0xae, 0x69, 0x23, 0x43, 0xac, 0x1e, 0x4c, 0x2e, 0x13, 0xe2, 0x29,
+	0xb0, 0xba, 0x44, 0x50, 0x21, 0xfe, 0x85, 0x01, 0xed, 0x4a, 0xb2, 0xc8, 0xc3, 0x4b, 0x9f, 0x74,
+	0xea, 0xf8, 0x31, 0x70, 0x13, 0x6c, 0xfb, 0xd3, 0x57, 0x61, 0x59, 0x9c, 0xc5, 0x7c, 0xa4, 0x11,
+	0xb7, 0x5c, 0xd5, 0x21, 0x1b, 0xd0, 0x8c, 0xf9, 0x08, 0xef, 0x95, 0x7a, 0xe7, 0x2c, 0xfb, 0x12,
+	0xb6, 0x69, 0xd5, 0xa7, 0x36, 0x90, 0xa9, 0xc0, 0xf9, 0xa3, 0x01, 0x44, 0x17, 0x48, 0x2f, 0xf4,
+	0xeb, 0x00, 0x09, 0x5b, 0x7d, 0xd9, 0xae, 0xa9, 0xaa, 0xb5, 0x2a, 0x9b, 0x3b, 0xbc, 0xcd, 0x33,
+	0x87, 0xf7, 0x5d, 0xb8, 0x16, 0xb0, 0x23, 0x2a, 0x6b, 0xb9, 0xf9, 0x25, 0x77, 0xb5, 0xa2, 0x2c,
+	0x53, 0xdf, 0x7c, 0x07, 0x5a, 0xe5, 0x1f, 0x3b, 0xd2, 0x05, 0x4b, 0xfe, 0xc0, 0xc1, 0x8b, 0x6f,
+	0x98, 0x8c, 0xba, 0x5f, 0x21, 0x6d, 0x68, 0xfc, 0x90, 0xd1, 0x48, 0x1c, 0x4f, 0xba, 0x06, 0xb1,
+	0xa0, 0xf9, 0x60, 0x98, 0xa4, 0x79, 0x4c, 0xa3, 0x6e, 0x6d, 0xf7, 0xed, 0x9f, 0x7e, 0x7b, 0x14,
+	0x8a, 0xe3, 0xf1, 0x50, 0x7a, 0xb2, 0xad, 0x5c, 0xfb, 0x46, 0x98, 0xea, 0xd6, 0x76, 0x81, 0xda,
+	0x36, 0x7a, 0x5b, 0x76, 0xb3, 0xe1, 0x70, 0x19, 0x25, 0x6f, 0xfd, 0x7b, 0x00, 0x57, 0x2f, 0x37,
+	0x78, 0xd7, 0x1c, 0x00, 0x00,
 }
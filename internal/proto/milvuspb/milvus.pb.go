@@ -79,6 +79,572 @@ func (PlaceholderType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{1}
 }
 
+type CreateDatabaseRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateDatabaseRequest) Reset()         { *m = CreateDatabaseRequest{} }
+func (m *CreateDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDatabaseRequest) ProtoMessage()    {}
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{0}
+}
+
+func (m *CreateDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDatabaseRequest.Unmarshal(m, b)
+}
+func (m *CreateDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDatabaseRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDatabaseRequest.Merge(m, src)
+}
+func (m *CreateDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDatabaseRequest.Size(m)
+}
+func (m *CreateDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDatabaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDatabaseRequest proto.InternalMessageInfo
+
+func (m *CreateDatabaseRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateDatabaseRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type DropDatabaseRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DropDatabaseRequest) Reset()         { *m = DropDatabaseRequest{} }
+func (m *DropDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*DropDatabaseRequest) ProtoMessage()    {}
+func (*DropDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{1}
+}
+
+func (m *DropDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropDatabaseRequest.Unmarshal(m, b)
+}
+func (m *DropDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropDatabaseRequest.Marshal(b, m, deterministic)
+}
+func (m *DropDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropDatabaseRequest.Merge(m, src)
+}
+func (m *DropDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_DropDatabaseRequest.Size(m)
+}
+func (m *DropDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropDatabaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropDatabaseRequest proto.InternalMessageInfo
+
+func (m *DropDatabaseRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropDatabaseRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type ListDatabasesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListDatabasesRequest) Reset()         { *m = ListDatabasesRequest{} }
+func (m *ListDatabasesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesRequest) ProtoMessage()    {}
+func (*ListDatabasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{2}
+}
+
+func (m *ListDatabasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesRequest.Unmarshal(m, b)
+}
+func (m *ListDatabasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesRequest.Merge(m, src)
+}
+func (m *ListDatabasesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesRequest.Size(m)
+}
+func (m *ListDatabasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabasesRequest proto.InternalMessageInfo
+
+func (m *ListDatabasesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListDatabasesResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DbNames              []string         `protobuf:"bytes,2,rep,name=db_names,json=dbNames,proto3" json:"db_names,omitempty"`
+	DbIds                []int64          `protobuf:"varint,3,rep,packed,name=db_ids,json=dbIds,proto3" json:"db_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListDatabasesResponse) Reset()         { *m = ListDatabasesResponse{} }
+func (m *ListDatabasesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesResponse) ProtoMessage()    {}
+func (*ListDatabasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{3}
+}
+
+func (m *ListDatabasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesResponse.Unmarshal(m, b)
+}
+func (m *ListDatabasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesResponse.Merge(m, src)
+}
+func (m *ListDatabasesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesResponse.Size(m)
+}
+func (m *ListDatabasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabasesResponse proto.InternalMessageInfo
+
+func (m *ListDatabasesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListDatabasesResponse) GetDbNames() []string {
+	if m != nil {
+		return m.DbNames
+	}
+	return nil
+}
+
+func (m *ListDatabasesResponse) GetDbIds() []int64 {
+	if m != nil {
+		return m.DbIds
+	}
+	return nil
+}
+
+type CreateUserRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string            `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateUserRequest) Reset()         { *m = CreateUserRequest{} }
+func (m *CreateUserRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateUserRequest) ProtoMessage()    {}
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{4}
+}
+
+func (m *CreateUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateUserRequest.Unmarshal(m, b)
+}
+func (m *CreateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateUserRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateUserRequest.Merge(m, src)
+}
+func (m *CreateUserRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateUserRequest.Size(m)
+}
+func (m *CreateUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateUserRequest proto.InternalMessageInfo
+
+func (m *CreateUserRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateUserRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *CreateUserRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+// Grant is a privilege on a collection, "*" as the database or collection name stands for all of them
+type Grant struct {
+	DbName               string             `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string             `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Privilege            commonpb.Privilege `protobuf:"varint,3,opt,name=privilege,proto3,enum=milvus.proto.common.Privilege" json:"privilege,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Grant) Reset()         { *m = Grant{} }
+func (m *Grant) String() string { return proto.CompactTextString(m) }
+func (*Grant) ProtoMessage()    {}
+func (*Grant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{5}
+}
+
+func (m *Grant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Grant.Unmarshal(m, b)
+}
+func (m *Grant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Grant.Marshal(b, m, deterministic)
+}
+func (m *Grant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Grant.Merge(m, src)
+}
+func (m *Grant) XXX_Size() int {
+	return xxx_messageInfo_Grant.Size(m)
+}
+func (m *Grant) XXX_DiscardUnknown() {
+	xxx_messageInfo_Grant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Grant proto.InternalMessageInfo
+
+func (m *Grant) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *Grant) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *Grant) GetPrivilege() commonpb.Privilege {
+	if m != nil {
+		return m.Privilege
+	}
+	return commonpb.Privilege_PrivilegeNone
+}
+
+type GrantRoleRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RoleName             string            `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	Username             string            `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Grants               []*Grant          `protobuf:"bytes,4,rep,name=grants,proto3" json:"grants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GrantRoleRequest) Reset()         { *m = GrantRoleRequest{} }
+func (m *GrantRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*GrantRoleRequest) ProtoMessage()    {}
+func (*GrantRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{6}
+}
+
+func (m *GrantRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantRoleRequest.Unmarshal(m, b)
+}
+func (m *GrantRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *GrantRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantRoleRequest.Merge(m, src)
+}
+func (m *GrantRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_GrantRoleRequest.Size(m)
+}
+func (m *GrantRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantRoleRequest proto.InternalMessageInfo
+
+func (m *GrantRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GrantRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *GrantRoleRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *GrantRoleRequest) GetGrants() []*Grant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+type RevokeRoleRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RoleName             string            `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	Username             string            `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Grants               []*Grant          `protobuf:"bytes,4,rep,name=grants,proto3" json:"grants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RevokeRoleRequest) Reset()         { *m = RevokeRoleRequest{} }
+func (m *RevokeRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeRoleRequest) ProtoMessage()    {}
+func (*RevokeRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{7}
+}
+
+func (m *RevokeRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeRoleRequest.Unmarshal(m, b)
+}
+func (m *RevokeRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeRoleRequest.Merge(m, src)
+}
+func (m *RevokeRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeRoleRequest.Size(m)
+}
+func (m *RevokeRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeRoleRequest proto.InternalMessageInfo
+
+func (m *RevokeRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RevokeRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *RevokeRoleRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *RevokeRoleRequest) GetGrants() []*Grant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+type ListGrantsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListGrantsRequest) Reset()         { *m = ListGrantsRequest{} }
+func (m *ListGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGrantsRequest) ProtoMessage()    {}
+func (*ListGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{8}
+}
+
+func (m *ListGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGrantsRequest.Unmarshal(m, b)
+}
+func (m *ListGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGrantsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGrantsRequest.Merge(m, src)
+}
+func (m *ListGrantsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGrantsRequest.Size(m)
+}
+func (m *ListGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGrantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGrantsRequest proto.InternalMessageInfo
+
+func (m *ListGrantsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListGrantsRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type RoleGrants struct {
+	RoleName             string   `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	Grants               []*Grant `protobuf:"bytes,2,rep,name=grants,proto3" json:"grants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoleGrants) Reset()         { *m = RoleGrants{} }
+func (m *RoleGrants) String() string { return proto.CompactTextString(m) }
+func (*RoleGrants) ProtoMessage()    {}
+func (*RoleGrants) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{9}
+}
+
+func (m *RoleGrants) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleGrants.Unmarshal(m, b)
+}
+func (m *RoleGrants) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleGrants.Marshal(b, m, deterministic)
+}
+func (m *RoleGrants) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleGrants.Merge(m, src)
+}
+func (m *RoleGrants) XXX_Size() int {
+	return xxx_messageInfo_RoleGrants.Size(m)
+}
+func (m *RoleGrants) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleGrants.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleGrants proto.InternalMessageInfo
+
+func (m *RoleGrants) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *RoleGrants) GetGrants() []*Grant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+type ListGrantsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Roles                []*RoleGrants    `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListGrantsResponse) Reset()         { *m = ListGrantsResponse{} }
+func (m *ListGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGrantsResponse) ProtoMessage()    {}
+func (*ListGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{10}
+}
+
+func (m *ListGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGrantsResponse.Unmarshal(m, b)
+}
+func (m *ListGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGrantsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGrantsResponse.Merge(m, src)
+}
+func (m *ListGrantsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGrantsResponse.Size(m)
+}
+func (m *ListGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGrantsResponse proto.InternalMessageInfo
+
+func (m *ListGrantsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListGrantsResponse) GetRoles() []*RoleGrants {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 type CreateCollectionRequest struct {
 	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -95,7 +661,7 @@ func (m *CreateCollectionRequest) Reset()         { *m = CreateCollectionRequest
 func (m *CreateCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateCollectionRequest) ProtoMessage()    {}
 func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{0}
+	return fileDescriptor_02345ba45cc0e303, []int{11}
 }
 
 func (m *CreateCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -164,7 +730,7 @@ func (m *DropCollectionRequest) Reset()         { *m = DropCollectionRequest{} }
 func (m *DropCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*DropCollectionRequest) ProtoMessage()    {}
 func (*DropCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{1}
+	return fileDescriptor_02345ba45cc0e303, []int{12}
 }
 
 func (m *DropCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -220,7 +786,7 @@ func (m *HasCollectionRequest) Reset()         { *m = HasCollectionRequest{} }
 func (m *HasCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*HasCollectionRequest) ProtoMessage()    {}
 func (*HasCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{2}
+	return fileDescriptor_02345ba45cc0e303, []int{13}
 }
 
 func (m *HasCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -281,7 +847,7 @@ func (m *BoolResponse) Reset()         { *m = BoolResponse{} }
 func (m *BoolResponse) String() string { return proto.CompactTextString(m) }
 func (*BoolResponse) ProtoMessage()    {}
 func (*BoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{3}
+	return fileDescriptor_02345ba45cc0e303, []int{14}
 }
 
 func (m *BoolResponse) XXX_Unmarshal(b []byte) error {
@@ -328,7 +894,7 @@ func (m *StringResponse) Reset()         { *m = StringResponse{} }
 func (m *StringResponse) String() string { return proto.CompactTextString(m) }
 func (*StringResponse) ProtoMessage()    {}
 func (*StringResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{4}
+	return fileDescriptor_02345ba45cc0e303, []int{15}
 }
 
 func (m *StringResponse) XXX_Unmarshal(b []byte) error {
@@ -378,7 +944,7 @@ func (m *DescribeCollectionRequest) Reset()         { *m = DescribeCollectionReq
 func (m *DescribeCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeCollectionRequest) ProtoMessage()    {}
 func (*DescribeCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{5}
+	return fileDescriptor_02345ba45cc0e303, []int{16}
 }
 
 func (m *DescribeCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -449,7 +1015,7 @@ func (m *DescribeCollectionResponse) Reset()         { *m = DescribeCollectionRe
 func (m *DescribeCollectionResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeCollectionResponse) ProtoMessage()    {}
 func (*DescribeCollectionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{6}
+	return fileDescriptor_02345ba45cc0e303, []int{17}
 }
 
 func (m *DescribeCollectionResponse) XXX_Unmarshal(b []byte) error {
@@ -509,6 +1075,7 @@ type LoadCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	ReplicaNumber        int32             `protobuf:"varint,4,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -518,7 +1085,7 @@ func (m *LoadCollectionRequest) Reset()         { *m = LoadCollectionRequest{} }
 func (m *LoadCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadCollectionRequest) ProtoMessage()    {}
 func (*LoadCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{7}
+	return fileDescriptor_02345ba45cc0e303, []int{18}
 }
 
 func (m *LoadCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -560,6 +1127,13 @@ func (m *LoadCollectionRequest) GetCollectionName() string {
 	return ""
 }
 
+func (m *LoadCollectionRequest) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
 type ReleaseCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -573,7 +1147,7 @@ func (m *ReleaseCollectionRequest) Reset()         { *m = ReleaseCollectionReque
 func (m *ReleaseCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseCollectionRequest) ProtoMessage()    {}
 func (*ReleaseCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{8}
+	return fileDescriptor_02345ba45cc0e303, []int{19}
 }
 
 func (m *ReleaseCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -628,7 +1202,7 @@ func (m *GetCollectionStatisticsRequest) Reset()         { *m = GetCollectionSta
 func (m *GetCollectionStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCollectionStatisticsRequest) ProtoMessage()    {}
 func (*GetCollectionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{9}
+	return fileDescriptor_02345ba45cc0e303, []int{20}
 }
 
 func (m *GetCollectionStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -682,7 +1256,7 @@ func (m *GetCollectionStatisticsResponse) Reset()         { *m = GetCollectionSt
 func (m *GetCollectionStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCollectionStatisticsResponse) ProtoMessage()    {}
 func (*GetCollectionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{10}
+	return fileDescriptor_02345ba45cc0e303, []int{21}
 }
 
 func (m *GetCollectionStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -731,7 +1305,7 @@ func (m *ShowCollectionsRequest) Reset()         { *m = ShowCollectionsRequest{}
 func (m *ShowCollectionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowCollectionsRequest) ProtoMessage()    {}
 func (*ShowCollectionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{11}
+	return fileDescriptor_02345ba45cc0e303, []int{22}
 }
 
 func (m *ShowCollectionsRequest) XXX_Unmarshal(b []byte) error {
@@ -793,7 +1367,7 @@ func (m *ShowCollectionsResponse) Reset()         { *m = ShowCollectionsResponse
 func (m *ShowCollectionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowCollectionsResponse) ProtoMessage()    {}
 func (*ShowCollectionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{12}
+	return fileDescriptor_02345ba45cc0e303, []int{23}
 }
 
 func (m *ShowCollectionsResponse) XXX_Unmarshal(b []byte) error {
@@ -835,6 +1409,251 @@ func (m *ShowCollectionsResponse) GetCollectionIds() []int64 {
 	return nil
 }
 
+type AddFieldRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// `schema` is the serialized `schema.FieldSchema` of the new field
+	Schema               []byte   `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddFieldRequest) Reset()         { *m = AddFieldRequest{} }
+func (m *AddFieldRequest) String() string { return proto.CompactTextString(m) }
+func (*AddFieldRequest) ProtoMessage()    {}
+func (*AddFieldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{24}
+}
+
+func (m *AddFieldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddFieldRequest.Unmarshal(m, b)
+}
+func (m *AddFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddFieldRequest.Marshal(b, m, deterministic)
+}
+func (m *AddFieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFieldRequest.Merge(m, src)
+}
+func (m *AddFieldRequest) XXX_Size() int {
+	return xxx_messageInfo_AddFieldRequest.Size(m)
+}
+func (m *AddFieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFieldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddFieldRequest proto.InternalMessageInfo
+
+func (m *AddFieldRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AddFieldRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *AddFieldRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *AddFieldRequest) GetSchema() []byte {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+type CreateAliasRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Alias                string            `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateAliasRequest) Reset()         { *m = CreateAliasRequest{} }
+func (m *CreateAliasRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAliasRequest) ProtoMessage()    {}
+func (*CreateAliasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{25}
+}
+
+func (m *CreateAliasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAliasRequest.Unmarshal(m, b)
+}
+func (m *CreateAliasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAliasRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateAliasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAliasRequest.Merge(m, src)
+}
+func (m *CreateAliasRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAliasRequest.Size(m)
+}
+func (m *CreateAliasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAliasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAliasRequest proto.InternalMessageInfo
+
+func (m *CreateAliasRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateAliasRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *CreateAliasRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *CreateAliasRequest) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+type DropAliasRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Alias                string            `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DropAliasRequest) Reset()         { *m = DropAliasRequest{} }
+func (m *DropAliasRequest) String() string { return proto.CompactTextString(m) }
+func (*DropAliasRequest) ProtoMessage()    {}
+func (*DropAliasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{26}
+}
+
+func (m *DropAliasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropAliasRequest.Unmarshal(m, b)
+}
+func (m *DropAliasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropAliasRequest.Marshal(b, m, deterministic)
+}
+func (m *DropAliasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropAliasRequest.Merge(m, src)
+}
+func (m *DropAliasRequest) XXX_Size() int {
+	return xxx_messageInfo_DropAliasRequest.Size(m)
+}
+func (m *DropAliasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropAliasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropAliasRequest proto.InternalMessageInfo
+
+func (m *DropAliasRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropAliasRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *DropAliasRequest) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+type AlterAliasRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Alias                string            `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AlterAliasRequest) Reset()         { *m = AlterAliasRequest{} }
+func (m *AlterAliasRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterAliasRequest) ProtoMessage()    {}
+func (*AlterAliasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{27}
+}
+
+func (m *AlterAliasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterAliasRequest.Unmarshal(m, b)
+}
+func (m *AlterAliasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterAliasRequest.Marshal(b, m, deterministic)
+}
+func (m *AlterAliasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterAliasRequest.Merge(m, src)
+}
+func (m *AlterAliasRequest) XXX_Size() int {
+	return xxx_messageInfo_AlterAliasRequest.Size(m)
+}
+func (m *AlterAliasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterAliasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterAliasRequest proto.InternalMessageInfo
+
+func (m *AlterAliasRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AlterAliasRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *AlterAliasRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *AlterAliasRequest) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
 type CreatePartitionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -849,7 +1668,7 @@ func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{}
 func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePartitionRequest) ProtoMessage()    {}
 func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{13}
+	return fileDescriptor_02345ba45cc0e303, []int{28}
 }
 
 func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -912,7 +1731,7 @@ func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
 func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*DropPartitionRequest) ProtoMessage()    {}
 func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{14}
+	return fileDescriptor_02345ba45cc0e303, []int{29}
 }
 
 func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -975,7 +1794,7 @@ func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
 func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*HasPartitionRequest) ProtoMessage()    {}
 func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{15}
+	return fileDescriptor_02345ba45cc0e303, []int{30}
 }
 
 func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1038,7 +1857,7 @@ func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
 func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadPartitionsRequest) ProtoMessage()    {}
 func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{16}
+	return fileDescriptor_02345ba45cc0e303, []int{31}
 }
 
 func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1101,7 +1920,7 @@ func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsReque
 func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleasePartitionsRequest) ProtoMessage()    {}
 func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{17}
+	return fileDescriptor_02345ba45cc0e303, []int{32}
 }
 
 func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1164,7 +1983,7 @@ func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStati
 func (m *GetPartitionStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatisticsRequest) ProtoMessage()    {}
 func (*GetPartitionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{18}
+	return fileDescriptor_02345ba45cc0e303, []int{33}
 }
 
 func (m *GetPartitionStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -1225,7 +2044,7 @@ func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStat
 func (m *GetPartitionStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatisticsResponse) ProtoMessage()    {}
 func (*GetPartitionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{19}
+	return fileDescriptor_02345ba45cc0e303, []int{34}
 }
 
 func (m *GetPartitionStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -1274,7 +2093,7 @@ func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
 func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsRequest) ProtoMessage()    {}
 func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{20}
+	return fileDescriptor_02345ba45cc0e303, []int{35}
 }
 
 func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1336,7 +2155,7 @@ func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{}
 func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsResponse) ProtoMessage()    {}
 func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{21}
+	return fileDescriptor_02345ba45cc0e303, []int{36}
 }
 
 func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
@@ -1382,6 +2201,7 @@ type DescribeSegmentRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	SegmentID            int64             `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldID              int64             `protobuf:"varint,4,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1391,7 +2211,7 @@ func (m *DescribeSegmentRequest) Reset()         { *m = DescribeSegmentRequest{}
 func (m *DescribeSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentRequest) ProtoMessage()    {}
 func (*DescribeSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{22}
+	return fileDescriptor_02345ba45cc0e303, []int{37}
 }
 
 func (m *DescribeSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -1433,11 +2253,19 @@ func (m *DescribeSegmentRequest) GetSegmentID() int64 {
 	return 0
 }
 
+func (m *DescribeSegmentRequest) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
 type DescribeSegmentResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IndexID              int64            `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
 	BuildID              int64            `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
 	EnableIndex          bool             `protobuf:"varint,4,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
+	FieldID              int64            `protobuf:"varint,5,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -1447,7 +2275,7 @@ func (m *DescribeSegmentResponse) Reset()         { *m = DescribeSegmentResponse
 func (m *DescribeSegmentResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentResponse) ProtoMessage()    {}
 func (*DescribeSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{23}
+	return fileDescriptor_02345ba45cc0e303, []int{38}
 }
 
 func (m *DescribeSegmentResponse) XXX_Unmarshal(b []byte) error {
@@ -1496,6 +2324,13 @@ func (m *DescribeSegmentResponse) GetEnableIndex() bool {
 	return false
 }
 
+func (m *DescribeSegmentResponse) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
 type ShowSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -1509,7 +2344,7 @@ func (m *ShowSegmentsRequest) Reset()         { *m = ShowSegmentsRequest{} }
 func (m *ShowSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsRequest) ProtoMessage()    {}
 func (*ShowSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{24}
+	return fileDescriptor_02345ba45cc0e303, []int{39}
 }
 
 func (m *ShowSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1563,7 +2398,7 @@ func (m *ShowSegmentsResponse) Reset()         { *m = ShowSegmentsResponse{} }
 func (m *ShowSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsResponse) ProtoMessage()    {}
 func (*ShowSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{25}
+	return fileDescriptor_02345ba45cc0e303, []int{40}
 }
 
 func (m *ShowSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -1613,7 +2448,7 @@ func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
 func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateIndexRequest) ProtoMessage()    {}
 func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{26}
+	return fileDescriptor_02345ba45cc0e303, []int{41}
 }
 
 func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -1684,7 +2519,7 @@ func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
 func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexRequest) ProtoMessage()    {}
 func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{27}
+	return fileDescriptor_02345ba45cc0e303, []int{42}
 }
 
 func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -1754,7 +2589,7 @@ func (m *IndexDescription) Reset()         { *m = IndexDescription{} }
 func (m *IndexDescription) String() string { return proto.CompactTextString(m) }
 func (*IndexDescription) ProtoMessage()    {}
 func (*IndexDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{28}
+	return fileDescriptor_02345ba45cc0e303, []int{43}
 }
 
 func (m *IndexDescription) XXX_Unmarshal(b []byte) error {
@@ -1815,7 +2650,7 @@ func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
 func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexResponse) ProtoMessage()    {}
 func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{29}
+	return fileDescriptor_02345ba45cc0e303, []int{44}
 }
 
 func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
@@ -1865,7 +2700,7 @@ func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgr
 func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
 func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{30}
+	return fileDescriptor_02345ba45cc0e303, []int{45}
 }
 
 func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
@@ -1934,7 +2769,7 @@ func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProg
 func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
 func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{31}
+	return fileDescriptor_02345ba45cc0e303, []int{46}
 }
 
 func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
@@ -1991,7 +2826,7 @@ func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
 func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateRequest) ProtoMessage()    {}
 func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{32}
+	return fileDescriptor_02345ba45cc0e303, []int{47}
 }
 
 func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
@@ -2059,7 +2894,7 @@ func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
 func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateResponse) ProtoMessage()    {}
 func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{33}
+	return fileDescriptor_02345ba45cc0e303, []int{48}
 }
 
 func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
@@ -2109,7 +2944,7 @@ func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
 func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DropIndexRequest) ProtoMessage()    {}
 func (*DropIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{34}
+	return fileDescriptor_02345ba45cc0e303, []int{49}
 }
 
 func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2182,7 +3017,7 @@ func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
 func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
 func (*InsertRequest) ProtoMessage()    {}
 func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{35}
+	return fileDescriptor_02345ba45cc0e303, []int{50}
 }
 
 func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
@@ -2252,6 +3087,158 @@ func (m *InsertRequest) GetNumRows() uint32 {
 	return 0
 }
 
+type DeleteRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	Expr                 string            `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{51}
+}
+
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DeleteRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+// Upsert inserts the entities and deletes the existing entities with the same primary keys at the same timestamp,
+// the entities to delete are searched in the partition if partition_name is set, otherwise in the whole collection
+type UpsertRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	NumRows              uint32                `protobuf:"varint,6,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpsertRequest) Reset()         { *m = UpsertRequest{} }
+func (m *UpsertRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertRequest) ProtoMessage()    {}
+func (*UpsertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{52}
+}
+
+func (m *UpsertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertRequest.Unmarshal(m, b)
+}
+func (m *UpsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertRequest.Marshal(b, m, deterministic)
+}
+func (m *UpsertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertRequest.Merge(m, src)
+}
+func (m *UpsertRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertRequest.Size(m)
+}
+func (m *UpsertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertRequest proto.InternalMessageInfo
+
+func (m *UpsertRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UpsertRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
+	}
+	return nil
+}
+
+func (m *UpsertRequest) GetNumRows() uint32 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
 type MutationResult struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IDs                  *schemapb.IDs    `protobuf:"bytes,2,opt,name=IDs,proto3" json:"IDs,omitempty"`
@@ -2271,7 +3258,7 @@ func (m *MutationResult) Reset()         { *m = MutationResult{} }
 func (m *MutationResult) String() string { return proto.CompactTextString(m) }
 func (*MutationResult) ProtoMessage()    {}
 func (*MutationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{36}
+	return fileDescriptor_02345ba45cc0e303, []int{53}
 }
 
 func (m *MutationResult) XXX_Unmarshal(b []byte) error {
@@ -2369,7 +3356,7 @@ func (m *PlaceholderValue) Reset()         { *m = PlaceholderValue{} }
 func (m *PlaceholderValue) String() string { return proto.CompactTextString(m) }
 func (*PlaceholderValue) ProtoMessage()    {}
 func (*PlaceholderValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{37}
+	return fileDescriptor_02345ba45cc0e303, []int{54}
 }
 
 func (m *PlaceholderValue) XXX_Unmarshal(b []byte) error {
@@ -2422,7 +3409,7 @@ func (m *PlaceholderGroup) Reset()         { *m = PlaceholderGroup{} }
 func (m *PlaceholderGroup) String() string { return proto.CompactTextString(m) }
 func (*PlaceholderGroup) ProtoMessage()    {}
 func (*PlaceholderGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{38}
+	return fileDescriptor_02345ba45cc0e303, []int{55}
 }
 
 func (m *PlaceholderGroup) XXX_Unmarshal(b []byte) error {
@@ -2457,22 +3444,23 @@ type SearchRequest struct {
 	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
 	Dsl            string            `protobuf:"bytes,5,opt,name=dsl,proto3" json:"dsl,omitempty"`
 	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	OutputFields         []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
-	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	PlaceholderGroup     []byte                    `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType              commonpb.DslType          `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	OutputFields         []string                  `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	SearchParams         []*commonpb.KeyValuePair  `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	TravelTimestamp      uint64                    `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64                    `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,12,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{39}
+	return fileDescriptor_02345ba45cc0e303, []int{56}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -2484,111 +3472,235 @@ func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 func (m *SearchRequest) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_SearchRequest.Merge(m, src)
 }
-func (m *SearchRequest) XXX_Size() int {
-	return xxx_messageInfo_SearchRequest.Size(m)
+func (m *SearchRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchRequest.Size(m)
+}
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+
+func (m *SearchRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetDsl() string {
+	if m != nil {
+		return m.Dsl
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetPlaceholderGroup() []byte {
+	if m != nil {
+		return m.PlaceholderGroup
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetDslType() commonpb.DslType {
+	if m != nil {
+		return m.DslType
+	}
+	return commonpb.DslType_Dsl
+}
+
+func (m *SearchRequest) GetOutputFields() []string {
+	if m != nil {
+		return m.OutputFields
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetSearchParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.SearchParams
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
+	if m != nil {
+		return m.GuaranteeTimestamp
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+type HybridSearchRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// the searches on the vector fields, whose collection, partitions, output fields and timestamps are set by the hybrid search
+	Requests []*SearchRequest `protobuf:"bytes,5,rep,name=requests,proto3" json:"requests,omitempty"`
+	// `strategy` is `weighted` with the `weights` of the searches, or `rrf` with `k`, `limit` is the top k of the merged results
+	RankParams      []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=rank_params,json=rankParams,proto3" json:"rank_params,omitempty"`
+	OutputFields    []string                 `protobuf:"bytes,7,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	TravelTimestamp uint64                   `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	// raised to travel_timestamp if less, so the searches see all the data till travel_timestamp whatever consistency_level is
+	GuaranteeTimestamp   uint64                    `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,10,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *HybridSearchRequest) Reset()         { *m = HybridSearchRequest{} }
+func (m *HybridSearchRequest) String() string { return proto.CompactTextString(m) }
+func (*HybridSearchRequest) ProtoMessage()    {}
+func (*HybridSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{57}
+}
+
+func (m *HybridSearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HybridSearchRequest.Unmarshal(m, b)
+}
+func (m *HybridSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HybridSearchRequest.Marshal(b, m, deterministic)
+}
+func (m *HybridSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HybridSearchRequest.Merge(m, src)
+}
+func (m *HybridSearchRequest) XXX_Size() int {
+	return xxx_messageInfo_HybridSearchRequest.Size(m)
 }
-func (m *SearchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+func (m *HybridSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HybridSearchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+var xxx_messageInfo_HybridSearchRequest proto.InternalMessageInfo
 
-func (m *SearchRequest) GetBase() *commonpb.MsgBase {
+func (m *HybridSearchRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetDbName() string {
+func (m *HybridSearchRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *SearchRequest) GetCollectionName() string {
+func (m *HybridSearchRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *SearchRequest) GetPartitionNames() []string {
+func (m *HybridSearchRequest) GetPartitionNames() []string {
 	if m != nil {
 		return m.PartitionNames
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetDsl() string {
-	if m != nil {
-		return m.Dsl
-	}
-	return ""
-}
-
-func (m *SearchRequest) GetPlaceholderGroup() []byte {
+func (m *HybridSearchRequest) GetRequests() []*SearchRequest {
 	if m != nil {
-		return m.PlaceholderGroup
+		return m.Requests
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetDslType() commonpb.DslType {
+func (m *HybridSearchRequest) GetRankParams() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.DslType
+		return m.RankParams
 	}
-	return commonpb.DslType_Dsl
+	return nil
 }
 
-func (m *SearchRequest) GetOutputFields() []string {
+func (m *HybridSearchRequest) GetOutputFields() []string {
 	if m != nil {
 		return m.OutputFields
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetSearchParams() []*commonpb.KeyValuePair {
+func (m *HybridSearchRequest) GetTravelTimestamp() uint64 {
 	if m != nil {
-		return m.SearchParams
+		return m.TravelTimestamp
 	}
-	return nil
+	return 0
 }
 
-func (m *SearchRequest) GetTravelTimestamp() uint64 {
+func (m *HybridSearchRequest) GetGuaranteeTimestamp() uint64 {
 	if m != nil {
-		return m.TravelTimestamp
+		return m.GuaranteeTimestamp
 	}
 	return 0
 }
 
-func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
+func (m *HybridSearchRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
 	if m != nil {
-		return m.GuaranteeTimestamp
+		return m.ConsistencyLevel
 	}
-	return 0
+	return commonpb.ConsistencyLevel_Strong
 }
 
 type RetrieveRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	Ids                  *schemapb.IDs     `protobuf:"bytes,5,opt,name=ids,proto3" json:"ids,omitempty"`
-	OutputFields         []string          `protobuf:"bytes,6,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base                 *commonpb.MsgBase         `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                    `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                    `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames       []string                  `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Ids                  *schemapb.IDs             `protobuf:"bytes,5,opt,name=ids,proto3" json:"ids,omitempty"`
+	OutputFields         []string                  `protobuf:"bytes,6,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	TravelTimestamp      uint64                    `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64                    `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,9,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	Limit                int64                     `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int64                     `protobuf:"varint,11,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
 func (m *RetrieveRequest) String() string { return proto.CompactTextString(m) }
 func (*RetrieveRequest) ProtoMessage()    {}
 func (*RetrieveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{40}
+	return fileDescriptor_02345ba45cc0e303, []int{58}
 }
 
 func (m *RetrieveRequest) XXX_Unmarshal(b []byte) error {
@@ -2665,6 +3777,27 @@ func (m *RetrieveRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *RetrieveRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *RetrieveRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *RetrieveRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
 type RetrieveResults struct {
 	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Ids                  *schemapb.IDs         `protobuf:"bytes,2,opt,name=ids,proto3" json:"ids,omitempty"`
@@ -2678,7 +3811,7 @@ func (m *RetrieveResults) Reset()         { *m = RetrieveResults{} }
 func (m *RetrieveResults) String() string { return proto.CompactTextString(m) }
 func (*RetrieveResults) ProtoMessage()    {}
 func (*RetrieveResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{41}
+	return fileDescriptor_02345ba45cc0e303, []int{59}
 }
 
 func (m *RetrieveResults) XXX_Unmarshal(b []byte) error {
@@ -2733,7 +3866,7 @@ func (m *Hits) Reset()         { *m = Hits{} }
 func (m *Hits) String() string { return proto.CompactTextString(m) }
 func (*Hits) ProtoMessage()    {}
 func (*Hits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{42}
+	return fileDescriptor_02345ba45cc0e303, []int{60}
 }
 
 func (m *Hits) XXX_Unmarshal(b []byte) error {
@@ -2783,172 +3916,414 @@ type SearchResults struct {
 	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (m *SearchResults) Reset()         { *m = SearchResults{} }
-func (m *SearchResults) String() string { return proto.CompactTextString(m) }
-func (*SearchResults) ProtoMessage()    {}
-func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{43}
+func (m *SearchResults) Reset()         { *m = SearchResults{} }
+func (m *SearchResults) String() string { return proto.CompactTextString(m) }
+func (*SearchResults) ProtoMessage()    {}
+func (*SearchResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{61}
+}
+
+func (m *SearchResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResults.Unmarshal(m, b)
+}
+func (m *SearchResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResults.Marshal(b, m, deterministic)
+}
+func (m *SearchResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResults.Merge(m, src)
+}
+func (m *SearchResults) XXX_Size() int {
+	return xxx_messageInfo_SearchResults.Size(m)
+}
+func (m *SearchResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResults proto.InternalMessageInfo
+
+func (m *SearchResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SearchResults) GetResults() *schemapb.SearchResultData {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type FlushRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionNames      []string          `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
+func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
+func (*FlushRequest) ProtoMessage()    {}
+func (*FlushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{62}
+}
+
+func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushRequest.Unmarshal(m, b)
+}
+func (m *FlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushRequest.Marshal(b, m, deterministic)
+}
+func (m *FlushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushRequest.Merge(m, src)
+}
+func (m *FlushRequest) XXX_Size() int {
+	return xxx_messageInfo_FlushRequest.Size(m)
+}
+func (m *FlushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushRequest proto.InternalMessageInfo
+
+func (m *FlushRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *FlushRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *FlushRequest) GetCollectionNames() []string {
+	if m != nil {
+		return m.CollectionNames
+	}
+	return nil
+}
+
+type FlushResponse struct {
+	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DbName               string                         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollSegIDs           map[string]*schemapb.LongArray `protobuf:"bytes,3,rep,name=coll_segIDs,json=collSegIDs,proto3" json:"coll_segIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
+func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
+func (*FlushResponse) ProtoMessage()    {}
+func (*FlushResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{63}
+}
+
+func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushResponse.Unmarshal(m, b)
+}
+func (m *FlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushResponse.Marshal(b, m, deterministic)
+}
+func (m *FlushResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushResponse.Merge(m, src)
+}
+func (m *FlushResponse) XXX_Size() int {
+	return xxx_messageInfo_FlushResponse.Size(m)
+}
+func (m *FlushResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushResponse proto.InternalMessageInfo
+
+func (m *FlushResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *FlushResponse) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *FlushResponse) GetCollSegIDs() map[string]*schemapb.LongArray {
+	if m != nil {
+		return m.CollSegIDs
+	}
+	return nil
+}
+
+type ImportRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName  string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// must, paths in the MinIO bucket, either JSON files of rows or a NumPy or Parquet file named after each field
+	Files                []string `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
+func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportRequest) ProtoMessage()    {}
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{64}
+}
+
+func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportRequest.Unmarshal(m, b)
+}
+func (m *ImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRequest.Merge(m, src)
+}
+func (m *ImportRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportRequest.Size(m)
+}
+func (m *ImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportRequest proto.InternalMessageInfo
+
+func (m *ImportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ImportRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ImportRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ImportRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *ImportRequest) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+type ImportResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskID               int64            `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ImportResponse) Reset()         { *m = ImportResponse{} }
+func (m *ImportResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportResponse) ProtoMessage()    {}
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{65}
 }
 
-func (m *SearchResults) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SearchResults.Unmarshal(m, b)
+func (m *ImportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResponse.Unmarshal(m, b)
 }
-func (m *SearchResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SearchResults.Marshal(b, m, deterministic)
+func (m *ImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResponse.Marshal(b, m, deterministic)
 }
-func (m *SearchResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchResults.Merge(m, src)
+func (m *ImportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResponse.Merge(m, src)
 }
-func (m *SearchResults) XXX_Size() int {
-	return xxx_messageInfo_SearchResults.Size(m)
+func (m *ImportResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportResponse.Size(m)
 }
-func (m *SearchResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchResults.DiscardUnknown(m)
+func (m *ImportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchResults proto.InternalMessageInfo
+var xxx_messageInfo_ImportResponse proto.InternalMessageInfo
 
-func (m *SearchResults) GetStatus() *commonpb.Status {
+func (m *ImportResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *SearchResults) GetResults() *schemapb.SearchResultData {
+func (m *ImportResponse) GetTaskID() int64 {
 	if m != nil {
-		return m.Results
+		return m.TaskID
 	}
-	return nil
+	return 0
 }
 
-type FlushRequest struct {
+type GetImportStateRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionNames      []string          `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	TaskID               int64             `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
-func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
-func (*FlushRequest) ProtoMessage()    {}
-func (*FlushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{44}
+func (m *GetImportStateRequest) Reset()         { *m = GetImportStateRequest{} }
+func (m *GetImportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetImportStateRequest) ProtoMessage()    {}
+func (*GetImportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{66}
 }
 
-func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FlushRequest.Unmarshal(m, b)
+func (m *GetImportStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetImportStateRequest.Unmarshal(m, b)
 }
-func (m *FlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FlushRequest.Marshal(b, m, deterministic)
+func (m *GetImportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetImportStateRequest.Marshal(b, m, deterministic)
 }
-func (m *FlushRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FlushRequest.Merge(m, src)
+func (m *GetImportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetImportStateRequest.Merge(m, src)
 }
-func (m *FlushRequest) XXX_Size() int {
-	return xxx_messageInfo_FlushRequest.Size(m)
+func (m *GetImportStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetImportStateRequest.Size(m)
 }
-func (m *FlushRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FlushRequest.DiscardUnknown(m)
+func (m *GetImportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetImportStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FlushRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetImportStateRequest proto.InternalMessageInfo
 
-func (m *FlushRequest) GetBase() *commonpb.MsgBase {
+func (m *GetImportStateRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *FlushRequest) GetDbName() string {
-	if m != nil {
-		return m.DbName
-	}
-	return ""
-}
-
-func (m *FlushRequest) GetCollectionNames() []string {
+func (m *GetImportStateRequest) GetTaskID() int64 {
 	if m != nil {
-		return m.CollectionNames
+		return m.TaskID
 	}
-	return nil
+	return 0
 }
 
-type FlushResponse struct {
-	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	DbName               string                         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollSegIDs           map[string]*schemapb.LongArray `protobuf:"bytes,3,rep,name=coll_segIDs,json=collSegIDs,proto3" json:"coll_segIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+type GetImportStateResponse struct {
+	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                commonpb.ImportState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	RowCount             int64                `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	SegmentIDs           []int64              `protobuf:"varint,4,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	Reason               string               `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
-func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
-func (*FlushResponse) ProtoMessage()    {}
-func (*FlushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{45}
+func (m *GetImportStateResponse) Reset()         { *m = GetImportStateResponse{} }
+func (m *GetImportStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetImportStateResponse) ProtoMessage()    {}
+func (*GetImportStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{67}
 }
 
-func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FlushResponse.Unmarshal(m, b)
+func (m *GetImportStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetImportStateResponse.Unmarshal(m, b)
 }
-func (m *FlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FlushResponse.Marshal(b, m, deterministic)
+func (m *GetImportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetImportStateResponse.Marshal(b, m, deterministic)
 }
-func (m *FlushResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FlushResponse.Merge(m, src)
+func (m *GetImportStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetImportStateResponse.Merge(m, src)
 }
-func (m *FlushResponse) XXX_Size() int {
-	return xxx_messageInfo_FlushResponse.Size(m)
+func (m *GetImportStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetImportStateResponse.Size(m)
 }
-func (m *FlushResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FlushResponse.DiscardUnknown(m)
+func (m *GetImportStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetImportStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FlushResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetImportStateResponse proto.InternalMessageInfo
 
-func (m *FlushResponse) GetStatus() *commonpb.Status {
+func (m *GetImportStateResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *FlushResponse) GetDbName() string {
+func (m *GetImportStateResponse) GetState() commonpb.ImportState {
 	if m != nil {
-		return m.DbName
+		return m.State
 	}
-	return ""
+	return commonpb.ImportState_ImportPending
 }
 
-func (m *FlushResponse) GetCollSegIDs() map[string]*schemapb.LongArray {
+func (m *GetImportStateResponse) GetRowCount() int64 {
 	if m != nil {
-		return m.CollSegIDs
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *GetImportStateResponse) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
 	}
 	return nil
 }
 
+func (m *GetImportStateResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type QueryRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	Expr                 string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
-	OutputFields         []string          `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base                 *commonpb.MsgBase         `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                    `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                    `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Expr                 string                    `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	OutputFields         []string                  `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	PartitionNames       []string                  `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	TravelTimestamp      uint64                    `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64                    `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,9,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	Limit                int64                     `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int64                     `protobuf:"varint,11,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{46}
+	return fileDescriptor_02345ba45cc0e303, []int{68}
 }
 
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
@@ -3011,6 +4386,41 @@ func (m *QueryRequest) GetPartitionNames() []string {
 	return nil
 }
 
+func (m *QueryRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetGuaranteeTimestamp() uint64 {
+	if m != nil {
+		return m.GuaranteeTimestamp
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *QueryRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
 type QueryResults struct {
 	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
@@ -3023,7 +4433,7 @@ func (m *QueryResults) Reset()         { *m = QueryResults{} }
 func (m *QueryResults) String() string { return proto.CompactTextString(m) }
 func (*QueryResults) ProtoMessage()    {}
 func (*QueryResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{47}
+	return fileDescriptor_02345ba45cc0e303, []int{69}
 }
 
 func (m *QueryResults) XXX_Unmarshal(b []byte) error {
@@ -3072,7 +4482,7 @@ func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
 func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
 func (*VectorIDs) ProtoMessage()    {}
 func (*VectorIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{48}
+	return fileDescriptor_02345ba45cc0e303, []int{70}
 }
 
 func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
@@ -3135,7 +4545,7 @@ func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
 func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
 func (*VectorsArray) ProtoMessage()    {}
 func (*VectorsArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{49}
+	return fileDescriptor_02345ba45cc0e303, []int{71}
 }
 
 func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
@@ -3215,7 +4625,7 @@ func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
 func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceRequest) ProtoMessage()    {}
 func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{50}
+	return fileDescriptor_02345ba45cc0e303, []int{72}
 }
 
 func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
@@ -3281,7 +4691,7 @@ func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
 func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceResults) ProtoMessage()    {}
 func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{51}
+	return fileDescriptor_02345ba45cc0e303, []int{73}
 }
 
 func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
@@ -3369,7 +4779,7 @@ func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
 func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*PersistentSegmentInfo) ProtoMessage()    {}
 func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{52}
+	return fileDescriptor_02345ba45cc0e303, []int{74}
 }
 
 func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -3438,7 +4848,7 @@ func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSe
 func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{53}
+	return fileDescriptor_02345ba45cc0e303, []int{75}
 }
 
 func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3492,7 +4902,7 @@ func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentS
 func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{54}
+	return fileDescriptor_02345ba45cc0e303, []int{76}
 }
 
 func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -3544,7 +4954,7 @@ func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
 func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*QuerySegmentInfo) ProtoMessage()    {}
 func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{55}
+	return fileDescriptor_02345ba45cc0e303, []int{77}
 }
 
 func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -3627,7 +5037,7 @@ func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoR
 func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
 func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{56}
+	return fileDescriptor_02345ba45cc0e303, []int{78}
 }
 
 func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3681,7 +5091,7 @@ func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfo
 func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
 func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{57}
+	return fileDescriptor_02345ba45cc0e303, []int{79}
 }
 
 func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -3727,7 +5137,7 @@ func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
 func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
 func (*DummyRequest) ProtoMessage()    {}
 func (*DummyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{58}
+	return fileDescriptor_02345ba45cc0e303, []int{80}
 }
 
 func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
@@ -3766,7 +5176,7 @@ func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
 func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
 func (*DummyResponse) ProtoMessage()    {}
 func (*DummyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{59}
+	return fileDescriptor_02345ba45cc0e303, []int{81}
 }
 
 func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
@@ -3804,7 +5214,7 @@ func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
 func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkRequest) ProtoMessage()    {}
 func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{60}
+	return fileDescriptor_02345ba45cc0e303, []int{82}
 }
 
 func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
@@ -3837,7 +5247,7 @@ func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
 func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkResponse) ProtoMessage()    {}
 func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{61}
+	return fileDescriptor_02345ba45cc0e303, []int{83}
 }
 
 func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
@@ -3875,6 +5285,17 @@ func (m *RegisterLinkResponse) GetStatus() *commonpb.Status {
 func init() {
 	proto.RegisterEnum("milvus.proto.milvus.ShowCollectionsType", ShowCollectionsType_name, ShowCollectionsType_value)
 	proto.RegisterEnum("milvus.proto.milvus.PlaceholderType", PlaceholderType_name, PlaceholderType_value)
+	proto.RegisterType((*CreateDatabaseRequest)(nil), "milvus.proto.milvus.CreateDatabaseRequest")
+	proto.RegisterType((*DropDatabaseRequest)(nil), "milvus.proto.milvus.DropDatabaseRequest")
+	proto.RegisterType((*ListDatabasesRequest)(nil), "milvus.proto.milvus.ListDatabasesRequest")
+	proto.RegisterType((*ListDatabasesResponse)(nil), "milvus.proto.milvus.ListDatabasesResponse")
+	proto.RegisterType((*CreateUserRequest)(nil), "milvus.proto.milvus.CreateUserRequest")
+	proto.RegisterType((*Grant)(nil), "milvus.proto.milvus.Grant")
+	proto.RegisterType((*GrantRoleRequest)(nil), "milvus.proto.milvus.GrantRoleRequest")
+	proto.RegisterType((*RevokeRoleRequest)(nil), "milvus.proto.milvus.RevokeRoleRequest")
+	proto.RegisterType((*ListGrantsRequest)(nil), "milvus.proto.milvus.ListGrantsRequest")
+	proto.RegisterType((*RoleGrants)(nil), "milvus.proto.milvus.RoleGrants")
+	proto.RegisterType((*ListGrantsResponse)(nil), "milvus.proto.milvus.ListGrantsResponse")
 	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
 	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
 	proto.RegisterType((*HasCollectionRequest)(nil), "milvus.proto.milvus.HasCollectionRequest")
@@ -3888,6 +5309,10 @@ func init() {
 	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.milvus.GetCollectionStatisticsResponse")
 	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.milvus.ShowCollectionsRequest")
 	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.milvus.ShowCollectionsResponse")
+	proto.RegisterType((*AddFieldRequest)(nil), "milvus.proto.milvus.AddFieldRequest")
+	proto.RegisterType((*CreateAliasRequest)(nil), "milvus.proto.milvus.CreateAliasRequest")
+	proto.RegisterType((*DropAliasRequest)(nil), "milvus.proto.milvus.DropAliasRequest")
+	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.milvus.AlterAliasRequest")
 	proto.RegisterType((*CreatePartitionRequest)(nil), "milvus.proto.milvus.CreatePartitionRequest")
 	proto.RegisterType((*DropPartitionRequest)(nil), "milvus.proto.milvus.DropPartitionRequest")
 	proto.RegisterType((*HasPartitionRequest)(nil), "milvus.proto.milvus.HasPartitionRequest")
@@ -3911,10 +5336,13 @@ func init() {
 	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.milvus.GetIndexStateResponse")
 	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.milvus.DropIndexRequest")
 	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.milvus.InsertRequest")
+	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.milvus.DeleteRequest")
+	proto.RegisterType((*UpsertRequest)(nil), "milvus.proto.milvus.UpsertRequest")
 	proto.RegisterType((*MutationResult)(nil), "milvus.proto.milvus.MutationResult")
 	proto.RegisterType((*PlaceholderValue)(nil), "milvus.proto.milvus.PlaceholderValue")
 	proto.RegisterType((*PlaceholderGroup)(nil), "milvus.proto.milvus.PlaceholderGroup")
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.milvus.SearchRequest")
+	proto.RegisterType((*HybridSearchRequest)(nil), "milvus.proto.milvus.HybridSearchRequest")
 	proto.RegisterType((*RetrieveRequest)(nil), "milvus.proto.milvus.RetrieveRequest")
 	proto.RegisterType((*RetrieveResults)(nil), "milvus.proto.milvus.RetrieveResults")
 	proto.RegisterType((*Hits)(nil), "milvus.proto.milvus.Hits")
@@ -3922,6 +5350,10 @@ func init() {
 	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.milvus.FlushRequest")
 	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.milvus.FlushResponse")
 	proto.RegisterMapType((map[string]*schemapb.LongArray)(nil), "milvus.proto.milvus.FlushResponse.CollSegIDsEntry")
+	proto.RegisterType((*ImportRequest)(nil), "milvus.proto.milvus.ImportRequest")
+	proto.RegisterType((*ImportResponse)(nil), "milvus.proto.milvus.ImportResponse")
+	proto.RegisterType((*GetImportStateRequest)(nil), "milvus.proto.milvus.GetImportStateRequest")
+	proto.RegisterType((*GetImportStateResponse)(nil), "milvus.proto.milvus.GetImportStateResponse")
 	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.milvus.QueryRequest")
 	proto.RegisterType((*QueryResults)(nil), "milvus.proto.milvus.QueryResults")
 	proto.RegisterType((*VectorIDs)(nil), "milvus.proto.milvus.VectorIDs")
@@ -3943,187 +5375,237 @@ func init() {
 func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
 
 var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 2868 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0xcd, 0x6f, 0x24, 0x47,
-	0xf5, 0xae, 0x19, 0xcf, 0xd7, 0x9b, 0x1e, 0x7b, 0x52, 0x5e, 0x7b, 0x27, 0x93, 0xdd, 0xc4, 0xdb,
-	0xc9, 0xfe, 0xe2, 0xdd, 0x4d, 0x76, 0x13, 0x6f, 0xf2, 0x4b, 0x48, 0x02, 0xc9, 0xee, 0x9a, 0xec,
-	0x5a, 0xd9, 0x0d, 0x4e, 0x3b, 0x44, 0x84, 0x28, 0x1a, 0xb5, 0xa7, 0xcb, 0x33, 0x2d, 0xf7, 0x74,
-	0x0f, 0x5d, 0x35, 0xf6, 0x4e, 0x4e, 0x48, 0x09, 0x48, 0x08, 0x48, 0x84, 0x40, 0x20, 0x38, 0x20,
-	0x04, 0xca, 0x81, 0x13, 0x44, 0x41, 0x42, 0xe2, 0xc4, 0x21, 0x07, 0x0e, 0x48, 0x7c, 0x5c, 0xb9,
-	0xc2, 0x31, 0xff, 0x00, 0xe2, 0x80, 0xaa, 0xaa, 0xbb, 0xa7, 0xbb, 0x5d, 0x3d, 0x1e, 0xef, 0x24,
-	0xd8, 0xbe, 0x75, 0xbf, 0x7a, 0xaf, 0xea, 0x7d, 0xd5, 0xab, 0x57, 0xaf, 0x1e, 0x68, 0x3d, 0xdb,
-	0xd9, 0x1d, 0xd0, 0xcb, 0x7d, 0xdf, 0x63, 0x1e, 0x5e, 0x88, 0xff, 0x5d, 0x96, 0x3f, 0x4d, 0xad,
-	0xed, 0xf5, 0x7a, 0x9e, 0x2b, 0x81, 0x4d, 0x8d, 0xb6, 0xbb, 0xa4, 0x67, 0xca, 0x3f, 0xfd, 0x13,
-	0x04, 0xa7, 0x6f, 0xf8, 0xc4, 0x64, 0xe4, 0x86, 0xe7, 0x38, 0xa4, 0xcd, 0x6c, 0xcf, 0x35, 0xc8,
-	0x37, 0x06, 0x84, 0x32, 0xfc, 0x04, 0xcc, 0x6e, 0x99, 0x94, 0x34, 0xd0, 0x32, 0x5a, 0xa9, 0xae,
-	0x9e, 0xb9, 0x9c, 0x98, 0x3b, 0x98, 0xf3, 0x0e, 0xed, 0x5c, 0x37, 0x29, 0x31, 0x04, 0x26, 0x3e,
-	0x0d, 0x25, 0x6b, 0xab, 0xe5, 0x9a, 0x3d, 0xd2, 0xc8, 0x2d, 0xa3, 0x95, 0x8a, 0x51, 0xb4, 0xb6,
-	0x5e, 0x35, 0x7b, 0x04, 0x3f, 0x0a, 0xf3, 0xed, 0x68, 0x7e, 0x89, 0x90, 0x17, 0x08, 0x73, 0x23,
-	0xb0, 0x40, 0x5c, 0x82, 0xa2, 0xe4, 0xaf, 0x31, 0xbb, 0x8c, 0x56, 0x34, 0x23, 0xf8, 0xc3, 0x67,
-	0x01, 0x68, 0xd7, 0xf4, 0x2d, 0xda, 0x72, 0x07, 0xbd, 0x46, 0x61, 0x19, 0xad, 0x14, 0x8c, 0x8a,
-	0x84, 0xbc, 0x3a, 0xe8, 0xe9, 0xdf, 0x45, 0xb0, 0xb8, 0xe6, 0x7b, 0xfd, 0x63, 0x21, 0x84, 0xfe,
-	0x6b, 0x04, 0xa7, 0x6e, 0x99, 0xf4, 0x78, 0x68, 0xf4, 0x2c, 0x00, 0xb3, 0x7b, 0xa4, 0x45, 0x99,
-	0xd9, 0xeb, 0x0b, 0xad, 0xce, 0x1a, 0x15, 0x0e, 0xd9, 0xe4, 0x00, 0xfd, 0x4d, 0xd0, 0xae, 0x7b,
-	0x9e, 0x63, 0x10, 0xda, 0xf7, 0x5c, 0x4a, 0xf0, 0x55, 0x28, 0x52, 0x66, 0xb2, 0x01, 0x0d, 0x98,
-	0x7c, 0x40, 0xc9, 0xe4, 0xa6, 0x40, 0x31, 0x02, 0x54, 0x7c, 0x0a, 0x0a, 0xbb, 0xa6, 0x33, 0x90,
-	0x3c, 0x96, 0x0d, 0xf9, 0xa3, 0xbf, 0x05, 0x73, 0x9b, 0xcc, 0xb7, 0xdd, 0xce, 0x67, 0x38, 0x79,
-	0x25, 0x9c, 0xfc, 0xef, 0x08, 0xee, 0x5f, 0x23, 0xb4, 0xed, 0xdb, 0x5b, 0xc7, 0xc4, 0x75, 0x75,
-	0xd0, 0x46, 0x90, 0xf5, 0x35, 0xa1, 0xea, 0xbc, 0x91, 0x80, 0xa5, 0x8c, 0x51, 0x48, 0x1b, 0xe3,
-	0xe7, 0x39, 0x68, 0xaa, 0x84, 0x9a, 0x46, 0x7d, 0x5f, 0x8c, 0x76, 0x54, 0x4e, 0x10, 0x9d, 0x4f,
-	0x12, 0x05, 0xd1, 0x60, 0xb4, 0xda, 0xa6, 0x00, 0x44, 0x1b, 0x2f, 0x2d, 0x55, 0x5e, 0x21, 0xd5,
-	0x2a, 0x2c, 0xee, 0xda, 0x3e, 0x1b, 0x98, 0x4e, 0xab, 0xdd, 0x35, 0x5d, 0x97, 0x38, 0x42, 0x4f,
-	0xb4, 0x31, 0xbb, 0x9c, 0x5f, 0xa9, 0x18, 0x0b, 0xc1, 0xe0, 0x0d, 0x39, 0xc6, 0x95, 0x45, 0xf1,
-	0x53, 0xb0, 0xd4, 0xef, 0x0e, 0xa9, 0xdd, 0xde, 0x47, 0x54, 0x10, 0x44, 0xa7, 0xc2, 0xd1, 0x38,
-	0x95, 0xd8, 0xe7, 0xb7, 0x3d, 0xd3, 0x3a, 0x1e, 0xfb, 0xfc, 0x7d, 0x04, 0x0d, 0x83, 0x38, 0xc4,
-	0xa4, 0xc7, 0xc3, 0x05, 0xf5, 0x1f, 0x21, 0x78, 0xf0, 0x26, 0x61, 0x31, 0x63, 0x32, 0x93, 0xd9,
-	0x94, 0xd9, 0x6d, 0x7a, 0x94, 0x6c, 0x7d, 0x80, 0xe0, 0xa1, 0x4c, 0xb6, 0xa6, 0xf1, 0xed, 0x67,
-	0xa0, 0xc0, 0xbf, 0x68, 0x23, 0xb7, 0x9c, 0x5f, 0xa9, 0xae, 0x9e, 0x53, 0xd2, 0xbc, 0x42, 0x86,
-	0x6f, 0xf0, 0x90, 0xb1, 0x61, 0xda, 0xbe, 0x21, 0xf1, 0xf5, 0x3f, 0x22, 0x58, 0xda, 0xec, 0x7a,
-	0x7b, 0x23, 0x96, 0x3e, 0x0f, 0x05, 0x25, 0x77, 0x7b, 0x3e, 0xb5, 0xdb, 0xf1, 0x0b, 0x30, 0xcb,
-	0x86, 0x7d, 0x22, 0x02, 0xc5, 0xdc, 0xea, 0xca, 0x65, 0xc5, 0xd9, 0x7d, 0x39, 0xc5, 0xe4, 0xeb,
-	0xc3, 0x3e, 0x31, 0x04, 0x95, 0xfe, 0x0b, 0x04, 0xa7, 0xf7, 0x89, 0x30, 0x8d, 0x32, 0x2f, 0x40,
-	0x3d, 0x65, 0x4e, 0xa9, 0xd7, 0x8a, 0x31, 0x9f, 0xb4, 0x27, 0xc5, 0xe7, 0x21, 0x66, 0xe2, 0x96,
-	0x6d, 0xd1, 0x46, 0x7e, 0x39, 0xbf, 0x92, 0x37, 0x6a, 0xb1, 0xb0, 0x60, 0x51, 0xfd, 0x63, 0x04,
-	0x4b, 0x32, 0xb9, 0xd8, 0x30, 0x7d, 0x66, 0x1f, 0x75, 0x80, 0x3e, 0x0f, 0x73, 0xfd, 0x90, 0x0f,
-	0x89, 0x37, 0x2b, 0xf0, 0x6a, 0x11, 0x54, 0x78, 0xeb, 0x47, 0x08, 0x4e, 0xf1, 0x5c, 0xe2, 0x24,
-	0xf1, 0xfc, 0x5b, 0x04, 0x0b, 0xb7, 0x4c, 0x7a, 0x92, 0x58, 0xfe, 0x5d, 0x10, 0xca, 0x23, 0x9e,
-	0x8f, 0x32, 0x44, 0x71, 0xc4, 0x24, 0xd3, 0xe1, 0xe1, 0x35, 0x97, 0xe0, 0x9a, 0xea, 0xbf, 0x1f,
-	0xc5, 0xfc, 0x13, 0xc6, 0xf9, 0x1f, 0x10, 0x9c, 0xbd, 0x49, 0x58, 0xc4, 0xf5, 0xb1, 0x38, 0x1b,
-	0x26, 0xf5, 0x96, 0xf7, 0xe5, 0xc9, 0xa6, 0x64, 0xfe, 0x48, 0x4e, 0x90, 0xdf, 0x20, 0x58, 0xe4,
-	0xe1, 0xf7, 0x78, 0x38, 0xc1, 0x04, 0xb9, 0xa7, 0xfe, 0xb3, 0xe0, 0xcc, 0x8b, 0x73, 0x3c, 0x8d,
-	0xea, 0x14, 0x8e, 0x97, 0x53, 0x39, 0x1e, 0x67, 0x2e, 0x82, 0xac, 0xaf, 0x85, 0x67, 0x45, 0x02,
-	0xa6, 0x7f, 0x0f, 0xc1, 0x52, 0x98, 0xf9, 0x6e, 0x92, 0x4e, 0x8f, 0xb8, 0xec, 0xde, 0xf5, 0x99,
-	0xd6, 0x46, 0x4e, 0x91, 0xb3, 0x9e, 0x81, 0x0a, 0x95, 0xeb, 0x44, 0x49, 0xed, 0x08, 0xa0, 0x7f,
-	0x88, 0xe0, 0xf4, 0x3e, 0x76, 0xa6, 0x51, 0x56, 0x03, 0x4a, 0xb6, 0x6b, 0x91, 0xbb, 0x11, 0x37,
-	0xe1, 0x2f, 0x1f, 0xd9, 0x1a, 0xd8, 0x8e, 0x15, 0xb1, 0x11, 0xfe, 0xe2, 0x73, 0xa0, 0x11, 0xd7,
-	0xdc, 0x72, 0x48, 0x4b, 0xe0, 0x0a, 0xa3, 0x96, 0x8d, 0xaa, 0x84, 0xad, 0x73, 0x90, 0xfe, 0x7d,
-	0x04, 0x0b, 0xdc, 0xa6, 0x01, 0x8f, 0xf4, 0xf3, 0xd5, 0xd9, 0x32, 0x54, 0x63, 0x46, 0x0b, 0xd8,
-	0x8d, 0x83, 0xf4, 0x1d, 0x38, 0x95, 0x64, 0x67, 0x1a, 0x9d, 0x3d, 0x08, 0x10, 0x59, 0x44, 0xfa,
-	0x56, 0xde, 0x88, 0x41, 0xf4, 0x4f, 0x11, 0x60, 0x99, 0x5e, 0x08, 0x65, 0x1c, 0xf1, 0x25, 0x7b,
-	0xdb, 0x26, 0x8e, 0x15, 0x8f, 0x60, 0x15, 0x01, 0x11, 0xc3, 0x6b, 0xa0, 0x91, 0xbb, 0xcc, 0x37,
-	0x5b, 0x7d, 0xd3, 0x37, 0x7b, 0xf2, 0x8a, 0x33, 0x51, 0xb0, 0xa9, 0x0a, 0xb2, 0x0d, 0x41, 0xa5,
-	0xff, 0x89, 0x27, 0x26, 0x81, 0x53, 0x1e, 0x77, 0x89, 0xcf, 0x02, 0x08, 0xa7, 0x95, 0xc3, 0x05,
-	0x39, 0x2c, 0x20, 0x22, 0x9c, 0x7f, 0x88, 0xa0, 0x2e, 0x44, 0x90, 0xf2, 0xf4, 0xf9, 0xb4, 0x29,
-	0x1a, 0x94, 0xa2, 0x19, 0xb3, 0x85, 0xbe, 0x00, 0xc5, 0x40, 0xb1, 0xf9, 0x49, 0x15, 0x1b, 0x10,
-	0x1c, 0x20, 0x86, 0xfe, 0x4b, 0x04, 0x8b, 0x29, 0x95, 0x4f, 0xe3, 0xd1, 0xaf, 0x03, 0x96, 0x12,
-	0x5a, 0x23, 0xb1, 0xc3, 0xa3, 0xe7, 0xbc, 0x32, 0xff, 0x4f, 0x2b, 0xc9, 0xb8, 0xcf, 0x4e, 0x41,
-	0xa8, 0xfe, 0x57, 0x04, 0x67, 0x6e, 0x12, 0x26, 0x50, 0xaf, 0xf3, 0xd8, 0xb1, 0xe1, 0x7b, 0x1d,
-	0x9f, 0x50, 0x7a, 0x72, 0xfd, 0xe3, 0xc7, 0x32, 0x57, 0x51, 0x89, 0x34, 0x8d, 0xfe, 0xcf, 0x81,
-	0x26, 0xd6, 0x20, 0x56, 0xcb, 0xf7, 0xf6, 0x68, 0xe0, 0x47, 0xd5, 0x00, 0x66, 0x78, 0x7b, 0xc2,
-	0x21, 0x98, 0xc7, 0x4c, 0x47, 0x22, 0x04, 0x07, 0x83, 0x80, 0xf0, 0x61, 0xb1, 0x07, 0x43, 0xc6,
-	0xf8, 0xe4, 0xe4, 0xe4, 0xea, 0xf8, 0x5d, 0x04, 0x8b, 0x29, 0x51, 0xa6, 0xd1, 0xed, 0xd3, 0x32,
-	0x93, 0x92, 0xc2, 0xcc, 0xad, 0x3e, 0xa4, 0xa4, 0x89, 0x2d, 0x26, 0xb1, 0xf5, 0x4f, 0x10, 0xd4,
-	0xf9, 0x6d, 0xeb, 0x84, 0x07, 0xb4, 0x5f, 0xe5, 0xa0, 0xb6, 0xee, 0x52, 0xe2, 0xb3, 0xe3, 0x9f,
-	0x4c, 0xe3, 0x17, 0xa1, 0x2a, 0x04, 0xa3, 0x2d, 0xcb, 0x64, 0x66, 0x70, 0x1a, 0x3d, 0xa8, 0xac,
-	0x0b, 0xbe, 0xcc, 0xf1, 0xd6, 0x4c, 0x66, 0x1a, 0x52, 0x3b, 0x94, 0x7f, 0xe3, 0x07, 0xa0, 0xd2,
-	0x35, 0x69, 0xb7, 0xb5, 0x43, 0x86, 0xb4, 0x51, 0x5c, 0xce, 0xaf, 0xd4, 0x8c, 0x32, 0x07, 0xbc,
-	0x42, 0x86, 0x14, 0xdf, 0x0f, 0x65, 0x77, 0xd0, 0x93, 0xfb, 0xa7, 0xb4, 0x8c, 0x56, 0x6a, 0x46,
-	0xc9, 0x1d, 0xf4, 0xc4, 0xee, 0xf9, 0x73, 0x0e, 0xe6, 0xee, 0x0c, 0x78, 0xea, 0x2e, 0xaa, 0x9a,
-	0x03, 0x87, 0xdd, 0x9b, 0xaf, 0x5d, 0x84, 0xbc, 0x4c, 0x09, 0x38, 0x45, 0x43, 0xc9, 0xf8, 0xfa,
-	0x1a, 0x35, 0x38, 0x92, 0x78, 0x39, 0x18, 0xb4, 0xdb, 0x41, 0x0e, 0x95, 0x17, 0xcc, 0x56, 0x38,
-	0x44, 0x78, 0x1c, 0x17, 0x85, 0xf8, 0x7e, 0x94, 0x61, 0x09, 0x51, 0x88, 0xef, 0xcb, 0x41, 0x1d,
-	0x34, 0xb3, 0xbd, 0xe3, 0x7a, 0x7b, 0x0e, 0xb1, 0x3a, 0xc4, 0x12, 0x66, 0x2f, 0x1b, 0x09, 0x98,
-	0x74, 0x0c, 0x6e, 0xf8, 0x56, 0xdb, 0x65, 0x8d, 0xa2, 0x0c, 0x18, 0x12, 0x72, 0xc3, 0x65, 0x7c,
-	0xd8, 0x22, 0x0e, 0x61, 0x44, 0x0c, 0x97, 0xe4, 0xb0, 0x84, 0x04, 0xc3, 0x83, 0x7e, 0x44, 0x5d,
-	0x96, 0xc3, 0x12, 0xc2, 0x87, 0xcf, 0x80, 0xa8, 0x17, 0xc9, 0x02, 0x52, 0x65, 0x54, 0x40, 0x12,
-	0x00, 0x7d, 0x17, 0xea, 0x1b, 0x8e, 0xd9, 0x26, 0x5d, 0xcf, 0xb1, 0x88, 0x2f, 0x0e, 0x37, 0x5c,
-	0x87, 0x3c, 0x33, 0x3b, 0xc1, 0xe9, 0xc9, 0x3f, 0xf1, 0xb3, 0x41, 0x99, 0x49, 0xee, 0xcb, 0x47,
-	0x94, 0xc7, 0x4c, 0x6c, 0x9a, 0x51, 0x89, 0x09, 0x2f, 0x41, 0x51, 0x14, 0xdb, 0xe5, 0xb9, 0xaa,
-	0x19, 0xc1, 0x9f, 0xfe, 0x76, 0x62, 0xdd, 0x9b, 0xbe, 0x37, 0xe8, 0xe3, 0x75, 0xd0, 0xfa, 0x23,
-	0x18, 0xb7, 0x66, 0xf6, 0xa1, 0x96, 0x66, 0xda, 0x48, 0x90, 0xea, 0x9f, 0xe6, 0xa1, 0xb6, 0x49,
-	0x4c, 0xbf, 0xdd, 0x3d, 0x09, 0xf7, 0x6a, 0xae, 0x71, 0x8b, 0x3a, 0x41, 0x48, 0xe0, 0x9f, 0xf8,
-	0x12, 0xdc, 0x17, 0x13, 0xa8, 0xd5, 0xe1, 0x0a, 0x12, 0x9e, 0xa1, 0x19, 0xf5, 0x7e, 0x5a, 0x71,
-	0xcf, 0x40, 0xd9, 0xa2, 0x4e, 0x4b, 0x98, 0xa8, 0x24, 0x4c, 0xa4, 0x96, 0x6f, 0x8d, 0x3a, 0xc2,
-	0x34, 0x25, 0x4b, 0x7e, 0xe0, 0x87, 0xa1, 0xe6, 0x0d, 0x58, 0x7f, 0xc0, 0x5a, 0x72, 0x67, 0x36,
-	0xca, 0x82, 0x3d, 0x4d, 0x02, 0xc5, 0xc6, 0xa5, 0xf8, 0x65, 0xa8, 0x51, 0xa1, 0xca, 0x30, 0xf5,
-	0xac, 0x4c, 0x9a, 0x21, 0x69, 0x92, 0x4e, 0xe6, 0x9e, 0xf8, 0x02, 0xd4, 0x99, 0x6f, 0xee, 0x12,
-	0xa7, 0x35, 0xf2, 0x47, 0x10, 0xfe, 0x38, 0x2f, 0xe1, 0xaf, 0x87, 0x60, 0x7c, 0x05, 0x16, 0x3a,
-	0x03, 0xd3, 0x37, 0x5d, 0x46, 0x48, 0x0c, 0xbb, 0x2a, 0xb0, 0x71, 0x34, 0x14, 0x11, 0xe8, 0xff,
-	0xc8, 0xc1, 0xbc, 0x41, 0x98, 0x6f, 0x93, 0x5d, 0x72, 0x22, 0x2c, 0x7e, 0x11, 0xf2, 0xb6, 0x45,
-	0x85, 0xc5, 0xc7, 0x86, 0x1f, 0xdb, 0xa2, 0xfb, 0xad, 0x54, 0x54, 0x58, 0x49, 0xa5, 0xdd, 0xd2,
-	0xa1, 0xb4, 0x5b, 0xce, 0xd4, 0xee, 0xc7, 0x28, 0xae, 0x5d, 0x1e, 0x73, 0xe9, 0x3d, 0x07, 0x5d,
-	0x2e, 0x75, 0x6e, 0x12, 0xa9, 0x53, 0x27, 0x4c, 0xfe, 0xb0, 0x27, 0x8c, 0xfe, 0x0a, 0xcc, 0xde,
-	0xb2, 0x99, 0xd8, 0x5c, 0x3c, 0xd2, 0x23, 0x71, 0xf9, 0x13, 0xf1, 0xfc, 0x7e, 0x28, 0xfb, 0xde,
-	0x9e, 0x9c, 0x37, 0x27, 0xc2, 0x52, 0xc9, 0xf7, 0xf6, 0xc4, 0xb1, 0x24, 0x1e, 0x8f, 0x3d, 0x3f,
-	0x88, 0x57, 0x39, 0x23, 0xf8, 0xd3, 0xbf, 0x85, 0x46, 0x01, 0x65, 0x0a, 0x05, 0xbc, 0x08, 0x25,
-	0x5f, 0xd2, 0x8f, 0x7d, 0x4a, 0x8b, 0xaf, 0x24, 0xe4, 0x0a, 0xa9, 0xf4, 0xf7, 0x10, 0x68, 0x2f,
-	0x3b, 0x03, 0xfa, 0x79, 0xc4, 0x35, 0x55, 0xf5, 0x3e, 0xaf, 0xac, 0xde, 0xeb, 0x3f, 0xc8, 0x41,
-	0x2d, 0x60, 0x63, 0x9a, 0x84, 0x2f, 0x93, 0x95, 0x4d, 0xa8, 0xf2, 0x25, 0x5b, 0x94, 0x74, 0xc2,
-	0x72, 0x4f, 0x75, 0x75, 0x55, 0x79, 0x12, 0x24, 0xd8, 0x10, 0x8f, 0x90, 0x9b, 0x82, 0xe8, 0xcb,
-	0x2e, 0xf3, 0x87, 0x06, 0xb4, 0x23, 0x40, 0xf3, 0x6d, 0x98, 0x4f, 0x0d, 0x73, 0xdf, 0xd8, 0x21,
-	0xc3, 0xf0, 0xa8, 0xdb, 0x21, 0x43, 0xfc, 0x54, 0xfc, 0xa9, 0x38, 0xcb, 0xe1, 0x6e, 0x7b, 0x6e,
-	0xe7, 0x9a, 0xef, 0x9b, 0xc3, 0xe0, 0x29, 0xf9, 0xb9, 0xdc, 0xb3, 0x48, 0xff, 0x27, 0x02, 0xed,
-	0xb5, 0x01, 0xf1, 0x87, 0x47, 0x19, 0x80, 0x30, 0xcc, 0x92, 0xbb, 0x7d, 0x3f, 0x48, 0xda, 0xc4,
-	0xf7, 0xfe, 0xf8, 0x51, 0x50, 0xc4, 0x0f, 0x45, 0xe4, 0x2a, 0x2a, 0x6b, 0xc0, 0xef, 0x8d, 0xc4,
-	0x9c, 0x6a, 0x23, 0x24, 0x76, 0x77, 0xee, 0xd0, 0xbb, 0xfb, 0x23, 0x04, 0x95, 0x37, 0x48, 0x9b,
-	0x79, 0x3e, 0xdf, 0xd1, 0x0a, 0xfd, 0xa0, 0x09, 0x52, 0xf4, 0x5c, 0x3a, 0x45, 0xbf, 0x0a, 0x65,
-	0xdb, 0x6a, 0x99, 0xdc, 0xb4, 0x42, 0xc1, 0xe3, 0xa2, 0x54, 0xc9, 0xb6, 0x84, 0x0f, 0x4c, 0x5e,
-	0x3e, 0xff, 0x09, 0x02, 0x4d, 0xf2, 0x4c, 0x25, 0xe5, 0xf3, 0xb1, 0xe5, 0x90, 0xca, 0xdf, 0x82,
-	0x9f, 0x48, 0xd0, 0x5b, 0x33, 0xa3, 0x65, 0xaf, 0x01, 0x70, 0xdd, 0x05, 0xe4, 0xd2, 0x5d, 0x97,
-	0x95, 0xdc, 0x4a, 0x72, 0xa1, 0xc7, 0x5b, 0x33, 0x46, 0x85, 0x53, 0x89, 0x29, 0xae, 0x97, 0xa0,
-	0x20, 0xa8, 0xf5, 0xff, 0x20, 0x58, 0xb8, 0x61, 0x3a, 0xed, 0x35, 0x9b, 0x32, 0xd3, 0x6d, 0x4f,
-	0x71, 0x86, 0x3e, 0x07, 0x25, 0xaf, 0xdf, 0x72, 0xc8, 0x36, 0x0b, 0x58, 0x3a, 0x37, 0x46, 0x22,
-	0xa9, 0x06, 0xa3, 0xe8, 0xf5, 0x6f, 0x93, 0x6d, 0x86, 0x5f, 0x80, 0xb2, 0xd7, 0x6f, 0xf9, 0x76,
-	0xa7, 0xcb, 0x02, 0xed, 0x4f, 0x40, 0x5c, 0xf2, 0xfa, 0x06, 0xa7, 0x88, 0x95, 0x70, 0x66, 0x0f,
-	0x59, 0xc2, 0xd1, 0xff, 0xb6, 0x4f, 0xfc, 0x29, 0x5c, 0xfb, 0x39, 0x28, 0xdb, 0x2e, 0x6b, 0x59,
-	0x36, 0x0d, 0x55, 0x70, 0x56, 0xed, 0x43, 0x2e, 0x13, 0x12, 0x08, 0x9b, 0xba, 0x8c, 0xaf, 0x8d,
-	0x5f, 0x02, 0xd8, 0x76, 0x3c, 0x33, 0xa0, 0x96, 0x3a, 0x78, 0x48, 0xbd, 0x2b, 0x38, 0x5a, 0x48,
-	0x5f, 0x11, 0x44, 0x7c, 0x86, 0x91, 0x49, 0xff, 0x82, 0x60, 0x71, 0x83, 0xf8, 0xd4, 0xa6, 0x8c,
-	0xb8, 0x2c, 0x28, 0xa7, 0xae, 0xbb, 0xdb, 0x5e, 0xb2, 0x6e, 0x8d, 0x52, 0x75, 0xeb, 0xcf, 0xa6,
-	0x8a, 0x9b, 0xb8, 0xc1, 0xc9, 0x97, 0x84, 0xf0, 0x06, 0x17, 0xbe, 0x97, 0xc8, 0x1b, 0xf0, 0x5c,
-	0x86, 0x99, 0x02, 0x7e, 0x13, 0xf7, 0xfc, 0x1f, 0xca, 0x1e, 0x00, 0xa5, 0x50, 0xf7, 0xee, 0xb0,
-	0x4b, 0x10, 0x04, 0xd9, 0x54, 0xc8, 0xfd, 0x3f, 0x48, 0xc5, 0x8e, 0x8c, 0xce, 0x84, 0x9f, 0x22,
-	0x58, 0xce, 0xe6, 0x6a, 0x9a, 0xd3, 0xf1, 0x25, 0x28, 0xd8, 0xee, 0xb6, 0x17, 0x56, 0xf7, 0x2e,
-	0xaa, 0x2f, 0x42, 0xca, 0x75, 0x25, 0xa1, 0xfe, 0x2f, 0x04, 0x75, 0x11, 0xab, 0x8f, 0xc0, 0xfc,
-	0x3d, 0xd2, 0x6b, 0x51, 0xfb, 0x1d, 0x12, 0x9a, 0xbf, 0x47, 0x7a, 0x9b, 0xf6, 0x3b, 0x24, 0xe1,
-	0x19, 0x85, 0xa4, 0x67, 0x24, 0x0b, 0x24, 0xc5, 0x31, 0xd5, 0xdb, 0x52, 0xa2, 0x7a, 0xab, 0xbf,
-	0x8f, 0xa0, 0x79, 0x93, 0xb0, 0xb4, 0xa8, 0x47, 0xe7, 0x14, 0x1f, 0x20, 0x78, 0x40, 0xc9, 0xd0,
-	0x34, 0xfe, 0xf0, 0x7c, 0xd2, 0x1f, 0xd4, 0x17, 0xe3, 0x7d, 0x4b, 0x06, 0xae, 0xf0, 0x24, 0x68,
-	0x6b, 0x83, 0x5e, 0x2f, 0x4a, 0x4e, 0xce, 0x81, 0xe6, 0xcb, 0x4f, 0x79, 0x6f, 0x94, 0xc7, 0x65,
-	0x35, 0x80, 0xf1, 0xdb, 0xa1, 0x7e, 0x09, 0x6a, 0x01, 0x49, 0xc0, 0x75, 0x13, 0xca, 0x7e, 0xf0,
-	0x1d, 0xe0, 0x47, 0xff, 0xfa, 0x22, 0x2c, 0x18, 0xa4, 0xc3, 0x3d, 0xd1, 0xbf, 0x6d, 0xbb, 0x3b,
-	0xc1, 0x32, 0xfa, 0xbb, 0x08, 0x4e, 0x25, 0xe1, 0xc1, 0x5c, 0xff, 0x0f, 0x25, 0xd3, 0xb2, 0x7c,
-	0x42, 0xe9, 0x58, 0xb3, 0x5c, 0x93, 0x38, 0x46, 0x88, 0x1c, 0xd3, 0x5c, 0x6e, 0x62, 0xcd, 0x5d,
-	0x7c, 0x4c, 0x3e, 0x71, 0xa5, 0xba, 0x60, 0x70, 0x09, 0xf2, 0xd7, 0x1c, 0xa7, 0x3e, 0x83, 0x35,
-	0x28, 0xaf, 0xbb, 0x77, 0x48, 0xcf, 0xf3, 0x87, 0x75, 0x74, 0xf1, 0x4b, 0x30, 0x9f, 0x2a, 0x66,
-	0xe0, 0x32, 0xcc, 0xbe, 0xea, 0xb9, 0xa4, 0x3e, 0x83, 0xeb, 0xa0, 0x5d, 0xb7, 0x5d, 0xd3, 0x1f,
-	0xca, 0x43, 0xa8, 0x6e, 0xe1, 0x79, 0xa8, 0x8a, 0x60, 0x1c, 0x00, 0xc8, 0xea, 0xbf, 0x4f, 0x43,
-	0xed, 0x8e, 0x60, 0x6a, 0x93, 0xf8, 0xbb, 0x76, 0x9b, 0xe0, 0x16, 0xd4, 0xd3, 0x1d, 0xb2, 0xf8,
-	0x31, 0xa5, 0xf9, 0x32, 0x1a, 0x69, 0x9b, 0xe3, 0xc4, 0xd4, 0x67, 0xf0, 0x5b, 0x30, 0x97, 0xec,
-	0x5d, 0xc5, 0xea, 0x68, 0xa1, 0x6c, 0x70, 0x3d, 0x68, 0xf2, 0x16, 0xd4, 0x12, 0xad, 0xa8, 0xf8,
-	0x82, 0x72, 0x6e, 0x55, 0xbb, 0x6a, 0x53, 0x7d, 0x80, 0xc7, 0xdb, 0x45, 0x25, 0xf7, 0xc9, 0x8e,
-	0xbc, 0x0c, 0xee, 0x95, 0x6d, 0x7b, 0x07, 0x71, 0x6f, 0xc2, 0x7d, 0xfb, 0x1a, 0xec, 0xf0, 0xe3,
-	0xca, 0xf9, 0xb3, 0x1a, 0xf1, 0x0e, 0x5a, 0x62, 0x0f, 0xf0, 0xfe, 0x96, 0x4b, 0x7c, 0x59, 0x6d,
-	0x81, 0xac, 0x86, 0xd3, 0xe6, 0x95, 0x89, 0xf1, 0x23, 0xc5, 0x7d, 0x1b, 0xc1, 0xe9, 0x8c, 0xae,
-	0x38, 0x7c, 0x55, 0x39, 0xdd, 0xf8, 0xd6, 0xbe, 0xe6, 0x53, 0x87, 0x23, 0x8a, 0x18, 0x71, 0x61,
-	0x3e, 0xb5, 0xc1, 0xf0, 0xa5, 0x49, 0x9a, 0xd1, 0xc2, 0x75, 0x1f, 0x9b, 0x0c, 0x39, 0x5a, 0x8f,
-	0x5f, 0xe5, 0x92, 0x5d, 0x61, 0x19, 0xeb, 0xa9, 0x7b, 0xc7, 0x0e, 0x32, 0xe8, 0x9b, 0x50, 0x4b,
-	0xb4, 0x6f, 0x65, 0x78, 0xbc, 0xaa, 0xc5, 0xeb, 0xa0, 0xa9, 0xdf, 0x06, 0x2d, 0xde, 0x65, 0x85,
-	0x57, 0xb2, 0xf6, 0xd2, 0xbe, 0x89, 0x0f, 0xb3, 0x95, 0x46, 0x0d, 0x1a, 0x63, 0xb6, 0xd2, 0xbe,
-	0xbe, 0x93, 0xc9, 0xb7, 0x52, 0x6c, 0xfe, 0xb1, 0x5b, 0xe9, 0xd0, 0x4b, 0xbc, 0x8b, 0x60, 0x49,
-	0xdd, 0xa4, 0x83, 0x57, 0xb3, 0x7c, 0x33, 0xbb, 0x1d, 0xa9, 0x79, 0xf5, 0x50, 0x34, 0x91, 0x16,
-	0x77, 0x60, 0x2e, 0xd9, 0xe6, 0x92, 0xa1, 0x45, 0x65, 0xf7, 0x4e, 0xf3, 0xd2, 0x44, 0xb8, 0xd1,
-	0x62, 0x5f, 0x85, 0x6a, 0xac, 0x05, 0x01, 0x3f, 0x3a, 0xc6, 0x8f, 0xe3, 0x2f, 0x5c, 0x07, 0x69,
-	0xb2, 0x0b, 0xb5, 0xc4, 0xb3, 0x73, 0x96, 0x0f, 0x2b, 0xba, 0x01, 0x9a, 0x17, 0x27, 0x41, 0x8d,
-	0x04, 0xe8, 0x42, 0x2d, 0xf1, 0x08, 0x98, 0xb1, 0x92, 0xea, 0xcd, 0x33, 0x63, 0x25, 0xe5, 0x9b,
-	0xa2, 0x3e, 0x83, 0xbf, 0x19, 0x7b, 0x6f, 0x4c, 0xbc, 0xe9, 0xe2, 0x27, 0xc7, 0xce, 0xa3, 0x7a,
-	0xd2, 0x6e, 0xae, 0x1e, 0x86, 0x24, 0x62, 0xe1, 0x35, 0xa8, 0x44, 0x6f, 0x8d, 0xf8, 0x7c, 0x66,
-	0x58, 0x38, 0x8c, 0xa5, 0x36, 0xa1, 0x28, 0xdf, 0xfd, 0xb0, 0x9e, 0xf1, 0x80, 0x1f, 0x7b, 0x14,
-	0x6c, 0x3e, 0xac, 0xc4, 0x49, 0x3e, 0x89, 0xe9, 0x33, 0xd8, 0x80, 0xa2, 0xac, 0x22, 0x66, 0x4c,
-	0x9a, 0x78, 0x1d, 0x69, 0x8e, 0xc7, 0x91, 0xa5, 0xc7, 0x19, 0xfc, 0x35, 0x28, 0x87, 0x65, 0x60,
-	0xfc, 0x48, 0xc6, 0xb6, 0x4f, 0xd4, 0xe0, 0x9b, 0x07, 0x61, 0x85, 0x33, 0x6f, 0x40, 0x41, 0xd4,
-	0xf1, 0xf0, 0xb9, 0x71, 0x35, 0xbe, 0x71, 0xbc, 0x26, 0xca, 0x80, 0xfa, 0x0c, 0xfe, 0x0a, 0x14,
-	0x44, 0x2a, 0x9c, 0x31, 0x63, 0xbc, 0x50, 0xd7, 0x1c, 0x8b, 0x12, 0xb2, 0x68, 0x81, 0x16, 0x2f,
-	0x11, 0x64, 0x04, 0x6e, 0x45, 0x11, 0xa5, 0x39, 0x09, 0x66, 0xb8, 0xca, 0x77, 0x10, 0x34, 0xb2,
-	0x6e, 0x93, 0x38, 0xf3, 0x74, 0x1e, 0x77, 0x25, 0x6e, 0x3e, 0x7d, 0x48, 0xaa, 0x48, 0x85, 0xef,
-	0xc0, 0x82, 0xe2, 0x0e, 0x83, 0xaf, 0x64, 0xcd, 0x97, 0x71, 0xfd, 0x6a, 0x3e, 0x31, 0x39, 0x41,
-	0xb4, 0xf6, 0x06, 0x14, 0xc4, 0xdd, 0x23, 0xc3, 0x7c, 0xf1, 0xab, 0x4c, 0x86, 0x43, 0x24, 0xae,
-	0x2e, 0xfa, 0x0c, 0x26, 0xa0, 0xc5, 0x2f, 0x22, 0x19, 0xf6, 0x53, 0xdc, 0x61, 0x9a, 0x17, 0x26,
-	0xc0, 0x0c, 0x97, 0x59, 0x1d, 0x80, 0xb6, 0xe1, 0x7b, 0x77, 0x87, 0x61, 0xea, 0xff, 0xbf, 0x59,
-	0xf6, 0xfa, 0xd3, 0x5f, 0xbf, 0xda, 0xb1, 0x59, 0x77, 0xb0, 0xc5, 0xa3, 0xcb, 0x15, 0x89, 0xfb,
-	0xb8, 0xed, 0x05, 0x5f, 0x57, 0x6c, 0x97, 0x11, 0xdf, 0x35, 0x9d, 0x2b, 0x62, 0xae, 0x00, 0xda,
-	0xdf, 0xda, 0x2a, 0x8a, 0xff, 0xab, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x57, 0x0f, 0x08, 0x41,
-	0x03, 0x38, 0x00, 0x00,
+	// 3678 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3b, 0x5d, 0x6f, 0x1c, 0xc7,
+	0x91, 0x9a, 0x1d, 0xee, 0x57, 0x71, 0x97, 0x1f, 0x43, 0x52, 0x5a, 0x8f, 0xbe, 0xa8, 0xd1, 0xc9,
+	0xa2, 0x29, 0x5b, 0xb2, 0x29, 0x7f, 0x9d, 0xed, 0xb3, 0x2d, 0x89, 0x67, 0x89, 0xb0, 0xa4, 0xa3,
+	0x87, 0xb6, 0xef, 0x7c, 0x86, 0x6e, 0x6f, 0xb8, 0xd3, 0x5c, 0x0e, 0x38, 0x3b, 0xb3, 0x37, 0x3d,
+	0x4b, 0x6a, 0x0d, 0xdc, 0xc1, 0x80, 0x7d, 0x0e, 0x02, 0x23, 0x36, 0x82, 0x18, 0x09, 0x12, 0xc0,
+	0x46, 0x90, 0x20, 0x01, 0xf2, 0x94, 0x38, 0x09, 0x10, 0x20, 0x0f, 0xf9, 0x42, 0x1e, 0xf2, 0x10,
+	0x20, 0x1f, 0x8f, 0x01, 0xf2, 0x98, 0xc7, 0xfc, 0x83, 0x3c, 0x04, 0xfd, 0x31, 0xb3, 0x33, 0xb3,
+	0x3d, 0xbb, 0xb3, 0x5a, 0x2b, 0x24, 0x93, 0xb7, 0xe9, 0xea, 0xae, 0xee, 0xea, 0xaa, 0xea, 0xea,
+	0x9a, 0xaa, 0x2e, 0xa8, 0xb4, 0x2c, 0x7b, 0xb7, 0x83, 0x2f, 0xb6, 0x3d, 0xd7, 0x77, 0x95, 0xb9,
+	0x68, 0xeb, 0x22, 0x6b, 0xa8, 0x95, 0x86, 0xdb, 0x6a, 0xb9, 0x0e, 0x03, 0xaa, 0x15, 0xdc, 0xd8,
+	0x46, 0x2d, 0x83, 0xb5, 0xb4, 0x4d, 0x58, 0xb8, 0xe6, 0x21, 0xc3, 0x47, 0xab, 0x86, 0x6f, 0x6c,
+	0x1a, 0x18, 0xe9, 0xe8, 0x7f, 0x3a, 0x08, 0xfb, 0xca, 0xa3, 0x30, 0x41, 0x9a, 0x35, 0x69, 0x51,
+	0x5a, 0x9a, 0x5c, 0x39, 0x71, 0x31, 0x36, 0x31, 0x9f, 0xf0, 0x16, 0x6e, 0x5e, 0x25, 0x28, 0x74,
+	0xa4, 0x72, 0x0c, 0x8a, 0xe6, 0x66, 0xdd, 0x31, 0x5a, 0xa8, 0x96, 0x5b, 0x94, 0x96, 0xca, 0x7a,
+	0xc1, 0xdc, 0xbc, 0x6d, 0xb4, 0x90, 0xf6, 0xdf, 0x30, 0xb7, 0xea, 0xb9, 0xed, 0xfb, 0xb8, 0xc2,
+	0x0d, 0x98, 0xbf, 0x69, 0x61, 0x3f, 0x58, 0x01, 0xdf, 0xf3, 0x12, 0xda, 0xff, 0xc1, 0x42, 0x62,
+	0x26, 0xdc, 0x76, 0x1d, 0x8c, 0x94, 0xcb, 0x50, 0xc0, 0xbe, 0xe1, 0x77, 0x30, 0x9f, 0xec, 0xb8,
+	0x70, 0xb2, 0x0d, 0x3a, 0x44, 0xe7, 0x43, 0x95, 0x07, 0xa0, 0xc4, 0x09, 0xc6, 0xb5, 0xdc, 0xa2,
+	0xbc, 0x54, 0xd6, 0x8b, 0x8c, 0x62, 0xac, 0x2c, 0x40, 0xc1, 0xdc, 0xac, 0x5b, 0x26, 0xae, 0xc9,
+	0x8b, 0xf2, 0x92, 0xac, 0xe7, 0xcd, 0xcd, 0x35, 0x13, 0x6b, 0xff, 0x0b, 0xb3, 0x4c, 0x1e, 0xaf,
+	0x61, 0xe4, 0xdd, 0x3b, 0xa7, 0x54, 0x28, 0x75, 0x30, 0xf2, 0x22, 0xac, 0x0a, 0xdb, 0xa4, 0xaf,
+	0x6d, 0x60, 0xbc, 0xe7, 0x7a, 0x66, 0x4d, 0x66, 0x7d, 0x41, 0x5b, 0xfb, 0x9c, 0x04, 0xf9, 0xeb,
+	0x9e, 0xe1, 0xf8, 0x51, 0x5e, 0x4b, 0x51, 0x5e, 0x2b, 0xe7, 0x61, 0xba, 0xe1, 0xda, 0x36, 0x6a,
+	0xf8, 0x96, 0xeb, 0x44, 0x85, 0x31, 0xd5, 0x03, 0xd3, 0x81, 0xcf, 0x41, 0xb9, 0xed, 0x59, 0xbb,
+	0x96, 0x8d, 0x9a, 0x88, 0x2e, 0x34, 0xb5, 0x72, 0x4a, 0x48, 0xfa, 0x7a, 0x30, 0x4a, 0xef, 0x21,
+	0x68, 0x9f, 0x4a, 0x30, 0x43, 0x29, 0xd1, 0x5d, 0x7b, 0x0c, 0x95, 0x39, 0x0e, 0x65, 0xcf, 0xb5,
+	0x51, 0x94, 0xce, 0x12, 0x01, 0xdc, 0xe6, 0x9c, 0x08, 0xb9, 0x24, 0x27, 0xb8, 0xb4, 0x02, 0x85,
+	0x26, 0x59, 0x1e, 0xd7, 0x26, 0x16, 0xe5, 0xa5, 0xc9, 0x15, 0xf5, 0xa2, 0xe0, 0x68, 0x5d, 0x64,
+	0x14, 0xf2, 0x91, 0xda, 0xf7, 0x25, 0x98, 0xd5, 0xd1, 0xae, 0xbb, 0x83, 0x0e, 0x11, 0xd1, 0x06,
+	0xcc, 0x12, 0x8d, 0xa7, 0x40, 0x7c, 0x5f, 0x34, 0x4e, 0xbb, 0x03, 0x40, 0x18, 0xc2, 0x96, 0x88,
+	0xef, 0x4e, 0x4a, 0xec, 0xae, 0xb7, 0x83, 0x5c, 0xe6, 0x1d, 0xbc, 0x2d, 0x81, 0x12, 0xdd, 0xc2,
+	0x38, 0x27, 0xf6, 0x09, 0xc8, 0x13, 0x5a, 0x82, 0xe5, 0x4f, 0x0b, 0x97, 0xef, 0x6d, 0x46, 0x67,
+	0xa3, 0xb5, 0x5f, 0x4a, 0x70, 0x8c, 0x9d, 0xdb, 0x6b, 0xe1, 0x21, 0xf8, 0xec, 0xed, 0x9c, 0xe8,
+	0xec, 0xc9, 0xc2, 0xb3, 0x77, 0x14, 0x0a, 0xcc, 0xcc, 0xd7, 0x26, 0x16, 0xa5, 0xa5, 0x8a, 0xce,
+	0x5b, 0xca, 0x49, 0x00, 0xbc, 0x6d, 0x78, 0x26, 0xae, 0x3b, 0x9d, 0x56, 0x2d, 0xbf, 0x28, 0x2d,
+	0xe5, 0xf5, 0x32, 0x83, 0xdc, 0xee, 0xb4, 0xb4, 0xf7, 0x25, 0x58, 0x20, 0xa6, 0xfa, 0x40, 0x6c,
+	0x42, 0xfb, 0x8e, 0x04, 0xf3, 0x37, 0x0c, 0x7c, 0x30, 0x38, 0x7a, 0x12, 0xc0, 0xb7, 0x5a, 0xa8,
+	0x8e, 0x7d, 0xa3, 0xd5, 0xa6, 0x5c, 0x9d, 0xd0, 0xcb, 0x04, 0xb2, 0x41, 0x00, 0xda, 0x1b, 0x50,
+	0xb9, 0xea, 0xba, 0xf6, 0x78, 0xca, 0x37, 0x0f, 0xf9, 0x5d, 0xc3, 0xee, 0x30, 0x1a, 0x4b, 0x3a,
+	0x6b, 0x68, 0x6f, 0xc2, 0xd4, 0x86, 0xef, 0x59, 0x4e, 0xf3, 0x33, 0x9c, 0xbc, 0x1c, 0x4c, 0xfe,
+	0x7b, 0x09, 0x1e, 0x58, 0x45, 0xb8, 0xe1, 0x59, 0x9b, 0x07, 0x44, 0x75, 0x35, 0xa8, 0xf4, 0x20,
+	0x6b, 0xab, 0x94, 0xd5, 0xb2, 0x1e, 0x83, 0x25, 0x84, 0x91, 0x4f, 0x0a, 0xe3, 0x93, 0x1c, 0xa8,
+	0xa2, 0x4d, 0x8d, 0xc3, 0xbe, 0x7f, 0x09, 0x4f, 0x54, 0x8e, 0x22, 0x9d, 0x8b, 0x23, 0xb1, 0xbe,
+	0x8b, 0xbd, 0xd5, 0x36, 0x28, 0x20, 0x3c, 0x78, 0xc9, 0x5d, 0xc9, 0x82, 0x5d, 0xad, 0xc0, 0xc2,
+	0xae, 0xe5, 0xf9, 0x1d, 0xc3, 0xae, 0x37, 0xb6, 0x0d, 0xc7, 0x41, 0x36, 0x77, 0x1d, 0x26, 0xa8,
+	0xeb, 0x30, 0xc7, 0x3b, 0xaf, 0xb1, 0x3e, 0xe6, 0x46, 0x3c, 0x0e, 0x47, 0xdb, 0xdb, 0x5d, 0x6c,
+	0x35, 0xfa, 0x90, 0xf2, 0x14, 0x69, 0x3e, 0xe8, 0x8d, 0x62, 0x91, 0x8b, 0x6a, 0xe1, 0xa6, 0x6b,
+	0x98, 0x07, 0x43, 0xe2, 0xe7, 0x60, 0xca, 0x43, 0x6d, 0xdb, 0x6a, 0x18, 0xc4, 0x2a, 0x6d, 0x22,
+	0x8f, 0xca, 0x3c, 0xaf, 0x57, 0x39, 0xf4, 0x36, 0x05, 0x6a, 0x1f, 0x48, 0x50, 0xd3, 0x91, 0x8d,
+	0x0c, 0x7c, 0x30, 0x34, 0x55, 0xfb, 0x48, 0x82, 0x53, 0xd7, 0x91, 0x1f, 0x91, 0xb9, 0x6f, 0xf8,
+	0x16, 0xf6, 0xad, 0x06, 0xde, 0x4f, 0xb2, 0x3e, 0x94, 0xe0, 0x74, 0x2a, 0x59, 0xe3, 0x1c, 0x81,
+	0xa7, 0x20, 0x4f, 0xbe, 0x82, 0xbb, 0xf1, 0x8c, 0x10, 0xe7, 0x65, 0xd4, 0x7d, 0x9d, 0x58, 0x96,
+	0x75, 0xc3, 0xf2, 0x74, 0x36, 0x5e, 0xfb, 0x99, 0x04, 0x47, 0x37, 0xb6, 0xdd, 0xbd, 0x1e, 0x49,
+	0xf7, 0x83, 0x41, 0x71, 0xa3, 0x20, 0x27, 0x8c, 0x82, 0xf2, 0x1c, 0x4c, 0xf8, 0xdd, 0x36, 0xa2,
+	0xba, 0x35, 0xb5, 0xb2, 0x24, 0xbc, 0xd8, 0x13, 0x44, 0xbe, 0xda, 0x6d, 0x23, 0x9d, 0x62, 0x69,
+	0x5f, 0x97, 0xe0, 0x58, 0xdf, 0x16, 0xc6, 0x61, 0xe6, 0x43, 0x30, 0x93, 0x10, 0x67, 0xf0, 0x8b,
+	0x30, 0x1d, 0x97, 0x27, 0x26, 0xe7, 0x23, 0x32, 0xb4, 0xf7, 0xcb, 0x50, 0xed, 0x41, 0xc9, 0xaf,
+	0xc3, 0xc7, 0x12, 0x4c, 0x5f, 0x31, 0xcd, 0x97, 0x2c, 0x64, 0x9b, 0x07, 0xd0, 0xf7, 0xd0, 0x3e,
+	0x91, 0x40, 0x61, 0x3e, 0xd2, 0x15, 0xdb, 0x32, 0xf6, 0xf3, 0x88, 0x90, 0xbb, 0xd0, 0x20, 0x34,
+	0x50, 0x0a, 0xcb, 0x3a, 0x6b, 0x68, 0x18, 0x66, 0x88, 0xf3, 0x73, 0xbf, 0xa8, 0x0b, 0x17, 0x95,
+	0xa3, 0x8b, 0x7e, 0x2c, 0xc1, 0xec, 0x15, 0xdb, 0x47, 0xde, 0x01, 0x65, 0xca, 0x0f, 0x24, 0x38,
+	0xca, 0xa4, 0xb6, 0x6e, 0x78, 0xbe, 0x75, 0x00, 0xee, 0x8a, 0x76, 0x40, 0x07, 0x1b, 0xc7, 0xa8,
+	0xad, 0x86, 0x50, 0x6a, 0x03, 0x3f, 0x95, 0x60, 0x9e, 0xc8, 0xf2, 0x30, 0xd1, 0xfc, 0x3d, 0x09,
+	0xe6, 0x6e, 0x18, 0xf8, 0x30, 0x91, 0xfc, 0x43, 0xee, 0x47, 0x84, 0x34, 0xef, 0xab, 0x02, 0x9f,
+	0x87, 0xe9, 0x38, 0xd1, 0x81, 0xe7, 0x34, 0x15, 0xa3, 0x1a, 0x6b, 0x3f, 0xea, 0x79, 0x12, 0x87,
+	0x8c, 0xf2, 0x1f, 0x4b, 0x70, 0xf2, 0x3a, 0xf2, 0x43, 0xaa, 0x0f, 0x84, 0xc7, 0x91, 0x55, 0x5b,
+	0x3e, 0x60, 0xfe, 0x92, 0x90, 0xf8, 0x7d, 0xf1, 0x4b, 0xbe, 0x2b, 0xc1, 0x02, 0xb9, 0xd4, 0x0f,
+	0x86, 0x12, 0x64, 0xf8, 0xf1, 0xd1, 0xbe, 0xc6, 0x3d, 0xa9, 0x28, 0xc5, 0xe3, 0xb0, 0x4e, 0xa0,
+	0x78, 0x39, 0x91, 0xe2, 0x11, 0xe2, 0x42, 0xc8, 0xda, 0x6a, 0xe0, 0x81, 0xc4, 0x60, 0xda, 0xb7,
+	0x25, 0x38, 0x1a, 0xfc, 0x76, 0x6d, 0xa0, 0x66, 0x0b, 0x39, 0xfe, 0xbd, 0xf3, 0x33, 0xc9, 0x8d,
+	0x9c, 0xe0, 0x87, 0xe9, 0x04, 0x94, 0x31, 0x5b, 0x27, 0xfc, 0xa3, 0xea, 0x01, 0x94, 0x1a, 0x14,
+	0xb7, 0x88, 0x2f, 0x14, 0xb2, 0x32, 0x68, 0x6a, 0x3f, 0x95, 0xe0, 0x58, 0x1f, 0xa1, 0xe3, 0xb0,
+	0xb1, 0x06, 0x45, 0xcb, 0x31, 0xd1, 0xdd, 0x90, 0xce, 0xa0, 0x49, 0x7a, 0x36, 0x3b, 0x96, 0x6d,
+	0x86, 0x04, 0x06, 0x4d, 0xe5, 0x0c, 0x54, 0x90, 0x63, 0x6c, 0xda, 0xa8, 0x4e, 0xc7, 0x52, 0x1a,
+	0x4b, 0xfa, 0x24, 0x83, 0xad, 0x11, 0x50, 0x74, 0x07, 0xf9, 0xf8, 0x0e, 0xbe, 0x20, 0xc1, 0x1c,
+	0xd1, 0x03, 0x4e, 0x3d, 0xbe, 0xbf, 0x7c, 0x5e, 0x84, 0xc9, 0x88, 0xa0, 0xf9, 0x46, 0xa2, 0x20,
+	0x6d, 0x07, 0xe6, 0xe3, 0xe4, 0x8c, 0xc3, 0xcd, 0x53, 0x00, 0xa1, 0x14, 0x99, 0x3e, 0xca, 0x7a,
+	0x04, 0xa2, 0xfd, 0x39, 0x74, 0x24, 0x29, 0x9b, 0xf6, 0x39, 0x2a, 0x44, 0x45, 0x12, 0xb5, 0x7a,
+	0x65, 0x0a, 0xa1, 0xdd, 0xab, 0x50, 0x41, 0x77, 0x7d, 0xcf, 0xa8, 0xb7, 0x0d, 0xcf, 0x68, 0xb1,
+	0x7f, 0xf2, 0x4c, 0x06, 0x6a, 0x92, 0xa2, 0xad, 0x53, 0x2c, 0xed, 0x57, 0xc4, 0x99, 0xe1, 0xea,
+	0x7a, 0xd0, 0x77, 0x7c, 0x12, 0x80, 0xaa, 0x33, 0xeb, 0xce, 0xb3, 0x6e, 0x0a, 0xa1, 0x57, 0xc0,
+	0xb7, 0x24, 0x98, 0xa1, 0x5b, 0x60, 0xfb, 0x69, 0x93, 0x69, 0x13, 0x38, 0x52, 0x02, 0x67, 0xc0,
+	0xe1, 0xfa, 0x67, 0x28, 0x70, 0xc6, 0xca, 0x59, 0x19, 0xcb, 0x11, 0x86, 0x6c, 0x43, 0xfb, 0x06,
+	0x09, 0x84, 0xc6, 0x59, 0x3e, 0x8e, 0x46, 0xbf, 0x0a, 0x0a, 0xdb, 0xa1, 0xd9, 0xdb, 0x76, 0x70,
+	0x5d, 0x9d, 0x13, 0xfe, 0x89, 0x26, 0x99, 0xa4, 0xcf, 0x5a, 0x09, 0x08, 0xd6, 0x7e, 0x2b, 0xc1,
+	0x89, 0xeb, 0xc8, 0xa7, 0x43, 0xaf, 0x12, 0xab, 0xb2, 0xee, 0xb9, 0x4d, 0x0f, 0x61, 0x7c, 0x78,
+	0xf5, 0xe3, 0xcb, 0xcc, 0xbf, 0x11, 0x6d, 0x69, 0x1c, 0xfe, 0x9f, 0x81, 0x0a, 0x5d, 0x03, 0x99,
+	0x75, 0xcf, 0xdd, 0xc3, 0x5c, 0x8f, 0x26, 0x39, 0x4c, 0x77, 0xf7, 0xa8, 0x42, 0xf8, 0xae, 0x6f,
+	0xd8, 0x6c, 0x00, 0xbf, 0x4c, 0x28, 0x84, 0x74, 0xd3, 0x33, 0x18, 0x10, 0x46, 0x26, 0x47, 0x87,
+	0x97, 0xc7, 0xef, 0x48, 0xb0, 0x90, 0xd8, 0xca, 0x98, 0x19, 0x13, 0xf2, 0xc5, 0x36, 0x33, 0xb5,
+	0x72, 0x5a, 0x88, 0x13, 0x59, 0x8c, 0x8d, 0x26, 0x19, 0x13, 0xfa, 0xb7, 0x7d, 0xc8, 0x0d, 0xda,
+	0x37, 0x73, 0x50, 0x5d, 0x73, 0x30, 0xf2, 0xfc, 0x83, 0xef, 0x80, 0x2b, 0x2f, 0xc0, 0x24, 0xdd,
+	0x18, 0xae, 0x9b, 0x86, 0x6f, 0xf0, 0xdb, 0xe8, 0x94, 0x30, 0x90, 0x4d, 0xa3, 0x48, 0x24, 0x0b,
+	0xae, 0x33, 0xee, 0x60, 0xf2, 0x4d, 0x52, 0x77, 0xdb, 0x06, 0xde, 0xae, 0xef, 0xa0, 0x2e, 0xae,
+	0x15, 0x16, 0xe5, 0xa5, 0xaa, 0x5e, 0x22, 0x80, 0x97, 0x51, 0x97, 0x26, 0xbb, 0x9d, 0x4e, 0x8b,
+	0x9d, 0x9f, 0xe2, 0xa2, 0xb4, 0x54, 0xd5, 0x8b, 0x4e, 0xa7, 0x45, 0x4f, 0xcf, 0x4f, 0x24, 0xa8,
+	0xae, 0x22, 0x1b, 0xf9, 0xe8, 0x10, 0x70, 0x49, 0x81, 0x09, 0x74, 0xb7, 0xed, 0x71, 0x59, 0xd3,
+	0x6f, 0xed, 0xbd, 0x1c, 0x54, 0x5f, 0x6b, 0xff, 0xa3, 0x88, 0x39, 0x2a, 0xc9, 0x42, 0x5c, 0x92,
+	0xbf, 0xce, 0xc1, 0xd4, 0xad, 0x8e, 0x6f, 0xf0, 0x84, 0x4a, 0xc7, 0xf6, 0xef, 0xcd, 0x6a, 0x2c,
+	0x83, 0xcc, 0x9c, 0x3b, 0x82, 0x51, 0x13, 0xd2, 0xb6, 0xb6, 0x8a, 0x75, 0x32, 0x88, 0x26, 0x2d,
+	0x3b, 0x8d, 0x06, 0xf7, 0x93, 0x65, 0xaa, 0x76, 0x65, 0x02, 0x61, 0x5e, 0xf2, 0x71, 0x28, 0x23,
+	0xcf, 0x0b, 0xbd, 0x68, 0xaa, 0x94, 0xc8, 0xf3, 0x58, 0xa7, 0x06, 0x15, 0xa3, 0xb1, 0xe3, 0xb8,
+	0x7b, 0x36, 0x32, 0x9b, 0xc8, 0xa4, 0x42, 0x2d, 0xe9, 0x31, 0x18, 0x3b, 0xe2, 0x44, 0xb6, 0xf5,
+	0x86, 0xe3, 0xd3, 0x0d, 0xcb, 0x7a, 0x99, 0x41, 0xae, 0x39, 0x3e, 0xe9, 0x36, 0xa9, 0xee, 0xd2,
+	0xee, 0x22, 0xeb, 0x66, 0x10, 0xde, 0xdd, 0x69, 0x87, 0xd8, 0x25, 0xd6, 0xcd, 0x20, 0xa4, 0xfb,
+	0x04, 0xd0, 0x18, 0x34, 0x0b, 0x4a, 0x97, 0x7b, 0x41, 0x69, 0x0a, 0xd0, 0x76, 0x61, 0x66, 0xdd,
+	0x36, 0x1a, 0x68, 0xdb, 0xb5, 0x4d, 0xe4, 0x51, 0x37, 0x45, 0x99, 0x01, 0xd9, 0x37, 0x9a, 0xdc,
+	0x0f, 0x22, 0x9f, 0xca, 0xd3, 0x3c, 0x74, 0xcd, 0x2c, 0xec, 0x3f, 0x09, 0x1d, 0x86, 0xc8, 0x34,
+	0xbd, 0xb0, 0x35, 0x89, 0xc5, 0xd2, 0x3c, 0x1f, 0xf3, 0x90, 0x2a, 0x3a, 0x6f, 0x69, 0x77, 0x62,
+	0xeb, 0x5e, 0xf7, 0xdc, 0x4e, 0x5b, 0x59, 0x83, 0x4a, 0xbb, 0x07, 0x23, 0xd2, 0x4c, 0x77, 0x4f,
+	0x92, 0x44, 0xeb, 0x31, 0x54, 0xed, 0xe7, 0x13, 0x50, 0xdd, 0x40, 0x86, 0xd7, 0xd8, 0x3e, 0x0c,
+	0x51, 0x15, 0xc2, 0x71, 0x13, 0xdb, 0xfc, 0xc0, 0x93, 0x4f, 0xe5, 0x02, 0xcc, 0x46, 0x36, 0x54,
+	0x6f, 0x12, 0x06, 0x51, 0xcd, 0xa8, 0xe8, 0x33, 0xed, 0x24, 0xe3, 0x9e, 0x82, 0x92, 0x89, 0xed,
+	0x3a, 0x15, 0x51, 0x91, 0x8a, 0x48, 0xbc, 0xbf, 0x55, 0x6c, 0x53, 0xd1, 0x14, 0x4d, 0xf6, 0xa1,
+	0x9c, 0x85, 0xaa, 0xdb, 0xf1, 0xdb, 0x1d, 0xbf, 0xce, 0x0e, 0x5f, 0xad, 0x44, 0xc9, 0xab, 0x30,
+	0x20, 0x3d, 0x9b, 0x58, 0x79, 0x09, 0xaa, 0x98, 0xb2, 0x32, 0xf8, 0x89, 0x28, 0x67, 0xf5, 0x75,
+	0x2b, 0x0c, 0x8f, 0xfd, 0x45, 0x90, 0x84, 0x83, 0xef, 0x19, 0xbb, 0xc8, 0xae, 0xf7, 0xf4, 0x11,
+	0xa8, 0x3e, 0x4e, 0x33, 0xf8, 0xab, 0x01, 0x58, 0xb9, 0x04, 0x73, 0xcd, 0x8e, 0xe1, 0x19, 0x8e,
+	0x8f, 0x50, 0x64, 0xf4, 0x24, 0x1d, 0xad, 0x84, 0x5d, 0x3d, 0x04, 0x1d, 0x66, 0x1b, 0xae, 0x83,
+	0x2d, 0xec, 0x23, 0xa7, 0xd1, 0xad, 0xdb, 0x68, 0x17, 0xd9, 0xb5, 0x0a, 0x65, 0xc5, 0x39, 0x21,
+	0x9d, 0xd7, 0x7a, 0xa3, 0x6f, 0x92, 0xc1, 0xfa, 0x4c, 0x23, 0x01, 0xd1, 0xde, 0x9f, 0x80, 0xb9,
+	0x1b, 0xdd, 0x4d, 0xcf, 0x32, 0x0f, 0x91, 0x26, 0x3d, 0x0f, 0x25, 0x8f, 0xd1, 0x19, 0xfc, 0xec,
+	0x69, 0xe2, 0x44, 0x53, 0x74, 0x4b, 0x7a, 0x88, 0xa3, 0x5c, 0x85, 0x49, 0xcf, 0x70, 0x76, 0x02,
+	0x51, 0x17, 0xb2, 0x8a, 0x1a, 0x08, 0x16, 0x17, 0x74, 0x9f, 0x56, 0x15, 0x05, 0x5a, 0x25, 0xd2,
+	0x86, 0xd2, 0x48, 0xda, 0x50, 0x1e, 0x4d, 0x1b, 0x60, 0x3c, 0x6d, 0xf8, 0xa3, 0x0c, 0xd3, 0x3a,
+	0xf2, 0x3d, 0x0b, 0xed, 0xa2, 0x43, 0xa1, 0x09, 0xcb, 0x20, 0x93, 0x4c, 0x5d, 0x7e, 0xd8, 0x05,
+	0x67, 0x99, 0x02, 0x89, 0x15, 0x32, 0x4a, 0xac, 0x38, 0x92, 0xc4, 0x4a, 0xa3, 0x49, 0xac, 0x3c,
+	0x96, 0xc4, 0x48, 0x3a, 0xc9, 0xb6, 0x5a, 0x96, 0x4f, 0x25, 0x2f, 0xeb, 0xac, 0x41, 0x2e, 0x24,
+	0x77, 0x6b, 0x0b, 0x23, 0x9f, 0x5a, 0x13, 0x59, 0xe7, 0x2d, 0x92, 0x66, 0x8a, 0xc8, 0x97, 0xf8,
+	0x15, 0xf8, 0x9e, 0x1d, 0x0b, 0xc2, 0xf7, 0x5c, 0x16, 0xbe, 0x27, 0x1c, 0x25, 0x79, 0x54, 0x47,
+	0x49, 0x7b, 0x19, 0x26, 0x6e, 0x58, 0x3e, 0xbd, 0x40, 0xd6, 0x56, 0xd9, 0x8d, 0x29, 0x33, 0x9f,
+	0xe5, 0x01, 0x28, 0x79, 0xee, 0x1e, 0x9b, 0x37, 0x47, 0xaf, 0xde, 0xa2, 0xe7, 0xee, 0x11, 0x24,
+	0x96, 0x1f, 0x75, 0x3d, 0x7e, 0x27, 0xe7, 0x74, 0xde, 0xd2, 0xfe, 0x5f, 0xea, 0x5d, 0x9a, 0x63,
+	0x30, 0xe0, 0x05, 0x28, 0x7a, 0x0c, 0x7f, 0xe0, 0x4b, 0x95, 0xe8, 0x4a, 0x74, 0x5f, 0x01, 0x96,
+	0xf6, 0xae, 0x04, 0x95, 0x97, 0xec, 0x0e, 0xbe, 0x1f, 0x16, 0x57, 0x94, 0xf5, 0x96, 0x85, 0x59,
+	0x6f, 0xed, 0x8b, 0x39, 0xa8, 0x72, 0x32, 0xc6, 0xf9, 0x3d, 0x4d, 0x25, 0x65, 0x03, 0x26, 0xc9,
+	0x92, 0x75, 0x8c, 0x9a, 0x41, 0x40, 0x7b, 0x72, 0x65, 0x45, 0x68, 0xad, 0x63, 0x64, 0xd0, 0x37,
+	0x3e, 0x1b, 0x14, 0xe9, 0x5f, 0x1d, 0xdf, 0xeb, 0xea, 0xd0, 0x08, 0x01, 0xea, 0x1d, 0x98, 0x4e,
+	0x74, 0x13, 0xdd, 0xd8, 0x41, 0xdd, 0xc0, 0x9d, 0xdb, 0x41, 0x5d, 0xe5, 0xf1, 0xe8, 0x4b, 0xac,
+	0x34, 0x85, 0xbb, 0xe9, 0x3a, 0xcd, 0x2b, 0x9e, 0x67, 0x74, 0xf9, 0x4b, 0xad, 0x67, 0x72, 0x4f,
+	0x4b, 0xe4, 0x21, 0x45, 0x75, 0xad, 0xd5, 0x76, 0x0f, 0xc5, 0x6f, 0xc8, 0x3c, 0xe4, 0xb7, 0x2c,
+	0x3b, 0x7c, 0x89, 0xc4, 0x1a, 0xda, 0x1d, 0x98, 0x0a, 0x76, 0x30, 0x8e, 0x58, 0x8f, 0x42, 0xc1,
+	0x37, 0xf0, 0x4e, 0x18, 0x13, 0xe4, 0x2d, 0xcd, 0x60, 0xb1, 0x0d, 0xba, 0xc2, 0x98, 0x71, 0x9a,
+	0xb4, 0x25, 0xfe, 0x20, 0xc1, 0xd1, 0xe4, 0x1a, 0xe3, 0x6c, 0xe5, 0xc9, 0x78, 0x00, 0x65, 0x51,
+	0x88, 0x13, 0x5d, 0x8d, 0x0d, 0x67, 0xef, 0x68, 0xf7, 0xea, 0x0d, 0xb7, 0xe3, 0xf8, 0x3c, 0x60,
+	0x45, 0x6c, 0xce, 0x35, 0xd2, 0x4e, 0xc4, 0xd0, 0x27, 0x92, 0x31, 0x74, 0xb2, 0x39, 0x0f, 0x19,
+	0xd8, 0x75, 0xb8, 0xd7, 0xcb, 0x5b, 0xda, 0x2f, 0x64, 0xa8, 0xbc, 0xd2, 0x41, 0x5e, 0x77, 0x3f,
+	0x15, 0x2c, 0xf8, 0x03, 0x9f, 0xe8, 0xfd, 0x81, 0xf7, 0xdf, 0x91, 0x79, 0xc1, 0x1d, 0x29, 0xb8,
+	0x9d, 0x0b, 0xc2, 0xdb, 0xf9, 0xef, 0xfb, 0x32, 0x7d, 0x57, 0x0a, 0x85, 0x38, 0xd6, 0x45, 0x12,
+	0xbb, 0x1d, 0x73, 0x23, 0xdf, 0x8e, 0x9f, 0x4a, 0x50, 0x7e, 0x1d, 0x35, 0x7c, 0xd7, 0x23, 0x1a,
+	0x27, 0x90, 0xbe, 0x94, 0x21, 0x20, 0x97, 0x4b, 0x06, 0xe4, 0x2e, 0x43, 0xc9, 0x32, 0xeb, 0x06,
+	0x31, 0x8d, 0x35, 0x79, 0xc8, 0x2d, 0x5f, 0xb4, 0x4c, 0x6a, 0x43, 0xb3, 0x27, 0xd8, 0xbf, 0x22,
+	0x41, 0x85, 0xd1, 0x8c, 0x19, 0xe6, 0xb3, 0x91, 0xe5, 0x24, 0x91, 0xbd, 0xe6, 0x8d, 0x70, 0xa3,
+	0x37, 0x8e, 0xf4, 0x96, 0xbd, 0x02, 0x40, 0x78, 0xc7, 0xd1, 0x99, 0xb9, 0x5f, 0x14, 0x52, 0xcb,
+	0xd0, 0x29, 0x1f, 0x6f, 0x1c, 0xd1, 0xcb, 0x04, 0x8b, 0x4e, 0x71, 0xb5, 0x08, 0x79, 0x8a, 0xad,
+	0xfd, 0x45, 0x82, 0xb9, 0x6b, 0x86, 0xdd, 0x58, 0xb5, 0xb0, 0x6f, 0x38, 0x8d, 0x31, 0x0c, 0xdb,
+	0x33, 0x50, 0x74, 0xdb, 0x75, 0x1b, 0x6d, 0xf9, 0x9c, 0xa4, 0x33, 0x03, 0x76, 0xc4, 0xd8, 0xa0,
+	0x17, 0xdc, 0xf6, 0x4d, 0xb4, 0xe5, 0x2b, 0xcf, 0x41, 0xc9, 0x6d, 0xd7, 0x3d, 0xab, 0xb9, 0xed,
+	0xd7, 0xe4, 0xac, 0xc8, 0x45, 0xb7, 0xad, 0x13, 0x8c, 0x48, 0xc2, 0x66, 0x62, 0xc4, 0x84, 0x8d,
+	0xf6, 0xbb, 0xbe, 0xed, 0x8f, 0xa1, 0xda, 0xcf, 0x40, 0xc9, 0x72, 0xfc, 0xba, 0x69, 0xe1, 0x80,
+	0x05, 0x27, 0xc5, 0x3a, 0xe4, 0xf8, 0x74, 0x07, 0x54, 0xa6, 0x8e, 0x4f, 0xd6, 0x56, 0x5e, 0x04,
+	0xd8, 0xb2, 0x5d, 0x83, 0x63, 0x33, 0x1e, 0x9c, 0x16, 0x9f, 0x0a, 0x32, 0x2c, 0xc0, 0x2f, 0x53,
+	0x24, 0x32, 0x43, 0x4f, 0xa4, 0xbf, 0x91, 0x60, 0x61, 0x1d, 0x79, 0xec, 0xa8, 0xfb, 0x3c, 0x79,
+	0xba, 0xe6, 0x6c, 0xb9, 0xf1, 0xcc, 0xb6, 0x94, 0xcc, 0x6c, 0x7f, 0x26, 0x39, 0xdb, 0x58, 0x94,
+	0x8f, 0x27, 0xc8, 0x79, 0x94, 0x2f, 0x78, 0x51, 0xc1, 0xe2, 0xdd, 0x53, 0x29, 0x62, 0xe2, 0xf4,
+	0xc6, 0xa2, 0xfa, 0x5f, 0x62, 0x6f, 0x4f, 0x85, 0x9b, 0x1a, 0xeb, 0x26, 0x66, 0x57, 0x48, 0xe2,
+	0x42, 0x79, 0x10, 0x12, 0xb6, 0x23, 0xe5, 0x45, 0xec, 0x57, 0x25, 0x58, 0x4c, 0xa7, 0x6a, 0x9c,
+	0xbb, 0xfb, 0x45, 0xc8, 0x5b, 0xce, 0x96, 0x1b, 0xe4, 0xf2, 0x96, 0xc5, 0xc1, 0x32, 0xe1, 0xba,
+	0x0c, 0x51, 0xfb, 0x93, 0x04, 0x33, 0xd4, 0x56, 0xef, 0x83, 0xf8, 0x5b, 0xa8, 0x55, 0xc7, 0xd6,
+	0x5b, 0x28, 0x10, 0x7f, 0x0b, 0xb5, 0x36, 0xac, 0xb7, 0x50, 0x4c, 0x33, 0xf2, 0x71, 0xcd, 0x88,
+	0xa7, 0x43, 0x0a, 0x03, 0x72, 0xb5, 0xc5, 0x58, 0xae, 0x96, 0x3c, 0xfe, 0x51, 0xaf, 0x23, 0x3f,
+	0xb9, 0xd5, 0xfd, 0x53, 0x8a, 0x0f, 0x25, 0x38, 0x2e, 0x24, 0x68, 0x1c, 0x7d, 0x78, 0x36, 0xae,
+	0x0f, 0xe2, 0xe0, 0x69, 0xdf, 0x92, 0x5c, 0x15, 0x1e, 0x83, 0xca, 0x6a, 0xa7, 0xd5, 0x0a, 0x5d,
+	0xaf, 0x33, 0x50, 0xe1, 0x81, 0x21, 0x16, 0x5b, 0x64, 0xd7, 0xe5, 0x24, 0x87, 0x91, 0x08, 0xa2,
+	0x76, 0x01, 0xaa, 0x1c, 0x85, 0x53, 0xad, 0x92, 0x00, 0x14, 0xfb, 0x0e, 0x6b, 0xab, 0x78, 0x5b,
+	0x5b, 0x80, 0x39, 0x1d, 0x35, 0x89, 0x26, 0x7a, 0x37, 0x2d, 0x67, 0x87, 0x2f, 0x43, 0xf2, 0x81,
+	0xf3, 0x71, 0x38, 0x9f, 0xeb, 0x49, 0x28, 0x1a, 0xa6, 0xe9, 0x21, 0x8c, 0x07, 0x8a, 0xe5, 0x0a,
+	0x1b, 0xa3, 0x07, 0x83, 0x23, 0x9c, 0xcb, 0x65, 0xe6, 0xdc, 0xf2, 0xc3, 0xec, 0x41, 0x4b, 0xe2,
+	0xf5, 0xb5, 0x52, 0x04, 0xf9, 0x8a, 0x6d, 0xcf, 0x1c, 0x51, 0x2a, 0x50, 0x5a, 0x73, 0x6e, 0xa1,
+	0x96, 0xeb, 0x75, 0x67, 0xa4, 0xe5, 0xe7, 0x61, 0x3a, 0x11, 0xf0, 0x56, 0x4a, 0x30, 0x71, 0xdb,
+	0x75, 0xd0, 0xcc, 0x11, 0x65, 0x06, 0x2a, 0x57, 0x2d, 0xc7, 0xf0, 0xba, 0xec, 0x12, 0x9a, 0x31,
+	0x95, 0x69, 0x98, 0xa4, 0xc6, 0x98, 0x03, 0xd0, 0xca, 0x47, 0x67, 0xa1, 0x7a, 0x8b, 0x12, 0xb5,
+	0x81, 0xbc, 0x5d, 0xab, 0x81, 0x94, 0x37, 0x61, 0x2a, 0x5e, 0x08, 0xab, 0x88, 0x0f, 0xb3, 0xb0,
+	0x5a, 0x56, 0x1d, 0xb4, 0x45, 0xed, 0x88, 0xf2, 0xef, 0x50, 0x89, 0x56, 0xc0, 0x2a, 0xe2, 0xd7,
+	0xe7, 0x82, 0x22, 0xd9, 0x61, 0x13, 0x6f, 0x43, 0x35, 0x56, 0xae, 0xaa, 0x3c, 0x24, 0x9c, 0x59,
+	0x54, 0x1c, 0xab, 0x2e, 0x67, 0x19, 0xca, 0x55, 0xe7, 0x88, 0xb2, 0x01, 0xd0, 0x2b, 0x4c, 0x55,
+	0x1e, 0x1c, 0xc0, 0x9b, 0x48, 0xe5, 0xea, 0x30, 0xf2, 0x5f, 0x81, 0x72, 0x58, 0xe3, 0xa9, 0x9c,
+	0x1b, 0x50, 0xea, 0xd7, 0x2b, 0xa7, 0x1c, 0x36, 0xe5, 0x06, 0x40, 0xaf, 0x04, 0x33, 0x85, 0xce,
+	0xbe, 0x1a, 0xcd, 0x61, 0x93, 0xd6, 0x01, 0x7a, 0x05, 0x86, 0x29, 0x93, 0xf6, 0x15, 0x51, 0xaa,
+	0xe7, 0x87, 0x8e, 0x0b, 0xb9, 0x5b, 0x87, 0x99, 0x64, 0xf9, 0xa0, 0xf2, 0xf0, 0x00, 0x1e, 0xf7,
+	0x15, 0xc0, 0x0c, 0xdb, 0xc1, 0x9b, 0x30, 0x15, 0x2f, 0xec, 0x4b, 0x51, 0x6f, 0x61, 0xf5, 0xdf,
+	0x70, 0xf6, 0x54, 0x63, 0x75, 0x7a, 0x29, 0x5a, 0x28, 0xaa, 0xe5, 0x53, 0xc5, 0xee, 0x63, 0xb4,
+	0x96, 0x8e, 0x51, 0x1f, 0x2f, 0x57, 0x4a, 0xa1, 0x5e, 0x58, 0xd3, 0x34, 0x8c, 0x7a, 0x03, 0x66,
+	0xf9, 0x63, 0xe0, 0xc8, 0xfc, 0x8f, 0xa4, 0x28, 0x8e, 0xb8, 0xfc, 0x68, 0xd8, 0x12, 0x7b, 0xa0,
+	0xf4, 0xd7, 0xa3, 0x29, 0x17, 0xc5, 0x12, 0x48, 0xab, 0xc6, 0x53, 0x2f, 0x65, 0x1e, 0x1f, 0x32,
+	0xee, 0x3d, 0x09, 0x8e, 0xa5, 0xd4, 0x02, 0x29, 0x97, 0xc5, 0xe7, 0x6d, 0x60, 0x41, 0x93, 0xfa,
+	0xf8, 0x68, 0x48, 0x21, 0x21, 0x0e, 0x4c, 0x27, 0xcc, 0xbb, 0x72, 0x21, 0x4b, 0x09, 0x4e, 0xb0,
+	0xee, 0xc3, 0xd9, 0x06, 0x87, 0xeb, 0xfd, 0x1b, 0x94, 0x82, 0x5a, 0x18, 0x45, 0x9c, 0x30, 0x4d,
+	0x94, 0xca, 0x0c, 0x13, 0xe1, 0x6b, 0x30, 0x19, 0x29, 0x5e, 0x51, 0xce, 0x0f, 0x38, 0x9c, 0xd1,
+	0x4a, 0x8e, 0x0c, 0x16, 0x30, 0xac, 0x39, 0x49, 0xb1, 0x80, 0xc9, 0x9a, 0x94, 0x0c, 0x16, 0xb0,
+	0x57, 0x50, 0x92, 0x62, 0xac, 0xfa, 0x2a, 0x4e, 0x86, 0x4d, 0x4a, 0x02, 0x9b, 0xf1, 0x2a, 0x90,
+	0x14, 0xf9, 0x89, 0x6b, 0x45, 0x86, 0x4d, 0xff, 0x06, 0x54, 0x63, 0xe5, 0x1a, 0x29, 0x16, 0x44,
+	0x54, 0xd2, 0x31, 0x9c, 0xf2, 0x4a, 0xb4, 0xaa, 0x22, 0xe5, 0xee, 0x15, 0x14, 0x5e, 0x8c, 0x64,
+	0x9a, 0x42, 0x64, 0x3c, 0xc0, 0x34, 0xf5, 0xbd, 0x33, 0xcf, 0x6e, 0x9a, 0x22, 0xf3, 0x0f, 0x34,
+	0x4d, 0x23, 0x2f, 0xf1, 0x0e, 0x8b, 0x66, 0x0a, 0x1e, 0xe5, 0x2b, 0x2b, 0x69, 0x67, 0x3d, 0xbd,
+	0xfc, 0x40, 0xbd, 0x3c, 0x12, 0x4e, 0xc8, 0xc5, 0x1d, 0x98, 0x8a, 0x3f, 0x6b, 0x4f, 0xe1, 0xa2,
+	0xf0, 0xb5, 0xbe, 0x7a, 0x21, 0xd3, 0xd8, 0x70, 0xb1, 0xf0, 0x28, 0xb3, 0x27, 0x22, 0x83, 0x8e,
+	0x72, 0xf4, 0x75, 0x5a, 0x06, 0x5f, 0x2c, 0xf6, 0x64, 0x34, 0x4d, 0x87, 0x05, 0x2f, 0x79, 0xd5,
+	0xe5, 0x2c, 0x43, 0xc3, 0x0d, 0x6c, 0x43, 0x35, 0xf6, 0x80, 0x2f, 0x65, 0x25, 0xd1, 0x7b, 0x45,
+	0x75, 0x39, 0xcb, 0xd0, 0x70, 0xa5, 0xb7, 0x23, 0x6f, 0x05, 0x63, 0xef, 0x31, 0x95, 0xc7, 0x06,
+	0xce, 0x23, 0x7a, 0x8e, 0xaa, 0xae, 0x8c, 0x82, 0x12, 0x92, 0xc0, 0x2d, 0x24, 0x63, 0x69, 0xba,
+	0x85, 0x1c, 0x45, 0x52, 0x1b, 0x50, 0x60, 0x6f, 0xf6, 0x14, 0x2d, 0xe5, 0xf1, 0x6d, 0xe4, 0xa5,
+	0x97, 0x7a, 0x56, 0x38, 0x26, 0xfe, 0x08, 0x8a, 0x4d, 0xca, 0x9e, 0xb8, 0xa5, 0x4c, 0x1a, 0x7b,
+	0xff, 0x36, 0xc2, 0xa4, 0xec, 0xd9, 0x59, 0xca, 0xa4, 0xb1, 0x37, 0x69, 0x59, 0x27, 0xd5, 0xa1,
+	0xc0, 0xb2, 0x7f, 0x4a, 0x86, 0xc7, 0x09, 0xea, 0xe0, 0x31, 0x2c, 0x65, 0x78, 0x44, 0xf9, 0x2f,
+	0xa8, 0x44, 0x1f, 0x6b, 0xa4, 0x59, 0xd9, 0xfe, 0xf7, 0x1c, 0x19, 0xe7, 0xff, 0x0f, 0x28, 0x05,
+	0xe9, 0xe1, 0x94, 0xfb, 0x3c, 0xf1, 0x3a, 0x40, 0x1d, 0x36, 0x2a, 0x98, 0x79, 0x1d, 0xf2, 0x34,
+	0xbf, 0xa7, 0x9c, 0x19, 0x94, 0xfb, 0x1b, 0x44, 0x6b, 0x2c, 0x3d, 0x48, 0x7d, 0x8f, 0x3c, 0xfd,
+	0xc5, 0x4f, 0x99, 0x31, 0x9a, 0x5e, 0x51, 0x07, 0x0e, 0x09, 0x48, 0x34, 0xa1, 0x12, 0x0d, 0x7d,
+	0xa6, 0x30, 0x57, 0x10, 0x1c, 0x56, 0xb3, 0x8c, 0x0c, 0x56, 0x21, 0xa7, 0x82, 0x66, 0x99, 0xd2,
+	0x4e, 0x45, 0x34, 0xf1, 0xa8, 0x9e, 0x1d, 0x38, 0x26, 0x6a, 0xd8, 0xe3, 0xb9, 0x32, 0x25, 0xdd,
+	0x00, 0xf5, 0x25, 0xed, 0xd4, 0x0b, 0x99, 0xc6, 0x86, 0x8b, 0x7d, 0x5e, 0x82, 0x5a, 0x5a, 0x9c,
+	0x4f, 0x49, 0xf5, 0x5c, 0x07, 0x05, 0x2b, 0xd5, 0x27, 0x46, 0xc4, 0x0a, 0x69, 0x79, 0x0b, 0xe6,
+	0x04, 0xd1, 0x25, 0xe5, 0x52, 0xda, 0x7c, 0x29, 0x81, 0x31, 0xf5, 0xd1, 0xec, 0x08, 0xe1, 0xda,
+	0xeb, 0x90, 0xa7, 0x51, 0xa1, 0x14, 0x05, 0x8c, 0x06, 0x99, 0x54, 0x6d, 0xd0, 0x90, 0x70, 0x46,
+	0x04, 0x95, 0x68, 0x88, 0x28, 0x45, 0x03, 0x05, 0xd1, 0x25, 0xf5, 0xa1, 0x0c, 0x23, 0x83, 0x65,
+	0x56, 0x3a, 0x50, 0x59, 0xf7, 0xdc, 0xbb, 0xdd, 0x20, 0x28, 0xf3, 0xb7, 0x59, 0xf6, 0xea, 0x13,
+	0xff, 0x79, 0xb9, 0x69, 0xf9, 0xdb, 0x9d, 0x4d, 0x72, 0x53, 0x5c, 0x62, 0x63, 0x1f, 0xb1, 0x5c,
+	0xfe, 0x75, 0xc9, 0x72, 0x7c, 0xe4, 0x39, 0x86, 0x7d, 0x89, 0xce, 0xc5, 0xa1, 0xed, 0xcd, 0xcd,
+	0x02, 0x6d, 0x5f, 0xfe, 0xeb, 0x00, 0xc4, 0x09, 0x38, 0x57, 0x83, 0x4d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4138,6 +5620,13 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MilvusServiceClient interface {
+	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GrantRole(ctx context.Context, in *GrantRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListGrants(ctx context.Context, in *ListGrantsRequest, opts ...grpc.CallOption) (*ListGrantsResponse, error)
 	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropCollection(ctx context.Context, in *DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	HasCollection(ctx context.Context, in *HasCollectionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
@@ -4146,6 +5635,10 @@ type MilvusServiceClient interface {
 	DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error)
 	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
 	ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error)
+	AddField(ctx context.Context, in *AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropAlias(ctx context.Context, in *DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AlterAlias(ctx context.Context, in *AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
@@ -4159,11 +5652,16 @@ type MilvusServiceClient interface {
 	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
 	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
+	HybridSearch(ctx context.Context, in *HybridSearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
 	Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (*RetrieveResults, error)
 	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error)
 	CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error)
+	Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error)
+	GetImportState(ctx context.Context, in *GetImportStateRequest, opts ...grpc.CallOption) (*GetImportStateResponse, error)
 	GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error)
 	GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error)
 	Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error)
@@ -4179,6 +5677,69 @@ func NewMilvusServiceClient(cc *grpc.ClientConn) MilvusServiceClient {
 	return &milvusServiceClient{cc}
 }
 
+func (c *milvusServiceClient) CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GrantRole(ctx context.Context, in *GrantRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GrantRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RevokeRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListGrants(ctx context.Context, in *ListGrantsRequest, opts ...grpc.CallOption) (*ListGrantsResponse, error) {
+	out := new(ListGrantsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCollection", in, out, opts...)
@@ -4251,6 +5812,42 @@ func (c *milvusServiceClient) ShowCollections(ctx context.Context, in *ShowColle
 	return out, nil
 }
 
+func (c *milvusServiceClient) AddField(ctx context.Context, in *AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AddField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropAlias(ctx context.Context, in *DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) AlterAlias(ctx context.Context, in *AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AlterAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreatePartition", in, out, opts...)
@@ -4368,6 +5965,24 @@ func (c *milvusServiceClient) Insert(ctx context.Context, in *InsertRequest, opt
 	return out, nil
 }
 
+func (c *milvusServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
 	out := new(SearchResults)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Search", in, out, opts...)
@@ -4377,6 +5992,15 @@ func (c *milvusServiceClient) Search(ctx context.Context, in *SearchRequest, opt
 	return out, nil
 }
 
+func (c *milvusServiceClient) HybridSearch(ctx context.Context, in *HybridSearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
+	out := new(SearchResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HybridSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (*RetrieveResults, error) {
 	out := new(RetrieveResults)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Retrieve", in, out, opts...)
@@ -4413,6 +6037,24 @@ func (c *milvusServiceClient) CalcDistance(ctx context.Context, in *CalcDistance
 	return out, nil
 }
 
+func (c *milvusServiceClient) Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetImportState(ctx context.Context, in *GetImportStateRequest, opts ...grpc.CallOption) (*GetImportStateResponse, error) {
+	out := new(GetImportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetImportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error) {
 	out := new(GetPersistentSegmentInfoResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetPersistentSegmentInfo", in, out, opts...)
@@ -4451,6 +6093,13 @@ func (c *milvusServiceClient) RegisterLink(ctx context.Context, in *RegisterLink
 
 // MilvusServiceServer is the server API for MilvusService service.
 type MilvusServiceServer interface {
+	CreateDatabase(context.Context, *CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *DropDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
+	CreateUser(context.Context, *CreateUserRequest) (*commonpb.Status, error)
+	GrantRole(context.Context, *GrantRoleRequest) (*commonpb.Status, error)
+	RevokeRole(context.Context, *RevokeRoleRequest) (*commonpb.Status, error)
+	ListGrants(context.Context, *ListGrantsRequest) (*ListGrantsResponse, error)
 	CreateCollection(context.Context, *CreateCollectionRequest) (*commonpb.Status, error)
 	DropCollection(context.Context, *DropCollectionRequest) (*commonpb.Status, error)
 	HasCollection(context.Context, *HasCollectionRequest) (*BoolResponse, error)
@@ -4459,6 +6108,10 @@ type MilvusServiceServer interface {
 	DescribeCollection(context.Context, *DescribeCollectionRequest) (*DescribeCollectionResponse, error)
 	GetCollectionStatistics(context.Context, *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error)
 	ShowCollections(context.Context, *ShowCollectionsRequest) (*ShowCollectionsResponse, error)
+	AddField(context.Context, *AddFieldRequest) (*commonpb.Status, error)
+	CreateAlias(context.Context, *CreateAliasRequest) (*commonpb.Status, error)
+	DropAlias(context.Context, *DropAliasRequest) (*commonpb.Status, error)
+	AlterAlias(context.Context, *AlterAliasRequest) (*commonpb.Status, error)
 	CreatePartition(context.Context, *CreatePartitionRequest) (*commonpb.Status, error)
 	DropPartition(context.Context, *DropPartitionRequest) (*commonpb.Status, error)
 	HasPartition(context.Context, *HasPartitionRequest) (*BoolResponse, error)
@@ -4472,11 +6125,16 @@ type MilvusServiceServer interface {
 	GetIndexBuildProgress(context.Context, *GetIndexBuildProgressRequest) (*GetIndexBuildProgressResponse, error)
 	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
 	Insert(context.Context, *InsertRequest) (*MutationResult, error)
+	Delete(context.Context, *DeleteRequest) (*MutationResult, error)
+	Upsert(context.Context, *UpsertRequest) (*MutationResult, error)
 	Search(context.Context, *SearchRequest) (*SearchResults, error)
+	HybridSearch(context.Context, *HybridSearchRequest) (*SearchResults, error)
 	Retrieve(context.Context, *RetrieveRequest) (*RetrieveResults, error)
 	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
 	Query(context.Context, *QueryRequest) (*QueryResults, error)
 	CalcDistance(context.Context, *CalcDistanceRequest) (*CalcDistanceResults, error)
+	Import(context.Context, *ImportRequest) (*ImportResponse, error)
+	GetImportState(context.Context, *GetImportStateRequest) (*GetImportStateResponse, error)
 	GetPersistentSegmentInfo(context.Context, *GetPersistentSegmentInfoRequest) (*GetPersistentSegmentInfoResponse, error)
 	GetQuerySegmentInfo(context.Context, *GetQuerySegmentInfoRequest) (*GetQuerySegmentInfoResponse, error)
 	Dummy(context.Context, *DummyRequest) (*DummyResponse, error)
@@ -4488,6 +6146,27 @@ type MilvusServiceServer interface {
 type UnimplementedMilvusServiceServer struct {
 }
 
+func (*UnimplementedMilvusServiceServer) CreateDatabase(ctx context.Context, req *CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropDatabase(ctx context.Context, req *DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListDatabases(ctx context.Context, req *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateUser(ctx context.Context, req *CreateUserRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GrantRole(ctx context.Context, req *GrantRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) RevokeRole(ctx context.Context, req *RevokeRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListGrants(ctx context.Context, req *ListGrantsRequest) (*ListGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGrants not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreateCollection(ctx context.Context, req *CreateCollectionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
 }
@@ -4512,6 +6191,18 @@ func (*UnimplementedMilvusServiceServer) GetCollectionStatistics(ctx context.Con
 func (*UnimplementedMilvusServiceServer) ShowCollections(ctx context.Context, req *ShowCollectionsRequest) (*ShowCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCollections not implemented")
 }
+func (*UnimplementedMilvusServiceServer) AddField(ctx context.Context, req *AddFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddField not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateAlias(ctx context.Context, req *CreateAliasRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlias not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropAlias(ctx context.Context, req *DropAliasRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropAlias not implemented")
+}
+func (*UnimplementedMilvusServiceServer) AlterAlias(ctx context.Context, req *AlterAliasRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterAlias not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreatePartition(ctx context.Context, req *CreatePartitionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePartition not implemented")
 }
@@ -4551,9 +6242,18 @@ func (*UnimplementedMilvusServiceServer) DropIndex(ctx context.Context, req *Dro
 func (*UnimplementedMilvusServiceServer) Insert(ctx context.Context, req *InsertRequest) (*MutationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
 }
+func (*UnimplementedMilvusServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*MutationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedMilvusServiceServer) Upsert(ctx context.Context, req *UpsertRequest) (*MutationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Search(ctx context.Context, req *SearchRequest) (*SearchResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (*UnimplementedMilvusServiceServer) HybridSearch(ctx context.Context, req *HybridSearchRequest) (*SearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HybridSearch not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Retrieve(ctx context.Context, req *RetrieveRequest) (*RetrieveResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Retrieve not implemented")
 }
@@ -4566,6 +6266,12 @@ func (*UnimplementedMilvusServiceServer) Query(ctx context.Context, req *QueryRe
 func (*UnimplementedMilvusServiceServer) CalcDistance(ctx context.Context, req *CalcDistanceRequest) (*CalcDistanceResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalcDistance not implemented")
 }
+func (*UnimplementedMilvusServiceServer) Import(ctx context.Context, req *ImportRequest) (*ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetImportState(ctx context.Context, req *GetImportStateRequest) (*GetImportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportState not implemented")
+}
 func (*UnimplementedMilvusServiceServer) GetPersistentSegmentInfo(ctx context.Context, req *GetPersistentSegmentInfoRequest) (*GetPersistentSegmentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPersistentSegmentInfo not implemented")
 }
@@ -4583,6 +6289,132 @@ func RegisterMilvusServiceServer(s *grpc.Server, srv MilvusServiceServer) {
 	s.RegisterService(&_MilvusService_serviceDesc, srv)
 }
 
+func _MilvusService_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropDatabase(ctx, req.(*DropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GrantRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GrantRole(ctx, req.(*GrantRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/RevokeRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).RevokeRole(ctx, req.(*RevokeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListGrants(ctx, req.(*ListGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCollectionRequest)
 	if err := dec(in); err != nil {
@@ -4727,6 +6559,78 @@ func _MilvusService_ShowCollections_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_AddField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).AddField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/AddField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).AddField(ctx, req.(*AddFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateAlias(ctx, req.(*CreateAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropAlias(ctx, req.(*DropAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_AlterAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).AlterAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/AlterAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).AlterAlias(ctx, req.(*AlterAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePartitionRequest)
 	if err := dec(in); err != nil {
@@ -4961,6 +6865,42 @@ func _MilvusService_Insert_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).Upsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/Upsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).Upsert(ctx, req.(*UpsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchRequest)
 	if err := dec(in); err != nil {
@@ -4979,6 +6919,24 @@ func _MilvusService_Search_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_HybridSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HybridSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).HybridSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/HybridSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).HybridSearch(ctx, req.(*HybridSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Retrieve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RetrieveRequest)
 	if err := dec(in); err != nil {
@@ -5051,6 +7009,42 @@ func _MilvusService_CalcDistance_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).Import(ctx, req.(*ImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetImportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetImportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetImportState(ctx, req.(*GetImportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_GetPersistentSegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPersistentSegmentInfoRequest)
 	if err := dec(in); err != nil {
@@ -5127,6 +7121,34 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.milvus.MilvusService",
 	HandlerType: (*MilvusServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _MilvusService_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _MilvusService_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _MilvusService_ListDatabases_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _MilvusService_CreateUser_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _MilvusService_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _MilvusService_RevokeRole_Handler,
+		},
+		{
+			MethodName: "ListGrants",
+			Handler:    _MilvusService_ListGrants_Handler,
+		},
 		{
 			MethodName: "CreateCollection",
 			Handler:    _MilvusService_CreateCollection_Handler,
@@ -5159,6 +7181,22 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowCollections",
 			Handler:    _MilvusService_ShowCollections_Handler,
 		},
+		{
+			MethodName: "AddField",
+			Handler:    _MilvusService_AddField_Handler,
+		},
+		{
+			MethodName: "CreateAlias",
+			Handler:    _MilvusService_CreateAlias_Handler,
+		},
+		{
+			MethodName: "DropAlias",
+			Handler:    _MilvusService_DropAlias_Handler,
+		},
+		{
+			MethodName: "AlterAlias",
+			Handler:    _MilvusService_AlterAlias_Handler,
+		},
 		{
 			MethodName: "CreatePartition",
 			Handler:    _MilvusService_CreatePartition_Handler,
@@ -5211,10 +7249,22 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Insert",
 			Handler:    _MilvusService_Insert_Handler,
 		},
+		{
+			MethodName: "Delete",
+			Handler:    _MilvusService_Delete_Handler,
+		},
+		{
+			MethodName: "Upsert",
+			Handler:    _MilvusService_Upsert_Handler,
+		},
 		{
 			MethodName: "Search",
 			Handler:    _MilvusService_Search_Handler,
 		},
+		{
+			MethodName: "HybridSearch",
+			Handler:    _MilvusService_HybridSearch_Handler,
+		},
 		{
 			MethodName: "Retrieve",
 			Handler:    _MilvusService_Retrieve_Handler,
@@ -5231,6 +7281,14 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CalcDistance",
 			Handler:    _MilvusService_CalcDistance_Handler,
 		},
+		{
+			MethodName: "Import",
+			Handler:    _MilvusService_Import_Handler,
+		},
+		{
+			MethodName: "GetImportState",
+			Handler:    _MilvusService_GetImportState_Handler,
+		},
 		{
 			MethodName: "GetPersistentSegmentInfo",
 			Handler:    _MilvusService_GetPersistentSegmentInfo_Handler,
@@ -119,6 +119,7 @@ type GenericValue struct {
 	//	*GenericValue_BoolVal
 	//	*GenericValue_Int64Val
 	//	*GenericValue_FloatVal
+	//	*GenericValue_StringVal
 	Val                  isGenericValue_Val `protobuf_oneof:"val"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -166,12 +167,18 @@ type GenericValue_FloatVal struct {
 	FloatVal float64 `protobuf:"fixed64,3,opt,name=float_val,json=floatVal,proto3,oneof"`
 }
 
+type GenericValue_StringVal struct {
+	StringVal string `protobuf:"bytes,4,opt,name=string_val,json=stringVal,proto3,oneof"`
+}
+
 func (*GenericValue_BoolVal) isGenericValue_Val() {}
 
 func (*GenericValue_Int64Val) isGenericValue_Val() {}
 
 func (*GenericValue_FloatVal) isGenericValue_Val() {}
 
+func (*GenericValue_StringVal) isGenericValue_Val() {}
+
 func (m *GenericValue) GetVal() isGenericValue_Val {
 	if m != nil {
 		return m.Val
@@ -200,19 +207,33 @@ func (m *GenericValue) GetFloatVal() float64 {
 	return 0
 }
 
+func (m *GenericValue) GetStringVal() string {
+	if x, ok := m.GetVal().(*GenericValue_StringVal); ok {
+		return x.StringVal
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*GenericValue) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*GenericValue_BoolVal)(nil),
 		(*GenericValue_Int64Val)(nil),
 		(*GenericValue_FloatVal)(nil),
+		(*GenericValue_StringVal)(nil),
 	}
 }
 
 type QueryInfo struct {
-	Topk                 int64    `protobuf:"varint,1,opt,name=topk,proto3" json:"topk,omitempty"`
-	MetricType           string   `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
-	SearchParams         string   `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	Topk         int64  `protobuf:"varint,1,opt,name=topk,proto3" json:"topk,omitempty"`
+	MetricType   string `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	SearchParams string `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	// range search returns at most topk hits within the distance range, which is (radius, range_filter]
+	// for IP and [range_filter, radius) for the other metrics, range_filter is unbounded if not set
+	RangeSearch          bool     `protobuf:"varint,5,opt,name=range_search,json=rangeSearch,proto3" json:"range_search,omitempty"`
+	Radius               float32  `protobuf:"fixed32,6,opt,name=radius,proto3" json:"radius,omitempty"`
+	HasRangeFilter       bool     `protobuf:"varint,7,opt,name=has_range_filter,json=hasRangeFilter,proto3" json:"has_range_filter,omitempty"`
+	RangeFilter          float32  `protobuf:"fixed32,8,opt,name=range_filter,json=rangeFilter,proto3" json:"range_filter,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -264,6 +285,34 @@ func (m *QueryInfo) GetSearchParams() string {
 	return ""
 }
 
+func (m *QueryInfo) GetRangeSearch() bool {
+	if m != nil {
+		return m.RangeSearch
+	}
+	return false
+}
+
+func (m *QueryInfo) GetRadius() float32 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *QueryInfo) GetHasRangeFilter() bool {
+	if m != nil {
+		return m.HasRangeFilter
+	}
+	return false
+}
+
+func (m *QueryInfo) GetRangeFilter() float32 {
+	if m != nil {
+		return m.RangeFilter
+	}
+	return 0
+}
+
 type ColumnInfo struct {
 	FieldId              int64             `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
 	DataType             schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
@@ -804,61 +853,66 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 893 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xee, 0x3a, 0xf6, 0xee, 0xb3, 0xeb, 0x9a, 0xb9, 0x60, 0x08, 0x55, 0xac, 0x6d, 0x05,
-	0x96, 0x50, 0x1d, 0xe1, 0x96, 0x54, 0x2a, 0x2a, 0x22, 0xa1, 0xa5, 0x89, 0xa8, 0x9c, 0xb0, 0x84,
-	0x1c, 0xb8, 0xac, 0xc6, 0xbb, 0x63, 0x7b, 0xd4, 0xf1, 0xcc, 0x64, 0x76, 0xd6, 0xaa, 0x2f, 0x5c,
-	0xb8, 0x71, 0xe3, 0x4b, 0x70, 0xe1, 0x0b, 0x71, 0xe7, 0x8b, 0xa0, 0x99, 0xd9, 0xd8, 0x31, 0x72,
-	0x82, 0x90, 0x7a, 0x7b, 0x7f, 0xe7, 0xfd, 0x7e, 0xef, 0xbd, 0x79, 0x00, 0x92, 0x61, 0x3e, 0x90,
-	0x4a, 0x68, 0x81, 0x3e, 0x98, 0x53, 0xb6, 0x28, 0x0b, 0xa7, 0x0d, 0x8c, 0xe3, 0xe3, 0x56, 0x91,
-	0xcd, 0xc8, 0x1c, 0x3b, 0x53, 0x2c, 0xa1, 0xf5, 0x9a, 0x70, 0xa2, 0x68, 0x76, 0x89, 0x59, 0x49,
-	0xd0, 0x1e, 0x84, 0x63, 0x21, 0x58, 0xba, 0xc0, 0xac, 0xeb, 0xf5, 0xbc, 0x7e, 0x78, 0xb2, 0x93,
-	0x34, 0x8c, 0xe5, 0x12, 0x33, 0xf4, 0x00, 0x22, 0xca, 0xf5, 0xe1, 0x53, 0xeb, 0xf5, 0x7b, 0x5e,
-	0x3f, 0x38, 0xd9, 0x49, 0x42, 0x6b, 0xaa, 0xdc, 0x13, 0x26, 0xb0, 0xb6, 0xee, 0xa0, 0xe7, 0xf5,
-	0x3d, 0xe3, 0xb6, 0xa6, 0x4b, 0xcc, 0x8e, 0x77, 0x21, 0x58, 0x60, 0x16, 0x13, 0x88, 0x7e, 0x28,
-	0x89, 0x5a, 0x9e, 0xf2, 0x89, 0x40, 0x08, 0x6a, 0x5a, 0xc8, 0xb7, 0xb6, 0x54, 0x90, 0x58, 0x19,
-	0xed, 0x43, 0x73, 0x4e, 0xb4, 0xa2, 0x59, 0xaa, 0x97, 0x92, 0xd8, 0x87, 0xa2, 0x04, 0x9c, 0xe9,
-	0x62, 0x29, 0x09, 0x7a, 0x08, 0xf7, 0x0a, 0x82, 0x55, 0x36, 0x4b, 0x25, 0x56, 0x78, 0x5e, 0x74,
-	0x6b, 0x36, 0xa4, 0xe5, 0x8c, 0xe7, 0xd6, 0x16, 0xff, 0xe1, 0x01, 0x7c, 0x2b, 0x58, 0x39, 0xe7,
-	0xb6, 0xd0, 0x47, 0x10, 0x4e, 0x28, 0x61, 0x79, 0x4a, 0xf3, 0xaa, 0x58, 0xc3, 0xea, 0xa7, 0x39,
-	0x7a, 0x0e, 0x51, 0x8e, 0x35, 0x76, 0xd5, 0x0c, 0xab, 0xf6, 0xf0, 0xc1, 0x60, 0xa3, 0x6f, 0x55,
-	0xc7, 0x5e, 0x62, 0x8d, 0x0d, 0x80, 0x24, 0xcc, 0x2b, 0x09, 0x3d, 0x82, 0x36, 0x2d, 0x52, 0xa9,
-	0xe8, 0x1c, 0xab, 0x65, 0xfa, 0x96, 0x2c, 0x2d, 0xdc, 0x30, 0x69, 0xd1, 0xe2, 0xdc, 0x19, 0xbf,
-	0x27, 0x4b, 0xb4, 0x07, 0x11, 0x2d, 0x52, 0x5c, 0x6a, 0x71, 0xfa, 0xd2, 0x82, 0x0d, 0x93, 0x90,
-	0x16, 0x47, 0x56, 0x8f, 0xff, 0xf4, 0x21, 0x4a, 0x30, 0x9f, 0x92, 0x57, 0xef, 0xa4, 0x42, 0x5f,
-	0x43, 0x33, 0xb3, 0xa8, 0x53, 0xca, 0x27, 0xc2, 0x42, 0x6d, 0xfe, 0x1b, 0x8e, 0x9d, 0xef, 0x9a,
-	0x5b, 0x02, 0xd9, 0x9a, 0xe7, 0x97, 0x10, 0x08, 0x59, 0x74, 0xfd, 0x5e, 0xd0, 0x6f, 0x0f, 0x1f,
-	0x6e, 0xc9, 0x5b, 0x95, 0x1a, 0x9c, 0x49, 0x4b, 0xc6, 0xc4, 0xa3, 0x67, 0x50, 0x5f, 0x98, 0xf9,
-	0x17, 0xdd, 0xa0, 0x17, 0xf4, 0x9b, 0xc3, 0xfd, 0x2d, 0x99, 0x37, 0xf7, 0x24, 0xa9, 0xc2, 0x63,
-	0x0e, 0x75, 0xf7, 0x0e, 0x6a, 0x42, 0xe3, 0x94, 0x2f, 0x30, 0xa3, 0x79, 0x67, 0x07, 0xdd, 0x87,
-	0xe6, 0x6b, 0x45, 0xb0, 0x26, 0xea, 0x62, 0x86, 0x79, 0xc7, 0x43, 0x1d, 0x68, 0x55, 0x86, 0x57,
-	0x57, 0x25, 0x66, 0x1d, 0x1f, 0xb5, 0x20, 0x7c, 0x43, 0x8a, 0xc2, 0xfa, 0x03, 0x74, 0x0f, 0x22,
-	0xa3, 0x39, 0x67, 0x0d, 0x45, 0xb0, 0xeb, 0xc4, 0x5d, 0x13, 0x37, 0x12, 0xda, 0x69, 0xf5, 0xf8,
-	0x57, 0x0f, 0xc2, 0x0b, 0xa2, 0xe6, 0xef, 0xa5, 0x59, 0x6b, 0xd6, 0xfe, 0xff, 0x63, 0xfd, 0xbb,
-	0x07, 0xd1, 0x4f, 0x1c, 0xab, 0xa5, 0x85, 0xf1, 0x14, 0x7c, 0x21, 0x6d, 0xf5, 0xf6, 0xf0, 0xd1,
-	0x96, 0x27, 0x56, 0x91, 0x4e, 0x3a, 0x93, 0x89, 0x2f, 0x24, 0x7a, 0x0c, 0xbb, 0xd9, 0x8c, 0xb2,
-	0xdc, 0xae, 0x5c, 0x73, 0xf8, 0xe1, 0x96, 0x44, 0x93, 0x93, 0xb8, 0xa8, 0x78, 0x1f, 0x1a, 0x55,
-	0xf6, 0x66, 0xa7, 0x1b, 0x10, 0x8c, 0x84, 0xee, 0x78, 0xf1, 0x5f, 0x1e, 0xc0, 0x31, 0x5d, 0x81,
-	0x3a, 0xbc, 0x01, 0xea, 0xd3, 0x2d, 0x6f, 0xaf, 0x43, 0x2b, 0xb1, 0x82, 0xf5, 0x39, 0xd4, 0x18,
-	0x99, 0xe8, 0xff, 0x42, 0x65, 0x83, 0x0c, 0x07, 0x45, 0xa7, 0x33, 0x6d, 0xb7, 0xfe, 0x2e, 0x0e,
-	0x36, 0x2a, 0x3e, 0x84, 0xf0, 0xba, 0xd6, 0x26, 0x89, 0x36, 0xc0, 0x1b, 0x31, 0xa5, 0x19, 0x66,
-	0x47, 0x3c, 0xef, 0x78, 0x76, 0x1b, 0x9c, 0x7e, 0xa6, 0x3a, 0x7e, 0xfc, 0x9b, 0x0f, 0x35, 0x4b,
-	0xea, 0x05, 0x80, 0x32, 0xfb, 0x9b, 0x92, 0x77, 0x52, 0x55, 0xf3, 0xfe, 0xe4, 0xae, 0x25, 0x3f,
-	0xd9, 0x49, 0x22, 0xb5, 0xfa, 0x5c, 0xcf, 0x21, 0xd2, 0x44, 0xcd, 0x5d, 0xb6, 0x23, 0xb8, 0xb7,
-	0x25, 0xfb, 0x7a, 0xbf, 0xcc, 0xf5, 0xd2, 0xd7, 0xbb, 0xf6, 0x02, 0xa0, 0x34, 0xd0, 0x5d, 0x72,
-	0x70, 0x6b, 0xe9, 0xd5, 0xb0, 0x4d, 0xe9, 0x72, 0x35, 0x8e, 0x6f, 0xa0, 0x39, 0xa6, 0xeb, 0xfc,
-	0xda, 0xad, 0xab, 0xba, 0x9e, 0xcb, 0xc9, 0x4e, 0x02, 0xe3, 0x95, 0x76, 0x5c, 0x87, 0x9a, 0x49,
-	0x8d, 0xff, 0xf6, 0x00, 0x2e, 0x49, 0xa6, 0x85, 0x3a, 0x1a, 0x8d, 0x7e, 0xac, 0x6e, 0x8b, 0x8b,
-	0x73, 0x17, 0xdb, 0xdc, 0x16, 0xf7, 0xca, 0xc6, 0xd5, 0xf3, 0x37, 0xaf, 0xde, 0x33, 0x00, 0xa9,
-	0x48, 0x4e, 0x33, 0xac, 0xed, 0xaf, 0xbf, 0x73, 0x7e, 0x37, 0x42, 0xd1, 0x57, 0x00, 0x57, 0xe6,
-	0x7e, 0xbb, 0x3f, 0x57, 0xbb, 0xb5, 0x11, 0xab, 0x23, 0x9f, 0x44, 0x57, 0xab, 0x7b, 0xff, 0x19,
-	0xdc, 0x97, 0x0c, 0x67, 0x64, 0x26, 0x58, 0x4e, 0x54, 0xaa, 0xf1, 0xb4, 0xbb, 0x6b, 0x8f, 0x77,
-	0xfb, 0x86, 0xf9, 0x02, 0x4f, 0xe3, 0x5f, 0x20, 0x3c, 0x67, 0x98, 0x8f, 0x44, 0x4e, 0x4c, 0xef,
-	0x16, 0x96, 0x70, 0x8a, 0x39, 0x2f, 0xee, 0xf8, 0xe6, 0xeb, 0xb6, 0x98, 0xde, 0xb9, 0x9c, 0x23,
-	0xce, 0x0b, 0xd4, 0x87, 0x8e, 0x28, 0xb5, 0x2c, 0x75, 0x7a, 0xdd, 0x0e, 0xf7, 0xe5, 0x83, 0xa4,
-	0xed, 0xec, 0xdf, 0xb9, 0xae, 0x14, 0xa6, 0xcb, 0x5c, 0xe4, 0xe4, 0xf8, 0xc9, 0xcf, 0x5f, 0x4c,
-	0xa9, 0x9e, 0x95, 0xe3, 0x41, 0x26, 0xe6, 0x07, 0xae, 0xd4, 0x63, 0x2a, 0x2a, 0xe9, 0x80, 0x72,
-	0x4d, 0x14, 0xc7, 0xec, 0xc0, 0x56, 0x3f, 0x30, 0xd5, 0xe5, 0x78, 0x5c, 0xb7, 0xda, 0x93, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xd5, 0x7c, 0x0d, 0xc8, 0x82, 0x07, 0x00, 0x00,
+	// 971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x23, 0x35,
+	0x14, 0xef, 0xcc, 0xa4, 0xc9, 0xcc, 0x9b, 0x6c, 0x36, 0xf8, 0x00, 0x81, 0xb2, 0x6a, 0x98, 0x5d,
+	0x41, 0x24, 0xb4, 0xad, 0xe8, 0x2e, 0x5d, 0x09, 0x04, 0xa2, 0x65, 0xff, 0xb4, 0x62, 0xd5, 0x16,
+	0x6f, 0xe9, 0x81, 0xcb, 0xc8, 0x99, 0x71, 0x12, 0x6b, 0x1d, 0x7b, 0xea, 0xf1, 0x44, 0x9b, 0x0b,
+	0x17, 0x6e, 0xdc, 0xf6, 0x4b, 0x70, 0xe1, 0x0b, 0x71, 0xe7, 0xcc, 0x77, 0x40, 0xb6, 0x27, 0x49,
+	0x8b, 0xd2, 0x22, 0xa4, 0xbd, 0xbd, 0xf7, 0x7b, 0xef, 0xf9, 0xbd, 0xdf, 0xf3, 0xf3, 0x33, 0x40,
+	0xc1, 0x89, 0xd8, 0x29, 0x94, 0xd4, 0x12, 0xbd, 0x37, 0x65, 0x7c, 0x56, 0x95, 0x4e, 0xdb, 0x31,
+	0x86, 0x8f, 0xda, 0x65, 0x36, 0xa1, 0x53, 0xe2, 0xa0, 0xe4, 0xad, 0x07, 0xed, 0x17, 0x54, 0x50,
+	0xc5, 0xb2, 0x0b, 0xc2, 0x2b, 0x8a, 0xb6, 0x20, 0x1c, 0x4a, 0xc9, 0xd3, 0x19, 0xe1, 0x3d, 0xaf,
+	0xef, 0x0d, 0xc2, 0xa3, 0x0d, 0xdc, 0x32, 0xc8, 0x05, 0xe1, 0xe8, 0x1e, 0x44, 0x4c, 0xe8, 0xfd,
+	0xc7, 0xd6, 0xea, 0xf7, 0xbd, 0x41, 0x70, 0xb4, 0x81, 0x43, 0x0b, 0xd5, 0xe6, 0x11, 0x97, 0x44,
+	0x5b, 0x73, 0xd0, 0xf7, 0x06, 0x9e, 0x31, 0x5b, 0xc8, 0x98, 0xb7, 0x01, 0x4a, 0xad, 0x98, 0x18,
+	0x5b, 0x7b, 0xa3, 0xef, 0x0d, 0xa2, 0xa3, 0x0d, 0x1c, 0x39, 0xec, 0x82, 0xf0, 0xc3, 0x4d, 0x08,
+	0x66, 0x84, 0x27, 0x7f, 0x7b, 0x10, 0xfd, 0x58, 0x51, 0x35, 0x3f, 0x16, 0x23, 0x89, 0x10, 0x34,
+	0xb4, 0x2c, 0x5e, 0xdb, 0x62, 0x02, 0x6c, 0x65, 0xb4, 0x0d, 0xf1, 0x94, 0x6a, 0xc5, 0xb2, 0x54,
+	0xcf, 0x0b, 0x6a, 0x53, 0x45, 0x18, 0x1c, 0x74, 0x3e, 0x2f, 0x28, 0xba, 0x0f, 0x77, 0x4a, 0x4a,
+	0x54, 0x36, 0x49, 0x0b, 0xa2, 0xc8, 0xb4, 0x74, 0xd9, 0x70, 0xdb, 0x81, 0x67, 0x16, 0x43, 0x9f,
+	0x40, 0x5b, 0x11, 0x31, 0xa6, 0xa9, 0x43, 0x7b, 0x9b, 0x86, 0x2e, 0x8e, 0x2d, 0xf6, 0xca, 0x42,
+	0xe8, 0x7d, 0x68, 0x2a, 0x92, 0xb3, 0xaa, 0xec, 0x35, 0xfb, 0xde, 0xc0, 0xc7, 0xb5, 0x86, 0x06,
+	0xd0, 0x9d, 0x90, 0x32, 0x75, 0xe1, 0x23, 0xc6, 0x35, 0x55, 0xbd, 0x96, 0x0d, 0xef, 0x4c, 0x48,
+	0x89, 0x0d, 0xfc, 0xdc, 0xa2, 0xab, 0x24, 0xb5, 0x57, 0x68, 0xcf, 0x89, 0xd5, 0xca, 0x25, 0xf9,
+	0xdd, 0x03, 0xf8, 0x5e, 0xf2, 0x6a, 0x2a, 0x2c, 0xe1, 0x0f, 0x21, 0x1c, 0x31, 0xca, 0xf3, 0x94,
+	0xe5, 0x35, 0xe9, 0x96, 0xd5, 0x8f, 0x73, 0xf4, 0x15, 0x44, 0x39, 0xd1, 0xc4, 0xb1, 0x36, 0xfd,
+	0xef, 0xec, 0xdd, 0xdb, 0xb9, 0x76, 0xc5, 0xf5, 0xe5, 0x3e, 0x25, 0x9a, 0x98, 0x46, 0xe0, 0x30,
+	0xaf, 0x25, 0xf4, 0x00, 0x3a, 0xac, 0x4c, 0x0b, 0xc5, 0xa6, 0x44, 0xcd, 0xd3, 0xd7, 0x74, 0x6e,
+	0xdb, 0x16, 0xe2, 0x36, 0x2b, 0xcf, 0x1c, 0xf8, 0x03, 0x9d, 0xa3, 0x2d, 0x88, 0x58, 0x99, 0x92,
+	0x4a, 0xcb, 0xe3, 0xa7, 0xb6, 0x69, 0x21, 0x0e, 0x59, 0x79, 0x60, 0xf5, 0xe4, 0x0f, 0x1f, 0x22,
+	0xcb, 0xed, 0xd9, 0x9b, 0x42, 0xa1, 0x6f, 0x21, 0xce, 0x6c, 0xd5, 0x29, 0x13, 0x23, 0x69, 0x4b,
+	0x8d, 0xff, 0x5d, 0x8e, 0x1d, 0xc5, 0x15, 0x37, 0x0c, 0xd9, 0x8a, 0xe7, 0x97, 0x10, 0xc8, 0xa2,
+	0xec, 0xf9, 0xfd, 0x60, 0xd0, 0xd9, 0xbb, 0xbf, 0x26, 0x6e, 0x99, 0x6a, 0xe7, 0xb4, 0xb0, 0x64,
+	0x8c, 0x3f, 0x7a, 0x02, 0xcd, 0x99, 0x99, 0xd4, 0xb2, 0x17, 0xf4, 0x83, 0x41, 0xbc, 0xb7, 0xbd,
+	0x26, 0xf2, 0xea, 0x44, 0xe3, 0xda, 0x3d, 0x11, 0xd0, 0x74, 0xe7, 0xa0, 0x18, 0x5a, 0xc7, 0x62,
+	0x46, 0x38, 0xcb, 0xbb, 0x1b, 0xe8, 0x2e, 0xc4, 0x2f, 0x14, 0x25, 0x9a, 0xaa, 0xf3, 0x09, 0x11,
+	0x5d, 0x0f, 0x75, 0xa1, 0x5d, 0x03, 0xcf, 0x2e, 0x2b, 0xc2, 0xbb, 0x3e, 0x6a, 0x43, 0xf8, 0x92,
+	0x96, 0xa5, 0xb5, 0x07, 0xe8, 0x0e, 0x44, 0x46, 0x73, 0xc6, 0x06, 0x8a, 0x60, 0xd3, 0x89, 0x9b,
+	0xc6, 0xef, 0x44, 0x6a, 0xa7, 0x35, 0x93, 0x5f, 0x3d, 0x08, 0xcf, 0xa9, 0x9a, 0xbe, 0x93, 0x66,
+	0xad, 0x58, 0xfb, 0xff, 0x8f, 0xf5, 0x5b, 0x0f, 0xa2, 0x9f, 0x04, 0x51, 0x73, 0x5b, 0xc6, 0x63,
+	0xf0, 0x65, 0x61, 0xb3, 0x77, 0xf6, 0x1e, 0xac, 0x39, 0x62, 0xe9, 0xe9, 0xa4, 0xd3, 0x02, 0xfb,
+	0xb2, 0x40, 0x0f, 0x61, 0x33, 0x9b, 0x30, 0x9e, 0xdb, 0x91, 0x8b, 0xf7, 0x3e, 0x58, 0x13, 0x68,
+	0x62, 0xb0, 0xf3, 0x4a, 0xb6, 0xa1, 0x55, 0x47, 0x5f, 0xef, 0x74, 0x0b, 0x82, 0x13, 0xa9, 0xbb,
+	0x5e, 0xf2, 0xa7, 0x07, 0x70, 0xc8, 0x96, 0x45, 0xed, 0x5f, 0x29, 0xea, 0xd3, 0x35, 0x67, 0xaf,
+	0x5c, 0x6b, 0xb1, 0x2e, 0xeb, 0x73, 0x68, 0x70, 0x3a, 0xd2, 0xff, 0x55, 0x95, 0x75, 0x32, 0x1c,
+	0x14, 0x1b, 0x4f, 0x74, 0x2f, 0xb8, 0xdd, 0xdb, 0x79, 0x25, 0xfb, 0x10, 0x1e, 0xb2, 0x75, 0x24,
+	0x3a, 0x00, 0x2f, 0xe5, 0x98, 0x65, 0x84, 0x1f, 0x88, 0xbc, 0xeb, 0xd9, 0x69, 0x70, 0xfa, 0xa9,
+	0xea, 0xfa, 0xc9, 0x6f, 0x3e, 0x34, 0x2c, 0xa9, 0x6f, 0x00, 0xdc, 0xbb, 0xa7, 0x6f, 0x0a, 0x55,
+	0xdf, 0xf7, 0xc7, 0xb7, 0x0d, 0xb9, 0x59, 0x85, 0x6a, 0xa1, 0x98, 0x97, 0xae, 0xa9, 0x9a, 0xba,
+	0x68, 0x47, 0x70, 0x6b, 0x4d, 0xf4, 0x62, 0xbe, 0xcc, 0x9e, 0xd5, 0xb5, 0x6c, 0x52, 0x57, 0xa6,
+	0x74, 0x17, 0x1c, 0xdc, 0x98, 0x7a, 0x79, 0xd9, 0x26, 0x75, 0xb5, 0x50, 0xd0, 0x77, 0x10, 0x0f,
+	0xd9, 0x2a, 0xbe, 0x71, 0xe3, 0xa8, 0xae, 0xee, 0xe5, 0x68, 0x03, 0xc3, 0x70, 0xa9, 0x1d, 0x36,
+	0xa1, 0x61, 0x42, 0x93, 0xbf, 0x3c, 0x80, 0x0b, 0x9a, 0x69, 0xa9, 0x0e, 0x4e, 0x4e, 0x5e, 0xd5,
+	0xbb, 0xc5, 0xf9, 0xf5, 0xbc, 0xc5, 0x6e, 0x71, 0xa7, 0x5c, 0xdb, 0x7a, 0xfe, 0xf5, 0xad, 0xf7,
+	0x04, 0xa0, 0x50, 0x34, 0x67, 0x19, 0xd1, 0xf6, 0xd5, 0xdf, 0x7a, 0x7f, 0x57, 0x5c, 0xd1, 0xd7,
+	0x00, 0x97, 0xe6, 0x1f, 0x71, 0x6f, 0xae, 0x71, 0x63, 0x23, 0x96, 0x9f, 0x0d, 0x8e, 0x2e, 0x17,
+	0x22, 0xfa, 0x0c, 0xee, 0x16, 0x9c, 0x64, 0x74, 0x22, 0x79, 0x4e, 0x55, 0xaa, 0xc9, 0xd8, 0x7e,
+	0x10, 0x11, 0xee, 0x5c, 0x81, 0xcf, 0xc9, 0x38, 0xf9, 0x05, 0xc2, 0x33, 0x4e, 0xc4, 0x89, 0xcc,
+	0xa9, 0xe9, 0xdd, 0xcc, 0x12, 0x4e, 0x89, 0x10, 0xe5, 0x2d, 0xcf, 0x7c, 0xd5, 0x16, 0xd3, 0x3b,
+	0x17, 0x73, 0x20, 0x84, 0xfd, 0x59, 0x64, 0xa5, 0x8b, 0x4a, 0xa7, 0x8b, 0x76, 0xb8, 0x27, 0x1f,
+	0xe0, 0x8e, 0xc3, 0x9f, 0xbb, 0xae, 0x94, 0xa6, 0xcb, 0x42, 0xe6, 0xf4, 0xf0, 0xd1, 0xcf, 0x5f,
+	0x8c, 0x99, 0x9e, 0x54, 0xc3, 0x9d, 0x4c, 0x4e, 0x77, 0x5d, 0xaa, 0x87, 0x4c, 0xd6, 0xd2, 0x2e,
+	0x13, 0x9a, 0x2a, 0x41, 0xf8, 0xae, 0xcd, 0xbe, 0x6b, 0xb2, 0x17, 0xc3, 0x61, 0xd3, 0x6a, 0x8f,
+	0xfe, 0x19, 0x00, 0x41, 0x33, 0xff, 0xc2, 0x2d, 0x08, 0x00, 0x00,
 }
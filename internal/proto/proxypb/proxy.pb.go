@@ -137,41 +137,92 @@ func (m *ReleaseDQLMessageStreamRequest) GetCollectionID() int64 {
 	return 0
 }
 
+type InvalidateCredCacheRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InvalidateCredCacheRequest) Reset()         { *m = InvalidateCredCacheRequest{} }
+func (m *InvalidateCredCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*InvalidateCredCacheRequest) ProtoMessage()    {}
+func (*InvalidateCredCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{2}
+}
+
+func (m *InvalidateCredCacheRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvalidateCredCacheRequest.Unmarshal(m, b)
+}
+func (m *InvalidateCredCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvalidateCredCacheRequest.Marshal(b, m, deterministic)
+}
+func (m *InvalidateCredCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidateCredCacheRequest.Merge(m, src)
+}
+func (m *InvalidateCredCacheRequest) XXX_Size() int {
+	return xxx_messageInfo_InvalidateCredCacheRequest.Size(m)
+}
+func (m *InvalidateCredCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidateCredCacheRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvalidateCredCacheRequest proto.InternalMessageInfo
+
+func (m *InvalidateCredCacheRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *InvalidateCredCacheRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*InvalidateCollMetaCacheRequest)(nil), "milvus.proto.proxy.InvalidateCollMetaCacheRequest")
 	proto.RegisterType((*ReleaseDQLMessageStreamRequest)(nil), "milvus.proto.proxy.ReleaseDQLMessageStreamRequest")
+	proto.RegisterType((*InvalidateCredCacheRequest)(nil), "milvus.proto.proxy.InvalidateCredCacheRequest")
 }
 
 func init() { proto.RegisterFile("proxy.proto", fileDescriptor_700b50b08ed8dbaf) }
 
 var fileDescriptor_700b50b08ed8dbaf = []byte{
-	// 413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xd1, 0x0a, 0xd3, 0x30,
-	0x14, 0x5d, 0xdd, 0x9c, 0x98, 0x95, 0x09, 0x41, 0xd8, 0xa8, 0x3a, 0x46, 0x05, 0x1d, 0x82, 0xeb,
-	0xa8, 0x7e, 0xc1, 0x5a, 0x18, 0x03, 0x27, 0xda, 0xbd, 0xf9, 0x22, 0x69, 0x7b, 0xe9, 0x02, 0x69,
-	0xd2, 0x35, 0xe9, 0xd0, 0x5f, 0xf0, 0xd9, 0x1f, 0xf1, 0x0f, 0xa5, 0x69, 0xb7, 0xd9, 0x6d, 0x9d,
-	0xe8, 0x5b, 0xcf, 0xcd, 0xb9, 0x9c, 0x73, 0x6e, 0x0f, 0x1a, 0x64, 0xb9, 0xf8, 0xf6, 0x7d, 0x9e,
-	0xe5, 0x42, 0x09, 0x8c, 0x53, 0xca, 0x0e, 0x85, 0xac, 0xd0, 0x5c, 0xbf, 0x58, 0x66, 0x24, 0xd2,
-	0x54, 0xf0, 0x6a, 0x66, 0x0d, 0x29, 0x57, 0x90, 0x73, 0xc2, 0x6a, 0x6c, 0xfe, 0xb9, 0x61, 0xff,
-	0x34, 0xd0, 0x64, 0xcd, 0x0f, 0x84, 0xd1, 0x98, 0x28, 0xf0, 0x04, 0x63, 0x1b, 0x50, 0xc4, 0x23,
-	0xd1, 0x0e, 0x02, 0xd8, 0x17, 0x20, 0x15, 0x5e, 0xa0, 0x5e, 0x48, 0x24, 0x8c, 0x8d, 0xa9, 0x31,
-	0x1b, 0xb8, 0xcf, 0xe7, 0x0d, 0xc5, 0x5a, 0x6a, 0x23, 0x93, 0x25, 0x91, 0x10, 0x68, 0x26, 0x1e,
-	0xa1, 0x47, 0x71, 0xf8, 0x95, 0x93, 0x14, 0xc6, 0x0f, 0xa6, 0xc6, 0xec, 0x71, 0xd0, 0x8f, 0xc3,
-	0x8f, 0x24, 0x05, 0xfc, 0x1a, 0x3d, 0x89, 0x04, 0x63, 0x10, 0x29, 0x2a, 0x78, 0x45, 0xe8, 0x6a,
-	0xc2, 0xf0, 0x3c, 0x2e, 0x89, 0xf6, 0x0f, 0x03, 0x4d, 0x02, 0x60, 0x40, 0x24, 0xf8, 0x9f, 0x3f,
-	0x6c, 0x40, 0x4a, 0x92, 0xc0, 0x56, 0xe5, 0x40, 0xd2, 0xff, 0xb7, 0x85, 0x51, 0x2f, 0x0e, 0xd7,
-	0xbe, 0xf6, 0xd4, 0x0d, 0xf4, 0x37, 0xb6, 0x91, 0x79, 0x96, 0x5e, 0xfb, 0xda, 0x4e, 0x37, 0x68,
-	0xcc, 0xdc, 0x5f, 0x3d, 0xf4, 0xf0, 0x53, 0x79, 0x59, 0x9c, 0x21, 0xbc, 0x02, 0xe5, 0x89, 0x34,
-	0x13, 0x1c, 0xb8, 0xda, 0x2a, 0xa2, 0x40, 0xe2, 0x45, 0x53, 0xfb, 0x74, 0xef, 0x6b, 0x6a, 0xed,
-	0xdd, 0x7a, 0xd5, 0xb2, 0x71, 0x41, 0xb7, 0x3b, 0x78, 0x8f, 0x9e, 0xae, 0x40, 0x43, 0x2a, 0x15,
-	0x8d, 0xa4, 0xb7, 0x23, 0x9c, 0x03, 0xc3, 0x6e, 0xbb, 0xe6, 0x15, 0xf9, 0xa8, 0xfa, 0xb2, 0xb9,
-	0x53, 0x83, 0xad, 0xca, 0x29, 0x4f, 0x02, 0x90, 0x99, 0xe0, 0x12, 0xec, 0x0e, 0xce, 0xd1, 0x8b,
-	0x66, 0x23, 0xaa, 0x43, 0x9c, 0x7a, 0x71, 0xa9, 0x5d, 0xd5, 0xf1, 0x7e, 0x89, 0xac, 0x67, 0x37,
-	0xff, 0x4f, 0x69, 0xb5, 0x28, 0x63, 0x12, 0x64, 0xae, 0x40, 0xf9, 0xf1, 0x31, 0xde, 0x9b, 0xf6,
-	0x78, 0x27, 0xd2, 0x3f, 0xc6, 0x62, 0x68, 0xd4, 0xd2, 0xa8, 0xdb, 0x81, 0xee, 0xd7, 0xef, 0x2f,
-	0x81, 0x96, 0xef, 0xbf, 0xb8, 0x09, 0x55, 0xbb, 0x22, 0x2c, 0x5f, 0x9c, 0x8a, 0xfa, 0x96, 0x8a,
-	0xfa, 0xcb, 0x39, 0x06, 0x72, 0xf4, 0xb6, 0xa3, 0x15, 0xb3, 0x30, 0xec, 0x6b, 0xf8, 0xee, 0x77,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x46, 0xe1, 0x7c, 0xd9, 0xe3, 0x03, 0x00, 0x00,
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xd1, 0x6e, 0xd3, 0x40,
+	0x10, 0xac, 0x49, 0x5b, 0x60, 0x1b, 0x15, 0xe9, 0x84, 0xd4, 0x62, 0xa0, 0xaa, 0x8c, 0x04, 0x15,
+	0x12, 0x49, 0x15, 0xf8, 0x82, 0x26, 0x52, 0x14, 0x89, 0x20, 0x70, 0xde, 0x78, 0x41, 0x6b, 0x7b,
+	0x95, 0x5c, 0x75, 0xbe, 0x73, 0x7d, 0xeb, 0x0a, 0x7e, 0x81, 0x67, 0x5e, 0xf9, 0x57, 0xe4, 0xb3,
+	0x93, 0xc6, 0x69, 0xdd, 0x08, 0x78, 0xf3, 0xdc, 0xcd, 0x7a, 0x76, 0xe6, 0x06, 0x0e, 0xb2, 0xdc,
+	0x7c, 0xff, 0xd1, 0xcb, 0x72, 0xc3, 0x46, 0x88, 0x54, 0xaa, 0xeb, 0xc2, 0x56, 0xa8, 0xe7, 0x6e,
+	0xfc, 0x6e, 0x6c, 0xd2, 0xd4, 0xe8, 0xea, 0xcc, 0x3f, 0x94, 0x9a, 0x29, 0xd7, 0xa8, 0x6a, 0xdc,
+	0x5d, 0x9f, 0x08, 0x7e, 0x79, 0x70, 0x32, 0xd1, 0xd7, 0xa8, 0x64, 0x82, 0x4c, 0x43, 0xa3, 0xd4,
+	0x94, 0x18, 0x87, 0x18, 0x2f, 0x28, 0xa4, 0xab, 0x82, 0x2c, 0x8b, 0x73, 0xd8, 0x8d, 0xd0, 0xd2,
+	0xb1, 0x77, 0xea, 0x9d, 0x1d, 0x0c, 0x5e, 0xf4, 0x1a, 0x8a, 0xb5, 0xd4, 0xd4, 0xce, 0x2f, 0xd0,
+	0x52, 0xe8, 0x98, 0xe2, 0x08, 0x1e, 0x26, 0xd1, 0x37, 0x8d, 0x29, 0x1d, 0x3f, 0x38, 0xf5, 0xce,
+	0x1e, 0x87, 0xfb, 0x49, 0xf4, 0x09, 0x53, 0x12, 0x6f, 0xe0, 0x49, 0x6c, 0x94, 0xa2, 0x98, 0xa5,
+	0xd1, 0x15, 0xa1, 0xe3, 0x08, 0x87, 0x37, 0xc7, 0x25, 0x31, 0xf8, 0xe9, 0xc1, 0x49, 0x48, 0x8a,
+	0xd0, 0xd2, 0xe8, 0xcb, 0xc7, 0x29, 0x59, 0x8b, 0x73, 0x9a, 0x71, 0x4e, 0x98, 0xfe, 0xfb, 0x5a,
+	0x02, 0x76, 0x93, 0x68, 0x32, 0x72, 0x3b, 0x75, 0x42, 0xf7, 0x2d, 0x02, 0xe8, 0xde, 0x48, 0x4f,
+	0x46, 0x6e, 0x9d, 0x4e, 0xd8, 0x38, 0x0b, 0x2e, 0xc1, 0x5f, 0x8b, 0x28, 0xa7, 0xe4, 0x3f, 0xe3,
+	0xf1, 0xe1, 0x51, 0x61, 0x29, 0x5f, 0xcb, 0x67, 0x85, 0x07, 0xbf, 0xf7, 0x60, 0xef, 0x73, 0xf9,
+	0x8a, 0x22, 0x03, 0x31, 0x26, 0x1e, 0x9a, 0x34, 0x33, 0x9a, 0x34, 0xcf, 0x18, 0x99, 0xac, 0x38,
+	0x6f, 0xfe, 0x7f, 0xf5, 0xb6, 0xb7, 0xa9, 0xf5, 0x7e, 0xfe, 0xeb, 0x96, 0x89, 0x0d, 0x7a, 0xb0,
+	0x23, 0xae, 0xe0, 0xe9, 0x98, 0x1c, 0x94, 0x96, 0x65, 0x6c, 0x87, 0x0b, 0xd4, 0x9a, 0x94, 0x18,
+	0xb4, 0x6b, 0xde, 0x22, 0x2f, 0x55, 0x5f, 0x35, 0x67, 0x6a, 0x30, 0xe3, 0x5c, 0xea, 0x79, 0x48,
+	0x36, 0x33, 0xda, 0x52, 0xb0, 0x23, 0x72, 0x78, 0xd9, 0x6c, 0x5f, 0x15, 0xfa, 0xaa, 0x83, 0x9b,
+	0xda, 0x55, 0xf5, 0xef, 0x2f, 0xac, 0xff, 0xfc, 0xce, 0x37, 0x28, 0x57, 0x2d, 0x4a, 0x9b, 0x08,
+	0xdd, 0x31, 0xf1, 0x28, 0x59, 0xda, 0x7b, 0xdb, 0x6e, 0x6f, 0x45, 0xfa, 0x4b, 0x5b, 0x0a, 0x8e,
+	0x5a, 0xda, 0x7b, 0xb7, 0xa1, 0xfb, 0xab, 0xbe, 0xcd, 0xd0, 0x25, 0x3c, 0x6b, 0xf6, 0x93, 0x34,
+	0x4b, 0x54, 0x55, 0x80, 0xbd, 0x2d, 0x01, 0x6e, 0xd4, 0x79, 0x8b, 0xd6, 0xc5, 0x87, 0xaf, 0x83,
+	0xb9, 0xe4, 0x45, 0x11, 0x95, 0x37, 0xfd, 0x8a, 0xfa, 0x4e, 0x9a, 0xfa, 0xab, 0xbf, 0x0c, 0xaf,
+	0xef, 0xa6, 0xfb, 0x4e, 0x2d, 0x8b, 0xa2, 0x7d, 0x07, 0xdf, 0xff, 0x19, 0x00, 0xa8, 0x44, 0x6e,
+	0x6f, 0xbb, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -191,6 +242,7 @@ type ProxyClient interface {
 	InvalidateCollectionMetaCache(ctx context.Context, in *InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetDdChannel(ctx context.Context, in *internalpb.GetDdChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	ReleaseDQLMessageStream(ctx context.Context, in *ReleaseDQLMessageStreamRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	InvalidateCredentialCache(ctx context.Context, in *InvalidateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type proxyClient struct {
@@ -246,6 +298,15 @@ func (c *proxyClient) ReleaseDQLMessageStream(ctx context.Context, in *ReleaseDQ
 	return out, nil
 }
 
+func (c *proxyClient) InvalidateCredentialCache(ctx context.Context, in *InvalidateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/InvalidateCredentialCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProxyServer is the server API for Proxy service.
 type ProxyServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -253,6 +314,7 @@ type ProxyServer interface {
 	InvalidateCollectionMetaCache(context.Context, *InvalidateCollMetaCacheRequest) (*commonpb.Status, error)
 	GetDdChannel(context.Context, *internalpb.GetDdChannelRequest) (*milvuspb.StringResponse, error)
 	ReleaseDQLMessageStream(context.Context, *ReleaseDQLMessageStreamRequest) (*commonpb.Status, error)
+	InvalidateCredentialCache(context.Context, *InvalidateCredCacheRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedProxyServer can be embedded to have forward compatible implementations.
@@ -274,6 +336,9 @@ func (*UnimplementedProxyServer) GetDdChannel(ctx context.Context, req *internal
 func (*UnimplementedProxyServer) ReleaseDQLMessageStream(ctx context.Context, req *ReleaseDQLMessageStreamRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReleaseDQLMessageStream not implemented")
 }
+func (*UnimplementedProxyServer) InvalidateCredentialCache(ctx context.Context, req *InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCredentialCache not implemented")
+}
 
 func RegisterProxyServer(s *grpc.Server, srv ProxyServer) {
 	s.RegisterService(&_Proxy_serviceDesc, srv)
@@ -369,6 +434,24 @@ func _Proxy_ReleaseDQLMessageStream_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Proxy_InvalidateCredentialCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateCredCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).InvalidateCredentialCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/InvalidateCredentialCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).InvalidateCredentialCache(ctx, req.(*InvalidateCredCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Proxy_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.proxy.Proxy",
 	HandlerType: (*ProxyServer)(nil),
@@ -393,6 +476,10 @@ var _Proxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReleaseDQLMessageStream",
 			Handler:    _Proxy_ReleaseDQLMessageStream_Handler,
 		},
+		{
+			MethodName: "InvalidateCredentialCache",
+			Handler:    _Proxy_InvalidateCredentialCache_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proxy.proto",
@@ -337,6 +337,7 @@ type LoadCollectionRequest struct {
 	DbID                 int64                      `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
 	CollectionID         int64                      `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	ReplicaNumber        int32                      `protobuf:"varint,5,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -395,6 +396,13 @@ func (m *LoadCollectionRequest) GetSchema() *schemapb.CollectionSchema {
 	return nil
 }
 
+func (m *LoadCollectionRequest) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
 type ReleaseCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -907,19 +915,21 @@ func (m *GetSegmentInfoRequest) GetSegmentIDs() []int64 {
 }
 
 type SegmentInfo struct {
-	SegmentID            int64        `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64        `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64        `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	NodeID               int64        `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	MemSize              int64        `protobuf:"varint,5,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
-	NumRows              int64        `protobuf:"varint,6,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	IndexName            string       `protobuf:"bytes,7,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64        `protobuf:"varint,8,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	ChannelID            string       `protobuf:"bytes,9,opt,name=channelID,proto3" json:"channelID,omitempty"`
-	SegmentState         SegmentState `protobuf:"varint,10,opt,name=segment_state,json=segmentState,proto3,enum=milvus.proto.query.SegmentState" json:"segment_state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	SegmentID            int64             `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NodeID               int64             `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	MemSize              int64             `protobuf:"varint,5,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
+	NumRows              int64             `protobuf:"varint,6,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexName            string            `protobuf:"bytes,7,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID              int64             `protobuf:"varint,8,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	ChannelID            string            `protobuf:"bytes,9,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	SegmentState         SegmentState      `protobuf:"varint,10,opt,name=segment_state,json=segmentState,proto3,enum=milvus.proto.query.SegmentState" json:"segment_state,omitempty"`
+	NodeIDs              []int64           `protobuf:"varint,11,rep,packed,name=nodeIDs,proto3" json:"nodeIDs,omitempty"`
+	IndexInfos           []*FieldIndexInfo `protobuf:"bytes,12,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
@@ -1017,6 +1027,75 @@ func (m *SegmentInfo) GetSegmentState() SegmentState {
 	return SegmentState_None
 }
 
+func (m *SegmentInfo) GetNodeIDs() []int64 {
+	if m != nil {
+		return m.NodeIDs
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetIndexInfos() []*FieldIndexInfo {
+	if m != nil {
+		return m.IndexInfos
+	}
+	return nil
+}
+
+type FieldIndexInfo struct {
+	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	IndexName            string   `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID              int64    `protobuf:"varint,3,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldIndexInfo) Reset()         { *m = FieldIndexInfo{} }
+func (m *FieldIndexInfo) String() string { return proto.CompactTextString(m) }
+func (*FieldIndexInfo) ProtoMessage()    {}
+func (*FieldIndexInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{15}
+}
+
+func (m *FieldIndexInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldIndexInfo.Unmarshal(m, b)
+}
+func (m *FieldIndexInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldIndexInfo.Marshal(b, m, deterministic)
+}
+func (m *FieldIndexInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldIndexInfo.Merge(m, src)
+}
+func (m *FieldIndexInfo) XXX_Size() int {
+	return xxx_messageInfo_FieldIndexInfo.Size(m)
+}
+func (m *FieldIndexInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldIndexInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldIndexInfo proto.InternalMessageInfo
+
+func (m *FieldIndexInfo) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *FieldIndexInfo) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *FieldIndexInfo) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
 type GetSegmentInfoResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Infos                []*SegmentInfo   `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
@@ -1029,7 +1108,7 @@ func (m *GetSegmentInfoResponse) Reset()         { *m = GetSegmentInfoResponse{}
 func (m *GetSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentInfoResponse) ProtoMessage()    {}
 func (*GetSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{15}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{16}
 }
 
 func (m *GetSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -1064,6 +1143,100 @@ func (m *GetSegmentInfoResponse) GetInfos() []*SegmentInfo {
 	return nil
 }
 
+type GetReplicasRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetReplicasRequest) Reset()         { *m = GetReplicasRequest{} }
+func (m *GetReplicasRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReplicasRequest) ProtoMessage()    {}
+func (*GetReplicasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{17}
+}
+
+func (m *GetReplicasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReplicasRequest.Unmarshal(m, b)
+}
+func (m *GetReplicasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReplicasRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReplicasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicasRequest.Merge(m, src)
+}
+func (m *GetReplicasRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReplicasRequest.Size(m)
+}
+func (m *GetReplicasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicasRequest proto.InternalMessageInfo
+
+func (m *GetReplicasRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetReplicasRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetReplicasResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Replicas             []*ReplicaInfo   `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetReplicasResponse) Reset()         { *m = GetReplicasResponse{} }
+func (m *GetReplicasResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReplicasResponse) ProtoMessage()    {}
+func (*GetReplicasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{18}
+}
+
+func (m *GetReplicasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReplicasResponse.Unmarshal(m, b)
+}
+func (m *GetReplicasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReplicasResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReplicasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicasResponse.Merge(m, src)
+}
+func (m *GetReplicasResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReplicasResponse.Size(m)
+}
+func (m *GetReplicasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicasResponse proto.InternalMessageInfo
+
+func (m *GetReplicasResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetReplicasResponse) GetReplicas() []*ReplicaInfo {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
 //-----------------query node proto----------------
 type AddQueryChannelRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -1071,6 +1244,7 @@ type AddQueryChannelRequest struct {
 	CollectionID         int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	RequestChannelID     string            `protobuf:"bytes,4,opt,name=request_channelID,json=requestChannelID,proto3" json:"request_channelID,omitempty"`
 	ResultChannelID      string            `protobuf:"bytes,5,opt,name=result_channelID,json=resultChannelID,proto3" json:"result_channelID,omitempty"`
+	ReplicaID            int64             `protobuf:"varint,6,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1080,7 +1254,7 @@ func (m *AddQueryChannelRequest) Reset()         { *m = AddQueryChannelRequest{}
 func (m *AddQueryChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*AddQueryChannelRequest) ProtoMessage()    {}
 func (*AddQueryChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{16}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{19}
 }
 
 func (m *AddQueryChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -1136,6 +1310,13 @@ func (m *AddQueryChannelRequest) GetResultChannelID() string {
 	return ""
 }
 
+func (m *AddQueryChannelRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type RemoveQueryChannelRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -1151,7 +1332,7 @@ func (m *RemoveQueryChannelRequest) Reset()         { *m = RemoveQueryChannelReq
 func (m *RemoveQueryChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveQueryChannelRequest) ProtoMessage()    {}
 func (*RemoveQueryChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{17}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
 }
 
 func (m *RemoveQueryChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -1215,6 +1396,7 @@ type WatchDmChannelsRequest struct {
 	Infos                []*datapb.VchannelInfo     `protobuf:"bytes,5,rep,name=infos,proto3" json:"infos,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
 	ExcludeInfos         []*datapb.SegmentInfo      `protobuf:"bytes,7,rep,name=exclude_infos,json=excludeInfos,proto3" json:"exclude_infos,omitempty"`
+	ReplicaID            int64                      `protobuf:"varint,8,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1224,7 +1406,7 @@ func (m *WatchDmChannelsRequest) Reset()         { *m = WatchDmChannelsRequest{}
 func (m *WatchDmChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchDmChannelsRequest) ProtoMessage()    {}
 func (*WatchDmChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{18}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
 }
 
 func (m *WatchDmChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -1294,24 +1476,35 @@ func (m *WatchDmChannelsRequest) GetExcludeInfos() []*datapb.SegmentInfo {
 	return nil
 }
 
+func (m *WatchDmChannelsRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 //used for handoff task
 type SegmentLoadInfo struct {
-	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	PartitionID          int64                 `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	CollectionID         int64                 `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	DbID                 int64                 `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	FlushTime            int64                 `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
-	BinlogPaths          []*datapb.FieldBinlog `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	SegmentID            int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	PartitionID          int64                   `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	CollectionID         int64                   `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	DbID                 int64                   `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	FlushTime            int64                   `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
+	BinlogPaths          []*datapb.FieldBinlog   `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
+	Deltalogs            []*datapb.DeltaLogInfo  `protobuf:"bytes,7,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	CompactionFrom       []int64                 `protobuf:"varint,8,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
+	DmlPosition          *internalpb.MsgPosition `protobuf:"bytes,9,opt,name=dml_position,json=dmlPosition,proto3" json:"dml_position,omitempty"`
+	SegmentSize          int64                   `protobuf:"varint,10,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *SegmentLoadInfo) Reset()         { *m = SegmentLoadInfo{} }
 func (m *SegmentLoadInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentLoadInfo) ProtoMessage()    {}
 func (*SegmentLoadInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{19}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
 }
 
 func (m *SegmentLoadInfo) XXX_Unmarshal(b []byte) error {
@@ -1374,12 +1567,42 @@ func (m *SegmentLoadInfo) GetBinlogPaths() []*datapb.FieldBinlog {
 	return nil
 }
 
+func (m *SegmentLoadInfo) GetDeltalogs() []*datapb.DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetCompactionFrom() []int64 {
+	if m != nil {
+		return m.CompactionFrom
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetDmlPosition() *internalpb.MsgPosition {
+	if m != nil {
+		return m.DmlPosition
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetSegmentSize() int64 {
+	if m != nil {
+		return m.SegmentSize
+	}
+	return 0
+}
+
 type LoadSegmentsRequest struct {
 	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64                      `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	Infos                []*SegmentLoadInfo         `protobuf:"bytes,3,rep,name=infos,proto3" json:"infos,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
 	LoadCondition        TriggerCondition           `protobuf:"varint,5,opt,name=load_condition,json=loadCondition,proto3,enum=milvus.proto.query.TriggerCondition" json:"load_condition,omitempty"`
+	ReplicaID            int64                      `protobuf:"varint,6,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	SourceNodeID         int64                      `protobuf:"varint,7,opt,name=source_nodeID,json=sourceNodeID,proto3" json:"source_nodeID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1389,7 +1612,7 @@ func (m *LoadSegmentsRequest) Reset()         { *m = LoadSegmentsRequest{} }
 func (m *LoadSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadSegmentsRequest) ProtoMessage()    {}
 func (*LoadSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
 }
 
 func (m *LoadSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1445,6 +1668,20 @@ func (m *LoadSegmentsRequest) GetLoadCondition() TriggerCondition {
 	return TriggerCondition_handoff
 }
 
+func (m *LoadSegmentsRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *LoadSegmentsRequest) GetSourceNodeID() int64 {
+	if m != nil {
+		return m.SourceNodeID
+	}
+	return 0
+}
+
 type ReleaseSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -1461,7 +1698,7 @@ func (m *ReleaseSegmentsRequest) Reset()         { *m = ReleaseSegmentsRequest{}
 func (m *ReleaseSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseSegmentsRequest) ProtoMessage()    {}
 func (*ReleaseSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
 }
 
 func (m *ReleaseSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1536,7 +1773,7 @@ func (m *DmChannelInfo) Reset()         { *m = DmChannelInfo{} }
 func (m *DmChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*DmChannelInfo) ProtoMessage()    {}
 func (*DmChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
 }
 
 func (m *DmChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -1571,6 +1808,61 @@ func (m *DmChannelInfo) GetChannelIDs() []string {
 	return nil
 }
 
+type ReplicaInfo struct {
+	ReplicaID            int64    `protobuf:"varint,1,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	NodeIDs              []int64  `protobuf:"varint,3,rep,packed,name=nodeIDs,proto3" json:"nodeIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicaInfo) Reset()         { *m = ReplicaInfo{} }
+func (m *ReplicaInfo) String() string { return proto.CompactTextString(m) }
+func (*ReplicaInfo) ProtoMessage()    {}
+func (*ReplicaInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
+}
+
+func (m *ReplicaInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaInfo.Unmarshal(m, b)
+}
+func (m *ReplicaInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaInfo.Marshal(b, m, deterministic)
+}
+func (m *ReplicaInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaInfo.Merge(m, src)
+}
+func (m *ReplicaInfo) XXX_Size() int {
+	return xxx_messageInfo_ReplicaInfo.Size(m)
+}
+func (m *ReplicaInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaInfo proto.InternalMessageInfo
+
+func (m *ReplicaInfo) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *ReplicaInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ReplicaInfo) GetNodeIDs() []int64 {
+	if m != nil {
+		return m.NodeIDs
+	}
+	return nil
+}
+
 type QueryChannelInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	QueryChannelID       string   `protobuf:"bytes,2,opt,name=query_channelID,json=queryChannelID,proto3" json:"query_channelID,omitempty"`
@@ -1584,7 +1876,7 @@ func (m *QueryChannelInfo) Reset()         { *m = QueryChannelInfo{} }
 func (m *QueryChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*QueryChannelInfo) ProtoMessage()    {}
 func (*QueryChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
 }
 
 func (m *QueryChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -1642,7 +1934,7 @@ func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
 func (m *CollectionInfo) String() string { return proto.CompactTextString(m) }
 func (*CollectionInfo) ProtoMessage()    {}
 func (*CollectionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
 }
 
 func (m *CollectionInfo) XXX_Unmarshal(b []byte) error {
@@ -1706,18 +1998,18 @@ func (m *CollectionInfo) GetReleasedPartitionIDs() []int64 {
 }
 
 type HandoffSegments struct {
-	Base                 *commonpb.MsgBase  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Infos                []*SegmentLoadInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentInfos         []*datapb.SegmentInfo `protobuf:"bytes,2,rep,name=segmentInfos,proto3" json:"segmentInfos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *HandoffSegments) Reset()         { *m = HandoffSegments{} }
 func (m *HandoffSegments) String() string { return proto.CompactTextString(m) }
 func (*HandoffSegments) ProtoMessage()    {}
 func (*HandoffSegments) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
 }
 
 func (m *HandoffSegments) XXX_Unmarshal(b []byte) error {
@@ -1745,9 +2037,9 @@ func (m *HandoffSegments) GetBase() *commonpb.MsgBase {
 	return nil
 }
 
-func (m *HandoffSegments) GetInfos() []*SegmentLoadInfo {
+func (m *HandoffSegments) GetSegmentInfos() []*datapb.SegmentInfo {
 	if m != nil {
-		return m.Infos
+		return m.SegmentInfos
 	}
 	return nil
 }
@@ -1770,7 +2062,7 @@ func (m *LoadBalanceSegmentInfo) Reset()         { *m = LoadBalanceSegmentInfo{}
 func (m *LoadBalanceSegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceSegmentInfo) ProtoMessage()    {}
 func (*LoadBalanceSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
 }
 
 func (m *LoadBalanceSegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -1851,6 +2143,8 @@ type LoadBalanceRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SourceNodeIDs        []int64           `protobuf:"varint,2,rep,packed,name=source_nodeIDs,json=sourceNodeIDs,proto3" json:"source_nodeIDs,omitempty"`
 	BalanceReason        TriggerCondition  `protobuf:"varint,3,opt,name=balance_reason,json=balanceReason,proto3,enum=milvus.proto.query.TriggerCondition" json:"balance_reason,omitempty"`
+	DstNodeIDs           []int64           `protobuf:"varint,4,rep,packed,name=dst_nodeIDs,json=dstNodeIDs,proto3" json:"dst_nodeIDs,omitempty"`
+	SealedSegmentIDs     []int64           `protobuf:"varint,5,rep,packed,name=sealed_segmentIDs,json=sealedSegmentIDs,proto3" json:"sealed_segmentIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1860,7 +2154,7 @@ func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
 func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceRequest) ProtoMessage()    {}
 func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{31}
 }
 
 func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -1902,6 +2196,20 @@ func (m *LoadBalanceRequest) GetBalanceReason() TriggerCondition {
 	return TriggerCondition_handoff
 }
 
+func (m *LoadBalanceRequest) GetDstNodeIDs() []int64 {
+	if m != nil {
+		return m.DstNodeIDs
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetSealedSegmentIDs() []int64 {
+	if m != nil {
+		return m.SealedSegmentIDs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.query.PartitionState", PartitionState_name, PartitionState_value)
 	proto.RegisterEnum("milvus.proto.query.TriggerCondition", TriggerCondition_name, TriggerCondition_value)
@@ -1921,7 +2229,10 @@ func init() {
 	proto.RegisterType((*GetPartitionStatesResponse)(nil), "milvus.proto.query.GetPartitionStatesResponse")
 	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.query.GetSegmentInfoRequest")
 	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.query.SegmentInfo")
+	proto.RegisterType((*FieldIndexInfo)(nil), "milvus.proto.query.FieldIndexInfo")
 	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.query.GetSegmentInfoResponse")
+	proto.RegisterType((*GetReplicasRequest)(nil), "milvus.proto.query.GetReplicasRequest")
+	proto.RegisterType((*GetReplicasResponse)(nil), "milvus.proto.query.GetReplicasResponse")
 	proto.RegisterType((*AddQueryChannelRequest)(nil), "milvus.proto.query.AddQueryChannelRequest")
 	proto.RegisterType((*RemoveQueryChannelRequest)(nil), "milvus.proto.query.RemoveQueryChannelRequest")
 	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.query.WatchDmChannelsRequest")
@@ -1929,6 +2240,7 @@ func init() {
 	proto.RegisterType((*LoadSegmentsRequest)(nil), "milvus.proto.query.LoadSegmentsRequest")
 	proto.RegisterType((*ReleaseSegmentsRequest)(nil), "milvus.proto.query.ReleaseSegmentsRequest")
 	proto.RegisterType((*DmChannelInfo)(nil), "milvus.proto.query.DmChannelInfo")
+	proto.RegisterType((*ReplicaInfo)(nil), "milvus.proto.query.ReplicaInfo")
 	proto.RegisterType((*QueryChannelInfo)(nil), "milvus.proto.query.QueryChannelInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.query.CollectionInfo")
 	proto.RegisterType((*HandoffSegments)(nil), "milvus.proto.query.HandoffSegments")
@@ -1939,122 +2251,140 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 1831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x24, 0x47,
-	0x15, 0x77, 0xcf, 0x97, 0x67, 0xde, 0x7c, 0xf5, 0x56, 0xd6, 0xc3, 0xec, 0x90, 0x4d, 0x4c, 0x6f,
-	0x96, 0xdd, 0x38, 0xc4, 0x1b, 0xcd, 0x06, 0x89, 0x1c, 0x38, 0x64, 0x3d, 0x59, 0x33, 0x90, 0x38,
-	0xa6, 0xbd, 0x04, 0xb1, 0x5a, 0xd1, 0xf4, 0x4c, 0x97, 0x67, 0x5a, 0xe9, 0xee, 0x1a, 0x77, 0xf5,
-	0xac, 0xbd, 0x3e, 0x20, 0x21, 0x71, 0xe6, 0xc6, 0x09, 0x2e, 0x5c, 0x90, 0x38, 0xf0, 0x0f, 0x20,
-	0x21, 0xe5, 0x1f, 0x01, 0x09, 0x91, 0x1b, 0xff, 0x04, 0xaa, 0x8f, 0xee, 0xe9, 0xaf, 0xb1, 0xc7,
-	0x76, 0x1c, 0x47, 0x88, 0x5b, 0xd7, 0xab, 0x57, 0xef, 0xbb, 0x7e, 0xfd, 0xea, 0xc1, 0xad, 0xa3,
-	0x39, 0xf6, 0x5f, 0x19, 0x63, 0x42, 0x7c, 0x6b, 0x7b, 0xe6, 0x93, 0x80, 0x20, 0xe4, 0xda, 0xce,
-	0xcb, 0x39, 0x15, 0xab, 0x6d, 0xbe, 0xdf, 0x6b, 0x8c, 0x89, 0xeb, 0x12, 0x4f, 0xd0, 0x7a, 0x8d,
-	0x38, 0x47, 0xaf, 0x65, 0x7b, 0x01, 0xf6, 0x3d, 0xd3, 0x09, 0x77, 0xe9, 0x78, 0x8a, 0x5d, 0x53,
-	0xae, 0x54, 0xcb, 0x0c, 0xcc, 0xb8, 0x7c, 0xed, 0x97, 0xd0, 0x39, 0x98, 0x92, 0xe3, 0x1d, 0xe2,
-	0x38, 0x78, 0x1c, 0xd8, 0xc4, 0xa3, 0x3a, 0x3e, 0x9a, 0x63, 0x1a, 0xa0, 0xf7, 0xa0, 0x34, 0x32,
-	0x29, 0xee, 0x2a, 0x9b, 0xca, 0xc3, 0x7a, 0xff, 0xf5, 0xed, 0x84, 0x21, 0xd2, 0x82, 0x4f, 0xe8,
-	0xe4, 0x89, 0x49, 0xb1, 0xce, 0x39, 0x11, 0x82, 0x92, 0x35, 0x1a, 0x0e, 0xba, 0x85, 0x4d, 0xe5,
-	0x61, 0x51, 0xe7, 0xdf, 0x5a, 0x00, 0xdf, 0xca, 0xc8, 0xa7, 0x33, 0xe2, 0x51, 0x8c, 0x1e, 0x43,
-	0x85, 0x06, 0x66, 0x30, 0xa7, 0x52, 0xc5, 0xb7, 0x73, 0x55, 0x1c, 0x70, 0x16, 0x5d, 0xb2, 0xa2,
-	0xb7, 0xa0, 0x39, 0x8e, 0x64, 0x0d, 0x07, 0xb4, 0x5b, 0xd8, 0x2c, 0x3e, 0x2c, 0xea, 0x49, 0xa2,
-	0xf6, 0x1b, 0x05, 0x36, 0x98, 0xda, 0x7d, 0xd3, 0x0f, 0xec, 0xaf, 0xde, 0x2b, 0xa4, 0x41, 0x23,
-	0xae, 0xb0, 0x5b, 0xe4, 0x7b, 0x09, 0x9a, 0x76, 0x24, 0x22, 0x1b, 0x37, 0xe1, 0x2a, 0x8e, 0x6b,
-	0xd0, 0x98, 0x85, 0xa2, 0x16, 0x7e, 0x27, 0x68, 0xda, 0x17, 0x0a, 0x6c, 0x7c, 0x4c, 0x4c, 0x6b,
-	0x11, 0xed, 0xaf, 0xdd, 0x6d, 0xf4, 0x43, 0xa8, 0x88, 0x92, 0xeb, 0x96, 0xb8, 0xae, 0xfb, 0x49,
-	0x5d, 0xb2, 0x1c, 0x17, 0x16, 0x1e, 0x70, 0x82, 0x2e, 0x0f, 0x69, 0x7f, 0x54, 0xa0, 0xab, 0x63,
-	0x07, 0x9b, 0x14, 0xdf, 0xa4, 0x17, 0x1d, 0xa8, 0x78, 0xc4, 0xc2, 0xc3, 0x01, 0xf7, 0xa2, 0xa8,
-	0xcb, 0x95, 0xf6, 0xa5, 0x8c, 0xf0, 0x0d, 0x16, 0x56, 0xa6, 0x12, 0x4a, 0xd9, 0x4a, 0x88, 0x65,
-	0xa1, 0x7c, 0x99, 0x2c, 0x7c, 0xb1, 0xc8, 0xc2, 0x37, 0xdd, 0xd3, 0x45, 0xa6, 0xca, 0x89, 0x4c,
-	0xfd, 0x02, 0xee, 0xec, 0xf8, 0xd8, 0x0c, 0xf0, 0x4f, 0x19, 0x66, 0xee, 0x4c, 0x4d, 0xcf, 0xc3,
-	0x4e, 0xe8, 0x42, 0x5a, 0xb9, 0x92, 0xa3, 0xbc, 0x0b, 0xeb, 0x33, 0x9f, 0x9c, 0xbc, 0x8a, 0xec,
-	0x0e, 0x97, 0xda, 0x9f, 0x14, 0xe8, 0xe5, 0xc9, 0xbe, 0xca, 0xf5, 0x7e, 0x00, 0x6d, 0x5f, 0x18,
-	0x67, 0x8c, 0x85, 0x3c, 0xae, 0xb5, 0xa6, 0xb7, 0x24, 0x59, 0x6a, 0x41, 0xf7, 0xa1, 0xe5, 0x63,
-	0x3a, 0x77, 0x16, 0x7c, 0x45, 0xce, 0xd7, 0x14, 0x54, 0xc9, 0xa6, 0xfd, 0x45, 0x81, 0x3b, 0xbb,
-	0x38, 0x88, 0xb2, 0xc7, 0xd4, 0xe1, 0x6f, 0x66, 0x0a, 0x35, 0x17, 0xda, 0x29, 0x3b, 0xd1, 0x26,
-	0xd4, 0x63, 0x2c, 0x32, 0x3f, 0x71, 0x12, 0xfa, 0x01, 0x94, 0x59, 0xe8, 0x30, 0xb7, 0xa8, 0xd5,
-	0xd7, 0xb6, 0xb3, 0x3f, 0xca, 0xed, 0xa4, 0x54, 0x5d, 0x1c, 0xd0, 0xfe, 0xaa, 0x40, 0x2f, 0x2f,
-	0x34, 0x57, 0x49, 0xdf, 0x73, 0xe8, 0x44, 0xc6, 0x19, 0x16, 0xa6, 0x63, 0xdf, 0x9e, 0xf1, 0x4b,
-	0xc3, 0x71, 0xba, 0xde, 0xbf, 0x77, 0xbe, 0x79, 0x54, 0xdf, 0x88, 0x44, 0x0c, 0x62, 0x12, 0x34,
-	0x1b, 0x36, 0x76, 0x71, 0x70, 0x80, 0x27, 0x2e, 0xf6, 0x82, 0xa1, 0x77, 0x48, 0x2e, 0x9f, 0xc5,
-	0x37, 0x00, 0xa8, 0x94, 0x13, 0xfd, 0x42, 0x62, 0x14, 0xed, 0x1f, 0x05, 0xa8, 0xc7, 0x14, 0xa1,
-	0xd7, 0xa1, 0x16, 0xed, 0xca, 0x24, 0x2c, 0x08, 0x99, 0xfc, 0x17, 0x72, 0xf2, 0x9f, 0x4a, 0x64,
-	0x31, 0x9b, 0xc8, 0x25, 0x50, 0x8b, 0xee, 0x40, 0xd5, 0xc5, 0xae, 0x41, 0xed, 0x53, 0x2c, 0xaf,
-	0xf6, 0xba, 0x8b, 0xdd, 0x03, 0xfb, 0x14, 0xb3, 0x2d, 0x6f, 0xee, 0x1a, 0x3e, 0x39, 0xa6, 0xdd,
-	0x8a, 0xd8, 0xf2, 0xe6, 0xae, 0x4e, 0x8e, 0x29, 0xba, 0x0b, 0x60, 0x7b, 0x16, 0x3e, 0x31, 0x3c,
-	0xd3, 0xc5, 0xdd, 0x75, 0x7e, 0x35, 0x6a, 0x9c, 0xb2, 0x67, 0xba, 0x98, 0x5d, 0x6a, 0xbe, 0x18,
-	0x0e, 0xba, 0x55, 0x71, 0x50, 0x2e, 0x99, 0xab, 0xf2, 0x42, 0x0d, 0x07, 0xdd, 0x9a, 0x38, 0x17,
-	0x11, 0xd0, 0x47, 0xd0, 0x94, 0x7e, 0x1b, 0xa2, 0xea, 0x80, 0x57, 0xdd, 0x66, 0x5e, 0x5a, 0x65,
-	0x00, 0x45, 0xcd, 0x35, 0x68, 0x6c, 0xa5, 0xfd, 0x56, 0x81, 0x4e, 0x3a, 0x97, 0x57, 0x29, 0xbb,
-	0xef, 0x43, 0xd9, 0xf6, 0x0e, 0x49, 0x58, 0x65, 0x6f, 0x9e, 0x61, 0x0e, 0x57, 0x26, 0xb8, 0xb5,
-	0x7f, 0x2a, 0xd0, 0xf9, 0xd0, 0xb2, 0xf2, 0x90, 0xf1, 0xe2, 0x35, 0xb5, 0xc8, 0x5f, 0x21, 0x91,
-	0xbf, 0x55, 0xd0, 0xe1, 0x1d, 0xb8, 0x95, 0x42, 0x3d, 0x59, 0x06, 0x35, 0x5d, 0x4d, 0xe2, 0xde,
-	0x70, 0x80, 0xde, 0x06, 0x35, 0x89, 0x7c, 0x12, 0xf3, 0x6b, 0x7a, 0x3b, 0x81, 0x7d, 0xc3, 0x81,
-	0xf6, 0x2f, 0x05, 0xee, 0xe8, 0xd8, 0x25, 0x2f, 0xf1, 0xff, 0xae, 0x8f, 0xff, 0x2e, 0x40, 0xe7,
-	0xe7, 0x66, 0x30, 0x9e, 0x0e, 0x5c, 0x49, 0xa4, 0x37, 0xe3, 0x60, 0xea, 0x8a, 0x97, 0xb2, 0x57,
-	0x3c, 0x2a, 0xd3, 0x72, 0x5e, 0x99, 0xb2, 0x37, 0xc9, 0xf6, 0x67, 0xa1, 0xbf, 0x8b, 0x32, 0x8d,
-	0x35, 0x31, 0x95, 0x4b, 0x34, 0x31, 0x68, 0x07, 0x9a, 0xf8, 0x64, 0xec, 0xcc, 0x2d, 0x6c, 0x08,
-	0xed, 0xeb, 0x5c, 0xfb, 0x1b, 0x39, 0xda, 0xe3, 0x77, 0xa4, 0x21, 0x0f, 0x0d, 0xf9, 0x55, 0xf9,
-	0x8f, 0x02, 0x6d, 0xb9, 0xcb, 0xfa, 0xbe, 0x15, 0x50, 0x31, 0x15, 0x8e, 0x42, 0x36, 0x1c, 0xab,
-	0x04, 0x35, 0xfc, 0xdf, 0x96, 0x62, 0xff, 0xdb, 0xbb, 0x00, 0x87, 0xce, 0x9c, 0x4e, 0x8d, 0xc0,
-	0x76, 0x43, 0x4c, 0xac, 0x71, 0xca, 0x33, 0xdb, 0xc5, 0xe8, 0x43, 0x68, 0x8c, 0x6c, 0xcf, 0x21,
-	0x13, 0x63, 0x66, 0x06, 0x53, 0x86, 0x8c, 0xcb, 0xdc, 0x7d, 0x6a, 0x63, 0xc7, 0x7a, 0xc2, 0x79,
-	0xf5, 0xba, 0x38, 0xb3, 0xcf, 0x8e, 0x68, 0x7f, 0x2e, 0xc0, 0x6b, 0xcc, 0x4d, 0xe9, 0xf1, 0x35,
-	0x14, 0xd4, 0x07, 0x61, 0x29, 0x14, 0x97, 0xff, 0x17, 0x53, 0xf1, 0xce, 0x96, 0xc3, 0x65, 0x5e,
-	0x16, 0xe8, 0x27, 0xd0, 0x72, 0x88, 0x69, 0x19, 0x63, 0xe2, 0x59, 0x3c, 0x13, 0x3c, 0x82, 0xad,
-	0xfe, 0x5b, 0x79, 0x26, 0x3c, 0xf3, 0xed, 0xc9, 0x04, 0xfb, 0x3b, 0x21, 0xaf, 0xde, 0x74, 0xf8,
-	0xbb, 0x4a, 0x2e, 0x39, 0x82, 0xca, 0x06, 0xf9, 0xfa, 0x62, 0x15, 0xd6, 0x40, 0xf1, 0x8c, 0x9e,
-	0xab, 0xb4, 0x42, 0xcf, 0x55, 0xce, 0x69, 0x9b, 0x93, 0x9d, 0x40, 0x25, 0xd3, 0x09, 0x3c, 0x83,
-	0x66, 0x84, 0x2b, 0xbc, 0xe8, 0xef, 0x41, 0x53, 0x98, 0x65, 0xb0, 0x48, 0x60, 0x2b, 0xec, 0x99,
-	0x05, 0xf1, 0x63, 0x4e, 0x63, 0x52, 0x23, 0xdc, 0x12, 0x3f, 0xa5, 0x9a, 0x1e, 0xa3, 0x68, 0xbf,
-	0x57, 0x40, 0x8d, 0x23, 0x32, 0x97, 0xbc, 0x4a, 0x33, 0xfe, 0x00, 0xda, 0x72, 0x36, 0x12, 0xc1,
-	0xa2, 0x6c, 0x8f, 0x8f, 0xe2, 0xe2, 0x06, 0xe8, 0x7d, 0xe8, 0x08, 0xc6, 0x0c, 0x8c, 0x8a, 0x36,
-	0xf9, 0x36, 0xdf, 0xd5, 0x53, 0x58, 0xfa, 0xf7, 0x02, 0xb4, 0x16, 0x85, 0xb3, 0xb2, 0x55, 0x2b,
-	0xbc, 0xc9, 0xd1, 0x53, 0x68, 0x4a, 0x1b, 0x8c, 0x78, 0xe1, 0x7f, 0x27, 0xaf, 0xea, 0x12, 0x11,
-	0xd7, 0x1b, 0x31, 0x48, 0xe4, 0x0f, 0x04, 0x59, 0xbe, 0xa1, 0x01, 0x3c, 0xf7, 0x55, 0xbd, 0xe5,
-	0x24, 0x5e, 0xfc, 0x57, 0x7c, 0xfa, 0xa1, 0xc7, 0xb0, 0xe1, 0x8b, 0xc2, 0xb6, 0x8c, 0x84, 0x73,
-	0xa2, 0x46, 0x6e, 0x87, 0x9b, 0xfb, 0xf1, 0x0e, 0xfe, 0xd7, 0xd0, 0xfe, 0x91, 0xe9, 0x59, 0xe4,
-	0xf0, 0x30, 0xbc, 0x0d, 0x97, 0xb8, 0x06, 0x1f, 0x24, 0x9b, 0x99, 0x0b, 0x40, 0x83, 0xf6, 0x87,
-	0x02, 0x74, 0x18, 0xed, 0x89, 0xe9, 0x98, 0xde, 0x18, 0xaf, 0xde, 0xc2, 0x7e, 0x35, 0x60, 0x7d,
-	0x0f, 0x9a, 0x94, 0xcc, 0xfd, 0x31, 0x36, 0x12, 0x9d, 0x6c, 0x43, 0x10, 0xf7, 0xc4, 0x6d, 0xbe,
-	0x0b, 0x60, 0xd1, 0xc0, 0x48, 0x3c, 0x56, 0x6b, 0x16, 0x0d, 0xe4, 0xf6, 0x9b, 0x50, 0x97, 0x32,
-	0x2c, 0xe2, 0x61, 0xfe, 0xc7, 0xab, 0xea, 0x20, 0x48, 0x03, 0xe2, 0xf1, 0xa6, 0x97, 0x9d, 0xe7,
-	0xbb, 0xeb, 0x7c, 0x77, 0xdd, 0xa2, 0x01, 0xdf, 0xba, 0x0b, 0xf0, 0xd2, 0x74, 0x6c, 0x8b, 0x57,
-	0x18, 0x6f, 0x6c, 0xab, 0x7a, 0x8d, 0x53, 0x58, 0x08, 0xb4, 0xbf, 0x29, 0x80, 0x62, 0xd1, 0xb9,
-	0x3c, 0x50, 0xdd, 0x87, 0x56, 0xc2, 0xcf, 0x68, 0xfa, 0x16, 0x77, 0x94, 0x32, 0xa4, 0x1d, 0x09,
-	0x55, 0x86, 0x8f, 0x4d, 0x4a, 0x3c, 0x1e, 0xb4, 0x95, 0x91, 0x76, 0x14, 0x9a, 0xc9, 0x8e, 0x6e,
-	0x9d, 0x42, 0x2b, 0xf9, 0x4e, 0x42, 0x0d, 0xa8, 0xee, 0x91, 0xe0, 0xa3, 0x13, 0x9b, 0x06, 0xea,
-	0x1a, 0x6a, 0x01, 0xec, 0x91, 0x60, 0xdf, 0xc7, 0x14, 0x7b, 0x81, 0xaa, 0x20, 0x80, 0xca, 0xa7,
-	0xde, 0xc0, 0xa6, 0x9f, 0xab, 0x05, 0xf4, 0x9a, 0x7c, 0x58, 0x9a, 0xce, 0xd0, 0xfb, 0x04, 0xbb,
-	0xc4, 0x7f, 0xa5, 0x16, 0xd9, 0xf1, 0x68, 0x55, 0x42, 0x2a, 0x34, 0x22, 0x96, 0xdd, 0xfd, 0x9f,
-	0xa9, 0x65, 0x54, 0x83, 0xb2, 0xf8, 0xac, 0x6c, 0x7d, 0x0a, 0x6a, 0xda, 0x3c, 0x54, 0x87, 0xf5,
-	0xa9, 0x28, 0x75, 0x75, 0x0d, 0xb5, 0xa1, 0xee, 0x2c, 0x02, 0xab, 0x2a, 0x8c, 0x30, 0xf1, 0x67,
-	0x63, 0x19, 0x62, 0xb5, 0xc0, 0xb4, 0xb1, 0x58, 0x0d, 0xc8, 0xb1, 0xa7, 0x16, 0xb7, 0x7e, 0x0c,
-	0x8d, 0xf8, 0xeb, 0x00, 0x55, 0xa1, 0xb4, 0x47, 0x3c, 0xac, 0xae, 0x31, 0xb1, 0xbb, 0x3e, 0x39,
-	0xb6, 0xbd, 0x89, 0xf0, 0xe1, 0xa9, 0x4f, 0x4e, 0xb1, 0xa7, 0x16, 0xd8, 0x06, 0xc5, 0xa6, 0xc3,
-	0x36, 0x8a, 0x6c, 0x83, 0x2d, 0xb0, 0xa5, 0x96, 0xfa, 0xbf, 0x03, 0x00, 0x81, 0xa5, 0x84, 0xf8,
-	0x16, 0x9a, 0x01, 0xda, 0xc5, 0xc1, 0x0e, 0x71, 0x67, 0xc4, 0x0b, 0xe5, 0x53, 0xf4, 0x5e, 0x32,
-	0xe4, 0xd1, 0x70, 0x38, 0xcb, 0x2a, 0x4d, 0xee, 0x7d, 0x77, 0xc9, 0x89, 0x14, 0xbb, 0xb6, 0x86,
-	0x5c, 0xae, 0x91, 0xb5, 0x1e, 0xcf, 0xec, 0xf1, 0xe7, 0xe1, 0x7c, 0xe2, 0x0c, 0x8d, 0x29, 0xd6,
-	0x50, 0x63, 0xea, 0xa2, 0xcb, 0xc5, 0x41, 0xe0, 0xdb, 0xde, 0x24, 0x7c, 0x1e, 0x69, 0x6b, 0xe8,
-	0x08, 0x6e, 0xb3, 0xa7, 0x53, 0x60, 0x06, 0x36, 0x0d, 0xec, 0x31, 0x0d, 0x15, 0xf6, 0x97, 0x2b,
-	0xcc, 0x30, 0x5f, 0x50, 0xa5, 0x03, 0xed, 0xd4, 0xf0, 0x1a, 0x6d, 0xe5, 0xa2, 0x52, 0xee, 0x04,
-	0xbd, 0xf7, 0xce, 0x4a, 0xbc, 0x91, 0x36, 0x1b, 0x5a, 0xc9, 0x81, 0x31, 0x7a, 0x7b, 0x99, 0x80,
-	0xcc, 0x50, 0xae, 0xb7, 0xb5, 0x0a, 0x6b, 0xa4, 0xea, 0x39, 0xb4, 0x92, 0x53, 0xcc, 0x7c, 0x55,
-	0xb9, 0x93, 0xce, 0xde, 0x59, 0x2f, 0x53, 0x6d, 0x0d, 0xfd, 0x0a, 0x6e, 0x65, 0x46, 0x87, 0xe8,
-	0x7b, 0x79, 0xe2, 0x97, 0x4d, 0x18, 0xcf, 0xd3, 0x20, 0xad, 0x8f, 0xfd, 0xf3, 0x96, 0x5a, 0x9f,
-	0x99, 0x21, 0xaf, 0x6e, 0x7d, 0x4c, 0xfc, 0x59, 0xd6, 0x5f, 0x58, 0xc3, 0x1c, 0x50, 0x76, 0x78,
-	0x88, 0xde, 0xcd, 0x53, 0xb1, 0x74, 0x80, 0xd9, 0xdb, 0x5e, 0x95, 0x3d, 0x4a, 0xf9, 0x9c, 0xdf,
-	0xd6, 0xf4, 0x9c, 0x2d, 0x57, 0xed, 0xd2, 0xb9, 0x61, 0xbe, 0xda, 0xe5, 0xb3, 0x34, 0x51, 0xd4,
-	0xc9, 0x81, 0x47, 0x7e, 0xae, 0x72, 0x07, 0x5c, 0xf9, 0x45, 0x9d, 0x3f, 0x3f, 0xd1, 0xd6, 0xfa,
-	0x5f, 0x56, 0xa1, 0xc6, 0x9d, 0x67, 0xff, 0xa1, 0xff, 0xe3, 0xe1, 0x35, 0xe0, 0xe1, 0x0b, 0x68,
-	0xa7, 0xc6, 0x46, 0xf9, 0x78, 0x98, 0x3f, 0x5b, 0x3a, 0xef, 0x62, 0x8c, 0x00, 0x65, 0x67, 0x36,
-	0xf9, 0x15, 0xba, 0x74, 0xb6, 0x73, 0x9e, 0x8e, 0x17, 0xd0, 0x4e, 0xcd, 0x4c, 0xf2, 0x3d, 0xc8,
-	0x1f, 0xac, 0x9c, 0x27, 0xfd, 0x33, 0x68, 0xc4, 0x5f, 0xcf, 0xe8, 0xc1, 0x32, 0x58, 0x4a, 0xbd,
-	0x19, 0x6f, 0x1e, 0x94, 0xae, 0x1f, 0xb4, 0x5f, 0x40, 0x3b, 0xf5, 0x60, 0xce, 0x8f, 0x7c, 0xfe,
-	0xab, 0xfa, 0x3c, 0xe9, 0x5f, 0x1f, 0xcc, 0x3c, 0x79, 0xff, 0x79, 0x7f, 0x62, 0x07, 0xd3, 0xf9,
-	0x88, 0x19, 0xf1, 0x48, 0x9c, 0x7c, 0xd7, 0x26, 0xf2, 0xeb, 0x51, 0x78, 0xdf, 0x1e, 0x71, 0x61,
-	0x8f, 0xb8, 0xb0, 0xd9, 0x68, 0x54, 0xe1, 0xcb, 0xc7, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x9d,
-	0x23, 0x87, 0xd7, 0xe3, 0x1f, 0x00, 0x00,
+	// 2128 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x19, 0x5b, 0x6f, 0xdb, 0xd6,
+	0xd9, 0x14, 0x25, 0x5b, 0xfa, 0x74, 0x63, 0x4e, 0x12, 0x4d, 0xd1, 0x9a, 0xd6, 0x65, 0x9a, 0x4b,
+	0xdd, 0x55, 0x29, 0x9c, 0x0e, 0xd8, 0x30, 0xf4, 0xa1, 0xb6, 0x12, 0x4f, 0x5b, 0xe2, 0x7a, 0x74,
+	0xd6, 0x61, 0x41, 0x30, 0x96, 0x12, 0x8f, 0x65, 0xae, 0x24, 0x8f, 0xcc, 0x43, 0xc5, 0x89, 0xdf,
+	0x0a, 0x0c, 0xd8, 0xfe, 0xc0, 0x9e, 0xb6, 0x97, 0x3d, 0xee, 0x61, 0xff, 0xa0, 0x03, 0xf6, 0x4b,
+	0x06, 0x0c, 0x28, 0x30, 0xec, 0x6d, 0x3f, 0x60, 0x0f, 0xc3, 0xb9, 0x90, 0xe2, 0x4d, 0xb6, 0x62,
+	0x37, 0x4d, 0x31, 0xec, 0x8d, 0xe7, 0x3b, 0xdf, 0x39, 0xdf, 0xfd, 0x72, 0x3e, 0xc2, 0xa5, 0xa3,
+	0x19, 0x0e, 0x5e, 0x98, 0x63, 0x42, 0x02, 0xbb, 0x3f, 0x0d, 0x48, 0x48, 0x10, 0xf2, 0x1c, 0xf7,
+	0xd9, 0x8c, 0x8a, 0x55, 0x9f, 0xef, 0xf7, 0x1a, 0x63, 0xe2, 0x79, 0xc4, 0x17, 0xb0, 0x5e, 0x23,
+	0x89, 0xd1, 0x6b, 0x39, 0x7e, 0x88, 0x03, 0xdf, 0x72, 0xa3, 0x5d, 0x3a, 0x3e, 0xc4, 0x9e, 0x25,
+	0x57, 0x9a, 0x6d, 0x85, 0x56, 0xf2, 0x7e, 0xfd, 0x57, 0xd0, 0xd9, 0x3f, 0x24, 0xc7, 0xdb, 0xc4,
+	0x75, 0xf1, 0x38, 0x74, 0x88, 0x4f, 0x0d, 0x7c, 0x34, 0xc3, 0x34, 0x44, 0x1f, 0x40, 0x79, 0x64,
+	0x51, 0xdc, 0x55, 0xd6, 0x95, 0x3b, 0xf5, 0xcd, 0x37, 0xfa, 0x29, 0x46, 0x24, 0x07, 0x8f, 0xe8,
+	0x64, 0xcb, 0xa2, 0xd8, 0xe0, 0x98, 0x08, 0x41, 0xd9, 0x1e, 0x0d, 0x07, 0xdd, 0xd2, 0xba, 0x72,
+	0x47, 0x35, 0xf8, 0xb7, 0x1e, 0xc2, 0x77, 0x72, 0xf7, 0xd3, 0x29, 0xf1, 0x29, 0x46, 0xf7, 0x60,
+	0x95, 0x86, 0x56, 0x38, 0xa3, 0x92, 0xc4, 0x77, 0x0b, 0x49, 0xec, 0x73, 0x14, 0x43, 0xa2, 0xa2,
+	0x77, 0xa0, 0x39, 0x8e, 0xef, 0x1a, 0x0e, 0x68, 0xb7, 0xb4, 0xae, 0xde, 0x51, 0x8d, 0x34, 0x50,
+	0xff, 0x42, 0x81, 0xab, 0x8c, 0xec, 0x9e, 0x15, 0x84, 0xce, 0xd7, 0x2f, 0x15, 0xd2, 0xa1, 0x91,
+	0x24, 0xd8, 0x55, 0xf9, 0x5e, 0x0a, 0xa6, 0x1f, 0x41, 0x27, 0xcb, 0xc2, 0x45, 0x04, 0xd7, 0xa1,
+	0x31, 0x8d, 0xae, 0x9a, 0xcb, 0x9d, 0x82, 0xe9, 0xff, 0x54, 0xe0, 0xea, 0x43, 0x62, 0xd9, 0x73,
+	0x6d, 0x7f, 0xe3, 0x62, 0xa3, 0x8f, 0x60, 0x55, 0xb8, 0x5c, 0xb7, 0xcc, 0x69, 0xdd, 0x4c, 0xd3,
+	0x12, 0x7b, 0xfd, 0x39, 0x87, 0xfb, 0x1c, 0x60, 0xc8, 0x43, 0xe8, 0x26, 0xb4, 0x02, 0x3c, 0x75,
+	0x9d, 0xb1, 0x65, 0xfa, 0x33, 0x6f, 0x84, 0x83, 0x6e, 0x65, 0x5d, 0xb9, 0x53, 0x31, 0x9a, 0x12,
+	0xba, 0xcb, 0x81, 0xfa, 0x1f, 0x15, 0xe8, 0x1a, 0xd8, 0xc5, 0x16, 0xc5, 0xaf, 0x53, 0xd8, 0x0e,
+	0xac, 0xfa, 0xc4, 0xc6, 0xc3, 0x01, 0x17, 0x56, 0x35, 0xe4, 0x4a, 0xff, 0x4a, 0x1a, 0xe2, 0x35,
+	0xfa, 0x5f, 0xce, 0x61, 0xca, 0x79, 0x87, 0x49, 0x18, 0xab, 0x72, 0x0e, 0x63, 0xe9, 0x7f, 0x9b,
+	0x5b, 0xe1, 0xdb, 0x2e, 0xe9, 0xdc, 0x52, 0x95, 0x94, 0xa5, 0x7e, 0x09, 0xd7, 0xb6, 0x03, 0x6c,
+	0x85, 0xf8, 0x67, 0x2c, 0xb5, 0x6e, 0x1f, 0x5a, 0xbe, 0x8f, 0xdd, 0x48, 0x84, 0x2c, 0x71, 0xa5,
+	0x80, 0x78, 0x17, 0xd6, 0xa6, 0x01, 0x79, 0xfe, 0x22, 0xe6, 0x3b, 0x5a, 0xea, 0x7f, 0x52, 0xa0,
+	0x57, 0x74, 0xf7, 0x45, 0xb2, 0xc0, 0x6d, 0x68, 0x07, 0x82, 0x39, 0x73, 0x2c, 0xee, 0xe3, 0x54,
+	0x6b, 0x46, 0x4b, 0x82, 0x25, 0x15, 0x11, 0x47, 0x74, 0xe6, 0xce, 0xf1, 0x54, 0x8e, 0xd7, 0x14,
+	0x50, 0x89, 0xa6, 0xff, 0x59, 0x81, 0x6b, 0x3b, 0x38, 0x8c, 0xad, 0xc7, 0xc8, 0xe1, 0x6f, 0xa7,
+	0x09, 0x75, 0x0f, 0xda, 0x19, 0x3e, 0xd1, 0x3a, 0xd4, 0x13, 0x28, 0xd2, 0x3e, 0x49, 0x10, 0xfa,
+	0x01, 0x54, 0x98, 0xea, 0x30, 0xe7, 0xa8, 0xb5, 0xa9, 0xf7, 0xf3, 0xf5, 0xb4, 0x9f, 0xbe, 0xd5,
+	0x10, 0x07, 0xf4, 0xbf, 0x28, 0xd0, 0x2b, 0x52, 0xcd, 0x45, 0xcc, 0xf7, 0x04, 0x3a, 0x31, 0x73,
+	0xa6, 0x8d, 0xe9, 0x38, 0x70, 0xa6, 0xec, 0x5b, 0xa4, 0xf3, 0xfa, 0xe6, 0x8d, 0xb3, 0xd9, 0xa3,
+	0xc6, 0xd5, 0xf8, 0x8a, 0x41, 0xe2, 0x06, 0xdd, 0x81, 0xab, 0x3b, 0x38, 0xdc, 0xc7, 0x13, 0x0f,
+	0xfb, 0xe1, 0xd0, 0x3f, 0x20, 0xe7, 0xb7, 0xe2, 0x9b, 0x00, 0x54, 0xde, 0x13, 0x57, 0x9a, 0x04,
+	0x44, 0xff, 0x52, 0x85, 0x7a, 0x82, 0x10, 0x7a, 0x03, 0x6a, 0xf1, 0xae, 0x34, 0xc2, 0x1c, 0x90,
+	0xb3, 0x7f, 0xa9, 0xc0, 0xfe, 0x19, 0x43, 0xaa, 0x79, 0x43, 0x2e, 0x48, 0xb5, 0xe8, 0x1a, 0x54,
+	0x3d, 0xec, 0x99, 0xd4, 0x39, 0xc1, 0x32, 0xb4, 0xd7, 0x3c, 0xec, 0xed, 0x3b, 0x27, 0x98, 0x6d,
+	0xf9, 0x33, 0xcf, 0x0c, 0xc8, 0x31, 0xed, 0xae, 0x8a, 0x2d, 0x7f, 0xe6, 0x19, 0xe4, 0x98, 0xa2,
+	0xeb, 0x00, 0x8e, 0x6f, 0xe3, 0xe7, 0xa6, 0x6f, 0x79, 0xb8, 0xbb, 0xc6, 0x43, 0xa3, 0xc6, 0x21,
+	0xbb, 0x96, 0x87, 0x59, 0x50, 0xf3, 0xc5, 0x70, 0xd0, 0xad, 0x8a, 0x83, 0x72, 0xc9, 0x44, 0x95,
+	0x01, 0x35, 0x1c, 0x74, 0x6b, 0xe2, 0x5c, 0x0c, 0x40, 0xf7, 0xa1, 0x29, 0xe5, 0x36, 0x85, 0xd7,
+	0x01, 0xf7, 0xba, 0xf5, 0x22, 0xb3, 0x4a, 0x05, 0x0a, 0x9f, 0x6b, 0xd0, 0xc4, 0x8a, 0x91, 0x17,
+	0xd2, 0xd1, 0x6e, 0x9d, 0x2b, 0x3f, 0x5a, 0xa2, 0x6d, 0xa8, 0x0b, 0xbe, 0x1d, 0xff, 0x80, 0xd0,
+	0x6e, 0x83, 0x7b, 0x4d, 0xa1, 0x53, 0x3f, 0x70, 0xb0, 0x6b, 0x0f, 0x39, 0xd7, 0xcc, 0x17, 0xc0,
+	0x89, 0x3e, 0xa9, 0x3e, 0x86, 0x56, 0x7a, 0x97, 0x11, 0x3c, 0xe0, 0x90, 0xc8, 0x7c, 0xd1, 0x32,
+	0xa3, 0xa8, 0xd2, 0x29, 0x8a, 0x52, 0x53, 0x8a, 0xd2, 0x7f, 0xa3, 0x40, 0x27, 0xeb, 0x8f, 0x17,
+	0x09, 0x9d, 0xef, 0x43, 0x45, 0xc8, 0x2c, 0x22, 0xe5, 0xad, 0x53, 0x54, 0xca, 0x89, 0x09, 0x6c,
+	0xfd, 0xd7, 0x80, 0x76, 0x70, 0x68, 0x88, 0xe6, 0xe1, 0x02, 0x89, 0x6d, 0x09, 0x27, 0xd6, 0x7f,
+	0xab, 0xc0, 0xe5, 0x14, 0xb1, 0x8b, 0xc8, 0xfb, 0x23, 0xa8, 0xca, 0x96, 0xe7, 0x54, 0x91, 0x25,
+	0x31, 0x2e, 0x72, 0x7c, 0x40, 0xff, 0x8f, 0x02, 0x9d, 0x8f, 0x6d, 0xbb, 0xa8, 0xa6, 0xbd, 0xbc,
+	0xe8, 0xf3, 0xc8, 0x2b, 0xa5, 0x22, 0x6f, 0x99, 0xbc, 0xfe, 0x1e, 0x5c, 0xca, 0xd4, 0x2b, 0x19,
+	0xc0, 0x35, 0x43, 0x4b, 0x57, 0xac, 0xe1, 0x00, 0xbd, 0x0b, 0x5a, 0xba, 0x66, 0xc9, 0x6a, 0x5d,
+	0x33, 0xda, 0xa9, 0xaa, 0x25, 0xc2, 0x50, 0x0a, 0x3b, 0x1c, 0xc8, 0xd8, 0x9e, 0x03, 0xf4, 0x7f,
+	0x28, 0x70, 0xcd, 0xc0, 0x1e, 0x79, 0x86, 0xff, 0x67, 0x35, 0xa0, 0x7f, 0xa1, 0x42, 0xe7, 0x17,
+	0x56, 0x38, 0x3e, 0x1c, 0x78, 0x12, 0x48, 0x5f, 0x8f, 0x80, 0x99, 0xd4, 0x5d, 0xce, 0xa7, 0xee,
+	0x38, 0x74, 0x2b, 0x45, 0x7e, 0xcc, 0x9e, 0xa4, 0xfd, 0x4f, 0x23, 0x79, 0xe7, 0xa1, 0x9b, 0x68,
+	0x4e, 0x57, 0xcf, 0xf3, 0x92, 0xd8, 0x86, 0x26, 0x7e, 0x3e, 0x76, 0x67, 0x36, 0x96, 0xc9, 0x72,
+	0x8d, 0x53, 0x7f, 0xb3, 0x80, 0x7a, 0x32, 0x6f, 0x34, 0xe4, 0xa1, 0x21, 0xe7, 0x21, 0xe5, 0x67,
+	0xd5, 0xac, 0x9f, 0x7d, 0xa9, 0x42, 0x5b, 0x9e, 0x65, 0xdd, 0xfe, 0x12, 0xb5, 0x30, 0xa3, 0xac,
+	0x52, 0x5e, 0x59, 0xcb, 0xa8, 0x3c, 0xea, 0xb2, 0xca, 0x89, 0x2e, 0xeb, 0x3a, 0xc0, 0x81, 0x3b,
+	0xa3, 0x87, 0x66, 0xe8, 0x78, 0x51, 0x25, 0xac, 0x71, 0xc8, 0x63, 0xc7, 0xc3, 0xe8, 0x63, 0x68,
+	0x8c, 0x1c, 0xdf, 0x25, 0x13, 0x73, 0x6a, 0x85, 0x87, 0xac, 0x1e, 0x2e, 0x52, 0x06, 0x2f, 0x0d,
+	0x5b, 0x1c, 0xd7, 0xa8, 0x8b, 0x33, 0x7b, 0xec, 0x08, 0xfa, 0x08, 0x6a, 0x36, 0x76, 0x43, 0xcb,
+	0x25, 0x93, 0x48, 0x99, 0x45, 0xa6, 0x1c, 0x30, 0x9c, 0x87, 0x64, 0xc2, 0xb5, 0x39, 0x3f, 0x81,
+	0x6e, 0x41, 0x6b, 0x4c, 0xbc, 0xa9, 0xc5, 0x85, 0x78, 0x10, 0x10, 0xaf, 0x5b, 0xe5, 0xb5, 0x2d,
+	0x03, 0x45, 0xf7, 0xa1, 0x61, 0x7b, 0xae, 0x39, 0x25, 0x94, 0xab, 0x84, 0x17, 0xd9, 0x5c, 0x8d,
+	0x8b, 0xa7, 0x1c, 0x8f, 0xe8, 0x64, 0x4f, 0x62, 0x1a, 0x75, 0xdb, 0x73, 0xa3, 0x05, 0x7a, 0x1b,
+	0x1a, 0x71, 0x29, 0x76, 0x4e, 0x44, 0x25, 0x56, 0x8d, 0x7a, 0x54, 0x67, 0x9d, 0x13, 0xac, 0xff,
+	0xab, 0x04, 0x97, 0x99, 0xdd, 0xa4, 0x09, 0x5f, 0x41, 0xfc, 0xfc, 0x30, 0xf2, 0x7c, 0x75, 0x71,
+	0x7b, 0x97, 0x71, 0xa0, 0xbc, 0xf7, 0x9f, 0xeb, 0x1d, 0xfd, 0x53, 0x68, 0xb9, 0xc4, 0xb2, 0xcd,
+	0x31, 0xf1, 0x6d, 0xa1, 0xc7, 0x0a, 0x6f, 0x45, 0xde, 0x29, 0x62, 0xe1, 0x71, 0xe0, 0x4c, 0x26,
+	0x38, 0xd8, 0x8e, 0x70, 0x8d, 0xa6, 0xcb, 0xa7, 0x08, 0x72, 0x79, 0x7a, 0xb6, 0x45, 0x37, 0xa0,
+	0x49, 0xc9, 0x2c, 0x18, 0x63, 0x53, 0xea, 0x60, 0x4d, 0xb8, 0xac, 0x00, 0xee, 0x72, 0x98, 0xfe,
+	0x77, 0x05, 0x3a, 0xf2, 0xa9, 0xf8, 0xea, 0xd4, 0x1d, 0xc5, 0x85, 0x7a, 0xca, 0xeb, 0xa3, 0xbc,
+	0xc4, 0xeb, 0xa3, 0x52, 0xf0, 0x80, 0x4c, 0xf7, 0xc4, 0xab, 0xb9, 0x9e, 0xf8, 0x31, 0x34, 0xe3,
+	0x4c, 0xcc, 0x13, 0xc1, 0x0d, 0x68, 0x0a, 0xb6, 0x4c, 0xa6, 0x4c, 0x6c, 0x47, 0xaf, 0x47, 0x01,
+	0x7c, 0xc8, 0x61, 0xec, 0xd6, 0x38, 0xd3, 0x8b, 0x3a, 0x5f, 0x33, 0x12, 0x10, 0xdd, 0x81, 0x7a,
+	0xa2, 0xc2, 0xa7, 0x0d, 0xa1, 0x64, 0x0d, 0xb1, 0x4c, 0xa3, 0x9d, 0x68, 0x2d, 0xd5, 0x54, 0x6b,
+	0xa9, 0xff, 0x5e, 0x01, 0x2d, 0x59, 0x2e, 0x39, 0xc1, 0x65, 0x5e, 0xc0, 0xb7, 0xa1, 0x2d, 0xe7,
+	0x96, 0x71, 0xcd, 0x92, 0x6f, 0xd2, 0xa3, 0xe4, 0x75, 0x03, 0xf4, 0x21, 0x74, 0x04, 0x62, 0xae,
+	0xc6, 0x89, 0xb7, 0xe9, 0x15, 0xbe, 0x6b, 0x64, 0x0a, 0xdd, 0x5f, 0x4b, 0xd0, 0x9a, 0xbb, 0xf9,
+	0xd2, 0x5c, 0x2d, 0x31, 0x2f, 0x43, 0x0f, 0xa0, 0x29, 0x79, 0x30, 0x93, 0x61, 0xfa, 0x76, 0x51,
+	0x8c, 0xa4, 0x8c, 0x6b, 0x34, 0x12, 0xf5, 0x8a, 0xbf, 0xca, 0x65, 0xb0, 0x45, 0x0c, 0x70, 0x37,
+	0xab, 0x1a, 0x2d, 0x37, 0x35, 0x8d, 0xbb, 0xe0, 0xbc, 0x05, 0xdd, 0x83, 0xab, 0x81, 0x88, 0x21,
+	0xdb, 0x4c, 0x09, 0x27, 0xdc, 0xf1, 0x4a, 0xb4, 0xb9, 0x97, 0xd8, 0x63, 0x5d, 0x69, 0xfb, 0xc7,
+	0x96, 0x6f, 0x93, 0x83, 0x83, 0x28, 0xf2, 0xce, 0x11, 0x72, 0x5b, 0x71, 0x3a, 0x1d, 0x26, 0xba,
+	0xf0, 0x33, 0x8b, 0x69, 0xf2, 0x8c, 0xfe, 0x87, 0x12, 0x74, 0x98, 0xdf, 0x6f, 0x59, 0xae, 0xe5,
+	0x8f, 0xf1, 0xf2, 0x2f, 0xc8, 0xaf, 0xa7, 0x6a, 0xe6, 0xf2, 0x54, 0x39, 0x9f, 0xa7, 0x58, 0x19,
+	0xb5, 0x69, 0x68, 0xa6, 0x66, 0x45, 0x35, 0x9b, 0x86, 0x72, 0xfb, 0x2d, 0xa8, 0xcb, 0x3b, 0x6c,
+	0xe2, 0x63, 0x9e, 0x0b, 0xab, 0x06, 0x08, 0xd0, 0x80, 0xf8, 0xfc, 0xcd, 0xc9, 0xce, 0xf3, 0xdd,
+	0x35, 0xbe, 0xbb, 0x66, 0xd3, 0x90, 0x6f, 0x5d, 0x07, 0x78, 0x66, 0xb9, 0x8e, 0xcd, 0x7d, 0x8d,
+	0x37, 0x13, 0x55, 0xa3, 0xc6, 0x21, 0x4c, 0x05, 0xfa, 0xef, 0x4a, 0x80, 0x12, 0xda, 0x39, 0x7f,
+	0x76, 0xbc, 0x09, 0xad, 0x94, 0x9c, 0xf1, 0x8c, 0x3c, 0x29, 0x28, 0x65, 0x15, 0x62, 0x24, 0x48,
+	0x99, 0x01, 0xb6, 0x28, 0xf1, 0xbb, 0xea, 0xcb, 0x54, 0x88, 0x51, 0xc4, 0x26, 0x3b, 0xca, 0xf4,
+	0x32, 0x57, 0x5b, 0x34, 0xbe, 0x81, 0x58, 0x6f, 0x94, 0xb5, 0xc1, 0x14, 0x5b, 0x2e, 0xb6, 0xcd,
+	0x44, 0x16, 0x15, 0x79, 0x56, 0x13, 0x1b, 0xfb, 0x31, 0x7c, 0xe3, 0x04, 0x5a, 0xe9, 0xa1, 0x07,
+	0x6a, 0x40, 0x75, 0x97, 0x84, 0xf7, 0x9f, 0x3b, 0x34, 0xd4, 0x56, 0x50, 0x0b, 0x60, 0x97, 0x84,
+	0x7b, 0x01, 0xa6, 0xd8, 0x0f, 0x35, 0x05, 0x01, 0xac, 0x7e, 0xe2, 0x0f, 0x1c, 0xfa, 0xb9, 0x56,
+	0x42, 0x97, 0xe5, 0x94, 0xc8, 0x72, 0x87, 0xfe, 0x23, 0xec, 0x91, 0xe0, 0x85, 0xa6, 0xb2, 0xe3,
+	0xf1, 0xaa, 0x8c, 0x34, 0x68, 0xc4, 0x28, 0x3b, 0x7b, 0x3f, 0xd7, 0x2a, 0xa8, 0x06, 0x15, 0xf1,
+	0xb9, 0xba, 0xf1, 0x09, 0x68, 0x59, 0x61, 0x51, 0x1d, 0xd6, 0x0e, 0x45, 0x04, 0x69, 0x2b, 0xa8,
+	0x0d, 0x75, 0x77, 0x6e, 0x26, 0x4d, 0x61, 0x80, 0x49, 0x30, 0x1d, 0x4b, 0x83, 0x69, 0x25, 0x46,
+	0x8d, 0x29, 0x62, 0x40, 0x8e, 0x7d, 0x4d, 0xdd, 0xf8, 0x09, 0x34, 0x92, 0x4f, 0x7d, 0x54, 0x85,
+	0xf2, 0x2e, 0xf1, 0xb1, 0xb6, 0xc2, 0xae, 0xdd, 0x09, 0xc8, 0xb1, 0xe3, 0x4f, 0x84, 0x0c, 0x0f,
+	0x02, 0x72, 0x82, 0x7d, 0xad, 0xc4, 0x36, 0x98, 0x4e, 0xd8, 0x86, 0xca, 0x36, 0x84, 0x82, 0xb4,
+	0xf2, 0xe6, 0xbf, 0x01, 0x40, 0xe4, 0x68, 0xf6, 0x0b, 0x07, 0x4d, 0xf9, 0xe3, 0x76, 0x9b, 0x78,
+	0x53, 0xe2, 0x47, 0xf7, 0x53, 0xf4, 0xc1, 0x82, 0x56, 0x29, 0x8f, 0x2a, 0x59, 0xee, 0xdd, 0x5a,
+	0x70, 0x22, 0x83, 0xae, 0xaf, 0x20, 0x8f, 0x53, 0x64, 0x1d, 0xe5, 0x63, 0x67, 0xfc, 0x79, 0x34,
+	0x6c, 0x3c, 0x85, 0x62, 0x06, 0x35, 0xa2, 0x98, 0xe9, 0x84, 0xe4, 0x62, 0x3f, 0x0c, 0x1c, 0x7f,
+	0x12, 0xbd, 0x9b, 0xf5, 0x15, 0x74, 0x04, 0x57, 0xd8, 0x0c, 0x21, 0xb4, 0x42, 0x87, 0x86, 0xce,
+	0x98, 0x46, 0x04, 0x37, 0x17, 0x13, 0xcc, 0x21, 0xbf, 0x24, 0x49, 0x17, 0xda, 0x99, 0x1f, 0x56,
+	0x68, 0xa3, 0xb0, 0x6d, 0x2b, 0xfc, 0x6b, 0xd6, 0x7b, 0x6f, 0x29, 0xdc, 0x98, 0x9a, 0x03, 0xad,
+	0xf4, 0x4f, 0x22, 0xf4, 0xee, 0xa2, 0x0b, 0x72, 0x13, 0xf6, 0xde, 0xc6, 0x32, 0xa8, 0x31, 0xa9,
+	0x27, 0xd0, 0x4a, 0xff, 0x92, 0x28, 0x26, 0x55, 0xf8, 0xdb, 0xa2, 0x77, 0xda, 0xc8, 0x42, 0x5f,
+	0x41, 0x9f, 0xc1, 0xa5, 0xdc, 0x7f, 0x00, 0xf4, 0xbd, 0xe2, 0x79, 0x45, 0xf1, 0xef, 0x82, 0xb3,
+	0x28, 0x48, 0xee, 0x13, 0xb5, 0x74, 0x21, 0xf7, 0xb9, 0x1f, 0x42, 0xcb, 0x73, 0x9f, 0xb8, 0xfe,
+	0x34, 0xee, 0x5f, 0x9a, 0xc2, 0x0c, 0x50, 0xfe, 0x4f, 0x00, 0x7a, 0xbf, 0x88, 0xc4, 0xc2, 0xbf,
+	0x11, 0xbd, 0xfe, 0xb2, 0xe8, 0xb1, 0xc9, 0x67, 0x3c, 0x5a, 0xb3, 0x43, 0xf3, 0x42, 0xb2, 0x0b,
+	0x7f, 0x02, 0xf4, 0xfa, 0xcb, 0xa2, 0x27, 0x9d, 0x3a, 0x3d, 0xf9, 0x2b, 0xb6, 0x55, 0xe1, 0xb4,
+	0xba, 0xb7, 0xb1, 0x0c, 0x6a, 0x4c, 0xea, 0x33, 0xa8, 0x27, 0x26, 0x6e, 0xe8, 0xd6, 0x82, 0xc3,
+	0x99, 0xf9, 0x5f, 0xef, 0xf6, 0x99, 0x78, 0x11, 0x85, 0xcd, 0xaf, 0xaa, 0x50, 0xe3, 0xea, 0x65,
+	0x95, 0xec, 0xff, 0x19, 0xf7, 0x15, 0x64, 0xdc, 0xa7, 0xd0, 0xce, 0xcc, 0x2a, 0x8b, 0x33, 0x6e,
+	0xf1, 0x40, 0xf3, 0xac, 0xd0, 0x1b, 0x01, 0xca, 0x8f, 0x02, 0x8b, 0x63, 0x60, 0xe1, 0xc8, 0xf0,
+	0x2c, 0x1a, 0x4f, 0xa1, 0x9d, 0x19, 0xc5, 0x15, 0x4b, 0x50, 0x3c, 0xaf, 0x3b, 0xeb, 0xf6, 0x4f,
+	0xa1, 0x91, 0x9c, 0x52, 0xa0, 0xdb, 0x8b, 0x12, 0x5f, 0xe6, 0x61, 0xfd, 0xfa, 0xd3, 0xde, 0xab,
+	0x2f, 0x0b, 0x4f, 0xa1, 0x9d, 0x99, 0x2a, 0x14, 0x6b, 0xbe, 0x78, 0xf4, 0x70, 0xd6, 0xed, 0xdf,
+	0x5c, 0x22, 0xdb, 0xfa, 0xf0, 0xc9, 0xe6, 0xc4, 0x09, 0x0f, 0x67, 0x23, 0xc6, 0xc4, 0x5d, 0x71,
+	0xf2, 0x7d, 0x87, 0xc8, 0xaf, 0xbb, 0x51, 0xbc, 0xdd, 0xe5, 0x97, 0xdd, 0xe5, 0x97, 0x4d, 0x47,
+	0xa3, 0x55, 0xbe, 0xbc, 0xf7, 0xdf, 0x01, 0x00, 0x3d, 0x78, 0x64, 0x56, 0x39, 0x24, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2081,6 +2411,7 @@ type QueryCoordClient interface {
 	CreateQueryChannel(ctx context.Context, in *CreateQueryChannelRequest, opts ...grpc.CallOption) (*CreateQueryChannelResponse, error)
 	GetPartitionStates(ctx context.Context, in *GetPartitionStatesRequest, opts ...grpc.CallOption) (*GetPartitionStatesResponse, error)
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	GetReplicas(ctx context.Context, in *GetReplicasRequest, opts ...grpc.CallOption) (*GetReplicasResponse, error)
 }
 
 type queryCoordClient struct {
@@ -2199,6 +2530,15 @@ func (c *queryCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInf
 	return out, nil
 }
 
+func (c *queryCoordClient) GetReplicas(ctx context.Context, in *GetReplicasRequest, opts ...grpc.CallOption) (*GetReplicasResponse, error) {
+	out := new(GetReplicasResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetReplicas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryCoordServer is the server API for QueryCoord service.
 type QueryCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -2213,6 +2553,7 @@ type QueryCoordServer interface {
 	CreateQueryChannel(context.Context, *CreateQueryChannelRequest) (*CreateQueryChannelResponse, error)
 	GetPartitionStates(context.Context, *GetPartitionStatesRequest) (*GetPartitionStatesResponse, error)
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
+	GetReplicas(context.Context, *GetReplicasRequest) (*GetReplicasResponse, error)
 }
 
 // UnimplementedQueryCoordServer can be embedded to have forward compatible implementations.
@@ -2255,6 +2596,9 @@ func (*UnimplementedQueryCoordServer) GetPartitionStates(ctx context.Context, re
 func (*UnimplementedQueryCoordServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
 }
+func (*UnimplementedQueryCoordServer) GetReplicas(ctx context.Context, req *GetReplicasRequest) (*GetReplicasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicas not implemented")
+}
 
 func RegisterQueryCoordServer(s *grpc.Server, srv QueryCoordServer) {
 	s.RegisterService(&_QueryCoord_serviceDesc, srv)
@@ -2476,6 +2820,24 @@ func _QueryCoord_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryCoord_GetReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetReplicas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetReplicas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetReplicas(ctx, req.(*GetReplicasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.query.QueryCoord",
 	HandlerType: (*QueryCoordServer)(nil),
@@ -2528,6 +2890,10 @@ var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSegmentInfo",
 			Handler:    _QueryCoord_GetSegmentInfo_Handler,
 		},
+		{
+			MethodName: "GetReplicas",
+			Handler:    _QueryCoord_GetReplicas_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query_coord.proto",
@@ -233,65 +233,194 @@ func (m *AllocIDResponse) GetCount() uint32 {
 	return 0
 }
 
+type GetCredentialRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCredentialRequest) Reset()         { *m = GetCredentialRequest{} }
+func (m *GetCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCredentialRequest) ProtoMessage()    {}
+func (*GetCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{4}
+}
+
+func (m *GetCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCredentialRequest.Unmarshal(m, b)
+}
+func (m *GetCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCredentialRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCredentialRequest.Merge(m, src)
+}
+func (m *GetCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCredentialRequest.Size(m)
+}
+func (m *GetCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCredentialRequest proto.InternalMessageInfo
+
+func (m *GetCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type GetCredentialResponse struct {
+	Status               *commonpb.Status       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Username             string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	PasswordHash         string                 `protobuf:"bytes,3,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
+	Roles                []*milvuspb.RoleGrants `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetCredentialResponse) Reset()         { *m = GetCredentialResponse{} }
+func (m *GetCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCredentialResponse) ProtoMessage()    {}
+func (*GetCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{5}
+}
+
+func (m *GetCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCredentialResponse.Unmarshal(m, b)
+}
+func (m *GetCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCredentialResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCredentialResponse.Merge(m, src)
+}
+func (m *GetCredentialResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCredentialResponse.Size(m)
+}
+func (m *GetCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCredentialResponse proto.InternalMessageInfo
+
+func (m *GetCredentialResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCredentialResponse) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *GetCredentialResponse) GetPasswordHash() string {
+	if m != nil {
+		return m.PasswordHash
+	}
+	return ""
+}
+
+func (m *GetCredentialResponse) GetRoles() []*milvuspb.RoleGrants {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AllocTimestampRequest)(nil), "milvus.proto.rootcoord.AllocTimestampRequest")
 	proto.RegisterType((*AllocTimestampResponse)(nil), "milvus.proto.rootcoord.AllocTimestampResponse")
 	proto.RegisterType((*AllocIDRequest)(nil), "milvus.proto.rootcoord.AllocIDRequest")
 	proto.RegisterType((*AllocIDResponse)(nil), "milvus.proto.rootcoord.AllocIDResponse")
+	proto.RegisterType((*GetCredentialRequest)(nil), "milvus.proto.rootcoord.GetCredentialRequest")
+	proto.RegisterType((*GetCredentialResponse)(nil), "milvus.proto.rootcoord.GetCredentialResponse")
 }
 
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 765 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x5b, 0x4f, 0xdb, 0x30,
-	0x14, 0xc7, 0x69, 0x61, 0x4c, 0x1c, 0xda, 0xc2, 0x2c, 0x60, 0xa8, 0xe3, 0x81, 0x75, 0x1a, 0xb4,
-	0x5c, 0x52, 0x04, 0xd2, 0xb4, 0xd7, 0xd1, 0x6a, 0x50, 0x69, 0x48, 0x23, 0x05, 0x69, 0x37, 0x54,
-	0xb9, 0xe9, 0x51, 0x1b, 0x91, 0xc4, 0x21, 0x76, 0x07, 0x7b, 0xdc, 0x27, 0xdd, 0x57, 0x99, 0x72,
-	0x73, 0x93, 0x34, 0x29, 0x41, 0xdb, 0x5b, 0x1d, 0xff, 0xfc, 0xff, 0xfb, 0x5c, 0x2a, 0x1f, 0x58,
-	0x75, 0x18, 0x13, 0x3d, 0x8d, 0x31, 0x67, 0xa0, 0xd8, 0x0e, 0x13, 0x8c, 0x6c, 0x98, 0xba, 0xf1,
-	0x73, 0xcc, 0xfd, 0x95, 0xe2, 0x6e, 0x7b, 0xbb, 0xd5, 0x92, 0xc6, 0x4c, 0x93, 0x59, 0xfe, 0xf7,
-	0x6a, 0x29, 0x4a, 0x55, 0x2b, 0xba, 0x25, 0xd0, 0xb1, 0xa8, 0x11, 0xac, 0x97, 0x6d, 0x87, 0x3d,
-	0xfc, 0x0a, 0x16, 0xab, 0x03, 0x2a, 0x68, 0xd4, 0xa2, 0xd6, 0x83, 0xf5, 0x0f, 0x86, 0xc1, 0xb4,
-	0x2b, 0xdd, 0x44, 0x2e, 0xa8, 0x69, 0xab, 0x78, 0x37, 0x46, 0x2e, 0xc8, 0x11, 0x2c, 0xf4, 0x29,
-	0xc7, 0xcd, 0xc2, 0x76, 0xa1, 0xbe, 0x7c, 0xbc, 0xa5, 0xc4, 0xae, 0x12, 0xf8, 0x5f, 0xf0, 0xe1,
-	0x29, 0xe5, 0xa8, 0x7a, 0x24, 0x59, 0x83, 0x67, 0x1a, 0x1b, 0x5b, 0x62, 0x73, 0x7e, 0xbb, 0x50,
-	0x2f, 0xab, 0xfe, 0xa2, 0xf6, 0xbb, 0x00, 0x1b, 0x49, 0x07, 0x6e, 0x33, 0x8b, 0x23, 0x39, 0x81,
-	0x45, 0x2e, 0xa8, 0x18, 0xf3, 0xc0, 0xe4, 0x55, 0xaa, 0x49, 0xd7, 0x43, 0xd4, 0x00, 0x25, 0x5b,
-	0xb0, 0x24, 0x42, 0xa5, 0xcd, 0xe2, 0x76, 0xa1, 0xbe, 0xa0, 0x4e, 0x3e, 0x64, 0xdc, 0xe1, 0x0b,
-	0x54, 0xbc, 0x2b, 0x74, 0xda, 0xff, 0x21, 0xba, 0x62, 0x54, 0xd9, 0x80, 0x15, 0xa9, 0xfc, 0x2f,
-	0x51, 0x55, 0xa0, 0xd8, 0x69, 0x7b, 0xd2, 0xf3, 0x6a, 0xb1, 0xd3, 0x4e, 0x8f, 0xe3, 0xf8, 0xcf,
-	0x0b, 0x58, 0x52, 0x19, 0x13, 0x2d, 0xb7, 0x80, 0xc4, 0x06, 0x72, 0x86, 0xa2, 0xc5, 0x4c, 0x9b,
-	0x59, 0x68, 0x09, 0x57, 0x11, 0x39, 0x39, 0x8a, 0xdb, 0xc9, 0x6e, 0x98, 0x46, 0x83, 0x5c, 0x54,
-	0x77, 0x32, 0x4e, 0x24, 0xf0, 0xda, 0x1c, 0x31, 0x3d, 0x47, 0xb7, 0x90, 0x57, 0xba, 0x76, 0xdb,
-	0x1a, 0x51, 0xcb, 0x42, 0x63, 0x96, 0x63, 0x02, 0x0d, 0x1d, 0xdf, 0xc4, 0x4f, 0x04, 0x8b, 0xae,
-	0x70, 0x74, 0x6b, 0x18, 0xe6, 0xb1, 0x36, 0x47, 0xee, 0x60, 0xed, 0x0c, 0x3d, 0x77, 0x9d, 0x0b,
-	0x5d, 0xe3, 0xa1, 0xe1, 0x71, 0xb6, 0xe1, 0x14, 0xfc, 0x44, 0xcb, 0x1e, 0xac, 0xb6, 0x1c, 0xa4,
-	0x02, 0x5b, 0xcc, 0x30, 0x50, 0x13, 0x3a, 0xb3, 0xc8, 0x41, 0xea, 0xd1, 0x24, 0x16, 0x1a, 0xcd,
-	0x2a, 0x77, 0x6d, 0x8e, 0x7c, 0x87, 0x4a, 0xdb, 0x61, 0x76, 0x44, 0x7e, 0x2f, 0x55, 0x3e, 0x0e,
-	0xe5, 0x14, 0xef, 0x41, 0xf9, 0x9c, 0xf2, 0x88, 0x76, 0x23, 0x55, 0x3b, 0xc6, 0x84, 0xd2, 0xaf,
-	0x53, 0xd1, 0x53, 0xc6, 0x8c, 0x48, 0x7a, 0xee, 0x81, 0xb4, 0x91, 0x6b, 0x8e, 0xde, 0x8f, 0x26,
-	0x48, 0x49, 0x8f, 0x60, 0x0a, 0x0c, 0xad, 0x9a, 0xb9, 0x79, 0x69, 0x6c, 0xc1, 0x4a, 0x77, 0xc4,
-	0xee, 0x27, 0x7b, 0x9c, 0xec, 0xa7, 0x57, 0x34, 0x4e, 0x85, 0x96, 0x07, 0xf9, 0x60, 0xe9, 0x77,
-	0x03, 0x2b, 0x7e, 0x81, 0x3f, 0x53, 0x47, 0xe8, 0x5e, 0x94, 0xfb, 0x33, 0xda, 0x40, 0x52, 0x39,
-	0x0b, 0xf5, 0x15, 0xca, 0x6e, 0x81, 0x27, 0xe2, 0x8d, 0xcc, 0x26, 0x78, 0xaa, 0xf4, 0x0d, 0x94,
-	0xce, 0x29, 0x9f, 0x28, 0xd7, 0xb3, 0x5a, 0x60, 0x4a, 0x38, 0x57, 0x07, 0xdc, 0x42, 0xc5, 0xcd,
-	0x9a, 0x3c, 0xcc, 0x33, 0xfa, 0x37, 0x0e, 0x85, 0x16, 0xfb, 0xb9, 0xd8, 0x68, 0xd5, 0xc3, 0xae,
-	0xe8, 0xe2, 0xd0, 0x44, 0x4b, 0x64, 0x54, 0x21, 0x41, 0xcd, 0xae, 0xfa, 0x14, 0x2c, 0xfd, 0x10,
-	0x4a, 0xee, 0x5d, 0x82, 0x0d, 0x9e, 0x91, 0xbb, 0x28, 0x12, 0x3a, 0x35, 0x72, 0x90, 0xd2, 0xe6,
-	0x1a, 0x96, 0xfd, 0xb6, 0xe9, 0x58, 0x03, 0x7c, 0x20, 0xbb, 0x33, 0x1a, 0xcb, 0x23, 0x72, 0x56,
-	0x7e, 0x04, 0xe5, 0x30, 0x34, 0x5f, 0xb8, 0x31, 0x33, 0xfc, 0x98, 0xf4, 0x5e, 0x1e, 0x54, 0x06,
-	0x70, 0x09, 0x4b, 0x6e, 0x6b, 0xfa, 0x2e, 0x6f, 0x33, 0x5b, 0xf7, 0x29, 0x97, 0xbf, 0x0b, 0x9e,
-	0x68, 0x39, 0x25, 0x90, 0x43, 0x25, 0x7d, 0xfa, 0x51, 0x52, 0xe7, 0x95, 0xaa, 0x92, 0x17, 0x97,
-	0x51, 0xfc, 0x80, 0xe7, 0xc1, 0xdb, 0x4d, 0x76, 0x66, 0x1e, 0x96, 0x63, 0x43, 0x75, 0xf7, 0x51,
-	0x4e, 0xaa, 0x53, 0x58, 0xbf, 0xb6, 0x07, 0xee, 0x13, 0xe1, 0x3f, 0x44, 0xe1, 0x53, 0x98, 0xac,
-	0xca, 0xe4, 0xb9, 0x8d, 0x73, 0x17, 0x7c, 0xf8, 0x58, 0xce, 0x0c, 0x78, 0xa9, 0xa2, 0x81, 0x94,
-	0x63, 0xfb, 0xf2, 0xd3, 0x05, 0x72, 0x4e, 0x87, 0xd8, 0x15, 0x0e, 0x52, 0x33, 0xf9, 0x44, 0xfa,
-	0x33, 0x60, 0x06, 0x9c, 0xb3, 0x42, 0x1a, 0xac, 0x07, 0xbd, 0xfc, 0xd1, 0x18, 0xf3, 0x91, 0x3b,
-	0x1d, 0x18, 0x28, 0x70, 0x90, 0xfc, 0x4b, 0xba, 0x23, 0xa6, 0x92, 0x4a, 0x3e, 0x1e, 0xd2, 0xe9,
-	0xfb, 0x6f, 0xef, 0x86, 0xba, 0x18, 0x8d, 0xfb, 0xee, 0x4e, 0xd3, 0x47, 0x0f, 0x75, 0x16, 0xfc,
-	0x6a, 0x86, 0xc9, 0x6a, 0x7a, 0xa7, 0x9b, 0x32, 0xff, 0x76, 0xbf, 0xbf, 0xe8, 0x7d, 0x3a, 0xf9,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x39, 0x40, 0xa4, 0x9c, 0x46, 0x0b, 0x00, 0x00,
+	// 1033 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xef, 0x6e, 0xdb, 0x36,
+	0x17, 0xc6, 0xe3, 0x24, 0xed, 0xdb, 0x9c, 0xd8, 0x4e, 0x40, 0x34, 0x7d, 0x03, 0xaf, 0xc0, 0x32,
+	0x77, 0x4d, 0x9d, 0xa4, 0xb5, 0x8b, 0x14, 0x1b, 0xf6, 0x35, 0xb1, 0xd1, 0xc6, 0x40, 0x83, 0xad,
+	0x72, 0x83, 0xfd, 0xe9, 0x0a, 0x83, 0xb6, 0x0e, 0x6c, 0x21, 0x92, 0xa8, 0x88, 0x74, 0xd3, 0x7d,
+	0xdc, 0x85, 0xec, 0x6e, 0x76, 0x61, 0x03, 0x25, 0x91, 0x96, 0x6c, 0x49, 0xa1, 0xd7, 0x7d, 0xb3,
+	0xc4, 0x1f, 0x9f, 0x87, 0xe7, 0xf0, 0x1c, 0x99, 0x84, 0xdd, 0x90, 0x31, 0x31, 0x1c, 0x33, 0x16,
+	0xda, 0xed, 0x20, 0x64, 0x82, 0x91, 0x47, 0x9e, 0xe3, 0x7e, 0x9a, 0xf1, 0xf8, 0xa9, 0x2d, 0x87,
+	0xa3, 0xd1, 0x46, 0x75, 0xcc, 0x3c, 0x8f, 0xf9, 0xf1, 0xfb, 0x46, 0x35, 0x4d, 0x35, 0xea, 0x8e,
+	0x2f, 0x30, 0xf4, 0xa9, 0x9b, 0x3c, 0x6f, 0x07, 0x21, 0xfb, 0xfc, 0x47, 0xf2, 0xb0, 0x6b, 0x53,
+	0x41, 0xd3, 0x16, 0xcd, 0x21, 0xec, 0x9d, 0xb9, 0x2e, 0x1b, 0xbf, 0x77, 0x3c, 0xe4, 0x82, 0x7a,
+	0x81, 0x85, 0x37, 0x33, 0xe4, 0x82, 0xbc, 0x84, 0xcd, 0x11, 0xe5, 0xb8, 0x5f, 0x39, 0xa8, 0xb4,
+	0xb6, 0x4f, 0x1f, 0xb7, 0x33, 0x4b, 0x49, 0xfc, 0x2f, 0xf9, 0xe4, 0x9c, 0x72, 0xb4, 0x22, 0x92,
+	0x3c, 0x84, 0x7b, 0x63, 0x36, 0xf3, 0xc5, 0xfe, 0xc6, 0x41, 0xa5, 0x55, 0xb3, 0xe2, 0x87, 0xe6,
+	0x9f, 0x15, 0x78, 0xb4, 0xe8, 0xc0, 0x03, 0xe6, 0x73, 0x24, 0xaf, 0xe0, 0x3e, 0x17, 0x54, 0xcc,
+	0x78, 0x62, 0xf2, 0x55, 0xae, 0xc9, 0x20, 0x42, 0xac, 0x04, 0x25, 0x8f, 0x61, 0x4b, 0x28, 0xa5,
+	0xfd, 0xf5, 0x83, 0x4a, 0x6b, 0xd3, 0x9a, 0xbf, 0x28, 0x58, 0xc3, 0x2f, 0x50, 0x8f, 0x96, 0xd0,
+	0xef, 0xfd, 0x07, 0xd1, 0xad, 0xa7, 0x95, 0x5d, 0xd8, 0xd1, 0xca, 0x5f, 0x12, 0x55, 0x1d, 0xd6,
+	0xfb, 0xbd, 0x48, 0x7a, 0xc3, 0x5a, 0xef, 0xf7, 0x0a, 0xe2, 0xb0, 0xe1, 0xe1, 0x1b, 0x14, 0xdd,
+	0x10, 0x6d, 0xf4, 0x85, 0x43, 0xdd, 0x7f, 0x1f, 0x4d, 0x03, 0x1e, 0xcc, 0xb8, 0x2c, 0x13, 0x0f,
+	0x23, 0xd7, 0x2d, 0x4b, 0x3f, 0x37, 0xff, 0xae, 0xc0, 0xde, 0x82, 0xcd, 0x97, 0x84, 0x56, 0x62,
+	0x45, 0x9e, 0x40, 0x2d, 0xa0, 0x9c, 0xdf, 0xb2, 0xd0, 0x1e, 0x4e, 0x29, 0x9f, 0x46, 0xe1, 0x6e,
+	0x59, 0x55, 0xf5, 0xf2, 0x82, 0xf2, 0x29, 0xf9, 0x0e, 0xee, 0x85, 0xcc, 0x45, 0xbe, 0xbf, 0x79,
+	0xb0, 0xd1, 0xda, 0x3e, 0xfd, 0x3a, 0x6b, 0x9a, 0x3c, 0x58, 0xcc, 0xc5, 0x37, 0x21, 0xf5, 0x05,
+	0xb7, 0x62, 0xfa, 0xf4, 0xaf, 0x06, 0x6c, 0x59, 0x8c, 0x89, 0xae, 0xac, 0x76, 0x12, 0x00, 0x91,
+	0x31, 0x31, 0x2f, 0x60, 0x3e, 0xfa, 0x42, 0xae, 0x11, 0x39, 0x79, 0x99, 0xd5, 0xd2, 0xad, 0xb3,
+	0x8c, 0x26, 0xa9, 0x6e, 0x1c, 0x16, 0xcc, 0x58, 0xc0, 0x9b, 0x6b, 0xc4, 0x8b, 0x1c, 0x65, 0xd5,
+	0xbf, 0x77, 0xc6, 0xd7, 0xdd, 0x29, 0xf5, 0x7d, 0x74, 0xcb, 0x1c, 0x17, 0x50, 0xe5, 0xf8, 0x24,
+	0x37, 0xde, 0x81, 0x08, 0x1d, 0x7f, 0xa2, 0x76, 0xa6, 0xb9, 0x46, 0x6e, 0xa2, 0xda, 0x90, 0xee,
+	0x0e, 0x17, 0xce, 0x98, 0x2b, 0xc3, 0xd3, 0x62, 0xc3, 0x25, 0x78, 0x45, 0xcb, 0x0f, 0x50, 0xef,
+	0x86, 0x48, 0x05, 0xf6, 0xa8, 0xa0, 0x51, 0x59, 0x1d, 0xe7, 0x4e, 0xcc, 0x42, 0xca, 0xa4, 0xac,
+	0x78, 0x9a, 0x6b, 0xe4, 0x67, 0xa8, 0xf6, 0x42, 0x16, 0x68, 0xe9, 0x56, 0xae, 0x74, 0x1a, 0x31,
+	0x14, 0x9e, 0x42, 0xed, 0xad, 0xc3, 0x85, 0x9a, 0xc5, 0xc9, 0x51, 0xae, 0x72, 0x86, 0x51, 0xd2,
+	0xc7, 0x26, 0xa8, 0xce, 0xcf, 0x00, 0x20, 0x0e, 0xfd, 0x8a, 0x63, 0x48, 0x0e, 0x4b, 0x72, 0x23,
+	0x01, 0xc3, 0xe5, 0xbf, 0x83, 0xad, 0xa8, 0xce, 0x65, 0xc1, 0x93, 0xa7, 0xb9, 0x9a, 0x7a, 0xdc,
+	0x50, 0x72, 0x00, 0x60, 0xe1, 0x27, 0x76, 0x8d, 0x91, 0x66, 0xfe, 0x3a, 0xe7, 0x80, 0xa1, 0xe8,
+	0x10, 0x40, 0xe6, 0x25, 0xee, 0xc9, 0x02, 0xd1, 0x39, 0xa0, 0x44, 0x9f, 0xdd, 0xc9, 0xe9, 0xec,
+	0xfa, 0x50, 0xcb, 0x7c, 0xa5, 0xc8, 0xf3, 0x76, 0xfe, 0xdf, 0x65, 0x3b, 0xef, 0x9b, 0xd9, 0x78,
+	0x61, 0x48, 0x6b, 0xbf, 0x21, 0xec, 0xc6, 0x9b, 0xd5, 0x65, 0xae, 0x8b, 0x63, 0xe1, 0x30, 0x7f,
+	0xd1, 0x32, 0xb3, 0xa7, 0x73, 0xcc, 0x30, 0x63, 0x1f, 0xa0, 0x2e, 0xcb, 0x39, 0x25, 0x7f, 0x5c,
+	0x58, 0xf3, 0x2b, 0x8b, 0x0f, 0xa1, 0x76, 0x41, 0x79, 0x4a, 0x3b, 0xbf, 0xea, 0x33, 0x8c, 0x92,
+	0xfe, 0x26, 0x17, 0x3d, 0x67, 0x2c, 0x9d, 0x9e, 0x5b, 0x20, 0x3d, 0xe4, 0xe3, 0xd0, 0x19, 0xa5,
+	0x13, 0xd4, 0xce, 0x8f, 0x60, 0x09, 0x54, 0x56, 0x1d, 0x63, 0x3e, 0x55, 0x07, 0x3b, 0x83, 0x29,
+	0xbb, 0x9d, 0x8f, 0x71, 0x72, 0x92, 0xff, 0xfd, 0xca, 0x52, 0xca, 0xf2, 0xb9, 0x19, 0xac, 0xfd,
+	0x7e, 0x84, 0x07, 0x67, 0xb6, 0xfd, 0xda, 0x41, 0xd7, 0x26, 0xdf, 0xe6, 0xce, 0x55, 0xc3, 0x86,
+	0x5b, 0x73, 0x05, 0xdb, 0x71, 0xc5, 0x9c, 0xb9, 0x0e, 0xe5, 0xe4, 0x59, 0x49, 0x4d, 0x45, 0x84,
+	0xf9, 0x87, 0x42, 0x56, 0x4a, 0x2c, 0xfa, 0xb4, 0xb0, 0x92, 0x56, 0x91, 0x1c, 0x00, 0x9c, 0xb9,
+	0x02, 0xc3, 0x58, 0x33, 0xbf, 0xa7, 0xe7, 0x80, 0xa1, 0xe8, 0x47, 0xd8, 0x89, 0x83, 0xfb, 0x89,
+	0x86, 0xc2, 0x89, 0xaa, 0xe6, 0xa4, 0x24, 0x05, 0x9a, 0x32, 0x94, 0xff, 0x15, 0x6a, 0x32, 0xcc,
+	0xb9, 0xf8, 0x51, 0x61, 0x2a, 0x56, 0x95, 0xfe, 0x08, 0xd5, 0x0b, 0xca, 0xe7, 0xca, 0xad, 0xa2,
+	0x96, 0x5a, 0x12, 0x36, 0xea, 0xa8, 0x6b, 0xa8, 0xcb, 0x2a, 0xd4, 0x93, 0x79, 0xc1, 0xf7, 0x20,
+	0x0b, 0x29, 0x8b, 0x13, 0x23, 0x36, 0xdd, 0x45, 0xaa, 0xcb, 0x06, 0x38, 0xf1, 0xd0, 0x17, 0x05,
+	0xbb, 0xb0, 0x40, 0x95, 0x77, 0xd1, 0x12, 0xac, 0xfd, 0x10, 0xaa, 0x72, 0x2d, 0xc9, 0x00, 0x2f,
+	0xc8, 0x5d, 0x1a, 0x51, 0x4e, 0x47, 0x06, 0xa4, 0xb6, 0xd1, 0xbd, 0xd5, 0xf7, 0x6d, 0xfc, 0x5c,
+	0xda, 0x5b, 0x11, 0x61, 0x7e, 0x86, 0x50, 0xa1, 0xc5, 0xc2, 0x47, 0xa5, 0xe1, 0x67, 0xa4, 0x8f,
+	0x4d, 0x50, 0x1d, 0x40, 0xd2, 0xc5, 0xb1, 0x4b, 0x71, 0x17, 0xaf, 0xb2, 0xf8, 0x9b, 0xe4, 0x36,
+	0xa4, 0x2f, 0x64, 0xa4, 0xf0, 0xbf, 0x30, 0xf7, 0x6a, 0xd8, 0x68, 0x9b, 0xe2, 0x3a, 0x8a, 0xdf,
+	0xe1, 0x7f, 0xc9, 0x35, 0x89, 0x1c, 0x96, 0x4e, 0xee, 0xf7, 0x0a, 0x4e, 0x02, 0x39, 0x9c, 0x56,
+	0xa7, 0xb0, 0x77, 0x15, 0xd8, 0xf2, 0x2f, 0x37, 0x3e, 0xc6, 0xaa, 0x83, 0x34, 0x39, 0x2a, 0x38,
+	0xfb, 0x2e, 0x70, 0x97, 0x7c, 0x72, 0x57, 0xce, 0x5c, 0xf8, 0xbf, 0x85, 0x2e, 0x52, 0x8e, 0xbd,
+	0x77, 0x6f, 0x2f, 0x91, 0x73, 0x3a, 0xc1, 0x81, 0x08, 0x91, 0x7a, 0x8b, 0x07, 0xec, 0xf8, 0xba,
+	0x5d, 0x00, 0x1b, 0xee, 0xd0, 0x18, 0xf6, 0x92, 0x5a, 0x7e, 0xed, 0xce, 0xf8, 0x54, 0xde, 0x2d,
+	0x5c, 0x14, 0x68, 0x2f, 0xb6, 0xa4, 0xbc, 0xcd, 0xb7, 0x73, 0xc9, 0xbb, 0x43, 0x3a, 0xff, 0xe1,
+	0xb7, 0xef, 0x27, 0x8e, 0x98, 0xce, 0x46, 0x72, 0xa4, 0x13, 0xa3, 0x2f, 0x1c, 0x96, 0xfc, 0xea,
+	0xa8, 0x64, 0x75, 0xa2, 0xd9, 0x1d, 0x9d, 0xff, 0x60, 0x34, 0xba, 0x1f, 0xbd, 0x7a, 0xf5, 0xcf,
+	0x00, 0x87, 0xff, 0xf2, 0x82, 0xb1, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -310,6 +439,46 @@ type RootCoordClient interface {
 	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	//*
+	// @brief This method is used to create database
+	//
+	// @return Status
+	CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to drop an empty database
+	//
+	// @return Status
+	DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to list all the databases
+	//
+	// @return ListDatabasesResponse, database name list
+	ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)
+	//*
+	// @brief This method is used to create a user with password
+	//
+	// @return Status
+	CreateUser(ctx context.Context, in *milvuspb.CreateUserRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to grant privileges to a role, or grant a role to a user
+	//
+	// @return Status
+	GrantRole(ctx context.Context, in *milvuspb.GrantRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to revoke privileges from a role, or revoke a role from a user
+	//
+	// @return Status
+	RevokeRole(ctx context.Context, in *milvuspb.RevokeRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to list the privileges of the roles of a user, or of all the roles
+	//
+	// @return ListGrantsResponse, role and privilege list
+	ListGrants(ctx context.Context, in *milvuspb.ListGrantsRequest, opts ...grpc.CallOption) (*milvuspb.ListGrantsResponse, error)
+	//*
+	// @brief This method is used by proxy to authenticate and authorize a user
+	//
+	// @return GetCredentialResponse, password hash and privileges of the user
+	GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error)
+	//*
 	// @brief This method is used to create collection
 	//
 	// @param CreateCollectionRequest, use to provide collection information to be created.
@@ -343,6 +512,27 @@ type RootCoordClient interface {
 	// @return StringListResponse, collection name list
 	ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error)
 	//*
+	// @brief This method is used to add a scalar field to an existing collection, the rows inserted
+	// before take the default value of the field
+	//
+	// @return Status
+	AddField(ctx context.Context, in *milvuspb.AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to create an alias of a collection
+	//
+	// @return Status
+	CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to drop an alias
+	//
+	// @return Status
+	DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to move an alias to another collection
+	//
+	// @return Status
+	AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
 	// @brief This method is used to create partition
 	//
 	// @return Status
@@ -411,6 +601,78 @@ func (c *rootCoordClient) GetStatisticsChannel(ctx context.Context, in *internal
 	return out, nil
 }
 
+func (c *rootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error) {
+	out := new(milvuspb.ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateUser(ctx context.Context, in *milvuspb.CreateUserRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GrantRole(ctx context.Context, in *milvuspb.GrantRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GrantRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RevokeRole(ctx context.Context, in *milvuspb.RevokeRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RevokeRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListGrants(ctx context.Context, in *milvuspb.ListGrantsRequest, opts ...grpc.CallOption) (*milvuspb.ListGrantsResponse, error) {
+	out := new(milvuspb.ListGrantsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error) {
+	out := new(GetCredentialResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateCollection", in, out, opts...)
@@ -456,6 +718,42 @@ func (c *rootCoordClient) ShowCollections(ctx context.Context, in *milvuspb.Show
 	return out, nil
 }
 
+func (c *rootCoordClient) AddField(ctx context.Context, in *milvuspb.AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AddField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AlterAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreatePartition", in, out, opts...)
@@ -588,6 +886,46 @@ type RootCoordServer interface {
 	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
 	//*
+	// @brief This method is used to create database
+	//
+	// @return Status
+	CreateDatabase(context.Context, *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to drop an empty database
+	//
+	// @return Status
+	DropDatabase(context.Context, *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to list all the databases
+	//
+	// @return ListDatabasesResponse, database name list
+	ListDatabases(context.Context, *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
+	//*
+	// @brief This method is used to create a user with password
+	//
+	// @return Status
+	CreateUser(context.Context, *milvuspb.CreateUserRequest) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to grant privileges to a role, or grant a role to a user
+	//
+	// @return Status
+	GrantRole(context.Context, *milvuspb.GrantRoleRequest) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to revoke privileges from a role, or revoke a role from a user
+	//
+	// @return Status
+	RevokeRole(context.Context, *milvuspb.RevokeRoleRequest) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to list the privileges of the roles of a user, or of all the roles
+	//
+	// @return ListGrantsResponse, role and privilege list
+	ListGrants(context.Context, *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error)
+	//*
+	// @brief This method is used by proxy to authenticate and authorize a user
+	//
+	// @return GetCredentialResponse, password hash and privileges of the user
+	GetCredential(context.Context, *GetCredentialRequest) (*GetCredentialResponse, error)
+	//*
 	// @brief This method is used to create collection
 	//
 	// @param CreateCollectionRequest, use to provide collection information to be created.
@@ -621,6 +959,27 @@ type RootCoordServer interface {
 	// @return StringListResponse, collection name list
 	ShowCollections(context.Context, *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
 	//*
+	// @brief This method is used to add a scalar field to an existing collection, the rows inserted
+	// before take the default value of the field
+	//
+	// @return Status
+	AddField(context.Context, *milvuspb.AddFieldRequest) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to create an alias of a collection
+	//
+	// @return Status
+	CreateAlias(context.Context, *milvuspb.CreateAliasRequest) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to drop an alias
+	//
+	// @return Status
+	DropAlias(context.Context, *milvuspb.DropAliasRequest) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to move an alias to another collection
+	//
+	// @return Status
+	AlterAlias(context.Context, *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
+	//*
 	// @brief This method is used to create partition
 	//
 	// @return Status
@@ -667,6 +1026,30 @@ func (*UnimplementedRootCoordServer) GetTimeTickChannel(ctx context.Context, req
 func (*UnimplementedRootCoordServer) GetStatisticsChannel(ctx context.Context, req *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStatisticsChannel not implemented")
 }
+func (*UnimplementedRootCoordServer) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateUser(ctx context.Context, req *milvuspb.CreateUserRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (*UnimplementedRootCoordServer) GrantRole(ctx context.Context, req *milvuspb.GrantRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (*UnimplementedRootCoordServer) RevokeRole(ctx context.Context, req *milvuspb.RevokeRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (*UnimplementedRootCoordServer) ListGrants(ctx context.Context, req *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGrants not implemented")
+}
+func (*UnimplementedRootCoordServer) GetCredential(ctx context.Context, req *GetCredentialRequest) (*GetCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCredential not implemented")
+}
 func (*UnimplementedRootCoordServer) CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
 }
@@ -682,6 +1065,18 @@ func (*UnimplementedRootCoordServer) DescribeCollection(ctx context.Context, req
 func (*UnimplementedRootCoordServer) ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCollections not implemented")
 }
+func (*UnimplementedRootCoordServer) AddField(ctx context.Context, req *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddField not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlias not implemented")
+}
+func (*UnimplementedRootCoordServer) DropAlias(ctx context.Context, req *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropAlias not implemented")
+}
+func (*UnimplementedRootCoordServer) AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterAlias not implemented")
+}
 func (*UnimplementedRootCoordServer) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePartition not implemented")
 }
@@ -783,6 +1178,150 @@ func _RootCoord_GetStatisticsChannel_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateDatabase(ctx, req.(*milvuspb.CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropDatabase(ctx, req.(*milvuspb.DropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListDatabases(ctx, req.(*milvuspb.ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateUser(ctx, req.(*milvuspb.CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GrantRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GrantRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GrantRole(ctx, req.(*milvuspb.GrantRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.RevokeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RevokeRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RevokeRole(ctx, req.(*milvuspb.RevokeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListGrants(ctx, req.(*milvuspb.ListGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetCredential(ctx, req.(*GetCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.CreateCollectionRequest)
 	if err := dec(in); err != nil {
@@ -873,6 +1412,78 @@ func _RootCoord_ShowCollections_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_AddField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AddFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AddField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AddField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AddField(ctx, req.(*milvuspb.AddFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateAlias(ctx, req.(*milvuspb.CreateAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropAlias(ctx, req.(*milvuspb.DropAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_AlterAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AlterAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AlterAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AlterAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AlterAlias(ctx, req.(*milvuspb.AlterAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.CreatePartitionRequest)
 	if err := dec(in); err != nil {
@@ -1141,6 +1752,38 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetStatisticsChannel",
 			Handler:    _RootCoord_GetStatisticsChannel_Handler,
 		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _RootCoord_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _RootCoord_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _RootCoord_ListDatabases_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _RootCoord_CreateUser_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _RootCoord_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _RootCoord_RevokeRole_Handler,
+		},
+		{
+			MethodName: "ListGrants",
+			Handler:    _RootCoord_ListGrants_Handler,
+		},
+		{
+			MethodName: "GetCredential",
+			Handler:    _RootCoord_GetCredential_Handler,
+		},
 		{
 			MethodName: "CreateCollection",
 			Handler:    _RootCoord_CreateCollection_Handler,
@@ -1161,6 +1804,22 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowCollections",
 			Handler:    _RootCoord_ShowCollections_Handler,
 		},
+		{
+			MethodName: "AddField",
+			Handler:    _RootCoord_AddField_Handler,
+		},
+		{
+			MethodName: "CreateAlias",
+			Handler:    _RootCoord_CreateAlias_Handler,
+		},
+		{
+			MethodName: "DropAlias",
+			Handler:    _RootCoord_DropAlias_Handler,
+		},
+		{
+			MethodName: "AlterAlias",
+			Handler:    _RootCoord_AlterAlias_Handler,
+		},
 		{
 			MethodName: "CreatePartition",
 			Handler:    _RootCoord_CreatePartition_Handler,
@@ -75,6 +75,42 @@ func (DataType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1c5fb4d8cc22d66a, []int{0}
 }
 
+//*
+// @brief Compression codec of the binlog payloads
+type CompressionType int32
+
+const (
+	CompressionType_DefaultCompression CompressionType = 0
+	CompressionType_Uncompressed       CompressionType = 1
+	CompressionType_Snappy             CompressionType = 2
+	CompressionType_Zstd               CompressionType = 3
+	CompressionType_Lz4                CompressionType = 4
+)
+
+var CompressionType_name = map[int32]string{
+	0: "DefaultCompression",
+	1: "Uncompressed",
+	2: "Snappy",
+	3: "Zstd",
+	4: "Lz4",
+}
+
+var CompressionType_value = map[string]int32{
+	"DefaultCompression": 0,
+	"Uncompressed":       1,
+	"Snappy":             2,
+	"Zstd":               3,
+	"Lz4":                4,
+}
+
+func (x CompressionType) String() string {
+	return proto.EnumName(CompressionType_name, int32(x))
+}
+
+func (CompressionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{1}
+}
+
 //*
 // @brief Field schema
 type FieldSchema struct {
@@ -86,6 +122,8 @@ type FieldSchema struct {
 	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
 	AutoID               bool                     `protobuf:"varint,8,opt,name=autoID,proto3" json:"autoID,omitempty"`
+	Compression          CompressionType          `protobuf:"varint,9,opt,name=compression,proto3,enum=milvus.proto.schema.CompressionType" json:"compression,omitempty"`
+	DefaultValue         *ValueField              `protobuf:"bytes,10,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -172,16 +210,32 @@ func (m *FieldSchema) GetAutoID() bool {
 	return false
 }
 
+func (m *FieldSchema) GetCompression() CompressionType {
+	if m != nil {
+		return m.Compression
+	}
+	return CompressionType_DefaultCompression
+}
+
+func (m *FieldSchema) GetDefaultValue() *ValueField {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return nil
+}
+
 //*
 // @brief Collection schema
 type CollectionSchema struct {
-	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description          string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	AutoID               bool           `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"`
-	Fields               []*FieldSchema `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Name                 string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AutoID               bool            `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"`
+	Fields               []*FieldSchema  `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Compression          CompressionType `protobuf:"varint,5,opt,name=compression,proto3,enum=milvus.proto.schema.CompressionType" json:"compression,omitempty"`
+	Version              int64           `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *CollectionSchema) Reset()         { *m = CollectionSchema{} }
@@ -237,6 +291,162 @@ func (m *CollectionSchema) GetFields() []*FieldSchema {
 	return nil
 }
 
+func (m *CollectionSchema) GetCompression() CompressionType {
+	if m != nil {
+		return m.Compression
+	}
+	return CompressionType_DefaultCompression
+}
+
+func (m *CollectionSchema) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+//*
+// @brief The value of a scalar field, used as the default value
+type ValueField struct {
+	// Types that are valid to be assigned to Data:
+	//	*ValueField_BoolData
+	//	*ValueField_IntData
+	//	*ValueField_LongData
+	//	*ValueField_FloatData
+	//	*ValueField_DoubleData
+	//	*ValueField_StringData
+	Data                 isValueField_Data `protobuf_oneof:"data"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ValueField) Reset()         { *m = ValueField{} }
+func (m *ValueField) String() string { return proto.CompactTextString(m) }
+func (*ValueField) ProtoMessage()    {}
+func (*ValueField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{2}
+}
+
+func (m *ValueField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValueField.Unmarshal(m, b)
+}
+func (m *ValueField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValueField.Marshal(b, m, deterministic)
+}
+func (m *ValueField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueField.Merge(m, src)
+}
+func (m *ValueField) XXX_Size() int {
+	return xxx_messageInfo_ValueField.Size(m)
+}
+func (m *ValueField) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueField proto.InternalMessageInfo
+
+type isValueField_Data interface {
+	isValueField_Data()
+}
+
+type ValueField_BoolData struct {
+	BoolData bool `protobuf:"varint,1,opt,name=bool_data,json=boolData,proto3,oneof"`
+}
+
+type ValueField_IntData struct {
+	IntData int32 `protobuf:"varint,2,opt,name=int_data,json=intData,proto3,oneof"`
+}
+
+type ValueField_LongData struct {
+	LongData int64 `protobuf:"varint,3,opt,name=long_data,json=longData,proto3,oneof"`
+}
+
+type ValueField_FloatData struct {
+	FloatData float32 `protobuf:"fixed32,4,opt,name=float_data,json=floatData,proto3,oneof"`
+}
+
+type ValueField_DoubleData struct {
+	DoubleData float64 `protobuf:"fixed64,5,opt,name=double_data,json=doubleData,proto3,oneof"`
+}
+
+type ValueField_StringData struct {
+	StringData string `protobuf:"bytes,6,opt,name=string_data,json=stringData,proto3,oneof"`
+}
+
+func (*ValueField_BoolData) isValueField_Data() {}
+
+func (*ValueField_IntData) isValueField_Data() {}
+
+func (*ValueField_LongData) isValueField_Data() {}
+
+func (*ValueField_FloatData) isValueField_Data() {}
+
+func (*ValueField_DoubleData) isValueField_Data() {}
+
+func (*ValueField_StringData) isValueField_Data() {}
+
+func (m *ValueField) GetData() isValueField_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ValueField) GetBoolData() bool {
+	if x, ok := m.GetData().(*ValueField_BoolData); ok {
+		return x.BoolData
+	}
+	return false
+}
+
+func (m *ValueField) GetIntData() int32 {
+	if x, ok := m.GetData().(*ValueField_IntData); ok {
+		return x.IntData
+	}
+	return 0
+}
+
+func (m *ValueField) GetLongData() int64 {
+	if x, ok := m.GetData().(*ValueField_LongData); ok {
+		return x.LongData
+	}
+	return 0
+}
+
+func (m *ValueField) GetFloatData() float32 {
+	if x, ok := m.GetData().(*ValueField_FloatData); ok {
+		return x.FloatData
+	}
+	return 0
+}
+
+func (m *ValueField) GetDoubleData() float64 {
+	if x, ok := m.GetData().(*ValueField_DoubleData); ok {
+		return x.DoubleData
+	}
+	return 0
+}
+
+func (m *ValueField) GetStringData() string {
+	if x, ok := m.GetData().(*ValueField_StringData); ok {
+		return x.StringData
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ValueField) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ValueField_BoolData)(nil),
+		(*ValueField_IntData)(nil),
+		(*ValueField_LongData)(nil),
+		(*ValueField_FloatData)(nil),
+		(*ValueField_DoubleData)(nil),
+		(*ValueField_StringData)(nil),
+	}
+}
+
 type BoolArray struct {
 	Data                 []bool   `protobuf:"varint,1,rep,packed,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -248,7 +458,7 @@ func (m *BoolArray) Reset()         { *m = BoolArray{} }
 func (m *BoolArray) String() string { return proto.CompactTextString(m) }
 func (*BoolArray) ProtoMessage()    {}
 func (*BoolArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{2}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{3}
 }
 
 func (m *BoolArray) XXX_Unmarshal(b []byte) error {
@@ -287,7 +497,7 @@ func (m *IntArray) Reset()         { *m = IntArray{} }
 func (m *IntArray) String() string { return proto.CompactTextString(m) }
 func (*IntArray) ProtoMessage()    {}
 func (*IntArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{3}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{4}
 }
 
 func (m *IntArray) XXX_Unmarshal(b []byte) error {
@@ -326,7 +536,7 @@ func (m *LongArray) Reset()         { *m = LongArray{} }
 func (m *LongArray) String() string { return proto.CompactTextString(m) }
 func (*LongArray) ProtoMessage()    {}
 func (*LongArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{4}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{5}
 }
 
 func (m *LongArray) XXX_Unmarshal(b []byte) error {
@@ -365,7 +575,7 @@ func (m *FloatArray) Reset()         { *m = FloatArray{} }
 func (m *FloatArray) String() string { return proto.CompactTextString(m) }
 func (*FloatArray) ProtoMessage()    {}
 func (*FloatArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{5}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{6}
 }
 
 func (m *FloatArray) XXX_Unmarshal(b []byte) error {
@@ -404,7 +614,7 @@ func (m *DoubleArray) Reset()         { *m = DoubleArray{} }
 func (m *DoubleArray) String() string { return proto.CompactTextString(m) }
 func (*DoubleArray) ProtoMessage()    {}
 func (*DoubleArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{6}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{7}
 }
 
 func (m *DoubleArray) XXX_Unmarshal(b []byte) error {
@@ -444,7 +654,7 @@ func (m *BytesArray) Reset()         { *m = BytesArray{} }
 func (m *BytesArray) String() string { return proto.CompactTextString(m) }
 func (*BytesArray) ProtoMessage()    {}
 func (*BytesArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{7}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{8}
 }
 
 func (m *BytesArray) XXX_Unmarshal(b []byte) error {
@@ -483,7 +693,7 @@ func (m *StringArray) Reset()         { *m = StringArray{} }
 func (m *StringArray) String() string { return proto.CompactTextString(m) }
 func (*StringArray) ProtoMessage()    {}
 func (*StringArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{8}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
 }
 
 func (m *StringArray) XXX_Unmarshal(b []byte) error {
@@ -530,7 +740,7 @@ func (m *ScalarField) Reset()         { *m = ScalarField{} }
 func (m *ScalarField) String() string { return proto.CompactTextString(m) }
 func (*ScalarField) ProtoMessage()    {}
 func (*ScalarField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
 }
 
 func (m *ScalarField) XXX_Unmarshal(b []byte) error {
@@ -681,7 +891,7 @@ func (m *VectorField) Reset()         { *m = VectorField{} }
 func (m *VectorField) String() string { return proto.CompactTextString(m) }
 func (*VectorField) ProtoMessage()    {}
 func (*VectorField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
 }
 
 func (m *VectorField) XXX_Unmarshal(b []byte) error {
@@ -770,7 +980,7 @@ func (m *FieldData) Reset()         { *m = FieldData{} }
 func (m *FieldData) String() string { return proto.CompactTextString(m) }
 func (*FieldData) ProtoMessage()    {}
 func (*FieldData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
 }
 
 func (m *FieldData) XXX_Unmarshal(b []byte) error {
@@ -864,7 +1074,7 @@ func (m *IDs) Reset()         { *m = IDs{} }
 func (m *IDs) String() string { return proto.CompactTextString(m) }
 func (*IDs) ProtoMessage()    {}
 func (*IDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
 }
 
 func (m *IDs) XXX_Unmarshal(b []byte) error {
@@ -931,22 +1141,25 @@ func (*IDs) XXX_OneofWrappers() []interface{} {
 }
 
 type SearchResultData struct {
-	NumQueries           int64        `protobuf:"varint,1,opt,name=num_queries,json=numQueries,proto3" json:"num_queries,omitempty"`
-	TopK                 int64        `protobuf:"varint,2,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`
-	FieldsData           []*FieldData `protobuf:"bytes,3,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
-	Scores               []float32    `protobuf:"fixed32,4,rep,packed,name=scores,proto3" json:"scores,omitempty"`
-	Ids                  *IDs         `protobuf:"bytes,5,opt,name=ids,proto3" json:"ids,omitempty"`
-	Topks                []int64      `protobuf:"varint,6,rep,packed,name=topks,proto3" json:"topks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	NumQueries int64        `protobuf:"varint,1,opt,name=num_queries,json=numQueries,proto3" json:"num_queries,omitempty"`
+	TopK       int64        `protobuf:"varint,2,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`
+	FieldsData []*FieldData `protobuf:"bytes,3,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	Scores     []float32    `protobuf:"fixed32,4,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	Ids        *IDs         `protobuf:"bytes,5,opt,name=ids,proto3" json:"ids,omitempty"`
+	Topks      []int64      `protobuf:"varint,6,rep,packed,name=topks,proto3" json:"topks,omitempty"`
+	// set if a range search may have missed hits within the distance range of some queries, because more than
+	// 16384 hits of a segment are within the range
+	RangeSearchTruncated bool     `protobuf:"varint,7,opt,name=range_search_truncated,json=rangeSearchTruncated,proto3" json:"range_search_truncated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchResultData) Reset()         { *m = SearchResultData{} }
 func (m *SearchResultData) String() string { return proto.CompactTextString(m) }
 func (*SearchResultData) ProtoMessage()    {}
 func (*SearchResultData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{14}
 }
 
 func (m *SearchResultData) XXX_Unmarshal(b []byte) error {
@@ -1009,10 +1222,19 @@ func (m *SearchResultData) GetTopks() []int64 {
 	return nil
 }
 
+func (m *SearchResultData) GetRangeSearchTruncated() bool {
+	if m != nil {
+		return m.RangeSearchTruncated
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.schema.DataType", DataType_name, DataType_value)
+	proto.RegisterEnum("milvus.proto.schema.CompressionType", CompressionType_name, CompressionType_value)
 	proto.RegisterType((*FieldSchema)(nil), "milvus.proto.schema.FieldSchema")
 	proto.RegisterType((*CollectionSchema)(nil), "milvus.proto.schema.CollectionSchema")
+	proto.RegisterType((*ValueField)(nil), "milvus.proto.schema.ValueField")
 	proto.RegisterType((*BoolArray)(nil), "milvus.proto.schema.BoolArray")
 	proto.RegisterType((*IntArray)(nil), "milvus.proto.schema.IntArray")
 	proto.RegisterType((*LongArray)(nil), "milvus.proto.schema.LongArray")
@@ -1030,65 +1252,77 @@ func init() {
 func init() { proto.RegisterFile("schema.proto", fileDescriptor_1c5fb4d8cc22d66a) }
 
 var fileDescriptor_1c5fb4d8cc22d66a = []byte{
-	// 951 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x5d, 0x8f, 0xdb, 0x44,
-	0x14, 0x8d, 0xe3, 0x7c, 0xd8, 0xd7, 0xa1, 0x58, 0xd3, 0x0a, 0x59, 0x48, 0xed, 0xa6, 0x11, 0x48,
-	0x51, 0x25, 0x76, 0xd5, 0x5d, 0x28, 0xa5, 0xa2, 0x02, 0xd2, 0x68, 0xb5, 0xd1, 0xa2, 0x6a, 0xf1,
-	0xa2, 0x3e, 0xf0, 0x12, 0x4d, 0xe2, 0xe9, 0xee, 0x68, 0xed, 0x99, 0xe0, 0x19, 0x57, 0xe4, 0x07,
-	0xf0, 0xcc, 0x0b, 0x4f, 0xfc, 0x37, 0x1e, 0x80, 0x3f, 0x82, 0xe6, 0xce, 0x24, 0x31, 0xc4, 0x5d,
-	0xed, 0xdb, 0x9d, 0xf1, 0x3d, 0x67, 0xe6, 0x9e, 0x7b, 0xe6, 0x1a, 0x06, 0x6a, 0x79, 0xcd, 0x0a,
-	0x7a, 0xb8, 0x2a, 0xa5, 0x96, 0xe4, 0x7e, 0xc1, 0xf3, 0x77, 0x95, 0xb2, 0xab, 0x43, 0xfb, 0xe9,
-	0xe3, 0xc1, 0x52, 0x16, 0x85, 0x14, 0x76, 0x73, 0xf4, 0x57, 0x1b, 0xa2, 0x53, 0xce, 0xf2, 0xec,
-	0x12, 0xbf, 0x92, 0x04, 0xfa, 0x6f, 0xcd, 0x72, 0x36, 0x4d, 0xbc, 0xa1, 0x37, 0xf6, 0xd3, 0xcd,
-	0x92, 0x10, 0xe8, 0x08, 0x5a, 0xb0, 0xa4, 0x3d, 0xf4, 0xc6, 0x61, 0x8a, 0x31, 0xf9, 0x04, 0xee,
-	0x71, 0x35, 0x5f, 0x95, 0xbc, 0xa0, 0xe5, 0x7a, 0x7e, 0xc3, 0xd6, 0x89, 0x3f, 0xf4, 0xc6, 0x41,
-	0x3a, 0xe0, 0xea, 0xc2, 0x6e, 0x9e, 0xb3, 0x35, 0x19, 0x42, 0x94, 0x31, 0xb5, 0x2c, 0xf9, 0x4a,
-	0x73, 0x29, 0x92, 0x0e, 0x12, 0xd4, 0xb7, 0xc8, 0x0b, 0x08, 0x33, 0xaa, 0xe9, 0x5c, 0xaf, 0x57,
-	0x2c, 0xe9, 0x0e, 0xbd, 0xf1, 0xbd, 0xe3, 0x87, 0x87, 0x0d, 0x97, 0x3f, 0x9c, 0x52, 0x4d, 0x7f,
-	0x5c, 0xaf, 0x58, 0x1a, 0x64, 0x2e, 0x22, 0x13, 0x88, 0x0c, 0x6c, 0xbe, 0xa2, 0x25, 0x2d, 0x54,
-	0xd2, 0x1b, 0xfa, 0xe3, 0xe8, 0xf8, 0xf1, 0x7f, 0xd1, 0xae, 0xe4, 0x73, 0xb6, 0x7e, 0x43, 0xf3,
-	0x8a, 0x5d, 0x50, 0x5e, 0xa6, 0x60, 0x50, 0x17, 0x08, 0x22, 0x53, 0x18, 0x70, 0x91, 0xb1, 0x5f,
-	0x36, 0x24, 0xfd, 0xbb, 0x92, 0x44, 0x08, 0x73, 0x2c, 0x1f, 0x41, 0x8f, 0x56, 0x5a, 0xce, 0xa6,
-	0x49, 0x80, 0x2a, 0xb8, 0xd5, 0xe8, 0x0f, 0x0f, 0xe2, 0x57, 0x32, 0xcf, 0xd9, 0xd2, 0x14, 0xeb,
-	0x84, 0xde, 0xc8, 0xe9, 0xd5, 0xe4, 0xfc, 0x9f, 0x50, 0xed, 0x7d, 0xa1, 0x76, 0x47, 0xf8, 0xf5,
-	0x23, 0xc8, 0x73, 0xe8, 0x61, 0x9f, 0x54, 0xd2, 0xc1, 0xab, 0x0f, 0x1b, 0xd5, 0xab, 0x35, 0x3a,
-	0x75, 0xf9, 0xa3, 0x03, 0x08, 0x27, 0x52, 0xe6, 0xdf, 0x95, 0x25, 0x5d, 0x9b, 0x4b, 0x19, 0x5d,
-	0x13, 0x6f, 0xe8, 0x8f, 0x83, 0x14, 0xe3, 0xd1, 0x23, 0x08, 0x66, 0x42, 0xef, 0x7f, 0xef, 0xba,
-	0xef, 0x07, 0x10, 0x7e, 0x2f, 0xc5, 0xd5, 0x7e, 0x82, 0xef, 0x12, 0x86, 0x00, 0xa7, 0xb9, 0xa4,
-	0x0d, 0x14, 0x6d, 0x97, 0xf1, 0x18, 0xa2, 0xa9, 0xac, 0x16, 0x39, 0xdb, 0x4f, 0xf1, 0x76, 0x24,
-	0x93, 0xb5, 0x66, 0x6a, 0x3f, 0x63, 0xb0, 0x23, 0xb9, 0xd4, 0x25, 0x6f, 0xba, 0x49, 0xe8, 0x52,
-	0xfe, 0xf4, 0x21, 0xba, 0x5c, 0xd2, 0x9c, 0x96, 0xa8, 0x04, 0x79, 0x09, 0xe1, 0x42, 0xca, 0x7c,
-	0xee, 0x12, 0xbd, 0x71, 0x74, 0xfc, 0xa8, 0x51, 0xb8, 0xad, 0x42, 0x67, 0xad, 0x34, 0x30, 0x10,
-	0xe3, 0x43, 0xf2, 0x02, 0x02, 0x2e, 0xb4, 0x45, 0xb7, 0x11, 0xdd, 0x6c, 0xda, 0x8d, 0x7c, 0x67,
-	0xad, 0xb4, 0xcf, 0x85, 0x46, 0xec, 0x4b, 0x08, 0x73, 0x29, 0xae, 0x2c, 0xd8, 0xbf, 0xe5, 0xe8,
-	0xad, 0xb6, 0xe6, 0x68, 0x03, 0x41, 0xf8, 0xb7, 0x00, 0x6f, 0x8d, 0xa6, 0x16, 0xdf, 0x41, 0xfc,
-	0x41, 0x73, 0xcf, 0xb7, 0xd2, 0x9f, 0xb5, 0xd2, 0x10, 0x41, 0xc8, 0xf0, 0x0a, 0xa2, 0x0c, 0x35,
-	0xb7, 0x14, 0x5d, 0xa4, 0x68, 0xb6, 0x4d, 0xad, 0x37, 0x67, 0xad, 0x14, 0x2c, 0x6c, 0x43, 0xa2,
-	0x50, 0x73, 0x4b, 0xd2, 0xbb, 0x85, 0xa4, 0xd6, 0x1b, 0x43, 0x62, 0x61, 0x9b, 0x5a, 0x16, 0xa6,
-	0xb5, 0x96, 0xa3, 0x7f, 0x4b, 0x2d, 0x3b, 0x07, 0x98, 0x5a, 0x10, 0x64, 0x18, 0x26, 0x3d, 0xdb,
-	0xeb, 0xd1, 0xef, 0x1e, 0x44, 0x6f, 0xd8, 0x52, 0x4b, 0xd7, 0xdf, 0x18, 0xfc, 0x8c, 0x17, 0x6e,
-	0x90, 0x99, 0xd0, 0x3c, 0x74, 0xab, 0xdb, 0x3b, 0x4c, 0x73, 0x6d, 0xbb, 0x83, 0x72, 0x11, 0xc2,
-	0x2c, 0x39, 0xf9, 0x14, 0x3e, 0x58, 0x70, 0x61, 0x46, 0x9e, 0xa3, 0x31, 0x0d, 0x1c, 0x9c, 0xb5,
-	0xd2, 0x81, 0xdd, 0xb6, 0x69, 0xdb, 0x6b, 0xfd, 0xed, 0x41, 0x88, 0x17, 0xc2, 0x72, 0x9f, 0x42,
-	0x07, 0xc7, 0x9c, 0x77, 0x97, 0x31, 0x87, 0xa9, 0xe4, 0x21, 0x00, 0xbe, 0xd6, 0x79, 0x6d, 0x00,
-	0x87, 0xb8, 0xf3, 0xda, 0x8c, 0x8d, 0xaf, 0xa1, 0xaf, 0xd0, 0xd5, 0xca, 0x39, 0xe9, 0x3d, 0x1d,
-	0xd8, 0x39, 0xdf, 0x38, 0xd1, 0x41, 0x0c, 0xda, 0x56, 0xa1, 0x9c, 0x8f, 0x9a, 0xd1, 0x35, 0x5d,
-	0x0d, 0xda, 0x41, 0x26, 0x7d, 0xe8, 0xe2, 0x45, 0x46, 0xbf, 0x7a, 0xe0, 0xcf, 0xa6, 0x8a, 0x7c,
-	0x09, 0x3d, 0xf3, 0x28, 0x78, 0x76, 0xeb, 0x83, 0xaa, 0xbb, 0xba, 0xcb, 0x85, 0x9e, 0x65, 0xe4,
-	0x2b, 0xe8, 0x29, 0x5d, 0x1a, 0x60, 0xfb, 0xce, 0x36, 0xea, 0x2a, 0x5d, 0xce, 0xb2, 0x09, 0x40,
-	0xc0, 0xb3, 0xb9, 0xbd, 0xc7, 0x3f, 0x1e, 0xc4, 0x97, 0x8c, 0x96, 0xcb, 0xeb, 0x94, 0xa9, 0x2a,
-	0xb7, 0x66, 0x3f, 0x80, 0x48, 0x54, 0xc5, 0xfc, 0xe7, 0x8a, 0x95, 0x9c, 0x29, 0x67, 0x08, 0x10,
-	0x55, 0xf1, 0x83, 0xdd, 0x21, 0xf7, 0xa1, 0xab, 0xe5, 0x6a, 0x7e, 0x83, 0x67, 0xfb, 0x69, 0x47,
-	0xcb, 0xd5, 0x39, 0xf9, 0x06, 0x22, 0x3b, 0x24, 0x37, 0xaf, 0xd4, 0x7f, 0x6f, 0x3d, 0xdb, 0xf6,
-	0xa6, 0xb6, 0x53, 0xe8, 0x4b, 0x33, 0xad, 0xd5, 0x52, 0x96, 0xcc, 0x4e, 0xe5, 0x76, 0xea, 0x56,
-	0xe4, 0x09, 0xf8, 0x3c, 0x53, 0xee, 0xcd, 0x25, 0xcd, 0x33, 0x63, 0xaa, 0x52, 0x93, 0x44, 0x1e,
-	0xe0, 0xcd, 0x6e, 0xec, 0x8f, 0xcd, 0x4f, 0xed, 0xe2, 0xc9, 0x6f, 0x1e, 0x04, 0x1b, 0x93, 0x90,
-	0x00, 0x3a, 0xaf, 0xa5, 0x60, 0x71, 0xcb, 0x44, 0x66, 0x54, 0xc5, 0x9e, 0x89, 0x66, 0x42, 0x3f,
-	0x8f, 0xdb, 0x24, 0x84, 0xee, 0x4c, 0xe8, 0xa7, 0xcf, 0x62, 0xdf, 0x85, 0x27, 0xc7, 0x71, 0xc7,
-	0x85, 0xcf, 0x3e, 0x8f, 0xbb, 0x26, 0x44, 0xab, 0xc7, 0x40, 0x00, 0x7a, 0xf6, 0xb1, 0xc7, 0x91,
-	0x89, 0xad, 0xd8, 0xf1, 0x03, 0x12, 0xc3, 0x60, 0x52, 0x73, 0x76, 0x9c, 0x91, 0x0f, 0x21, 0x3a,
-	0xdd, 0xbd, 0x88, 0x98, 0x4d, 0xbe, 0xf8, 0xe9, 0xe4, 0x8a, 0xeb, 0xeb, 0x6a, 0x61, 0xfe, 0x93,
-	0x47, 0xb6, 0xa4, 0xcf, 0xb8, 0x74, 0xd1, 0x11, 0x17, 0x9a, 0x95, 0x82, 0xe6, 0x47, 0x58, 0xe5,
-	0x91, 0xad, 0x72, 0xb5, 0x58, 0xf4, 0x70, 0x7d, 0xf2, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xca,
-	0x27, 0x8a, 0x41, 0xb9, 0x08, 0x00, 0x00,
+	// 1148 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x13, 0x17, 0x45, 0x3d, 0xc8, 0xa1, 0x92, 0x10, 0x9b, 0x20, 0x20, 0xfe, 0x7f, 0x38, 0x96, 0x8d,
+	0x14, 0x10, 0x0c, 0xd4, 0x46, 0x6c, 0x37, 0x4d, 0x83, 0x06, 0x6d, 0x65, 0xc1, 0xb0, 0xe0, 0x20,
+	0x70, 0xe9, 0x34, 0x07, 0x5f, 0x88, 0x95, 0xb8, 0xb6, 0x17, 0xa6, 0x76, 0x59, 0xee, 0xca, 0xa8,
+	0x7a, 0xef, 0xb9, 0x97, 0x5e, 0xfa, 0xc5, 0x7a, 0xeb, 0xa5, 0x5f, 0xa0, 0x5f, 0xa0, 0x87, 0x62,
+	0x1f, 0xb2, 0xa8, 0x97, 0x61, 0xf4, 0x36, 0x3b, 0xf3, 0x9b, 0xe1, 0x3c, 0x7e, 0xbb, 0x43, 0x68,
+	0x89, 0xe1, 0x35, 0x19, 0xe1, 0xdd, 0xbc, 0xe0, 0x92, 0xa3, 0xa7, 0x23, 0x9a, 0xdd, 0x8e, 0x85,
+	0x39, 0xed, 0x1a, 0xd3, 0xff, 0x5a, 0x43, 0x3e, 0x1a, 0x71, 0x66, 0x94, 0xdb, 0x7f, 0xbb, 0x10,
+	0x1c, 0x53, 0x92, 0xa5, 0xe7, 0xda, 0x8a, 0x22, 0x68, 0x5e, 0xaa, 0x63, 0xbf, 0x17, 0x39, 0x6d,
+	0xa7, 0xe3, 0xc6, 0xd3, 0x23, 0x42, 0x50, 0x63, 0x78, 0x44, 0xa2, 0x6a, 0xdb, 0xe9, 0xf8, 0xb1,
+	0x96, 0xd1, 0x4b, 0x78, 0x4c, 0x45, 0x92, 0x17, 0x74, 0x84, 0x8b, 0x49, 0x72, 0x43, 0x26, 0x91,
+	0xdb, 0x76, 0x3a, 0x5e, 0xdc, 0xa2, 0xe2, 0xcc, 0x28, 0x4f, 0xc9, 0x04, 0xb5, 0x21, 0x48, 0x89,
+	0x18, 0x16, 0x34, 0x97, 0x94, 0xb3, 0xa8, 0xa6, 0x03, 0x94, 0x55, 0xe8, 0x2d, 0xf8, 0x29, 0x96,
+	0x38, 0x91, 0x93, 0x9c, 0x44, 0xf5, 0xb6, 0xd3, 0x79, 0xbc, 0xbf, 0xb1, 0xbb, 0x22, 0xf9, 0xdd,
+	0x1e, 0x96, 0xf8, 0xe3, 0x24, 0x27, 0xb1, 0x97, 0x5a, 0x09, 0x75, 0x21, 0x50, 0x6e, 0x49, 0x8e,
+	0x0b, 0x3c, 0x12, 0x51, 0xa3, 0xed, 0x76, 0x82, 0xfd, 0xad, 0x79, 0x6f, 0x5b, 0xf2, 0x29, 0x99,
+	0x7c, 0xc2, 0xd9, 0x98, 0x9c, 0x61, 0x5a, 0xc4, 0xa0, 0xbc, 0xce, 0xb4, 0x13, 0xea, 0x41, 0x8b,
+	0xb2, 0x94, 0xfc, 0x34, 0x0d, 0xd2, 0x7c, 0x68, 0x90, 0x40, 0xbb, 0xd9, 0x28, 0xcf, 0xa1, 0x81,
+	0xc7, 0x92, 0xf7, 0x7b, 0x91, 0xa7, 0xbb, 0x60, 0x4f, 0xe8, 0x18, 0x82, 0x21, 0x1f, 0xe5, 0x05,
+	0x11, 0x42, 0xd5, 0xef, 0xeb, 0xfa, 0x5e, 0xae, 0xac, 0xef, 0x68, 0x86, 0xd3, 0x65, 0x96, 0x1d,
+	0x51, 0x0f, 0x1e, 0xa5, 0xe4, 0x12, 0x8f, 0x33, 0x99, 0xdc, 0xaa, 0x0c, 0x22, 0x68, 0x3b, 0x9d,
+	0x60, 0x7f, 0x73, 0x65, 0x24, 0x9d, 0xa3, 0x9e, 0x6c, 0xdc, 0xb2, 0x5e, 0x5a, 0xb5, 0xfd, 0x8f,
+	0x03, 0xe1, 0x11, 0xcf, 0x32, 0x32, 0x54, 0xad, 0xb7, 0x63, 0x9f, 0x0e, 0xd7, 0x29, 0x0d, 0x77,
+	0x61, 0x6c, 0xd5, 0xe5, 0xb1, 0xcd, 0x0a, 0x76, 0xe7, 0x0a, 0x7e, 0x03, 0x0d, 0xcd, 0x1a, 0x11,
+	0xd5, 0x74, 0x23, 0xdb, 0x2b, 0x33, 0x2c, 0xd1, 0x2e, 0xb6, 0xf8, 0xc5, 0x56, 0xd5, 0xff, 0x6b,
+	0xab, 0x22, 0x68, 0xde, 0x92, 0x42, 0xc7, 0x68, 0x18, 0x1a, 0xdb, 0xe3, 0xf6, 0x1f, 0x0e, 0xc0,
+	0xac, 0x37, 0x68, 0x03, 0xfc, 0x01, 0xe7, 0x59, 0xa2, 0xe8, 0xa4, 0xab, 0xf7, 0x4e, 0x2a, 0xb1,
+	0xa7, 0x54, 0x8a, 0x6a, 0xe8, 0xff, 0xe0, 0x51, 0x26, 0x8d, 0x55, 0x35, 0xa0, 0x7e, 0x52, 0x89,
+	0x9b, 0x94, 0x49, 0x6d, 0xdc, 0x00, 0x3f, 0xe3, 0xec, 0xca, 0x58, 0x55, 0x07, 0x5c, 0xe5, 0xab,
+	0x54, 0xda, 0xbc, 0x09, 0x70, 0x99, 0x71, 0x6c, 0xbd, 0x15, 0xeb, 0xab, 0x27, 0x95, 0xd8, 0xd7,
+	0x3a, 0x0d, 0xd8, 0x82, 0x20, 0xe5, 0xe3, 0x41, 0x46, 0x0c, 0x42, 0x15, 0xeb, 0x9c, 0x54, 0x62,
+	0x30, 0xca, 0x29, 0x44, 0xc8, 0x82, 0x4e, 0x3f, 0xa2, 0x6a, 0xf1, 0x15, 0xc4, 0x28, 0x15, 0xa4,
+	0xdb, 0x80, 0x9a, 0xb2, 0x6d, 0x6f, 0x82, 0xdf, 0xe5, 0x3c, 0xfb, 0xae, 0x28, 0xf0, 0x04, 0x21,
+	0xa3, 0x8c, 0x9c, 0xb6, 0xdb, 0xf1, 0x62, 0x03, 0x78, 0x01, 0x5e, 0x9f, 0xc9, 0x65, 0x7b, 0x3d,
+	0xbe, 0x0b, 0xf0, 0x9e, 0xb3, 0xab, 0x65, 0x80, 0x6b, 0x01, 0x6d, 0x80, 0x63, 0x95, 0xfc, 0x32,
+	0xa2, 0x6a, 0x11, 0x5b, 0x10, 0xf4, 0x74, 0xf2, 0xcb, 0x10, 0x67, 0x16, 0xa4, 0x3b, 0x91, 0x44,
+	0x2c, 0x23, 0x5a, 0xb3, 0x20, 0xe7, 0xba, 0xbc, 0x65, 0x88, 0x6f, 0x21, 0x7f, 0xba, 0x10, 0x9c,
+	0x0f, 0x71, 0x86, 0x0b, 0x33, 0xc5, 0x77, 0x8b, 0x53, 0x0c, 0xf6, 0x5f, 0xac, 0x24, 0xcd, 0x5d,
+	0x87, 0xe6, 0xa6, 0xfc, 0x76, 0x61, 0xca, 0xc1, 0x9a, 0xd7, 0x67, 0xda, 0xbe, 0x32, 0x09, 0xde,
+	0x2d, 0x92, 0x60, 0xdd, 0xa7, 0xef, 0x7a, 0x3b, 0x47, 0x92, 0x6f, 0x97, 0x48, 0xb2, 0xee, 0x42,
+	0xcf, 0x5a, 0x3f, 0xcf, 0xa2, 0xa3, 0x65, 0x16, 0xad, 0xbb, 0x71, 0xa5, 0xd9, 0x2c, 0xf0, 0xec,
+	0x68, 0x99, 0x67, 0xeb, 0x82, 0x94, 0x66, 0x33, 0xcf, 0x44, 0x55, 0xcb, 0x40, 0x8d, 0xd6, 0xc4,
+	0x68, 0xde, 0x53, 0xcb, 0x8c, 0x01, 0xaa, 0x16, 0xed, 0x34, 0xc7, 0xe5, 0xdf, 0x1c, 0x08, 0x3e,
+	0x91, 0xa1, 0xe4, 0x76, 0xbe, 0x21, 0xb8, 0x29, 0x1d, 0xd9, 0x8d, 0xa4, 0x44, 0xf5, 0x62, 0x9b,
+	0xbe, 0xdd, 0x6a, 0x58, 0x54, 0xbd, 0xe7, 0x6b, 0x73, 0x9d, 0x0b, 0xb4, 0x9b, 0x09, 0x8e, 0x3e,
+	0x83, 0x47, 0x03, 0xca, 0xd4, 0xee, 0xb2, 0x61, 0xd4, 0x00, 0x5b, 0x27, 0x95, 0xb8, 0x65, 0xd4,
+	0x06, 0x76, 0x97, 0xd6, 0x5f, 0x0e, 0xf8, 0x3a, 0x21, 0x5d, 0xee, 0x2b, 0xa8, 0xe9, 0x7d, 0xe5,
+	0x3c, 0x64, 0x5f, 0x69, 0x28, 0xda, 0x00, 0xd0, 0x0f, 0x5d, 0x52, 0xda, 0xa4, 0xbe, 0xd6, 0x7c,
+	0x50, 0x2f, 0xee, 0xd7, 0xd0, 0x14, 0x9a, 0xd5, 0x22, 0x72, 0xef, 0x9b, 0xc0, 0x8c, 0xf9, 0x8a,
+	0x89, 0xd6, 0x45, 0x79, 0x9b, 0x2a, 0x44, 0x54, 0xbb, 0xc7, 0xbb, 0xd4, 0x57, 0xe5, 0x6d, 0x5d,
+	0xba, 0x4d, 0xa8, 0xeb, 0x44, 0xb6, 0x7f, 0x71, 0xc0, 0xed, 0xf7, 0x04, 0xfa, 0x12, 0x1a, 0xea,
+	0x52, 0xd0, 0x34, 0x72, 0x1e, 0xc8, 0xea, 0x3a, 0x65, 0xb2, 0x9f, 0xa2, 0xaf, 0xa0, 0x21, 0x64,
+	0xa1, 0x1c, 0xab, 0x0f, 0xa6, 0x51, 0x5d, 0xc8, 0xa2, 0x9f, 0x76, 0x01, 0x3c, 0x9a, 0x26, 0x26,
+	0x8f, 0xdf, 0xab, 0x10, 0x9e, 0x13, 0x5c, 0x0c, 0xaf, 0x63, 0x22, 0xc6, 0x99, 0xb4, 0x6f, 0x6a,
+	0xc0, 0xc6, 0xa3, 0xe4, 0xc7, 0x31, 0x29, 0x28, 0x11, 0x96, 0x10, 0xc0, 0xc6, 0xa3, 0xef, 0x8d,
+	0x06, 0x3d, 0x85, 0xba, 0xe4, 0x79, 0x72, 0xa3, 0xbf, 0xed, 0xc6, 0x35, 0xc9, 0xf3, 0x53, 0xf4,
+	0x0d, 0x04, 0x66, 0xbf, 0x4c, 0x6f, 0xa9, 0xbb, 0xb6, 0x9e, 0xbb, 0xf1, 0xc6, 0x66, 0x52, 0x9a,
+	0x97, 0x6a, 0xd1, 0x89, 0x21, 0x2f, 0x88, 0x59, 0x68, 0xd5, 0xd8, 0x9e, 0xd0, 0x0e, 0xb8, 0x34,
+	0x15, 0xf6, 0xce, 0x45, 0xab, 0xdf, 0x8c, 0x9e, 0x88, 0x15, 0x08, 0x3d, 0xd3, 0x99, 0xdd, 0x98,
+	0x3f, 0x14, 0x37, 0x36, 0x07, 0x74, 0x08, 0xcf, 0x0b, 0xcc, 0xae, 0x48, 0x22, 0x74, 0xa9, 0x89,
+	0x2c, 0xc6, 0x6c, 0x88, 0x25, 0x49, 0xf5, 0xfd, 0xf1, 0xe2, 0x67, 0xda, 0x6a, 0xfa, 0xf0, 0x71,
+	0x6a, 0xdb, 0xf9, 0xd5, 0x01, 0x6f, 0x4a, 0x2d, 0xe4, 0x41, 0xed, 0x03, 0x67, 0x24, 0xac, 0x28,
+	0x49, 0x3d, 0x70, 0xa1, 0xa3, 0xa4, 0x3e, 0x93, 0x6f, 0xc2, 0x2a, 0xf2, 0xa1, 0xde, 0x67, 0xf2,
+	0xd5, 0xeb, 0xd0, 0xb5, 0xe2, 0xc1, 0x7e, 0x58, 0xb3, 0xe2, 0xeb, 0xc3, 0xb0, 0xae, 0x44, 0x7d,
+	0x41, 0x42, 0x40, 0x00, 0x0d, 0xf3, 0x44, 0x84, 0x81, 0x92, 0xcd, 0x88, 0xc2, 0x67, 0x28, 0x84,
+	0x56, 0xb7, 0x74, 0x1f, 0xc2, 0x14, 0x3d, 0x81, 0xe0, 0x78, 0x76, 0x8f, 0x42, 0xb2, 0x73, 0x01,
+	0x4f, 0x16, 0x16, 0x32, 0x7a, 0x0e, 0xa8, 0x67, 0x7e, 0x3c, 0x4a, 0x96, 0xb0, 0xa2, 0xa2, 0xfd,
+	0xc0, 0xa6, 0xcb, 0x9a, 0xa4, 0xa1, 0xa3, 0xbf, 0xc5, 0x70, 0x9e, 0x4f, 0xc2, 0xaa, 0xca, 0xfc,
+	0x42, 0xc8, 0x34, 0x74, 0x51, 0x13, 0xdc, 0xf7, 0x3f, 0x1f, 0x86, 0xb5, 0xee, 0x17, 0x17, 0x07,
+	0x57, 0x54, 0x5e, 0x8f, 0x07, 0xea, 0x17, 0x6c, 0xcf, 0x34, 0xf9, 0x73, 0xca, 0xad, 0xb4, 0x47,
+	0x99, 0x24, 0x05, 0xc3, 0xd9, 0x9e, 0xee, 0xfb, 0x9e, 0xe9, 0x7b, 0x3e, 0x18, 0x34, 0xf4, 0xf9,
+	0xe0, 0xdf, 0x01, 0x00, 0x92, 0x44, 0x59, 0x95, 0x14, 0x0b, 0x00, 0x00,
 }
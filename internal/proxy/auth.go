@@ -0,0 +1,174 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+const (
+	// authorizationHeader is the gRPC metadata key of the credentials, the value is either
+	// "Basic base64(username:password)" or "Bearer username:password"
+	authorizationHeader = "authorization"
+	basicAuthPrefix     = "Basic "
+	tokenAuthPrefix     = "Bearer "
+
+	// anyName matches all the databases or collections in a grant
+	anyName = "*"
+
+	minPasswordLength = 6
+	maxPasswordLength = 72
+)
+
+// readPrivileges are the privileges allowing to read the meta of a collection, any of them suffices
+var readPrivileges = []commonpb.Privilege{
+	commonpb.Privilege_PrivilegeDDL,
+	commonpb.Privilege_PrivilegeInsert,
+	commonpb.Privilege_PrivilegeSearch,
+	commonpb.Privilege_PrivilegeQuery,
+}
+
+type userContextKey struct{}
+
+// getUserFromContext returns the name of the authenticated user of the request
+func getUserFromContext(ctx context.Context) (string, bool) {
+	username, ok := ctx.Value(userContextKey{}).(string)
+	return username, ok
+}
+
+// AuthenticationInterceptor is a gRPC unary server interceptor which authenticates every request of the server
+// serving the clients when authorization is enabled, the authenticated user is put into the context of the request.
+// ProxyService for the other components is served on another port without it.
+func AuthenticationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !Params.AuthorizationEnabled {
+		return handler(ctx, req)
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	username, password, err := parseCredentials(md.Get(authorizationHeader))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := authenticate(ctx, username, password); err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return handler(context.WithValue(ctx, userContextKey{}, username), req)
+}
+
+// parseCredentials returns the user name and password in the values of the authorization header
+func parseCredentials(values []string) (string, string, error) {
+	if len(values) == 0 {
+		return "", "", errors.New("missing credentials")
+	}
+	var credentials string
+	switch value := values[0]; {
+	case strings.HasPrefix(value, basicAuthPrefix):
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, basicAuthPrefix))
+		if err != nil {
+			return "", "", fmt.Errorf("invalid basic credentials: %w", err)
+		}
+		credentials = string(decoded)
+	case strings.HasPrefix(value, tokenAuthPrefix):
+		credentials = strings.TrimPrefix(value, tokenAuthPrefix)
+	default:
+		return "", "", errors.New("unsupported authorization scheme, should be Basic or Bearer")
+	}
+	idx := strings.Index(credentials, ":")
+	if idx <= 0 {
+		return "", "", errors.New("invalid credentials, should be username:password")
+	}
+	return credentials[:idx], credentials[idx+1:], nil
+}
+
+// authenticate checks the password of the user against the salted hash stored in RootCoord
+func authenticate(ctx context.Context, username string, password string) error {
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, username)
+	if err != nil {
+		return fmt.Errorf("authentication failed for user %s", username)
+	}
+	sum := sha256.Sum256([]byte(password))
+	if _, ok := credInfo.verifiedPasswords.Load(sum); ok {
+		return nil
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(credInfo.passwordHash), []byte(password)); err != nil {
+		return fmt.Errorf("authentication failed for user %s", username)
+	}
+	credInfo.verifiedPasswords.Store(sum, struct{}{})
+	return nil
+}
+
+// hasPrivilege returns whether any grant of the roles gives one of the privileges on the collection,
+// PrivilegeAdmin implies all the privileges
+func hasPrivilege(roles []*milvuspb.RoleGrants, dbName string, collectionName string, privileges ...commonpb.Privilege) bool {
+	dbName = getDatabaseName(dbName)
+	for _, role := range roles {
+		for _, grant := range role.Grants {
+			if grant.DbName != anyName && getDatabaseName(grant.DbName) != dbName {
+				continue
+			}
+			if grant.CollectionName != anyName && grant.CollectionName != collectionName {
+				continue
+			}
+			if grant.Privilege == commonpb.Privilege_PrivilegeAdmin {
+				return true
+			}
+			for _, privilege := range privileges {
+				if grant.Privilege == privilege {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// checkPrivilege returns an error unless the user of ctx has one of the privileges on the collection,
+// it always passes when authorization is disabled. An empty collectionName checks the grants on all
+// the collections of the database, i.e. those granted with "*"
+func checkPrivilege(ctx context.Context, dbName string, collectionName string, privileges ...commonpb.Privilege) error {
+	if !Params.AuthorizationEnabled {
+		return nil
+	}
+	username, ok := getUserFromContext(ctx)
+	if !ok {
+		return errors.New("permission denied, the request is not authenticated")
+	}
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, username)
+	if err != nil {
+		return err
+	}
+	if collectionName == "" {
+		collectionName = anyName
+	}
+	if !hasPrivilege(credInfo.roles, dbName, collectionName, privileges...) {
+		names := make([]string, 0, len(privileges))
+		for _, privilege := range privileges {
+			names = append(names, privilege.String())
+		}
+		return fmt.Errorf("permission denied, user %s has none of the privileges %v on collection %s of database %s",
+			username, names, collectionName, getDatabaseName(dbName))
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+func TestAuth_parseCredentials(t *testing.T) {
+	basic := basicAuthPrefix + base64.StdEncoding.EncodeToString([]byte("alice:pass:word"))
+	username, password, err := parseCredentials([]string{basic})
+	assert.Nil(t, err)
+	assert.Equal(t, "alice", username)
+	assert.Equal(t, "pass:word", password)
+
+	username, password, err = parseCredentials([]string{tokenAuthPrefix + "bob:123456"})
+	assert.Nil(t, err)
+	assert.Equal(t, "bob", username)
+	assert.Equal(t, "123456", password)
+
+	_, _, err = parseCredentials(nil)
+	assert.NotNil(t, err)
+	_, _, err = parseCredentials([]string{"Digest abc"})
+	assert.NotNil(t, err)
+	_, _, err = parseCredentials([]string{basicAuthPrefix + "!!!"})
+	assert.NotNil(t, err)
+	_, _, err = parseCredentials([]string{tokenAuthPrefix + ":123456"})
+	assert.NotNil(t, err)
+}
+
+func TestAuth_AuthenticationInterceptor(t *testing.T) {
+	enabled := Params.AuthorizationEnabled
+	defer func() {
+		Params.AuthorizationEnabled = enabled
+	}()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.proxy.Proxy/InvalidateCollectionMetaCache"}
+
+	Params.AuthorizationEnabled = false
+	resp, err := AuthenticationInterceptor(context.Background(), nil, info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "ok", resp)
+
+	// every request of the server is authenticated, not only those of MilvusService
+	Params.AuthorizationEnabled = true
+	_, err = AuthenticationInterceptor(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuth_hasPrivilege(t *testing.T) {
+	Params.Init()
+	roles := []*milvuspb.RoleGrants{
+		{
+			RoleName: "reader",
+			Grants: []*milvuspb.Grant{
+				{DbName: Params.DefaultDatabaseName, CollectionName: "coll", Privilege: commonpb.Privilege_PrivilegeSearch},
+				{DbName: "db", CollectionName: anyName, Privilege: commonpb.Privilege_PrivilegeQuery},
+			},
+		},
+	}
+	assert.True(t, hasPrivilege(roles, "", "coll", commonpb.Privilege_PrivilegeSearch))
+	assert.False(t, hasPrivilege(roles, "", "coll", commonpb.Privilege_PrivilegeInsert))
+	assert.False(t, hasPrivilege(roles, "", "other", commonpb.Privilege_PrivilegeSearch))
+	assert.True(t, hasPrivilege(roles, "db", "other", readPrivileges...))
+	assert.False(t, hasPrivilege(roles, "db", "other", commonpb.Privilege_PrivilegeDDL))
+
+	admin := []*milvuspb.RoleGrants{
+		{
+			RoleName: "admin",
+			Grants:   []*milvuspb.Grant{{DbName: anyName, CollectionName: anyName, Privilege: commonpb.Privilege_PrivilegeAdmin}},
+		},
+	}
+	assert.True(t, hasPrivilege(admin, "db", anyName, commonpb.Privilege_PrivilegeAdmin))
+	assert.True(t, hasPrivilege(admin, "", "coll", commonpb.Privilege_PrivilegeDDL))
+}
+
+func TestAuth_checkPrivilege(t *testing.T) {
+	Params.Init()
+	Params.AuthorizationEnabled = false
+	assert.Nil(t, checkPrivilege(context.Background(), "", "coll", commonpb.Privilege_PrivilegeDDL))
+
+	Params.AuthorizationEnabled = true
+	defer func() {
+		Params.AuthorizationEnabled = false
+	}()
+	assert.NotNil(t, checkPrivilege(context.Background(), "", "coll", commonpb.Privilege_PrivilegeDDL))
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const (
+	// sessionExpiration is how long the last write of an idle client connection is remembered
+	sessionExpiration = time.Hour
+	// sessionCleanupInterval is the minimal interval between two scans for expired sessions
+	sessionCleanupInterval = time.Minute
+
+	// eventuallyTs is the guarantee timestamp of the eventually consistency level,
+	// query nodes serve it with whatever data they have consumed
+	eventuallyTs Timestamp = 1
+)
+
+type clientSession struct {
+	lastWrite Timestamp
+	updatedAt time.Time
+}
+
+// clientSessions tracks the timestamp of the last write of every client connection,
+// it provides the guarantee timestamp of the session consistency level.
+type clientSessions struct {
+	mu          sync.Mutex
+	sessions    map[string]*clientSession // client address to session
+	lastCleanup time.Time
+}
+
+func newClientSessions() *clientSessions {
+	return &clientSessions{
+		sessions:    make(map[string]*clientSession),
+		lastCleanup: time.Now(),
+	}
+}
+
+// getClientAddr returns the address of the client connection of ctx, empty if ctx is not from a grpc request
+func getClientAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	return p.Addr.String()
+}
+
+// updateLastWrite records ts as the last write timestamp of the client of ctx
+func (c *clientSessions) updateLastWrite(ctx context.Context, ts Timestamp) {
+	addr := getClientAddr(ctx)
+	if addr == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	session, ok := c.sessions[addr]
+	if !ok {
+		session = &clientSession{}
+		c.sessions[addr] = session
+	}
+	if ts > session.lastWrite {
+		session.lastWrite = ts
+	}
+	session.updatedAt = now
+	c.removeExpired(now)
+}
+
+// getLastWrite returns the last write timestamp of the client of ctx, 0 if the client wrote nothing
+func (c *clientSessions) getLastWrite(ctx context.Context) Timestamp {
+	addr := getClientAddr(ctx)
+	if addr == "" {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	session, ok := c.sessions[addr]
+	if !ok {
+		return 0
+	}
+	return session.lastWrite
+}
+
+// removeExpired forgets the sessions idle for longer than sessionExpiration, caller must hold the lock
+func (c *clientSessions) removeExpired(now time.Time) {
+	if now.Sub(c.lastCleanup) < sessionCleanupInterval {
+		return
+	}
+	for addr, session := range c.sessions {
+		if now.Sub(session.updatedAt) > sessionExpiration {
+			delete(c.sessions, addr)
+		}
+	}
+	c.lastCleanup = now
+}
+
+// getGuaranteeTimestamp picks the timestamp query nodes must have consumed before serving a request
+// of the consistency level, a guarantee timestamp set by the user overrides the consistency level.
+func getGuaranteeTimestamp(ctx context.Context, level commonpb.ConsistencyLevel, guaranteeTs Timestamp,
+	beginTs Timestamp, sessions *clientSessions) Timestamp {
+	if guaranteeTs != 0 {
+		return guaranteeTs
+	}
+	switch level {
+	case commonpb.ConsistencyLevel_Session:
+		if sessions != nil {
+			if ts := sessions.getLastWrite(ctx); ts != 0 {
+				return ts
+			}
+		}
+		return eventuallyTs
+	case commonpb.ConsistencyLevel_Bounded:
+		ts := tsoutil.SubPhysicalDuration(beginTs, Params.BoundedStaleness)
+		if ts == 0 {
+			return eventuallyTs
+		}
+		return ts
+	case commonpb.ConsistencyLevel_Eventually:
+		return eventuallyTs
+	default:
+		return beginTs
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func newPeerContext(addr string) context.Context {
+	tcpAddr, _ := net.ResolveTCPAddr("tcp", addr)
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: tcpAddr})
+}
+
+func TestClientSessions(t *testing.T) {
+	sessions := newClientSessions()
+	ctx1 := newPeerContext("127.0.0.1:10001")
+	ctx2 := newPeerContext("127.0.0.1:10002")
+
+	sessions.updateLastWrite(ctx1, 100)
+	sessions.updateLastWrite(ctx1, 50)
+	assert.EqualValues(t, 100, sessions.getLastWrite(ctx1))
+	assert.EqualValues(t, 0, sessions.getLastWrite(ctx2))
+
+	// requests not from grpc clients are not tracked
+	sessions.updateLastWrite(context.Background(), 200)
+	assert.EqualValues(t, 0, sessions.getLastWrite(context.Background()))
+
+	sessions.updateLastWrite(ctx2, 300)
+	sessions.sessions["127.0.0.1:10001"].updatedAt = time.Now().Add(-2 * sessionExpiration)
+	sessions.lastCleanup = time.Now().Add(-2 * sessionCleanupInterval)
+	sessions.updateLastWrite(ctx2, 400)
+	assert.EqualValues(t, 0, sessions.getLastWrite(ctx1))
+	assert.EqualValues(t, 400, sessions.getLastWrite(ctx2))
+}
+
+func TestGetGuaranteeTimestamp(t *testing.T) {
+	Params.Init()
+	Params.BoundedStaleness = time.Second
+
+	ctx := newPeerContext("127.0.0.1:10001")
+	sessions := newClientSessions()
+	beginTs := tsoutil.ComposeTS(time.Now().UnixNano()/int64(time.Millisecond), 10)
+
+	assert.EqualValues(t, 5, getGuaranteeTimestamp(ctx, commonpb.ConsistencyLevel_Eventually, 5, beginTs, sessions))
+	assert.EqualValues(t, beginTs, getGuaranteeTimestamp(ctx, commonpb.ConsistencyLevel_Strong, 0, beginTs, sessions))
+	assert.EqualValues(t, eventuallyTs, getGuaranteeTimestamp(ctx, commonpb.ConsistencyLevel_Eventually, 0, beginTs, sessions))
+	assert.EqualValues(t, tsoutil.SubPhysicalDuration(beginTs, time.Second),
+		getGuaranteeTimestamp(ctx, commonpb.ConsistencyLevel_Bounded, 0, beginTs, sessions))
+
+	assert.EqualValues(t, eventuallyTs, getGuaranteeTimestamp(ctx, commonpb.ConsistencyLevel_Session, 0, beginTs, sessions))
+	sessions.updateLastWrite(ctx, beginTs-1)
+	assert.EqualValues(t, beginTs-1, getGuaranteeTimestamp(ctx, commonpb.ConsistencyLevel_Session, 0, beginTs, sessions))
+}
@@ -17,7 +17,9 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -26,7 +28,6 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
-	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
@@ -80,7 +81,7 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 
 	collectionName := request.CollectionName
 	if globalMetaCache != nil {
-		globalMetaCache.RemoveCollection(ctx, collectionName) // no need to return error, though collection may be not cached
+		globalMetaCache.RemoveCollection(ctx, request.DbName, collectionName) // no need to return error, though collection may be not cached
 	}
 	log.Debug("InvalidateCollectionMetaCache Done",
 		zap.String("role", Params.RoleName),
@@ -93,6 +94,24 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 	}, nil
 }
 
+func (node *Proxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	log.Debug("InvalidateCredentialCache",
+		zap.String("role", Params.RoleName),
+		zap.String("username", request.Username))
+
+	if globalMetaCache != nil {
+		globalMetaCache.RemoveCredential(ctx, request.Username)
+	}
+	log.Debug("InvalidateCredentialCache Done",
+		zap.String("role", Params.RoleName),
+		zap.String("username", request.Username))
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func (node *Proxy) ReleaseDQLMessageStream(ctx context.Context, request *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error) {
 	log.Debug("ReleaseDQLMessageStream",
 		zap.Any("role", Params.RoleName),
@@ -116,6 +135,328 @@ func (node *Proxy) ReleaseDQLMessageStream(ctx context.Context, request *proxypb
 	}, nil
 }
 
+func (node *Proxy) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	cdbt := &CreateDatabaseTask{
+		ctx:                   ctx,
+		Condition:             NewTaskCondition(ctx),
+		CreateDatabaseRequest: request,
+		rootCoord:             node.rootCoord,
+	}
+
+	err := node.sched.DdQueue.Enqueue(cdbt)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: getErrorCode(err),
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("CreateDatabase",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName))
+	defer func() {
+		log.Debug("CreateDatabase Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName))
+	}()
+
+	err = cdbt.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return cdbt.result, nil
+}
+
+func (node *Proxy) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	ddbt := &DropDatabaseTask{
+		ctx:                 ctx,
+		Condition:           NewTaskCondition(ctx),
+		DropDatabaseRequest: request,
+		rootCoord:           node.rootCoord,
+	}
+
+	err := node.sched.DdQueue.Enqueue(ddbt)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: getErrorCode(err),
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("DropDatabase",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName))
+	defer func() {
+		log.Debug("DropDatabase Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName))
+	}()
+
+	err = ddbt.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return ddbt.result, nil
+}
+
+func (node *Proxy) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.ListDatabasesResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	ldbt := &ListDatabasesTask{
+		ctx:                  ctx,
+		Condition:            NewTaskCondition(ctx),
+		ListDatabasesRequest: request,
+		rootCoord:            node.rootCoord,
+	}
+
+	err := node.sched.DdQueue.Enqueue(ldbt)
+	if err != nil {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("ListDatabases",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp))
+	defer func() {
+		log.Debug("ListDatabases Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp))
+	}()
+
+	err = ldbt.WaitToFinish()
+	if err != nil {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	return ldbt.result, nil
+}
+
+func (node *Proxy) CreateUser(ctx context.Context, request *milvuspb.CreateUserRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	cut := &CreateUserTask{
+		ctx:               ctx,
+		Condition:         NewTaskCondition(ctx),
+		CreateUserRequest: request,
+		rootCoord:         node.rootCoord,
+	}
+
+	err := node.sched.DdQueue.Enqueue(cut)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("CreateUser",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("username", request.Username))
+	defer func() {
+		log.Debug("CreateUser Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("username", request.Username))
+	}()
+
+	err = cut.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return cut.result, nil
+}
+
+func (node *Proxy) GrantRole(ctx context.Context, request *milvuspb.GrantRoleRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	grt := &GrantRoleTask{
+		ctx:              ctx,
+		Condition:        NewTaskCondition(ctx),
+		GrantRoleRequest: request,
+		rootCoord:        node.rootCoord,
+	}
+
+	err := node.sched.DdQueue.Enqueue(grt)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("GrantRole",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("role name", request.RoleName),
+		zap.String("username", request.Username))
+	defer func() {
+		log.Debug("GrantRole Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("role name", request.RoleName),
+			zap.String("username", request.Username))
+	}()
+
+	err = grt.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return grt.result, nil
+}
+
+func (node *Proxy) RevokeRole(ctx context.Context, request *milvuspb.RevokeRoleRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	rrt := &RevokeRoleTask{
+		ctx:               ctx,
+		Condition:         NewTaskCondition(ctx),
+		RevokeRoleRequest: request,
+		rootCoord:         node.rootCoord,
+	}
+
+	err := node.sched.DdQueue.Enqueue(rrt)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("RevokeRole",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("role name", request.RoleName),
+		zap.String("username", request.Username))
+	defer func() {
+		log.Debug("RevokeRole Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("role name", request.RoleName),
+			zap.String("username", request.Username))
+	}()
+
+	err = rrt.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return rrt.result, nil
+}
+
+func (node *Proxy) ListGrants(ctx context.Context, request *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.ListGrantsResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	lgt := &ListGrantsTask{
+		ctx:               ctx,
+		Condition:         NewTaskCondition(ctx),
+		ListGrantsRequest: request,
+		rootCoord:         node.rootCoord,
+	}
+
+	err := node.sched.DdQueue.Enqueue(lgt)
+	if err != nil {
+		return &milvuspb.ListGrantsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("ListGrants",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("username", request.Username))
+	defer func() {
+		log.Debug("ListGrants Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("username", request.Username))
+	}()
+
+	err = lgt.WaitToFinish()
+	if err != nil {
+		return &milvuspb.ListGrantsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	return lgt.result, nil
+}
+
 func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
@@ -131,7 +472,7 @@ func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.Creat
 	err := node.sched.DdQueue.Enqueue(cct)
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: getErrorCode(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -181,7 +522,7 @@ func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCol
 	err := node.sched.DdQueue.Enqueue(dct)
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: getErrorCode(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -458,59 +799,246 @@ func (node *Proxy) GetCollectionStatistics(ctx context.Context, request *milvusp
 			},
 		}, nil
 	}
-
-	return g.result, nil
+
+	return g.result, nil
+}
+
+func (node *Proxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.ShowCollectionsResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	sct := &ShowCollectionsTask{
+		ctx:                    ctx,
+		Condition:              NewTaskCondition(ctx),
+		ShowCollectionsRequest: request,
+		queryCoord:             node.queryCoord,
+		rootCoord:              node.rootCoord,
+	}
+
+	err := node.sched.DdQueue.Enqueue(sct)
+	if err != nil {
+		return &milvuspb.ShowCollectionsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("ShowCollections",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName))
+	defer func() {
+		log.Debug("ShowCollections Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName))
+	}()
+
+	err = sct.WaitToFinish()
+	if err != nil {
+		return &milvuspb.ShowCollectionsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	return sct.result, nil
+}
+
+// AddField adds a scalar field with a default value to an existing collection, the rows inserted before
+// read the default value of the field
+func (node *Proxy) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	aft := &AddFieldTask{
+		ctx:             ctx,
+		Condition:       NewTaskCondition(ctx),
+		AddFieldRequest: request,
+		rootCoord:       node.rootCoord,
+		queryCoord:      node.queryCoord,
+	}
+
+	err := node.sched.DdQueue.Enqueue(aft)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: getErrorCode(err),
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("AddField",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName))
+	defer func() {
+		log.Debug("AddField Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName))
+	}()
+
+	err = aft.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return aft.result, nil
+}
+
+func (node *Proxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	cat := &CreateAliasTask{
+		ctx:                ctx,
+		Condition:          NewTaskCondition(ctx),
+		CreateAliasRequest: request,
+		rootCoord:          node.rootCoord,
+	}
+
+	err := node.sched.DdQueue.Enqueue(cat)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: getErrorCode(err),
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("CreateAlias",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("alias", request.Alias),
+		zap.String("collection", request.CollectionName))
+	defer func() {
+		log.Debug("CreateAlias Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("alias", request.Alias),
+			zap.String("collection", request.CollectionName))
+	}()
+
+	err = cat.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return cat.result, nil
+}
+
+func (node *Proxy) DropAlias(ctx context.Context, request *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	dat := &DropAliasTask{
+		ctx:              ctx,
+		Condition:        NewTaskCondition(ctx),
+		DropAliasRequest: request,
+		rootCoord:        node.rootCoord,
+	}
+
+	err := node.sched.DdQueue.Enqueue(dat)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: getErrorCode(err),
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("DropAlias",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("alias", request.Alias))
+	defer func() {
+		log.Debug("DropAlias Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("alias", request.Alias))
+	}()
+
+	err = dat.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return dat.result, nil
 }
 
-func (node *Proxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
-		return &milvuspb.ShowCollectionsResponse{
-			Status: unhealthyStatus(),
-		}, nil
+		return unhealthyStatus(), nil
 	}
-	sct := &ShowCollectionsTask{
-		ctx:                    ctx,
-		Condition:              NewTaskCondition(ctx),
-		ShowCollectionsRequest: request,
-		queryCoord:             node.queryCoord,
-		rootCoord:              node.rootCoord,
+	aat := &AlterAliasTask{
+		ctx:               ctx,
+		Condition:         NewTaskCondition(ctx),
+		AlterAliasRequest: request,
+		rootCoord:         node.rootCoord,
 	}
 
-	err := node.sched.DdQueue.Enqueue(sct)
+	err := node.sched.DdQueue.Enqueue(aat)
 	if err != nil {
-		return &milvuspb.ShowCollectionsResponse{
-			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-				Reason:    err.Error(),
-			},
+		return &commonpb.Status{
+			ErrorCode: getErrorCode(err),
+			Reason:    err.Error(),
 		}, nil
 	}
 
-	log.Debug("ShowCollections",
+	log.Debug("AlterAlias",
 		zap.String("role", Params.RoleName),
 		zap.Int64("msgID", request.Base.MsgID),
 		zap.Uint64("timestamp", request.Base.Timestamp),
-		zap.String("db", request.DbName))
+		zap.String("db", request.DbName),
+		zap.String("alias", request.Alias),
+		zap.String("collection", request.CollectionName))
 	defer func() {
-		log.Debug("ShowCollections Done",
+		log.Debug("AlterAlias Done",
 			zap.Error(err),
 			zap.String("role", Params.RoleName),
 			zap.Int64("msgID", request.Base.MsgID),
 			zap.Uint64("timestamp", request.Base.Timestamp),
-			zap.String("db", request.DbName))
+			zap.String("db", request.DbName),
+			zap.String("alias", request.Alias),
+			zap.String("collection", request.CollectionName))
 	}()
 
-	err = sct.WaitToFinish()
+	err = aat.WaitToFinish()
 	if err != nil {
-		return &milvuspb.ShowCollectionsResponse{
-			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-				Reason:    err.Error(),
-			},
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
 		}, nil
 	}
-
-	return sct.result, nil
+	return aat.result, nil
 }
 
 func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
@@ -528,7 +1056,7 @@ func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.Create
 	err := node.sched.DdQueue.Enqueue(cpt)
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: getErrorCode(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -577,7 +1105,7 @@ func (node *Proxy) DropPartition(ctx context.Context, request *milvuspb.DropPart
 
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: getErrorCode(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -884,7 +1412,7 @@ func (node *Proxy) CreateIndex(ctx context.Context, request *milvuspb.CreateInde
 	err := node.sched.DdQueue.Enqueue(cit)
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: getErrorCode(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -993,7 +1521,7 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 	err := node.sched.DdQueue.Enqueue(dit)
 	if err != nil {
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: getErrorCode(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -1167,6 +1695,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 					MsgType: commonpb.MsgType_Insert,
 					MsgID:   0,
 				},
+				DbName:         request.DbName,
 				CollectionName: request.CollectionName,
 				PartitionName:  request.PartitionName,
 				// RowData: transfer column based request to this
@@ -1189,7 +1718,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 	err := node.sched.DmQueue.Enqueue(it)
 
 	if err != nil {
-		result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		result.Status.ErrorCode = getErrorCode(err)
 		result.Status.Reason = err.Error()
 		numRows := it.req.NumRows
 		errIndex := make([]uint32, numRows)
@@ -1241,11 +1770,183 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 			errIndex[i] = i
 		}
 		it.result.ErrIndex = errIndex
+	} else {
+		node.clientSessions.updateLastWrite(ctx, it.EndTs())
 	}
 	it.result.InsertCnt = int64(it.req.NumRows)
 	return it.result, nil
 }
 
+func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.MutationResult{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	dt := &DeleteTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		req:       request,
+		BaseDeleteTask: BaseDeleteTask{
+			BaseMsg: msgstream.BaseMsg{},
+			DeleteRequest: internalpb.DeleteRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_Delete,
+					MsgID:   0,
+				},
+				DbName:         request.DbName,
+				CollectionName: request.CollectionName,
+				PartitionName:  request.PartitionName,
+			},
+		},
+		chMgr:    node.chMgr,
+		chTicker: node.chTicker,
+	}
+
+	err := node.sched.DmQueue.Enqueue(dt)
+	if err != nil {
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("Delete",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", dt.Base.MsgID),
+		zap.Uint64("timestamp", dt.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.String("expr", request.Expr))
+	defer func() {
+		log.Debug("Delete Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", dt.Base.MsgID),
+			zap.Uint64("timestamp", dt.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("partition", request.PartitionName),
+			zap.String("expr", request.Expr))
+	}()
+
+	err = dt.WaitToFinish()
+	if err != nil {
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	if dt.result.Status.ErrorCode == commonpb.ErrorCode_Success {
+		node.clientSessions.updateLastWrite(ctx, dt.EndTs())
+	}
+
+	return dt.result, nil
+}
+
+// Upsert inserts the entities in request and replaces the existing entities with the same primary keys
+func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.MutationResult{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	partitionName := request.PartitionName
+	if len(partitionName) <= 0 {
+		partitionName = Params.DefaultPartitionName
+	}
+	ut := &UpsertTask{
+		InsertTask: InsertTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			dataCoord: node.dataCoord,
+			req: &milvuspb.InsertRequest{
+				Base:           request.Base,
+				DbName:         request.DbName,
+				CollectionName: request.CollectionName,
+				PartitionName:  partitionName,
+				FieldsData:     request.FieldsData,
+				NumRows:        request.NumRows,
+			},
+			BaseInsertTask: BaseInsertTask{
+				InsertRequest: internalpb.InsertRequest{
+					Base: &commonpb.MsgBase{
+						MsgType: commonpb.MsgType_Insert,
+						MsgID:   0,
+					},
+					DbName:         request.DbName,
+					CollectionName: request.CollectionName,
+					PartitionName:  partitionName,
+				},
+			},
+			rowIDAllocator: node.idAllocator,
+			segIDAssigner:  node.segAssigner,
+			chMgr:          node.chMgr,
+			chTicker:       node.chTicker,
+		},
+		upsertReq: request,
+	}
+
+	allIndex := func() []uint32 {
+		index := make([]uint32, request.NumRows)
+		for i := uint32(0); i < request.NumRows; i++ {
+			index[i] = i
+		}
+		return index
+	}
+	errResult := func(err error) *milvuspb.MutationResult {
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: getErrorCode(err),
+				Reason:    err.Error(),
+			},
+			ErrIndex: allIndex(),
+		}
+	}
+
+	err := node.sched.DmQueue.Enqueue(ut)
+	if err != nil {
+		return errResult(err), nil
+	}
+
+	log.Debug("Upsert",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", ut.Base.MsgID),
+		zap.Uint64("timestamp", ut.BeginTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Uint32("numRows", request.NumRows))
+	defer func() {
+		log.Debug("Upsert Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", ut.Base.MsgID),
+			zap.Uint64("timestamp", ut.BeginTs()),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("partition", request.PartitionName),
+			zap.Uint32("numRows", request.NumRows))
+	}()
+
+	err = ut.WaitToFinish()
+	if err != nil {
+		return errResult(err), nil
+	}
+	if ut.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		ut.result.ErrIndex = allIndex()
+	} else {
+		node.clientSessions.updateLastWrite(ctx, ut.EndTs())
+	}
+	ut.result.UpsertCnt = int64(request.NumRows)
+	return ut.result, nil
+}
+
 func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	if !node.checkHealthy() {
 		return &milvuspb.SearchResults{
@@ -1266,13 +1967,14 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		query:     request,
 		chMgr:     node.chMgr,
 		qc:        node.queryCoord,
+		sessions:  node.clientSessions,
 	}
 
 	err := node.sched.DqQueue.Enqueue(qt)
 	if err != nil {
 		return &milvuspb.SearchResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: getErrorCode(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1326,6 +2028,114 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	return qt.result, nil
 }
 
+// HybridSearch runs the searches on the vector fields of a collection at the same travel timestamp, and merges
+// their results by the rank params of the request
+func (node *Proxy) HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.SearchResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	failed := func(err error) *milvuspb.SearchResults {
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}
+	}
+	if len(request.Requests) == 0 {
+		return failed(errors.New("no search in hybrid search")), nil
+	}
+	params, err := parseRankParams(request.RankParams, len(request.Requests))
+	if err != nil {
+		return failed(err), nil
+	}
+	metricTypes := make([]string, len(request.Requests))
+	for i, req := range request.Requests {
+		metricType, err := GetAttrByKeyFromRepeatedKV(MetricTypeKey, req.SearchParams)
+		if err != nil && params.strategy == WeightedRankStrategy {
+			return failed(fmt.Errorf("%s not found in search_params of search %d", MetricTypeKey, i)), nil
+		}
+		metricTypes[i] = metricType
+	}
+
+	// the searches read the same snapshot of the collection, the data till the travel timestamp. The query nodes
+	// must have consumed it before serving any of the searches, whatever the consistency level is, otherwise the
+	// searches served at different times see different data.
+	travelTimestamp := request.TravelTimestamp
+	if travelTimestamp == 0 {
+		travelTimestamp, err = node.tsoAllocator.AllocOne()
+		if err != nil {
+			return failed(err), nil
+		}
+	}
+	guaranteeTimestamp := request.GuaranteeTimestamp
+	if guaranteeTimestamp < travelTimestamp {
+		guaranteeTimestamp = travelTimestamp
+	}
+
+	log.Debug("HybridSearch",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("partitions", request.PartitionNames),
+		zap.Int("searches", len(request.Requests)),
+		zap.Any("rank params", request.RankParams),
+		zap.Uint64("travel timestamp", travelTimestamp),
+		zap.Uint64("guarantee timestamp", guaranteeTimestamp))
+
+	results := make([]*milvuspb.SearchResults, len(request.Requests))
+	errs := make([]error, len(request.Requests))
+	var wg sync.WaitGroup
+	for i, req := range request.Requests {
+		searchReq := proto.Clone(req).(*milvuspb.SearchRequest)
+		searchReq.DbName = request.DbName
+		searchReq.CollectionName = request.CollectionName
+		searchReq.PartitionNames = request.PartitionNames
+		searchReq.OutputFields = request.OutputFields
+		searchReq.TravelTimestamp = travelTimestamp
+		searchReq.GuaranteeTimestamp = guaranteeTimestamp
+		searchReq.ConsistencyLevel = request.ConsistencyLevel
+		wg.Add(1)
+		go func(i int, searchReq *milvuspb.SearchRequest) {
+			defer wg.Done()
+			results[i], errs[i] = node.Search(ctx, searchReq)
+		}(i, searchReq)
+	}
+	wg.Wait()
+
+	resultData := make([]*schemapb.SearchResultData, len(results))
+	for i, result := range results {
+		if errs[i] != nil {
+			return failed(errs[i]), nil
+		}
+		if result.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return &milvuspb.SearchResults{
+				Status: result.Status,
+			}, nil
+		}
+		resultData[i] = result.Results
+	}
+	merged, err := rerankSearchResults(resultData, metricTypes, params)
+	if err != nil {
+		return failed(err), nil
+	}
+
+	log.Debug("HybridSearch Done",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Int64("nq", merged.NumQueries),
+		zap.Int64("topk", merged.TopK))
+	return &milvuspb.SearchResults{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Results: merged,
+	}, nil
+}
+
 func (node *Proxy) Retrieve(ctx context.Context, request *milvuspb.RetrieveRequest) (*milvuspb.RetrieveResults, error) {
 	if !node.checkHealthy() {
 		return &milvuspb.RetrieveResults{
@@ -1345,6 +2155,7 @@ func (node *Proxy) Retrieve(ctx context.Context, request *milvuspb.RetrieveReque
 		resultBuf: make(chan []*internalpb.RetrieveResults),
 		retrieve:  request,
 		qc:        node.queryCoord,
+		sessions:  node.clientSessions,
 	}
 
 	err := node.sched.DqQueue.Enqueue(rt)
@@ -1364,7 +2175,7 @@ func (node *Proxy) Retrieve(ctx context.Context, request *milvuspb.RetrieveReque
 		zap.String("db", request.DbName),
 		zap.String("collection", request.CollectionName),
 		zap.Any("partitions", request.PartitionNames),
-		zap.Any("len(Ids)", len(request.Ids.IdField.(*schemapb.IDs_IntId).IntId.Data)))
+		zap.Any("len(Ids)", getNumOfIDs(request.Ids)))
 	defer func() {
 		log.Debug("Retrieve Done",
 			zap.Error(err),
@@ -1374,7 +2185,7 @@ func (node *Proxy) Retrieve(ctx context.Context, request *milvuspb.RetrieveReque
 			zap.String("db", request.DbName),
 			zap.String("collection", request.CollectionName),
 			zap.Any("partitions", request.PartitionNames),
-			zap.Any("len(Ids)", len(rt.result.Ids.IdField.(*schemapb.IDs_IntId).IntId.Data)))
+			zap.Any("len(Ids)", getNumOfIDs(rt.result.Ids)))
 	}()
 
 	err = rt.WaitToFinish()
@@ -1439,13 +2250,91 @@ func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*
 	return ft.result, nil
 }
 
+// Import starts a task importing the files in object storage into a collection, the files are
+// loaded by datanodes and the progress of the task is queried by GetImportState
+func (node *Proxy) Import(ctx context.Context, request *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+	resp := &milvuspb.ImportResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "",
+		},
+	}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+	it := &ImportTask{
+		ctx:           ctx,
+		Condition:     NewTaskCondition(ctx),
+		ImportRequest: request,
+		rootCoord:     node.rootCoord,
+		dataCoord:     node.dataCoord,
+	}
+
+	err := node.sched.DdQueue.Enqueue(it)
+	if err != nil {
+		resp.Status.ErrorCode = getErrorCode(err)
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	log.Debug("Import",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", it.Base.MsgID),
+		zap.Uint64("timestamp", it.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Strings("files", request.Files))
+	defer func() {
+		log.Debug("Import Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", it.Base.MsgID),
+			zap.Uint64("timestamp", it.Base.Timestamp),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName))
+	}()
+
+	err = it.WaitToFinish()
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	return it.result, nil
+}
+
+// GetImportState returns the state and the progress of an import task
+func (node *Proxy) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	log.Debug("GetImportState",
+		zap.String("role", Params.RoleName),
+		zap.Int64("taskID", req.TaskID))
+
+	if !node.checkHealthy() {
+		return &milvuspb.GetImportStateResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	resp, err := node.dataCoord.GetImportState(ctx, req)
+	if err != nil {
+		return &milvuspb.GetImportStateResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Errorf("dataCoord:GetImportState, err:%w", err).Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
 func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
 	if !node.checkHealthy() {
 		return &milvuspb.QueryResults{
 			Status: unhealthyStatus(),
 		}, nil
 	}
-	schemaPb, err := globalMetaCache.GetCollectionSchema(ctx, request.CollectionName)
+	schemaPb, err := globalMetaCache.GetCollectionSchema(ctx, request.DbName, request.CollectionName)
 	if err != nil { // err is not nil if collection not exists
 		return nil, err
 	}
@@ -1459,49 +2348,20 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		return nil, fmt.Errorf(errMsg)
 	}
 
-	parseRetrieveTask := func(exprString string) ([]int64, error) {
-		expr, err := parseQueryExpr(schema, exprString)
-		if err != nil {
-			return nil, err
-		}
-
-		switch xExpr := expr.Expr.(type) {
-		case *planpb.Expr_TermExpr:
-			var ids []int64
-			for _, value := range xExpr.TermExpr.Values {
-				switch v := value.Val.(type) {
-				case *planpb.GenericValue_Int64Val:
-					ids = append(ids, v.Int64Val)
-				default:
-					return nil, errors.New("column is not int64")
-				}
-			}
-
-			if !xExpr.TermExpr.ColumnInfo.IsPrimaryKey {
-				return nil, errors.New("column is not primary key")
-			}
-
-			return ids, nil
-		default:
-			return nil, errors.New("not top level term")
-		}
-	}
-
-	ids, err := parseRetrieveTask(request.Expr)
+	ids, err := getPrimaryKeysFromExpr(schema, request.Expr)
 
 	if err == nil {
 		retrieveRequest := &milvuspb.RetrieveRequest{
-			DbName:         request.DbName,
-			CollectionName: request.CollectionName,
-			PartitionNames: request.PartitionNames,
-			Ids: &schemapb.IDs{
-				IdField: &schemapb.IDs_IntId{
-					IntId: &schemapb.LongArray{
-						Data: ids,
-					},
-				},
-			},
-			OutputFields: request.OutputFields,
+			DbName:             request.DbName,
+			CollectionName:     request.CollectionName,
+			PartitionNames:     request.PartitionNames,
+			Ids:                ids,
+			OutputFields:       request.OutputFields,
+			TravelTimestamp:    request.TravelTimestamp,
+			GuaranteeTimestamp: request.GuaranteeTimestamp,
+			ConsistencyLevel:   request.ConsistencyLevel,
+			Limit:              request.Limit,
+			Offset:             request.Offset,
 		}
 
 		rt := &RetrieveTask{
@@ -1518,6 +2378,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 			retrieve:  retrieveRequest,
 			chMgr:     node.chMgr,
 			qc:        node.queryCoord,
+			sessions:  node.clientSessions,
 		}
 
 		err := node.sched.DqQueue.Enqueue(rt)
@@ -1537,7 +2398,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 			zap.String("db", retrieveRequest.DbName),
 			zap.String("collection", retrieveRequest.CollectionName),
 			zap.Any("partitions", retrieveRequest.PartitionNames),
-			zap.Any("len(Ids)", len(retrieveRequest.Ids.IdField.(*schemapb.IDs_IntId).IntId.Data)))
+			zap.Any("len(Ids)", getNumOfIDs(retrieveRequest.Ids)))
 		defer func() {
 			log.Debug("Retrieve Done",
 				zap.Error(err),
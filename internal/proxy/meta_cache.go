@@ -22,18 +22,29 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
+// Cache caches the meta of collections, the collections are identified by the database and collection name.
+// An empty dbName stands for the default database.
 type Cache interface {
-	GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error)
-	GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error)
-	GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error)
-	GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
-	RemoveCollection(ctx context.Context, collectionName string)
-	RemovePartition(ctx context.Context, collectionName string, partitionName string)
+	GetCollectionID(ctx context.Context, dbName string, collectionName string) (typeutil.UniqueID, error)
+	GetPartitionID(ctx context.Context, dbName string, collectionName string, partitionName string) (typeutil.UniqueID, error)
+	GetPartitions(ctx context.Context, dbName string, collectionName string) (map[string]typeutil.UniqueID, error)
+	GetCollectionSchema(ctx context.Context, dbName string, collectionName string) (*schemapb.CollectionSchema, error)
+	RemoveCollection(ctx context.Context, dbName string, collectionName string)
+	RemovePartition(ctx context.Context, dbName string, collectionName string, partitionName string)
+	GetCredentialInfo(ctx context.Context, username string) (*credentialInfo, error)
+	// RemoveCredential removes the cached credential of the user, all of them are removed if username is empty
+	RemoveCredential(ctx context.Context, username string)
+	// GetReplicas returns the replicas on service of the collection, they are cached until RemoveReplicas.
+	// No replica is cached for a collection loaded without replicas, or whose replicas are not ready yet.
+	GetReplicas(ctx context.Context, collectionID typeutil.UniqueID) ([]*querypb.ReplicaInfo, error)
+	RemoveReplicas(ctx context.Context, collectionID typeutil.UniqueID)
 }
 
 type collectionInfo struct {
@@ -42,45 +53,89 @@ type collectionInfo struct {
 	partInfo map[string]typeutil.UniqueID
 }
 
+// credentialInfo is the password hash and the privileges of a user
+type credentialInfo struct {
+	username     string
+	passwordHash string
+	roles        []*milvuspb.RoleGrants
+	// verifiedPasswords holds the sha256 sums of the passwords verified against passwordHash,
+	// so that the expensive bcrypt comparison is done once for each password
+	verifiedPasswords sync.Map
+}
+
 type MetaCache struct {
-	client types.RootCoord
+	client     types.RootCoord
+	queryCoord types.QueryCoord
 
-	collInfo map[string]*collectionInfo
+	collInfo map[string]map[string]*collectionInfo        // database name to collection name to collection info
+	credInfo map[string]*credentialInfo                   // user name to credential info
+	replicas map[typeutil.UniqueID][]*querypb.ReplicaInfo // collection id to the replicas on service
 	mu       sync.RWMutex
 }
 
 var globalMetaCache Cache
 
-func InitMetaCache(client types.RootCoord) error {
+func InitMetaCache(client types.RootCoord, queryCoord types.QueryCoord) error {
 	var err error
-	globalMetaCache, err = NewMetaCache(client)
+	globalMetaCache, err = NewMetaCache(client, queryCoord)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func NewMetaCache(client types.RootCoord) (*MetaCache, error) {
+func NewMetaCache(client types.RootCoord, queryCoord types.QueryCoord) (*MetaCache, error) {
 	return &MetaCache{
-		client:   client,
-		collInfo: map[string]*collectionInfo{},
+		client:     client,
+		queryCoord: queryCoord,
+		collInfo:   map[string]map[string]*collectionInfo{},
+		credInfo:   map[string]*credentialInfo{},
+		replicas:   map[typeutil.UniqueID][]*querypb.ReplicaInfo{},
 	}, nil
 }
 
-func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+// getDatabaseName returns the name of the database dbName refers to, empty dbName is the default database
+func getDatabaseName(dbName string) string {
+	if dbName == "" {
+		return Params.DefaultDatabaseName
+	}
+	return dbName
+}
+
+// getCollInfo returns the cached info of the collection, caller must hold the lock
+func (m *MetaCache) getCollInfo(dbName string, collectionName string) (*collectionInfo, bool) {
+	collInfo, ok := m.collInfo[getDatabaseName(dbName)][collectionName]
+	return collInfo, ok
+}
+
+// getOrCreateCollInfo returns the cached info of the collection, an empty one is cached if absent,
+// caller must hold the write lock
+func (m *MetaCache) getOrCreateCollInfo(dbName string, collectionName string) *collectionInfo {
+	dbName = getDatabaseName(dbName)
+	if _, ok := m.collInfo[dbName]; !ok {
+		m.collInfo[dbName] = map[string]*collectionInfo{}
+	}
+	collInfo, ok := m.collInfo[dbName][collectionName]
+	if !ok {
+		collInfo = &collectionInfo{}
+		m.collInfo[dbName][collectionName] = collInfo
+	}
+	return collInfo
+}
+
+func (m *MetaCache) GetCollectionID(ctx context.Context, dbName string, collectionName string) (typeutil.UniqueID, error) {
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 
 	if !ok {
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, dbName, collectionName)
 		if err != nil {
 			return 0, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		collInfo = m.updateCollection(coll, dbName, collectionName)
 		return collInfo.collID, nil
 	}
 	defer m.mu.RUnlock()
@@ -88,20 +143,19 @@ func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string)
 	return collInfo.collID, nil
 }
 
-func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+func (m *MetaCache) GetCollectionSchema(ctx context.Context, dbName string, collectionName string) (*schemapb.CollectionSchema, error) {
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 
 	if !ok {
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		collInfo = m.updateCollection(coll, dbName, collectionName)
 		return collInfo.schema, nil
 	}
 	defer m.mu.RUnlock()
@@ -109,24 +163,22 @@ func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName stri
 	return collInfo.schema, nil
 }
 
-func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, collectionName string) {
-	_, ok := m.collInfo[collectionName]
-	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{}
-	}
-	m.collInfo[collectionName].schema = coll.Schema
-	m.collInfo[collectionName].collID = coll.CollectionID
+func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, dbName string, collectionName string) *collectionInfo {
+	collInfo := m.getOrCreateCollInfo(dbName, collectionName)
+	collInfo.schema = coll.Schema
+	collInfo.collID = coll.CollectionID
+	return collInfo
 }
 
-func (m *MetaCache) GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitionID(ctx context.Context, dbName string, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+	_, err := m.GetCollectionID(ctx, dbName, collectionName)
 	if err != nil {
 		return 0, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return 0, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -136,7 +188,7 @@ func (m *MetaCache) GetPartitionID(ctx context.Context, collectionName string, p
 	m.mu.RUnlock()
 
 	if !ok {
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, dbName, collectionName)
 		if err != nil {
 			return 0, err
 		}
@@ -144,10 +196,9 @@ func (m *MetaCache) GetPartitionID(ctx context.Context, collectionName string, p
 		m.mu.Lock()
 		defer m.mu.Unlock()
 		log.Debug("proxy", zap.Any("GetPartitionID:partitions before update", partitions), zap.Any("collectionName", collectionName))
-		m.updatePartitions(partitions, collectionName)
+		partInfo := m.updatePartitions(partitions, dbName, collectionName)
 		log.Debug("proxy", zap.Any("GetPartitionID:partitions after update", partitions), zap.Any("collectionName", collectionName))
 
-		partInfo := m.collInfo[collectionName].partInfo
 		_, ok := partInfo[partitionName]
 		if !ok {
 			return 0, fmt.Errorf("partitionID of partitionName:%s can not be find", partitionName)
@@ -157,15 +208,15 @@ func (m *MetaCache) GetPartitionID(ctx context.Context, collectionName string, p
 	return partitionID, nil
 }
 
-func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitions(ctx context.Context, dbName string, collectionName string) (map[string]typeutil.UniqueID, error) {
+	_, err := m.GetCollectionID(ctx, dbName, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -174,7 +225,7 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	if collInfo.partInfo == nil || len(collInfo.partInfo) == 0 {
 		m.mu.RUnlock()
 
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
@@ -182,10 +233,9 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 		m.mu.Lock()
 		defer m.mu.Unlock()
 
-		m.updatePartitions(partitions, collectionName)
+		partInfo := m.updatePartitions(partitions, dbName, collectionName)
 
 		ret := make(map[string]typeutil.UniqueID)
-		partInfo := m.collInfo[collectionName].partInfo
 		for k, v := range partInfo {
 			ret[k] = v
 		}
@@ -195,19 +245,19 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	defer m.mu.RUnlock()
 
 	ret := make(map[string]typeutil.UniqueID)
-	partInfo := m.collInfo[collectionName].partInfo
-	for k, v := range partInfo {
+	for k, v := range collInfo.partInfo {
 		ret[k] = v
 	}
 
 	return ret, nil
 }
 
-func (m *MetaCache) describeCollection(ctx context.Context, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
+func (m *MetaCache) describeCollection(ctx context.Context, dbName string, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
 	req := &milvuspb.DescribeCollectionRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_DescribeCollection,
 		},
+		DbName:         dbName,
 		CollectionName: collectionName,
 	}
 	coll, err := m.client.DescribeCollection(ctx, req)
@@ -237,11 +287,12 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 	return resp, nil
 }
 
-func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
+func (m *MetaCache) showPartitions(ctx context.Context, dbName string, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
 	req := &milvuspb.ShowPartitionsRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_ShowPartitions,
 		},
+		DbName:         dbName,
 		CollectionName: collectionName,
 	}
 
@@ -261,14 +312,9 @@ func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (
 	return partitions, nil
 }
 
-func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, collectionName string) {
-	_, ok := m.collInfo[collectionName]
-	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{
-			partInfo: map[string]typeutil.UniqueID{},
-		}
-	}
-	partInfo := m.collInfo[collectionName].partInfo
+func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, dbName string, collectionName string) map[string]typeutil.UniqueID {
+	collInfo := m.getOrCreateCollInfo(dbName, collectionName)
+	partInfo := collInfo.partInfo
 	if partInfo == nil {
 		partInfo = map[string]typeutil.UniqueID{}
 	}
@@ -279,25 +325,115 @@ func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse
 			partInfo[partitions.PartitionNames[i]] = partitions.PartitionIDs[i]
 		}
 	}
-	m.collInfo[collectionName].partInfo = partInfo
+	collInfo.partInfo = partInfo
+	return partInfo
 }
 
-func (m *MetaCache) RemoveCollection(ctx context.Context, collectionName string) {
+// RemoveCollection removes the cached meta of the collection, collectionName may be an alias.
+// Entries cached under the aliases of the collection are removed as well.
+func (m *MetaCache) RemoveCollection(ctx context.Context, dbName string, collectionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	delete(m.collInfo, collectionName)
+	dbColls := m.collInfo[getDatabaseName(dbName)]
+	delete(dbColls, collectionName)
+	for name, collInfo := range dbColls {
+		if collInfo.schema.GetName() == collectionName {
+			delete(dbColls, name)
+		}
+	}
 }
 
-func (m *MetaCache) RemovePartition(ctx context.Context, collectionName, partitionName string) {
+func (m *MetaCache) RemovePartition(ctx context.Context, dbName string, collectionName, partitionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	_, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 	if !ok {
 		return
 	}
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := collInfo.partInfo
 	if partInfo == nil {
 		return
 	}
 	delete(partInfo, partitionName)
 }
+
+func (m *MetaCache) GetCredentialInfo(ctx context.Context, username string) (*credentialInfo, error) {
+	m.mu.RLock()
+	credInfo, ok := m.credInfo[username]
+	m.mu.RUnlock()
+	if ok {
+		return credInfo, nil
+	}
+
+	req := &rootcoordpb.GetCredentialRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_GetCredential,
+		},
+		Username: username,
+	}
+	resp, err := m.client.GetCredential(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+	credInfo = &credentialInfo{
+		username:     resp.Username,
+		passwordHash: resp.PasswordHash,
+		roles:        resp.Roles,
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.credInfo[username] = credInfo
+	return credInfo, nil
+}
+
+func (m *MetaCache) RemoveCredential(ctx context.Context, username string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if username == "" {
+		m.credInfo = map[string]*credentialInfo{}
+		return
+	}
+	delete(m.credInfo, username)
+}
+
+func (m *MetaCache) GetReplicas(ctx context.Context, collectionID typeutil.UniqueID) ([]*querypb.ReplicaInfo, error) {
+	m.mu.RLock()
+	replicas, ok := m.replicas[collectionID]
+	m.mu.RUnlock()
+	if ok {
+		return replicas, nil
+	}
+
+	req := &querypb.GetReplicasRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_GetReplicas,
+			SourceID: Params.ProxyID,
+		},
+		CollectionID: collectionID,
+	}
+	resp, err := m.queryCoord.GetReplicas(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+
+	if len(resp.Replicas) == 0 {
+		return resp.Replicas, nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.replicas[collectionID] = resp.Replicas
+	return resp.Replicas, nil
+}
+
+func (m *MetaCache) RemoveReplicas(ctx context.Context, collectionID typeutil.UniqueID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.replicas, collectionID)
+}
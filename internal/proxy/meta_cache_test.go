@@ -71,21 +71,21 @@ func (m *MockRootCoordClientInterface) DescribeCollection(ctx context.Context, i
 func TestMetaCache_GetCollection(t *testing.T) {
 	ctx := context.Background()
 	client := &MockRootCoordClientInterface{}
-	err := InitMetaCache(client)
+	err := InitMetaCache(client, nil)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err := globalMetaCache.GetCollectionID(ctx, "", "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
 	})
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection2")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection2")
 	assert.NotNil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(0))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection2")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection2")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 }
@@ -93,22 +93,115 @@ func TestMetaCache_GetCollection(t *testing.T) {
 func TestMetaCache_GetPartitionID(t *testing.T) {
 	ctx := context.Background()
 	client := &MockRootCoordClientInterface{}
-	err := InitMetaCache(client)
+	err := InitMetaCache(client, nil)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetPartitionID(ctx, "collection1", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, "", "collection1", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par3")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "par3")
 	assert.NotNil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(0))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par3")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection2", "par3")
 	assert.NotNil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(0))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par4")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection2", "par4")
 	assert.NotNil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(0))
 }
+
+type aliasRootCoord struct {
+	types.RootCoord
+	aliases map[string]string // alias to collection name
+}
+
+func (m *aliasRootCoord) DescribeCollection(ctx context.Context, in *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	collIDs := map[string]typeutil.UniqueID{"collection1": 1, "collection2": 2}
+	collName := in.CollectionName
+	if name, ok := m.aliases[collName]; ok {
+		collName = name
+	}
+	return &milvuspb.DescribeCollectionResponse{
+		Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		CollectionID: collIDs[collName],
+		Schema:       &schemapb.CollectionSchema{Name: collName},
+	}, nil
+}
+
+func TestMetaCache_Alias(t *testing.T) {
+	ctx := context.Background()
+	client := &aliasRootCoord{aliases: map[string]string{"alias": "collection1"}}
+	cache, err := NewMetaCache(client, nil)
+	assert.Nil(t, err)
+
+	id, err := cache.GetCollectionID(ctx, "", "alias")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), id)
+	schema, err := cache.GetCollectionSchema(ctx, "", "alias")
+	assert.Nil(t, err)
+	assert.Equal(t, "collection1", schema.Name)
+
+	// the alias keeps resolving to the cached collection until it is invalidated
+	client.aliases["alias"] = "collection2"
+	id, err = cache.GetCollectionID(ctx, "", "alias")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), id)
+
+	cache.RemoveCollection(ctx, "", "alias")
+	id, err = cache.GetCollectionID(ctx, "", "alias")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(2), id)
+
+	// removing a collection removes its aliases as well
+	cache.RemoveCollection(ctx, "", "collection2")
+	_, ok := cache.getCollInfo("", "alias")
+	assert.False(t, ok)
+}
+
+type databaseRootCoord struct {
+	types.RootCoord
+}
+
+func (m *databaseRootCoord) DescribeCollection(ctx context.Context, in *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	collIDs := map[string]typeutil.UniqueID{"": 1, Params.DefaultDatabaseName: 1, "db1": 2}
+	collID, ok := collIDs[in.DbName]
+	if !ok {
+		return &milvuspb.DescribeCollectionResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "database not found"},
+		}, nil
+	}
+	return &milvuspb.DescribeCollectionResponse{
+		Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		CollectionID: collID,
+		Schema:       &schemapb.CollectionSchema{Name: in.CollectionName},
+	}, nil
+}
+
+func TestMetaCache_Database(t *testing.T) {
+	ctx := context.Background()
+	cache, err := NewMetaCache(&databaseRootCoord{}, nil)
+	assert.Nil(t, err)
+
+	// collections of the same name in different databases are cached apart
+	id, err := cache.GetCollectionID(ctx, "", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), id)
+	id, err = cache.GetCollectionID(ctx, "db1", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(2), id)
+	_, err = cache.GetCollectionID(ctx, "db2", "collection1")
+	assert.NotNil(t, err)
+
+	// empty database name refers to the default database
+	_, ok := cache.getCollInfo(Params.DefaultDatabaseName, "collection1")
+	assert.True(t, ok)
+
+	cache.RemoveCollection(ctx, "db1", "collection1")
+	_, ok = cache.getCollInfo("db1", "collection1")
+	assert.False(t, ok)
+	_, ok = cache.getCollInfo("", "collection1")
+	assert.True(t, ok)
+}
@@ -44,6 +44,8 @@ type ParamTable struct {
 
 	ProxyID                    UniqueID
 	TimeTickInterval           time.Duration
+	BoundedStaleness           time.Duration
+	ReplicaTimeout             time.Duration
 	SearchResultChannelNames   []string
 	RetrieveResultChannelNames []string
 	ProxySubName               string
@@ -52,14 +54,31 @@ type ParamTable struct {
 	MaxNameLength              int64
 	MaxFieldNum                int64
 	MaxDimension               int64
+	DefaultDatabaseName        string
 	DefaultPartitionName       string
 	DefaultIndexName           string
+	RetentionDuration          int64
+	AuthorizationEnabled       bool
+
+	RateLimitEnabled     bool
+	GlobalRateLimit      RateLimitConfig            // limits on all the requests of the proxy
+	CollectionRateLimits map[string]RateLimitConfig // db.collection in lower case -> limits on its requests
+	QuotaRefreshInterval time.Duration              // interval of refreshing the row counts of collections
 
 	PulsarMaxMessageSize int
 	Log                  log.Config
 	RoleName             string
 }
 
+// RateLimitConfig holds the rate limits and the storage quota of the proxy or of a collection, 0 means unlimited
+type RateLimitConfig struct {
+	InsertRows      float64 // rows per second
+	InsertBytes     float64 // bytes per second
+	SearchQPS       float64
+	DDLOpsPerMinute float64
+	MaxRows         int64 // max row count of a collection, the global one applies to every collection
+}
+
 var Params ParamTable
 var once sync.Once
 
@@ -81,14 +100,20 @@ func (pt *ParamTable) initParams() {
 	pt.initPulsarAddress()
 	pt.initRocksmqPath()
 	pt.initTimeTickInterval()
+	pt.initBoundedStaleness()
 	pt.initProxySubName()
 	pt.initProxyTimeTickChannelNames()
 	pt.initMsgStreamTimeTickBufSize()
 	pt.initMaxNameLength()
 	pt.initMaxFieldNum()
 	pt.initMaxDimension()
+	pt.initReplicaTimeout()
+	pt.initDefaultDatabaseName()
 	pt.initDefaultPartitionName()
 	pt.initDefaultIndexName()
+	pt.initRetentionDuration()
+	pt.initAuthorizationEnabled()
+	pt.initRateLimit()
 
 	pt.initPulsarMaxMessageSize()
 	pt.initRoleName()
@@ -126,6 +151,18 @@ func (pt *ParamTable) initTimeTickInterval() {
 	pt.TimeTickInterval = time.Duration(interval) * time.Millisecond
 }
 
+func (pt *ParamTable) initBoundedStaleness() {
+	stalenessStr, err := pt.Load("proxy.boundedStaleness")
+	if err != nil {
+		panic(err)
+	}
+	staleness, err := strconv.Atoi(stalenessStr)
+	if err != nil {
+		panic(err)
+	}
+	pt.BoundedStaleness = time.Duration(staleness) * time.Millisecond
+}
+
 func (pt *ParamTable) initProxySubName() {
 	prefix, err := pt.Load("msgChannel.subNamePrefix.proxySubNamePrefix")
 	if err != nil {
@@ -183,6 +220,18 @@ func (pt *ParamTable) initMaxDimension() {
 	pt.MaxDimension = maxDimension
 }
 
+func (pt *ParamTable) initReplicaTimeout() {
+	pt.ReplicaTimeout = time.Duration(pt.ParseInt64("proxy.replicaTimeout")) * time.Millisecond
+}
+
+func (pt *ParamTable) initDefaultDatabaseName() {
+	name, err := pt.Load("common.defaultDatabaseName")
+	if err != nil {
+		panic(err)
+	}
+	pt.DefaultDatabaseName = name
+}
+
 func (pt *ParamTable) initDefaultPartitionName() {
 	name, err := pt.Load("common.defaultPartitionName")
 	if err != nil {
@@ -199,6 +248,75 @@ func (pt *ParamTable) initDefaultIndexName() {
 	pt.DefaultIndexName = name
 }
 
+func (pt *ParamTable) initRetentionDuration() {
+	pt.RetentionDuration = pt.ParseInt64("common.retentionDuration")
+}
+
+func (pt *ParamTable) initAuthorizationEnabled() {
+	enabled, err := pt.Load("common.security.authorizationEnabled")
+	if err != nil {
+		panic(err)
+	}
+	pt.AuthorizationEnabled, err = strconv.ParseBool(enabled)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (pt *ParamTable) initRateLimit() {
+	enabled, err := pt.Load("proxy.rateLimit.enabled")
+	if err != nil {
+		panic(err)
+	}
+	pt.RateLimitEnabled, err = strconv.ParseBool(enabled)
+	if err != nil {
+		panic(err)
+	}
+	pt.GlobalRateLimit = RateLimitConfig{
+		InsertRows:      pt.ParseFloat("proxy.rateLimit.insertRows"),
+		InsertBytes:     pt.ParseFloat("proxy.rateLimit.insertBytes"),
+		SearchQPS:       pt.ParseFloat("proxy.rateLimit.searchQPS"),
+		DDLOpsPerMinute: pt.ParseFloat("proxy.rateLimit.ddlOpsPerMinute"),
+		MaxRows:         pt.ParseInt64("proxy.rateLimit.maxRowsPerCollection"),
+	}
+	pt.QuotaRefreshInterval = time.Duration(pt.ParseInt64("proxy.rateLimit.quotaRefreshInterval")) * time.Second
+
+	// the limits of collections are keyed by proxy.rateLimit.collections.<db>.<collection>.<limit>,
+	// keys are stored in lower case
+	prefix := "proxy.ratelimit.collections."
+	keys, values, err := pt.LoadRange(prefix, "proxy.ratelimit.collections/", 0)
+	if err != nil {
+		panic(err)
+	}
+	pt.CollectionRateLimits = make(map[string]RateLimitConfig)
+	for i, key := range keys {
+		names := strings.Split(strings.TrimPrefix(key, prefix), ".")
+		if len(names) != 3 {
+			panic(fmt.Errorf("invalid rate limit config %s", key))
+		}
+		collection := names[0] + "." + names[1]
+		config := pt.CollectionRateLimits[collection]
+		switch names[2] {
+		case "insertrows":
+			config.InsertRows, err = strconv.ParseFloat(values[i], 64)
+		case "insertbytes":
+			config.InsertBytes, err = strconv.ParseFloat(values[i], 64)
+		case "searchqps":
+			config.SearchQPS, err = strconv.ParseFloat(values[i], 64)
+		case "ddlopsperminute":
+			config.DDLOpsPerMinute, err = strconv.ParseFloat(values[i], 64)
+		case "maxrows":
+			config.MaxRows, err = strconv.ParseInt(values[i], 10, 64)
+		default:
+			err = fmt.Errorf("unknown rate limit %s", names[2])
+		}
+		if err != nil {
+			panic(err)
+		}
+		pt.CollectionRateLimits[collection] = config
+	}
+}
+
 func (pt *ParamTable) initPulsarMaxMessageSize() {
 	// pulsarHost, err := pt.Load("pulsar.address")
 	// if err != nil {
@@ -124,6 +124,9 @@ func (context *ParserContext) handleCmpExpr(node *ant_ast.BinaryNode) (*planpb.E
 	if err != nil {
 		return nil, err
 	}
+	if field.DataType == schemapb.DataType_String {
+		return nil, fmt.Errorf("compare expr on string field %s is not supported, use in expr instead", field.Name)
+	}
 
 	val, err := context.handleLeafValue(valueNode, field.DataType)
 	if err != nil {
@@ -286,6 +289,16 @@ func (context *ParserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType sc
 		} else {
 			return nil, fmt.Errorf("type mismatch")
 		}
+	case *ant_ast.StringNode:
+		if dataType == schemapb.DataType_String {
+			gv = &planpb.GenericValue{
+				Val: &planpb.GenericValue_StringVal{
+					StringVal: node.Value,
+				},
+			}
+		} else {
+			return nil, fmt.Errorf("type mismatch")
+		}
 	default:
 		return nil, fmt.Errorf("unsupported leaf node")
 	}
@@ -317,7 +330,8 @@ func (context *ParserContext) handleExpr(nodeRaw *ant_ast.Node) (*planpb.Expr, e
 	case *ant_ast.IdentifierNode,
 		*ant_ast.FloatNode,
 		*ant_ast.IntegerNode,
-		*ant_ast.BoolNode:
+		*ant_ast.BoolNode,
+		*ant_ast.StringNode:
 		return nil, fmt.Errorf("scalar expr is not supported yet")
 	case *ant_ast.UnaryNode:
 		expr, err := context.handleUnaryExpr(node)
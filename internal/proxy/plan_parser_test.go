@@ -137,3 +137,51 @@ func TestExprPlan_Str(t *testing.T) {
 		println(dbgStr)
 	}
 }
+
+func TestGetPrimaryKeysFromExpr(t *testing.T) {
+	schemaPb := newTestSchema()
+	schemaPb.Fields[0].IsPrimaryKey = true
+	schema, err := typeutil.CreateSchemaHelper(schemaPb)
+	assert.Nil(t, err)
+
+	pks, err := getPrimaryKeysFromExpr(schema, "FieldID in [1, 2, 3]")
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, pks.GetIntId().GetData())
+
+	invalidExprs := []string{
+		"FieldID > 3",
+		"Int64Field in [1, 2, 3]",
+		"FieldID in [1, 2, 3] && Int64Field < 3",
+		"not a valid expr",
+	}
+	for _, expr := range invalidExprs {
+		_, err = getPrimaryKeysFromExpr(schema, expr)
+		assert.NotNil(t, err, expr)
+	}
+}
+
+func TestGetPrimaryKeysFromExpr_String(t *testing.T) {
+	schemaPb := &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "uuid", IsPrimaryKey: true, DataType: schemapb.DataType_String},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+		},
+	}
+	schema, err := typeutil.CreateSchemaHelper(schemaPb)
+	assert.Nil(t, err)
+
+	pks, err := getPrimaryKeysFromExpr(schema, `uuid in ["a", "b"]`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, pks.GetStrId().GetData())
+
+	invalidExprs := []string{
+		`uuid in [1, 2]`,
+		`age in ["a"]`,
+		`uuid == "a"`,
+	}
+	for _, expr := range invalidExprs {
+		_, err = getPrimaryKeysFromExpr(schema, expr)
+		assert.NotNil(t, err, expr)
+	}
+}
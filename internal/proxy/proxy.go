@@ -70,6 +70,9 @@ type Proxy struct {
 
 	session *sessionutil.Session
 
+	// last write timestamps of client connections, for session consistency
+	clientSessions *clientSessions
+
 	msFactory msgstream.Factory
 
 	// Add callback functions at different stages
@@ -81,9 +84,10 @@ func NewProxy(ctx context.Context, factory msgstream.Factory) (*Proxy, error) {
 	rand.Seed(time.Now().UnixNano())
 	ctx1, cancel := context.WithCancel(ctx)
 	node := &Proxy{
-		ctx:       ctx1,
-		cancel:    cancel,
-		msFactory: factory,
+		ctx:            ctx1,
+		cancel:         cancel,
+		msFactory:      factory,
+		clientSessions: newClientSessions(),
 	}
 	node.UpdateStateCode(internalpb.StateCode_Abnormal)
 	log.Debug("Proxy", zap.Any("State", node.stateCode.Load()))
@@ -255,6 +259,9 @@ func (node *Proxy) Init() error {
 	if err != nil {
 		return err
 	}
+	if Params.RateLimitEnabled {
+		node.sched.rateLimiter = newRateLimiter(node.dataCoord)
+	}
 
 	node.tick = newTimeTick(node.ctx, node.tsoAllocator, time.Millisecond*200, node.sched.TaskDoneTest, node.msFactory)
 
@@ -331,7 +338,7 @@ func (node *Proxy) sendChannelsTimeTickLoop() {
 }
 
 func (node *Proxy) Start() error {
-	err := InitMetaCache(node.rootCoord)
+	err := InitMetaCache(node.rootCoord, node.queryCoord)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,365 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+type rateType int
+
+const (
+	insertRowsRate rateType = iota
+	insertBytesRate
+	searchRate
+	ddlRate
+)
+
+func (rt rateType) String() string {
+	switch rt {
+	case insertRowsRate:
+		return "insert rows"
+	case insertBytesRate:
+		return "insert bytes"
+	case searchRate:
+		return "search"
+	case ddlRate:
+		return "DDL"
+	}
+	return "unknown"
+}
+
+// throttleError is returned for the requests rejected by the rate limits or the storage quotas
+type throttleError struct {
+	code       commonpb.ErrorCode
+	reason     string
+	retryAfter time.Duration // 0 if retrying doesn't help
+}
+
+func (e *throttleError) Error() string {
+	if e.retryAfter > 0 {
+		return fmt.Sprintf("%s, retry after %v", e.reason, e.retryAfter)
+	}
+	return e.reason
+}
+
+// getErrorCode returns the error code reported to clients for err
+func getErrorCode(err error) commonpb.ErrorCode {
+	var tErr *throttleError
+	if errors.As(err, &tErr) {
+		return tErr.code
+	}
+	return commonpb.ErrorCode_UnexpectedError
+}
+
+// tokenBucket refills at rate tokens per second up to burst tokens. A request taking more tokens than
+// burst passes when the bucket is full and leaves the bucket in debt, so that large requests are delayed
+// rather than rejected forever.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket returns a full bucket allowing limit tokens per period
+func newTokenBucket(limit float64, period time.Duration, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		rate:   limit / period.Seconds(),
+		burst:  limit,
+		tokens: limit,
+		last:   now,
+	}
+}
+
+func (tb *tokenBucket) refill(now time.Time) {
+	if now.After(tb.last) {
+		tb.tokens = math.Min(tb.burst, tb.tokens+now.Sub(tb.last).Seconds()*tb.rate)
+		tb.last = now
+	}
+}
+
+// waitTime returns how long it takes before n tokens can be taken, 0 if they can be taken now
+func (tb *tokenBucket) waitTime(n float64, now time.Time) time.Duration {
+	tb.refill(now)
+	n = math.Min(n, tb.burst)
+	if tb.tokens >= n {
+		return 0
+	}
+	return time.Duration((n - tb.tokens) / tb.rate * float64(time.Second))
+}
+
+func (tb *tokenBucket) take(n float64) {
+	tb.tokens -= n
+}
+
+func newTokenBuckets(config RateLimitConfig, now time.Time) map[rateType]*tokenBucket {
+	buckets := make(map[rateType]*tokenBucket)
+	if config.InsertRows > 0 {
+		buckets[insertRowsRate] = newTokenBucket(config.InsertRows, time.Second, now)
+	}
+	if config.InsertBytes > 0 {
+		buckets[insertBytesRate] = newTokenBucket(config.InsertBytes, time.Second, now)
+	}
+	if config.SearchQPS > 0 {
+		buckets[searchRate] = newTokenBucket(config.SearchQPS, time.Second, now)
+	}
+	if config.DDLOpsPerMinute > 0 {
+		buckets[ddlRate] = newTokenBucket(config.DDLOpsPerMinute, time.Minute, now)
+	}
+	return buckets
+}
+
+// rowCountInfo is the row count of a collection reported by DataCoord, plus the rows inserted through
+// the proxy since then
+type rowCountInfo struct {
+	rowCount   int64
+	updateTime time.Time
+}
+
+// rateLimiter throttles the tasks enqueued to TaskScheduler by the rate limits and the storage quotas
+// in Params, the global limits apply to all the requests of the proxy and the limits of a collection
+// apply to its requests in addition
+type rateLimiter struct {
+	dataCoord types.DataCoord
+
+	mu          sync.Mutex
+	global      map[rateType]*tokenBucket
+	collections map[string]map[rateType]*tokenBucket // db.collection in lower case -> buckets
+
+	rowCountMu sync.Mutex
+	rowCounts  map[UniqueID]*rowCountInfo
+}
+
+func newRateLimiter(dataCoord types.DataCoord) *rateLimiter {
+	now := time.Now()
+	rl := &rateLimiter{
+		dataCoord:   dataCoord,
+		global:      newTokenBuckets(Params.GlobalRateLimit, now),
+		collections: make(map[string]map[rateType]*tokenBucket),
+		rowCounts:   make(map[UniqueID]*rowCountInfo),
+	}
+	for collection, config := range Params.CollectionRateLimits {
+		rl.collections[collection] = newTokenBuckets(config, now)
+	}
+	return rl
+}
+
+func getRateLimitKey(dbName string, collectionName string) string {
+	return strings.ToLower(getDatabaseName(dbName) + "." + collectionName)
+}
+
+// getRateCosts returns the database and collection a task works on and the costs of it on the rate limits
+func getRateCosts(t task) (string, string, map[rateType]float64) {
+	ddlCost := map[rateType]float64{ddlRate: 1}
+	switch t := t.(type) {
+	case *InsertTask:
+		return t.req.GetDbName(), t.req.GetCollectionName(), map[rateType]float64{
+			insertRowsRate:  float64(t.req.GetNumRows()),
+			insertBytesRate: float64(proto.Size(t.req)),
+		}
+	case *UpsertTask:
+		return t.req.GetDbName(), t.req.GetCollectionName(), map[rateType]float64{
+			insertRowsRate:  float64(t.req.GetNumRows()),
+			insertBytesRate: float64(proto.Size(t.req)),
+		}
+	case *SearchTask:
+		return t.query.GetDbName(), t.query.GetCollectionName(), map[rateType]float64{searchRate: 1}
+	case *CreateDatabaseTask:
+		return t.GetDbName(), "", ddlCost
+	case *DropDatabaseTask:
+		return t.GetDbName(), "", ddlCost
+	case *CreateCollectionTask:
+		return t.GetDbName(), t.GetCollectionName(), ddlCost
+	case *DropCollectionTask:
+		return t.GetDbName(), t.GetCollectionName(), ddlCost
+	case *AddFieldTask:
+		return t.GetDbName(), t.GetCollectionName(), ddlCost
+	case *CreatePartitionTask:
+		return t.GetDbName(), t.GetCollectionName(), ddlCost
+	case *DropPartitionTask:
+		return t.GetDbName(), t.GetCollectionName(), ddlCost
+	case *CreateIndexTask:
+		return t.GetDbName(), t.GetCollectionName(), ddlCost
+	case *DropIndexTask:
+		return t.GetDbName(), t.GetCollectionName(), ddlCost
+	case *CreateAliasTask:
+		return t.GetDbName(), t.GetCollectionName(), ddlCost
+	case *DropAliasTask:
+		return t.GetDbName(), "", ddlCost
+	case *AlterAliasTask:
+		return t.GetDbName(), t.GetCollectionName(), ddlCost
+	case *ImportTask:
+		return t.GetDbName(), t.GetCollectionName(), ddlCost
+	}
+	return "", "", nil
+}
+
+// check returns a throttleError if the task exceeds any rate limit or storage quota. The costs of the
+// task are counted only when it passes.
+func (rl *rateLimiter) check(t task) error {
+	dbName, collectionName, costs := getRateCosts(t)
+	if len(costs) == 0 {
+		return nil
+	}
+	rows, ok := costs[insertRowsRate]
+	if !ok {
+		return rl.checkRate(dbName, collectionName, costs, time.Now())
+	}
+
+	// the rows are counted only if the task passes the rate limits as well
+	rl.rowCountMu.Lock()
+	defer rl.rowCountMu.Unlock()
+	info, err := rl.checkQuota(t.TraceCtx(), dbName, collectionName, int64(rows))
+	if err != nil {
+		return err
+	}
+	if err := rl.checkRate(dbName, collectionName, costs, time.Now()); err != nil {
+		return err
+	}
+	if info != nil {
+		info.rowCount += int64(rows)
+	}
+	return nil
+}
+
+func (rl *rateLimiter) checkRate(dbName string, collectionName string, costs map[rateType]float64, now time.Time) error {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	bucketsList := []map[rateType]*tokenBucket{rl.global}
+	if collectionName != "" {
+		if buckets, ok := rl.collections[getRateLimitKey(dbName, collectionName)]; ok {
+			bucketsList = append(bucketsList, buckets)
+		}
+	}
+
+	var err *throttleError
+	for i, buckets := range bucketsList {
+		for rt, cost := range costs {
+			tb, ok := buckets[rt]
+			if !ok {
+				continue
+			}
+			wait := tb.waitTime(cost, now)
+			if wait == 0 || (err != nil && wait <= err.retryAfter) {
+				continue
+			}
+			scope := "proxy"
+			if i > 0 {
+				scope = fmt.Sprintf("collection %s of database %s", collectionName, getDatabaseName(dbName))
+			}
+			err = &throttleError{
+				code:   commonpb.ErrorCode_RateLimit,
+				reason: fmt.Sprintf("rate limit of %s on %s exceeded", rt, scope),
+				// round up to milliseconds, the tokens are available by then
+				retryAfter: time.Duration(math.Ceil(float64(wait)/float64(time.Millisecond))) * time.Millisecond,
+			}
+		}
+	}
+	if err != nil {
+		metrics.ProxyThrottledCounter.WithLabelValues("rate_limit").Inc()
+		return err
+	}
+
+	for _, buckets := range bucketsList {
+		for rt, cost := range costs {
+			if tb, ok := buckets[rt]; ok {
+				tb.take(cost)
+			}
+		}
+	}
+	return nil
+}
+
+func (rl *rateLimiter) getMaxRows(dbName string, collectionName string) int64 {
+	if config, ok := Params.CollectionRateLimits[getRateLimitKey(dbName, collectionName)]; ok && config.MaxRows > 0 {
+		return config.MaxRows
+	}
+	return Params.GlobalRateLimit.MaxRows
+}
+
+// checkQuota checks the row count of the collection after inserting rows against its storage quota. It
+// returns the row count info of the collection, nil if the collection has no quota, the caller adds the
+// rows to it when the insertion is accepted, and they are counted until the row count is refreshed from
+// DataCoord. The caller must hold rowCountMu.
+func (rl *rateLimiter) checkQuota(ctx context.Context, dbName string, collectionName string, rows int64) (*rowCountInfo, error) {
+	maxRows := rl.getMaxRows(dbName, collectionName)
+	if maxRows <= 0 {
+		return nil, nil
+	}
+	collID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	info, ok := rl.rowCounts[collID]
+	if !ok || time.Since(info.updateTime) >= Params.QuotaRefreshInterval {
+		rowCount, err := rl.getRowCount(ctx, collID)
+		if err != nil {
+			return nil, err
+		}
+		info = &rowCountInfo{
+			rowCount:   rowCount,
+			updateTime: time.Now(),
+		}
+		rl.rowCounts[collID] = info
+	}
+	if info.rowCount+rows > maxRows {
+		metrics.ProxyThrottledCounter.WithLabelValues("quota").Inc()
+		return nil, &throttleError{
+			code: commonpb.ErrorCode_QuotaExceeded,
+			reason: fmt.Sprintf("storage quota of collection %s exceeded, %d rows stored, %d rows to insert, at most %d rows",
+				collectionName, info.rowCount, rows, maxRows),
+		}
+	}
+	return info, nil
+}
+
+func (rl *rateLimiter) getRowCount(ctx context.Context, collID UniqueID) (int64, error) {
+	resp, err := rl.dataCoord.GetCollectionStatistics(ctx, &datapb.GetCollectionStatisticsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_GetCollectionStatistics,
+			SourceID: Params.ProxyID,
+		},
+		CollectionID: collID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return 0, errors.New(resp.Status.Reason)
+	}
+	for _, kv := range resp.Stats {
+		if kv.Key == "row_count" {
+			return strconv.ParseInt(kv.Value, 10, 64)
+		}
+	}
+	log.Warn("row count not found in collection statistics", zap.Int64("collectionID", collID))
+	return 0, nil
+}
@@ -0,0 +1,184 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	tb := newTokenBucket(10, time.Second, now)
+	assert.Equal(t, time.Duration(0), tb.waitTime(10, now))
+	tb.take(10)
+	assert.Equal(t, 100*time.Millisecond, tb.waitTime(1, now))
+	assert.Equal(t, time.Duration(0), tb.waitTime(1, now.Add(100*time.Millisecond)))
+
+	// large requests pass when the bucket is full and leave it in debt
+	now = now.Add(time.Hour)
+	assert.Equal(t, time.Duration(0), tb.waitTime(30, now))
+	tb.take(30)
+	assert.Equal(t, 3*time.Second, tb.waitTime(10, now))
+
+	tb = newTokenBucket(6, time.Minute, now)
+	tb.take(6)
+	assert.Equal(t, 10*time.Second, tb.waitTime(1, now))
+}
+
+func TestRateLimiter_checkRate(t *testing.T) {
+	Params.Init()
+	now := time.Now()
+	rl := &rateLimiter{
+		global: newTokenBuckets(RateLimitConfig{SearchQPS: 2}, now),
+		collections: map[string]map[rateType]*tokenBucket{
+			getRateLimitKey("", "Coll"): newTokenBuckets(RateLimitConfig{SearchQPS: 1, InsertRows: 100}, now),
+		},
+	}
+	search := map[rateType]float64{searchRate: 1}
+	assert.Nil(t, rl.checkRate("", "coll", search, now))
+	err := rl.checkRate(Params.DefaultDatabaseName, "coll", search, now)
+	assert.NotNil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, getErrorCode(err))
+	assert.Equal(t, time.Second, err.(*throttleError).retryAfter)
+
+	// the rejected request takes no tokens
+	assert.Nil(t, rl.checkRate("", "other", search, now))
+	err = rl.checkRate("", "other", search, now)
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, getErrorCode(err))
+	assert.Equal(t, 500*time.Millisecond, err.(*throttleError).retryAfter)
+
+	insert := map[rateType]float64{insertRowsRate: 100, insertBytesRate: 1000}
+	assert.Nil(t, rl.checkRate("", "coll", insert, now))
+	assert.NotNil(t, rl.checkRate("", "coll", insert, now.Add(500*time.Millisecond)))
+	assert.Nil(t, rl.checkRate("", "coll", insert, now.Add(time.Second)))
+	assert.Nil(t, rl.checkRate("db1", "coll", insert, now))
+}
+
+func TestRateLimiter_getRateCosts(t *testing.T) {
+	it := &InsertTask{
+		req: &milvuspb.InsertRequest{DbName: "db1", CollectionName: "coll", NumRows: 10},
+	}
+	dbName, collectionName, costs := getRateCosts(it)
+	assert.Equal(t, "db1", dbName)
+	assert.Equal(t, "coll", collectionName)
+	assert.Equal(t, float64(10), costs[insertRowsRate])
+	assert.Greater(t, costs[insertBytesRate], float64(0))
+
+	ut := &UpsertTask{
+		InsertTask: InsertTask{
+			req: &milvuspb.InsertRequest{CollectionName: "coll", NumRows: 5},
+		},
+	}
+	_, collectionName, costs = getRateCosts(ut)
+	assert.Equal(t, "coll", collectionName)
+	assert.Equal(t, float64(5), costs[insertRowsRate])
+
+	cct := &CreateCollectionTask{
+		CreateCollectionRequest: &milvuspb.CreateCollectionRequest{CollectionName: "coll"},
+	}
+	_, collectionName, costs = getRateCosts(cct)
+	assert.Equal(t, "coll", collectionName)
+	assert.Equal(t, map[rateType]float64{ddlRate: 1}, costs)
+
+	_, _, costs = getRateCosts(&HasCollectionTask{})
+	assert.Equal(t, 0, len(costs))
+}
+
+type rowCountDataCoord struct {
+	types.DataCoord
+	rowCount int64
+	calls    int
+}
+
+func (m *rowCountDataCoord) GetCollectionStatistics(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
+	m.calls++
+	return &datapb.GetCollectionStatisticsResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Stats:  []*commonpb.KeyValuePair{{Key: "row_count", Value: strconv.FormatInt(m.rowCount, 10)}},
+	}, nil
+}
+
+func TestRateLimiter_checkQuota(t *testing.T) {
+	Params.Init()
+	oldCache, oldGlobal, oldInterval := globalMetaCache, Params.GlobalRateLimit, Params.QuotaRefreshInterval
+	defer func() {
+		globalMetaCache, Params.GlobalRateLimit, Params.QuotaRefreshInterval = oldCache, oldGlobal, oldInterval
+	}()
+	cache, err := NewMetaCache(&databaseRootCoord{}, nil)
+	assert.Nil(t, err)
+	globalMetaCache = cache
+	Params.GlobalRateLimit = RateLimitConfig{MaxRows: 100}
+	Params.QuotaRefreshInterval = time.Hour
+
+	ctx := context.Background()
+	dataCoord := &rowCountDataCoord{rowCount: 90}
+	rl := newRateLimiter(dataCoord)
+	newInsertTask := func(dbName string, rows uint32) *InsertTask {
+		return &InsertTask{
+			ctx: ctx,
+			req: &milvuspb.InsertRequest{DbName: dbName, CollectionName: "coll", NumRows: rows},
+		}
+	}
+	assert.Nil(t, rl.check(newInsertTask("", 5)))
+	// the rows inserted are counted until the row count is refreshed
+	err = rl.check(newInsertTask("", 6))
+	assert.Equal(t, commonpb.ErrorCode_QuotaExceeded, getErrorCode(err))
+	assert.Nil(t, rl.check(newInsertTask("", 5)))
+	assert.Equal(t, 1, dataCoord.calls)
+	// collections are counted apart
+	assert.Nil(t, rl.check(newInsertTask("db1", 10)))
+	assert.Equal(t, 2, dataCoord.calls)
+
+	// the rows of the insertions throttled by the rate limits aren't counted
+	dataCoord.rowCount = 0
+	rl = newRateLimiter(dataCoord)
+	rl.global = newTokenBuckets(RateLimitConfig{InsertRows: 50}, time.Now())
+	assert.Nil(t, rl.check(newInsertTask("", 50)))
+	err = rl.check(newInsertTask("", 50))
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, getErrorCode(err))
+	assert.Equal(t, 1, len(rl.rowCounts))
+	for _, info := range rl.rowCounts {
+		assert.Equal(t, int64(50), info.rowCount)
+	}
+}
+
+func TestGetErrorCode(t *testing.T) {
+	err := &throttleError{code: commonpb.ErrorCode_RateLimit, reason: "rate limit exceeded", retryAfter: time.Second}
+	assert.Equal(t, "rate limit exceeded, retry after 1s", err.Error())
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, getErrorCode(fmt.Errorf("wrapped: %w", err)))
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, getErrorCode(errors.New("error")))
+}
+
+func TestRateLimiter_params(t *testing.T) {
+	Params.Init()
+	defer Params.initRateLimit()
+	assert.Nil(t, Params.Save("proxy.rateLimit.collections.default.Coll1.insertRows", "100"))
+	assert.Nil(t, Params.Save("proxy.rateLimit.collections.default.Coll1.maxRows", "1000"))
+	defer func() {
+		_ = Params.Remove("proxy.rateLimit.collections.default.Coll1.insertRows")
+		_ = Params.Remove("proxy.rateLimit.collections.default.Coll1.maxRows")
+	}()
+	Params.initRateLimit()
+	assert.Equal(t, RateLimitConfig{InsertRows: 100, MaxRows: 1000}, Params.CollectionRateLimits[getRateLimitKey("", "coll1")])
+}
@@ -13,8 +13,29 @@ package proxy
 
 import (
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
+// hashInt64PrimaryKeys returns the hash values of primary keys, which decide the DML channels of the entities
+func hashInt64PrimaryKeys(pks []int64) []uint32 {
+	hashValues := make([]uint32, 0, len(pks))
+	for _, pk := range pks {
+		hash, _ := typeutil.Hash32Int64(pk)
+		hashValues = append(hashValues, hash)
+	}
+	return hashValues
+}
+
+// hashStringPrimaryKeys returns the hash values of string primary keys, which decide the DML channels of the entities
+func hashStringPrimaryKeys(pks []string) []uint32 {
+	hashValues := make([]uint32, 0, len(pks))
+	for _, pk := range pks {
+		hash, _ := typeutil.Hash32String(pk)
+		hashValues = append(hashValues, uint32(hash))
+	}
+	return hashValues
+}
+
 func insertRepackFunc(tsMsgs []msgstream.TsMsg,
 	hashKeys [][]int32) (map[int32]*msgstream.MsgPack, error) {
 
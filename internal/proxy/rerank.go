@@ -0,0 +1,226 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	RankStrategyKey      = "strategy"
+	RankWeightsKey       = "weights"
+	RankKKey             = "k"
+	RankLimitKey         = "limit"
+	WeightedRankStrategy = "weighted"
+	RRFRankStrategy      = "rrf"
+	defaultRRFK          = 60
+)
+
+// rankParams are the parameters to merge the results of the searches of a hybrid search
+type rankParams struct {
+	strategy string
+	weights  []float64 // the weights of the searches of the weighted strategy
+	k        float64   // the smoothing constant of the rrf strategy
+	limit    int       // the max number of hits of each query, 0 means the max top k of the searches
+}
+
+// parseRankParams parses the rank params of a hybrid search with numSearches searches, the strategy is rrf
+// by default, and the searches are weighted equally if the weights are not given
+func parseRankParams(kvs []*commonpb.KeyValuePair, numSearches int) (*rankParams, error) {
+	params := &rankParams{
+		strategy: RRFRankStrategy,
+		k:        defaultRRFK,
+	}
+	if strategy, err := GetAttrByKeyFromRepeatedKV(RankStrategyKey, kvs); err == nil {
+		params.strategy = strategy
+	}
+
+	switch params.strategy {
+	case WeightedRankStrategy:
+		weightsStr, err := GetAttrByKeyFromRepeatedKV(RankWeightsKey, kvs)
+		if err != nil {
+			params.weights = make([]float64, numSearches)
+			for i := range params.weights {
+				params.weights[i] = 1.0 / float64(numSearches)
+			}
+			break
+		}
+		if err := json.Unmarshal([]byte(weightsStr), &params.weights); err != nil {
+			return nil, fmt.Errorf("%s %s is invalid, %s", RankWeightsKey, weightsStr, err.Error())
+		}
+		if len(params.weights) != numSearches {
+			return nil, fmt.Errorf("%d %s don't match %d searches", len(params.weights), RankWeightsKey, numSearches)
+		}
+		for _, weight := range params.weights {
+			if weight < 0 {
+				return nil, fmt.Errorf("%s %s is invalid, weights can't be negative", RankWeightsKey, weightsStr)
+			}
+		}
+	case RRFRankStrategy:
+		if kStr, err := GetAttrByKeyFromRepeatedKV(RankKKey, kvs); err == nil {
+			k, err := strconv.ParseFloat(kStr, 64)
+			if err != nil || k <= 0 {
+				return nil, fmt.Errorf("%s %s is invalid", RankKKey, kStr)
+			}
+			params.k = k
+		}
+	default:
+		return nil, fmt.Errorf("rank %s %s is not supported", RankStrategyKey, params.strategy)
+	}
+
+	if limitStr, err := GetAttrByKeyFromRepeatedKV(RankLimitKey, kvs); err == nil {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("%s %s is invalid", RankLimitKey, limitStr)
+		}
+		params.limit = limit
+	}
+	return params, nil
+}
+
+// normalizeScore maps the score of a hit to (0, 1), the larger the more similar. The scores of IP are
+// similarities, and the ones of the other metrics are distances.
+func normalizeScore(score float32, metricType string) float64 {
+	if metricType == "IP" {
+		return 0.5 + math.Atan(float64(score))/math.Pi
+	}
+	return 1.0 - 2*math.Atan(float64(score))/math.Pi
+}
+
+// rerankSearchResults merges the results of the searches of a hybrid search by pk for each query, the
+// merged scores are the sums of the weighted normalized scores, or the reciprocal rank fusion scores of
+// the hits. The hits are sorted by the merged scores in descending order, and the fields of a hit are
+// the ones of the first search it's found in. The searches must have the same number of queries.
+func rerankSearchResults(results []*schemapb.SearchResultData, metricTypes []string, params *rankParams) (*schemapb.SearchResultData, error) {
+	if len(results) != len(metricTypes) {
+		return nil, errors.New("the numbers of search results and metric types don't match")
+	}
+	nq := 0
+	limit := params.limit
+	numFields := 0
+	var strID bool
+	for _, result := range results {
+		if result == nil || len(result.Scores) == 0 {
+			continue
+		}
+		if nq != 0 && int(result.NumQueries) != nq {
+			return nil, errors.New("the searches of hybrid search have different numbers of queries")
+		}
+		nq = int(result.NumQueries)
+		if params.limit == 0 {
+			limit = getMax(limit, int(result.TopK))
+		}
+		numFields = getMax(numFields, len(result.FieldsData))
+		strID = result.GetIds().GetStrId() != nil
+	}
+
+	ret := &schemapb.SearchResultData{
+		NumQueries: int64(nq),
+		FieldsData: make([]*schemapb.FieldData, numFields),
+		Scores:     make([]float32, 0),
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: make([]int64, 0),
+				},
+			},
+		},
+		Topks: make([]int64, 0),
+	}
+	if strID {
+		ret.Ids.IdField = &schemapb.IDs_StrId{
+			StrId: &schemapb.StringArray{
+				Data: make([]string, 0),
+			},
+		}
+	}
+
+	starts := make([][]int, len(results))
+	sizes := make([][]int, len(results))
+	for i, result := range results {
+		if result == nil || len(result.Scores) == 0 {
+			starts[i], sizes[i] = make([]int, nq), make([]int, nq)
+			continue
+		}
+		starts[i], sizes[i] = getSearchResultHitRanges(result, nq, int(result.TopK))
+	}
+
+	type candidate struct {
+		pk     interface{}
+		score  float64
+		search int // the index of the first search the hit is found in
+		offset int // the offset of the hit in the result of the search
+	}
+	maxHits := 0
+	for idx := 0; idx < nq; idx++ {
+		candidates := make([]*candidate, 0)
+		pks := make(map[interface{}]*candidate)
+		for i, result := range results {
+			for rank := 0; rank < sizes[i][idx]; rank++ {
+				offset := starts[i][idx] + rank
+				var pk interface{}
+				if strID {
+					pk = result.GetIds().GetStrId().GetData()[offset]
+				} else {
+					pk = result.GetIds().GetIntId().GetData()[offset]
+				}
+				var score float64
+				if params.strategy == WeightedRankStrategy {
+					score = params.weights[i] * normalizeScore(result.Scores[offset], metricTypes[i])
+				} else {
+					score = 1.0 / (params.k + float64(rank+1))
+				}
+				if c, ok := pks[pk]; ok {
+					c.score += score
+					continue
+				}
+				c := &candidate{pk: pk, score: score, search: i, offset: offset}
+				pks[pk] = c
+				candidates = append(candidates, c)
+			}
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].score > candidates[j].score
+		})
+		if len(candidates) > limit {
+			candidates = candidates[:limit]
+		}
+
+		for _, c := range candidates {
+			switch ids := ret.Ids.IdField.(type) {
+			case *schemapb.IDs_IntId:
+				ids.IntId.Data = append(ids.IntId.Data, c.pk.(int64))
+			case *schemapb.IDs_StrId:
+				ids.StrId.Data = append(ids.StrId.Data, c.pk.(string))
+			}
+			typeutil.AppendFieldData(ret.FieldsData, results[c.search].FieldsData, int64(c.offset))
+			ret.Scores = append(ret.Scores, float32(c.score))
+		}
+		maxHits = getMax(maxHits, len(candidates))
+		ret.Topks = append(ret.Topks, int64(len(candidates)))
+	}
+
+	ret.TopK = int64(maxHits)
+	if len(ret.Scores) == 0 {
+		ret.FieldsData = make([]*schemapb.FieldData, 0)
+	}
+	return ret, nil
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestParseRankParams(t *testing.T) {
+	kvs := func(pairs ...string) []*commonpb.KeyValuePair {
+		res := make([]*commonpb.KeyValuePair, 0)
+		for i := 0; i+1 < len(pairs); i += 2 {
+			res = append(res, &commonpb.KeyValuePair{Key: pairs[i], Value: pairs[i+1]})
+		}
+		return res
+	}
+
+	params, err := parseRankParams(nil, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, RRFRankStrategy, params.strategy)
+	assert.Equal(t, float64(defaultRRFK), params.k)
+	assert.Equal(t, 0, params.limit)
+
+	params, err = parseRankParams(kvs(RankStrategyKey, RRFRankStrategy, RankKKey, "10", RankLimitKey, "5"), 2)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(10), params.k)
+	assert.Equal(t, 5, params.limit)
+
+	params, err = parseRankParams(kvs(RankStrategyKey, WeightedRankStrategy), 4)
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{0.25, 0.25, 0.25, 0.25}, params.weights)
+
+	params, err = parseRankParams(kvs(RankStrategyKey, WeightedRankStrategy, RankWeightsKey, "[0.7, 0.3]"), 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{0.7, 0.3}, params.weights)
+
+	invalids := [][]*commonpb.KeyValuePair{
+		kvs(RankStrategyKey, "max"),
+		kvs(RankStrategyKey, WeightedRankStrategy, RankWeightsKey, "[0.7]"),
+		kvs(RankStrategyKey, WeightedRankStrategy, RankWeightsKey, "0.7, 0.3"),
+		kvs(RankStrategyKey, WeightedRankStrategy, RankWeightsKey, "[-1, 2]"),
+		kvs(RankKKey, "0"),
+		kvs(RankLimitKey, "-1"),
+	}
+	for _, invalid := range invalids {
+		_, err = parseRankParams(invalid, 2)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestRerankSearchResults(t *testing.T) {
+	genResult := func(ids []int64, scores []float32, topks []int64) *schemapb.SearchResultData {
+		topk := int64(0)
+		for _, k := range topks {
+			if k > topk {
+				topk = k
+			}
+		}
+		values := make([]int64, 0, len(ids))
+		for _, id := range ids {
+			values = append(values, id*10)
+		}
+		return &schemapb.SearchResultData{
+			NumQueries: int64(len(topks)),
+			TopK:       topk,
+			Scores:     scores,
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}},
+			},
+			Topks: topks,
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "value",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}},
+						},
+					},
+				},
+			},
+		}
+	}
+	// two queries, the first one hits 1, 2, 3 on the L2 field and 3, 4 on the IP field
+	l2Result := genResult([]int64{1, 2, 3, 5}, []float32{0.1, 0.2, 0.3, 0.5}, []int64{3, 1})
+	ipResult := genResult([]int64{3, 4, 6}, []float32{0.9, 0.8, 0.7}, []int64{2, 1})
+	metricTypes := []string{"L2", "IP"}
+
+	t.Run("rrf", func(t *testing.T) {
+		ret, err := rerankSearchResults([]*schemapb.SearchResultData{l2Result, ipResult}, metricTypes, &rankParams{strategy: RRFRankStrategy, k: 60})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), ret.NumQueries)
+		assert.Equal(t, int64(3), ret.TopK)
+		assert.Equal(t, []int64{3, 2}, ret.Topks)
+		// 3 is in both searches, 2 and 4 are both the second but 2 is found first, limited by the max top k
+		assert.Equal(t, []int64{3, 1, 2, 5, 6}, ret.Ids.GetIntId().Data)
+		assert.InDelta(t, 1.0/63+1.0/61, ret.Scores[0], 1e-6)
+		assert.InDelta(t, 1.0/61, ret.Scores[1], 1e-6)
+		assert.Equal(t, []int64{30, 10, 20, 50, 60}, ret.FieldsData[0].GetScalars().GetLongData().Data)
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		params := &rankParams{strategy: WeightedRankStrategy, weights: []float64{1, 0}, limit: 2}
+		ret, err := rerankSearchResults([]*schemapb.SearchResultData{l2Result, ipResult}, metricTypes, params)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{2, 2}, ret.Topks)
+		// the smaller the distance the larger the score
+		assert.Equal(t, []int64{1, 2, 5, 6}, ret.Ids.GetIntId().Data)
+		assert.InDelta(t, normalizeScore(0.1, "L2"), ret.Scores[0], 1e-6)
+
+		params.weights = []float64{0.5, 0.5}
+		ret, err = rerankSearchResults([]*schemapb.SearchResultData{l2Result, ipResult}, metricTypes, params)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{3, 1, 5, 6}, ret.Ids.GetIntId().Data)
+	})
+
+	t.Run("empty and mismatched results", func(t *testing.T) {
+		empty := &schemapb.SearchResultData{}
+		ret, err := rerankSearchResults([]*schemapb.SearchResultData{empty, ipResult}, metricTypes, &rankParams{strategy: RRFRankStrategy, k: 60})
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{3, 4, 6}, ret.Ids.GetIntId().Data)
+
+		ret, err = rerankSearchResults([]*schemapb.SearchResultData{nil, empty}, metricTypes, &rankParams{strategy: RRFRankStrategy, k: 60})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(ret.Scores))
+		assert.Equal(t, 0, len(ret.FieldsData))
+
+		oneQuery := genResult([]int64{1}, []float32{0.1}, []int64{1})
+		_, err = rerankSearchResults([]*schemapb.SearchResultData{oneQuery, ipResult}, metricTypes, &rankParams{strategy: RRFRankStrategy, k: 60})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestNormalizeScore(t *testing.T) {
+	assert.InDelta(t, 1.0, normalizeScore(0, "L2"), 1e-6)
+	assert.True(t, normalizeScore(1, "L2") > normalizeScore(2, "L2"))
+	assert.InDelta(t, 0.5, normalizeScore(0, "IP"), 1e-6)
+	assert.True(t, normalizeScore(2, "IP") > normalizeScore(1, "IP"))
+}
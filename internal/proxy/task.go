@@ -23,6 +23,7 @@ import (
 	"runtime"
 	"sort"
 	"strconv"
+	"sync/atomic"
 	"time"
 	"unsafe"
 
@@ -51,12 +52,24 @@ import (
 
 const (
 	InsertTaskName                  = "InsertTask"
+	DeleteTaskName                  = "DeleteTask"
+	UpsertTaskName                  = "UpsertTask"
+	CreateDatabaseTaskName          = "CreateDatabaseTask"
+	DropDatabaseTaskName            = "DropDatabaseTask"
+	ListDatabasesTaskName           = "ListDatabasesTask"
+	CreateUserTaskName              = "CreateUserTask"
+	GrantRoleTaskName               = "GrantRoleTask"
+	RevokeRoleTaskName              = "RevokeRoleTask"
+	ListGrantsTaskName              = "ListGrantsTask"
 	CreateCollectionTaskName        = "CreateCollectionTask"
 	DropCollectionTaskName          = "DropCollectionTask"
 	SearchTaskName                  = "SearchTask"
 	RetrieveTaskName                = "RetrieveTask"
 	AnnsFieldKey                    = "anns_field"
 	TopKKey                         = "topk"
+	OffsetKey                       = "offset"
+	RadiusKey                       = "radius"
+	RangeFilterKey                  = "range_filter"
 	MetricTypeKey                   = "metric_type"
 	SearchParamsKey                 = "params"
 	HasCollectionTaskName           = "HasCollectionTask"
@@ -64,6 +77,10 @@ const (
 	GetCollectionStatisticsTaskName = "GetCollectionStatisticsTask"
 	GetPartitionStatisticsTaskName  = "GetPartitionStatisticsTask"
 	ShowCollectionTaskName          = "ShowCollectionTask"
+	AddFieldTaskName                = "AddFieldTask"
+	CreateAliasTaskName             = "CreateAliasTask"
+	DropAliasTaskName               = "DropAliasTask"
+	AlterAliasTaskName              = "AlterAliasTask"
 	CreatePartitionTaskName         = "CreatePartitionTask"
 	DropPartitionTaskName           = "DropPartitionTask"
 	HasPartitionTaskName            = "HasPartitionTask"
@@ -74,6 +91,7 @@ const (
 	GetIndexStateTaskName           = "GetIndexStateTask"
 	GetIndexBuildProgressTaskName   = "GetIndexBuildProgressTask"
 	FlushTaskName                   = "FlushTask"
+	ImportTaskName                  = "ImportTask"
 	LoadCollectionTaskName          = "LoadCollectionTask"
 	ReleaseCollectionTaskName       = "ReleaseCollectionTask"
 	LoadPartitionTaskName           = "LoadPartitionTask"
@@ -176,7 +194,7 @@ func (it *InsertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (it *InsertTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.DbName, it.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -312,7 +330,12 @@ func (it *InsertTask) transferColumnBasedRequestToRowBasedData() error {
 			case *schemapb.ScalarField_BytesData:
 				return errors.New("bytes field is not supported now")
 			case *schemapb.ScalarField_StringData:
-				return errors.New("string field is not supported now")
+				err := appendScalarField(func() interface{} {
+					return scalarField.GetStringData().Data
+				})
+				if err != nil {
+					return err
+				}
 			case nil:
 				continue
 			default:
@@ -358,6 +381,9 @@ func (it *InsertTask) transferColumnBasedRequestToRowBasedData() error {
 		blob := &commonpb.Blob{
 			Value: make([]byte, 0),
 		}
+		// strings are variable-length, they are appended after all the fixed-length fields
+		// so that the fixed-length fields are at the same offsets of all rows
+		var varLenBuffer bytes.Buffer
 
 		for j := 0; j < l; j++ {
 			var buffer bytes.Buffer
@@ -411,6 +437,14 @@ func (it *InsertTask) transferColumnBasedRequestToRowBasedData() error {
 					log.Warn("ConvertData", zap.Error(err))
 				}
 				blob.Value = append(blob.Value, buffer.Bytes()...)
+			case schemapb.DataType_String:
+				// prefixed with the uint32 length in bytes
+				d := datas[j][i].(string)
+				err := binary.Write(&varLenBuffer, endian, uint32(len(d)))
+				if err != nil {
+					log.Warn("ConvertData", zap.Error(err))
+				}
+				varLenBuffer.WriteString(d)
 			case schemapb.DataType_FloatVector:
 				d := datas[j][i].([]float32)
 				err := binary.Write(&buffer, endian, d)
@@ -429,6 +463,7 @@ func (it *InsertTask) transferColumnBasedRequestToRowBasedData() error {
 				log.Warn("unsupported data type")
 			}
 		}
+		blob.Value = append(blob.Value, varLenBuffer.Bytes()...)
 		if !printed {
 			log.Debug("Proxy, transform", zap.Any("ID", it.ID()), zap.Any("BlobLen", len(blob.Value)), zap.Any("dTypes", dTypes))
 			printed = true
@@ -439,6 +474,26 @@ func (it *InsertTask) transferColumnBasedRequestToRowBasedData() error {
 	return nil
 }
 
+// fillDefaultFields fills the fields missing in the request with their default values, only the fields
+// added to existing collections have default values
+func (it *InsertTask) fillDefaultFields() error {
+	names := make(map[string]struct{}, len(it.req.FieldsData))
+	for _, fieldData := range it.req.FieldsData {
+		names[fieldData.FieldName] = struct{}{}
+	}
+	for _, field := range it.schema.Fields {
+		if _, ok := names[field.Name]; ok || field.DefaultValue == nil {
+			continue
+		}
+		fieldData, err := typeutil.GenDefaultFieldData(field, int(it.req.NumRows))
+		if err != nil {
+			return err
+		}
+		it.req.FieldsData = append(it.req.FieldsData, fieldData)
+	}
+	return nil
+}
+
 func (it *InsertTask) checkFieldAutoID() error {
 	// TODO(dragondriver): in fact, NumRows is not trustable, we should check all input fields
 	rowNums := it.req.NumRows
@@ -473,6 +528,7 @@ func (it *InsertTask) checkFieldAutoID() error {
 
 	var primaryField *schemapb.FieldData
 	var primaryData []int64
+	var strPrimaryData []string
 	for _, field := range it.req.FieldsData {
 		if field.FieldName == autoIDFieldName {
 			return fmt.Errorf("autoID field (%v) does not require data", autoIDFieldName)
@@ -483,8 +539,8 @@ func (it *InsertTask) checkFieldAutoID() error {
 	}
 
 	if primaryField != nil {
-		if primaryField.Type != schemapb.DataType_Int64 {
-			return fmt.Errorf("currently only support DataType Int64 as PrimaryField and Enable autoID")
+		if primaryField.Type != schemapb.DataType_Int64 && primaryField.Type != schemapb.DataType_String {
+			return fmt.Errorf("currently only support DataType Int64 or String as PrimaryField")
 		}
 		switch primaryField.Field.(type) {
 		case *schemapb.FieldData_Scalars:
@@ -492,16 +548,26 @@ func (it *InsertTask) checkFieldAutoID() error {
 			switch scalarField.Data.(type) {
 			case *schemapb.ScalarField_LongData:
 				primaryData = scalarField.GetLongData().Data
+				it.result.IDs.IdField = &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: primaryData,
+					},
+				}
+			case *schemapb.ScalarField_StringData:
+				strPrimaryData = scalarField.GetStringData().Data
+				if err := ValidateStringPrimaryKeys(strPrimaryData); err != nil {
+					return err
+				}
+				it.result.IDs.IdField = &schemapb.IDs_StrId{
+					StrId: &schemapb.StringArray{
+						Data: strPrimaryData,
+					},
+				}
 			default:
-				return fmt.Errorf("currently only support DataType Int64 as PrimaryField and Enable autoID")
+				return fmt.Errorf("currently only support DataType Int64 or String as PrimaryField")
 			}
 		default:
-			return fmt.Errorf("currently only support DataType Int64 as PrimaryField and Enable autoID")
-		}
-		it.result.IDs.IdField = &schemapb.IDs_IntId{
-			IntId: &schemapb.LongArray{
-				Data: primaryData,
-			},
+			return fmt.Errorf("currently only support DataType Int64 or String as PrimaryField")
 		}
 	}
 
@@ -550,11 +616,7 @@ func (it *InsertTask) checkFieldAutoID() error {
 			return fmt.Errorf("invalid length of input hash values")
 		}
 		if it.HashValues == nil || len(it.HashValues) <= 0 {
-			it.HashValues = make([]uint32, 0, len(it.BaseInsertTask.RowIDs))
-			for _, rowID := range it.BaseInsertTask.RowIDs {
-				hash, _ := typeutil.Hash32Int64(rowID)
-				it.HashValues = append(it.HashValues, hash)
-			}
+			it.HashValues = hashInt64PrimaryKeys(it.BaseInsertTask.RowIDs)
 		}
 	} else {
 		// use primary keys as hash if hash is not provided
@@ -563,10 +625,10 @@ func (it *InsertTask) checkFieldAutoID() error {
 			return fmt.Errorf("invalid length of input hash values")
 		}
 		if it.HashValues == nil || len(it.HashValues) <= 0 {
-			it.HashValues = make([]uint32, 0, len(primaryData))
-			for _, pk := range primaryData {
-				hash, _ := typeutil.Hash32Int64(pk)
-				it.HashValues = append(it.HashValues, hash)
+			if strPrimaryData != nil {
+				it.HashValues = hashStringPrimaryKeys(strPrimaryData)
+			} else {
+				it.HashValues = hashInt64PrimaryKeys(primaryData)
 			}
 		}
 	}
@@ -584,6 +646,10 @@ func (it *InsertTask) PreExecute(ctx context.Context) error {
 	it.Base.MsgType = commonpb.MsgType_Insert
 	it.Base.SourceID = Params.ProxyID
 
+	if err := checkPrivilege(ctx, it.req.DbName, it.req.CollectionName, commonpb.Privilege_PrivilegeInsert); err != nil {
+		return err
+	}
+
 	it.result = &milvuspb.MutationResult{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
@@ -604,7 +670,7 @@ func (it *InsertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, it.DbName, collectionName)
 	log.Debug("Proxy Insert PreExecute", zap.Any("collSchema", collSchema))
 	if err != nil {
 		return err
@@ -616,6 +682,11 @@ func (it *InsertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	err = it.fillDefaultFields()
+	if err != nil {
+		return err
+	}
+
 	err = it.transferColumnBasedRequestToRowBasedData()
 	if err != nil {
 		return err
@@ -775,6 +846,7 @@ func (it *InsertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 	for i, request := range tsMsgs {
 		insertRequest := request.(*msgstream.InsertMsg)
 		keys := hashKeys[i]
+		dbName := insertRequest.DbName
 		collectionName := insertRequest.CollectionName
 		collectionID := insertRequest.CollectionID
 		partitionID := insertRequest.PartitionID
@@ -794,6 +866,7 @@ func (it *InsertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 						Timestamp: ts,
 						SourceID:  proxyID,
 					},
+					DbName:         dbName,
 					CollectionID:   collectionID,
 					PartitionID:    partitionID,
 					CollectionName: collectionName,
@@ -838,23 +911,25 @@ func (it *InsertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 	return newPack, nil
 }
 
-func (it *InsertTask) Execute(ctx context.Context) error {
+// prepareMsgPack resolves the collection and the partition of the task, and returns the DML stream of
+// the collection and the insert messages with segments assigned
+func (it *InsertTask) prepareMsgPack(ctx context.Context) (msgstream.MsgStream, *msgstream.MsgPack, error) {
 	collectionName := it.BaseInsertTask.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, it.DbName, collectionName)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	it.CollectionID = collID
 	var partitionID UniqueID
 	if len(it.PartitionName) > 0 {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, it.PartitionName)
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.DbName, collectionName, it.PartitionName)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 	} else {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, Params.DefaultPartitionName)
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.DbName, collectionName, Params.DefaultPartitionName)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 	}
 	it.PartitionID = partitionID
@@ -875,19 +950,19 @@ func (it *InsertTask) Execute(ctx context.Context) error {
 		if err != nil {
 			it.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
 			it.result.Status.Reason = err.Error()
-			return err
+			return nil, nil, err
 		}
 		stream, err = it.chMgr.getDMLStream(collID)
 		if err != nil {
 			it.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
 			it.result.Status.Reason = err.Error()
-			return err
+			return nil, nil, err
 		}
 	}
 
 	pchans, err := it.chMgr.getChannels(collID)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	for _, pchan := range pchans {
 		log.Debug("Proxy InsertTask add pchan", zap.Any("pchan", pchan))
@@ -897,6 +972,14 @@ func (it *InsertTask) Execute(ctx context.Context) error {
 	// Assign SegmentID
 	var pack *msgstream.MsgPack
 	pack, err = it._assignSegmentID(stream, &msgPack)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stream, pack, nil
+}
+
+func (it *InsertTask) Execute(ctx context.Context) error {
+	stream, pack, err := it.prepareMsgPack(ctx)
 	if err != nil {
 		return err
 	}
@@ -915,1795 +998,3270 @@ func (it *InsertTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
-type CreateCollectionTask struct {
-	Condition
-	*milvuspb.CreateCollectionRequest
-	ctx             context.Context
-	rootCoord       types.RootCoord
-	dataCoordClient types.DataCoord
-	result          *commonpb.Status
-	schema          *schemapb.CollectionSchema
-}
-
-func (cct *CreateCollectionTask) TraceCtx() context.Context {
-	return cct.ctx
-}
-
-func (cct *CreateCollectionTask) ID() UniqueID {
-	return cct.Base.MsgID
-}
-
-func (cct *CreateCollectionTask) SetID(uid UniqueID) {
-	cct.Base.MsgID = uid
-}
-
-func (cct *CreateCollectionTask) Name() string {
-	return CreateCollectionTaskName
-}
-
-func (cct *CreateCollectionTask) Type() commonpb.MsgType {
-	return cct.Base.MsgType
-}
-
-func (cct *CreateCollectionTask) BeginTs() Timestamp {
-	return cct.Base.Timestamp
-}
-
-func (cct *CreateCollectionTask) EndTs() Timestamp {
-	return cct.Base.Timestamp
+// UpsertTask inserts entities and deletes the existing entities with the same primary keys at the same
+// timestamp. The deletes are sent to the DML channels of the inserted entities, and a delete only hides
+// the entities inserted before its timestamp, so query nodes and data nodes see the old entities
+// replaced by the new ones at once.
+type UpsertTask struct {
+	InsertTask
+	upsertReq  *milvuspb.UpsertRequest
+	deleteMsgs []msgstream.TsMsg
 }
 
-func (cct *CreateCollectionTask) SetTs(ts Timestamp) {
-	cct.Base.Timestamp = ts
+func (ut *UpsertTask) Name() string {
+	return UpsertTaskName
 }
 
-func (cct *CreateCollectionTask) OnEnqueue() error {
-	cct.Base = &commonpb.MsgBase{}
-	return nil
+func (ut *UpsertTask) Type() commonpb.MsgType {
+	return commonpb.MsgType_Upsert
 }
 
-func (cct *CreateCollectionTask) PreExecute(ctx context.Context) error {
-	cct.Base.MsgType = commonpb.MsgType_CreateCollection
-	cct.Base.SourceID = Params.ProxyID
-
-	cct.schema = &schemapb.CollectionSchema{}
-	err := proto.Unmarshal(cct.Schema, cct.schema)
-	cct.schema.AutoID = false
-	cct.CreateCollectionRequest.Schema, _ = proto.Marshal(cct.schema)
-	if err != nil {
+func (ut *UpsertTask) PreExecute(ctx context.Context) error {
+	if err := ut.InsertTask.PreExecute(ctx); err != nil {
 		return err
 	}
+	for _, field := range ut.schema.Fields {
+		if field.IsPrimaryKey && field.AutoID {
+			return fmt.Errorf("upsert is not supported on collection %s with auto ID primary key", ut.CollectionName)
+		}
+	}
 
-	if int64(len(cct.schema.Fields)) > Params.MaxFieldNum {
-		return fmt.Errorf("maximum field's number should be limited to %d", Params.MaxFieldNum)
+	var numOfPks int
+	switch ids := ut.result.IDs.IdField.(type) {
+	case *schemapb.IDs_IntId:
+		seen := make(map[int64]struct{}, len(ids.IntId.Data))
+		for _, pk := range ids.IntId.Data {
+			if _, ok := seen[pk]; ok {
+				return fmt.Errorf("duplicate primary key %d in upsert request", pk)
+			}
+			seen[pk] = struct{}{}
+		}
+		numOfPks = len(ids.IntId.Data)
+	case *schemapb.IDs_StrId:
+		seen := make(map[string]struct{}, len(ids.StrId.Data))
+		for _, pk := range ids.StrId.Data {
+			if _, ok := seen[pk]; ok {
+				return fmt.Errorf("duplicate primary key %s in upsert request", pk)
+			}
+			seen[pk] = struct{}{}
+		}
+		numOfPks = len(ids.StrId.Data)
+	}
+	if numOfPks != len(ut.RowData) {
+		return fmt.Errorf("the number of primary keys %d doesn't match the number of rows %d", numOfPks, len(ut.RowData))
 	}
+	return nil
+}
 
-	// validate collection name
-	if err := ValidateCollectionName(cct.schema.Name); err != nil {
-		return err
+// getDeleteMsgs returns the deletes of the primary keys to upsert, grouped by the DML channels the
+// rows are inserted into
+func (ut *UpsertTask) getDeleteMsgs(ctx context.Context, channelNames []vChan) []msgstream.TsMsg {
+	// partitionID 0 means that the existing entities are searched in all partitions of the collection
+	partitionID := UniqueID(0)
+	if len(ut.upsertReq.PartitionName) > 0 {
+		partitionID = ut.PartitionID
+	}
+	channelNum := uint32(len(channelNames))
+	msgs := make(map[uint32]*msgstream.DeleteMsg)
+	keys := make([]uint32, 0)
+	for i, hash := range ut.HashValues {
+		key := hash % channelNum
+		msg, ok := msgs[key]
+		if !ok {
+			msg = &msgstream.DeleteMsg{
+				BaseMsg: msgstream.BaseMsg{
+					Ctx:            ctx,
+					BeginTimestamp: ut.BeginTs(),
+					EndTimestamp:   ut.EndTs(),
+				},
+				DeleteRequest: internalpb.DeleteRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:   commonpb.MsgType_Delete,
+						MsgID:     ut.Base.MsgID,
+						Timestamp: ut.BeginTs(),
+						SourceID:  Params.ProxyID,
+					},
+					DbName:         ut.DbName,
+					CollectionName: ut.CollectionName,
+					PartitionName:  ut.upsertReq.PartitionName,
+					CollectionID:   ut.CollectionID,
+					PartitionID:    partitionID,
+					ChannelID:      channelNames[key],
+				},
+			}
+			msgs[key] = msg
+			keys = append(keys, key)
+		}
+		msg.HashValues = append(msg.HashValues, hash)
+		msg.Timestamps = append(msg.Timestamps, ut.BeginTs())
+		switch ids := ut.result.IDs.IdField.(type) {
+		case *schemapb.IDs_IntId:
+			msg.PrimaryKeys = append(msg.PrimaryKeys, ids.IntId.Data[i])
+		case *schemapb.IDs_StrId:
+			msg.StringPrimaryKeys = append(msg.StringPrimaryKeys, ids.StrId.Data[i])
+		}
 	}
 
-	if err := ValidateDuplicatedFieldName(cct.schema.Fields); err != nil {
-		return err
+	ret := make([]msgstream.TsMsg, 0, len(keys))
+	for _, key := range keys {
+		ret = append(ret, msgs[key])
 	}
+	return ret
+}
 
-	if err := ValidatePrimaryKey(cct.schema); err != nil {
+func (ut *UpsertTask) Execute(ctx context.Context) error {
+	stream, pack, err := ut.prepareMsgPack(ctx)
+	if err != nil {
 		return err
 	}
-
-	if err := ValidateFieldAutoID(cct.schema); err != nil {
+	channelNames, err := ut.chMgr.getVChannels(ut.CollectionID)
+	if err != nil {
 		return err
 	}
 
-	// validate field name
-	for _, field := range cct.schema.Fields {
-		if err := ValidateFieldName(field.Name); err != nil {
-			return err
-		}
-		if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector {
-			exist := false
-			var dim int64 = 0
-			for _, param := range field.TypeParams {
-				if param.Key == "dim" {
-					exist = true
-					tmp, err := strconv.ParseInt(param.Value, 10, 64)
-					if err != nil {
-						return err
-					}
-					dim = tmp
-					break
-				}
-			}
-			if !exist {
-				return errors.New("dimension is not defined in field type params")
-			}
-			if field.DataType == schemapb.DataType_FloatVector {
-				if err := ValidateDimension(dim, false); err != nil {
-					return err
-				}
-			} else {
-				if err := ValidateDimension(dim, true); err != nil {
-					return err
-				}
-			}
-		}
+	// the deletes and the inserts are produced in one pack, the deletes go first
+	ut.deleteMsgs = ut.getDeleteMsgs(ctx, channelNames)
+	pack.Msgs = append(append([]msgstream.TsMsg{}, ut.deleteMsgs...), pack.Msgs...)
+	err = stream.Produce(pack)
+	if err != nil {
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
 	}
-
 	return nil
 }
 
-func (cct *CreateCollectionTask) Execute(ctx context.Context) error {
-	var err error
-	cct.result, err = cct.rootCoord.CreateCollection(ctx, cct.CreateCollectionRequest)
-	return err
-}
-
-func (cct *CreateCollectionTask) PostExecute(ctx context.Context) error {
-	return nil
-}
+type BaseDeleteTask = msgstream.DeleteMsg
 
-type DropCollectionTask struct {
+type DeleteTask struct {
 	Condition
-	*milvuspb.DropCollectionRequest
-	ctx       context.Context
-	rootCoord types.RootCoord
-	result    *commonpb.Status
-	chMgr     channelsMgr
-	chTicker  channelsTimeTicker
+	BaseDeleteTask
+	ctx        context.Context
+	req        *milvuspb.DeleteRequest
+	result     *milvuspb.MutationResult
+	chMgr      channelsMgr
+	chTicker   channelsTimeTicker
+	hashValues []uint32
 }
 
-func (dct *DropCollectionTask) TraceCtx() context.Context {
-	return dct.ctx
+func (dt *DeleteTask) TraceCtx() context.Context {
+	return dt.ctx
 }
 
-func (dct *DropCollectionTask) ID() UniqueID {
-	return dct.Base.MsgID
+func (dt *DeleteTask) ID() UniqueID {
+	return dt.Base.MsgID
 }
 
-func (dct *DropCollectionTask) SetID(uid UniqueID) {
-	dct.Base.MsgID = uid
+func (dt *DeleteTask) SetID(uid UniqueID) {
+	dt.Base.MsgID = uid
 }
 
-func (dct *DropCollectionTask) Name() string {
-	return DropCollectionTaskName
+func (dt *DeleteTask) Type() commonpb.MsgType {
+	return dt.Base.MsgType
 }
 
-func (dct *DropCollectionTask) Type() commonpb.MsgType {
-	return dct.Base.MsgType
+func (dt *DeleteTask) Name() string {
+	return DeleteTaskName
 }
 
-func (dct *DropCollectionTask) BeginTs() Timestamp {
-	return dct.Base.Timestamp
+func (dt *DeleteTask) BeginTs() Timestamp {
+	return dt.Base.Timestamp
 }
 
-func (dct *DropCollectionTask) EndTs() Timestamp {
-	return dct.Base.Timestamp
+func (dt *DeleteTask) EndTs() Timestamp {
+	return dt.Base.Timestamp
 }
 
-func (dct *DropCollectionTask) SetTs(ts Timestamp) {
-	dct.Base.Timestamp = ts
+func (dt *DeleteTask) SetTs(ts Timestamp) {
+	dt.Base.Timestamp = ts
 }
 
-func (dct *DropCollectionTask) OnEnqueue() error {
-	dct.Base = &commonpb.MsgBase{}
+func (dt *DeleteTask) OnEnqueue() error {
+	dt.DeleteRequest.Base = &commonpb.MsgBase{}
 	return nil
 }
 
-func (dct *DropCollectionTask) PreExecute(ctx context.Context) error {
-	dct.Base.MsgType = commonpb.MsgType_DropCollection
-	dct.Base.SourceID = Params.ProxyID
+func (dt *DeleteTask) getPChanStats() (map[pChan]pChanStatistics, error) {
+	ret := make(map[pChan]pChanStatistics)
 
-	if err := ValidateCollectionName(dct.CollectionName); err != nil {
-		return err
+	channels, err := dt.getChannels()
+	if err != nil {
+		return ret, err
 	}
-	return nil
+
+	beginTs := dt.BeginTs()
+	endTs := dt.EndTs()
+
+	for _, channel := range channels {
+		ret[channel] = pChanStatistics{
+			minTs: beginTs,
+			maxTs: endTs,
+		}
+	}
+	return ret, nil
 }
 
-func (dct *DropCollectionTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, dct.CollectionName)
+func (dt *DeleteTask) getChannels() ([]pChan, error) {
+	collID, err := globalMetaCache.GetCollectionID(dt.ctx, dt.DbName, dt.CollectionName)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	dct.result, err = dct.rootCoord.DropCollection(ctx, dct.DropCollectionRequest)
+	var channels []pChan
+	channels, err = dt.chMgr.getChannels(collID)
 	if err != nil {
-		return err
+		err = dt.chMgr.createDMLMsgStream(collID)
+		if err != nil {
+			return nil, err
+		}
+		channels, err = dt.chMgr.getChannels(collID)
 	}
+	return channels, err
+}
 
-	pchans, _ := dct.chMgr.getChannels(collID)
-	for _, pchan := range pchans {
-		_ = dct.chTicker.removePChan(pchan)
+// getPrimaryKeysFromExpr parses the primary keys from a term expression like "pk in [1, 2, 3]"
+// or `pk in ["a", "b"]` of string primary keys, other kinds of expression are not supported
+func getPrimaryKeysFromExpr(schema *typeutil.SchemaHelper, exprString string) (*schemapb.IDs, error) {
+	expr, err := parseQueryExpr(schema, exprString)
+	if err != nil {
+		return nil, err
 	}
 
-	_ = dct.chMgr.removeDMLStream(collID)
-	_ = dct.chMgr.removeDQLStream(collID)
+	switch xExpr := expr.Expr.(type) {
+	case *planpb.Expr_TermExpr:
+		if !xExpr.TermExpr.ColumnInfo.IsPrimaryKey {
+			return nil, errors.New("column is not primary key")
+		}
 
-	return nil
+		switch xExpr.TermExpr.ColumnInfo.DataType {
+		case schemapb.DataType_Int64:
+			var ids []int64
+			for _, value := range xExpr.TermExpr.Values {
+				v, ok := value.Val.(*planpb.GenericValue_Int64Val)
+				if !ok {
+					return nil, errors.New("column is not int64")
+				}
+				ids = append(ids, v.Int64Val)
+			}
+			return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}}, nil
+		case schemapb.DataType_String:
+			var ids []string
+			for _, value := range xExpr.TermExpr.Values {
+				v, ok := value.Val.(*planpb.GenericValue_StringVal)
+				if !ok {
+					return nil, errors.New("column is not string")
+				}
+				ids = append(ids, v.StringVal)
+			}
+			return &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: ids}}}, nil
+		default:
+			return nil, errors.New("column is not int64 or string")
+		}
+	default:
+		return nil, errors.New("not top level term")
+	}
 }
 
-func (dct *DropCollectionTask) PostExecute(ctx context.Context) error {
-	globalMetaCache.RemoveCollection(ctx, dct.CollectionName)
-	return nil
-}
+func (dt *DeleteTask) PreExecute(ctx context.Context) error {
+	dt.Base.MsgType = commonpb.MsgType_Delete
+	dt.Base.SourceID = Params.ProxyID
 
-type SearchTask struct {
-	Condition
-	*internalpb.SearchRequest
-	ctx       context.Context
-	resultBuf chan []*internalpb.SearchResults
-	result    *milvuspb.SearchResults
-	query     *milvuspb.SearchRequest
-	chMgr     channelsMgr
-	qc        types.QueryCoord
-}
-
-func (st *SearchTask) TraceCtx() context.Context {
-	return st.ctx
-}
-
-func (st *SearchTask) ID() UniqueID {
-	return st.Base.MsgID
-}
-
-func (st *SearchTask) SetID(uid UniqueID) {
-	st.Base.MsgID = uid
-}
-
-func (st *SearchTask) Name() string {
-	return SearchTaskName
-}
-
-func (st *SearchTask) Type() commonpb.MsgType {
-	return st.Base.MsgType
-}
-
-func (st *SearchTask) BeginTs() Timestamp {
-	return st.Base.Timestamp
-}
-
-func (st *SearchTask) EndTs() Timestamp {
-	return st.Base.Timestamp
-}
-
-func (st *SearchTask) SetTs(ts Timestamp) {
-	st.Base.Timestamp = ts
-}
-
-func (st *SearchTask) OnEnqueue() error {
-	st.Base = &commonpb.MsgBase{}
-	return nil
-}
-
-func (st *SearchTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.CollectionName)
-	if err != nil {
-		return nil, err
+	if err := checkPrivilege(ctx, dt.req.DbName, dt.req.CollectionName, commonpb.Privilege_PrivilegeInsert); err != nil {
+		return err
 	}
 
-	return st.chMgr.getChannels(collID)
-}
+	dt.result = &milvuspb.MutationResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		IDs: &schemapb.IDs{
+			IdField: nil,
+		},
+		Timestamp: dt.BeginTs(),
+	}
 
-func (st *SearchTask) getVChannels() ([]vChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.CollectionName)
+	collName := dt.req.CollectionName
+	if err := ValidateCollectionName(collName); err != nil {
+		log.Error("Invalid collection name", zap.String("collectionName", collName))
+		return err
+	}
+	collID, err := globalMetaCache.GetCollectionID(ctx, dt.DbName, collName)
 	if err != nil {
-		return nil, err
+		log.Debug("Failed to get collection id", zap.String("collectionName", collName))
+		return err
 	}
+	dt.DeleteRequest.CollectionID = collID
 
-	_, err = st.chMgr.getChannels(collID)
-	if err != nil {
-		err := st.chMgr.createDMLMsgStream(collID)
+	// If partitionName is not empty, partitionID will be set.
+	if len(dt.req.PartitionName) > 0 {
+		partName := dt.req.PartitionName
+		if err := ValidatePartitionTag(partName, true); err != nil {
+			log.Error("Invalid partition name", zap.String("partitionName", partName))
+			return err
+		}
+		partID, err := globalMetaCache.GetPartitionID(ctx, dt.DbName, collName, partName)
 		if err != nil {
-			return nil, err
+			log.Debug("Failed to get partition id", zap.String("collectionName", collName), zap.String("partitionName", partName))
+			return err
 		}
+		dt.DeleteRequest.PartitionID = partID
+	} else {
+		// partitionID 0 means that entities are deleted from all partitions of the collection
+		dt.DeleteRequest.PartitionID = 0
 	}
 
-	return st.chMgr.getVChannels(collID)
-}
-
-func (st *SearchTask) PreExecute(ctx context.Context) error {
-	st.Base.MsgType = commonpb.MsgType_Search
-	st.Base.SourceID = Params.ProxyID
-
-	collectionName := st.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
-	if err != nil { // err is not nil if collection not exists
+	schemaPb, err := globalMetaCache.GetCollectionSchema(ctx, dt.DbName, collName)
+	if err != nil {
+		log.Error("Failed to get collection schema", zap.String("collectionName", collName))
 		return err
 	}
-
-	if err := ValidateCollectionName(st.query.CollectionName); err != nil {
+	schema, err := typeutil.CreateSchemaHelper(schemaPb)
+	if err != nil {
+		log.Error("Failed to create schema helper", zap.String("collectionName", collName))
 		return err
 	}
 
-	for _, tag := range st.query.PartitionNames {
-		if err := ValidatePartitionTag(tag, false); err != nil {
-			return err
-		}
-	}
-
-	// check if collection was already loaded into query node
-	showResp, err := st.qc.ShowCollections(st.ctx, &querypb.ShowCollectionsRequest{
-		Base: &commonpb.MsgBase{
-			MsgType:   commonpb.MsgType_ShowCollections,
-			MsgID:     st.Base.MsgID,
-			Timestamp: st.Base.Timestamp,
-			SourceID:  Params.ProxyID,
-		},
-		DbID: 0, // TODO(dragondriver)
-	})
+	primaryKeys, err := getPrimaryKeysFromExpr(schema, dt.req.Expr)
 	if err != nil {
+		log.Error("Failed to get primary keys from expr", zap.Error(err))
 		return err
 	}
-	if showResp.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return errors.New(showResp.Status.Reason)
-	}
-	log.Debug("query coordinator show collections",
-		zap.Any("collID", collID),
-		zap.Any("collections", showResp.CollectionIDs),
-	)
-	collectionLoaded := false
-	for _, collectionID := range showResp.CollectionIDs {
-		if collectionID == collID {
-			collectionLoaded = true
-			break
+
+	var numOfPks int
+	switch ids := primaryKeys.IdField.(type) {
+	case *schemapb.IDs_IntId:
+		dt.DeleteRequest.PrimaryKeys = ids.IntId.Data
+		dt.hashValues = hashInt64PrimaryKeys(ids.IntId.Data)
+		numOfPks = len(ids.IntId.Data)
+	case *schemapb.IDs_StrId:
+		if err := ValidateStringPrimaryKeys(ids.StrId.Data); err != nil {
+			return err
 		}
+		dt.DeleteRequest.StringPrimaryKeys = ids.StrId.Data
+		dt.hashValues = hashStringPrimaryKeys(ids.StrId.Data)
+		numOfPks = len(ids.StrId.Data)
 	}
-	if !collectionLoaded {
-		return fmt.Errorf("collection %v was not loaded into memory", collectionName)
-	}
-
-	// TODO(dragondriver): necessary to check if partition was loaded into query node?
+	log.Debug("get primary keys from expr", zap.Int("len of primary keys", numOfPks))
 
-	st.Base.MsgType = commonpb.MsgType_Search
-
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
-	if err != nil { // err is not nil if collection not exists
-		return err
+	dt.DeleteRequest.Timestamps = make([]uint64, numOfPks)
+	for index := range dt.DeleteRequest.Timestamps {
+		dt.DeleteRequest.Timestamps[index] = dt.BeginTs()
 	}
-	if st.query.GetDslType() == commonpb.DslType_BoolExprV1 {
-		annsField, err := GetAttrByKeyFromRepeatedKV(AnnsFieldKey, st.query.SearchParams)
-		if err != nil {
-			return errors.New(AnnsFieldKey + " not found in search_params")
-		}
 
-		topKStr, err := GetAttrByKeyFromRepeatedKV(TopKKey, st.query.SearchParams)
-		if err != nil {
-			return errors.New(TopKKey + " not found in search_params")
-		}
-		topK, err := strconv.Atoi(topKStr)
-		if err != nil {
-			return errors.New(TopKKey + " " + topKStr + " is not invalid")
-		}
+	dt.result.IDs = primaryKeys
+	dt.result.DeleteCnt = int64(numOfPks)
 
-		metricType, err := GetAttrByKeyFromRepeatedKV(MetricTypeKey, st.query.SearchParams)
-		if err != nil {
-			return errors.New(MetricTypeKey + " not found in search_params")
-		}
+	return nil
+}
 
-		searchParams, err := GetAttrByKeyFromRepeatedKV(SearchParamsKey, st.query.SearchParams)
-		if err != nil {
-			return errors.New(SearchParamsKey + " not found in search_params")
+// getDeleteMsgs splits the delete by the channels of the primary keys, the dml stream routes a message by
+// its first hash value only
+func (dt *DeleteTask) getDeleteMsgs(ctx context.Context, channelNames []vChan) []msgstream.TsMsg {
+	channelNum := uint32(len(channelNames))
+	msgs := make(map[uint32]*msgstream.DeleteMsg)
+	keys := make([]uint32, 0)
+	for i, hash := range dt.hashValues {
+		key := hash % channelNum
+		msg, ok := msgs[key]
+		if !ok {
+			msg = &msgstream.DeleteMsg{
+				BaseMsg: msgstream.BaseMsg{
+					Ctx:            ctx,
+					BeginTimestamp: dt.BeginTs(),
+					EndTimestamp:   dt.EndTs(),
+				},
+				DeleteRequest: internalpb.DeleteRequest{
+					Base:           dt.Base,
+					DbName:         dt.DbName,
+					CollectionName: dt.CollectionName,
+					PartitionName:  dt.PartitionName,
+					CollectionID:   dt.CollectionID,
+					PartitionID:    dt.PartitionID,
+					ChannelID:      channelNames[key],
+				},
+			}
+			msgs[key] = msg
+			keys = append(keys, key)
 		}
-
-		queryInfo := &planpb.QueryInfo{
-			Topk:         int64(topK),
-			MetricType:   metricType,
-			SearchParams: searchParams,
+		msg.HashValues = append(msg.HashValues, hash)
+		msg.Timestamps = append(msg.Timestamps, dt.Timestamps[i])
+		if len(dt.StringPrimaryKeys) > 0 {
+			msg.StringPrimaryKeys = append(msg.StringPrimaryKeys, dt.StringPrimaryKeys[i])
+		} else {
+			msg.PrimaryKeys = append(msg.PrimaryKeys, dt.PrimaryKeys[i])
 		}
+	}
 
-		plan, err := CreateQueryPlan(schema, st.query.Dsl, annsField, queryInfo)
+	ret := make([]msgstream.TsMsg, 0, len(keys))
+	for _, key := range keys {
+		ret = append(ret, msgs[key])
+	}
+	return ret
+}
+
+func (dt *DeleteTask) Execute(ctx context.Context) error {
+	collID := dt.DeleteRequest.CollectionID
+	stream, err := dt.chMgr.getDMLStream(collID)
+	if err != nil {
+		err = dt.chMgr.createDMLMsgStream(collID)
 		if err != nil {
-			//return errors.New("invalid expression: " + st.query.Dsl)
+			dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			dt.result.Status.Reason = err.Error()
 			return err
 		}
-		for _, name := range st.query.OutputFields {
-			hitField := false
-			for _, field := range schema.Fields {
-				if field.Name == name {
-					if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
-						return errors.New("Search doesn't support vector field as output_fields")
-					}
-
-					st.SearchRequest.OutputFieldsId = append(st.SearchRequest.OutputFieldsId, field.FieldID)
-					plan.OutputFieldIds = append(plan.OutputFieldIds, field.FieldID)
-					hitField = true
-					break
-				}
-			}
-			if !hitField {
-				errMsg := "Field " + name + " not exist"
-				return errors.New(errMsg)
-			}
-		}
-
-		st.SearchRequest.DslType = commonpb.DslType_BoolExprV1
-		st.SearchRequest.SerializedExprPlan, err = proto.Marshal(plan)
+		stream, err = dt.chMgr.getDMLStream(collID)
 		if err != nil {
+			dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			dt.result.Status.Reason = err.Error()
 			return err
 		}
-		log.Debug("Proxy::SearchTask::PreExecute", zap.Any("plan.OutputFieldIds", plan.OutputFieldIds),
-			zap.Any("plan", plan.String()))
 	}
-	travelTimestamp := st.query.TravelTimestamp
-	if travelTimestamp == 0 {
-		travelTimestamp = st.BeginTs()
+
+	pchans, err := dt.chMgr.getChannels(collID)
+	if err != nil {
+		return err
 	}
-	guaranteeTimestamp := st.query.GuaranteeTimestamp
-	if guaranteeTimestamp == 0 {
-		guaranteeTimestamp = st.BeginTs()
+	for _, pchan := range pchans {
+		log.Debug("Proxy DeleteTask add pchan", zap.Any("pchan", pchan))
+		_ = dt.chTicker.addPChan(pchan)
 	}
-	st.SearchRequest.TravelTimestamp = travelTimestamp
-	st.SearchRequest.GuaranteeTimestamp = guaranteeTimestamp
 
-	st.SearchRequest.ResultChannelID = Params.SearchResultChannelNames[0]
-	st.SearchRequest.DbID = 0 // todo
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
-	if err != nil { // err is not nil if collection not exists
+	channelNames, err := dt.chMgr.getVChannels(collID)
+	if err != nil {
+		dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		dt.result.Status.Reason = err.Error()
 		return err
 	}
-	st.SearchRequest.CollectionID = collectionID
-	st.SearchRequest.PartitionIDs = make([]UniqueID, 0)
-
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	msgPack := msgstream.MsgPack{
+		BeginTs: dt.BeginTs(),
+		EndTs:   dt.EndTs(),
+		Msgs:    dt.getDeleteMsgs(ctx, channelNames),
+	}
+	err = stream.Produce(&msgPack)
 	if err != nil {
+		dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		dt.result.Status.Reason = err.Error()
 		return err
 	}
+	return nil
+}
 
-	partitionsRecord := make(map[UniqueID]bool)
-	for _, partitionName := range st.query.PartitionNames {
-		pattern := fmt.Sprintf("^%s$", partitionName)
-		re, err := regexp.Compile(pattern)
-		if err != nil {
-			return errors.New("invalid partition names")
-		}
-		found := false
-		for name, pID := range partitionsMap {
-			if re.MatchString(name) {
-				if _, exist := partitionsRecord[pID]; !exist {
-					st.PartitionIDs = append(st.PartitionIDs, pID)
-					partitionsRecord[pID] = true
-				}
-				found = true
-			}
-		}
-		if !found {
-			errMsg := fmt.Sprintf("PartitonName: %s not found", partitionName)
-			return errors.New(errMsg)
-		}
-	}
+func (dt *DeleteTask) PostExecute(ctx context.Context) error {
+	return nil
+}
 
-	st.SearchRequest.Dsl = st.query.Dsl
-	st.SearchRequest.PlaceholderGroup = st.query.PlaceholderGroup
+type CreateDatabaseTask struct {
+	Condition
+	*milvuspb.CreateDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
 
-	return nil
+func (cdbt *CreateDatabaseTask) TraceCtx() context.Context {
+	return cdbt.ctx
 }
 
-func (st *SearchTask) Execute(ctx context.Context) error {
-	var tsMsg msgstream.TsMsg = &msgstream.SearchMsg{
-		SearchRequest: *st.SearchRequest,
-		BaseMsg: msgstream.BaseMsg{
-			Ctx:            ctx,
-			HashValues:     []uint32{uint32(Params.ProxyID)},
-			BeginTimestamp: st.Base.Timestamp,
-			EndTimestamp:   st.Base.Timestamp,
-		},
-	}
-	msgPack := msgstream.MsgPack{
-		BeginTs: st.Base.Timestamp,
-		EndTs:   st.Base.Timestamp,
-		Msgs:    make([]msgstream.TsMsg, 1),
-	}
-	msgPack.Msgs[0] = tsMsg
+func (cdbt *CreateDatabaseTask) ID() UniqueID {
+	return cdbt.Base.MsgID
+}
 
-	collectionName := st.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
-	if err != nil { // err is not nil if collection not exists
+func (cdbt *CreateDatabaseTask) SetID(uid UniqueID) {
+	cdbt.Base.MsgID = uid
+}
+
+func (cdbt *CreateDatabaseTask) Name() string {
+	return CreateDatabaseTaskName
+}
+
+func (cdbt *CreateDatabaseTask) Type() commonpb.MsgType {
+	return cdbt.Base.MsgType
+}
+
+func (cdbt *CreateDatabaseTask) BeginTs() Timestamp {
+	return cdbt.Base.Timestamp
+}
+
+func (cdbt *CreateDatabaseTask) EndTs() Timestamp {
+	return cdbt.Base.Timestamp
+}
+
+func (cdbt *CreateDatabaseTask) SetTs(ts Timestamp) {
+	cdbt.Base.Timestamp = ts
+}
+
+func (cdbt *CreateDatabaseTask) OnEnqueue() error {
+	cdbt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (cdbt *CreateDatabaseTask) PreExecute(ctx context.Context) error {
+	cdbt.Base.MsgType = commonpb.MsgType_CreateDatabase
+	cdbt.Base.SourceID = Params.ProxyID
+
+	if err := checkPrivilege(ctx, anyName, "", commonpb.Privilege_PrivilegeAdmin); err != nil {
 		return err
 	}
 
-	stream, err := st.chMgr.getDQLStream(collID)
-	if err != nil {
-		err = st.chMgr.createDQLStream(collID)
-		if err != nil {
-			st.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
-			st.result.Status.Reason = err.Error()
-			return err
-		}
-		stream, err = st.chMgr.getDQLStream(collID)
-		if err != nil {
-			st.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
-			st.result.Status.Reason = err.Error()
-			return err
-		}
+	if err := ValidateDatabaseName(cdbt.DbName); err != nil {
+		return err
 	}
-	err = stream.Produce(&msgPack)
-	log.Debug("proxy", zap.Int("length of searchMsg", len(msgPack.Msgs)))
-	log.Debug("proxy sent one searchMsg",
-		zap.Any("collectionID", st.CollectionID),
-		zap.Any("msgID", tsMsg.ID()),
-	)
-	if err != nil {
-		log.Debug("proxy", zap.String("send search request failed", err.Error()))
+
+	return nil
+}
+
+func (cdbt *CreateDatabaseTask) Execute(ctx context.Context) (err error) {
+	cdbt.result, err = cdbt.rootCoord.CreateDatabase(ctx, cdbt.CreateDatabaseRequest)
+	if cdbt.result == nil {
+		return errors.New("CreateDatabase resp is nil")
+	}
+	if cdbt.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(cdbt.result.Reason)
 	}
 	return err
 }
 
-func decodeSearchResultsSerial(searchResults []*internalpb.SearchResults) ([]*schemapb.SearchResultData, error) {
-	log.Debug("reduceSearchResultDataParallel", zap.Any("lenOfSearchResults", len(searchResults)))
+func (cdbt *CreateDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
 
-	results := make([]*schemapb.SearchResultData, 0)
-	// necessary to parallel this?
-	for i, partialSearchResult := range searchResults {
-		log.Debug("decodeSearchResultsSerial", zap.Any("i", i), zap.Any("SlicedBob", partialSearchResult.SlicedBlob))
-		if partialSearchResult.SlicedBlob == nil {
-			continue
-		}
+type DropDatabaseTask struct {
+	Condition
+	*milvuspb.DropDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
 
-		var partialResultData schemapb.SearchResultData
-		err := proto.Unmarshal(partialSearchResult.SlicedBlob, &partialResultData)
-		log.Debug("decodeSearchResultsSerial, Unmarshal partitalSearchResult.SliceBlob", zap.Error(err))
-		if err != nil {
-			return nil, err
-		}
+func (ddbt *DropDatabaseTask) TraceCtx() context.Context {
+	return ddbt.ctx
+}
 
-		results = append(results, &partialResultData)
-	}
-	log.Debug("reduceSearchResultDataParallel", zap.Any("lenOfResults", len(results)))
+func (ddbt *DropDatabaseTask) ID() UniqueID {
+	return ddbt.Base.MsgID
+}
 
-	return results, nil
+func (ddbt *DropDatabaseTask) SetID(uid UniqueID) {
+	ddbt.Base.MsgID = uid
 }
 
-func decodeSearchResults(searchResults []*internalpb.SearchResults) ([]*schemapb.SearchResultData, error) {
-	t := time.Now()
-	defer func() {
-		log.Debug("decodeSearchResults", zap.Any("time cost", time.Since(t)))
-	}()
-	return decodeSearchResultsSerial(searchResults)
-	// return decodeSearchResultsParallelByCPU(searchResults)
+func (ddbt *DropDatabaseTask) Name() string {
+	return DropDatabaseTaskName
 }
 
-func reduceSearchResultDataParallel(searchResultData []*schemapb.SearchResultData, nq, availableQueryNodeNum, topk int, metricType string, maxParallel int) (*milvuspb.SearchResults, error) {
-	log.Debug("reduceSearchResultDataParallel", zap.Any("lenOfsearchResultData", len(searchResultData)),
-		zap.Any("nq", nq), zap.Any("availableQueryNodeNum", availableQueryNodeNum),
-		zap.Any("topk", topk), zap.Any("metricType", metricType),
-		zap.Any("maxParallel", maxParallel))
+func (ddbt *DropDatabaseTask) Type() commonpb.MsgType {
+	return ddbt.Base.MsgType
+}
 
-	for i, sData := range searchResultData {
-		log.Debug("reduceSearchResultDataParallel", zap.Any("i", i), zap.Any("len(FieldsData)", len(sData.FieldsData)))
-	}
+func (ddbt *DropDatabaseTask) BeginTs() Timestamp {
+	return ddbt.Base.Timestamp
+}
 
-	ret := &milvuspb.SearchResults{
-		Status: &commonpb.Status{
-			ErrorCode: 0,
-		},
-		Results: &schemapb.SearchResultData{
-			NumQueries: int64(nq),
-			TopK:       int64(topk),
-			FieldsData: make([]*schemapb.FieldData, len(searchResultData[0].FieldsData)),
-			Scores:     make([]float32, 0),
-			Ids: &schemapb.IDs{
-				IdField: &schemapb.IDs_IntId{
-					IntId: &schemapb.LongArray{
-						Data: make([]int64, 0),
-					},
-				},
-			},
-			Topks: make([]int64, 0),
-		},
-	}
+func (ddbt *DropDatabaseTask) EndTs() Timestamp {
+	return ddbt.Base.Timestamp
+}
 
-	const minFloat32 = -1 * float32(math.MaxFloat32)
+func (ddbt *DropDatabaseTask) SetTs(ts Timestamp) {
+	ddbt.Base.Timestamp = ts
+}
 
-	// TODO(yukun): Use parallel function
-	realTopK := -1
-	for idx := 0; idx < nq; idx++ {
-		locs := make([]int, availableQueryNodeNum)
+func (ddbt *DropDatabaseTask) OnEnqueue() error {
+	ddbt.Base = &commonpb.MsgBase{}
+	return nil
+}
 
-		j := 0
-		for ; j < topk; j++ {
-			valid := false
-			choice, maxDistance := 0, minFloat32
-			for q, loc := range locs { // query num, the number of ways to merge
-				if loc >= topk {
-					continue
-				}
-				distance := searchResultData[q].Scores[idx*topk+loc]
-				if distance > maxDistance || (math.Abs(float64(distance-maxDistance)) < math.SmallestNonzeroFloat32 && choice != q) {
-					choice = q
-					maxDistance = distance
-					valid = true
-				}
-			}
-			if !valid {
-				break
-			}
-			choiceOffset := locs[choice]
-			// check if distance is valid, `invalid` here means very very big,
-			// in this process, distance here is the smallest, so the rest of distance are all invalid
-			if searchResultData[choice].Scores[idx*topk+choiceOffset] <= minFloat32 {
-				break
-			}
-			curIdx := idx*topk + choiceOffset
-			ret.Results.Ids.GetIntId().Data = append(ret.Results.Ids.GetIntId().Data, searchResultData[choice].Ids.GetIntId().Data[curIdx])
-			// TODO(yukun): Process searchResultData.FieldsData
-			for k, fieldData := range searchResultData[choice].FieldsData {
-				switch fieldType := fieldData.Field.(type) {
-				case *schemapb.FieldData_Scalars:
-					if ret.Results.FieldsData[k] == nil || ret.Results.FieldsData[k].GetScalars() == nil {
-						ret.Results.FieldsData[k] = &schemapb.FieldData{
-							FieldName: fieldData.FieldName,
-							Field: &schemapb.FieldData_Scalars{
-								Scalars: &schemapb.ScalarField{},
-							},
-						}
-					}
-					switch scalarType := fieldType.Scalars.Data.(type) {
-					case *schemapb.ScalarField_BoolData:
-						if ret.Results.FieldsData[k].GetScalars().GetBoolData() == nil {
-							ret.Results.FieldsData[k].Field.(*schemapb.FieldData_Scalars).Scalars = &schemapb.ScalarField{
-								Data: &schemapb.ScalarField_BoolData{
-									BoolData: &schemapb.BoolArray{
-										Data: []bool{scalarType.BoolData.Data[curIdx]},
-									},
-								},
-							}
-						} else {
-							ret.Results.FieldsData[k].GetScalars().GetBoolData().Data = append(ret.Results.FieldsData[k].GetScalars().GetBoolData().Data, scalarType.BoolData.Data[curIdx])
-						}
-					case *schemapb.ScalarField_IntData:
-						if ret.Results.FieldsData[k].GetScalars().GetIntData() == nil {
-							ret.Results.FieldsData[k].Field.(*schemapb.FieldData_Scalars).Scalars = &schemapb.ScalarField{
-								Data: &schemapb.ScalarField_IntData{
-									IntData: &schemapb.IntArray{
-										Data: []int32{scalarType.IntData.Data[curIdx]},
-									},
-								},
-							}
-						} else {
-							ret.Results.FieldsData[k].GetScalars().GetIntData().Data = append(ret.Results.FieldsData[k].GetScalars().GetIntData().Data, scalarType.IntData.Data[curIdx])
-						}
-					case *schemapb.ScalarField_LongData:
-						if ret.Results.FieldsData[k].GetScalars().GetLongData() == nil {
-							ret.Results.FieldsData[k].Field.(*schemapb.FieldData_Scalars).Scalars = &schemapb.ScalarField{
-								Data: &schemapb.ScalarField_LongData{
-									LongData: &schemapb.LongArray{
-										Data: []int64{scalarType.LongData.Data[curIdx]},
-									},
-								},
-							}
-						} else {
-							ret.Results.FieldsData[k].GetScalars().GetLongData().Data = append(ret.Results.FieldsData[k].GetScalars().GetLongData().Data, scalarType.LongData.Data[curIdx])
-						}
-					case *schemapb.ScalarField_FloatData:
-						if ret.Results.FieldsData[k].GetScalars().GetFloatData() == nil {
-							ret.Results.FieldsData[k].Field.(*schemapb.FieldData_Scalars).Scalars = &schemapb.ScalarField{
-								Data: &schemapb.ScalarField_FloatData{
-									FloatData: &schemapb.FloatArray{
-										Data: []float32{scalarType.FloatData.Data[curIdx]},
-									},
-								},
-							}
-						} else {
-							ret.Results.FieldsData[k].GetScalars().GetFloatData().Data = append(ret.Results.FieldsData[k].GetScalars().GetFloatData().Data, scalarType.FloatData.Data[curIdx])
-						}
-					case *schemapb.ScalarField_DoubleData:
-						if ret.Results.FieldsData[k].GetScalars().GetDoubleData() == nil {
-							ret.Results.FieldsData[k].Field.(*schemapb.FieldData_Scalars).Scalars = &schemapb.ScalarField{
-								Data: &schemapb.ScalarField_DoubleData{
-									DoubleData: &schemapb.DoubleArray{
-										Data: []float64{scalarType.DoubleData.Data[curIdx]},
-									},
-								},
-							}
-						} else {
-							ret.Results.FieldsData[k].GetScalars().GetDoubleData().Data = append(ret.Results.FieldsData[k].GetScalars().GetDoubleData().Data, scalarType.DoubleData.Data[curIdx])
-						}
-					default:
-						log.Debug("Not supported field type")
-						return nil, fmt.Errorf("not supported field type: %s", fieldData.Type.String())
-					}
-				case *schemapb.FieldData_Vectors:
-					dim := fieldType.Vectors.Dim
-					if ret.Results.FieldsData[k] == nil || ret.Results.FieldsData[k].GetVectors() == nil {
-						ret.Results.FieldsData[k] = &schemapb.FieldData{
-							FieldName: fieldData.FieldName,
-							Field: &schemapb.FieldData_Vectors{
-								Vectors: &schemapb.VectorField{
-									Dim: dim,
-								},
-							},
-						}
-					}
-					switch vectorType := fieldType.Vectors.Data.(type) {
-					case *schemapb.VectorField_BinaryVector:
-						if ret.Results.FieldsData[k].GetVectors().GetBinaryVector() == nil {
-							bvec := &schemapb.VectorField_BinaryVector{
-								BinaryVector: vectorType.BinaryVector[curIdx*int((dim/8)) : (curIdx+1)*int((dim/8))],
-							}
-							ret.Results.FieldsData[k].GetVectors().Data = bvec
-						} else {
-							ret.Results.FieldsData[k].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector = append(ret.Results.FieldsData[k].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector, vectorType.BinaryVector[curIdx*int((dim/8)):(curIdx+1)*int((dim/8))]...)
-						}
-					case *schemapb.VectorField_FloatVector:
-						if ret.Results.FieldsData[k].GetVectors().GetFloatVector() == nil {
-							fvec := &schemapb.VectorField_FloatVector{
-								FloatVector: &schemapb.FloatArray{
-									Data: vectorType.FloatVector.Data[curIdx*int(dim) : (curIdx+1)*int(dim)],
-								},
-							}
-							ret.Results.FieldsData[k].GetVectors().Data = fvec
-						} else {
-							ret.Results.FieldsData[k].GetVectors().GetFloatVector().Data = append(ret.Results.FieldsData[k].GetVectors().GetFloatVector().Data, vectorType.FloatVector.Data[curIdx*int(dim):(curIdx+1)*int(dim)]...)
-						}
-					}
-				}
-			}
-			ret.Results.Scores = append(ret.Results.Scores, searchResultData[choice].Scores[idx*topk+choiceOffset])
-			locs[choice]++
-		}
-		if realTopK != -1 && realTopK != j {
-			log.Warn("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
-			// return nil, errors.New("the length (topk) between all result of query is different")
-		}
-		realTopK = j
-		ret.Results.Topks = append(ret.Results.Topks, int64(realTopK))
+func (ddbt *DropDatabaseTask) PreExecute(ctx context.Context) error {
+	ddbt.Base.MsgType = commonpb.MsgType_DropDatabase
+	ddbt.Base.SourceID = Params.ProxyID
+
+	if err := checkPrivilege(ctx, ddbt.DbName, "", commonpb.Privilege_PrivilegeAdmin); err != nil {
+		return err
 	}
 
-	ret.Results.TopK = int64(realTopK)
+	if err := ValidateDatabaseName(ddbt.DbName); err != nil {
+		return err
+	}
 
-	if metricType != "IP" {
-		for k := range ret.Results.Scores {
-			ret.Results.Scores[k] *= -1
-		}
+	return nil
+}
+
+func (ddbt *DropDatabaseTask) Execute(ctx context.Context) (err error) {
+	ddbt.result, err = ddbt.rootCoord.DropDatabase(ctx, ddbt.DropDatabaseRequest)
+	if ddbt.result == nil {
+		return errors.New("DropDatabase resp is nil")
 	}
+	if ddbt.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(ddbt.result.Reason)
+	}
+	return err
+}
 
-	return ret, nil
+func (ddbt *DropDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
 }
 
-func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq, availableQueryNodeNum, topk int, metricType string) (*milvuspb.SearchResults, error) {
-	t := time.Now()
-	defer func() {
-		log.Debug("reduceSearchResults", zap.Any("time cost", time.Since(t)))
-	}()
-	return reduceSearchResultDataParallel(searchResultData, nq, availableQueryNodeNum, topk, metricType, runtime.NumCPU())
+type ListDatabasesTask struct {
+	Condition
+	*milvuspb.ListDatabasesRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *milvuspb.ListDatabasesResponse
 }
 
-func printSearchResult(partialSearchResult *internalpb.SearchResults) {
-	for i := 0; i < len(partialSearchResult.Hits); i++ {
-		testHits := milvuspb.Hits{}
-		err := proto.Unmarshal(partialSearchResult.Hits[i], &testHits)
-		if err != nil {
-			panic(err)
-		}
-		fmt.Println(testHits.IDs)
-		fmt.Println(testHits.Scores)
-	}
+func (ldbt *ListDatabasesTask) TraceCtx() context.Context {
+	return ldbt.ctx
 }
 
-func (st *SearchTask) PostExecute(ctx context.Context) error {
-	t0 := time.Now()
-	defer func() {
-		log.Debug("WaitAndPostExecute", zap.Any("time cost", time.Since(t0)))
-	}()
-	for {
-		select {
-		case <-st.TraceCtx().Done():
-			log.Debug("Proxy", zap.Int64("SearchTask PostExecute Loop exit caused by ctx.Done", st.ID()))
-			return fmt.Errorf("SearchTask:wait to finish failed, timeout: %d", st.ID())
-		case searchResults := <-st.resultBuf:
-			// fmt.Println("searchResults: ", searchResults)
-			filterSearchResult := make([]*internalpb.SearchResults, 0)
-			var filterReason string
-			for _, partialSearchResult := range searchResults {
-				if partialSearchResult.Status.ErrorCode == commonpb.ErrorCode_Success {
-					filterSearchResult = append(filterSearchResult, partialSearchResult)
-					// For debugging, please don't delete.
-					// printSearchResult(partialSearchResult)
-				} else {
-					filterReason += partialSearchResult.Status.Reason + "\n"
-				}
-			}
+func (ldbt *ListDatabasesTask) ID() UniqueID {
+	return ldbt.Base.MsgID
+}
 
-			availableQueryNodeNum := len(filterSearchResult)
-			log.Debug("Proxy Search PostExecute stage1", zap.Any("availableQueryNodeNum", availableQueryNodeNum))
-			if availableQueryNodeNum <= 0 {
-				log.Debug("Proxy Search PostExecute failed", zap.Any("filterReason", filterReason))
-				st.result = &milvuspb.SearchResults{
-					Status: &commonpb.Status{
-						ErrorCode: commonpb.ErrorCode_UnexpectedError,
-						Reason:    filterReason,
-					},
-				}
-				return errors.New(filterReason)
-			}
+func (ldbt *ListDatabasesTask) SetID(uid UniqueID) {
+	ldbt.Base.MsgID = uid
+}
 
-			availableQueryNodeNum = 0
-			for _, partialSearchResult := range filterSearchResult {
-				if partialSearchResult.SlicedBlob == nil {
-					filterReason += "nq is zero\n"
-					continue
-				}
-				availableQueryNodeNum++
-			}
-			log.Debug("Proxy Search PostExecute stage2", zap.Any("availableQueryNodeNum", availableQueryNodeNum))
+func (ldbt *ListDatabasesTask) Name() string {
+	return ListDatabasesTaskName
+}
 
-			if availableQueryNodeNum <= 0 {
-				log.Debug("Proxy Search PostExecute stage2 failed", zap.Any("filterReason", filterReason))
+func (ldbt *ListDatabasesTask) Type() commonpb.MsgType {
+	return ldbt.Base.MsgType
+}
 
-				st.result = &milvuspb.SearchResults{
-					Status: &commonpb.Status{
-						ErrorCode: commonpb.ErrorCode_Success,
-						Reason:    filterReason,
-					},
-				}
-				return nil
-			}
+func (ldbt *ListDatabasesTask) BeginTs() Timestamp {
+	return ldbt.Base.Timestamp
+}
 
-			results, err := decodeSearchResults(filterSearchResult)
-			log.Debug("Proxy Search PostExecute decodeSearchResults", zap.Error(err))
-			if err != nil {
-				return err
-			}
+func (ldbt *ListDatabasesTask) EndTs() Timestamp {
+	return ldbt.Base.Timestamp
+}
 
-			nq := results[0].NumQueries
-			topk := 0
-			for _, partialResult := range results {
-				topk = getMax(topk, int(partialResult.TopK))
-			}
-			if nq <= 0 {
-				st.result = &milvuspb.SearchResults{
-					Status: &commonpb.Status{
-						ErrorCode: commonpb.ErrorCode_Success,
-						Reason:    filterReason,
-					},
-				}
-				return nil
-			}
+func (ldbt *ListDatabasesTask) SetTs(ts Timestamp) {
+	ldbt.Base.Timestamp = ts
+}
 
-			st.result, err = reduceSearchResultData(results, int(nq), availableQueryNodeNum, topk, searchResults[0].MetricType)
-			if err != nil {
-				return err
-			}
+func (ldbt *ListDatabasesTask) OnEnqueue() error {
+	ldbt.Base = &commonpb.MsgBase{}
+	return nil
+}
 
-			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.CollectionName)
-			if err != nil {
-				return err
-			}
-			if len(st.query.OutputFields) != 0 && len(st.result.Results.FieldsData) != 0 {
-				for k, fieldName := range st.query.OutputFields {
-					for _, field := range schema.Fields {
-						if st.result.Results.FieldsData[k] != nil && field.Name == fieldName {
-							st.result.Results.FieldsData[k].FieldName = fieldName
-							st.result.Results.FieldsData[k].Type = field.DataType
-						}
-					}
-				}
-			}
-			log.Debug("Proxy Search PostExecute Done")
-			return nil
-		}
+func (ldbt *ListDatabasesTask) PreExecute(ctx context.Context) error {
+	ldbt.Base.MsgType = commonpb.MsgType_ListDatabases
+	ldbt.Base.SourceID = Params.ProxyID
+
+	return nil
+}
+
+func (ldbt *ListDatabasesTask) Execute(ctx context.Context) (err error) {
+	ldbt.result, err = ldbt.rootCoord.ListDatabases(ctx, ldbt.ListDatabasesRequest)
+	if ldbt.result == nil {
+		return errors.New("ListDatabases resp is nil")
+	}
+	if ldbt.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(ldbt.result.Status.Reason)
 	}
+	return err
 }
 
-type RetrieveTask struct {
+func (ldbt *ListDatabasesTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type CreateUserTask struct {
 	Condition
-	*internalpb.RetrieveRequest
+	*milvuspb.CreateUserRequest
 	ctx       context.Context
-	resultBuf chan []*internalpb.RetrieveResults
-	result    *milvuspb.RetrieveResults
-	retrieve  *milvuspb.RetrieveRequest
-	chMgr     channelsMgr
-	qc        types.QueryCoord
+	rootCoord types.RootCoord
+	result    *commonpb.Status
 }
 
-func (rt *RetrieveTask) TraceCtx() context.Context {
-	return rt.ctx
+func (cut *CreateUserTask) TraceCtx() context.Context {
+	return cut.ctx
 }
 
-func (rt *RetrieveTask) ID() UniqueID {
-	return rt.Base.MsgID
+func (cut *CreateUserTask) ID() UniqueID {
+	return cut.Base.MsgID
 }
 
-func (rt *RetrieveTask) SetID(uid UniqueID) {
-	rt.Base.MsgID = uid
+func (cut *CreateUserTask) SetID(uid UniqueID) {
+	cut.Base.MsgID = uid
 }
 
-func (rt *RetrieveTask) Name() string {
-	return RetrieveTaskName
+func (cut *CreateUserTask) Name() string {
+	return CreateUserTaskName
 }
 
-func (rt *RetrieveTask) Type() commonpb.MsgType {
-	return rt.Base.MsgType
+func (cut *CreateUserTask) Type() commonpb.MsgType {
+	return cut.Base.MsgType
 }
 
-func (rt *RetrieveTask) BeginTs() Timestamp {
-	return rt.Base.Timestamp
+func (cut *CreateUserTask) BeginTs() Timestamp {
+	return cut.Base.Timestamp
 }
 
-func (rt *RetrieveTask) EndTs() Timestamp {
-	return rt.Base.Timestamp
+func (cut *CreateUserTask) EndTs() Timestamp {
+	return cut.Base.Timestamp
 }
 
-func (rt *RetrieveTask) SetTs(ts Timestamp) {
-	rt.Base.Timestamp = ts
+func (cut *CreateUserTask) SetTs(ts Timestamp) {
+	cut.Base.Timestamp = ts
 }
 
-func (rt *RetrieveTask) OnEnqueue() error {
-	rt.Base.MsgType = commonpb.MsgType_Retrieve
+func (cut *CreateUserTask) OnEnqueue() error {
+	cut.Base = &commonpb.MsgBase{}
 	return nil
 }
 
-func (rt *RetrieveTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(rt.ctx, rt.retrieve.CollectionName)
-	if err != nil {
-		return nil, err
+func (cut *CreateUserTask) PreExecute(ctx context.Context) error {
+	cut.Base.MsgType = commonpb.MsgType_CreateUser
+	cut.Base.SourceID = Params.ProxyID
+
+	if err := checkPrivilege(ctx, anyName, "", commonpb.Privilege_PrivilegeAdmin); err != nil {
+		return err
+	}
+	if err := ValidateUsername(cut.Username); err != nil {
+		return err
+	}
+	if err := ValidatePassword(cut.Password); err != nil {
+		return err
 	}
 
-	return rt.chMgr.getChannels(collID)
+	return nil
 }
 
-func (rt *RetrieveTask) getVChannels() ([]vChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(rt.ctx, rt.retrieve.CollectionName)
-	if err != nil {
-		return nil, err
+func (cut *CreateUserTask) Execute(ctx context.Context) (err error) {
+	cut.result, err = cut.rootCoord.CreateUser(ctx, cut.CreateUserRequest)
+	if cut.result == nil {
+		return errors.New("CreateUser resp is nil")
 	}
-
-	_, err = rt.chMgr.getChannels(collID)
-	if err != nil {
-		err := rt.chMgr.createDMLMsgStream(collID)
-		if err != nil {
-			return nil, err
-		}
+	if cut.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(cut.result.Reason)
 	}
+	return err
+}
 
-	return rt.chMgr.getVChannels(collID)
+func (cut *CreateUserTask) PostExecute(ctx context.Context) error {
+	return nil
 }
 
-func (rt *RetrieveTask) PreExecute(ctx context.Context) error {
-	rt.Base.MsgType = commonpb.MsgType_Retrieve
-	rt.Base.SourceID = Params.ProxyID
+type GrantRoleTask struct {
+	Condition
+	*milvuspb.GrantRoleRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
 
-	collectionName := rt.retrieve.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
-	if err != nil {
-		log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
-			zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+func (grt *GrantRoleTask) TraceCtx() context.Context {
+	return grt.ctx
+}
+
+func (grt *GrantRoleTask) ID() UniqueID {
+	return grt.Base.MsgID
+}
+
+func (grt *GrantRoleTask) SetID(uid UniqueID) {
+	grt.Base.MsgID = uid
+}
+
+func (grt *GrantRoleTask) Name() string {
+	return GrantRoleTaskName
+}
+
+func (grt *GrantRoleTask) Type() commonpb.MsgType {
+	return grt.Base.MsgType
+}
+
+func (grt *GrantRoleTask) BeginTs() Timestamp {
+	return grt.Base.Timestamp
+}
+
+func (grt *GrantRoleTask) EndTs() Timestamp {
+	return grt.Base.Timestamp
+}
+
+func (grt *GrantRoleTask) SetTs(ts Timestamp) {
+	grt.Base.Timestamp = ts
+}
+
+func (grt *GrantRoleTask) OnEnqueue() error {
+	grt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (grt *GrantRoleTask) PreExecute(ctx context.Context) error {
+	grt.Base.MsgType = commonpb.MsgType_GrantRole
+	grt.Base.SourceID = Params.ProxyID
+
+	if err := checkPrivilege(ctx, anyName, "", commonpb.Privilege_PrivilegeAdmin); err != nil {
 		return err
 	}
-	log.Info("Get collection id by name.", zap.Any("collectionName", collectionName),
-		zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
-
-	if err := ValidateCollectionName(rt.retrieve.CollectionName); err != nil {
-		log.Debug("Invalid collection name.", zap.Any("collectionName", collectionName),
-			zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+	if err := ValidateRoleName(grt.RoleName); err != nil {
 		return err
 	}
-	log.Info("Validate collection name.", zap.Any("collectionName", collectionName),
-		zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
-
-	for _, tag := range rt.retrieve.PartitionNames {
-		if err := ValidatePartitionTag(tag, false); err != nil {
-			log.Debug("Invalid partition name.", zap.Any("partitionName", tag),
-				zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+	if grt.Username != "" {
+		if err := ValidateUsername(grt.Username); err != nil {
 			return err
 		}
 	}
-	log.Info("Validate partition names.",
-		zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
-
-	// check if collection was already loaded into query node
-	showResp, err := rt.qc.ShowCollections(rt.ctx, &querypb.ShowCollectionsRequest{
-		Base: &commonpb.MsgBase{
-			MsgType:   commonpb.MsgType_ShowCollections,
-			MsgID:     rt.Base.MsgID,
-			Timestamp: rt.Base.Timestamp,
-			SourceID:  Params.ProxyID,
-		},
-		DbID: 0, // TODO(dragondriver)
-	})
-	if err != nil {
-		return err
-	}
-	if showResp.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return errors.New(showResp.Status.Reason)
-	}
-	log.Debug("query coordinator show collections",
-		zap.Any("collections", showResp.CollectionIDs),
-		zap.Any("collID", collectionID))
-	collectionLoaded := false
-	for _, collID := range showResp.CollectionIDs {
-		if collectionID == collID {
-			collectionLoaded = true
-			break
+	for _, grant := range grt.Grants {
+		if err := ValidateGrant(grant); err != nil {
+			return err
 		}
 	}
-	if !collectionLoaded {
-		return fmt.Errorf("collection %v was not loaded into memory", collectionName)
+
+	return nil
+}
+
+func (grt *GrantRoleTask) Execute(ctx context.Context) (err error) {
+	grt.result, err = grt.rootCoord.GrantRole(ctx, grt.GrantRoleRequest)
+	if grt.result == nil {
+		return errors.New("GrantRole resp is nil")
+	}
+	if grt.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(grt.result.Reason)
 	}
+	return err
+}
 
-	// TODO(dragondriver): necessary to check if partition was loaded into query node?
+func (grt *GrantRoleTask) PostExecute(ctx context.Context) error {
+	return nil
+}
 
-	rt.Base.MsgType = commonpb.MsgType_Retrieve
-	if rt.retrieve.Ids == nil {
-		errMsg := "Retrieve ids is nil"
-		return errors.New(errMsg)
+type RevokeRoleTask struct {
+	Condition
+	*milvuspb.RevokeRoleRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (rrt *RevokeRoleTask) TraceCtx() context.Context {
+	return rrt.ctx
+}
+
+func (rrt *RevokeRoleTask) ID() UniqueID {
+	return rrt.Base.MsgID
+}
+
+func (rrt *RevokeRoleTask) SetID(uid UniqueID) {
+	rrt.Base.MsgID = uid
+}
+
+func (rrt *RevokeRoleTask) Name() string {
+	return RevokeRoleTaskName
+}
+
+func (rrt *RevokeRoleTask) Type() commonpb.MsgType {
+	return rrt.Base.MsgType
+}
+
+func (rrt *RevokeRoleTask) BeginTs() Timestamp {
+	return rrt.Base.Timestamp
+}
+
+func (rrt *RevokeRoleTask) EndTs() Timestamp {
+	return rrt.Base.Timestamp
+}
+
+func (rrt *RevokeRoleTask) SetTs(ts Timestamp) {
+	rrt.Base.Timestamp = ts
+}
+
+func (rrt *RevokeRoleTask) OnEnqueue() error {
+	rrt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (rrt *RevokeRoleTask) PreExecute(ctx context.Context) error {
+	rrt.Base.MsgType = commonpb.MsgType_RevokeRole
+	rrt.Base.SourceID = Params.ProxyID
+
+	if err := checkPrivilege(ctx, anyName, "", commonpb.Privilege_PrivilegeAdmin); err != nil {
+		return err
 	}
-	rt.Ids = rt.retrieve.Ids
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
-	if err != nil {
+	if err := ValidateRoleName(rrt.RoleName); err != nil {
 		return err
 	}
-	if len(rt.retrieve.OutputFields) == 0 {
-		for _, field := range schema.Fields {
-			if field.FieldID >= 100 && field.DataType != schemapb.DataType_FloatVector && field.DataType != schemapb.DataType_BinaryVector {
-				rt.OutputFields = append(rt.OutputFields, field.Name)
-			}
+	if rrt.Username != "" {
+		if err := ValidateUsername(rrt.Username); err != nil {
+			return err
 		}
-	} else {
-		for _, reqField := range rt.retrieve.OutputFields {
-			findField := false
-			addPrimaryKey := false
-			for _, field := range schema.Fields {
-				if reqField == field.Name {
-					if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector {
-						errMsg := "Query does not support vector field currently"
-						return errors.New(errMsg)
-					}
-					if field.IsPrimaryKey {
-						addPrimaryKey = true
-					}
-					findField = true
-					rt.OutputFields = append(rt.OutputFields, reqField)
-				} else {
-					if field.IsPrimaryKey && !addPrimaryKey {
-						rt.OutputFields = append(rt.OutputFields, field.Name)
-						addPrimaryKey = true
-					}
-				}
-			}
-			if !findField {
-				errMsg := "Field " + reqField + " not exist"
-				return errors.New(errMsg)
-			}
+	}
+	for _, grant := range rrt.Grants {
+		if err := ValidateGrant(grant); err != nil {
+			return err
 		}
 	}
 
-	travelTimestamp := rt.retrieve.TravelTimestamp
-	if travelTimestamp == 0 {
-		travelTimestamp = rt.BeginTs()
+	return nil
+}
+
+func (rrt *RevokeRoleTask) Execute(ctx context.Context) (err error) {
+	rrt.result, err = rrt.rootCoord.RevokeRole(ctx, rrt.RevokeRoleRequest)
+	if rrt.result == nil {
+		return errors.New("RevokeRole resp is nil")
 	}
-	guaranteeTimestamp := rt.retrieve.GuaranteeTimestamp
-	if guaranteeTimestamp == 0 {
-		guaranteeTimestamp = rt.BeginTs()
+	if rrt.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(rrt.result.Reason)
 	}
-	rt.RetrieveRequest.TravelTimestamp = travelTimestamp
-	rt.RetrieveRequest.GuaranteeTimestamp = guaranteeTimestamp
+	return err
+}
 
-	rt.ResultChannelID = Params.RetrieveResultChannelNames[0]
-	rt.DbID = 0 // todo(yukun)
+func (rrt *RevokeRoleTask) PostExecute(ctx context.Context) error {
+	return nil
+}
 
-	rt.CollectionID = collectionID
-	rt.PartitionIDs = make([]UniqueID, 0)
+type ListGrantsTask struct {
+	Condition
+	*milvuspb.ListGrantsRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *milvuspb.ListGrantsResponse
+}
+
+func (lgt *ListGrantsTask) TraceCtx() context.Context {
+	return lgt.ctx
+}
+
+func (lgt *ListGrantsTask) ID() UniqueID {
+	return lgt.Base.MsgID
+}
+
+func (lgt *ListGrantsTask) SetID(uid UniqueID) {
+	lgt.Base.MsgID = uid
+}
+
+func (lgt *ListGrantsTask) Name() string {
+	return ListGrantsTaskName
+}
+
+func (lgt *ListGrantsTask) Type() commonpb.MsgType {
+	return lgt.Base.MsgType
+}
+
+func (lgt *ListGrantsTask) BeginTs() Timestamp {
+	return lgt.Base.Timestamp
+}
+
+func (lgt *ListGrantsTask) EndTs() Timestamp {
+	return lgt.Base.Timestamp
+}
+
+func (lgt *ListGrantsTask) SetTs(ts Timestamp) {
+	lgt.Base.Timestamp = ts
+}
+
+func (lgt *ListGrantsTask) OnEnqueue() error {
+	lgt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (lgt *ListGrantsTask) PreExecute(ctx context.Context) error {
+	lgt.Base.MsgType = commonpb.MsgType_ListGrants
+	lgt.Base.SourceID = Params.ProxyID
+
+	// users can list their own grants, listing those of the others requires the admin privilege
+	if username, ok := getUserFromContext(ctx); !ok || lgt.Username != username {
+		if err := checkPrivilege(ctx, anyName, "", commonpb.Privilege_PrivilegeAdmin); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (lgt *ListGrantsTask) Execute(ctx context.Context) (err error) {
+	lgt.result, err = lgt.rootCoord.ListGrants(ctx, lgt.ListGrantsRequest)
+	if lgt.result == nil {
+		return errors.New("ListGrants resp is nil")
+	}
+	if lgt.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(lgt.result.Status.Reason)
+	}
+	return err
+}
+
+func (lgt *ListGrantsTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type CreateCollectionTask struct {
+	Condition
+	*milvuspb.CreateCollectionRequest
+	ctx             context.Context
+	rootCoord       types.RootCoord
+	dataCoordClient types.DataCoord
+	result          *commonpb.Status
+	schema          *schemapb.CollectionSchema
+}
+
+func (cct *CreateCollectionTask) TraceCtx() context.Context {
+	return cct.ctx
+}
+
+func (cct *CreateCollectionTask) ID() UniqueID {
+	return cct.Base.MsgID
+}
+
+func (cct *CreateCollectionTask) SetID(uid UniqueID) {
+	cct.Base.MsgID = uid
+}
+
+func (cct *CreateCollectionTask) Name() string {
+	return CreateCollectionTaskName
+}
+
+func (cct *CreateCollectionTask) Type() commonpb.MsgType {
+	return cct.Base.MsgType
+}
+
+func (cct *CreateCollectionTask) BeginTs() Timestamp {
+	return cct.Base.Timestamp
+}
+
+func (cct *CreateCollectionTask) EndTs() Timestamp {
+	return cct.Base.Timestamp
+}
+
+func (cct *CreateCollectionTask) SetTs(ts Timestamp) {
+	cct.Base.Timestamp = ts
+}
+
+func (cct *CreateCollectionTask) OnEnqueue() error {
+	cct.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (cct *CreateCollectionTask) PreExecute(ctx context.Context) error {
+	cct.Base.MsgType = commonpb.MsgType_CreateCollection
+	cct.Base.SourceID = Params.ProxyID
+
+	if err := checkPrivilege(ctx, cct.DbName, "", commonpb.Privilege_PrivilegeDDL); err != nil {
+		return err
+	}
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	cct.schema = &schemapb.CollectionSchema{}
+	err := proto.Unmarshal(cct.Schema, cct.schema)
+	cct.schema.AutoID = false
+	cct.CreateCollectionRequest.Schema, _ = proto.Marshal(cct.schema)
 	if err != nil {
-		log.Debug("Failed to get partitions in collection.", zap.Any("collectionName", collectionName),
-			zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
 		return err
 	}
-	log.Info("Get partitions in collection.", zap.Any("collectionName", collectionName),
-		zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
 
-	partitionsRecord := make(map[UniqueID]bool)
-	for _, partitionName := range rt.retrieve.PartitionNames {
-		pattern := fmt.Sprintf("^%s$", partitionName)
-		re, err := regexp.Compile(pattern)
-		if err != nil {
-			log.Debug("Failed to compile partition name regex expression.", zap.Any("partitionName", partitionName),
-				zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
-			return errors.New("invalid partition names")
+	if int64(len(cct.schema.Fields)) > Params.MaxFieldNum {
+		return fmt.Errorf("maximum field's number should be limited to %d", Params.MaxFieldNum)
+	}
+
+	// validate collection name
+	if err := ValidateCollectionName(cct.schema.Name); err != nil {
+		return err
+	}
+
+	if err := ValidateDuplicatedFieldName(cct.schema.Fields); err != nil {
+		return err
+	}
+
+	if err := ValidatePrimaryKey(cct.schema); err != nil {
+		return err
+	}
+
+	if err := ValidateFieldAutoID(cct.schema); err != nil {
+		return err
+	}
+
+	if err := ValidateCompression(cct.schema); err != nil {
+		return err
+	}
+
+	// validate field name
+	for _, field := range cct.schema.Fields {
+		if err := ValidateFieldName(field.Name); err != nil {
+			return err
 		}
-		found := false
-		for name, pID := range partitionsMap {
-			if re.MatchString(name) {
-				if _, exist := partitionsRecord[pID]; !exist {
-					rt.PartitionIDs = append(rt.PartitionIDs, pID)
-					partitionsRecord[pID] = true
+		if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector {
+			exist := false
+			var dim int64 = 0
+			for _, param := range field.TypeParams {
+				if param.Key == "dim" {
+					exist = true
+					tmp, err := strconv.ParseInt(param.Value, 10, 64)
+					if err != nil {
+						return err
+					}
+					dim = tmp
+					break
+				}
+			}
+			if !exist {
+				return errors.New("dimension is not defined in field type params")
+			}
+			if field.DataType == schemapb.DataType_FloatVector {
+				if err := ValidateDimension(dim, false); err != nil {
+					return err
+				}
+			} else {
+				if err := ValidateDimension(dim, true); err != nil {
+					return err
 				}
-				found = true
 			}
-		}
-		if !found {
-			// FIXME(wxyu): undefined behavior
-			errMsg := fmt.Sprintf("PartitonName: %s not found", partitionName)
-			return errors.New(errMsg)
 		}
 	}
 
-	log.Info("Retrieve PreExecute done.",
-		zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
 	return nil
 }
 
-func (rt *RetrieveTask) Execute(ctx context.Context) error {
-	var tsMsg msgstream.TsMsg = &msgstream.RetrieveMsg{
-		RetrieveRequest: *rt.RetrieveRequest,
-		BaseMsg: msgstream.BaseMsg{
-			Ctx:            ctx,
-			HashValues:     []uint32{uint32(Params.ProxyID)},
-			BeginTimestamp: rt.Base.Timestamp,
-			EndTimestamp:   rt.Base.Timestamp,
-		},
+func (cct *CreateCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	cct.result, err = cct.rootCoord.CreateCollection(ctx, cct.CreateCollectionRequest)
+	return err
+}
+
+func (cct *CreateCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type DropCollectionTask struct {
+	Condition
+	*milvuspb.DropCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+	chMgr     channelsMgr
+	chTicker  channelsTimeTicker
+}
+
+func (dct *DropCollectionTask) TraceCtx() context.Context {
+	return dct.ctx
+}
+
+func (dct *DropCollectionTask) ID() UniqueID {
+	return dct.Base.MsgID
+}
+
+func (dct *DropCollectionTask) SetID(uid UniqueID) {
+	dct.Base.MsgID = uid
+}
+
+func (dct *DropCollectionTask) Name() string {
+	return DropCollectionTaskName
+}
+
+func (dct *DropCollectionTask) Type() commonpb.MsgType {
+	return dct.Base.MsgType
+}
+
+func (dct *DropCollectionTask) BeginTs() Timestamp {
+	return dct.Base.Timestamp
+}
+
+func (dct *DropCollectionTask) EndTs() Timestamp {
+	return dct.Base.Timestamp
+}
+
+func (dct *DropCollectionTask) SetTs(ts Timestamp) {
+	dct.Base.Timestamp = ts
+}
+
+func (dct *DropCollectionTask) OnEnqueue() error {
+	dct.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (dct *DropCollectionTask) PreExecute(ctx context.Context) error {
+	dct.Base.MsgType = commonpb.MsgType_DropCollection
+	dct.Base.SourceID = Params.ProxyID
+
+	if err := checkPrivilege(ctx, dct.DbName, dct.CollectionName, commonpb.Privilege_PrivilegeDDL); err != nil {
+		return err
 	}
-	msgPack := msgstream.MsgPack{
-		BeginTs: rt.Base.Timestamp,
-		EndTs:   rt.Base.Timestamp,
-		Msgs:    make([]msgstream.TsMsg, 1),
+
+	if err := ValidateCollectionName(dct.CollectionName); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (dct *DropCollectionTask) Execute(ctx context.Context) error {
+	collID, err := globalMetaCache.GetCollectionID(ctx, dct.DbName, dct.CollectionName)
+	if err != nil {
+		return err
+	}
+
+	dct.result, err = dct.rootCoord.DropCollection(ctx, dct.DropCollectionRequest)
+	if err != nil {
+		return err
+	}
+
+	pchans, _ := dct.chMgr.getChannels(collID)
+	for _, pchan := range pchans {
+		_ = dct.chTicker.removePChan(pchan)
+	}
+
+	_ = dct.chMgr.removeDMLStream(collID)
+	_ = dct.chMgr.removeDQLStream(collID)
+
+	return nil
+}
+
+func (dct *DropCollectionTask) PostExecute(ctx context.Context) error {
+	globalMetaCache.RemoveCollection(ctx, dct.DbName, dct.CollectionName)
+	return nil
+}
+
+// replicaRoundRobin counts the requests routed to the replicas of the collections
+var replicaRoundRobin uint64
+
+// selectReplicas returns the replicas on service of the collection in the order to serve the request, the first
+// one is chosen round-robin and the others are failed over to in turn. It returns no replica if the collection
+// is loaded without replicas, and the request is served by all the query nodes of the collection then.
+func selectReplicas(ctx context.Context, collectionID UniqueID) ([]*querypb.ReplicaInfo, error) {
+	onService, err := globalMetaCache.GetReplicas(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(onService) == 0 {
+		return onService, nil
+	}
+
+	offset := int(atomic.AddUint64(&replicaRoundRobin, 1) % uint64(len(onService)))
+	replicas := make([]*querypb.ReplicaInfo, 0, len(onService))
+	replicas = append(replicas, onService[offset:]...)
+	replicas = append(replicas, onService[:offset]...)
+	return replicas, nil
+}
+
+type SearchTask struct {
+	Condition
+	*internalpb.SearchRequest
+	ctx       context.Context
+	resultBuf chan []*internalpb.SearchResults
+	result    *milvuspb.SearchResults
+	query     *milvuspb.SearchRequest
+	chMgr     channelsMgr
+	qc        types.QueryCoord
+	sessions  *clientSessions
+	offset    int64                  // the number of top hits of each query to skip
+	replicas  []*querypb.ReplicaInfo // the replicas to fail over to if the one serving the search fails
+}
+
+func (st *SearchTask) TraceCtx() context.Context {
+	return st.ctx
+}
+
+func (st *SearchTask) ID() UniqueID {
+	return st.Base.MsgID
+}
+
+func (st *SearchTask) SetID(uid UniqueID) {
+	st.Base.MsgID = uid
+}
+
+func (st *SearchTask) Name() string {
+	return SearchTaskName
+}
+
+func (st *SearchTask) Type() commonpb.MsgType {
+	return st.Base.MsgType
+}
+
+func (st *SearchTask) BeginTs() Timestamp {
+	return st.Base.Timestamp
+}
+
+func (st *SearchTask) EndTs() Timestamp {
+	return st.Base.Timestamp
+}
+
+func (st *SearchTask) SetTs(ts Timestamp) {
+	st.Base.Timestamp = ts
+}
+
+func (st *SearchTask) OnEnqueue() error {
+	st.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (st *SearchTask) getChannels() ([]pChan, error) {
+	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.DbName, st.query.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	return st.chMgr.getChannels(collID)
+}
+
+func (st *SearchTask) getVChannels() ([]vChan, error) {
+	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.DbName, st.query.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = st.chMgr.getChannels(collID)
+	if err != nil {
+		err := st.chMgr.createDMLMsgStream(collID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return st.chMgr.getVChannels(collID)
+}
+
+func (st *SearchTask) PreExecute(ctx context.Context) error {
+	st.Base.MsgType = commonpb.MsgType_Search
+	st.Base.SourceID = Params.ProxyID
+
+	if err := checkPrivilege(ctx, st.query.DbName, st.query.CollectionName, commonpb.Privilege_PrivilegeSearch); err != nil {
+		return err
+	}
+
+	collectionName := st.query.CollectionName
+	collID, err := globalMetaCache.GetCollectionID(ctx, st.query.DbName, collectionName)
+	if err != nil { // err is not nil if collection not exists
+		return err
+	}
+
+	if err := ValidateCollectionName(st.query.CollectionName); err != nil {
+		return err
+	}
+
+	for _, tag := range st.query.PartitionNames {
+		if err := ValidatePartitionTag(tag, false); err != nil {
+			return err
+		}
+	}
+
+	// check if collection was already loaded into query node
+	showResp, err := st.qc.ShowCollections(st.ctx, &querypb.ShowCollectionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_ShowCollections,
+			MsgID:     st.Base.MsgID,
+			Timestamp: st.Base.Timestamp,
+			SourceID:  Params.ProxyID,
+		},
+		DbID: 0, // TODO(dragondriver)
+	})
+	if err != nil {
+		return err
+	}
+	if showResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(showResp.Status.Reason)
+	}
+	log.Debug("query coordinator show collections",
+		zap.Any("collID", collID),
+		zap.Any("collections", showResp.CollectionIDs),
+	)
+	collectionLoaded := false
+	for _, collectionID := range showResp.CollectionIDs {
+		if collectionID == collID {
+			collectionLoaded = true
+			break
+		}
+	}
+	if !collectionLoaded {
+		return fmt.Errorf("collection %v was not loaded into memory", collectionName)
+	}
+
+	replicas, err := selectReplicas(ctx, collID)
+	if err != nil {
+		return err
+	}
+	if len(replicas) > 0 {
+		st.ReplicaID = replicas[0].ReplicaID
+		st.replicas = replicas[1:]
+	}
+
+	// TODO(dragondriver): necessary to check if partition was loaded into query node?
+
+	st.Base.MsgType = commonpb.MsgType_Search
+
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.DbName, collectionName)
+	if err != nil { // err is not nil if collection not exists
+		return err
+	}
+	if st.query.GetDslType() == commonpb.DslType_BoolExprV1 {
+		annsField, err := GetAttrByKeyFromRepeatedKV(AnnsFieldKey, st.query.SearchParams)
+		if err != nil {
+			return errors.New(AnnsFieldKey + " not found in search_params")
+		}
+
+		topKStr, err := GetAttrByKeyFromRepeatedKV(TopKKey, st.query.SearchParams)
+		if err != nil {
+			return errors.New(TopKKey + " not found in search_params")
+		}
+		topK, err := strconv.Atoi(topKStr)
+		if err != nil {
+			return errors.New(TopKKey + " " + topKStr + " is not invalid")
+		}
+		// offset is optional, the top hits of topk + offset are searched and the first offset hits are skipped
+		st.offset = 0
+		if offsetStr, err := GetAttrByKeyFromRepeatedKV(OffsetKey, st.query.SearchParams); err == nil {
+			st.offset, err = strconv.ParseInt(offsetStr, 10, 64)
+			if err != nil {
+				return errors.New(OffsetKey + " " + offsetStr + " is invalid")
+			}
+		}
+		if err := ValidateResultWindow(st.offset, int64(topK)); err != nil {
+			return err
+		}
+
+		metricType, err := GetAttrByKeyFromRepeatedKV(MetricTypeKey, st.query.SearchParams)
+		if err != nil {
+			return errors.New(MetricTypeKey + " not found in search_params")
+		}
+
+		searchParams, err := GetAttrByKeyFromRepeatedKV(SearchParamsKey, st.query.SearchParams)
+		if err != nil {
+			return errors.New(SearchParamsKey + " not found in search_params")
+		}
+
+		queryInfo := &planpb.QueryInfo{
+			Topk:         int64(topK) + st.offset,
+			MetricType:   metricType,
+			SearchParams: searchParams,
+		}
+		if err := parseRangeSearchParams(st.query.SearchParams, queryInfo); err != nil {
+			return err
+		}
+
+		plan, err := CreateQueryPlan(schema, st.query.Dsl, annsField, queryInfo)
+		if err != nil {
+			//return errors.New("invalid expression: " + st.query.Dsl)
+			return err
+		}
+		for _, name := range st.query.OutputFields {
+			hitField := false
+			for _, field := range schema.Fields {
+				if field.Name == name {
+					if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+						return errors.New("Search doesn't support vector field as output_fields")
+					}
+
+					st.SearchRequest.OutputFieldsId = append(st.SearchRequest.OutputFieldsId, field.FieldID)
+					plan.OutputFieldIds = append(plan.OutputFieldIds, field.FieldID)
+					hitField = true
+					break
+				}
+			}
+			if !hitField {
+				errMsg := "Field " + name + " not exist"
+				return errors.New(errMsg)
+			}
+		}
+
+		st.SearchRequest.DslType = commonpb.DslType_BoolExprV1
+		st.SearchRequest.SerializedExprPlan, err = proto.Marshal(plan)
+		if err != nil {
+			return err
+		}
+		log.Debug("Proxy::SearchTask::PreExecute", zap.Any("plan.OutputFieldIds", plan.OutputFieldIds),
+			zap.Any("plan", plan.String()))
+	}
+	travelTimestamp := st.query.TravelTimestamp
+	if travelTimestamp == 0 {
+		travelTimestamp = st.BeginTs()
+	} else if err := ValidateTravelTimestamp(travelTimestamp, st.BeginTs()); err != nil {
+		return err
+	}
+	guaranteeTimestamp := getGuaranteeTimestamp(ctx, st.query.ConsistencyLevel, st.query.GuaranteeTimestamp,
+		st.BeginTs(), st.sessions)
+	st.SearchRequest.TravelTimestamp = travelTimestamp
+	st.SearchRequest.GuaranteeTimestamp = guaranteeTimestamp
+
+	st.SearchRequest.ResultChannelID = Params.SearchResultChannelNames[0]
+	st.SearchRequest.DbID = 0 // todo
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, st.query.DbName, collectionName)
+	if err != nil { // err is not nil if collection not exists
+		return err
+	}
+	st.SearchRequest.CollectionID = collectionID
+	st.SearchRequest.PartitionIDs = make([]UniqueID, 0)
+
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, st.query.DbName, collectionName)
+	if err != nil {
+		return err
+	}
+
+	partitionsRecord := make(map[UniqueID]bool)
+	for _, partitionName := range st.query.PartitionNames {
+		pattern := fmt.Sprintf("^%s$", partitionName)
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return errors.New("invalid partition names")
+		}
+		found := false
+		for name, pID := range partitionsMap {
+			if re.MatchString(name) {
+				if _, exist := partitionsRecord[pID]; !exist {
+					st.PartitionIDs = append(st.PartitionIDs, pID)
+					partitionsRecord[pID] = true
+				}
+				found = true
+			}
+		}
+		if !found {
+			errMsg := fmt.Sprintf("PartitonName: %s not found", partitionName)
+			return errors.New(errMsg)
+		}
+	}
+
+	st.SearchRequest.Dsl = st.query.Dsl
+	st.SearchRequest.PlaceholderGroup = st.query.PlaceholderGroup
+
+	return nil
+}
+
+func (st *SearchTask) Execute(ctx context.Context) error {
+	var tsMsg msgstream.TsMsg = &msgstream.SearchMsg{
+		SearchRequest: *st.SearchRequest,
+		BaseMsg: msgstream.BaseMsg{
+			Ctx:            ctx,
+			HashValues:     []uint32{uint32(Params.ProxyID)},
+			BeginTimestamp: st.Base.Timestamp,
+			EndTimestamp:   st.Base.Timestamp,
+		},
+	}
+	msgPack := msgstream.MsgPack{
+		BeginTs: st.Base.Timestamp,
+		EndTs:   st.Base.Timestamp,
+		Msgs:    make([]msgstream.TsMsg, 1),
+	}
+	msgPack.Msgs[0] = tsMsg
+
+	collectionName := st.query.CollectionName
+	collID, err := globalMetaCache.GetCollectionID(ctx, st.query.DbName, collectionName)
+	if err != nil { // err is not nil if collection not exists
+		return err
+	}
+
+	stream, err := st.chMgr.getDQLStream(collID)
+	if err != nil {
+		err = st.chMgr.createDQLStream(collID)
+		if err != nil {
+			st.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			st.result.Status.Reason = err.Error()
+			return err
+		}
+		stream, err = st.chMgr.getDQLStream(collID)
+		if err != nil {
+			st.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			st.result.Status.Reason = err.Error()
+			return err
+		}
+	}
+	err = stream.Produce(&msgPack)
+	log.Debug("proxy", zap.Int("length of searchMsg", len(msgPack.Msgs)))
+	log.Debug("proxy sent one searchMsg",
+		zap.Any("collectionID", st.CollectionID),
+		zap.Any("msgID", tsMsg.ID()),
+		zap.Int64("replicaID", st.ReplicaID),
+	)
+	if err != nil {
+		log.Debug("proxy", zap.String("send search request failed", err.Error()))
+	}
+	return err
+}
+
+func decodeSearchResultsSerial(searchResults []*internalpb.SearchResults) ([]*schemapb.SearchResultData, error) {
+	log.Debug("reduceSearchResultDataParallel", zap.Any("lenOfSearchResults", len(searchResults)))
+
+	results := make([]*schemapb.SearchResultData, 0)
+	// necessary to parallel this?
+	for i, partialSearchResult := range searchResults {
+		log.Debug("decodeSearchResultsSerial", zap.Any("i", i), zap.Any("SlicedBob", partialSearchResult.SlicedBlob))
+		if partialSearchResult.SlicedBlob == nil {
+			continue
+		}
+
+		var partialResultData schemapb.SearchResultData
+		err := proto.Unmarshal(partialSearchResult.SlicedBlob, &partialResultData)
+		log.Debug("decodeSearchResultsSerial, Unmarshal partitalSearchResult.SliceBlob", zap.Error(err))
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, &partialResultData)
+	}
+	log.Debug("reduceSearchResultDataParallel", zap.Any("lenOfResults", len(results)))
+
+	return results, nil
+}
+
+func decodeSearchResults(searchResults []*internalpb.SearchResults) ([]*schemapb.SearchResultData, error) {
+	t := time.Now()
+	defer func() {
+		log.Debug("decodeSearchResults", zap.Any("time cost", time.Since(t)))
+	}()
+	return decodeSearchResultsSerial(searchResults)
+	// return decodeSearchResultsParallelByCPU(searchResults)
+}
+
+// parseRangeSearchParams parses the optional radius and range_filter of a range search, which returns at most
+// topk hits within the distance range. The result is marked truncated if some hits in the range may be missed.
+func parseRangeSearchParams(searchParams []*commonpb.KeyValuePair, queryInfo *planpb.QueryInfo) error {
+	radiusStr, err := GetAttrByKeyFromRepeatedKV(RadiusKey, searchParams)
+	if err != nil {
+		if _, err := GetAttrByKeyFromRepeatedKV(RangeFilterKey, searchParams); err == nil {
+			return errors.New(RangeFilterKey + " is set without " + RadiusKey)
+		}
+		return nil
+	}
+	radius, err := strconv.ParseFloat(radiusStr, 32)
+	if err != nil {
+		return errors.New(RadiusKey + " " + radiusStr + " is invalid")
+	}
+	queryInfo.RangeSearch = true
+	queryInfo.Radius = float32(radius)
+	if rangeFilterStr, err := GetAttrByKeyFromRepeatedKV(RangeFilterKey, searchParams); err == nil {
+		rangeFilter, err := strconv.ParseFloat(rangeFilterStr, 32)
+		if err != nil {
+			return errors.New(RangeFilterKey + " " + rangeFilterStr + " is invalid")
+		}
+		queryInfo.HasRangeFilter = true
+		queryInfo.RangeFilter = float32(rangeFilter)
+	}
+	return ValidateRangeSearch(queryInfo)
+}
+
+// getSearchResultHitRanges returns the start and the number of hits of each query in a partial search result,
+// which holds the numbers of hits of the queries in Topks, or topk hits of each query padded with invalid hits
+func getSearchResultHitRanges(data *schemapb.SearchResultData, nq, topk int) ([]int, []int) {
+	starts := make([]int, nq)
+	sizes := make([]int, nq)
+	start := 0
+	for i := 0; i < nq; i++ {
+		size := topk
+		if len(data.Topks) == nq {
+			size = int(data.Topks[i])
+		}
+		if start+size > len(data.Scores) {
+			size = getMax(len(data.Scores)-start, 0)
+		}
+		starts[i] = start
+		sizes[i] = size
+		start += size
+	}
+	return starts, sizes
+}
+
+// reduceSearchResultDataParallel merges the top hits of each query from query nodes, the first offset hits
+// of each query are skipped and topk is the max number of hits of each query in the partial results. The
+// queries may have different numbers of hits, e.g. in range search.
+func reduceSearchResultDataParallel(searchResultData []*schemapb.SearchResultData, nq, availableQueryNodeNum, topk, offset int, metricType string, maxParallel int) (*milvuspb.SearchResults, error) {
+	log.Debug("reduceSearchResultDataParallel", zap.Any("lenOfsearchResultData", len(searchResultData)),
+		zap.Any("nq", nq), zap.Any("availableQueryNodeNum", availableQueryNodeNum),
+		zap.Any("topk", topk), zap.Any("offset", offset), zap.Any("metricType", metricType),
+		zap.Any("maxParallel", maxParallel))
+
+	numFields := 0
+	for i, sData := range searchResultData {
+		log.Debug("reduceSearchResultDataParallel", zap.Any("i", i), zap.Any("len(FieldsData)", len(sData.FieldsData)))
+		numFields = getMax(numFields, len(sData.FieldsData))
+	}
+
+	ret := &milvuspb.SearchResults{
+		Status: &commonpb.Status{
+			ErrorCode: 0,
+		},
+		Results: &schemapb.SearchResultData{
+			NumQueries: int64(nq),
+			TopK:       int64(topk),
+			FieldsData: make([]*schemapb.FieldData, numFields),
+			Scores:     make([]float32, 0),
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: make([]int64, 0),
+					},
+				},
+			},
+			Topks: make([]int64, 0),
+		},
+	}
+	if searchResultData[0].GetIds().GetStrId() != nil {
+		ret.Results.Ids.IdField = &schemapb.IDs_StrId{
+			StrId: &schemapb.StringArray{
+				Data: make([]string, 0),
+			},
+		}
+	}
+
+	starts := make([][]int, availableQueryNodeNum)
+	sizes := make([][]int, availableQueryNodeNum)
+	for q := 0; q < availableQueryNodeNum; q++ {
+		starts[q], sizes[q] = getSearchResultHitRanges(searchResultData[q], nq, topk)
+		ret.Results.RangeSearchTruncated = ret.Results.RangeSearchTruncated || searchResultData[q].GetRangeSearchTruncated()
+	}
+	if ret.Results.RangeSearchTruncated {
+		// the search succeeds still, the reason tells that the hits are not complete
+		ret.Status.Reason = "range search result is truncated, more hits of a segment are within the distance range " +
+			"than can be searched, narrow the range to get all of them"
+	}
+
+	const minFloat32 = -1 * float32(math.MaxFloat32)
+
+	// TODO(yukun): Use parallel function
+	maxHits := 0
+	for idx := 0; idx < nq; idx++ {
+		locs := make([]int, availableQueryNodeNum)
+		// a segment may be searched on two query nodes while it's moved between them, the duplicated hits are skipped
+		pks := make(map[interface{}]struct{})
+
+		j := 0
+		for ; j < topk; j++ {
+			valid := false
+			choice, maxDistance := 0, minFloat32
+			for q, loc := range locs { // query num, the number of ways to merge
+				if loc >= sizes[q][idx] {
+					continue
+				}
+				distance := searchResultData[q].Scores[starts[q][idx]+loc]
+				if distance > maxDistance || (math.Abs(float64(distance-maxDistance)) < math.SmallestNonzeroFloat32 && choice != q) {
+					choice = q
+					maxDistance = distance
+					valid = true
+				}
+			}
+			if !valid {
+				break
+			}
+			curIdx := starts[choice][idx] + locs[choice]
+			// check if distance is valid, `invalid` here means very very big,
+			// in this process, distance here is the smallest, so the rest of distance are all invalid
+			if searchResultData[choice].Scores[curIdx] <= minFloat32 {
+				break
+			}
+			locs[choice]++
+			var pk interface{}
+			if searchResultData[choice].Ids.GetStrId() != nil {
+				pk = searchResultData[choice].Ids.GetStrId().GetData()[curIdx]
+			} else {
+				pk = searchResultData[choice].Ids.GetIntId().GetData()[curIdx]
+			}
+			if _, ok := pks[pk]; ok {
+				j--
+				continue
+			}
+			pks[pk] = struct{}{}
+			if j < offset {
+				continue
+			}
+			switch ids := ret.Results.Ids.IdField.(type) {
+			case *schemapb.IDs_IntId:
+				ids.IntId.Data = append(ids.IntId.Data, searchResultData[choice].Ids.GetIntId().GetData()[curIdx])
+			case *schemapb.IDs_StrId:
+				ids.StrId.Data = append(ids.StrId.Data, searchResultData[choice].Ids.GetStrId().GetData()[curIdx])
+			}
+			typeutil.AppendFieldData(ret.Results.FieldsData, searchResultData[choice].FieldsData, int64(curIdx))
+			ret.Results.Scores = append(ret.Results.Scores, searchResultData[choice].Scores[curIdx])
+		}
+		hits := getMax(j-offset, 0)
+		maxHits = getMax(maxHits, hits)
+		ret.Results.Topks = append(ret.Results.Topks, int64(hits))
+	}
+
+	ret.Results.TopK = int64(maxHits)
+	if len(ret.Results.Scores) == 0 {
+		ret.Results.FieldsData = make([]*schemapb.FieldData, 0)
+	}
+
+	if metricType != "IP" {
+		for k := range ret.Results.Scores {
+			ret.Results.Scores[k] *= -1
+		}
+	}
+
+	return ret, nil
+}
+
+func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq, availableQueryNodeNum, topk, offset int, metricType string) (*milvuspb.SearchResults, error) {
+	t := time.Now()
+	defer func() {
+		log.Debug("reduceSearchResults", zap.Any("time cost", time.Since(t)))
+	}()
+	return reduceSearchResultDataParallel(searchResultData, nq, availableQueryNodeNum, topk, offset, metricType, runtime.NumCPU())
+}
+
+func printSearchResult(partialSearchResult *internalpb.SearchResults) {
+	for i := 0; i < len(partialSearchResult.Hits); i++ {
+		testHits := milvuspb.Hits{}
+		err := proto.Unmarshal(partialSearchResult.Hits[i], &testHits)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(testHits.IDs)
+		fmt.Println(testHits.Scores)
+	}
+}
+
+func (st *SearchTask) PostExecute(ctx context.Context) error {
+	t0 := time.Now()
+	defer func() {
+		log.Debug("WaitAndPostExecute", zap.Any("time cost", time.Since(t0)))
+	}()
+	// the replica is failed over if any query node of it fails, or it doesn't return all the results in time
+	// as some query node is down
+	replicaTimeout := st.replicaTimeout()
+	for {
+		select {
+		case <-st.TraceCtx().Done():
+			log.Debug("Proxy", zap.Int64("SearchTask PostExecute Loop exit caused by ctx.Done", st.ID()))
+			return fmt.Errorf("SearchTask:wait to finish failed, timeout: %d", st.ID())
+		case <-replicaTimeout:
+			reason := fmt.Sprintf("replica %d didn't return all the results in %v", st.ReplicaID, Params.ReplicaTimeout)
+			if !st.failover(ctx, reason) {
+				replicaTimeout = nil
+				continue
+			}
+			replicaTimeout = st.replicaTimeout()
+		case searchResults := <-st.resultBuf:
+			// fmt.Println("searchResults: ", searchResults)
+			if len(searchResults) > 0 && searchResults[0].ReplicaID != st.ReplicaID {
+				log.Debug("Proxy Search PostExecute ignore the results of the replica failed over",
+					zap.Int64("replicaID", searchResults[0].ReplicaID))
+				continue
+			}
+			if reason := getSearchFailedReason(searchResults); reason != "" && st.failover(ctx, reason) {
+				replicaTimeout = st.replicaTimeout()
+				continue
+			}
+			filterSearchResult := make([]*internalpb.SearchResults, 0)
+			var filterReason string
+			for _, partialSearchResult := range searchResults {
+				if partialSearchResult.Status.ErrorCode == commonpb.ErrorCode_Success {
+					filterSearchResult = append(filterSearchResult, partialSearchResult)
+					// For debugging, please don't delete.
+					// printSearchResult(partialSearchResult)
+				} else {
+					filterReason += partialSearchResult.Status.Reason + "\n"
+				}
+			}
+
+			availableQueryNodeNum := len(filterSearchResult)
+			log.Debug("Proxy Search PostExecute stage1", zap.Any("availableQueryNodeNum", availableQueryNodeNum))
+			if availableQueryNodeNum <= 0 {
+				log.Debug("Proxy Search PostExecute failed", zap.Any("filterReason", filterReason))
+				st.result = &milvuspb.SearchResults{
+					Status: &commonpb.Status{
+						ErrorCode: commonpb.ErrorCode_UnexpectedError,
+						Reason:    filterReason,
+					},
+				}
+				return errors.New(filterReason)
+			}
+
+			availableQueryNodeNum = 0
+			for _, partialSearchResult := range filterSearchResult {
+				if partialSearchResult.SlicedBlob == nil {
+					filterReason += "nq is zero\n"
+					continue
+				}
+				availableQueryNodeNum++
+			}
+			log.Debug("Proxy Search PostExecute stage2", zap.Any("availableQueryNodeNum", availableQueryNodeNum))
+
+			if availableQueryNodeNum <= 0 {
+				log.Debug("Proxy Search PostExecute stage2 failed", zap.Any("filterReason", filterReason))
+
+				st.result = &milvuspb.SearchResults{
+					Status: &commonpb.Status{
+						ErrorCode: commonpb.ErrorCode_Success,
+						Reason:    filterReason,
+					},
+				}
+				return nil
+			}
+
+			results, err := decodeSearchResults(filterSearchResult)
+			log.Debug("Proxy Search PostExecute decodeSearchResults", zap.Error(err))
+			if err != nil {
+				return err
+			}
+
+			nq := results[0].NumQueries
+			topk := 0
+			for _, partialResult := range results {
+				topk = getMax(topk, int(partialResult.TopK))
+			}
+			if nq <= 0 {
+				st.result = &milvuspb.SearchResults{
+					Status: &commonpb.Status{
+						ErrorCode: commonpb.ErrorCode_Success,
+						Reason:    filterReason,
+					},
+				}
+				return nil
+			}
+
+			st.result, err = reduceSearchResultData(results, int(nq), availableQueryNodeNum, topk, int(st.offset), searchResults[0].MetricType)
+			if err != nil {
+				return err
+			}
+
+			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.DbName, st.query.CollectionName)
+			if err != nil {
+				return err
+			}
+			if len(st.query.OutputFields) != 0 && len(st.result.Results.FieldsData) != 0 {
+				for k, fieldName := range st.query.OutputFields {
+					for _, field := range schema.Fields {
+						if st.result.Results.FieldsData[k] != nil && field.Name == fieldName {
+							st.result.Results.FieldsData[k].FieldName = fieldName
+							st.result.Results.FieldsData[k].Type = field.DataType
+						}
+					}
+				}
+			}
+			log.Debug("Proxy Search PostExecute Done")
+			return nil
+		}
+	}
+}
+
+// replicaTimeout returns the channel signaled when the replica serving the search times out, it's nil if there is
+// no replica to fail over to
+func (st *SearchTask) replicaTimeout() <-chan time.Time {
+	if len(st.replicas) == 0 || Params.ReplicaTimeout <= 0 {
+		return nil
+	}
+	return time.After(Params.ReplicaTimeout)
+}
+
+// getSearchFailedReason returns the reason of the first failed query node, it's empty if all of them succeeded
+func getSearchFailedReason(searchResults []*internalpb.SearchResults) string {
+	for _, partialSearchResult := range searchResults {
+		if partialSearchResult.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return partialSearchResult.Status.Reason
+		}
+	}
+	return ""
+}
+
+// failover resends the search to the next replica as the current one failed for the reason,
+// and returns whether the search is resent
+func (st *SearchTask) failover(ctx context.Context, reason string) bool {
+	if len(st.replicas) == 0 {
+		return false
+	}
+	// the replicas of the collection may have changed, they are fetched from QueryCoord again by the next search
+	globalMetaCache.RemoveReplicas(ctx, st.CollectionID)
+
+	failedReplicaID := st.ReplicaID
+	st.ReplicaID = st.replicas[0].ReplicaID
+	st.replicas = st.replicas[1:]
+	log.Warn("Proxy Search fail over to another replica",
+		zap.Int64("msgID", st.ID()),
+		zap.Int64("failedReplicaID", failedReplicaID),
+		zap.Int64("replicaID", st.ReplicaID),
+		zap.String("reason", reason))
+	if err := st.Execute(ctx); err != nil {
+		log.Warn("Proxy Search fail over failed", zap.Int64("msgID", st.ID()), zap.Error(err))
+		st.ReplicaID = failedReplicaID
+		return false
+	}
+	return true
+}
+
+type RetrieveTask struct {
+	Condition
+	*internalpb.RetrieveRequest
+	ctx       context.Context
+	resultBuf chan []*internalpb.RetrieveResults
+	result    *milvuspb.RetrieveResults
+	retrieve  *milvuspb.RetrieveRequest
+	chMgr     channelsMgr
+	qc        types.QueryCoord
+	sessions  *clientSessions
+}
+
+func (rt *RetrieveTask) TraceCtx() context.Context {
+	return rt.ctx
+}
+
+func (rt *RetrieveTask) ID() UniqueID {
+	return rt.Base.MsgID
+}
+
+func (rt *RetrieveTask) SetID(uid UniqueID) {
+	rt.Base.MsgID = uid
+}
+
+func (rt *RetrieveTask) Name() string {
+	return RetrieveTaskName
+}
+
+func (rt *RetrieveTask) Type() commonpb.MsgType {
+	return rt.Base.MsgType
+}
+
+func (rt *RetrieveTask) BeginTs() Timestamp {
+	return rt.Base.Timestamp
+}
+
+func (rt *RetrieveTask) EndTs() Timestamp {
+	return rt.Base.Timestamp
+}
+
+func (rt *RetrieveTask) SetTs(ts Timestamp) {
+	rt.Base.Timestamp = ts
+}
+
+func (rt *RetrieveTask) OnEnqueue() error {
+	rt.Base.MsgType = commonpb.MsgType_Retrieve
+	return nil
+}
+
+func (rt *RetrieveTask) getChannels() ([]pChan, error) {
+	collID, err := globalMetaCache.GetCollectionID(rt.ctx, rt.retrieve.DbName, rt.retrieve.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	return rt.chMgr.getChannels(collID)
+}
+
+func (rt *RetrieveTask) getVChannels() ([]vChan, error) {
+	collID, err := globalMetaCache.GetCollectionID(rt.ctx, rt.retrieve.DbName, rt.retrieve.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = rt.chMgr.getChannels(collID)
+	if err != nil {
+		err := rt.chMgr.createDMLMsgStream(collID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rt.chMgr.getVChannels(collID)
+}
+
+func (rt *RetrieveTask) PreExecute(ctx context.Context) error {
+	rt.Base.MsgType = commonpb.MsgType_Retrieve
+	rt.Base.SourceID = Params.ProxyID
+
+	if err := checkPrivilege(ctx, rt.retrieve.DbName, rt.retrieve.CollectionName, commonpb.Privilege_PrivilegeQuery); err != nil {
+		return err
+	}
+
+	collectionName := rt.retrieve.CollectionName
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, rt.retrieve.DbName, collectionName)
+	if err != nil {
+		log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
+			zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+		return err
+	}
+	log.Info("Get collection id by name.", zap.Any("collectionName", collectionName),
+		zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+
+	if err := ValidateCollectionName(rt.retrieve.CollectionName); err != nil {
+		log.Debug("Invalid collection name.", zap.Any("collectionName", collectionName),
+			zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+		return err
+	}
+	log.Info("Validate collection name.", zap.Any("collectionName", collectionName),
+		zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+
+	for _, tag := range rt.retrieve.PartitionNames {
+		if err := ValidatePartitionTag(tag, false); err != nil {
+			log.Debug("Invalid partition name.", zap.Any("partitionName", tag),
+				zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+			return err
+		}
+	}
+	log.Info("Validate partition names.",
+		zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+
+	// check if collection was already loaded into query node
+	showResp, err := rt.qc.ShowCollections(rt.ctx, &querypb.ShowCollectionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_ShowCollections,
+			MsgID:     rt.Base.MsgID,
+			Timestamp: rt.Base.Timestamp,
+			SourceID:  Params.ProxyID,
+		},
+		DbID: 0, // TODO(dragondriver)
+	})
+	if err != nil {
+		return err
+	}
+	if showResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(showResp.Status.Reason)
+	}
+	log.Debug("query coordinator show collections",
+		zap.Any("collections", showResp.CollectionIDs),
+		zap.Any("collID", collectionID))
+	collectionLoaded := false
+	for _, collID := range showResp.CollectionIDs {
+		if collectionID == collID {
+			collectionLoaded = true
+			break
+		}
+	}
+	if !collectionLoaded {
+		return fmt.Errorf("collection %v was not loaded into memory", collectionName)
+	}
+
+	replicas, err := selectReplicas(ctx, collectionID)
+	if err != nil {
+		return err
+	}
+	if len(replicas) > 0 {
+		rt.ReplicaID = replicas[0].ReplicaID
+	}
+
+	// TODO(dragondriver): necessary to check if partition was loaded into query node?
+
+	rt.Base.MsgType = commonpb.MsgType_Retrieve
+	if rt.retrieve.Ids == nil {
+		errMsg := "Retrieve ids is nil"
+		return errors.New(errMsg)
+	}
+	rt.Ids = rt.retrieve.Ids
+	if err := ValidateResultWindow(rt.retrieve.Offset, rt.retrieve.Limit); err != nil {
+		return err
+	}
+	// each query node returns the rows of the smallest offset + limit primary keys, which are enough to
+	// reduce the global page
+	if rt.retrieve.Limit > 0 {
+		rt.Limit = rt.retrieve.Offset + rt.retrieve.Limit
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, rt.retrieve.DbName, collectionName)
+	if err != nil {
+		return err
+	}
+	if len(rt.retrieve.OutputFields) == 0 {
+		for _, field := range schema.Fields {
+			if field.FieldID >= 100 && field.DataType != schemapb.DataType_FloatVector && field.DataType != schemapb.DataType_BinaryVector {
+				rt.OutputFields = append(rt.OutputFields, field.Name)
+			}
+		}
+	} else {
+		for _, reqField := range rt.retrieve.OutputFields {
+			findField := false
+			addPrimaryKey := false
+			for _, field := range schema.Fields {
+				if reqField == field.Name {
+					if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector {
+						errMsg := "Query does not support vector field currently"
+						return errors.New(errMsg)
+					}
+					if field.IsPrimaryKey {
+						addPrimaryKey = true
+					}
+					findField = true
+					rt.OutputFields = append(rt.OutputFields, reqField)
+				} else {
+					if field.IsPrimaryKey && !addPrimaryKey {
+						rt.OutputFields = append(rt.OutputFields, field.Name)
+						addPrimaryKey = true
+					}
+				}
+			}
+			if !findField {
+				errMsg := "Field " + reqField + " not exist"
+				return errors.New(errMsg)
+			}
+		}
+	}
+
+	travelTimestamp := rt.retrieve.TravelTimestamp
+	if travelTimestamp == 0 {
+		travelTimestamp = rt.BeginTs()
+	} else if err := ValidateTravelTimestamp(travelTimestamp, rt.BeginTs()); err != nil {
+		return err
+	}
+	guaranteeTimestamp := getGuaranteeTimestamp(ctx, rt.retrieve.ConsistencyLevel, rt.retrieve.GuaranteeTimestamp,
+		rt.BeginTs(), rt.sessions)
+	rt.RetrieveRequest.TravelTimestamp = travelTimestamp
+	rt.RetrieveRequest.GuaranteeTimestamp = guaranteeTimestamp
+
+	rt.ResultChannelID = Params.RetrieveResultChannelNames[0]
+	rt.DbID = 0 // todo(yukun)
+
+	rt.CollectionID = collectionID
+	rt.PartitionIDs = make([]UniqueID, 0)
+
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, rt.retrieve.DbName, collectionName)
+	if err != nil {
+		log.Debug("Failed to get partitions in collection.", zap.Any("collectionName", collectionName),
+			zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+		return err
+	}
+	log.Info("Get partitions in collection.", zap.Any("collectionName", collectionName),
+		zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+
+	partitionsRecord := make(map[UniqueID]bool)
+	for _, partitionName := range rt.retrieve.PartitionNames {
+		pattern := fmt.Sprintf("^%s$", partitionName)
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			log.Debug("Failed to compile partition name regex expression.", zap.Any("partitionName", partitionName),
+				zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+			return errors.New("invalid partition names")
+		}
+		found := false
+		for name, pID := range partitionsMap {
+			if re.MatchString(name) {
+				if _, exist := partitionsRecord[pID]; !exist {
+					rt.PartitionIDs = append(rt.PartitionIDs, pID)
+					partitionsRecord[pID] = true
+				}
+				found = true
+			}
+		}
+		if !found {
+			// FIXME(wxyu): undefined behavior
+			errMsg := fmt.Sprintf("PartitonName: %s not found", partitionName)
+			return errors.New(errMsg)
+		}
+	}
+
+	log.Info("Retrieve PreExecute done.",
+		zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+	return nil
+}
+
+func (rt *RetrieveTask) Execute(ctx context.Context) error {
+	var tsMsg msgstream.TsMsg = &msgstream.RetrieveMsg{
+		RetrieveRequest: *rt.RetrieveRequest,
+		BaseMsg: msgstream.BaseMsg{
+			Ctx:            ctx,
+			HashValues:     []uint32{uint32(Params.ProxyID)},
+			BeginTimestamp: rt.Base.Timestamp,
+			EndTimestamp:   rt.Base.Timestamp,
+		},
+	}
+	msgPack := msgstream.MsgPack{
+		BeginTs: rt.Base.Timestamp,
+		EndTs:   rt.Base.Timestamp,
+		Msgs:    make([]msgstream.TsMsg, 1),
+	}
+	msgPack.Msgs[0] = tsMsg
+
+	collectionName := rt.retrieve.CollectionName
+	collID, err := globalMetaCache.GetCollectionID(ctx, rt.retrieve.DbName, collectionName)
+	if err != nil {
+		return err
+	}
+
+	stream, err := rt.chMgr.getDQLStream(collID)
+	if err != nil {
+		err = rt.chMgr.createDQLStream(collID)
+		if err != nil {
+			rt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			rt.result.Status.Reason = err.Error()
+			return err
+		}
+		stream, err = rt.chMgr.getDQLStream(collID)
+		if err != nil {
+			rt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			rt.result.Status.Reason = err.Error()
+			return err
+		}
+	}
+	err = stream.Produce(&msgPack)
+	log.Debug("proxy", zap.Int("length of retrieveMsg", len(msgPack.Msgs)))
+	if err != nil {
+		log.Debug("Failed to send retrieve request.",
+			zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+	}
+
+	log.Info("Retrieve Execute done.",
+		zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+	return err
+}
+
+func (rt *RetrieveTask) PostExecute(ctx context.Context) error {
+	t0 := time.Now()
+	defer func() {
+		log.Debug("WaitAndPostExecute", zap.Any("time cost", time.Since(t0)))
+	}()
+	select {
+	case <-rt.TraceCtx().Done():
+		log.Debug("proxy", zap.Int64("Retrieve: wait to finish failed, timeout!, taskID:", rt.ID()))
+		return fmt.Errorf("RetrieveTask:wait to finish failed, timeout : %d", rt.ID())
+	case retrieveResults := <-rt.resultBuf:
+		retrieveResult := make([]*internalpb.RetrieveResults, 0)
+		var reason string
+		for _, partialRetrieveResult := range retrieveResults {
+			if partialRetrieveResult.Status.ErrorCode == commonpb.ErrorCode_Success {
+				retrieveResult = append(retrieveResult, partialRetrieveResult)
+			} else {
+				reason += partialRetrieveResult.Status.Reason + "\n"
+			}
+		}
+
+		if len(retrieveResult) == 0 {
+			rt.result = &milvuspb.RetrieveResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    reason,
+				},
+			}
+			log.Debug("Retrieve failed on all querynodes.",
+				zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+			return errors.New(reason)
+		}
+
+		availableQueryNodeNum := 0
+		validResults := make([]*internalpb.RetrieveResults, 0, len(retrieveResult))
+		for _, partialRetrieveResult := range retrieveResult {
+			availableQueryNodeNum++
+			if partialRetrieveResult.Ids == nil {
+				reason += "ids is nil\n"
+				continue
+			}
+			if partialRetrieveResult.Ids.GetIntId() == nil && partialRetrieveResult.Ids.GetStrId() == nil {
+				reason += "ids is empty\n"
+				continue
+			}
+			validResults = append(validResults, partialRetrieveResult)
+		}
+		rt.result = reduceRetrieveResults(validResults, rt.retrieve.Offset, rt.retrieve.Limit)
+
+		if availableQueryNodeNum == 0 {
+			log.Info("Not any valid result found.",
+				zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+			rt.result = &milvuspb.RetrieveResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    reason,
+				},
+			}
+			return nil
+		}
+
+		if len(rt.result.FieldsData) == 0 {
+			log.Info("Retrieve result is nil.",
+				zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+			rt.result = &milvuspb.RetrieveResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_EmptyCollection,
+					Reason:    reason,
+				},
+			}
+			return nil
+		}
+
+		schema, err := globalMetaCache.GetCollectionSchema(ctx, rt.retrieve.DbName, rt.retrieve.CollectionName)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < len(rt.result.FieldsData); i++ {
+			for _, field := range schema.Fields {
+				if field.Name == rt.OutputFields[i] {
+					rt.result.FieldsData[i].FieldName = field.Name
+					rt.result.FieldsData[i].Type = field.DataType
+				}
+			}
+		}
+	}
+
+	log.Info("Retrieve PostExecute done.",
+		zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+	return nil
+}
+
+// reduceRetrieveResults merges the partial results sorted by primary key from query nodes, the rows of a
+// primary key retrieved by several query nodes are kept once. The first offset rows are skipped and at most
+// limit rows are returned if limit is positive.
+func reduceRetrieveResults(partialResults []*internalpb.RetrieveResults, offset, limit int64) *milvuspb.RetrieveResults {
+	ret := &milvuspb.RetrieveResults{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Ids:        &schemapb.IDs{},
+		FieldsData: make([]*schemapb.FieldData, 0),
+	}
+
+	locs := make([]int, len(partialResults))
+	var lastPK interface{}
+	skipped := int64(0)
+	for limit <= 0 || int64(typeutil.GetSizeOfIDs(ret.Ids)) < limit {
+		choice := -1
+		var choicePK interface{}
+		for i, partialResult := range partialResults {
+			if locs[i] >= typeutil.GetSizeOfIDs(partialResult.Ids) {
+				continue
+			}
+			pk := typeutil.GetPK(partialResult.Ids, locs[i])
+			if choice == -1 || typeutil.ComparePK(pk, choicePK) < 0 {
+				choice, choicePK = i, pk
+			}
+		}
+		if choice == -1 {
+			break
+		}
+		idx := locs[choice]
+		locs[choice]++
+		if lastPK != nil && typeutil.ComparePK(lastPK, choicePK) == 0 {
+			continue
+		}
+		lastPK = choicePK
+		if skipped < offset {
+			skipped++
+			continue
+		}
+
+		typeutil.AppendPK(ret.Ids, choicePK)
+		if len(ret.FieldsData) == 0 {
+			ret.FieldsData = make([]*schemapb.FieldData, len(partialResults[choice].FieldsData))
+		}
+		typeutil.AppendFieldData(ret.FieldsData, partialResults[choice].FieldsData, int64(idx))
+	}
+	return ret
+}
+
+type HasCollectionTask struct {
+	Condition
+	*milvuspb.HasCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *milvuspb.BoolResponse
+}
+
+func (hct *HasCollectionTask) TraceCtx() context.Context {
+	return hct.ctx
+}
+
+func (hct *HasCollectionTask) ID() UniqueID {
+	return hct.Base.MsgID
+}
+
+func (hct *HasCollectionTask) SetID(uid UniqueID) {
+	hct.Base.MsgID = uid
+}
+
+func (hct *HasCollectionTask) Name() string {
+	return HasCollectionTaskName
+}
+
+func (hct *HasCollectionTask) Type() commonpb.MsgType {
+	return hct.Base.MsgType
+}
+
+func (hct *HasCollectionTask) BeginTs() Timestamp {
+	return hct.Base.Timestamp
+}
+
+func (hct *HasCollectionTask) EndTs() Timestamp {
+	return hct.Base.Timestamp
+}
+
+func (hct *HasCollectionTask) SetTs(ts Timestamp) {
+	hct.Base.Timestamp = ts
+}
+
+func (hct *HasCollectionTask) OnEnqueue() error {
+	hct.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (hct *HasCollectionTask) PreExecute(ctx context.Context) error {
+	hct.Base.MsgType = commonpb.MsgType_HasCollection
+	hct.Base.SourceID = Params.ProxyID
+
+	if err := checkPrivilege(ctx, hct.DbName, hct.CollectionName, readPrivileges...); err != nil {
+		return err
+	}
+
+	if err := ValidateCollectionName(hct.CollectionName); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (hct *HasCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	hct.result, err = hct.rootCoord.HasCollection(ctx, hct.HasCollectionRequest)
+	if hct.result == nil {
+		return errors.New("has collection resp is nil")
+	}
+	if hct.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(hct.result.Status.Reason)
+	}
+	return err
+}
+
+func (hct *HasCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type DescribeCollectionTask struct {
+	Condition
+	*milvuspb.DescribeCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *milvuspb.DescribeCollectionResponse
+}
+
+func (dct *DescribeCollectionTask) TraceCtx() context.Context {
+	return dct.ctx
+}
+
+func (dct *DescribeCollectionTask) ID() UniqueID {
+	return dct.Base.MsgID
+}
+
+func (dct *DescribeCollectionTask) SetID(uid UniqueID) {
+	dct.Base.MsgID = uid
+}
+
+func (dct *DescribeCollectionTask) Name() string {
+	return DescribeCollectionTaskName
+}
+
+func (dct *DescribeCollectionTask) Type() commonpb.MsgType {
+	return dct.Base.MsgType
+}
+
+func (dct *DescribeCollectionTask) BeginTs() Timestamp {
+	return dct.Base.Timestamp
+}
+
+func (dct *DescribeCollectionTask) EndTs() Timestamp {
+	return dct.Base.Timestamp
+}
+
+func (dct *DescribeCollectionTask) SetTs(ts Timestamp) {
+	dct.Base.Timestamp = ts
+}
+
+func (dct *DescribeCollectionTask) OnEnqueue() error {
+	dct.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (dct *DescribeCollectionTask) PreExecute(ctx context.Context) error {
+	dct.Base.MsgType = commonpb.MsgType_DescribeCollection
+	dct.Base.SourceID = Params.ProxyID
+
+	if err := checkPrivilege(ctx, dct.DbName, dct.CollectionName, readPrivileges...); err != nil {
+		return err
+	}
+
+	if err := ValidateCollectionName(dct.CollectionName); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (dct *DescribeCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	dct.result = &milvuspb.DescribeCollectionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Schema: &schemapb.CollectionSchema{
+			Name:        "",
+			Description: "",
+			AutoID:      false,
+			Fields:      make([]*schemapb.FieldSchema, 0),
+		},
+		CollectionID:         0,
+		VirtualChannelNames:  nil,
+		PhysicalChannelNames: nil,
+	}
+
+	result, err := dct.rootCoord.DescribeCollection(ctx, dct.DescribeCollectionRequest)
+
+	if err != nil {
+		return err
+	}
+
+	if result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		dct.result.Status = result.Status
+	} else {
+		dct.result.Schema.Name = result.Schema.Name
+		dct.result.Schema.Description = result.Schema.Description
+		dct.result.Schema.AutoID = result.Schema.AutoID
+		dct.result.CollectionID = result.CollectionID
+		dct.result.VirtualChannelNames = result.VirtualChannelNames
+		dct.result.PhysicalChannelNames = result.PhysicalChannelNames
+
+		for _, field := range result.Schema.Fields {
+			if field.FieldID >= 100 { // TODO(dragondriver): use StartOfUserFieldID replacing 100
+				dct.result.Schema.Fields = append(dct.result.Schema.Fields, &schemapb.FieldSchema{
+					FieldID:      field.FieldID,
+					Name:         field.Name,
+					IsPrimaryKey: field.IsPrimaryKey,
+					AutoID:       field.AutoID,
+					Description:  field.Description,
+					DataType:     field.DataType,
+					TypeParams:   field.TypeParams,
+					IndexParams:  field.IndexParams,
+				})
+			}
+		}
+	}
+	return nil
+}
+
+func (dct *DescribeCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type GetCollectionStatisticsTask struct {
+	Condition
+	*milvuspb.GetCollectionStatisticsRequest
+	ctx       context.Context
+	dataCoord types.DataCoord
+	result    *milvuspb.GetCollectionStatisticsResponse
+}
+
+func (g *GetCollectionStatisticsTask) TraceCtx() context.Context {
+	return g.ctx
+}
+
+func (g *GetCollectionStatisticsTask) ID() UniqueID {
+	return g.Base.MsgID
+}
+
+func (g *GetCollectionStatisticsTask) SetID(uid UniqueID) {
+	g.Base.MsgID = uid
+}
+
+func (g *GetCollectionStatisticsTask) Name() string {
+	return GetCollectionStatisticsTaskName
+}
+
+func (g *GetCollectionStatisticsTask) Type() commonpb.MsgType {
+	return g.Base.MsgType
+}
+
+func (g *GetCollectionStatisticsTask) BeginTs() Timestamp {
+	return g.Base.Timestamp
+}
+
+func (g *GetCollectionStatisticsTask) EndTs() Timestamp {
+	return g.Base.Timestamp
+}
+
+func (g *GetCollectionStatisticsTask) SetTs(ts Timestamp) {
+	g.Base.Timestamp = ts
+}
+
+func (g *GetCollectionStatisticsTask) OnEnqueue() error {
+	g.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (g *GetCollectionStatisticsTask) PreExecute(ctx context.Context) error {
+	g.Base.MsgType = commonpb.MsgType_GetCollectionStatistics
+	g.Base.SourceID = Params.ProxyID
+
+	if err := checkPrivilege(ctx, g.DbName, g.CollectionName, readPrivileges...); err != nil {
+		return err
 	}
-	msgPack.Msgs[0] = tsMsg
+	return nil
+}
 
-	collectionName := rt.retrieve.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+func (g *GetCollectionStatisticsTask) Execute(ctx context.Context) error {
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.DbName, g.CollectionName)
 	if err != nil {
 		return err
 	}
+	req := &datapb.GetCollectionStatisticsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_GetCollectionStatistics,
+			MsgID:     g.Base.MsgID,
+			Timestamp: g.Base.Timestamp,
+			SourceID:  g.Base.SourceID,
+		},
+		CollectionID: collID,
+	}
 
-	stream, err := rt.chMgr.getDQLStream(collID)
-	if err != nil {
-		err = rt.chMgr.createDQLStream(collID)
-		if err != nil {
-			rt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
-			rt.result.Status.Reason = err.Error()
-			return err
-		}
-		stream, err = rt.chMgr.getDQLStream(collID)
-		if err != nil {
-			rt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
-			rt.result.Status.Reason = err.Error()
-			return err
-		}
+	result, _ := g.dataCoord.GetCollectionStatistics(ctx, req)
+	if result == nil {
+		return errors.New("get collection statistics resp is nil")
 	}
-	err = stream.Produce(&msgPack)
-	log.Debug("proxy", zap.Int("length of retrieveMsg", len(msgPack.Msgs)))
-	if err != nil {
-		log.Debug("Failed to send retrieve request.",
-			zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+	if result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(result.Status.Reason)
+	}
+	g.result = &milvuspb.GetCollectionStatisticsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Stats: result.Stats,
 	}
+	return nil
+}
 
-	log.Info("Retrieve Execute done.",
-		zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
-	return err
+func (g *GetCollectionStatisticsTask) PostExecute(ctx context.Context) error {
+	return nil
 }
 
-func (rt *RetrieveTask) PostExecute(ctx context.Context) error {
-	t0 := time.Now()
-	defer func() {
-		log.Debug("WaitAndPostExecute", zap.Any("time cost", time.Since(t0)))
-	}()
-	select {
-	case <-rt.TraceCtx().Done():
-		log.Debug("proxy", zap.Int64("Retrieve: wait to finish failed, timeout!, taskID:", rt.ID()))
-		return fmt.Errorf("RetrieveTask:wait to finish failed, timeout : %d", rt.ID())
-	case retrieveResults := <-rt.resultBuf:
-		retrieveResult := make([]*internalpb.RetrieveResults, 0)
-		var reason string
-		for _, partialRetrieveResult := range retrieveResults {
-			if partialRetrieveResult.Status.ErrorCode == commonpb.ErrorCode_Success {
-				retrieveResult = append(retrieveResult, partialRetrieveResult)
-			} else {
-				reason += partialRetrieveResult.Status.Reason + "\n"
-			}
-		}
+type GetPartitionStatisticsTask struct {
+	Condition
+	*milvuspb.GetPartitionStatisticsRequest
+	ctx       context.Context
+	dataCoord types.DataCoord
+	result    *milvuspb.GetPartitionStatisticsResponse
+}
 
-		if len(retrieveResult) == 0 {
-			rt.result = &milvuspb.RetrieveResults{
-				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
-					Reason:    reason,
-				},
-			}
-			log.Debug("Retrieve failed on all querynodes.",
-				zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
-			return errors.New(reason)
-		}
+func (g *GetPartitionStatisticsTask) TraceCtx() context.Context {
+	return g.ctx
+}
 
-		availableQueryNodeNum := 0
-		rt.result = &milvuspb.RetrieveResults{
-			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_Success,
-			},
-			Ids:        &schemapb.IDs{},
-			FieldsData: make([]*schemapb.FieldData, 0),
-		}
-		for idx, partialRetrieveResult := range retrieveResult {
-			log.Debug("Index-" + strconv.Itoa(idx))
-			availableQueryNodeNum++
-			if partialRetrieveResult.Ids == nil {
-				reason += "ids is nil\n"
-				continue
-			} else {
-				intIds, intOk := partialRetrieveResult.Ids.IdField.(*schemapb.IDs_IntId)
-				strIds, strOk := partialRetrieveResult.Ids.IdField.(*schemapb.IDs_StrId)
-				if !intOk && !strOk {
-					reason += "ids is empty\n"
-					continue
-				}
+func (g *GetPartitionStatisticsTask) ID() UniqueID {
+	return g.Base.MsgID
+}
 
-				if !intOk {
-					if idsStr, ok := rt.result.Ids.IdField.(*schemapb.IDs_StrId); ok {
-						idsStr.StrId.Data = append(idsStr.StrId.Data, strIds.StrId.Data...)
-					} else {
-						rt.result.Ids.IdField = &schemapb.IDs_StrId{
-							StrId: &schemapb.StringArray{
-								Data: strIds.StrId.Data,
-							},
-						}
-					}
-				} else {
-					if idsInt, ok := rt.result.Ids.IdField.(*schemapb.IDs_IntId); ok {
-						idsInt.IntId.Data = append(idsInt.IntId.Data, intIds.IntId.Data...)
-					} else {
-						rt.result.Ids.IdField = &schemapb.IDs_IntId{
-							IntId: &schemapb.LongArray{
-								Data: intIds.IntId.Data,
-							},
-						}
-					}
-				}
+func (g *GetPartitionStatisticsTask) SetID(uid UniqueID) {
+	g.Base.MsgID = uid
+}
 
-				if idx == 0 {
-					rt.result.FieldsData = append(rt.result.FieldsData, partialRetrieveResult.FieldsData...)
-				} else {
-					for k, fieldData := range partialRetrieveResult.FieldsData {
-						switch fieldType := fieldData.Field.(type) {
-						case *schemapb.FieldData_Scalars:
-							switch scalarType := fieldType.Scalars.Data.(type) {
-							case *schemapb.ScalarField_BoolData:
-								rt.result.FieldsData[k].GetScalars().GetBoolData().Data = append(rt.result.FieldsData[k].GetScalars().GetBoolData().Data, scalarType.BoolData.Data...)
-							case *schemapb.ScalarField_IntData:
-								rt.result.FieldsData[k].GetScalars().GetIntData().Data = append(rt.result.FieldsData[k].GetScalars().GetIntData().Data, scalarType.IntData.Data...)
-							case *schemapb.ScalarField_LongData:
-								rt.result.FieldsData[k].GetScalars().GetLongData().Data = append(rt.result.FieldsData[k].GetScalars().GetLongData().Data, scalarType.LongData.Data...)
-							case *schemapb.ScalarField_FloatData:
-								rt.result.FieldsData[k].GetScalars().GetFloatData().Data = append(rt.result.FieldsData[k].GetScalars().GetFloatData().Data, scalarType.FloatData.Data...)
-							case *schemapb.ScalarField_DoubleData:
-								rt.result.FieldsData[k].GetScalars().GetDoubleData().Data = append(rt.result.FieldsData[k].GetScalars().GetDoubleData().Data, scalarType.DoubleData.Data...)
-							default:
-								log.Debug("Retrieve received not supported data type")
-							}
-						case *schemapb.FieldData_Vectors:
-							switch vectorType := fieldType.Vectors.Data.(type) {
-							case *schemapb.VectorField_BinaryVector:
-								rt.result.FieldsData[k].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector = append(rt.result.FieldsData[k].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector, vectorType.BinaryVector...)
-							case *schemapb.VectorField_FloatVector:
-								rt.result.FieldsData[k].GetVectors().GetFloatVector().Data = append(rt.result.FieldsData[k].GetVectors().GetFloatVector().Data, vectorType.FloatVector.Data...)
-							}
-						default:
-						}
-					}
-				}
-				// rt.result.FieldsData = append(rt.result.FieldsData, partialRetrieveResult.FieldsData...)
-			}
-		}
+func (g *GetPartitionStatisticsTask) Name() string {
+	return GetPartitionStatisticsTaskName
+}
 
-		if availableQueryNodeNum == 0 {
-			log.Info("Not any valid result found.",
-				zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
-			rt.result = &milvuspb.RetrieveResults{
-				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
-					Reason:    reason,
-				},
-			}
-			return nil
-		}
+func (g *GetPartitionStatisticsTask) Type() commonpb.MsgType {
+	return g.Base.MsgType
+}
 
-		if len(rt.result.FieldsData) == 0 {
-			log.Info("Retrieve result is nil.",
-				zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
-			rt.result = &milvuspb.RetrieveResults{
-				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_EmptyCollection,
-					Reason:    reason,
-				},
-			}
-			return nil
-		}
+func (g *GetPartitionStatisticsTask) BeginTs() Timestamp {
+	return g.Base.Timestamp
+}
 
-		schema, err := globalMetaCache.GetCollectionSchema(ctx, rt.retrieve.CollectionName)
-		if err != nil {
-			return err
-		}
-		for i := 0; i < len(rt.result.FieldsData); i++ {
-			for _, field := range schema.Fields {
-				if field.Name == rt.OutputFields[i] {
-					rt.result.FieldsData[i].FieldName = field.Name
-					rt.result.FieldsData[i].Type = field.DataType
-				}
-			}
-		}
+func (g *GetPartitionStatisticsTask) EndTs() Timestamp {
+	return g.Base.Timestamp
+}
+
+func (g *GetPartitionStatisticsTask) SetTs(ts Timestamp) {
+	g.Base.Timestamp = ts
+}
+
+func (g *GetPartitionStatisticsTask) OnEnqueue() error {
+	g.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (g *GetPartitionStatisticsTask) PreExecute(ctx context.Context) error {
+	g.Base.MsgType = commonpb.MsgType_GetPartitionStatistics
+	g.Base.SourceID = Params.ProxyID
+
+	if err := checkPrivilege(ctx, g.DbName, g.CollectionName, readPrivileges...); err != nil {
+		return err
 	}
+	return nil
+}
 
-	log.Info("Retrieve PostExecute done.",
-		zap.Any("requestID", rt.Base.MsgID), zap.Any("requestType", "retrieve"))
+func (g *GetPartitionStatisticsTask) Execute(ctx context.Context) error {
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.DbName, g.CollectionName)
+	if err != nil {
+		return err
+	}
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.DbName, g.CollectionName, g.PartitionName)
+	if err != nil {
+		return err
+	}
+	req := &datapb.GetPartitionStatisticsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_GetPartitionStatistics,
+			MsgID:     g.Base.MsgID,
+			Timestamp: g.Base.Timestamp,
+			SourceID:  g.Base.SourceID,
+		},
+		CollectionID: collID,
+		PartitionID:  partitionID,
+	}
+
+	result, _ := g.dataCoord.GetPartitionStatistics(ctx, req)
+	if result == nil {
+		return errors.New("get partition statistics resp is nil")
+	}
+	if result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(result.Status.Reason)
+	}
+	g.result = &milvuspb.GetPartitionStatisticsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Stats: result.Stats,
+	}
 	return nil
 }
 
-type HasCollectionTask struct {
+func (g *GetPartitionStatisticsTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type ShowCollectionsTask struct {
 	Condition
-	*milvuspb.HasCollectionRequest
-	ctx       context.Context
-	rootCoord types.RootCoord
-	result    *milvuspb.BoolResponse
+	*milvuspb.ShowCollectionsRequest
+	ctx        context.Context
+	rootCoord  types.RootCoord
+	queryCoord types.QueryCoord
+	result     *milvuspb.ShowCollectionsResponse
 }
 
-func (hct *HasCollectionTask) TraceCtx() context.Context {
-	return hct.ctx
+func (sct *ShowCollectionsTask) TraceCtx() context.Context {
+	return sct.ctx
 }
 
-func (hct *HasCollectionTask) ID() UniqueID {
-	return hct.Base.MsgID
+func (sct *ShowCollectionsTask) ID() UniqueID {
+	return sct.Base.MsgID
 }
 
-func (hct *HasCollectionTask) SetID(uid UniqueID) {
-	hct.Base.MsgID = uid
+func (sct *ShowCollectionsTask) SetID(uid UniqueID) {
+	sct.Base.MsgID = uid
 }
 
-func (hct *HasCollectionTask) Name() string {
-	return HasCollectionTaskName
+func (sct *ShowCollectionsTask) Name() string {
+	return ShowCollectionTaskName
+}
+
+func (sct *ShowCollectionsTask) Type() commonpb.MsgType {
+	return sct.Base.MsgType
 }
 
-func (hct *HasCollectionTask) Type() commonpb.MsgType {
-	return hct.Base.MsgType
+func (sct *ShowCollectionsTask) BeginTs() Timestamp {
+	return sct.Base.Timestamp
 }
 
-func (hct *HasCollectionTask) BeginTs() Timestamp {
-	return hct.Base.Timestamp
+func (sct *ShowCollectionsTask) EndTs() Timestamp {
+	return sct.Base.Timestamp
 }
 
-func (hct *HasCollectionTask) EndTs() Timestamp {
-	return hct.Base.Timestamp
+func (sct *ShowCollectionsTask) SetTs(ts Timestamp) {
+	sct.Base.Timestamp = ts
 }
 
-func (hct *HasCollectionTask) SetTs(ts Timestamp) {
-	hct.Base.Timestamp = ts
+func (sct *ShowCollectionsTask) OnEnqueue() error {
+	sct.Base = &commonpb.MsgBase{}
+	return nil
 }
 
-func (hct *HasCollectionTask) OnEnqueue() error {
-	hct.Base = &commonpb.MsgBase{}
+func (sct *ShowCollectionsTask) PreExecute(ctx context.Context) error {
+	sct.Base.MsgType = commonpb.MsgType_ShowCollections
+	sct.Base.SourceID = Params.ProxyID
+
 	return nil
 }
 
-func (hct *HasCollectionTask) PreExecute(ctx context.Context) error {
-	hct.Base.MsgType = commonpb.MsgType_HasCollection
-	hct.Base.SourceID = Params.ProxyID
+func (sct *ShowCollectionsTask) Execute(ctx context.Context) error {
+	var err error
 
-	if err := ValidateCollectionName(hct.CollectionName); err != nil {
+	respFromRootCoord, err := sct.rootCoord.ShowCollections(ctx, sct.ShowCollectionsRequest)
+
+	if err != nil {
 		return err
 	}
-	return nil
-}
 
-func (hct *HasCollectionTask) Execute(ctx context.Context) error {
-	var err error
-	hct.result, err = hct.rootCoord.HasCollection(ctx, hct.HasCollectionRequest)
-	if hct.result == nil {
-		return errors.New("has collection resp is nil")
+	if respFromRootCoord == nil {
+		return errors.New("failed to show collections")
 	}
-	if hct.result.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return errors.New(hct.result.Status.Reason)
+
+	if respFromRootCoord.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(respFromRootCoord.Status.Reason)
 	}
-	return err
+
+	if sct.ShowCollectionsRequest.Type == milvuspb.ShowCollectionsType_InMemory {
+		resp, err := sct.queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_ShowCollections,
+				MsgID:     sct.ShowCollectionsRequest.Base.MsgID,
+				Timestamp: sct.ShowCollectionsRequest.Base.Timestamp,
+				SourceID:  sct.ShowCollectionsRequest.Base.SourceID,
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if resp == nil {
+			return errors.New("failed to show collections")
+		}
+
+		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return errors.New(resp.Status.Reason)
+		}
+
+		sct.result = &milvuspb.ShowCollectionsResponse{
+			Status:          resp.Status,
+			CollectionNames: make([]string, 0, len(resp.CollectionIDs)),
+			CollectionIds:   make([]int64, 0, len(resp.CollectionIDs)),
+		}
+
+		idMap := make(map[int64]string)
+		for i, name := range respFromRootCoord.CollectionNames {
+			idMap[respFromRootCoord.CollectionIds[i]] = name
+		}
+
+		for _, id := range resp.CollectionIDs {
+			// query coord reports the loaded collections of all the databases
+			name, ok := idMap[id]
+			if !ok {
+				continue
+			}
+			sct.result.CollectionIds = append(sct.result.CollectionIds, id)
+			sct.result.CollectionNames = append(sct.result.CollectionNames, name)
+		}
+	} else {
+		sct.result = respFromRootCoord
+	}
+
+	return nil
 }
 
-func (hct *HasCollectionTask) PostExecute(ctx context.Context) error {
+func (sct *ShowCollectionsTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
-type DescribeCollectionTask struct {
+type CreateAliasTask struct {
 	Condition
-	*milvuspb.DescribeCollectionRequest
+	*milvuspb.CreateAliasRequest
 	ctx       context.Context
 	rootCoord types.RootCoord
-	result    *milvuspb.DescribeCollectionResponse
+	result    *commonpb.Status
 }
 
-func (dct *DescribeCollectionTask) TraceCtx() context.Context {
-	return dct.ctx
+func (cat *CreateAliasTask) TraceCtx() context.Context {
+	return cat.ctx
 }
 
-func (dct *DescribeCollectionTask) ID() UniqueID {
-	return dct.Base.MsgID
+func (cat *CreateAliasTask) ID() UniqueID {
+	return cat.Base.MsgID
 }
 
-func (dct *DescribeCollectionTask) SetID(uid UniqueID) {
-	dct.Base.MsgID = uid
+func (cat *CreateAliasTask) SetID(uid UniqueID) {
+	cat.Base.MsgID = uid
 }
 
-func (dct *DescribeCollectionTask) Name() string {
-	return DescribeCollectionTaskName
+func (cat *CreateAliasTask) Name() string {
+	return CreateAliasTaskName
 }
 
-func (dct *DescribeCollectionTask) Type() commonpb.MsgType {
-	return dct.Base.MsgType
+func (cat *CreateAliasTask) Type() commonpb.MsgType {
+	return cat.Base.MsgType
 }
 
-func (dct *DescribeCollectionTask) BeginTs() Timestamp {
-	return dct.Base.Timestamp
+func (cat *CreateAliasTask) BeginTs() Timestamp {
+	return cat.Base.Timestamp
 }
 
-func (dct *DescribeCollectionTask) EndTs() Timestamp {
-	return dct.Base.Timestamp
+func (cat *CreateAliasTask) EndTs() Timestamp {
+	return cat.Base.Timestamp
 }
 
-func (dct *DescribeCollectionTask) SetTs(ts Timestamp) {
-	dct.Base.Timestamp = ts
+func (cat *CreateAliasTask) SetTs(ts Timestamp) {
+	cat.Base.Timestamp = ts
 }
 
-func (dct *DescribeCollectionTask) OnEnqueue() error {
-	dct.Base = &commonpb.MsgBase{}
+func (cat *CreateAliasTask) OnEnqueue() error {
+	cat.Base = &commonpb.MsgBase{}
 	return nil
 }
 
-func (dct *DescribeCollectionTask) PreExecute(ctx context.Context) error {
-	dct.Base.MsgType = commonpb.MsgType_DescribeCollection
-	dct.Base.SourceID = Params.ProxyID
+func (cat *CreateAliasTask) PreExecute(ctx context.Context) error {
+	cat.Base.MsgType = commonpb.MsgType_CreateAlias
+	cat.Base.SourceID = Params.ProxyID
 
-	if err := ValidateCollectionName(dct.CollectionName); err != nil {
+	if err := checkPrivilege(ctx, cat.DbName, cat.CollectionName, commonpb.Privilege_PrivilegeDDL); err != nil {
 		return err
 	}
-	return nil
-}
 
-func (dct *DescribeCollectionTask) Execute(ctx context.Context) error {
-	var err error
-	dct.result = &milvuspb.DescribeCollectionResponse{
-		Status: &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_Success,
-		},
-		Schema: &schemapb.CollectionSchema{
-			Name:        "",
-			Description: "",
-			AutoID:      false,
-			Fields:      make([]*schemapb.FieldSchema, 0),
-		},
-		CollectionID:         0,
-		VirtualChannelNames:  nil,
-		PhysicalChannelNames: nil,
+	// an alias follows the naming rules of collections
+	if err := ValidateCollectionName(cat.Alias); err != nil {
+		return err
 	}
-
-	result, err := dct.rootCoord.DescribeCollection(ctx, dct.DescribeCollectionRequest)
-
-	if err != nil {
+	if err := ValidateCollectionName(cat.CollectionName); err != nil {
 		return err
 	}
 
-	if result.Status.ErrorCode != commonpb.ErrorCode_Success {
-		dct.result.Status = result.Status
-	} else {
-		dct.result.Schema.Name = result.Schema.Name
-		dct.result.Schema.Description = result.Schema.Description
-		dct.result.Schema.AutoID = result.Schema.AutoID
-		dct.result.CollectionID = result.CollectionID
-		dct.result.VirtualChannelNames = result.VirtualChannelNames
-		dct.result.PhysicalChannelNames = result.PhysicalChannelNames
+	return nil
+}
 
-		for _, field := range result.Schema.Fields {
-			if field.FieldID >= 100 { // TODO(dragondriver): use StartOfUserFieldID replacing 100
-				dct.result.Schema.Fields = append(dct.result.Schema.Fields, &schemapb.FieldSchema{
-					FieldID:      field.FieldID,
-					Name:         field.Name,
-					IsPrimaryKey: field.IsPrimaryKey,
-					AutoID:       field.AutoID,
-					Description:  field.Description,
-					DataType:     field.DataType,
-					TypeParams:   field.TypeParams,
-					IndexParams:  field.IndexParams,
-				})
-			}
-		}
+func (cat *CreateAliasTask) Execute(ctx context.Context) (err error) {
+	cat.result, err = cat.rootCoord.CreateAlias(ctx, cat.CreateAliasRequest)
+	if cat.result == nil {
+		return errors.New("CreateAlias resp is nil")
 	}
-	return nil
+	if cat.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(cat.result.Reason)
+	}
+	return err
 }
 
-func (dct *DescribeCollectionTask) PostExecute(ctx context.Context) error {
+func (cat *CreateAliasTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
-type GetCollectionStatisticsTask struct {
+type DropAliasTask struct {
 	Condition
-	*milvuspb.GetCollectionStatisticsRequest
+	*milvuspb.DropAliasRequest
 	ctx       context.Context
-	dataCoord types.DataCoord
-	result    *milvuspb.GetCollectionStatisticsResponse
+	rootCoord types.RootCoord
+	result    *commonpb.Status
 }
 
-func (g *GetCollectionStatisticsTask) TraceCtx() context.Context {
-	return g.ctx
+func (dat *DropAliasTask) TraceCtx() context.Context {
+	return dat.ctx
 }
 
-func (g *GetCollectionStatisticsTask) ID() UniqueID {
-	return g.Base.MsgID
+func (dat *DropAliasTask) ID() UniqueID {
+	return dat.Base.MsgID
 }
 
-func (g *GetCollectionStatisticsTask) SetID(uid UniqueID) {
-	g.Base.MsgID = uid
+func (dat *DropAliasTask) SetID(uid UniqueID) {
+	dat.Base.MsgID = uid
 }
 
-func (g *GetCollectionStatisticsTask) Name() string {
-	return GetCollectionStatisticsTaskName
+func (dat *DropAliasTask) Name() string {
+	return DropAliasTaskName
 }
 
-func (g *GetCollectionStatisticsTask) Type() commonpb.MsgType {
-	return g.Base.MsgType
+func (dat *DropAliasTask) Type() commonpb.MsgType {
+	return dat.Base.MsgType
 }
 
-func (g *GetCollectionStatisticsTask) BeginTs() Timestamp {
-	return g.Base.Timestamp
+func (dat *DropAliasTask) BeginTs() Timestamp {
+	return dat.Base.Timestamp
 }
 
-func (g *GetCollectionStatisticsTask) EndTs() Timestamp {
-	return g.Base.Timestamp
+func (dat *DropAliasTask) EndTs() Timestamp {
+	return dat.Base.Timestamp
 }
 
-func (g *GetCollectionStatisticsTask) SetTs(ts Timestamp) {
-	g.Base.Timestamp = ts
+func (dat *DropAliasTask) SetTs(ts Timestamp) {
+	dat.Base.Timestamp = ts
 }
 
-func (g *GetCollectionStatisticsTask) OnEnqueue() error {
-	g.Base = &commonpb.MsgBase{}
+func (dat *DropAliasTask) OnEnqueue() error {
+	dat.Base = &commonpb.MsgBase{}
 	return nil
 }
 
-func (g *GetCollectionStatisticsTask) PreExecute(ctx context.Context) error {
-	g.Base.MsgType = commonpb.MsgType_GetCollectionStatistics
-	g.Base.SourceID = Params.ProxyID
-	return nil
-}
+func (dat *DropAliasTask) PreExecute(ctx context.Context) error {
+	dat.Base.MsgType = commonpb.MsgType_DropAlias
+	dat.Base.SourceID = Params.ProxyID
 
-func (g *GetCollectionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
-	if err != nil {
+	if err := checkPrivilege(ctx, dat.DbName, dat.Alias, commonpb.Privilege_PrivilegeDDL); err != nil {
 		return err
 	}
-	req := &datapb.GetCollectionStatisticsRequest{
-		Base: &commonpb.MsgBase{
-			MsgType:   commonpb.MsgType_GetCollectionStatistics,
-			MsgID:     g.Base.MsgID,
-			Timestamp: g.Base.Timestamp,
-			SourceID:  g.Base.SourceID,
-		},
-		CollectionID: collID,
-	}
 
-	result, _ := g.dataCoord.GetCollectionStatistics(ctx, req)
-	if result == nil {
-		return errors.New("get collection statistics resp is nil")
+	// an alias follows the naming rules of collections
+	if err := ValidateCollectionName(dat.Alias); err != nil {
+		return err
 	}
-	if result.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return errors.New(result.Status.Reason)
+
+	return nil
+}
+
+func (dat *DropAliasTask) Execute(ctx context.Context) (err error) {
+	dat.result, err = dat.rootCoord.DropAlias(ctx, dat.DropAliasRequest)
+	if dat.result == nil {
+		return errors.New("DropAlias resp is nil")
 	}
-	g.result = &milvuspb.GetCollectionStatisticsResponse{
-		Status: &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_Success,
-			Reason:    "",
-		},
-		Stats: result.Stats,
+	if dat.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(dat.result.Reason)
 	}
-	return nil
+	return err
 }
 
-func (g *GetCollectionStatisticsTask) PostExecute(ctx context.Context) error {
+func (dat *DropAliasTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
-type GetPartitionStatisticsTask struct {
+type AlterAliasTask struct {
 	Condition
-	*milvuspb.GetPartitionStatisticsRequest
+	*milvuspb.AlterAliasRequest
 	ctx       context.Context
-	dataCoord types.DataCoord
-	result    *milvuspb.GetPartitionStatisticsResponse
-}
-
-func (g *GetPartitionStatisticsTask) TraceCtx() context.Context {
-	return g.ctx
+	rootCoord types.RootCoord
+	result    *commonpb.Status
 }
 
-func (g *GetPartitionStatisticsTask) ID() UniqueID {
-	return g.Base.MsgID
+func (aat *AlterAliasTask) TraceCtx() context.Context {
+	return aat.ctx
 }
 
-func (g *GetPartitionStatisticsTask) SetID(uid UniqueID) {
-	g.Base.MsgID = uid
+func (aat *AlterAliasTask) ID() UniqueID {
+	return aat.Base.MsgID
 }
 
-func (g *GetPartitionStatisticsTask) Name() string {
-	return GetPartitionStatisticsTaskName
+func (aat *AlterAliasTask) SetID(uid UniqueID) {
+	aat.Base.MsgID = uid
 }
 
-func (g *GetPartitionStatisticsTask) Type() commonpb.MsgType {
-	return g.Base.MsgType
+func (aat *AlterAliasTask) Name() string {
+	return AlterAliasTaskName
 }
 
-func (g *GetPartitionStatisticsTask) BeginTs() Timestamp {
-	return g.Base.Timestamp
+func (aat *AlterAliasTask) Type() commonpb.MsgType {
+	return aat.Base.MsgType
 }
 
-func (g *GetPartitionStatisticsTask) EndTs() Timestamp {
-	return g.Base.Timestamp
+func (aat *AlterAliasTask) BeginTs() Timestamp {
+	return aat.Base.Timestamp
 }
-
-func (g *GetPartitionStatisticsTask) SetTs(ts Timestamp) {
-	g.Base.Timestamp = ts
+
+func (aat *AlterAliasTask) EndTs() Timestamp {
+	return aat.Base.Timestamp
 }
 
-func (g *GetPartitionStatisticsTask) OnEnqueue() error {
-	g.Base = &commonpb.MsgBase{}
-	return nil
+func (aat *AlterAliasTask) SetTs(ts Timestamp) {
+	aat.Base.Timestamp = ts
 }
 
-func (g *GetPartitionStatisticsTask) PreExecute(ctx context.Context) error {
-	g.Base.MsgType = commonpb.MsgType_GetPartitionStatistics
-	g.Base.SourceID = Params.ProxyID
+func (aat *AlterAliasTask) OnEnqueue() error {
+	aat.Base = &commonpb.MsgBase{}
 	return nil
 }
 
-func (g *GetPartitionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
-	if err != nil {
+func (aat *AlterAliasTask) PreExecute(ctx context.Context) error {
+	aat.Base.MsgType = commonpb.MsgType_AlterAlias
+	aat.Base.SourceID = Params.ProxyID
+
+	if err := checkPrivilege(ctx, aat.DbName, aat.CollectionName, commonpb.Privilege_PrivilegeDDL); err != nil {
 		return err
 	}
-	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.CollectionName, g.PartitionName)
-	if err != nil {
+
+	// an alias follows the naming rules of collections
+	if err := ValidateCollectionName(aat.Alias); err != nil {
 		return err
 	}
-	req := &datapb.GetPartitionStatisticsRequest{
-		Base: &commonpb.MsgBase{
-			MsgType:   commonpb.MsgType_GetPartitionStatistics,
-			MsgID:     g.Base.MsgID,
-			Timestamp: g.Base.Timestamp,
-			SourceID:  g.Base.SourceID,
-		},
-		CollectionID: collID,
-		PartitionID:  partitionID,
+	if err := ValidateCollectionName(aat.CollectionName); err != nil {
+		return err
 	}
 
-	result, _ := g.dataCoord.GetPartitionStatistics(ctx, req)
-	if result == nil {
-		return errors.New("get partition statistics resp is nil")
-	}
-	if result.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return errors.New(result.Status.Reason)
+	return nil
+}
+
+func (aat *AlterAliasTask) Execute(ctx context.Context) (err error) {
+	aat.result, err = aat.rootCoord.AlterAlias(ctx, aat.AlterAliasRequest)
+	if aat.result == nil {
+		return errors.New("AlterAlias resp is nil")
 	}
-	g.result = &milvuspb.GetPartitionStatisticsResponse{
-		Status: &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_Success,
-			Reason:    "",
-		},
-		Stats: result.Stats,
+	if aat.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(aat.result.Reason)
 	}
-	return nil
+	return err
 }
 
-func (g *GetPartitionStatisticsTask) PostExecute(ctx context.Context) error {
+func (aat *AlterAliasTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
-type ShowCollectionsTask struct {
+type AddFieldTask struct {
 	Condition
-	*milvuspb.ShowCollectionsRequest
+	*milvuspb.AddFieldRequest
 	ctx        context.Context
 	rootCoord  types.RootCoord
 	queryCoord types.QueryCoord
-	result     *milvuspb.ShowCollectionsResponse
+	result     *commonpb.Status
 }
 
-func (sct *ShowCollectionsTask) TraceCtx() context.Context {
-	return sct.ctx
+func (aft *AddFieldTask) TraceCtx() context.Context {
+	return aft.ctx
 }
 
-func (sct *ShowCollectionsTask) ID() UniqueID {
-	return sct.Base.MsgID
+func (aft *AddFieldTask) ID() UniqueID {
+	return aft.Base.MsgID
 }
 
-func (sct *ShowCollectionsTask) SetID(uid UniqueID) {
-	sct.Base.MsgID = uid
+func (aft *AddFieldTask) SetID(uid UniqueID) {
+	aft.Base.MsgID = uid
 }
 
-func (sct *ShowCollectionsTask) Name() string {
-	return ShowCollectionTaskName
+func (aft *AddFieldTask) Name() string {
+	return AddFieldTaskName
 }
 
-func (sct *ShowCollectionsTask) Type() commonpb.MsgType {
-	return sct.Base.MsgType
+func (aft *AddFieldTask) Type() commonpb.MsgType {
+	return aft.Base.MsgType
 }
 
-func (sct *ShowCollectionsTask) BeginTs() Timestamp {
-	return sct.Base.Timestamp
+func (aft *AddFieldTask) BeginTs() Timestamp {
+	return aft.Base.Timestamp
 }
 
-func (sct *ShowCollectionsTask) EndTs() Timestamp {
-	return sct.Base.Timestamp
+func (aft *AddFieldTask) EndTs() Timestamp {
+	return aft.Base.Timestamp
 }
 
-func (sct *ShowCollectionsTask) SetTs(ts Timestamp) {
-	sct.Base.Timestamp = ts
+func (aft *AddFieldTask) SetTs(ts Timestamp) {
+	aft.Base.Timestamp = ts
 }
 
-func (sct *ShowCollectionsTask) OnEnqueue() error {
-	sct.Base = &commonpb.MsgBase{}
+func (aft *AddFieldTask) OnEnqueue() error {
+	aft.Base = &commonpb.MsgBase{}
 	return nil
 }
 
-func (sct *ShowCollectionsTask) PreExecute(ctx context.Context) error {
-	sct.Base.MsgType = commonpb.MsgType_ShowCollections
-	sct.Base.SourceID = Params.ProxyID
+func (aft *AddFieldTask) PreExecute(ctx context.Context) error {
+	aft.Base.MsgType = commonpb.MsgType_AddField
+	aft.Base.SourceID = Params.ProxyID
 
-	return nil
-}
+	if err := checkPrivilege(ctx, aft.DbName, aft.CollectionName, commonpb.Privilege_PrivilegeDDL); err != nil {
+		return err
+	}
 
-func (sct *ShowCollectionsTask) Execute(ctx context.Context) error {
-	var err error
+	if err := ValidateCollectionName(aft.CollectionName); err != nil {
+		return err
+	}
 
-	respFromRootCoord, err := sct.rootCoord.ShowCollections(ctx, sct.ShowCollectionsRequest)
+	var field schemapb.FieldSchema
+	if err := proto.Unmarshal(aft.Schema, &field); err != nil {
+		return err
+	}
+	if err := ValidateFieldName(field.Name); err != nil {
+		return err
+	}
+	// the rows inserted before take the default value of the field
+	if field.IsPrimaryKey || field.AutoID {
+		return fmt.Errorf("field %s to add can't be primary key or auto id", field.Name)
+	}
+	if err := typeutil.ValidateDefaultValue(&field); err != nil {
+		return err
+	}
 
+	// the loaded segments are not aware of the new field, so the collection should be released first. A load
+	// racing with the check is caught by the query nodes, they reject the requests guaranteed to see the field
+	// until the collection is loaded again
+	collID, err := globalMetaCache.GetCollectionID(ctx, aft.DbName, aft.CollectionName)
 	if err != nil {
 		return err
 	}
-
-	if respFromRootCoord == nil {
-		return errors.New("failed to show collections")
+	showResp, err := aft.queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_ShowCollections,
+			MsgID:     aft.Base.MsgID,
+			Timestamp: aft.Base.Timestamp,
+			SourceID:  Params.ProxyID,
+		},
+	})
+	if err != nil {
+		return err
 	}
-
-	if respFromRootCoord.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return errors.New(respFromRootCoord.Status.Reason)
+	if showResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(showResp.Status.Reason)
 	}
-
-	if sct.ShowCollectionsRequest.Type == milvuspb.ShowCollectionsType_InMemory {
-		resp, err := sct.queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
-			Base: &commonpb.MsgBase{
-				MsgType:   commonpb.MsgType_ShowCollections,
-				MsgID:     sct.ShowCollectionsRequest.Base.MsgID,
-				Timestamp: sct.ShowCollectionsRequest.Base.Timestamp,
-				SourceID:  sct.ShowCollectionsRequest.Base.SourceID,
-			},
-			//DbID: sct.ShowCollectionsRequest.DbName,
-		})
-
-		if err != nil {
-			return err
-		}
-
-		if resp == nil {
-			return errors.New("failed to show collections")
-		}
-
-		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
-			return errors.New(resp.Status.Reason)
-		}
-
-		sct.result = &milvuspb.ShowCollectionsResponse{
-			Status:          resp.Status,
-			CollectionNames: make([]string, 0, len(resp.CollectionIDs)),
-			CollectionIds:   make([]int64, 0, len(resp.CollectionIDs)),
-		}
-
-		idMap := make(map[int64]string)
-		for i, name := range respFromRootCoord.CollectionNames {
-			idMap[respFromRootCoord.CollectionIds[i]] = name
+	for _, id := range showResp.CollectionIDs {
+		if id == collID {
+			return fmt.Errorf("collection %s is loaded, release it before adding fields", aft.CollectionName)
 		}
-
-		for _, id := range resp.CollectionIDs {
-			sct.result.CollectionIds = append(sct.result.CollectionIds, id)
-			sct.result.CollectionNames = append(sct.result.CollectionNames, idMap[id])
-		}
-	} else {
-		sct.result = respFromRootCoord
 	}
 
 	return nil
 }
 
-func (sct *ShowCollectionsTask) PostExecute(ctx context.Context) error {
+func (aft *AddFieldTask) Execute(ctx context.Context) (err error) {
+	aft.result, err = aft.rootCoord.AddField(ctx, aft.AddFieldRequest)
+	if aft.result == nil {
+		return errors.New("add field resp is nil")
+	}
+	if aft.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(aft.result.Reason)
+	}
+	return err
+}
+
+func (aft *AddFieldTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
@@ -2756,6 +4314,10 @@ func (cpt *CreatePartitionTask) PreExecute(ctx context.Context) error {
 	cpt.Base.MsgType = commonpb.MsgType_CreatePartition
 	cpt.Base.SourceID = Params.ProxyID
 
+	if err := checkPrivilege(ctx, cpt.DbName, cpt.CollectionName, commonpb.Privilege_PrivilegeDDL); err != nil {
+		return err
+	}
+
 	collName, partitionTag := cpt.CollectionName, cpt.PartitionName
 
 	if err := ValidateCollectionName(collName); err != nil {
@@ -2833,6 +4395,10 @@ func (dpt *DropPartitionTask) PreExecute(ctx context.Context) error {
 	dpt.Base.MsgType = commonpb.MsgType_DropPartition
 	dpt.Base.SourceID = Params.ProxyID
 
+	if err := checkPrivilege(ctx, dpt.DbName, dpt.CollectionName, commonpb.Privilege_PrivilegeDDL); err != nil {
+		return err
+	}
+
 	collName, partitionTag := dpt.CollectionName, dpt.PartitionName
 
 	if err := ValidateCollectionName(collName); err != nil {
@@ -2910,6 +4476,10 @@ func (hpt *HasPartitionTask) PreExecute(ctx context.Context) error {
 	hpt.Base.MsgType = commonpb.MsgType_HasPartition
 	hpt.Base.SourceID = Params.ProxyID
 
+	if err := checkPrivilege(ctx, hpt.DbName, hpt.CollectionName, readPrivileges...); err != nil {
+		return err
+	}
+
 	collName, partitionTag := hpt.CollectionName, hpt.PartitionName
 
 	if err := ValidateCollectionName(collName); err != nil {
@@ -2986,6 +4556,10 @@ func (spt *ShowPartitionsTask) PreExecute(ctx context.Context) error {
 	spt.Base.MsgType = commonpb.MsgType_ShowPartitions
 	spt.Base.SourceID = Params.ProxyID
 
+	if err := checkPrivilege(ctx, spt.DbName, spt.CollectionName, readPrivileges...); err != nil {
+		return err
+	}
+
 	if err := ValidateCollectionName(spt.CollectionName); err != nil {
 		return err
 	}
@@ -3057,6 +4631,10 @@ func (cit *CreateIndexTask) PreExecute(ctx context.Context) error {
 	cit.Base.MsgType = commonpb.MsgType_CreateIndex
 	cit.Base.SourceID = Params.ProxyID
 
+	if err := checkPrivilege(ctx, cit.DbName, cit.CollectionName, commonpb.Privilege_PrivilegeDDL); err != nil {
+		return err
+	}
+
 	collName, fieldName := cit.CollectionName, cit.FieldName
 
 	if err := ValidateCollectionName(collName); err != nil {
@@ -3171,6 +4749,10 @@ func (dit *DescribeIndexTask) PreExecute(ctx context.Context) error {
 	dit.Base.MsgType = commonpb.MsgType_DescribeIndex
 	dit.Base.SourceID = Params.ProxyID
 
+	if err := checkPrivilege(ctx, dit.DbName, dit.CollectionName, readPrivileges...); err != nil {
+		return err
+	}
+
 	if err := ValidateCollectionName(dit.CollectionName); err != nil {
 		return err
 	}
@@ -3248,6 +4830,10 @@ func (dit *DropIndexTask) PreExecute(ctx context.Context) error {
 	dit.Base.MsgType = commonpb.MsgType_DropIndex
 	dit.Base.SourceID = Params.ProxyID
 
+	if err := checkPrivilege(ctx, dit.DbName, dit.CollectionName, commonpb.Privilege_PrivilegeDDL); err != nil {
+		return err
+	}
+
 	collName, fieldName := dit.CollectionName, dit.FieldName
 
 	if err := ValidateCollectionName(collName); err != nil {
@@ -3332,6 +4918,10 @@ func (gibpt *GetIndexBuildProgressTask) PreExecute(ctx context.Context) error {
 	gibpt.Base.MsgType = commonpb.MsgType_GetIndexBuildProgress
 	gibpt.Base.SourceID = Params.ProxyID
 
+	if err := checkPrivilege(ctx, gibpt.DbName, gibpt.CollectionName, readPrivileges...); err != nil {
+		return err
+	}
+
 	if err := ValidateCollectionName(gibpt.CollectionName); err != nil {
 		return err
 	}
@@ -3341,7 +4931,7 @@ func (gibpt *GetIndexBuildProgressTask) PreExecute(ctx context.Context) error {
 
 func (gibpt *GetIndexBuildProgressTask) Execute(ctx context.Context) error {
 	collectionName := gibpt.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gibpt.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -3553,6 +5143,10 @@ func (gist *GetIndexStateTask) PreExecute(ctx context.Context) error {
 	gist.Base.MsgType = commonpb.MsgType_GetIndexState
 	gist.Base.SourceID = Params.ProxyID
 
+	if err := checkPrivilege(ctx, gist.DbName, gist.CollectionName, readPrivileges...); err != nil {
+		return err
+	}
+
 	if err := ValidateCollectionName(gist.CollectionName); err != nil {
 		return err
 	}
@@ -3562,7 +5156,7 @@ func (gist *GetIndexStateTask) PreExecute(ctx context.Context) error {
 
 func (gist *GetIndexStateTask) Execute(ctx context.Context) error {
 	collectionName := gist.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gist.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -3756,13 +5350,20 @@ func (ft *FlushTask) OnEnqueue() error {
 func (ft *FlushTask) PreExecute(ctx context.Context) error {
 	ft.Base.MsgType = commonpb.MsgType_Flush
 	ft.Base.SourceID = Params.ProxyID
+
+	for _, collName := range ft.CollectionNames {
+		if err := checkPrivilege(ctx, ft.DbName, collName, commonpb.Privilege_PrivilegeInsert); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (ft *FlushTask) Execute(ctx context.Context) error {
 	coll2Segments := make(map[string]*schemapb.LongArray)
 	for _, collName := range ft.CollectionNames {
-		collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+		collID, err := globalMetaCache.GetCollectionID(ctx, ft.DbName, collName)
 		if err != nil {
 			return err
 		}
@@ -3790,7 +5391,7 @@ func (ft *FlushTask) Execute(ctx context.Context) error {
 			ErrorCode: commonpb.ErrorCode_Success,
 			Reason:    "",
 		},
-		DbName:     "",
+		DbName:     ft.DbName,
 		CollSegIDs: coll2Segments,
 	}
 	return nil
@@ -3800,6 +5401,121 @@ func (ft *FlushTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+type ImportTask struct {
+	Condition
+	*milvuspb.ImportRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	dataCoord types.DataCoord
+	result    *milvuspb.ImportResponse
+}
+
+func (it *ImportTask) TraceCtx() context.Context {
+	return it.ctx
+}
+
+func (it *ImportTask) ID() UniqueID {
+	return it.Base.MsgID
+}
+
+func (it *ImportTask) SetID(uid UniqueID) {
+	it.Base.MsgID = uid
+}
+
+func (it *ImportTask) Name() string {
+	return ImportTaskName
+}
+
+func (it *ImportTask) Type() commonpb.MsgType {
+	return it.Base.MsgType
+}
+
+func (it *ImportTask) BeginTs() Timestamp {
+	return it.Base.Timestamp
+}
+
+func (it *ImportTask) EndTs() Timestamp {
+	return it.Base.Timestamp
+}
+
+func (it *ImportTask) SetTs(ts Timestamp) {
+	it.Base.Timestamp = ts
+}
+
+func (it *ImportTask) OnEnqueue() error {
+	it.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (it *ImportTask) PreExecute(ctx context.Context) error {
+	it.Base.MsgType = commonpb.MsgType_Import
+	it.Base.SourceID = Params.ProxyID
+
+	if err := checkPrivilege(ctx, it.DbName, it.CollectionName, commonpb.Privilege_PrivilegeInsert); err != nil {
+		return err
+	}
+	if err := ValidateCollectionName(it.CollectionName); err != nil {
+		return err
+	}
+	if it.PartitionName != "" {
+		if err := ValidatePartitionTag(it.PartitionName, true); err != nil {
+			return err
+		}
+	}
+	if len(it.Files) == 0 {
+		return errors.New("no file to import")
+	}
+	return nil
+}
+
+func (it *ImportTask) Execute(ctx context.Context) error {
+	descResp, err := it.rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_DescribeCollection,
+			MsgID:     it.Base.MsgID,
+			Timestamp: it.Base.Timestamp,
+			SourceID:  it.Base.SourceID,
+		},
+		DbName:         it.DbName,
+		CollectionName: it.CollectionName,
+	})
+	if err != nil {
+		return err
+	}
+	if descResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(descResp.Status.Reason)
+	}
+	partitionName := it.PartitionName
+	if partitionName == "" {
+		partitionName = Params.DefaultPartitionName
+	}
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, it.DbName, it.CollectionName, partitionName)
+	if err != nil {
+		return err
+	}
+
+	it.result, err = it.dataCoord.Import(ctx, &datapb.ImportTaskRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_Import,
+			MsgID:     it.Base.MsgID,
+			Timestamp: it.Base.Timestamp,
+			SourceID:  it.Base.SourceID,
+		},
+		CollectionID: descResp.CollectionID,
+		PartitionID:  partitionID,
+		ChannelNames: descResp.VirtualChannelNames,
+		Files:        it.Files,
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to call import to data coordinator: %s", err.Error())
+	}
+	return nil
+}
+
+func (it *ImportTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
 type LoadCollectionTask struct {
 	Condition
 	*milvuspb.LoadCollectionRequest
@@ -3850,22 +5566,30 @@ func (lct *LoadCollectionTask) PreExecute(ctx context.Context) error {
 	lct.Base.MsgType = commonpb.MsgType_LoadCollection
 	lct.Base.SourceID = Params.ProxyID
 
+	if err := checkPrivilege(ctx, lct.DbName, lct.CollectionName, commonpb.Privilege_PrivilegeDDL); err != nil {
+		return err
+	}
+
 	collName := lct.CollectionName
 
 	if err := ValidateCollectionName(collName); err != nil {
 		return err
 	}
 
+	if lct.ReplicaNumber < 0 {
+		return fmt.Errorf("invalid replica number %d, it should be positive", lct.ReplicaNumber)
+	}
+
 	return nil
 }
 
 func (lct *LoadCollectionTask) Execute(ctx context.Context) (err error) {
 	log.Debug("LoadCollectionTask Execute", zap.String("role", Params.RoleName), zap.Int64("msgID", lct.Base.MsgID))
-	collID, err := globalMetaCache.GetCollectionID(ctx, lct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lct.DbName, lct.CollectionName)
 	if err != nil {
 		return err
 	}
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.DbName, lct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -3877,16 +5601,19 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) (err error) {
 			Timestamp: lct.Base.Timestamp,
 			SourceID:  lct.Base.SourceID,
 		},
-		DbID:         0,
-		CollectionID: collID,
-		Schema:       collSchema,
+		DbID:          0,
+		CollectionID:  collID,
+		Schema:        collSchema,
+		ReplicaNumber: lct.ReplicaNumber,
 	}
 	log.Debug("send LoadCollectionRequest to query coordinator", zap.String("role", Params.RoleName), zap.Int64("msgID", request.Base.MsgID), zap.Int64("collectionID", request.CollectionID),
+		zap.Int32("replicaNumber", request.ReplicaNumber),
 		zap.Any("schema", request.Schema))
 	lct.result, err = lct.queryCoord.LoadCollection(ctx, request)
 	if err != nil {
 		return fmt.Errorf("call query coordinator LoadCollection: %s", err)
 	}
+	globalMetaCache.RemoveReplicas(ctx, collID)
 	return nil
 }
 
@@ -3945,6 +5672,10 @@ func (rct *ReleaseCollectionTask) PreExecute(ctx context.Context) error {
 	rct.Base.MsgType = commonpb.MsgType_ReleaseCollection
 	rct.Base.SourceID = Params.ProxyID
 
+	if err := checkPrivilege(ctx, rct.DbName, rct.CollectionName, commonpb.Privilege_PrivilegeDDL); err != nil {
+		return err
+	}
+
 	collName := rct.CollectionName
 
 	if err := ValidateCollectionName(collName); err != nil {
@@ -3955,7 +5686,7 @@ func (rct *ReleaseCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (rct *ReleaseCollectionTask) Execute(ctx context.Context) (err error) {
-	collID, err := globalMetaCache.GetCollectionID(ctx, rct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rct.DbName, rct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -3973,6 +5704,7 @@ func (rct *ReleaseCollectionTask) Execute(ctx context.Context) (err error) {
 	rct.result, err = rct.queryCoord.ReleaseCollection(ctx, request)
 
 	_ = rct.chMgr.removeDQLStream(collID)
+	globalMetaCache.RemoveReplicas(ctx, collID)
 
 	return err
 }
@@ -4030,6 +5762,10 @@ func (lpt *LoadPartitionTask) PreExecute(ctx context.Context) error {
 	lpt.Base.MsgType = commonpb.MsgType_LoadPartitions
 	lpt.Base.SourceID = Params.ProxyID
 
+	if err := checkPrivilege(ctx, lpt.DbName, lpt.CollectionName, commonpb.Privilege_PrivilegeDDL); err != nil {
+		return err
+	}
+
 	collName := lpt.CollectionName
 
 	if err := ValidateCollectionName(collName); err != nil {
@@ -4041,16 +5777,16 @@ func (lpt *LoadPartitionTask) PreExecute(ctx context.Context) error {
 
 func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.DbName, lpt.CollectionName)
 	if err != nil {
 		return err
 	}
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.DbName, lpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	for _, partitionName := range lpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.DbName, lpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -4125,6 +5861,10 @@ func (rpt *ReleasePartitionTask) PreExecute(ctx context.Context) error {
 	rpt.Base.MsgType = commonpb.MsgType_ReleasePartitions
 	rpt.Base.SourceID = Params.ProxyID
 
+	if err := checkPrivilege(ctx, rpt.DbName, rpt.CollectionName, commonpb.Privilege_PrivilegeDDL); err != nil {
+		return err
+	}
+
 	collName := rpt.CollectionName
 
 	if err := ValidateCollectionName(collName); err != nil {
@@ -4136,12 +5876,12 @@ func (rpt *ReleasePartitionTask) PreExecute(ctx context.Context) error {
 
 func (rpt *ReleasePartitionTask) Execute(ctx context.Context) (err error) {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.DbName, rpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	for _, partitionName := range rpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.DbName, rpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
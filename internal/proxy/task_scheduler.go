@@ -178,6 +178,10 @@ func (queue *BaseTaskQueue) TaskDoneTest(ts Timestamp) bool {
 }
 
 func (queue *BaseTaskQueue) Enqueue(t task) error {
+	if err := queue.sched.checkRateLimit(t); err != nil {
+		return err
+	}
+
 	err := t.OnEnqueue()
 	if err != nil {
 		return err
@@ -215,6 +219,10 @@ type DmTaskQueue struct {
 }
 
 func (queue *DmTaskQueue) Enqueue(t task) error {
+	if err := queue.sched.checkRateLimit(t); err != nil {
+		return err
+	}
+
 	err := t.OnEnqueue()
 	if err != nil {
 		return err
@@ -387,6 +395,7 @@ type TaskScheduler struct {
 
 	idAllocator  *allocator.IDAllocator
 	tsoAllocator *TimestampAllocator
+	rateLimiter  *rateLimiter // nil if rate limiting is disabled
 
 	wg     sync.WaitGroup
 	ctx    context.Context
@@ -414,6 +423,14 @@ func NewTaskScheduler(ctx context.Context,
 	return s, nil
 }
 
+// checkRateLimit returns an error if the task is throttled by the rate limits or the storage quotas
+func (sched *TaskScheduler) checkRateLimit(t task) error {
+	if sched.rateLimiter == nil {
+		return nil
+	}
+	return sched.rateLimiter.check(t)
+}
+
 func (sched *TaskScheduler) scheduleDdTask() task {
 	return sched.DdQueue.PopUnissuedTask()
 }
@@ -530,6 +547,12 @@ type resultBufHeader struct {
 	haveError                   bool
 }
 
+// searchResultBufKey identifies the results of a search served by a replica
+type searchResultBufKey struct {
+	reqID     UniqueID
+	replicaID UniqueID
+}
+
 type searchResultBuf struct {
 	resultBufHeader
 	resultBuf []*internalpb.SearchResults
@@ -674,8 +697,9 @@ func (sched *TaskScheduler) collectResultLoop() {
 	queryResultMsgStream.Start()
 	defer queryResultMsgStream.Close()
 
-	searchResultBufs := make(map[UniqueID]*searchResultBuf)
-	searchResultBufFlags := make(map[UniqueID]bool) // if value is true, we can ignore queryResult
+	// the results of a search failed over to another replica are collected apart from the ones of the failed replica
+	searchResultBufs := make(map[searchResultBufKey]*searchResultBuf)
+	searchResultBufFlags := make(map[searchResultBufKey]bool) // if value is true, we can ignore queryResult
 	queryResultBufs := make(map[UniqueID]*queryResultBuf)
 	queryResultBufFlags := make(map[UniqueID]bool) // if value is true, we can ignore queryResult
 
@@ -696,9 +720,10 @@ func (sched *TaskScheduler) collectResultLoop() {
 				if searchResultMsg, srOk := tsMsg.(*msgstream.SearchResultMsg); srOk {
 					reqID := searchResultMsg.Base.MsgID
 					reqIDStr := strconv.FormatInt(reqID, 10)
-					ignoreThisResult, ok := searchResultBufFlags[reqID]
+					bufKey := searchResultBufKey{reqID: reqID, replicaID: searchResultMsg.ReplicaID}
+					ignoreThisResult, ok := searchResultBufFlags[bufKey]
 					if !ok {
-						searchResultBufFlags[reqID] = false
+						searchResultBufFlags[bufKey] = false
 						ignoreThisResult = false
 					}
 					if ignoreThisResult {
@@ -709,27 +734,27 @@ func (sched *TaskScheduler) collectResultLoop() {
 					log.Debug("Proxy collectResultLoop Got a SearchResultMsg", zap.Any("ReqID", reqID), zap.Any("t", t))
 					if t == nil {
 						log.Debug("Proxy collectResultLoop GetTaskByReqID failed", zap.String("reqID", reqIDStr))
-						delete(searchResultBufs, reqID)
-						searchResultBufFlags[reqID] = true
+						delete(searchResultBufs, bufKey)
+						searchResultBufFlags[bufKey] = true
 						continue
 					}
 
 					st, ok := t.(*SearchTask)
 					if !ok {
 						log.Debug("Proxy collectResultLoop type assert t as SearchTask failed", zap.Any("t", t))
-						delete(searchResultBufs, reqID)
-						searchResultBufFlags[reqID] = true
+						delete(searchResultBufs, bufKey)
+						searchResultBufFlags[bufKey] = true
 						continue
 					}
 
-					resultBuf, ok := searchResultBufs[reqID]
+					resultBuf, ok := searchResultBufs[bufKey]
 					if !ok {
 						resultBuf = newSearchResultBuf()
 						vchans, err := st.getVChannels()
 						log.Debug("Proxy collectResultLoop, first receive", zap.Any("reqID", reqID), zap.Any("vchans", vchans),
 							zap.Error(err))
 						if err != nil {
-							delete(searchResultBufs, reqID)
+							delete(searchResultBufs, bufKey)
 							continue
 						}
 						for _, vchan := range vchans {
@@ -739,24 +764,24 @@ func (sched *TaskScheduler) collectResultLoop() {
 						log.Debug("Proxy collectResultLoop, first receive", zap.Any("reqID", reqID), zap.Any("pchans", pchans),
 							zap.Error(err))
 						if err != nil {
-							delete(searchResultBufs, reqID)
+							delete(searchResultBufs, bufKey)
 							continue
 						}
-						searchResultBufs[reqID] = resultBuf
+						searchResultBufs[bufKey] = resultBuf
 					}
 					resultBuf.addPartialResult(&searchResultMsg.SearchResults)
 
 					//t := sched.getTaskByReqID(reqID)
 					{
 						colName := t.(*SearchTask).query.CollectionName
-						log.Debug("Proxy collectResultLoop", zap.String("collection name", colName), zap.String("reqID", reqIDStr), zap.Int("answer cnt", len(searchResultBufs[reqID].resultBuf)))
+						log.Debug("Proxy collectResultLoop", zap.String("collection name", colName), zap.String("reqID", reqIDStr), zap.Int("answer cnt", len(searchResultBufs[bufKey].resultBuf)))
 					}
 
 					if resultBuf.readyToReduce() {
 						log.Debug("Proxy collectResultLoop readyToReduce and assign to reduce")
-						searchResultBufFlags[reqID] = true
+						searchResultBufFlags[bufKey] = true
 						st.resultBuf <- resultBuf.resultBuf
-						delete(searchResultBufs, reqID)
+						delete(searchResultBufs, bufKey)
 					}
 
 					sp.Finish()
@@ -0,0 +1,444 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+func TestUpsertTask_getDeleteMsgs(t *testing.T) {
+	Params.Init()
+	newTask := func(partitionName string, ids *schemapb.IDs, hashValues []uint32) *UpsertTask {
+		ut := &UpsertTask{
+			InsertTask: InsertTask{
+				BaseInsertTask: BaseInsertTask{
+					BaseMsg: msgstream.BaseMsg{
+						BeginTimestamp: 100,
+						EndTimestamp:   100,
+						HashValues:     hashValues,
+					},
+					InsertRequest: internalpb.InsertRequest{
+						Base:           &commonpb.MsgBase{MsgID: 1},
+						CollectionName: "coll",
+						CollectionID:   10,
+						PartitionID:    20,
+					},
+				},
+				result: &milvuspb.MutationResult{IDs: ids},
+			},
+			upsertReq: &milvuspb.UpsertRequest{PartitionName: partitionName},
+		}
+		return ut
+	}
+
+	ids := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}}
+	msgs := newTask("", ids, []uint32{0, 1, 2}).getDeleteMsgs(context.Background(), []vChan{"ch0", "ch1"})
+	assert.Equal(t, 2, len(msgs))
+	del := msgs[0].(*msgstream.DeleteMsg)
+	assert.Equal(t, commonpb.MsgType_Delete, del.Type())
+	assert.Equal(t, "ch0", del.ChannelID)
+	assert.Equal(t, []int64{1, 3}, del.PrimaryKeys)
+	assert.Equal(t, []uint32{0, 2}, del.HashValues)
+	assert.Equal(t, []uint64{100, 100}, del.Timestamps)
+	assert.EqualValues(t, 10, del.CollectionID)
+	// existing entities are deleted from all partitions
+	assert.EqualValues(t, 0, del.PartitionID)
+	del = msgs[1].(*msgstream.DeleteMsg)
+	assert.Equal(t, "ch1", del.ChannelID)
+	assert.Equal(t, []int64{2}, del.PrimaryKeys)
+
+	ids = &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a", "b"}}}}
+	msgs = newTask("p1", ids, []uint32{5, 7}).getDeleteMsgs(context.Background(), []vChan{"ch0"})
+	assert.Equal(t, 1, len(msgs))
+	del = msgs[0].(*msgstream.DeleteMsg)
+	assert.Equal(t, []string{"a", "b"}, del.StringPrimaryKeys)
+	assert.Empty(t, del.PrimaryKeys)
+	assert.EqualValues(t, 20, del.PartitionID)
+}
+
+func TestDeleteTask_getDeleteMsgs(t *testing.T) {
+	Params.Init()
+	dt := &DeleteTask{
+		BaseDeleteTask: BaseDeleteTask{
+			BaseMsg: msgstream.BaseMsg{
+				BeginTimestamp: 100,
+				EndTimestamp:   100,
+			},
+			DeleteRequest: internalpb.DeleteRequest{
+				Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_Delete, MsgID: 1},
+				CollectionID: 10,
+				PartitionID:  20,
+				PrimaryKeys:  []int64{1, 2, 3},
+				Timestamps:   []uint64{100, 100, 100},
+			},
+		},
+		hashValues: []uint32{0, 1, 2},
+	}
+	msgs := dt.getDeleteMsgs(context.Background(), []vChan{"ch0", "ch1"})
+	assert.Equal(t, 2, len(msgs))
+	del := msgs[0].(*msgstream.DeleteMsg)
+	assert.Equal(t, "ch0", del.ChannelID)
+	assert.Equal(t, []int64{1, 3}, del.PrimaryKeys)
+	assert.Equal(t, []uint32{0, 2}, del.HashValues)
+	assert.Equal(t, []uint64{100, 100}, del.Timestamps)
+	assert.EqualValues(t, 10, del.CollectionID)
+	assert.EqualValues(t, 20, del.PartitionID)
+	del = msgs[1].(*msgstream.DeleteMsg)
+	assert.Equal(t, "ch1", del.ChannelID)
+	assert.Equal(t, []int64{2}, del.PrimaryKeys)
+	assert.Equal(t, []uint32{1}, del.HashValues)
+
+	dt.PrimaryKeys = nil
+	dt.StringPrimaryKeys = []string{"a", "b"}
+	dt.Timestamps = []uint64{100, 100}
+	dt.hashValues = []uint32{5, 7}
+	msgs = dt.getDeleteMsgs(context.Background(), []vChan{"ch0"})
+	assert.Equal(t, 1, len(msgs))
+	del = msgs[0].(*msgstream.DeleteMsg)
+	assert.Equal(t, []string{"a", "b"}, del.StringPrimaryKeys)
+	assert.Empty(t, del.PrimaryKeys)
+}
+
+func TestInsertTask_fillDefaultFields(t *testing.T) {
+	it := &InsertTask{
+		req: &milvuspb.InsertRequest{
+			NumRows: 2,
+			FieldsData: []*schemapb.FieldData{
+				{Type: schemapb.DataType_Int64, FieldName: "pk"},
+				{Type: schemapb.DataType_Int32, FieldName: "age"},
+			},
+		},
+		schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32,
+					DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 18}}},
+				{FieldID: 102, Name: "name", DataType: schemapb.DataType_String,
+					DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "unknown"}}},
+			},
+		},
+	}
+	assert.NoError(t, it.fillDefaultFields())
+	// the fields in the request are kept, the missing ones are appended
+	assert.Equal(t, 3, len(it.req.FieldsData))
+	assert.Nil(t, it.req.FieldsData[1].GetScalars())
+	assert.Equal(t, "name", it.req.FieldsData[2].FieldName)
+	assert.Equal(t, []string{"unknown", "unknown"}, it.req.FieldsData[2].GetScalars().GetStringData().GetData())
+
+	// the default value mismatching the type of the field is rejected
+	it.schema.Fields[2].DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 1}}
+	it.req.FieldsData = it.req.FieldsData[:2]
+	assert.Error(t, it.fillDefaultFields())
+}
+
+func newRetrieveResults(pks []int64, values []int64) *internalpb.RetrieveResults {
+	return &internalpb.RetrieveResults{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}},
+		},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:      schemapb.DataType_Int64,
+				FieldName: "value",
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestReduceRetrieveResults(t *testing.T) {
+	partialResults := []*internalpb.RetrieveResults{
+		newRetrieveResults([]int64{1, 4, 5}, []int64{10, 40, 50}),
+		newRetrieveResults([]int64{2, 3, 4, 6}, []int64{20, 30, 40, 60}),
+	}
+
+	result := reduceRetrieveResults(partialResults, 0, 0)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6}, result.GetIds().GetIntId().GetData())
+	assert.Equal(t, []int64{10, 20, 30, 40, 50, 60}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+	result = reduceRetrieveResults(partialResults, 2, 3)
+	assert.Equal(t, []int64{3, 4, 5}, result.GetIds().GetIntId().GetData())
+	assert.Equal(t, []int64{30, 40, 50}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+	result = reduceRetrieveResults(partialResults, 5, 0)
+	assert.Equal(t, []int64{6}, result.GetIds().GetIntId().GetData())
+
+	// no rows after offset
+	result = reduceRetrieveResults(partialResults, 6, 10)
+	assert.Equal(t, 0, len(result.GetFieldsData()))
+
+	strResults := []*internalpb.RetrieveResults{
+		{Ids: &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"b", "d"}}}}},
+		{Ids: &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a", "c"}}}}},
+	}
+	result = reduceRetrieveResults(strResults, 1, 2)
+	assert.Equal(t, []string{"b", "c"}, result.GetIds().GetStrId().GetData())
+}
+
+func TestReduceSearchResultData_offset(t *testing.T) {
+	// 2 query nodes, 1 query, top 3 hits of each node, scores are sorted in descending order
+	newSearchResultData := func(ids []int64, scores []float32) *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       3,
+			Scores:     scores,
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}},
+			},
+		}
+	}
+	data := []*schemapb.SearchResultData{
+		newSearchResultData([]int64{1, 3, 5}, []float32{9, 7, 5}),
+		newSearchResultData([]int64{2, 4, 6}, []float32{8, 6, 4}),
+	}
+
+	result, err := reduceSearchResultData(data, 1, 2, 3, 0, "IP")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, result.GetResults().GetIds().GetIntId().GetData())
+
+	result, err = reduceSearchResultData(data, 1, 2, 3, 1, "IP")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 3}, result.GetResults().GetIds().GetIntId().GetData())
+	assert.Equal(t, []float32{8, 7}, result.GetResults().GetScores())
+	assert.Equal(t, []int64{2}, result.GetResults().GetTopks())
+	assert.EqualValues(t, 2, result.GetResults().GetTopK())
+
+	result, err = reduceSearchResultData(data, 1, 2, 3, 3, "IP")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(result.GetResults().GetIds().GetIntId().GetData()))
+	assert.Equal(t, []int64{0}, result.GetResults().GetTopks())
+}
+
+func TestReduceSearchResultData_duplicated(t *testing.T) {
+	// segment of hits 1 and 3 is searched on both query nodes while it's moved between them
+	data := []*schemapb.SearchResultData{
+		{
+			NumQueries: 1,
+			TopK:       3,
+			Scores:     []float32{9, 7, 5},
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 3, 5}}}},
+		},
+		{
+			NumQueries: 1,
+			TopK:       3,
+			Scores:     []float32{9, 8, 7},
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}},
+		},
+	}
+	result, err := reduceSearchResultData(data, 1, 2, 3, 0, "IP")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, result.GetResults().GetIds().GetIntId().GetData())
+	assert.Equal(t, []float32{9, 8, 7}, result.GetResults().GetScores())
+
+	result, err = reduceSearchResultData(data, 1, 2, 3, 2, "IP")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3}, result.GetResults().GetIds().GetIntId().GetData())
+}
+
+func TestReduceSearchResultData_variableTopks(t *testing.T) {
+	// 2 queries of range search, the hits of each query are sorted in descending order of scores
+	data := []*schemapb.SearchResultData{
+		{
+			NumQueries: 2,
+			TopK:       3,
+			Topks:      []int64{2, 0},
+			Scores:     []float32{9, 5},
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 3}}}},
+		},
+		{
+			NumQueries: 2,
+			TopK:       3,
+			Topks:      []int64{1, 3},
+			Scores:     []float32{7, 8, 6, 4},
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{2, 4, 5, 6}}}},
+		},
+	}
+	result, err := reduceSearchResultData(data, 2, 2, 3, 0, "IP")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3, 3}, result.GetResults().GetTopks())
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6}, result.GetResults().GetIds().GetIntId().GetData())
+	assert.Equal(t, []float32{9, 7, 5, 8, 6, 4}, result.GetResults().GetScores())
+
+	result, err = reduceSearchResultData(data, 2, 2, 3, 2, "IP")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 1}, result.GetResults().GetTopks())
+	assert.Equal(t, []int64{3, 6}, result.GetResults().GetIds().GetIntId().GetData())
+	assert.EqualValues(t, 1, result.GetResults().GetTopK())
+	assert.False(t, result.GetResults().GetRangeSearchTruncated())
+	assert.Empty(t, result.GetStatus().GetReason())
+
+	data[1].RangeSearchTruncated = true
+	result, err = reduceSearchResultData(data, 2, 2, 3, 0, "IP")
+	assert.NoError(t, err)
+	assert.True(t, result.GetResults().GetRangeSearchTruncated())
+	assert.Equal(t, commonpb.ErrorCode_Success, result.GetStatus().GetErrorCode())
+	assert.NotEmpty(t, result.GetStatus().GetReason())
+}
+
+func TestParseRangeSearchParams(t *testing.T) {
+	newParams := func(kvs ...string) []*commonpb.KeyValuePair {
+		params := make([]*commonpb.KeyValuePair, 0, len(kvs)/2)
+		for i := 0; i < len(kvs); i += 2 {
+			params = append(params, &commonpb.KeyValuePair{Key: kvs[i], Value: kvs[i+1]})
+		}
+		return params
+	}
+
+	queryInfo := &planpb.QueryInfo{MetricType: "L2"}
+	assert.NoError(t, parseRangeSearchParams(newParams(TopKKey, "10"), queryInfo))
+	assert.False(t, queryInfo.RangeSearch)
+
+	assert.NoError(t, parseRangeSearchParams(newParams(RadiusKey, "2.5", RangeFilterKey, "1"), queryInfo))
+	assert.True(t, queryInfo.RangeSearch)
+	assert.EqualValues(t, 2.5, queryInfo.Radius)
+	assert.True(t, queryInfo.HasRangeFilter)
+	assert.EqualValues(t, 1, queryInfo.RangeFilter)
+
+	assert.Error(t, parseRangeSearchParams(newParams(RangeFilterKey, "1"), &planpb.QueryInfo{MetricType: "L2"}))
+	assert.Error(t, parseRangeSearchParams(newParams(RadiusKey, "abc"), &planpb.QueryInfo{MetricType: "L2"}))
+	assert.Error(t, parseRangeSearchParams(newParams(RadiusKey, "1", RangeFilterKey, "abc"), &planpb.QueryInfo{MetricType: "L2"}))
+	assert.Error(t, parseRangeSearchParams(newParams(RadiusKey, "1", RangeFilterKey, "2"), &planpb.QueryInfo{MetricType: "L2"}))
+	assert.NoError(t, parseRangeSearchParams(newParams(RadiusKey, "0.5", RangeFilterKey, "0.9"), &planpb.QueryInfo{MetricType: "IP"}))
+	assert.Error(t, parseRangeSearchParams(newParams(RadiusKey, "0.9", RangeFilterKey, "0.5"), &planpb.QueryInfo{MetricType: "IP"}))
+}
+
+type replicaQueryCoord struct {
+	types.QueryCoord
+	resp  *querypb.GetReplicasResponse
+	calls int
+}
+
+func (m *replicaQueryCoord) GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+	m.calls++
+	return m.resp, nil
+}
+
+// setReplicaMetaCache replaces the global meta cache with the one getting the replicas from qc during the test
+func setReplicaMetaCache(t *testing.T, qc types.QueryCoord) {
+	oldCache := globalMetaCache
+	t.Cleanup(func() {
+		globalMetaCache = oldCache
+	})
+	cache, err := NewMetaCache(&databaseRootCoord{}, qc)
+	assert.NoError(t, err)
+	globalMetaCache = cache
+}
+
+func TestSelectReplicas(t *testing.T) {
+	qc := &replicaQueryCoord{resp: &querypb.GetReplicasResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}}
+	setReplicaMetaCache(t, qc)
+	replicas, err := selectReplicas(context.TODO(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(replicas))
+
+	// no replica is cached for the collection without replicas
+	qc.resp.Replicas = []*querypb.ReplicaInfo{{ReplicaID: 1}, {ReplicaID: 2}, {ReplicaID: 3}}
+	first := make(map[UniqueID]int)
+	for i := 0; i < 6; i++ {
+		replicas, err = selectReplicas(context.TODO(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(replicas))
+		first[replicas[0].ReplicaID]++
+		// the others are failed over to in turn
+		for j := 1; j < len(replicas); j++ {
+			assert.Equal(t, (replicas[0].ReplicaID-1+UniqueID(j))%3+1, replicas[j].ReplicaID)
+		}
+	}
+	assert.Equal(t, map[UniqueID]int{1: 2, 2: 2, 3: 2}, first)
+	// the replicas are cached
+	assert.Equal(t, 2, qc.calls)
+
+	globalMetaCache.RemoveReplicas(context.TODO(), 1)
+	qc.resp.Status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "none on service"}
+	_, err = selectReplicas(context.TODO(), 1)
+	assert.Error(t, err)
+}
+
+func TestSearchTask_failover(t *testing.T) {
+	qc := &replicaQueryCoord{resp: &querypb.GetReplicasResponse{
+		Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Replicas: []*querypb.ReplicaInfo{{ReplicaID: 1}, {ReplicaID: 2}},
+	}}
+	setReplicaMetaCache(t, qc)
+	_, err := selectReplicas(context.TODO(), 1)
+	assert.NoError(t, err)
+
+	st := &SearchTask{
+		SearchRequest: &internalpb.SearchRequest{Base: &commonpb.MsgBase{}, ReplicaID: 1, CollectionID: 1},
+		query:         &milvuspb.SearchRequest{DbName: "db2", CollectionName: "collection1"},
+	}
+	failed := []*internalpb.SearchResults{
+		{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
+		{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "node down"}},
+	}
+	assert.Equal(t, "", getSearchFailedReason(failed[:1]))
+	assert.Equal(t, "node down", getSearchFailedReason(failed))
+
+	// no replica to fail over to
+	assert.Nil(t, st.replicaTimeout())
+	assert.False(t, st.failover(context.TODO(), "node down"))
+	assert.EqualValues(t, 1, st.ReplicaID)
+	assert.Equal(t, 1, qc.calls)
+
+	// the search can't be resent to the next replica as the collection is not found, the replica is kept
+	st.replicas = []*querypb.ReplicaInfo{{ReplicaID: 2}}
+	assert.NotNil(t, st.replicaTimeout())
+	assert.False(t, st.failover(context.TODO(), "node down"))
+	assert.EqualValues(t, 1, st.ReplicaID)
+	assert.Equal(t, 0, len(st.replicas))
+	// the cached replicas are removed
+	_, err = selectReplicas(context.TODO(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, qc.calls)
+}
+
+func TestSearchTask_PostExecute_replicaTimeout(t *testing.T) {
+	setReplicaMetaCache(t, &replicaQueryCoord{})
+	oldTimeout := Params.ReplicaTimeout
+	defer func() {
+		Params.ReplicaTimeout = oldTimeout
+	}()
+	Params.ReplicaTimeout = 10 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	st := &SearchTask{
+		ctx:           ctx,
+		SearchRequest: &internalpb.SearchRequest{Base: &commonpb.MsgBase{}, ReplicaID: 1, CollectionID: 1},
+		query:         &milvuspb.SearchRequest{DbName: "db2", CollectionName: "collection1"},
+		resultBuf:     make(chan []*internalpb.SearchResults),
+		replicas:      []*querypb.ReplicaInfo{{ReplicaID: 2}},
+	}
+	// the replica timed out and is failed over before the search does
+	assert.Error(t, st.PostExecute(ctx))
+	assert.Equal(t, 0, len(st.replicas))
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/retry"
 )
 
@@ -79,3 +80,14 @@ func GetAttrByKeyFromRepeatedKV(key string, kvs []*commonpb.KeyValuePair) (strin
 
 	return "", errors.New("key " + key + " not found")
 }
+
+// getNumOfIDs returns the number of the int64 or string ids
+func getNumOfIDs(ids *schemapb.IDs) int {
+	switch field := ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		return len(field.IntId.GetData())
+	case *schemapb.IDs_StrId:
+		return len(field.StrId.GetData())
+	}
+	return 0
+}
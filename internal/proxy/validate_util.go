@@ -14,11 +14,16 @@ package proxy
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
 func isAlpha(c uint8) bool {
@@ -65,6 +70,94 @@ func ValidateCollectionName(collName string) error {
 	return nil
 }
 
+func ValidateDatabaseName(dbName string) error {
+	dbName = strings.TrimSpace(dbName)
+
+	if dbName == "" {
+		return errors.New("Database name should not be empty")
+	}
+
+	invalidMsg := "Invalid database name: " + dbName + ". "
+	if int64(len(dbName)) > Params.MaxNameLength {
+		msg := invalidMsg + "The length of a database name must be less than " +
+			strconv.FormatInt(Params.MaxNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := dbName[0]
+	if firstChar != '_' && !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of a database name must be an underscore or letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(dbName); i++ {
+		c := dbName[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Database name can only contain numbers, letters and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
+// validateCredentialName checks the name of a user or role, kind is used in the error messages
+func validateCredentialName(name string, kind string) error {
+	if name == "" {
+		return fmt.Errorf("%s name should not be empty", kind)
+	}
+
+	invalidMsg := fmt.Sprintf("Invalid %s name: %s. ", kind, name)
+	if int64(len(name)) > Params.MaxNameLength {
+		return fmt.Errorf("%sThe length of a %s name must be less than %d characters.", invalidMsg, kind, Params.MaxNameLength)
+	}
+
+	if !isAlpha(name[0]) {
+		return fmt.Errorf("%sThe first character of a %s name must be a letter.", invalidMsg, kind)
+	}
+
+	for i := 1; i < len(name); i++ {
+		c := name[i]
+		if c != '_' && c != '-' && !isAlpha(c) && !isNumber(c) {
+			return fmt.Errorf("%sThe %s name can only contain numbers, letters, dashes and underscores.", invalidMsg, kind)
+		}
+	}
+	return nil
+}
+
+func ValidateUsername(username string) error {
+	return validateCredentialName(username, "user")
+}
+
+func ValidateRoleName(roleName string) error {
+	return validateCredentialName(roleName, "role")
+}
+
+// ValidatePassword checks the length of password, bcrypt only uses the first 72 bytes of a password
+func ValidatePassword(password string) error {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return fmt.Errorf("The length of a password must be between %d and %d bytes", minPasswordLength, maxPasswordLength)
+	}
+	return nil
+}
+
+// ValidateGrant checks the database and collection names and the privilege of a grant, "*" matches all of them
+func ValidateGrant(grant *milvuspb.Grant) error {
+	if grant.DbName != "" && grant.DbName != anyName {
+		if err := ValidateDatabaseName(grant.DbName); err != nil {
+			return err
+		}
+	}
+	if grant.CollectionName != anyName {
+		if err := ValidateCollectionName(grant.CollectionName); err != nil {
+			return err
+		}
+	}
+	if _, ok := commonpb.Privilege_name[int32(grant.Privilege)]; !ok || grant.Privilege == commonpb.Privilege_PrivilegeNone {
+		return fmt.Errorf("Invalid privilege: %d", grant.Privilege)
+	}
+	return nil
+}
+
 func ValidatePartitionTag(partitionTag string, strictCheck bool) error {
 	partitionTag = strings.TrimSpace(partitionTag)
 
@@ -177,6 +270,9 @@ func ValidateFieldAutoID(coll *schemapb.CollectionSchema) error {
 			if !field.IsPrimaryKey {
 				return fmt.Errorf("only primary field can speficy AutoID with true, field name = %s", field.Name)
 			}
+			if field.DataType != schemapb.DataType_Int64 {
+				return fmt.Errorf("only int64 primary field can speficy AutoID with true, field name = %s", field.Name)
+			}
 		}
 	}
 	return nil
@@ -189,10 +285,12 @@ func ValidatePrimaryKey(coll *schemapb.CollectionSchema) error {
 			if idx != -1 {
 				return fmt.Errorf("there are more than one primary key, field name = %s, %s", coll.Fields[idx].Name, field.Name)
 			}
-			if field.DataType != schemapb.DataType_Int64 {
-				return errors.New("the data type of primary key should be int64")
+			if field.DataType != schemapb.DataType_Int64 && field.DataType != schemapb.DataType_String {
+				return errors.New("the data type of primary key should be int64 or string")
 			}
 			idx = i
+		} else if field.DataType == schemapb.DataType_String {
+			return fmt.Errorf("only primary key can be of string type now, field name = %s", field.Name)
 		}
 	}
 	if idx == -1 {
@@ -201,6 +299,90 @@ func ValidatePrimaryKey(coll *schemapb.CollectionSchema) error {
 	return nil
 }
 
+// ValidateStringPrimaryKeys checks the string primary keys to insert or delete,
+// empty strings are not allowed as they cannot be stored in binlogs
+func ValidateStringPrimaryKeys(pks []string) error {
+	for _, pk := range pks {
+		if pk == "" {
+			return errors.New("string primary key should not be empty")
+		}
+	}
+	return nil
+}
+
+// ValidateCompression checks the compression codecs of the collection and its fields are supported
+func ValidateCompression(coll *schemapb.CollectionSchema) error {
+	if _, ok := schemapb.CompressionType_name[int32(coll.Compression)]; !ok {
+		return fmt.Errorf("unsupported compression type %d of collection %s", coll.Compression, coll.Name)
+	}
+	for _, field := range coll.Fields {
+		if _, ok := schemapb.CompressionType_name[int32(field.Compression)]; !ok {
+			return fmt.Errorf("unsupported compression type %d of field %s", field.Compression, field.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateTravelTimestamp checks whether travelTs is not in the future of tMax and the history at travelTs is
+// still retained at tMax
+func ValidateTravelTimestamp(travelTs, tMax Timestamp) error {
+	if travelTs > tMax {
+		return fmt.Errorf("travel timestamp %d is later than the timestamp %d of the request, can't travel to the future",
+			travelTs, tMax)
+	}
+	tMin := tsoutil.SubPhysicalDuration(tMax, time.Duration(Params.RetentionDuration)*time.Second)
+	if travelTs < tMin {
+		return fmt.Errorf("travel timestamp %d is out of the retention duration of %d seconds, the earliest timestamp to travel is %d",
+			travelTs, Params.RetentionDuration, tMin)
+	}
+	return nil
+}
+
+// MaxResultWindow is the max offset + limit of a query or a search, since the rows of offset + limit are
+// reduced on the proxy before the first offset rows are skipped
+const MaxResultWindow = 16384
+
+// ValidateResultWindow checks the offset and the limit (topk of a search) of a query or a search
+func ValidateResultWindow(offset, limit int64) error {
+	if offset < 0 {
+		return fmt.Errorf("offset %d should not be negative", offset)
+	}
+	if limit < 0 {
+		return fmt.Errorf("limit %d should not be negative", limit)
+	}
+	if offset+limit > MaxResultWindow {
+		return fmt.Errorf("offset %d + limit %d should not exceed %d", offset, limit, MaxResultWindow)
+	}
+	return nil
+}
+
+// ValidateRangeSearch checks the distance range of a range search, range_filter is the inner bound of the range,
+// so it should be closer than radius, i.e. larger for IP and smaller for the other metrics
+func ValidateRangeSearch(queryInfo *planpb.QueryInfo) error {
+	if !queryInfo.GetRangeSearch() {
+		return nil
+	}
+	radius := float64(queryInfo.GetRadius())
+	if math.IsNaN(radius) || math.IsInf(radius, 0) {
+		return fmt.Errorf("radius %v is not a finite number", radius)
+	}
+	if !queryInfo.GetHasRangeFilter() {
+		return nil
+	}
+	rangeFilter := float64(queryInfo.GetRangeFilter())
+	if math.IsNaN(rangeFilter) || math.IsInf(rangeFilter, 0) {
+		return fmt.Errorf("range_filter %v is not a finite number", rangeFilter)
+	}
+	if strings.ToUpper(queryInfo.GetMetricType()) == "IP" {
+		if rangeFilter <= radius {
+			return fmt.Errorf("range_filter %v should be larger than radius %v for metric IP", rangeFilter, radius)
+		}
+	} else if rangeFilter >= radius {
+		return fmt.Errorf("range_filter %v should be smaller than radius %v for metric %s", rangeFilter, radius, queryInfo.GetMetricType())
+	}
+	return nil
+}
+
 func RepeatedKeyValToMap(kvPairs []*commonpb.KeyValuePair) (map[string]string, error) {
 	resMap := make(map[string]string)
 	for _, kv := range kvPairs {
@@ -218,7 +400,8 @@ func isVector(dataType schemapb.DataType) (bool, error) {
 	case schemapb.DataType_Bool, schemapb.DataType_Int8,
 		schemapb.DataType_Int16, schemapb.DataType_Int32,
 		schemapb.DataType_Int64,
-		schemapb.DataType_Float, schemapb.DataType_Double:
+		schemapb.DataType_Float, schemapb.DataType_Double,
+		schemapb.DataType_String:
 		return false, nil
 
 	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
@@ -262,10 +445,12 @@ func ValidateSchema(coll *schemapb.CollectionSchema) error {
 			} else if primaryIdx != -1 {
 				return fmt.Errorf("there are more than one primary key, field name = %s, %s", coll.Fields[primaryIdx].Name, field.Name)
 			}
-			if field.DataType != schemapb.DataType_Int64 {
-				return fmt.Errorf("type of primary key shoule be int64")
+			if field.DataType != schemapb.DataType_Int64 && field.DataType != schemapb.DataType_String {
+				return fmt.Errorf("type of primary key shoule be int64 or string")
 			}
 			primaryIdx = idx
+		} else if field.DataType == schemapb.DataType_String {
+			return fmt.Errorf("only primary key can be of string type now: %s(%d)", field.Name, field.FieldID)
 		}
 		// check unique
 		elemIdx, ok := idMap[field.FieldID]
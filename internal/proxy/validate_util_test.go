@@ -12,10 +12,15 @@
 package proxy
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -51,6 +56,29 @@ func TestValidateCollectionName(t *testing.T) {
 	}
 }
 
+func TestValidateDatabaseName(t *testing.T) {
+	assert.Nil(t, ValidateDatabaseName("abc"))
+	assert.Nil(t, ValidateDatabaseName("_123abc"))
+
+	longName := make([]byte, 256)
+	for i := 0; i < len(longName); i++ {
+		longName[i] = 'a'
+	}
+	invalidNames := []string{
+		"123abc",
+		"abc$",
+		"_12 ac",
+		" ",
+		"",
+		string(longName),
+		"中文",
+	}
+
+	for _, name := range invalidNames {
+		assert.NotNil(t, ValidateDatabaseName(name))
+	}
+}
+
 func TestValidatePartitionTag(t *testing.T) {
 	assert.Nil(t, ValidatePartitionTag("abc", true))
 	assert.Nil(t, ValidatePartitionTag("123abc", true))
@@ -373,3 +401,112 @@ func TestValidateSchema(t *testing.T) {
 	pf3.IndexParams = ip3Good
 	assert.Nil(t, ValidateSchema(coll))
 }
+
+func TestValidateStringPrimaryKey(t *testing.T) {
+	pk := &schemapb.FieldSchema{Name: "uuid", FieldID: 100, IsPrimaryKey: true, DataType: schemapb.DataType_String}
+	vec := &schemapb.FieldSchema{Name: "vec", FieldID: 101, DataType: schemapb.DataType_FloatVector,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}}}
+	coll := &schemapb.CollectionSchema{Name: "coll1", Fields: []*schemapb.FieldSchema{pk, vec}}
+	assert.Nil(t, ValidatePrimaryKey(coll))
+	assert.Nil(t, ValidateFieldAutoID(coll))
+	assert.Nil(t, ValidateSchema(coll))
+
+	pk.AutoID = true
+	assert.NotNil(t, ValidateFieldAutoID(coll))
+	pk.AutoID = false
+
+	// only the primary key can be of string type
+	str := &schemapb.FieldSchema{Name: "str", FieldID: 102, DataType: schemapb.DataType_String}
+	coll.Fields = append(coll.Fields, str)
+	assert.NotNil(t, ValidatePrimaryKey(coll))
+	assert.NotNil(t, ValidateSchema(coll))
+
+	assert.Nil(t, ValidateStringPrimaryKeys([]string{"a", "b"}))
+	assert.NotNil(t, ValidateStringPrimaryKeys([]string{"a", ""}))
+}
+
+func TestValidateTravelTimestamp(t *testing.T) {
+	retentionDuration := Params.RetentionDuration
+	defer func() {
+		Params.RetentionDuration = retentionDuration
+	}()
+	Params.RetentionDuration = 3600
+
+	now := time.Now()
+	tMax := tsoutil.ComposeTS(now.UnixNano()/int64(time.Millisecond), 0)
+	assert.Nil(t, ValidateTravelTimestamp(tMax, tMax))
+	inWindow := tsoutil.ComposeTS(now.Add(-time.Minute).UnixNano()/int64(time.Millisecond), 0)
+	assert.Nil(t, ValidateTravelTimestamp(inWindow, tMax))
+	outOfWindow := tsoutil.ComposeTS(now.Add(-2*time.Hour).UnixNano()/int64(time.Millisecond), 0)
+	assert.NotNil(t, ValidateTravelTimestamp(outOfWindow, tMax))
+	future := tsoutil.ComposeTS(now.Add(time.Minute).UnixNano()/int64(time.Millisecond), 0)
+	assert.NotNil(t, ValidateTravelTimestamp(future, tMax))
+	assert.NotNil(t, ValidateTravelTimestamp(tMax+1, tMax))
+}
+
+func TestValidateResultWindow(t *testing.T) {
+	assert.Nil(t, ValidateResultWindow(0, 0))
+	assert.Nil(t, ValidateResultWindow(10, 100))
+	assert.Nil(t, ValidateResultWindow(MaxResultWindow-1, 1))
+	assert.NotNil(t, ValidateResultWindow(-1, 10))
+	assert.NotNil(t, ValidateResultWindow(0, -1))
+	assert.NotNil(t, ValidateResultWindow(MaxResultWindow, 1))
+}
+
+func TestValidateRangeSearch(t *testing.T) {
+	assert.Nil(t, ValidateRangeSearch(&planpb.QueryInfo{MetricType: "L2"}))
+	assert.Nil(t, ValidateRangeSearch(&planpb.QueryInfo{MetricType: "L2", RangeSearch: true, Radius: 1}))
+	assert.NotNil(t, ValidateRangeSearch(&planpb.QueryInfo{MetricType: "L2", RangeSearch: true, Radius: float32(math.Inf(1))}))
+	assert.Nil(t, ValidateRangeSearch(&planpb.QueryInfo{MetricType: "L2", RangeSearch: true, Radius: 1, HasRangeFilter: true, RangeFilter: 0.5}))
+	assert.NotNil(t, ValidateRangeSearch(&planpb.QueryInfo{MetricType: "L2", RangeSearch: true, Radius: 1, HasRangeFilter: true, RangeFilter: 1}))
+	assert.Nil(t, ValidateRangeSearch(&planpb.QueryInfo{MetricType: "IP", RangeSearch: true, Radius: 0.5, HasRangeFilter: true, RangeFilter: 1}))
+	assert.NotNil(t, ValidateRangeSearch(&planpb.QueryInfo{MetricType: "IP", RangeSearch: true, Radius: 0.5, HasRangeFilter: true, RangeFilter: 0.1}))
+}
+
+func TestValidateCompression(t *testing.T) {
+	coll := &schemapb.CollectionSchema{
+		Name:        "coll",
+		Compression: schemapb.CompressionType_Zstd,
+		Fields: []*schemapb.FieldSchema{
+			{Name: "f1", Compression: schemapb.CompressionType_Snappy},
+			{Name: "f2"},
+		},
+	}
+	assert.Nil(t, ValidateCompression(coll))
+
+	coll.Fields[1].Compression = schemapb.CompressionType(100)
+	assert.NotNil(t, ValidateCompression(coll))
+
+	coll.Fields[1].Compression = schemapb.CompressionType_Lz4
+	coll.Compression = schemapb.CompressionType(-1)
+	assert.NotNil(t, ValidateCompression(coll))
+}
+
+func TestValidateCredential(t *testing.T) {
+	Params.Init()
+	assert.Nil(t, ValidateUsername("alice"))
+	assert.Nil(t, ValidateRoleName("role-1_a"))
+	assert.NotNil(t, ValidateUsername(""))
+	assert.NotNil(t, ValidateUsername("1alice"))
+	assert.NotNil(t, ValidateRoleName("role*"))
+
+	assert.Nil(t, ValidatePassword("123456"))
+	assert.NotNil(t, ValidatePassword("12345"))
+	assert.NotNil(t, ValidatePassword(string(make([]byte, 73))))
+
+	grant := &milvuspb.Grant{
+		DbName:         anyName,
+		CollectionName: "coll",
+		Privilege:      commonpb.Privilege_PrivilegeSearch,
+	}
+	assert.Nil(t, ValidateGrant(grant))
+	grant.CollectionName = anyName
+	assert.Nil(t, ValidateGrant(grant))
+	grant.Privilege = commonpb.Privilege_PrivilegeNone
+	assert.NotNil(t, ValidateGrant(grant))
+	grant.Privilege = commonpb.Privilege(100)
+	assert.NotNil(t, ValidateGrant(grant))
+	grant.Privilege = commonpb.Privilege_PrivilegeQuery
+	grant.CollectionName = "1coll"
+	assert.NotNil(t, ValidateGrant(grant))
+}
@@ -0,0 +1,227 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+// nodeMemoryUsage is the memory usage of a query node and the sealed segments on it
+type nodeMemoryUsage struct {
+	nodeID   int64
+	used     int64
+	total    int64
+	segments map[UniqueID]*querypb.SegmentInfo
+}
+
+func (nu *nodeMemoryUsage) usageRatio() float64 {
+	return float64(nu.used) / float64(nu.total)
+}
+
+// segmentMove moves a sealed segment from the source query node to the dst one
+type segmentMove struct {
+	segmentID    UniqueID
+	sourceNodeID int64
+	dstNodeID    int64
+}
+
+// planSegmentMoves plans the segment moves from the query node with the highest memory usage ratio to the one
+// with the lowest, until the gap of their ratios is no more than maxDifference, or no move narrows the gap.
+// Every move picks the segment narrowing the gap most, and each segment is moved once at most.
+// canMove tells whether the segment is allowed to move between the query nodes.
+func planSegmentMoves(usages []*nodeMemoryUsage, maxDifference float64,
+	canMove func(info *querypb.SegmentInfo, sourceNodeID, dstNodeID int64) bool) []*segmentMove {
+	moves := make([]*segmentMove, 0)
+	if len(usages) < 2 {
+		return moves
+	}
+	moved := make(map[UniqueID]struct{})
+	for {
+		sort.Slice(usages, func(i, j int) bool {
+			if usages[i].usageRatio() == usages[j].usageRatio() {
+				return usages[i].nodeID < usages[j].nodeID
+			}
+			return usages[i].usageRatio() < usages[j].usageRatio()
+		})
+		cold, hot := usages[0], usages[len(usages)-1]
+		gap := hot.usageRatio() - cold.usageRatio()
+		if gap <= maxDifference {
+			return moves
+		}
+
+		var chosen *querypb.SegmentInfo
+		minGap := gap
+		for _, info := range hot.segments {
+			if _, ok := moved[info.SegmentID]; ok {
+				continue
+			}
+			if _, ok := cold.segments[info.SegmentID]; ok || !canMove(info, hot.nodeID, cold.nodeID) {
+				continue
+			}
+			newGap := math.Abs(float64(hot.used-info.MemSize)/float64(hot.total) - float64(cold.used+info.MemSize)/float64(cold.total))
+			if newGap < minGap || (newGap == minGap && chosen != nil && info.SegmentID < chosen.SegmentID) {
+				chosen = info
+				minGap = newGap
+			}
+		}
+		if chosen == nil {
+			return moves
+		}
+
+		hot.used -= chosen.MemSize
+		cold.used += chosen.MemSize
+		delete(hot.segments, chosen.SegmentID)
+		cold.segments[chosen.SegmentID] = chosen
+		moved[chosen.SegmentID] = struct{}{}
+		moves = append(moves, &segmentMove{
+			segmentID:    chosen.SegmentID,
+			sourceNodeID: hot.nodeID,
+			dstNodeID:    cold.nodeID,
+		})
+	}
+}
+
+// getNodeMemoryUsages returns the memory usages of the query nodes on service, with the sealed segments on them.
+// The query nodes whose memory usage is unknown are left out.
+func (qc *QueryCoord) getNodeMemoryUsages(ctx context.Context) ([]*nodeMemoryUsage, error) {
+	nodes, err := qc.cluster.onServiceNodes()
+	if err != nil {
+		return nil, err
+	}
+	usages := make([]*nodeMemoryUsage, 0, len(nodes))
+	for nodeID := range nodes {
+		used, total, err := qc.cluster.getNodeMemoryUsage(ctx, nodeID)
+		if err != nil {
+			log.Warn("balance: get memory usage of query node failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+			continue
+		}
+		usage := &nodeMemoryUsage{
+			nodeID:   nodeID,
+			used:     used,
+			total:    total,
+			segments: make(map[UniqueID]*querypb.SegmentInfo),
+		}
+		segmentIDs := make([]UniqueID, 0)
+		for _, info := range qc.meta.getSegmentInfosByNodeID(nodeID) {
+			if info.SegmentState == querypb.SegmentState_sealed {
+				usage.segments[info.SegmentID] = info
+				segmentIDs = append(segmentIDs, info.SegmentID)
+			}
+		}
+		if len(segmentIDs) > 0 {
+			infos, err := qc.cluster.getNodeSegmentInfo(ctx, nodeID, segmentIDs)
+			if err != nil {
+				log.Warn("balance: get segment info of query node failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+				continue
+			}
+			sized := make(map[UniqueID]*querypb.SegmentInfo)
+			for _, info := range infos {
+				if segment, ok := usage.segments[info.SegmentID]; ok {
+					segment.MemSize = info.MemSize
+					sized[info.SegmentID] = segment
+				}
+			}
+			// the segments not reported by the query node are not loaded yet
+			usage.segments = sized
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
+
+// canMoveSegment tells whether the segment can move between the query nodes, which should serve the same
+// replica of the collection if it's loaded with replicas
+func (qc *QueryCoord) canMoveSegment(info *querypb.SegmentInfo, sourceNodeID, dstNodeID int64) bool {
+	if len(qc.meta.getReplicas(info.CollectionID)) == 0 {
+		return true
+	}
+	source, err := qc.meta.getReplicaByNodeID(info.CollectionID, sourceNodeID)
+	if err != nil {
+		return false
+	}
+	dst, err := qc.meta.getReplicaByNodeID(info.CollectionID, dstNodeID)
+	if err != nil {
+		return false
+	}
+	return source.ReplicaID == dst.ReplicaID
+}
+
+// balanceSegments moves sealed segments from the query nodes with high memory usage to the ones with low usage,
+// and waits until the moves are done
+func (qc *QueryCoord) balanceSegments(ctx context.Context) {
+	usages, err := qc.getNodeMemoryUsages(ctx)
+	if err != nil {
+		log.Warn("balance: get memory usages of query nodes failed", zap.Error(err))
+		return
+	}
+	moves := planSegmentMoves(usages, Params.MemoryUsageMaxDifferencePercentage/100, qc.canMoveSegment)
+	if len(moves) == 0 {
+		return
+	}
+
+	type nodePair struct {
+		sourceNodeID int64
+		dstNodeID    int64
+	}
+	pair2Segments := make(map[nodePair][]UniqueID)
+	pairs := make([]nodePair, 0)
+	for _, move := range moves {
+		pair := nodePair{sourceNodeID: move.sourceNodeID, dstNodeID: move.dstNodeID}
+		if _, ok := pair2Segments[pair]; !ok {
+			pairs = append(pairs, pair)
+		}
+		pair2Segments[pair] = append(pair2Segments[pair], move.segmentID)
+	}
+
+	loadBalanceTasks := make([]*LoadBalanceTask, 0, len(pairs))
+	for _, pair := range pairs {
+		loadBalanceTask := &LoadBalanceTask{
+			BaseTask: BaseTask{
+				ctx:              qc.loopCtx,
+				Condition:        NewTaskCondition(qc.loopCtx),
+				triggerCondition: querypb.TriggerCondition_loadBalance,
+			},
+			LoadBalanceRequest: &querypb.LoadBalanceRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_LoadBalanceSegments,
+					SourceID: qc.session.ServerID,
+				},
+				SourceNodeIDs:    []int64{pair.sourceNodeID},
+				DstNodeIDs:       []int64{pair.dstNodeID},
+				SealedSegmentIDs: pair2Segments[pair],
+				BalanceReason:    querypb.TriggerCondition_loadBalance,
+			},
+			rootCoord: qc.rootCoordClient,
+			dataCoord: qc.dataCoordClient,
+			cluster:   qc.cluster,
+			meta:      qc.meta,
+		}
+		qc.scheduler.Enqueue([]task{loadBalanceTask})
+		loadBalanceTasks = append(loadBalanceTasks, loadBalanceTask)
+		log.Debug("balance: move segments", zap.Int64("sourceNodeID", pair.sourceNodeID),
+			zap.Int64("dstNodeID", pair.dstNodeID), zap.Int64s("segmentIDs", pair2Segments[pair]))
+	}
+	for _, loadBalanceTask := range loadBalanceTasks {
+		err := loadBalanceTask.WaitToFinish()
+		if err != nil {
+			log.Warn("balance: move segments failed", zap.Int64s("segmentIDs", loadBalanceTask.SealedSegmentIDs), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func newTestNodeMemoryUsage(nodeID int64, total int64, segmentSizes map[UniqueID]int64) *nodeMemoryUsage {
+	usage := &nodeMemoryUsage{
+		nodeID:   nodeID,
+		total:    total,
+		segments: make(map[UniqueID]*querypb.SegmentInfo),
+	}
+	for segmentID, size := range segmentSizes {
+		usage.used += size
+		usage.segments[segmentID] = &querypb.SegmentInfo{SegmentID: segmentID, CollectionID: 1, MemSize: size}
+	}
+	return usage
+}
+
+func TestPlanSegmentMoves(t *testing.T) {
+	canMove := func(info *querypb.SegmentInfo, sourceNodeID, dstNodeID int64) bool {
+		return true
+	}
+
+	t.Run("move from hot to cold", func(t *testing.T) {
+		usages := []*nodeMemoryUsage{
+			newTestNodeMemoryUsage(1, 100, map[UniqueID]int64{10: 30, 11: 20, 12: 10}),
+			newTestNodeMemoryUsage(2, 100, map[UniqueID]int64{}),
+		}
+		moves := planSegmentMoves(usages, 0.2, canMove)
+		// 60% vs 0%, moving the 30 segment narrows the gap to 0
+		assert.Equal(t, []*segmentMove{{segmentID: 10, sourceNodeID: 1, dstNodeID: 2}}, moves)
+	})
+
+	t.Run("balanced", func(t *testing.T) {
+		usages := []*nodeMemoryUsage{
+			newTestNodeMemoryUsage(1, 100, map[UniqueID]int64{10: 30}),
+			newTestNodeMemoryUsage(2, 100, map[UniqueID]int64{11: 20}),
+		}
+		assert.Equal(t, 0, len(planSegmentMoves(usages, 0.2, canMove)))
+	})
+
+	t.Run("no move narrows the gap", func(t *testing.T) {
+		usages := []*nodeMemoryUsage{
+			newTestNodeMemoryUsage(1, 100, map[UniqueID]int64{10: 90}),
+			newTestNodeMemoryUsage(2, 100, map[UniqueID]int64{}),
+		}
+		assert.Equal(t, 0, len(planSegmentMoves(usages, 0.2, canMove)))
+	})
+
+	t.Run("ratios of different memory", func(t *testing.T) {
+		usages := []*nodeMemoryUsage{
+			newTestNodeMemoryUsage(1, 100, map[UniqueID]int64{10: 20, 11: 20, 12: 20}),
+			newTestNodeMemoryUsage(2, 400, map[UniqueID]int64{13: 40}),
+			newTestNodeMemoryUsage(3, 100, map[UniqueID]int64{14: 50}),
+		}
+		// 60%, 10%, 50% -> 40%, 15%, 50% -> 40%, 27.5%, 0% -> 20%, 27.5%, 20%
+		moves := planSegmentMoves(usages, 0.2, canMove)
+		assert.Equal(t, []*segmentMove{
+			{segmentID: 10, sourceNodeID: 1, dstNodeID: 2},
+			{segmentID: 14, sourceNodeID: 3, dstNodeID: 2},
+			{segmentID: 11, sourceNodeID: 1, dstNodeID: 3},
+		}, moves)
+	})
+
+	t.Run("segments not allowed to move", func(t *testing.T) {
+		usages := []*nodeMemoryUsage{
+			newTestNodeMemoryUsage(1, 100, map[UniqueID]int64{10: 30, 11: 20}),
+			newTestNodeMemoryUsage(2, 100, map[UniqueID]int64{}),
+		}
+		moves := planSegmentMoves(usages, 0.1, func(info *querypb.SegmentInfo, sourceNodeID, dstNodeID int64) bool {
+			return info.SegmentID != 10
+		})
+		assert.Equal(t, []*segmentMove{{segmentID: 11, sourceNodeID: 1, dstNodeID: 2}}, moves)
+	})
+}
+
+func TestQueryCoord_canMoveSegment(t *testing.T) {
+	kv := newTestEtcdKV(t)
+	m, err := newMeta(kv)
+	assert.Nil(t, err)
+	qc := &QueryCoord{meta: m}
+
+	info := &querypb.SegmentInfo{SegmentID: 10, CollectionID: 1}
+	assert.True(t, qc.canMoveSegment(info, 1, 2))
+
+	assert.Nil(t, m.addReplica(&querypb.ReplicaInfo{ReplicaID: 100, CollectionID: 1, NodeIDs: []int64{1, 2}}))
+	assert.Nil(t, m.addReplica(&querypb.ReplicaInfo{ReplicaID: 101, CollectionID: 1, NodeIDs: []int64{3}}))
+	assert.True(t, qc.canMoveSegment(info, 1, 2))
+	assert.False(t, qc.canMoveSegment(info, 1, 3))
+	assert.False(t, qc.canMoveSegment(info, 1, 4))
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const (
@@ -143,9 +144,10 @@ func (c *queryNodeCluster) LoadSegments(ctx context.Context, nodeID int64, in *q
 			segmentInfo, err := c.clusterMeta.getSegmentInfoByID(segmentID)
 			if err == nil {
 				segmentInfos[segmentID] = proto.Clone(segmentInfo).(*querypb.SegmentInfo)
-				if in.LoadCondition != querypb.TriggerCondition_loadBalance {
-					segmentInfo.SegmentState = querypb.SegmentState_sealing
-					segmentInfo.NodeID = nodeID
+				segmentInfo.SegmentState = querypb.SegmentState_sealing
+				segmentInfo.NodeID = nodeID
+				if !segmentOnNode(segmentInfos[segmentID], nodeID) {
+					segmentInfo.NodeIDs = append(getSegmentNodeIDs(segmentInfos[segmentID]), nodeID)
 				}
 			} else {
 				segmentInfo = &querypb.SegmentInfo{
@@ -154,6 +156,7 @@ func (c *queryNodeCluster) LoadSegments(ctx context.Context, nodeID int64, in *q
 					PartitionID:  info.PartitionID,
 					NodeID:       nodeID,
 					SegmentState: querypb.SegmentState_sealing,
+					NodeIDs:      []int64{nodeID},
 				}
 			}
 			c.clusterMeta.setSegmentInfo(segmentID, segmentInfo)
@@ -192,16 +195,10 @@ func (c *queryNodeCluster) ReleaseSegments(ctx context.Context, nodeID int64, in
 		if !node.isOnService() {
 			return nil, errors.New("node offline")
 		}
-		for _, segmentID := range in.SegmentIDs {
-			err := c.clusterMeta.removeSegmentInfo(segmentID)
-			if err != nil {
-				log.Error("remove segmentInfo Error", zap.Any("error", err.Error()), zap.Int64("segmentID", segmentID))
-			}
-		}
 		status, err := node.client.ReleaseSegments(ctx, in)
 		if err == nil && status.ErrorCode == commonpb.ErrorCode_Success {
 			for _, segmentID := range in.SegmentIDs {
-				c.clusterMeta.deleteSegmentInfoByID(segmentID)
+				c.clusterMeta.deleteSegmentNode(segmentID, nodeID)
 			}
 		}
 		return status, err
@@ -344,6 +341,64 @@ func (c *queryNodeCluster) getSegmentInfo(ctx context.Context, in *querypb.GetSe
 	return segmentInfos, nil
 }
 
+// getNodeMemoryUsage returns the memory used by the segments on the query node and the memory of the query node
+func (c *queryNodeCluster) getNodeMemoryUsage(ctx context.Context, nodeID int64) (int64, int64, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	node, ok := c.nodes[nodeID]
+	if !ok {
+		return 0, 0, errors.New("can't find query node by nodeID")
+	}
+	states, err := node.client.GetComponentStates(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	if states.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return 0, 0, errors.New(states.Status.Reason)
+	}
+	var used, total int64
+	for _, kv := range states.State.GetExtraInfo() {
+		switch kv.Key {
+		case typeutil.MemoryUsedKey:
+			used, err = strconv.ParseInt(kv.Value, 10, 64)
+		case typeutil.MemoryTotalKey:
+			total, err = strconv.ParseInt(kv.Value, 10, 64)
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	if total <= 0 {
+		return 0, 0, fmt.Errorf("memory of query node %d is unknown", nodeID)
+	}
+	return used, total, nil
+}
+
+// getNodeSegmentInfo returns the infos with memory sizes of the segments on the query node
+func (c *queryNodeCluster) getNodeSegmentInfo(ctx context.Context, nodeID int64, segmentIDs []UniqueID) ([]*querypb.SegmentInfo, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	node, ok := c.nodes[nodeID]
+	if !ok {
+		return nil, errors.New("can't find query node by nodeID")
+	}
+	res, err := node.client.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_SegmentInfo,
+		},
+		SegmentIDs: segmentIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(res.Status.Reason)
+	}
+	return res.Infos, nil
+}
+
 func (c *queryNodeCluster) getNumDmChannels(nodeID int64) (int, error) {
 	c.Lock()
 	defer c.Unlock()
@@ -373,7 +428,7 @@ func (c *queryNodeCluster) getNumSegments(nodeID int64) (int, error) {
 
 	numSegment := 0
 	for _, info := range c.clusterMeta.segmentInfos {
-		if info.NodeID == nodeID {
+		if segmentOnNode(info, nodeID) {
 			numSegment++
 		}
 	}
@@ -470,6 +525,23 @@ func (c *queryNodeCluster) isOnService(nodeID int64) (bool, error) {
 	return false, fmt.Errorf("query node %d not exist", nodeID)
 }
 
+// isReplicaOnService returns whether all the query nodes of the replica are on service
+func (c *queryNodeCluster) isReplicaOnService(replica *querypb.ReplicaInfo) bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	if len(replica.NodeIDs) == 0 {
+		return false
+	}
+	for _, nodeID := range replica.NodeIDs {
+		node, ok := c.nodes[nodeID]
+		if !ok || !node.isOnService() {
+			return false
+		}
+	}
+	return true
+}
+
 func (c *queryNodeCluster) printMeta() {
 	for id, node := range c.nodes {
 		if node.isOnService() {
@@ -96,7 +96,7 @@ func (qc *QueryCoord) LoadCollection(ctx context.Context, req *querypb.LoadColle
 	collectionID := req.CollectionID
 	//schema := req.Schema
 	log.Debug("LoadCollectionRequest received", zap.String("role", Params.RoleName), zap.Int64("msgID", req.Base.MsgID), zap.Int64("collectionID", collectionID),
-		zap.Stringer("schema", req.Schema))
+		zap.Int32("replicaNumber", req.ReplicaNumber), zap.Stringer("schema", req.Schema))
 	status := &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
 	}
@@ -117,6 +117,7 @@ func (qc *QueryCoord) LoadCollection(ctx context.Context, req *querypb.LoadColle
 		LoadCollectionRequest: req,
 		rootCoord:             qc.rootCoordClient,
 		dataCoord:             qc.dataCoordClient,
+		indexCoord:            qc.indexCoordClient,
 		cluster:               qc.cluster,
 		meta:                  qc.meta,
 	}
@@ -126,6 +127,11 @@ func (qc *QueryCoord) LoadCollection(ctx context.Context, req *querypb.LoadColle
 	if err != nil {
 		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
 		status.Reason = err.Error()
+		if loadCollectionTask.result.GetErrorCode() == commonpb.ErrorCode_InsufficientMemoryToLoad {
+			// returned without error, or the error code is lost through grpc
+			status.ErrorCode = commonpb.ErrorCode_InsufficientMemoryToLoad
+			return status, nil
+		}
 		return status, err
 	}
 
@@ -270,7 +276,9 @@ func (qc *QueryCoord) LoadPartitions(ctx context.Context, req *querypb.LoadParti
 			triggerCondition: querypb.TriggerCondition_grpcRequest,
 		},
 		LoadPartitionsRequest: req,
+		rootCoord:             qc.rootCoordClient,
 		dataCoord:             qc.dataCoordClient,
+		indexCoord:            qc.indexCoordClient,
 		cluster:               qc.cluster,
 		meta:                  qc.meta,
 	}
@@ -280,6 +288,11 @@ func (qc *QueryCoord) LoadPartitions(ctx context.Context, req *querypb.LoadParti
 	if err != nil {
 		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
 		status.Reason = err.Error()
+		if loadPartitionTask.result.GetErrorCode() == commonpb.ErrorCode_InsufficientMemoryToLoad {
+			// returned without error, or the error code is lost through grpc
+			status.ErrorCode = commonpb.ErrorCode_InsufficientMemoryToLoad
+			return status, nil
+		}
 		log.Debug("LoadPartitionRequest completed", zap.String("role", Params.RoleName), zap.Int64("msgID", req.Base.MsgID), zap.Int64("collectionID", req.CollectionID))
 		return status, err
 	}
@@ -440,3 +453,41 @@ func (qc *QueryCoord) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmen
 		Infos:  segmentInfos,
 	}, nil
 }
+
+// GetReplicas returns the replicas of the collection whose query nodes are all on service, it fails if the
+// collection has replicas but none of them is on service
+func (qc *QueryCoord) GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("query coordinator is not healthy")
+		status.Reason = err.Error()
+		log.Debug("getReplicas end with query coordinator not healthy")
+		return &querypb.GetReplicasResponse{
+			Status: status,
+		}, err
+	}
+
+	allReplicas := qc.meta.getReplicas(req.CollectionID)
+	replicas := make([]*querypb.ReplicaInfo, 0, len(allReplicas))
+	for _, replica := range allReplicas {
+		if qc.cluster.isReplicaOnService(replica) {
+			replicas = append(replicas, replica)
+		}
+	}
+	if len(allReplicas) > 0 && len(replicas) == 0 {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := fmt.Errorf("none of the %d replicas of collection %d is on service", len(allReplicas), req.CollectionID)
+		status.Reason = err.Error()
+		return &querypb.GetReplicasResponse{
+			Status: status,
+		}, nil
+	}
+	log.Debug("getReplicas", zap.Int64("collectionID", req.CollectionID), zap.Any("replicas", replicas))
+	return &querypb.GetReplicasResponse{
+		Status:   status,
+		Replicas: replicas,
+	}, nil
+}
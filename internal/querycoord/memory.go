@@ -0,0 +1,252 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// estimateSegmentSizes fills the estimated memory sizes of the segments to load, which are the sizes of their
+// insert binlogs, or their row counts times the estimated record size if the binlog sizes are unknown,
+// plus the sizes of the index files of their vector fields. The index files are left out if rootCoord or indexCoord is nil.
+func estimateSegmentSizes(ctx context.Context, rootCoord types.RootCoord, dataCoord types.DataCoord, indexCoord types.IndexCoord,
+	schema *schemapb.CollectionSchema, infos []*querypb.SegmentLoadInfo) error {
+	if len(infos) == 0 {
+		return nil
+	}
+	segmentIDs := make([]UniqueID, 0, len(infos))
+	for _, info := range infos {
+		segmentIDs = append(segmentIDs, info.SegmentID)
+	}
+	segmentInfoResp, err := dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_SegmentInfo,
+		},
+		SegmentIDs: segmentIDs,
+	})
+	if err != nil {
+		return err
+	}
+	if segmentInfoResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(segmentInfoResp.Status.Reason)
+	}
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(schema)
+	if err != nil {
+		return err
+	}
+	segmentInfos := make(map[UniqueID]*datapb.SegmentInfo)
+	for _, segmentInfo := range segmentInfoResp.Infos {
+		segmentInfos[segmentInfo.ID] = segmentInfo
+	}
+	for _, info := range infos {
+		segmentInfo, ok := segmentInfos[info.SegmentID]
+		if !ok {
+			continue
+		}
+		info.SegmentSize = segmentInfo.BinlogSize
+		if info.SegmentSize == 0 {
+			info.SegmentSize = segmentInfo.NumOfRows * int64(sizePerRecord)
+		}
+	}
+
+	if rootCoord == nil || indexCoord == nil {
+		return nil
+	}
+	buildID2Info := make(map[UniqueID]*querypb.SegmentLoadInfo)
+	buildIDs := make([]UniqueID, 0)
+	vectorFieldIDs := getVectorFieldIDs(schema)
+	for _, info := range infos {
+		for _, fieldID := range vectorFieldIDs {
+			describeResp, err := rootCoord.DescribeSegment(ctx, &milvuspb.DescribeSegmentRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_DescribeSegment,
+				},
+				CollectionID: info.CollectionID,
+				SegmentID:    info.SegmentID,
+				FieldID:      fieldID,
+			})
+			if err != nil {
+				return err
+			}
+			if describeResp.Status.ErrorCode != commonpb.ErrorCode_Success || !describeResp.EnableIndex {
+				continue
+			}
+			buildID2Info[describeResp.BuildID] = info
+			buildIDs = append(buildIDs, describeResp.BuildID)
+		}
+	}
+	if len(buildIDs) == 0 {
+		return nil
+	}
+	indexPathsResp, err := indexCoord.GetIndexFilePaths(ctx, &indexpb.GetIndexFilePathsRequest{
+		IndexBuildIDs: buildIDs,
+	})
+	if err != nil {
+		return err
+	}
+	if indexPathsResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(indexPathsResp.Status.Reason)
+	}
+	for _, pathInfo := range indexPathsResp.FilePaths {
+		if info, ok := buildID2Info[pathInfo.IndexBuildID]; ok {
+			info.SegmentSize += pathInfo.SerializedSize
+		}
+	}
+	return nil
+}
+
+// getVectorFieldIDs returns the ids of the vector fields of the schema, which are the ones with indexes
+func getVectorFieldIDs(schema *schemapb.CollectionSchema) []int64 {
+	fieldIDs := make([]int64, 0)
+	for _, field := range schema.Fields {
+		if typeutil.IsVectorType(field.DataType) {
+			fieldIDs = append(fieldIDs, field.FieldID)
+		}
+	}
+	return fieldIDs
+}
+
+// getNodesFreeMemory returns the free memory of the query nodes, the ones whose memory is unknown are left out
+func getNodesFreeMemory(ctx context.Context, cluster *queryNodeCluster, nodes map[int64]*queryNode) map[int64]int64 {
+	free := make(map[int64]int64)
+	for nodeID := range nodes {
+		used, total, err := cluster.getNodeMemoryUsage(ctx, nodeID)
+		if err != nil {
+			log.Warn("get memory usage of query node failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+			continue
+		}
+		free[nodeID] = total - used
+	}
+	return free
+}
+
+// placeSegmentsByMemory places the segments of sizes on the query nodes by their free memory, from the largest
+// segment to the smallest, each to the query node with the most free memory left. The query nodes of the segments
+// are returned in the order of sizes, or an error if any segment doesn't fit in the free memory.
+func placeSegmentsByMemory(sizes []int64, free map[int64]int64) ([]int64, error) {
+	if len(sizes) > 0 && len(free) == 0 {
+		return nil, errors.New("no query node to load segments")
+	}
+	left := make(map[int64]int64, len(free))
+	for nodeID, size := range free {
+		left[nodeID] = size
+	}
+	order := make([]int, len(sizes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return sizes[order[i]] > sizes[order[j]]
+	})
+
+	res := make([]int64, len(sizes))
+	for _, index := range order {
+		chosen := int64(-1)
+		for nodeID, size := range left {
+			if chosen == -1 || size > left[chosen] || (size == left[chosen] && nodeID < chosen) {
+				chosen = nodeID
+			}
+		}
+		if sizes[index] > left[chosen] {
+			return nil, fmt.Errorf("segment of %d bytes doesn't fit, the most free memory of query nodes is %d bytes",
+				sizes[index], left[chosen])
+		}
+		left[chosen] -= sizes[index]
+		res[index] = chosen
+	}
+	return res, nil
+}
+
+// placeSegmentsOnQueryNode places the segments on the query nodes on service in nodeIDs, or all of them if nodeIDs
+// is empty, by their estimated sizes and the free memory of the query nodes. An error is returned if the size of
+// any segment or the memory of any query node is unknown, or the segments don't fit.
+func placeSegmentsOnQueryNode(ctx context.Context, infos []*querypb.SegmentLoadInfo, cluster *queryNodeCluster, nodeIDs []int64) ([]int64, error) {
+	sizes := make([]int64, 0, len(infos))
+	for _, info := range infos {
+		if info.SegmentSize <= 0 {
+			return nil, fmt.Errorf("size of segment %d is unknown", info.SegmentID)
+		}
+		sizes = append(sizes, info.SegmentSize)
+	}
+	nodes, err := waitOnServiceNodes(cluster, nodeIDs)
+	if err != nil {
+		return nil, err
+	}
+	free := getNodesFreeMemory(ctx, cluster, nodes)
+	if len(free) != len(nodes) {
+		return nil, errors.New("memory of query nodes is unknown")
+	}
+	return placeSegmentsByMemory(sizes, free)
+}
+
+// checkLoadMemory checks whether the query nodes of every replica, or all the query nodes on service if replicas
+// is empty, have enough free memory to load the segments by their estimated sizes. The query nodes whose memory
+// is unknown are not taken into account, and the check passes if none of them is known.
+func checkLoadMemory(ctx context.Context, cluster *queryNodeCluster, infos []*querypb.SegmentLoadInfo, replicas []*querypb.ReplicaInfo) error {
+	sizes := make([]int64, 0, len(infos))
+	var totalSize int64
+	for _, info := range infos {
+		sizes = append(sizes, info.SegmentSize)
+		totalSize += info.SegmentSize
+	}
+	if totalSize == 0 {
+		return nil
+	}
+	nodes, err := cluster.onServiceNodes()
+	if err != nil {
+		// no query node is on service, whose memory is unknown
+		log.Warn("check memory to load segments skipped", zap.Error(err))
+		return nil
+	}
+
+	nodeGroups := make(map[UniqueID]map[int64]*queryNode)
+	if len(replicas) == 0 {
+		nodeGroups[0] = nodes
+	}
+	for _, replica := range replicas {
+		group := make(map[int64]*queryNode)
+		for _, nodeID := range replica.NodeIDs {
+			if node, ok := nodes[nodeID]; ok {
+				group[nodeID] = node
+			}
+		}
+		nodeGroups[replica.ReplicaID] = group
+	}
+	for replicaID, group := range nodeGroups {
+		free := getNodesFreeMemory(ctx, cluster, group)
+		if len(free) == 0 {
+			continue
+		}
+		if _, err := placeSegmentsByMemory(sizes, free); err != nil {
+			if replicaID == 0 {
+				return err
+			}
+			return fmt.Errorf("replica %d: %s", replicaID, err.Error())
+		}
+	}
+	return nil
+}
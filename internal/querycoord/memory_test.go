@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+func TestPlaceSegmentsByMemory(t *testing.T) {
+	t.Run("largest first to most free", func(t *testing.T) {
+		nodes, err := placeSegmentsByMemory([]int64{10, 30, 20}, map[int64]int64{1: 40, 2: 35})
+		assert.Nil(t, err)
+		// 30 -> 1 (40 -> 10), 20 -> 2 (35 -> 15), 10 -> 2 (15 -> 5)
+		assert.Equal(t, []int64{2, 1, 2}, nodes)
+	})
+
+	t.Run("insufficient memory", func(t *testing.T) {
+		_, err := placeSegmentsByMemory([]int64{30, 30}, map[int64]int64{1: 40, 2: 20})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("no query node", func(t *testing.T) {
+		_, err := placeSegmentsByMemory([]int64{1}, map[int64]int64{})
+		assert.NotNil(t, err)
+
+		nodes, err := placeSegmentsByMemory([]int64{}, map[int64]int64{})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(nodes))
+	})
+}
+
+type segmentSizeDataCoord struct {
+	types.DataCoord
+	infos []*datapb.SegmentInfo
+}
+
+func (dc *segmentSizeDataCoord) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	return &datapb.GetSegmentInfoResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Infos:  dc.infos,
+	}, nil
+}
+
+type indexSizeRootCoord struct {
+	types.RootCoord
+	buildIDs map[UniqueID]map[UniqueID]UniqueID // segment id -> field id -> build id
+}
+
+func (rc *indexSizeRootCoord) DescribeSegment(ctx context.Context, req *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error) {
+	buildID, ok := rc.buildIDs[req.SegmentID][req.FieldID]
+	return &milvuspb.DescribeSegmentResponse{
+		Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		BuildID:     buildID,
+		EnableIndex: ok,
+	}, nil
+}
+
+type indexSizeIndexCoord struct {
+	types.IndexCoord
+	sizes map[UniqueID]int64
+}
+
+func (ic *indexSizeIndexCoord) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
+	resp := &indexpb.GetIndexFilePathsResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}
+	for _, buildID := range req.IndexBuildIDs {
+		resp.FilePaths = append(resp.FilePaths, &indexpb.IndexFilePathInfo{IndexBuildID: buildID, SerializedSize: ic.sizes[buildID]})
+	}
+	return resp, nil
+}
+
+func TestEstimateSegmentSizes(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}}},
+		},
+	}
+	dataCoord := &segmentSizeDataCoord{
+		infos: []*datapb.SegmentInfo{
+			{ID: 1, NumOfRows: 100, BinlogSize: 4096},
+			{ID: 2, NumOfRows: 100},
+		},
+	}
+
+	newInfos := func() []*querypb.SegmentLoadInfo {
+		return []*querypb.SegmentLoadInfo{
+			{SegmentID: 1, CollectionID: 1},
+			{SegmentID: 2, CollectionID: 1},
+		}
+	}
+
+	t.Run("binlog size and rows", func(t *testing.T) {
+		infos := newInfos()
+		err := estimateSegmentSizes(ctx, nil, dataCoord, nil, schema, infos)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(4096), infos[0].SegmentSize)
+		// 8 bytes of int64 and 16 bytes of 4 dim float vector per record
+		assert.Equal(t, int64(100*24), infos[1].SegmentSize)
+	})
+
+	t.Run("with index files", func(t *testing.T) {
+		// only segment 2 has index
+		rootCoord := &indexSizeRootCoord{buildIDs: map[UniqueID]map[UniqueID]UniqueID{2: {101: 20}}}
+		indexCoord := &indexSizeIndexCoord{sizes: map[UniqueID]int64{20: 1024}}
+		infos := newInfos()
+		err := estimateSegmentSizes(ctx, rootCoord, dataCoord, indexCoord, schema, infos)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(4096), infos[0].SegmentSize)
+		assert.Equal(t, int64(100*24+1024), infos[1].SegmentSize)
+	})
+
+	t.Run("index files of multiple vector fields", func(t *testing.T) {
+		multiVectorSchema := &schemapb.CollectionSchema{
+			Fields: append(schema.Fields,
+				&schemapb.FieldSchema{FieldID: 102, DataType: schemapb.DataType_BinaryVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "32"}}}),
+		}
+		rootCoord := &indexSizeRootCoord{buildIDs: map[UniqueID]map[UniqueID]UniqueID{
+			1: {102: 10},
+			2: {101: 20, 102: 21},
+		}}
+		indexCoord := &indexSizeIndexCoord{sizes: map[UniqueID]int64{10: 512, 20: 1024, 21: 256}}
+		infos := newInfos()
+		err := estimateSegmentSizes(ctx, rootCoord, dataCoord, indexCoord, multiVectorSchema, infos)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(4096+512), infos[0].SegmentSize)
+		// 4 more bytes of 32 dim binary vector per record
+		assert.Equal(t, int64(100*28+1024+256), infos[1].SegmentSize)
+	})
+}
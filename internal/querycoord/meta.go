@@ -31,6 +31,7 @@ const (
 	collectionMetaPrefix   = "queryCoord-collectionMeta"
 	segmentMetaPrefix      = "queryCoord-segmentMeta"
 	queryChannelMetaPrefix = "queryCoord-queryChannel"
+	replicaMetaPrefix      = "queryCoord-replicaMeta"
 )
 
 type meta struct {
@@ -40,6 +41,7 @@ type meta struct {
 	collectionInfos   map[UniqueID]*querypb.CollectionInfo
 	segmentInfos      map[UniqueID]*querypb.SegmentInfo
 	queryChannelInfos map[UniqueID]*querypb.QueryChannelInfo
+	replicas          map[UniqueID][]*querypb.ReplicaInfo // collectionID -> replicas
 
 	partitionStates map[UniqueID]querypb.PartitionState
 }
@@ -48,6 +50,7 @@ func newMeta(kv *etcdkv.EtcdKV) (*meta, error) {
 	collectionInfos := make(map[UniqueID]*querypb.CollectionInfo)
 	segmentInfos := make(map[UniqueID]*querypb.SegmentInfo)
 	queryChannelInfos := make(map[UniqueID]*querypb.QueryChannelInfo)
+	replicas := make(map[UniqueID][]*querypb.ReplicaInfo)
 	partitionStates := make(map[UniqueID]querypb.PartitionState)
 
 	m := &meta{
@@ -55,6 +58,7 @@ func newMeta(kv *etcdkv.EtcdKV) (*meta, error) {
 		collectionInfos:   collectionInfos,
 		segmentInfos:      segmentInfos,
 		queryChannelInfos: queryChannelInfos,
+		replicas:          replicas,
 		partitionStates:   partitionStates,
 	}
 
@@ -117,6 +121,19 @@ func (m *meta) reloadFromKV() error {
 		}
 		m.queryChannelInfos[collectionID] = queryChannelInfo
 	}
+
+	_, replicaValues, err := m.client.LoadWithPrefix(replicaMetaPrefix)
+	if err != nil {
+		return err
+	}
+	for index := range replicaValues {
+		replicaInfo := &querypb.ReplicaInfo{}
+		err = proto.UnmarshalText(replicaValues[index], replicaInfo)
+		if err != nil {
+			return err
+		}
+		m.replicas[replicaInfo.CollectionID] = append(m.replicas[replicaInfo.CollectionID], replicaInfo)
+	}
 	//TODO::update partition states
 
 	return nil
@@ -255,19 +272,74 @@ func (m *meta) deleteSegmentInfoByID(segmentID UniqueID) {
 	}
 }
 
-func (m *meta) deleteSegmentInfoByNodeID(nodeID UniqueID) {
+// deleteSegmentInfoByNodeID removes the query node from the segments it serves, and returns the segments
+func (m *meta) deleteSegmentInfoByNodeID(nodeID UniqueID) []UniqueID {
 	m.Lock()
 	defer m.Unlock()
 
+	segmentIDs := make([]UniqueID, 0)
 	for segmentID, info := range m.segmentInfos {
-		if info.NodeID == nodeID {
-			err := m.removeSegmentInfo(segmentID)
-			if err != nil {
-				log.Error("remove segmentInfo error", zap.Any("error", err.Error()), zap.Int64("segmentID", segmentID))
-			}
-			delete(m.segmentInfos, segmentID)
+		if segmentOnNode(info, nodeID) {
+			m.removeSegmentNode(segmentID, info, nodeID)
+			segmentIDs = append(segmentIDs, segmentID)
+		}
+	}
+	return segmentIDs
+}
+
+// deleteSegmentNode removes the query node from the ones serving the segment
+func (m *meta) deleteSegmentNode(segmentID UniqueID, nodeID int64) {
+	m.Lock()
+	defer m.Unlock()
+
+	if info, ok := m.segmentInfos[segmentID]; ok {
+		m.removeSegmentNode(segmentID, info, nodeID)
+	}
+}
+
+// removeSegmentNode removes the query node from the ones serving the segment, the segment info
+// is deleted if no query node serves the segment any longer
+func (m *meta) removeSegmentNode(segmentID UniqueID, info *querypb.SegmentInfo, nodeID int64) {
+	nodeIDs := make([]int64, 0)
+	for _, id := range getSegmentNodeIDs(info) {
+		if id != nodeID {
+			nodeIDs = append(nodeIDs, id)
+		}
+	}
+	if len(nodeIDs) == 0 {
+		err := m.removeSegmentInfo(segmentID)
+		if err != nil {
+			log.Error("remove segmentInfo error", zap.Any("error", err.Error()), zap.Int64("segmentID", segmentID))
+		}
+		delete(m.segmentInfos, segmentID)
+		return
+	}
+
+	info.NodeIDs = nodeIDs
+	if info.NodeID == nodeID {
+		info.NodeID = nodeIDs[0]
+	}
+	err := m.saveSegmentInfo(segmentID, info)
+	if err != nil {
+		log.Error("save segmentInfo error", zap.Any("error", err.Error()), zap.Int64("segmentID", segmentID))
+	}
+}
+
+// getSegmentNodeIDs returns the query nodes serving the segment, one for each replica of the collection
+func getSegmentNodeIDs(info *querypb.SegmentInfo) []int64 {
+	if len(info.NodeIDs) == 0 && info.NodeID != 0 {
+		return []int64{info.NodeID}
+	}
+	return info.NodeIDs
+}
+
+func segmentOnNode(info *querypb.SegmentInfo, nodeID int64) bool {
+	for _, id := range getSegmentNodeIDs(info) {
+		if id == nodeID {
+			return true
 		}
 	}
+	return false
 }
 
 func (m *meta) setSegmentInfo(segmentID UniqueID, info *querypb.SegmentInfo) {
@@ -296,6 +368,20 @@ func (m *meta) getSegmentInfos(segmentIDs []UniqueID) ([]*querypb.SegmentInfo, e
 	return segmentInfos, nil
 }
 
+// getSegmentInfosByNodeID returns the segments served by the query node
+func (m *meta) getSegmentInfosByNodeID(nodeID int64) []*querypb.SegmentInfo {
+	m.RLock()
+	defer m.RUnlock()
+
+	segmentInfos := make([]*querypb.SegmentInfo, 0)
+	for _, info := range m.segmentInfos {
+		if segmentOnNode(info, nodeID) {
+			segmentInfos = append(segmentInfos, proto.Clone(info).(*querypb.SegmentInfo))
+		}
+	}
+	return segmentInfos
+}
+
 func (m *meta) hasSegmentInfo(segmentID UniqueID) bool {
 	m.RLock()
 	defer m.RUnlock()
@@ -388,6 +474,13 @@ func (m *meta) releaseCollection(collectionID UniqueID) {
 	if err != nil {
 		log.Error("remove collectionInfo error", zap.Any("error", err.Error()), zap.Int64("collectionID", collectionID))
 	}
+	if _, ok := m.replicas[collectionID]; ok {
+		delete(m.replicas, collectionID)
+		err = m.removeReplicaInfos(collectionID)
+		if err != nil {
+			log.Error("remove replicaInfo error", zap.Any("error", err.Error()), zap.Int64("collectionID", collectionID))
+		}
+	}
 }
 
 func (m *meta) releasePartition(collectionID UniqueID, partitionID UniqueID) {
@@ -542,6 +635,80 @@ func (m *meta) removeDmChannel(collectionID UniqueID, nodeID int64, channels []s
 	return errors.New("addDmChannels: can't find collection in collectionInfos")
 }
 
+func (m *meta) addReplica(info *querypb.ReplicaInfo) error {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, replica := range m.replicas[info.CollectionID] {
+		if replica.ReplicaID == info.ReplicaID {
+			return fmt.Errorf("addReplica: replica %d already exists in collection %d", info.ReplicaID, info.CollectionID)
+		}
+	}
+	err := m.saveReplicaInfo(info)
+	if err != nil {
+		return err
+	}
+	m.replicas[info.CollectionID] = append(m.replicas[info.CollectionID], proto.Clone(info).(*querypb.ReplicaInfo))
+	log.Debug("add replica", zap.Int64("collectionID", info.CollectionID), zap.Int64("replicaID", info.ReplicaID), zap.Int64s("nodeIDs", info.NodeIDs))
+	return nil
+}
+
+func (m *meta) getReplicas(collectionID UniqueID) []*querypb.ReplicaInfo {
+	m.RLock()
+	defer m.RUnlock()
+
+	replicas := make([]*querypb.ReplicaInfo, 0)
+	for _, replica := range m.replicas[collectionID] {
+		replicas = append(replicas, proto.Clone(replica).(*querypb.ReplicaInfo))
+	}
+	return replicas
+}
+
+func (m *meta) getReplicaByID(collectionID UniqueID, replicaID UniqueID) (*querypb.ReplicaInfo, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	for _, replica := range m.replicas[collectionID] {
+		if replica.ReplicaID == replicaID {
+			return proto.Clone(replica).(*querypb.ReplicaInfo), nil
+		}
+	}
+	return nil, fmt.Errorf("getReplicaByID: can't find replica %d of collection %d", replicaID, collectionID)
+}
+
+func (m *meta) getReplicaByNodeID(collectionID UniqueID, nodeID int64) (*querypb.ReplicaInfo, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	for _, replica := range m.replicas[collectionID] {
+		for _, id := range replica.NodeIDs {
+			if id == nodeID {
+				return proto.Clone(replica).(*querypb.ReplicaInfo), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("getReplicaByNodeID: query node %d serves no replica of collection %d", nodeID, collectionID)
+}
+
+func (m *meta) setReplicaNodes(collectionID UniqueID, replicaID UniqueID, nodeIDs []int64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, replica := range m.replicas[collectionID] {
+		if replica.ReplicaID == replicaID {
+			info := proto.Clone(replica).(*querypb.ReplicaInfo)
+			info.NodeIDs = nodeIDs
+			err := m.saveReplicaInfo(info)
+			if err != nil {
+				return err
+			}
+			replica.NodeIDs = nodeIDs
+			return nil
+		}
+	}
+	return fmt.Errorf("setReplicaNodes: can't find replica %d of collection %d", replicaID, collectionID)
+}
+
 func (m *meta) GetQueryChannel(collectionID UniqueID) (string, string) {
 	m.Lock()
 	defer m.Unlock()
@@ -604,6 +771,18 @@ func (m *meta) removeQueryChannelInfo(collectionID UniqueID) error {
 	return m.client.Remove(key)
 }
 
+func (m *meta) saveReplicaInfo(info *querypb.ReplicaInfo) error {
+	infoBytes := proto.MarshalTextString(info)
+
+	key := fmt.Sprintf("%s/%d/%d", replicaMetaPrefix, info.CollectionID, info.ReplicaID)
+	return m.client.Save(key, infoBytes)
+}
+
+func (m *meta) removeReplicaInfos(collectionID UniqueID) error {
+	key := fmt.Sprintf("%s/%d/", replicaMetaPrefix, collectionID)
+	return m.client.RemoveWithPrefix(key)
+}
+
 func (m *meta) setLoadCollection(collectionID UniqueID, state bool) error {
 	m.Lock()
 	defer m.Unlock()
@@ -642,4 +821,8 @@ func (m *meta) printMeta() {
 	for id, info := range m.queryChannelInfos {
 		log.Debug("query coordinator meta: queryChannelInfo", zap.Int64("collectionID", id), zap.Any("info", info))
 	}
+
+	for id, replicas := range m.replicas {
+		log.Debug("query coordinator meta: replicaInfo", zap.Int64("collectionID", id), zap.Any("replicas", replicas))
+	}
 }
@@ -12,10 +12,16 @@
 package querycoord
 
 import (
+	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/clientv3"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 )
 
 func TestReplica_Release(t *testing.T) {
@@ -44,3 +50,74 @@ func TestReplica_Release(t *testing.T) {
 	assert.Equal(t, 0, len(collections))
 	meta.releaseCollection(1)
 }
+
+func newTestEtcdKV(t *testing.T) *etcdkv.EtcdKV {
+	Params.Init()
+	etcdClient, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	require.NoError(t, err)
+	kv := etcdkv.NewEtcdKV(etcdClient, fmt.Sprintf("%s/test-%d", Params.MetaRootPath, rand.Int()))
+	t.Cleanup(func() {
+		kv.RemoveWithPrefix("")
+		kv.Close()
+	})
+	return kv
+}
+
+func TestMeta_Replicas(t *testing.T) {
+	kv := newTestEtcdKV(t)
+	meta, err := newMeta(kv)
+	require.NoError(t, err)
+	require.NoError(t, meta.addCollection(1, nil))
+
+	assert.Nil(t, meta.addReplica(&querypb.ReplicaInfo{ReplicaID: 10, CollectionID: 1, NodeIDs: []int64{1, 3}}))
+	assert.Nil(t, meta.addReplica(&querypb.ReplicaInfo{ReplicaID: 11, CollectionID: 1, NodeIDs: []int64{2}}))
+	assert.NotNil(t, meta.addReplica(&querypb.ReplicaInfo{ReplicaID: 11, CollectionID: 1}))
+	assert.Equal(t, 2, len(meta.getReplicas(1)))
+	assert.Equal(t, 0, len(meta.getReplicas(2)))
+
+	replica, err := meta.getReplicaByNodeID(1, 3)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 10, replica.ReplicaID)
+	_, err = meta.getReplicaByNodeID(1, 4)
+	assert.NotNil(t, err)
+
+	assert.Nil(t, meta.setReplicaNodes(1, 10, []int64{3, 4}))
+	assert.NotNil(t, meta.setReplicaNodes(1, 12, []int64{5}))
+	replica, err = meta.getReplicaByID(1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3, 4}, replica.NodeIDs)
+
+	// the replicas are reloaded from kv
+	meta, err = newMeta(kv)
+	require.NoError(t, err)
+	replica, err = meta.getReplicaByNodeID(1, 4)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 10, replica.ReplicaID)
+
+	meta.releaseCollection(1)
+	assert.Equal(t, 0, len(meta.getReplicas(1)))
+	meta, err = newMeta(kv)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(meta.getReplicas(1)))
+}
+
+func TestMeta_SegmentNodes(t *testing.T) {
+	kv := newTestEtcdKV(t)
+	meta, err := newMeta(kv)
+	require.NoError(t, err)
+
+	meta.setSegmentInfo(1, &querypb.SegmentInfo{SegmentID: 1, NodeID: 2, NodeIDs: []int64{1, 2}})
+	meta.setSegmentInfo(2, &querypb.SegmentInfo{SegmentID: 2, NodeID: 1})
+	assert.Equal(t, []int64{1}, getSegmentNodeIDs(meta.segmentInfos[2]))
+
+	meta.deleteSegmentNode(1, 2)
+	info, err := meta.getSegmentInfoByID(1)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 1, info.NodeID)
+	assert.Equal(t, []int64{1}, info.NodeIDs)
+
+	segmentIDs := meta.deleteSegmentInfoByNodeID(1)
+	assert.ElementsMatch(t, []UniqueID{1, 2}, segmentIDs)
+	assert.False(t, meta.hasSegmentInfo(1))
+	assert.False(t, meta.hasSegmentInfo(2))
+}
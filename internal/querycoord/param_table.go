@@ -14,8 +14,10 @@ package querycoord
 import (
 	"fmt"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -50,6 +52,11 @@ type ParamTable struct {
 	EtcdEndpoints []string
 	MetaRootPath  string
 	KvRootPath    string
+
+	// --- balance ---
+	AutoBalance                        bool
+	BalanceInterval                    time.Duration
+	MemoryUsageMaxDifferencePercentage float64
 }
 
 var Params ParamTable
@@ -81,6 +88,11 @@ func (p *ParamTable) Init() {
 		p.initEtcdEndpoints()
 		p.initMetaRootPath()
 		p.initKvRootPath()
+
+		// --- balance ---
+		p.initAutoBalance()
+		p.initBalanceInterval()
+		p.initMemoryUsageMaxDifferencePercentage()
 	})
 }
 
@@ -188,3 +200,22 @@ func (p *ParamTable) initKvRootPath() {
 	}
 	p.KvRootPath = path.Join(rootPath, subPath)
 }
+
+func (p *ParamTable) initAutoBalance() {
+	autoBalance, err := p.Load("queryCoord.autoBalance")
+	if err != nil {
+		panic(err)
+	}
+	p.AutoBalance, err = strconv.ParseBool(autoBalance)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (p *ParamTable) initBalanceInterval() {
+	p.BalanceInterval = time.Duration(p.ParseInt64("queryCoord.balanceInterval")) * time.Second
+}
+
+func (p *ParamTable) initMemoryUsageMaxDifferencePercentage() {
+	p.MemoryUsageMaxDifferencePercentage = p.ParseFloat("queryCoord.memoryUsageMaxDifferencePercentage")
+}
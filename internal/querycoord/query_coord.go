@@ -13,6 +13,8 @@ package querycoord
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/rand"
 	"path/filepath"
 	"strconv"
@@ -29,7 +31,10 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -39,6 +44,12 @@ import (
 
 type Timestamp = typeutil.Timestamp
 
+const (
+	// handoffSegmentPrefix is the prefix of the flushed segments written by DataCoord to hand off
+	handoffSegmentPrefix        = "querycoord-handoff"
+	handoffSegmentCheckInterval = 5 * time.Second
+)
+
 type queryChannelInfo struct {
 	requestChannel  string
 	responseChannel string
@@ -55,8 +66,9 @@ type QueryCoord struct {
 	cluster      *queryNodeCluster
 	scheduler    *TaskScheduler
 
-	dataCoordClient types.DataCoord
-	rootCoordClient types.RootCoord
+	dataCoordClient  types.DataCoord
+	rootCoordClient  types.RootCoord
+	indexCoordClient types.IndexCoord
 
 	session   *sessionutil.Session
 	eventChan <-chan *sessionutil.SessionEvent
@@ -66,6 +78,10 @@ type QueryCoord struct {
 	enableGrpc bool
 
 	msFactory msgstream.Factory
+
+	handoffMu sync.Mutex
+	// the segments whose handoff tasks are enqueued and not finished yet
+	handoffSegmentIDs map[UniqueID]struct{}
 }
 
 // Register register query service at etcd
@@ -94,7 +110,7 @@ func (qc *QueryCoord) Init() error {
 			return err
 		}
 
-		qc.scheduler, err = NewTaskScheduler(qc.loopCtx, metaKV, qc.cluster, etcdKV, qc.rootCoordClient, qc.dataCoordClient)
+		qc.scheduler, err = NewTaskScheduler(qc.loopCtx, metaKV, qc.cluster, etcdKV, qc.rootCoordClient, qc.dataCoordClient, qc.indexCoordClient)
 		return err
 	}
 	log.Debug("query coordinator try to connect etcd")
@@ -118,6 +134,14 @@ func (qc *QueryCoord) Start() error {
 	qc.loopWg.Add(1)
 	go qc.watchMetaLoop()
 
+	qc.loopWg.Add(1)
+	go qc.watchHandoffSegmentLoop()
+
+	if Params.AutoBalance {
+		qc.loopWg.Add(1)
+		go qc.loadBalanceSegmentLoop()
+	}
+
 	return nil
 }
 
@@ -151,9 +175,10 @@ func NewQueryCoord(ctx context.Context, factory msgstream.Factory) (*QueryCoord,
 
 	ctx1, cancel := context.WithCancel(ctx)
 	service := &QueryCoord{
-		loopCtx:    ctx1,
-		loopCancel: cancel,
-		msFactory:  factory,
+		loopCtx:           ctx1,
+		loopCancel:        cancel,
+		msFactory:         factory,
+		handoffSegmentIDs: make(map[UniqueID]struct{}),
 	}
 
 	service.UpdateStateCode(internalpb.StateCode_Abnormal)
@@ -169,6 +194,10 @@ func (qc *QueryCoord) SetDataCoord(dataCoord types.DataCoord) {
 	qc.dataCoordClient = dataCoord
 }
 
+func (qc *QueryCoord) SetIndexCoord(indexCoord types.IndexCoord) {
+	qc.indexCoordClient = indexCoord
+}
+
 func (qc *QueryCoord) watchNodeLoop() {
 	ctx, cancel := context.WithCancel(qc.loopCtx)
 	defer cancel()
@@ -316,3 +345,216 @@ func (qc *QueryCoord) watchMetaLoop() {
 	}
 
 }
+
+func (qc *QueryCoord) watchHandoffSegmentLoop() {
+	ctx, cancel := context.WithCancel(qc.loopCtx)
+
+	defer cancel()
+	defer qc.loopWg.Done()
+	log.Debug("query coordinator start watch handoff segment loop")
+
+	// segments are handed off only after their indexes are built,
+	// so the handoff segments are checked periodically besides on watch events
+	ticker := time.NewTicker(handoffSegmentCheckInterval)
+	defer ticker.Stop()
+
+	qc.handoffSegments(ctx)
+	watchChan := qc.kvClient.WatchWithPrefix(handoffSegmentPrefix)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case resp := <-watchChan:
+			for _, event := range resp.Events {
+				if event.Type == mvccpb.PUT {
+					qc.handoffSegments(ctx)
+					break
+				}
+			}
+		case <-ticker.C:
+			qc.handoffSegments(ctx)
+		}
+	}
+}
+
+// loadBalanceSegmentLoop balances the sealed segments between query nodes by their memory usage periodically
+func (qc *QueryCoord) loadBalanceSegmentLoop() {
+	ctx, cancel := context.WithCancel(qc.loopCtx)
+
+	defer cancel()
+	defer qc.loopWg.Done()
+	log.Debug("query coordinator start load balance segment loop")
+
+	ticker := time.NewTicker(Params.BalanceInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			qc.balanceSegments(ctx)
+		}
+	}
+}
+
+// handoffSegments enqueues handoff tasks for the flushed segments whose indexes are built. A segment is removed
+// from the handoff segments once its handoff task succeeds, or it needn't be handed off, and is retried otherwise.
+func (qc *QueryCoord) handoffSegments(ctx context.Context) {
+	_, values, err := qc.kvClient.LoadWithPrefix(handoffSegmentPrefix)
+	if err != nil {
+		log.Error("watch handoff segment loop error when load handoff segments", zap.Error(err))
+		return
+	}
+	for _, value := range values {
+		segmentInfo := &datapb.SegmentInfo{}
+		err = proto.UnmarshalText(value, segmentInfo)
+		if err != nil {
+			log.Error("watch handoff segment loop error when unmarshal", zap.Error(err))
+			continue
+		}
+		if qc.isSegmentHandingOff(segmentInfo.ID) {
+			continue
+		}
+		if !qc.meta.hasPartition(segmentInfo.CollectionID, segmentInfo.PartitionID) {
+			log.Debug("handoff segment of partition not loaded, ignore it", zap.Int64("segmentID", segmentInfo.ID))
+			qc.removeHandoffSegment(segmentInfo)
+			continue
+		}
+		if qc.meta.hasSegmentInfo(segmentInfo.ID) {
+			log.Debug("handoff segment already loaded", zap.Int64("segmentID", segmentInfo.ID))
+			qc.removeHandoffSegment(segmentInfo)
+			continue
+		}
+
+		collectionInfo, err := qc.meta.getCollectionInfoByID(segmentInfo.CollectionID)
+		if err != nil {
+			log.Warn("get collection info of handoff segment error", zap.Int64("segmentID", segmentInfo.ID), zap.Error(err))
+			continue
+		}
+		indexed, err := qc.isSegmentIndexed(ctx, segmentInfo, getVectorFieldIDs(collectionInfo.Schema))
+		if err != nil {
+			log.Warn("check handoff segment index error", zap.Int64("segmentID", segmentInfo.ID), zap.Error(err))
+			continue
+		}
+		if !indexed {
+			continue
+		}
+		handoffReq := &querypb.HandoffSegments{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_HandoffSegments,
+				SourceID: qc.session.ServerID,
+			},
+			SegmentInfos: []*datapb.SegmentInfo{segmentInfo},
+		}
+		handoffTask := &HandoffTask{
+			BaseTask: BaseTask{
+				ctx:              qc.loopCtx,
+				Condition:        NewTaskCondition(qc.loopCtx),
+				triggerCondition: querypb.TriggerCondition_handoff,
+			},
+			HandoffSegments: handoffReq,
+			dataCoord:       qc.dataCoordClient,
+			cluster:         qc.cluster,
+			meta:            qc.meta,
+		}
+		qc.handoffMu.Lock()
+		qc.handoffSegmentIDs[segmentInfo.ID] = struct{}{}
+		qc.handoffMu.Unlock()
+		qc.scheduler.Enqueue([]task{handoffTask})
+		log.Debug("handoff segment task enqueued", zap.Int64("segmentID", segmentInfo.ID))
+
+		qc.loopWg.Add(1)
+		go qc.waitHandoffTask(handoffTask, segmentInfo)
+	}
+}
+
+// waitHandoffTask removes the segment from the handoff segments if the handoff task succeeds, or else the
+// segment is handed off again in the next check
+func (qc *QueryCoord) waitHandoffTask(handoffTask *HandoffTask, segmentInfo *datapb.SegmentInfo) {
+	defer qc.loopWg.Done()
+	err := handoffTask.WaitToFinish()
+	defer func() {
+		qc.handoffMu.Lock()
+		delete(qc.handoffSegmentIDs, segmentInfo.ID)
+		qc.handoffMu.Unlock()
+	}()
+	if err != nil {
+		log.Warn("handoff segment task failed, retry it later", zap.Int64("segmentID", segmentInfo.ID), zap.Error(err))
+		return
+	}
+	// the child tasks loading the segment may fail after the handoff task itself succeeds
+	if !qc.meta.hasSegmentInfo(segmentInfo.ID) && !handoffTask.isSegmentIgnored(segmentInfo.ID) &&
+		qc.meta.hasPartition(segmentInfo.CollectionID, segmentInfo.PartitionID) {
+		log.Warn("handoff segment not loaded, retry it later", zap.Int64("segmentID", segmentInfo.ID))
+		return
+	}
+	qc.removeHandoffSegment(segmentInfo)
+}
+
+func (qc *QueryCoord) isSegmentHandingOff(segmentID UniqueID) bool {
+	qc.handoffMu.Lock()
+	defer qc.handoffMu.Unlock()
+	_, ok := qc.handoffSegmentIDs[segmentID]
+	return ok
+}
+
+func (qc *QueryCoord) removeHandoffSegment(segmentInfo *datapb.SegmentInfo) {
+	key := buildHandoffSegmentPath(segmentInfo.CollectionID, segmentInfo.PartitionID, segmentInfo.ID)
+	if err := qc.kvClient.Remove(key); err != nil {
+		log.Error("remove handoff segment error", zap.Int64("segmentID", segmentInfo.ID), zap.Error(err))
+	}
+}
+
+// isSegmentIndexed checks whether the indexes of the vector fields of the flushed segment are built, fields
+// without index or segments with too few rows to build index are regarded as indexed
+func (qc *QueryCoord) isSegmentIndexed(ctx context.Context, segmentInfo *datapb.SegmentInfo, vectorFieldIDs []int64) (bool, error) {
+	buildIDs := make([]UniqueID, 0)
+	for _, fieldID := range vectorFieldIDs {
+		describeReq := &milvuspb.DescribeSegmentRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_DescribeSegment,
+				SourceID: qc.session.ServerID,
+			},
+			CollectionID: segmentInfo.CollectionID,
+			SegmentID:    segmentInfo.ID,
+			FieldID:      fieldID,
+		}
+		describeResp, err := qc.rootCoordClient.DescribeSegment(ctx, describeReq)
+		if err != nil {
+			return false, err
+		}
+		// a field without index is described successfully with index disabled, a failed status leaves the index
+		// state unknown and the segment is checked again later
+		if describeResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return false, fmt.Errorf("describe segment %d of field %d failed, reason = %s",
+				segmentInfo.ID, fieldID, describeResp.Status.Reason)
+		}
+		if !describeResp.EnableIndex {
+			continue
+		}
+		buildIDs = append(buildIDs, describeResp.BuildID)
+	}
+	if len(buildIDs) == 0 {
+		return true, nil
+	}
+
+	statesResp, err := qc.indexCoordClient.GetIndexStates(ctx, &indexpb.GetIndexStatesRequest{
+		IndexBuildIDs: buildIDs,
+	})
+	if err != nil {
+		return false, err
+	}
+	if statesResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return false, errors.New(statesResp.Status.Reason)
+	}
+	for _, state := range statesResp.States {
+		if state.State != commonpb.IndexState_Finished {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func buildHandoffSegmentPath(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID) string {
+	return fmt.Sprintf("%s/%d/%d/%d", handoffSegmentPrefix, collectionID, partitionID, segmentID)
+}
@@ -13,12 +13,20 @@ package querycoord
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
 )
 
 func TestQueryCoord_Init(t *testing.T) {
@@ -52,6 +60,184 @@ func TestQueryCoord_Init(t *testing.T) {
 	service.Stop()
 }
 
+type indexRootCoordMock struct {
+	types.RootCoord
+	resp       *milvuspb.DescribeSegmentResponse
+	fieldResps map[UniqueID]*milvuspb.DescribeSegmentResponse // overrides resp of the fields
+}
+
+func (rc *indexRootCoordMock) DescribeSegment(ctx context.Context, req *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error) {
+	if resp, ok := rc.fieldResps[req.FieldID]; ok {
+		return resp, nil
+	}
+	return rc.resp, nil
+}
+
+type indexCoordMock struct {
+	types.IndexCoord
+	state       commonpb.IndexState
+	buildStates map[UniqueID]commonpb.IndexState // overrides state of the builds
+	err         error
+}
+
+func (ic *indexCoordMock) GetIndexStates(ctx context.Context, req *indexpb.GetIndexStatesRequest) (*indexpb.GetIndexStatesResponse, error) {
+	if ic.err != nil {
+		return nil, ic.err
+	}
+	resp := &indexpb.GetIndexStatesResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}
+	for _, buildID := range req.IndexBuildIDs {
+		state, ok := ic.buildStates[buildID]
+		if !ok {
+			state = ic.state
+		}
+		resp.States = append(resp.States, &indexpb.IndexInfo{IndexBuildID: buildID, State: state})
+	}
+	return resp, nil
+}
+
+func TestQueryCoord_isSegmentIndexed(t *testing.T) {
+	ctx := context.Background()
+	rootCoord := &indexRootCoordMock{}
+	indexCoord := &indexCoordMock{}
+	qc := &QueryCoord{
+		rootCoordClient:  rootCoord,
+		indexCoordClient: indexCoord,
+		session:          &sessionutil.Session{ServerID: 1},
+	}
+	segmentInfo := &datapb.SegmentInfo{ID: 1, CollectionID: 2}
+	vectorFieldIDs := []int64{101}
+
+	t.Run("describe segment failed", func(t *testing.T) {
+		rootCoord.resp = &milvuspb.DescribeSegmentResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "root coord not healthy"},
+		}
+		indexed, err := qc.isSegmentIndexed(ctx, segmentInfo, vectorFieldIDs)
+		assert.NotNil(t, err)
+		assert.False(t, indexed)
+	})
+
+	t.Run("index not enabled", func(t *testing.T) {
+		rootCoord.resp = &milvuspb.DescribeSegmentResponse{
+			Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			EnableIndex: false,
+		}
+		indexed, err := qc.isSegmentIndexed(ctx, segmentInfo, vectorFieldIDs)
+		assert.Nil(t, err)
+		assert.True(t, indexed)
+	})
+
+	rootCoord.resp = &milvuspb.DescribeSegmentResponse{
+		Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		BuildID:     10,
+		EnableIndex: true,
+	}
+	t.Run("index in progress", func(t *testing.T) {
+		indexCoord.state = commonpb.IndexState_InProgress
+		indexed, err := qc.isSegmentIndexed(ctx, segmentInfo, vectorFieldIDs)
+		assert.Nil(t, err)
+		assert.False(t, indexed)
+	})
+
+	t.Run("index finished", func(t *testing.T) {
+		indexCoord.state = commonpb.IndexState_Finished
+		indexed, err := qc.isSegmentIndexed(ctx, segmentInfo, vectorFieldIDs)
+		assert.Nil(t, err)
+		assert.True(t, indexed)
+	})
+
+	t.Run("multiple vector fields", func(t *testing.T) {
+		rootCoord.fieldResps = map[UniqueID]*milvuspb.DescribeSegmentResponse{
+			102: {
+				Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				BuildID:     11,
+				EnableIndex: true,
+			},
+			103: {
+				Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				EnableIndex: false,
+			},
+		}
+		defer func() {
+			rootCoord.fieldResps = nil
+			indexCoord.buildStates = nil
+		}()
+		indexCoord.state = commonpb.IndexState_Finished
+		indexCoord.buildStates = map[UniqueID]commonpb.IndexState{11: commonpb.IndexState_InProgress}
+		indexed, err := qc.isSegmentIndexed(ctx, segmentInfo, []int64{101, 102, 103})
+		assert.Nil(t, err)
+		assert.False(t, indexed)
+
+		indexCoord.buildStates[11] = commonpb.IndexState_Finished
+		indexed, err = qc.isSegmentIndexed(ctx, segmentInfo, []int64{101, 102, 103})
+		assert.Nil(t, err)
+		assert.True(t, indexed)
+	})
+
+	t.Run("get index states failed", func(t *testing.T) {
+		indexCoord.err = errors.New("index coord down")
+		_, err := qc.isSegmentIndexed(ctx, segmentInfo, vectorFieldIDs)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestQueryCoord_waitHandoffTask(t *testing.T) {
+	ctx := context.Background()
+	kv := newTestEtcdKV(t)
+	m, err := newMeta(kv)
+	assert.Nil(t, err)
+	assert.Nil(t, m.addCollection(1, nil))
+	assert.Nil(t, m.addPartition(1, 2))
+	qc := &QueryCoord{
+		kvClient:          kv,
+		meta:              m,
+		handoffSegmentIDs: make(map[UniqueID]struct{}),
+	}
+
+	segmentInfo := &datapb.SegmentInfo{ID: 3, CollectionID: 1, PartitionID: 2}
+	key := buildHandoffSegmentPath(1, 2, 3)
+	assert.Nil(t, kv.Save(key, proto.MarshalTextString(segmentInfo)))
+	hasKey := func() bool {
+		keys, _, err := kv.LoadWithPrefix(key)
+		assert.Nil(t, err)
+		return len(keys) > 0
+	}
+	waitTask := func(taskErr error, ignored bool) {
+		handoffTask := &HandoffTask{
+			BaseTask: BaseTask{
+				ctx:       ctx,
+				Condition: NewTaskCondition(ctx),
+			},
+		}
+		if ignored {
+			handoffTask.ignoreSegment(segmentInfo.ID)
+		}
+		qc.handoffSegmentIDs[segmentInfo.ID] = struct{}{}
+		handoffTask.Notify(taskErr)
+		qc.loopWg.Add(1)
+		qc.waitHandoffTask(handoffTask, segmentInfo)
+		assert.False(t, qc.isSegmentHandingOff(segmentInfo.ID))
+	}
+
+	// the handoff is retried if the task fails or the segment is not loaded
+	waitTask(errors.New("load segment failed"), false)
+	assert.True(t, hasKey())
+	waitTask(nil, false)
+	assert.True(t, hasKey())
+
+	m.setSegmentInfo(segmentInfo.ID, &querypb.SegmentInfo{SegmentID: 3, CollectionID: 1, PartitionID: 2})
+	waitTask(nil, false)
+	assert.False(t, hasKey())
+
+	// the segment compacted before handed off needn't be retried
+	segmentInfo.ID = 4
+	key = buildHandoffSegmentPath(1, 2, 4)
+	assert.Nil(t, kv.Save(key, proto.MarshalTextString(segmentInfo)))
+	waitTask(nil, true)
+	assert.False(t, hasKey())
+}
+
 //func TestQueryCoord_load(t *testing.T) {
 //	ctx := context.Background()
 //	msFactory := msgstream.NewPmsFactory()
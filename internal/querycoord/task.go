@@ -15,6 +15,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -132,10 +134,11 @@ func (bt *BaseTask) SetState(state taskState) {
 type LoadCollectionTask struct {
 	BaseTask
 	*querypb.LoadCollectionRequest
-	rootCoord types.RootCoord
-	dataCoord types.DataCoord
-	cluster   *queryNodeCluster
-	meta      *meta
+	rootCoord  types.RootCoord
+	dataCoord  types.DataCoord
+	indexCoord types.IndexCoord
+	cluster    *queryNodeCluster
+	meta       *meta
 }
 
 func (lct *LoadCollectionTask) MsgBase() *commonpb.MsgBase {
@@ -192,6 +195,20 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 	toLoadPartitionIDs := make([]UniqueID, 0)
 	hasCollection := lct.meta.hasCollection(collectionID)
 	watchPartition := false
+	replicas := lct.meta.getReplicas(collectionID)
+	if !hasCollection {
+		replicas, err = lct.newReplicas()
+		if err != nil {
+			status.Reason = err.Error()
+			lct.result = status
+			return err
+		}
+	} else if lct.ReplicaNumber > 1 && int(lct.ReplicaNumber) != len(replicas) {
+		err = fmt.Errorf("collection %d has been loaded with %d replicas, release it before loading %d replicas", collectionID, len(replicas), lct.ReplicaNumber)
+		status.Reason = err.Error()
+		lct.result = status
+		return err
+	}
 	if hasCollection {
 		watchPartition = true
 		loadCollection, _ := lct.meta.getLoadCollection(collectionID)
@@ -215,11 +232,6 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 	}
 
 	log.Debug("loadCollectionTask: toLoadPartitionIDs", zap.Int64s("partitionIDs", toLoadPartitionIDs))
-	lct.meta.addCollection(collectionID, lct.Schema)
-	lct.meta.setLoadCollection(collectionID, true)
-	for _, id := range toLoadPartitionIDs {
-		lct.meta.addPartition(collectionID, id)
-	}
 
 	loadSegmentReqs := make([]*querypb.LoadSegmentsRequest, 0)
 	watchDmChannelReqs := make([]*querypb.WatchDmChannelsRequest, 0)
@@ -245,6 +257,7 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 				PartitionID:  partitionID,
 				CollectionID: collectionID,
 				BinlogPaths:  segmentBingLog.FieldBinlogs,
+				Deltalogs:    segmentBingLog.Deltalogs,
 			}
 
 			loadSegmentReq := &querypb.LoadSegmentsRequest{
@@ -295,8 +308,47 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 		}
 	}
 
-	assignInternalTask(ctx, collectionID, lct, lct.meta, lct.cluster, loadSegmentReqs, watchDmChannelReqs)
-	log.Debug("loadCollectionTask: assign child task done", zap.Int64("collectionID", collectionID))
+	segmentLoadInfos := make([]*querypb.SegmentLoadInfo, 0, len(loadSegmentReqs))
+	for _, req := range loadSegmentReqs {
+		segmentLoadInfos = append(segmentLoadInfos, req.Infos...)
+	}
+	err = estimateSegmentSizes(ctx, lct.rootCoord, lct.dataCoord, lct.indexCoord, lct.Schema, segmentLoadInfos)
+	if err != nil {
+		log.Warn("loadCollectionTask: estimate segment sizes failed", zap.Int64("collectionID", collectionID), zap.Error(err))
+	} else {
+		err = checkLoadMemory(ctx, lct.cluster, segmentLoadInfos, replicas)
+		if err != nil {
+			err = fmt.Errorf("insufficient memory to load collection %d, %s", collectionID, err.Error())
+			status.ErrorCode = commonpb.ErrorCode_InsufficientMemoryToLoad
+			status.Reason = err.Error()
+			lct.result = status
+			return err
+		}
+	}
+
+	if !hasCollection {
+		for _, replica := range replicas {
+			err = lct.meta.addReplica(replica)
+			if err != nil {
+				status.Reason = err.Error()
+				lct.result = status
+				return err
+			}
+		}
+	}
+	lct.meta.addCollection(collectionID, lct.Schema)
+	lct.meta.setLoadCollection(collectionID, true)
+	for _, id := range toLoadPartitionIDs {
+		lct.meta.addPartition(collectionID, id)
+	}
+
+	err = assignReplicaTasks(ctx, collectionID, lct, lct.meta, lct.cluster, loadSegmentReqs, watchDmChannelReqs, replicas)
+	if err != nil {
+		status.Reason = err.Error()
+		lct.result = status
+		return err
+	}
+	log.Debug("loadCollectionTask: assign child task done", zap.Int64("collectionID", collectionID), zap.Int("replicaNumber", len(replicas)))
 
 	log.Debug("LoadCollection execute done",
 		zap.Int64("msgID", lct.ID()),
@@ -304,8 +356,55 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 	return nil
 }
 
+// newReplicas splits the query nodes on service into disjoint groups, one for each replica of the collection,
+// the replicas are saved to meta by Execute once the collection is admitted to load
+func (lct *LoadCollectionTask) newReplicas() ([]*querypb.ReplicaInfo, error) {
+	nodes, err := lct.cluster.onServiceNodes()
+	if err != nil {
+		return nil, err
+	}
+	nodeIDs := make([]int64, 0, len(nodes))
+	for nodeID := range nodes {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	return assignReplicas(lct.CollectionID, lct.ID(), int(lct.ReplicaNumber), nodeIDs)
+}
+
+// assignReplicas spreads the query nodes evenly over replicaNumber replicas, the replica IDs start from firstReplicaID
+func assignReplicas(collectionID UniqueID, firstReplicaID UniqueID, replicaNumber int, nodeIDs []int64) ([]*querypb.ReplicaInfo, error) {
+	if replicaNumber <= 0 {
+		replicaNumber = 1
+	}
+	if len(nodeIDs) < replicaNumber {
+		return nil, fmt.Errorf("no enough query nodes to load %d replicas, %d query nodes on service", replicaNumber, len(nodeIDs))
+	}
+	sorted := make([]int64, len(nodeIDs))
+	copy(sorted, nodeIDs)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	replicas := make([]*querypb.ReplicaInfo, 0, replicaNumber)
+	for i := 0; i < replicaNumber; i++ {
+		replicas = append(replicas, &querypb.ReplicaInfo{
+			ReplicaID:    firstReplicaID + int64(i),
+			CollectionID: collectionID,
+			NodeIDs:      make([]int64, 0),
+		})
+	}
+	for i, nodeID := range sorted {
+		replicas[i%replicaNumber].NodeIDs = append(replicas[i%replicaNumber].NodeIDs, nodeID)
+	}
+	return replicas, nil
+}
+
 func (lct *LoadCollectionTask) PostExecute(ctx context.Context) error {
 	collectionID := lct.CollectionID
+	if lct.result.ErrorCode == commonpb.ErrorCode_InsufficientMemoryToLoad {
+		// nothing is loaded or saved to meta if the collection is not admitted to load
+		log.Debug("LoadCollectionTask postExecute done, insufficient memory to load",
+			zap.Int64("msgID", lct.ID()),
+			zap.Int64("collectionID", collectionID))
+		return nil
+	}
 	lct.meta.addCollection(collectionID, lct.Schema)
 	if lct.result.ErrorCode != commonpb.ErrorCode_Success {
 		lct.childTasks = make([]task, 0)
@@ -468,10 +567,12 @@ func (rct *ReleaseCollectionTask) PostExecute(context.Context) error {
 type LoadPartitionTask struct {
 	BaseTask
 	*querypb.LoadPartitionsRequest
-	dataCoord types.DataCoord
-	cluster   *queryNodeCluster
-	meta      *meta
-	addCol    bool
+	rootCoord  types.RootCoord
+	dataCoord  types.DataCoord
+	indexCoord types.IndexCoord
+	cluster    *queryNodeCluster
+	meta       *meta
+	addCol     bool
 }
 
 func (lpt *LoadPartitionTask) MsgBase() *commonpb.MsgBase {
@@ -505,14 +606,6 @@ func (lpt *LoadPartitionTask) PreExecute(context.Context) error {
 func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 	collectionID := lpt.CollectionID
 	partitionIDs := lpt.PartitionIDs
-
-	if !lpt.meta.hasCollection(collectionID) {
-		lpt.meta.addCollection(collectionID, lpt.Schema)
-		lpt.addCol = true
-	}
-	for _, id := range partitionIDs {
-		lpt.meta.addPartition(collectionID, id)
-	}
 	status := &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_UnexpectedError,
 	}
@@ -541,6 +634,7 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 				PartitionID:  partitionID,
 				CollectionID: collectionID,
 				BinlogPaths:  segmentBingLog.FieldBinlogs,
+				Deltalogs:    segmentBingLog.Deltalogs,
 			}
 
 			loadSegmentReq := &querypb.LoadSegmentsRequest{
@@ -567,7 +661,39 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 			log.Debug("LoadPartitionTask: set watchDmChannelsRequests", zap.Any("request", watchDmRequest), zap.Int64("collectionID", collectionID))
 		}
 	}
-	assignInternalTask(ctx, collectionID, lpt, lpt.meta, lpt.cluster, loadSegmentReqs, watchDmReqs)
+	replicas := lpt.meta.getReplicas(collectionID)
+	segmentLoadInfos := make([]*querypb.SegmentLoadInfo, 0, len(loadSegmentReqs))
+	for _, req := range loadSegmentReqs {
+		segmentLoadInfos = append(segmentLoadInfos, req.Infos...)
+	}
+	err := estimateSegmentSizes(ctx, lpt.rootCoord, lpt.dataCoord, lpt.indexCoord, lpt.Schema, segmentLoadInfos)
+	if err != nil {
+		log.Warn("LoadPartitionTask: estimate segment sizes failed", zap.Int64("collectionID", collectionID), zap.Error(err))
+	} else {
+		err = checkLoadMemory(ctx, lpt.cluster, segmentLoadInfos, replicas)
+		if err != nil {
+			err = fmt.Errorf("insufficient memory to load partitions %v of collection %d, %s", partitionIDs, collectionID, err.Error())
+			status.ErrorCode = commonpb.ErrorCode_InsufficientMemoryToLoad
+			status.Reason = err.Error()
+			lpt.result = status
+			return err
+		}
+	}
+
+	if !lpt.meta.hasCollection(collectionID) {
+		lpt.meta.addCollection(collectionID, lpt.Schema)
+		lpt.addCol = true
+	}
+	for _, id := range partitionIDs {
+		lpt.meta.addPartition(collectionID, id)
+	}
+
+	err = assignReplicaTasks(ctx, collectionID, lpt, lpt.meta, lpt.cluster, loadSegmentReqs, watchDmReqs, replicas)
+	if err != nil {
+		status.Reason = err.Error()
+		lpt.result = status
+		return err
+	}
 	log.Debug("LoadPartitionTask: assign child task done", zap.Int64("collectionID", collectionID), zap.Int64s("partitionIDs", partitionIDs))
 
 	log.Debug("LoadPartitionTask Execute done",
@@ -580,6 +706,14 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 func (lpt *LoadPartitionTask) PostExecute(ctx context.Context) error {
 	collectionID := lpt.CollectionID
 	partitionIDs := lpt.PartitionIDs
+	if lpt.result.ErrorCode == commonpb.ErrorCode_InsufficientMemoryToLoad {
+		// nothing is loaded or saved to meta if the partitions are not admitted to load
+		log.Debug("LoadPartitionTask postExecute done, insufficient memory to load",
+			zap.Int64("msgID", lpt.ID()),
+			zap.Int64("collectionID", collectionID),
+			zap.Int64s("partitionIDs", partitionIDs))
+		return nil
+	}
 	if lpt.result.ErrorCode != commonpb.ErrorCode_Success {
 		lpt.childTasks = make([]task, 0)
 		if lpt.addCol {
@@ -757,6 +891,8 @@ type LoadSegmentTask struct {
 	*querypb.LoadSegmentsRequest
 	meta    *meta
 	cluster *queryNodeCluster
+	// the query node refused to load the segments for insufficient memory, they are rescheduled to the other ones
+	insufficientMemory bool
 }
 
 func (lst *LoadSegmentTask) MsgBase() *commonpb.MsgBase {
@@ -773,7 +909,7 @@ func (lst *LoadSegmentTask) IsValid() bool {
 		return false
 	}
 
-	return lst.ctx != nil && onService
+	return lst.ctx != nil && onService && !lst.insufficientMemory
 }
 
 func (lst *LoadSegmentTask) Type() commonpb.MsgType {
@@ -804,6 +940,16 @@ func (lst *LoadSegmentTask) Execute(ctx context.Context) error {
 	}
 
 	lst.result = status
+	lst.insufficientMemory = status.ErrorCode == commonpb.ErrorCode_InsufficientMemoryToLoad
+	if lst.insufficientMemory {
+		return errors.New(status.Reason)
+	}
+	if lst.LoadCondition == querypb.TriggerCondition_handoff && status.ErrorCode == commonpb.ErrorCode_Success {
+		lst.releaseCompactedSegments(ctx)
+	}
+	if lst.LoadCondition == querypb.TriggerCondition_loadBalance && status.ErrorCode == commonpb.ErrorCode_Success {
+		lst.releaseMovedSegments(ctx)
+	}
 	log.Debug("loadSegmentTask Execute done",
 		zap.Int64("taskID", lst.ID()))
 	return nil
@@ -814,7 +960,124 @@ func (lst *LoadSegmentTask) PostExecute(context.Context) error {
 	return nil
 }
 
+// releaseCompactedSegments releases the segments compacted into the handed off segments. The ones on the
+// loading query node have been released by it when the handed off segments were put on service.
+func (lst *LoadSegmentTask) releaseCompactedSegments(ctx context.Context) {
+	for _, info := range lst.Infos {
+		// the other replicas release the compacted segments when they load the handed off segments
+		replicaNodeIDs := getReplicaNodeIDs(lst.meta, info.CollectionID, lst.ReplicaID)
+		node2Segments := make(map[int64][]UniqueID)
+		for _, segmentID := range info.CompactionFrom {
+			segmentInfo, err := lst.meta.getSegmentInfoByID(segmentID)
+			if err != nil {
+				continue
+			}
+			for _, nodeID := range getSegmentNodeIDs(segmentInfo) {
+				if nodeID == lst.NodeID {
+					lst.meta.deleteSegmentNode(segmentID, nodeID)
+					continue
+				}
+				if replicaNodeIDs != nil && !containsNode(replicaNodeIDs, nodeID) {
+					continue
+				}
+				node2Segments[nodeID] = append(node2Segments[nodeID], segmentID)
+			}
+		}
+
+		for nodeID, segmentIDs := range node2Segments {
+			releaseSegmentReq := &querypb.ReleaseSegmentsRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:   commonpb.MsgType_ReleaseSegments,
+					MsgID:     lst.Base.MsgID,
+					Timestamp: lst.Base.Timestamp,
+					SourceID:  lst.Base.SourceID,
+				},
+				NodeID:       nodeID,
+				CollectionID: info.CollectionID,
+				PartitionIDs: []UniqueID{info.PartitionID},
+				SegmentIDs:   segmentIDs,
+			}
+			status, err := lst.cluster.ReleaseSegments(ctx, nodeID, releaseSegmentReq)
+			if err == nil && status.ErrorCode != commonpb.ErrorCode_Success {
+				err = errors.New(status.Reason)
+			}
+			if err != nil {
+				log.Error("loadSegmentTask: release compacted segments error", zap.Int64("nodeID", nodeID),
+					zap.Int64s("segmentIDs", segmentIDs), zap.Error(err))
+			}
+		}
+	}
+}
+
+// releaseMovedSegments releases the segments moved for load balance from the source query node, after they
+// are loaded on the dst one
+func (lst *LoadSegmentTask) releaseMovedSegments(ctx context.Context) {
+	if lst.SourceNodeID == 0 || lst.SourceNodeID == lst.NodeID {
+		return
+	}
+	segmentIDs := make([]UniqueID, 0)
+	partitionIDs := make([]UniqueID, 0)
+	partitions := make(map[UniqueID]struct{})
+	for _, info := range lst.Infos {
+		segmentIDs = append(segmentIDs, info.SegmentID)
+		if _, ok := partitions[info.PartitionID]; !ok {
+			partitions[info.PartitionID] = struct{}{}
+			partitionIDs = append(partitionIDs, info.PartitionID)
+		}
+	}
+	releaseSegmentReq := &querypb.ReleaseSegmentsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_ReleaseSegments,
+			MsgID:     lst.Base.MsgID,
+			Timestamp: lst.Base.Timestamp,
+			SourceID:  lst.Base.SourceID,
+		},
+		NodeID:       lst.SourceNodeID,
+		CollectionID: lst.Infos[0].CollectionID,
+		PartitionIDs: partitionIDs,
+		SegmentIDs:   segmentIDs,
+	}
+	status, err := lst.cluster.ReleaseSegments(ctx, lst.SourceNodeID, releaseSegmentReq)
+	if err == nil && status.ErrorCode != commonpb.ErrorCode_Success {
+		err = errors.New(status.Reason)
+	}
+	if err != nil {
+		log.Error("loadSegmentTask: release moved segments error", zap.Int64("nodeID", lst.SourceNodeID),
+			zap.Int64s("segmentIDs", segmentIDs), zap.Error(err))
+	}
+}
+
+// placeOnOtherQueryNodes places the segments refused by the query node on the other query nodes of the replica,
+// or all the other query nodes if the collection is loaded without replicas, by their free memory
+func (lst *LoadSegmentTask) placeOnOtherQueryNodes() ([]int64, error) {
+	nodeIDs := getReplicaNodeIDs(lst.meta, lst.Infos[0].CollectionID, lst.ReplicaID)
+	if len(nodeIDs) == 0 {
+		nodes, err := lst.cluster.onServiceNodes()
+		if err != nil {
+			return nil, err
+		}
+		for nodeID := range nodes {
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+	}
+	otherNodeIDs := make([]int64, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		if nodeID != lst.NodeID {
+			otherNodeIDs = append(otherNodeIDs, nodeID)
+		}
+	}
+	if len(otherNodeIDs) == 0 {
+		return nil, fmt.Errorf("no other query node to load the segments refused by query node %d for insufficient memory", lst.NodeID)
+	}
+	return placeSegmentsOnQueryNode(lst.ctx, lst.Infos, lst.cluster, otherNodeIDs)
+}
+
 func (lst *LoadSegmentTask) Reschedule() ([]task, error) {
+	if lst.LoadCondition == querypb.TriggerCondition_loadBalance {
+		// the segments failed to move are still served by the source query node
+		log.Debug("LoadSegmentTask: segments failed to move for load balance are not rescheduled", zap.Int64("taskID", lst.ID()))
+		return nil, nil
+	}
 	segmentIDs := make([]UniqueID, 0)
 	collectionID := lst.Infos[0].CollectionID
 	reScheduledTask := make([]task, 0)
@@ -822,11 +1085,21 @@ func (lst *LoadSegmentTask) Reschedule() ([]task, error) {
 		segmentID := info.SegmentID
 		segmentIDs = append(segmentIDs, segmentID)
 	}
-	segment2Nodes := shuffleSegmentsToQueryNode(segmentIDs, lst.cluster)
+	var segment2Nodes []int64
+	var err error
+	if lst.insufficientMemory {
+		// the segments are only placed on the other query nodes with enough free memory,
+		// or given up to avoid bouncing between the query nodes
+		segment2Nodes, err = lst.placeOnOtherQueryNodes()
+	} else {
+		segment2Nodes, err = shuffleSegmentsToQueryNode(segmentIDs, lst.cluster, getReplicaNodeIDs(lst.meta, collectionID, lst.ReplicaID))
+	}
+	if err != nil {
+		return nil, err
+	}
 	node2segmentInfos := make(map[int64][]*querypb.SegmentLoadInfo)
-	for _, info := range lst.Infos {
-		segmentID := info.SegmentID
-		nodeID := segment2Nodes[segmentID]
+	for index, info := range lst.Infos {
+		nodeID := segment2Nodes[index]
 		if _, ok := node2segmentInfos[nodeID]; !ok {
 			node2segmentInfos[nodeID] = make([]*querypb.SegmentLoadInfo, 0)
 		}
@@ -842,6 +1115,7 @@ func (lst *LoadSegmentTask) Reschedule() ([]task, error) {
 				Infos:         infos,
 				Schema:        lst.Schema,
 				LoadCondition: lst.LoadCondition,
+				ReplicaID:     lst.ReplicaID,
 			},
 			meta:    lst.meta,
 			cluster: lst.cluster,
@@ -859,6 +1133,7 @@ func (lst *LoadSegmentTask) Reschedule() ([]task, error) {
 				CollectionID:     collectionID,
 				RequestChannelID: queryChannel,
 				ResultChannelID:  queryResultChannel,
+				ReplicaID:        lst.ReplicaID,
 			}
 			watchQueryChannelTask := &WatchQueryChannelTask{
 				BaseTask: BaseTask{
@@ -1011,7 +1286,10 @@ func (wdt *WatchDmChannelTask) Reschedule() ([]task, error) {
 		channelIDs = append(channelIDs, channelID)
 	}
 
-	channel2Nodes := shuffleChannelsToQueryNode(channelIDs, wdt.cluster)
+	channel2Nodes, err := shuffleChannelsToQueryNode(channelIDs, wdt.cluster, getReplicaNodeIDs(wdt.meta, collectionID, wdt.ReplicaID))
+	if err != nil {
+		return nil, err
+	}
 	node2channelInfos := make(map[int64][]*datapb.VchannelInfo)
 	for index, info := range wdt.Infos {
 		nodeID := channel2Nodes[index]
@@ -1032,6 +1310,7 @@ func (wdt *WatchDmChannelTask) Reschedule() ([]task, error) {
 				Infos:        infos,
 				Schema:       wdt.Schema,
 				ExcludeInfos: wdt.ExcludeInfos,
+				ReplicaID:    wdt.ReplicaID,
 			},
 			meta:    wdt.meta,
 			cluster: wdt.cluster,
@@ -1049,6 +1328,7 @@ func (wdt *WatchDmChannelTask) Reschedule() ([]task, error) {
 				CollectionID:     collectionID,
 				RequestChannelID: queryChannel,
 				ResultChannelID:  queryResultChannel,
+				ReplicaID:        wdt.ReplicaID,
 			}
 			watchQueryChannelTask := &WatchQueryChannelTask{
 				BaseTask: BaseTask{
@@ -1136,6 +1416,203 @@ func (wqt *WatchQueryChannelTask) PostExecute(context.Context) error {
 
 //****************************handoff task********************************//
 type HandoffTask struct {
+	BaseTask
+	*querypb.HandoffSegments
+	dataCoord types.DataCoord
+	cluster   *queryNodeCluster
+	meta      *meta
+
+	mu sync.Mutex
+	// the segments which needn't be handed off, whose partitions are released or binlogs are compacted
+	ignoredSegmentIDs map[UniqueID]struct{}
+}
+
+func (ht *HandoffTask) ignoreSegment(segmentID UniqueID) {
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	if ht.ignoredSegmentIDs == nil {
+		ht.ignoredSegmentIDs = make(map[UniqueID]struct{})
+	}
+	ht.ignoredSegmentIDs[segmentID] = struct{}{}
+}
+
+func (ht *HandoffTask) isSegmentIgnored(segmentID UniqueID) bool {
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	_, ok := ht.ignoredSegmentIDs[segmentID]
+	return ok
+}
+
+func (ht *HandoffTask) MsgBase() *commonpb.MsgBase {
+	return ht.Base
+}
+
+func (ht *HandoffTask) Marshal() string {
+	return proto.MarshalTextString(ht.HandoffSegments)
+}
+
+func (ht *HandoffTask) Type() commonpb.MsgType {
+	return ht.Base.MsgType
+}
+
+func (ht *HandoffTask) Timestamp() Timestamp {
+	return ht.Base.Timestamp
+}
+
+func (ht *HandoffTask) PreExecute(context.Context) error {
+	segmentIDs := make([]UniqueID, 0)
+	for _, info := range ht.SegmentInfos {
+		segmentIDs = append(segmentIDs, info.ID)
+	}
+	ht.result = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	log.Debug("start do HandoffTask",
+		zap.Int64s("segmentIDs", segmentIDs),
+		zap.Int64("taskID", ht.ID()))
+	return nil
+}
+
+func (ht *HandoffTask) Execute(ctx context.Context) error {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	node2Segments := make(map[int64]*querypb.LoadSegmentsRequest)
+	for _, segmentInfo := range ht.SegmentInfos {
+		collectionID := segmentInfo.CollectionID
+		partitionID := segmentInfo.PartitionID
+		segmentID := segmentInfo.ID
+		if !ht.meta.hasPartition(collectionID, partitionID) {
+			log.Debug("HandoffTask: partition of the segment not loaded, ignore it", zap.Int64("segmentID", segmentID))
+			ht.ignoreSegment(segmentID)
+			continue
+		}
+		if ht.meta.hasSegmentInfo(segmentID) {
+			// the task is reloaded after the segment is handed off
+			log.Debug("HandoffTask: segment already loaded, ignore it", zap.Int64("segmentID", segmentID))
+			continue
+		}
+		collectionInfo, err := ht.meta.getCollectionInfoByID(collectionID)
+		if err != nil {
+			status.Reason = err.Error()
+			ht.result = status
+			return err
+		}
+		nodeIDs, err := getHandoffNodeIDs(ht.meta, collectionInfo, segmentInfo)
+		if err != nil {
+			log.Warn("HandoffTask: no query node to hand off the segment", zap.Int64("segmentID", segmentID), zap.Error(err))
+			continue
+		}
+
+		getRecoveryInfoRequest := &datapb.GetRecoveryInfoRequest{
+			Base:         ht.Base,
+			CollectionID: collectionID,
+			PartitionID:  partitionID,
+		}
+		recoveryInfo, err := ht.dataCoord.GetRecoveryInfo(ctx, getRecoveryInfoRequest)
+		if err != nil {
+			status.Reason = err.Error()
+			ht.result = status
+			return err
+		}
+		var segmentLoadInfo *querypb.SegmentLoadInfo
+		for _, segmentBinlogs := range recoveryInfo.Binlogs {
+			if segmentBinlogs.SegmentID == segmentID {
+				segmentLoadInfo = &querypb.SegmentLoadInfo{
+					SegmentID:      segmentID,
+					PartitionID:    partitionID,
+					CollectionID:   collectionID,
+					BinlogPaths:    segmentBinlogs.FieldBinlogs,
+					Deltalogs:      segmentBinlogs.Deltalogs,
+					CompactionFrom: segmentInfo.CompactionFrom,
+					DmlPosition:    segmentInfo.DmlPosition,
+				}
+				break
+			}
+		}
+		if segmentLoadInfo == nil {
+			// the segment may be compacted before handed off
+			log.Warn("HandoffTask: binlogs of the segment not found", zap.Int64("segmentID", segmentID))
+			ht.ignoreSegment(segmentID)
+			continue
+		}
+
+		// the segment is handed off to each replica of the collection
+		for _, nodeID := range nodeIDs {
+			if _, ok := node2Segments[nodeID]; !ok {
+				var replicaID UniqueID
+				if replica, err := ht.meta.getReplicaByNodeID(collectionID, nodeID); err == nil {
+					replicaID = replica.ReplicaID
+				}
+				node2Segments[nodeID] = &querypb.LoadSegmentsRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:   commonpb.MsgType_LoadSegments,
+						MsgID:     ht.Base.MsgID,
+						Timestamp: ht.Base.Timestamp,
+						SourceID:  ht.Base.SourceID,
+					},
+					NodeID:        nodeID,
+					Schema:        collectionInfo.Schema,
+					LoadCondition: querypb.TriggerCondition_handoff,
+					ReplicaID:     replicaID,
+				}
+			}
+			node2Segments[nodeID].Infos = append(node2Segments[nodeID].Infos, segmentLoadInfo)
+		}
+	}
+
+	for nodeID, loadSegmentsReq := range node2Segments {
+		loadSegmentTask := &LoadSegmentTask{
+			BaseTask: BaseTask{
+				ctx:              ht.ctx,
+				Condition:        NewTaskCondition(ht.ctx),
+				triggerCondition: querypb.TriggerCondition_handoff,
+			},
+			LoadSegmentsRequest: loadSegmentsReq,
+			meta:                ht.meta,
+			cluster:             ht.cluster,
+		}
+		ht.AddChildTask(loadSegmentTask)
+		log.Debug("HandoffTask: add a loadSegmentTask childTask", zap.Int64("nodeID", nodeID))
+	}
+
+	log.Debug("HandoffTask Execute done",
+		zap.Int64("taskID", ht.ID()))
+	return nil
+}
+
+func (ht *HandoffTask) PostExecute(context.Context) error {
+	if ht.result.ErrorCode != commonpb.ErrorCode_Success {
+		ht.childTasks = make([]task, 0)
+	}
+	log.Debug("HandoffTask postExecute done",
+		zap.Int64("taskID", ht.ID()))
+	return nil
+}
+
+// getHandoffNodeIDs returns the query nodes serving the segments compacted into the handoff segment, one for
+// each replica, or else the query nodes watching the insert channel of the segment, which serve it as a growing segment
+func getHandoffNodeIDs(meta *meta, collectionInfo *querypb.CollectionInfo, segmentInfo *datapb.SegmentInfo) ([]int64, error) {
+	for _, segmentID := range segmentInfo.CompactionFrom {
+		info, err := meta.getSegmentInfoByID(segmentID)
+		if err == nil {
+			return getSegmentNodeIDs(info), nil
+		}
+	}
+	nodeIDs := make([]int64, 0)
+	for _, channelInfo := range collectionInfo.ChannelInfos {
+		for _, channel := range channelInfo.ChannelIDs {
+			if channel == segmentInfo.InsertChannel {
+				nodeIDs = append(nodeIDs, channelInfo.NodeIDLoaded)
+				break
+			}
+		}
+	}
+	if len(nodeIDs) == 0 {
+		return nil, fmt.Errorf("channel %s of segment %d is not watched", segmentInfo.InsertChannel, segmentInfo.ID)
+	}
+	return nodeIDs, nil
 }
 
 //*********************** ***load balance task*** ************************//
@@ -1184,7 +1661,10 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 				log.Error(err.Error())
 				continue
 			}
-			lbt.meta.deleteSegmentInfoByNodeID(nodeID)
+			lostSegments := make(map[UniqueID]struct{})
+			for _, segmentID := range lbt.meta.deleteSegmentInfoByNodeID(nodeID) {
+				lostSegments[segmentID] = struct{}{}
+			}
 			collectionInfos := node.collectionInfos
 			for collectionID, info := range collectionInfos {
 				metaInfo, err := lbt.meta.getCollectionInfoByID(collectionID)
@@ -1192,6 +1672,11 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 					log.Error(err.Error())
 					continue
 				}
+				replica, err := lbt.replaceReplicaNode(collectionID, nodeID)
+				if err != nil {
+					log.Error("loadBalanceTask: replica of the collection can't be reloaded", zap.Int64("collectionID", collectionID), zap.Error(err))
+					continue
+				}
 				loadCollection := metaInfo.LoadCollection
 				schema := metaInfo.Schema
 				partitionIDs := info.PartitionIDs
@@ -1225,11 +1710,16 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 
 					for _, segmentBingLog := range recoveryInfo.Binlogs {
 						segmentID := segmentBingLog.SegmentID
+						// the other query nodes still serve the segments not on the offline one
+						if _, ok := lostSegments[segmentID]; !ok {
+							continue
+						}
 						segmentLoadInfo := &querypb.SegmentLoadInfo{
 							SegmentID:    segmentID,
 							PartitionID:  partitionID,
 							CollectionID: collectionID,
 							BinlogPaths:  segmentBingLog.FieldBinlogs,
+							Deltalogs:    segmentBingLog.Deltalogs,
 						}
 
 						loadSegmentReq := &querypb.LoadSegmentsRequest{
@@ -1283,16 +1773,25 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 						}
 					}
 				}
-				assignInternalTask(ctx, collectionID, lbt, lbt.meta, lbt.cluster, loadSegmentReqs, watchDmChannelReqs)
+				err = assignInternalTask(ctx, collectionID, lbt, lbt.meta, lbt.cluster, loadSegmentReqs, watchDmChannelReqs, replica)
+				if err != nil {
+					status.Reason = err.Error()
+					lbt.result = status
+					return err
+				}
 				log.Debug("loadBalanceTask: assign child task done", zap.Int64("collectionID", collectionID), zap.Int64s("partitionIDs", partitionIDs))
 			}
 		}
 	}
 
-	//TODO::
-	//if lbt.triggerCondition == querypb.TriggerCondition_loadBalance {
-	//	return nil
-	//}
+	if lbt.triggerCondition == querypb.TriggerCondition_loadBalance {
+		err := lbt.moveSegments(ctx)
+		if err != nil {
+			status.Reason = err.Error()
+			lbt.result = status
+			return err
+		}
+	}
 
 	log.Debug("LoadBalanceTask Execute done",
 		zap.Int64s("sourceNodeIDs", lbt.SourceNodeIDs),
@@ -1301,11 +1800,195 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 	return nil
 }
 
-func (lbt *LoadBalanceTask) PostExecute(context.Context) error {
-	for _, id := range lbt.SourceNodeIDs {
-		err := lbt.cluster.removeNodeInfo(id)
+// replaceReplicaNode removes the offline query node from its replica of the collection, and returns the replica
+// with the query nodes to reload the segments and channels of the offline one, which are the other query nodes
+// on service of the replica, or the ones serving no replica of the collection if there is none. A nil replica is
+// returned if the collection is loaded without replicas.
+func (lbt *LoadBalanceTask) replaceReplicaNode(collectionID UniqueID, nodeID int64) (*querypb.ReplicaInfo, error) {
+	if len(lbt.meta.getReplicas(collectionID)) == 0 {
+		return nil, nil
+	}
+	replica, err := lbt.meta.getReplicaByNodeID(collectionID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := lbt.cluster.onServiceNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	replicaNodeIDs := make([]int64, 0)
+	onServiceNodeIDs := make([]int64, 0)
+	for _, id := range replica.NodeIDs {
+		if id == nodeID {
+			continue
+		}
+		replicaNodeIDs = append(replicaNodeIDs, id)
+		if _, ok := nodes[id]; ok {
+			onServiceNodeIDs = append(onServiceNodeIDs, id)
+		}
+	}
+	if len(onServiceNodeIDs) == 0 {
+		for id := range nodes {
+			if _, err := lbt.meta.getReplicaByNodeID(collectionID, id); err != nil {
+				onServiceNodeIDs = append(onServiceNodeIDs, id)
+				replicaNodeIDs = append(replicaNodeIDs, id)
+			}
+		}
+	}
+	if len(onServiceNodeIDs) == 0 {
+		return nil, fmt.Errorf("no query node on service to reload replica %d", replica.ReplicaID)
+	}
+
+	err = lbt.meta.setReplicaNodes(collectionID, replica.ReplicaID, replicaNodeIDs)
+	if err != nil {
+		return nil, err
+	}
+	replica.NodeIDs = onServiceNodeIDs
+	return replica, nil
+}
+
+// moveSegments adds the child tasks loading the sealed segments on the dst query node, which release them
+// from the source query node after loaded
+func (lbt *LoadBalanceTask) moveSegments(ctx context.Context) error {
+	if len(lbt.SourceNodeIDs) != 1 || len(lbt.DstNodeIDs) != 1 {
+		return fmt.Errorf("segments should move from one query node to another, source %v, dst %v", lbt.SourceNodeIDs, lbt.DstNodeIDs)
+	}
+	sourceNodeID, dstNodeID := lbt.SourceNodeIDs[0], lbt.DstNodeIDs[0]
+	onService, err := lbt.cluster.isOnService(dstNodeID)
+	if err != nil {
+		return err
+	}
+	if !onService {
+		return fmt.Errorf("query node %d to move segments to is not on service", dstNodeID)
+	}
+
+	// the segments are loaded by collections, and by partitions for the binlogs
+	collection2Partitions := make(map[UniqueID]map[UniqueID]map[UniqueID]struct{})
+	for _, segmentID := range lbt.SealedSegmentIDs {
+		info, err := lbt.meta.getSegmentInfoByID(segmentID)
 		if err != nil {
-			log.Error("LoadBalanceTask: remove mode info error", zap.Int64("nodeID", id))
+			log.Warn("loadBalanceTask: segment to move not found", zap.Int64("segmentID", segmentID))
+			continue
+		}
+		if !segmentOnNode(info, sourceNodeID) || segmentOnNode(info, dstNodeID) {
+			log.Warn("loadBalanceTask: segment to move is not on the source query node only", zap.Int64("segmentID", segmentID),
+				zap.Int64s("nodeIDs", getSegmentNodeIDs(info)))
+			continue
+		}
+		if _, ok := collection2Partitions[info.CollectionID]; !ok {
+			collection2Partitions[info.CollectionID] = make(map[UniqueID]map[UniqueID]struct{})
+		}
+		if _, ok := collection2Partitions[info.CollectionID][info.PartitionID]; !ok {
+			collection2Partitions[info.CollectionID][info.PartitionID] = make(map[UniqueID]struct{})
+		}
+		collection2Partitions[info.CollectionID][info.PartitionID][segmentID] = struct{}{}
+	}
+
+	for collectionID, partition2Segments := range collection2Partitions {
+		collectionInfo, err := lbt.meta.getCollectionInfoByID(collectionID)
+		if err != nil {
+			return err
+		}
+		var replicaID UniqueID
+		if replica, err := lbt.meta.getReplicaByNodeID(collectionID, sourceNodeID); err == nil {
+			replicaID = replica.ReplicaID
+		}
+
+		infos := make([]*querypb.SegmentLoadInfo, 0)
+		for partitionID, segmentIDs := range partition2Segments {
+			recoveryInfo, err := lbt.dataCoord.GetRecoveryInfo(ctx, &datapb.GetRecoveryInfoRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_LoadBalanceSegments,
+				},
+				CollectionID: collectionID,
+				PartitionID:  partitionID,
+			})
+			if err != nil {
+				return err
+			}
+			for _, segmentBinlogs := range recoveryInfo.Binlogs {
+				if _, ok := segmentIDs[segmentBinlogs.SegmentID]; !ok {
+					continue
+				}
+				infos = append(infos, &querypb.SegmentLoadInfo{
+					SegmentID:    segmentBinlogs.SegmentID,
+					PartitionID:  partitionID,
+					CollectionID: collectionID,
+					BinlogPaths:  segmentBinlogs.FieldBinlogs,
+					Deltalogs:    segmentBinlogs.Deltalogs,
+				})
+			}
+		}
+		if len(infos) == 0 {
+			continue
+		}
+
+		loadSegmentTask := &LoadSegmentTask{
+			BaseTask: BaseTask{
+				ctx:              lbt.ctx,
+				Condition:        NewTaskCondition(lbt.ctx),
+				triggerCondition: querypb.TriggerCondition_loadBalance,
+			},
+			LoadSegmentsRequest: &querypb.LoadSegmentsRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:   commonpb.MsgType_LoadSegments,
+					MsgID:     lbt.Base.MsgID,
+					Timestamp: lbt.Base.Timestamp,
+					SourceID:  lbt.Base.SourceID,
+				},
+				NodeID:        dstNodeID,
+				Infos:         infos,
+				Schema:        collectionInfo.Schema,
+				LoadCondition: querypb.TriggerCondition_loadBalance,
+				ReplicaID:     replicaID,
+				SourceNodeID:  sourceNodeID,
+			},
+			meta:    lbt.meta,
+			cluster: lbt.cluster,
+		}
+		lbt.AddChildTask(loadSegmentTask)
+		log.Debug("loadBalanceTask: add a loadSegmentTask childTask", zap.Int64("collectionID", collectionID),
+			zap.Int64("sourceNodeID", sourceNodeID), zap.Int64("dstNodeID", dstNodeID))
+
+		if !lbt.cluster.hasWatchedQueryChannel(lbt.ctx, dstNodeID, collectionID) {
+			queryChannel, queryResultChannel := lbt.meta.GetQueryChannel(collectionID)
+			watchQueryChannelTask := &WatchQueryChannelTask{
+				BaseTask: BaseTask{
+					ctx:              lbt.ctx,
+					Condition:        NewTaskCondition(lbt.ctx),
+					triggerCondition: querypb.TriggerCondition_loadBalance,
+				},
+				AddQueryChannelRequest: &querypb.AddQueryChannelRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:   commonpb.MsgType_WatchQueryChannels,
+						MsgID:     lbt.Base.MsgID,
+						Timestamp: lbt.Base.Timestamp,
+						SourceID:  lbt.Base.SourceID,
+					},
+					NodeID:           dstNodeID,
+					CollectionID:     collectionID,
+					RequestChannelID: queryChannel,
+					ResultChannelID:  queryResultChannel,
+					ReplicaID:        replicaID,
+				},
+				cluster: lbt.cluster,
+			}
+			lbt.AddChildTask(watchQueryChannelTask)
+			log.Debug("loadBalanceTask: add a watchQueryChannelTask childTask", zap.Int64("collectionID", collectionID),
+				zap.Int64("dstNodeID", dstNodeID))
+		}
+	}
+	return nil
+}
+
+func (lbt *LoadBalanceTask) PostExecute(context.Context) error {
+	if lbt.triggerCondition == querypb.TriggerCondition_nodeDown {
+		for _, id := range lbt.SourceNodeIDs {
+			err := lbt.cluster.removeNodeInfo(id)
+			if err != nil {
+				log.Error("LoadBalanceTask: remove mode info error", zap.Int64("nodeID", id))
+			}
 		}
 	}
 	log.Debug("LoadBalanceTask postExecute done",
@@ -1315,9 +1998,10 @@ func (lbt *LoadBalanceTask) PostExecute(context.Context) error {
 	return nil
 }
 
-func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster) []int64 {
-	maxNumChannels := 0
-	nodes := make(map[int64]*queryNode)
+// waitOnServiceNodes waits until any query node is on service, and returns the ones on service in nodeIDs,
+// or all of them if nodeIDs is empty
+func waitOnServiceNodes(cluster *queryNodeCluster, nodeIDs []int64) (map[int64]*queryNode, error) {
+	var nodes map[int64]*queryNode
 	var err error
 	for {
 		nodes, err = cluster.onServiceNodes()
@@ -1328,6 +2012,28 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster)
 		}
 		break
 	}
+	if len(nodeIDs) == 0 {
+		return nodes, nil
+	}
+
+	res := make(map[int64]*queryNode)
+	for _, nodeID := range nodeIDs {
+		if node, ok := nodes[nodeID]; ok {
+			res[nodeID] = node
+		}
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("none of query nodes %v is on service", nodeIDs)
+	}
+	return res, nil
+}
+
+func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster, nodeIDs []int64) ([]int64, error) {
+	maxNumChannels := 0
+	nodes, err := waitOnServiceNodes(cluster, nodeIDs)
+	if err != nil {
+		return nil, err
+	}
 
 	for nodeID := range nodes {
 		numChannels, _ := cluster.getNumDmChannels(nodeID)
@@ -1337,7 +2043,7 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster)
 	}
 	res := make([]int64, 0)
 	if len(dmChannels) == 0 {
-		return res
+		return res, nil
 	}
 
 	offset := 0
@@ -1353,7 +2059,7 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster)
 				res = append(res, nodeID)
 				offset++
 				if offset == len(dmChannels) {
-					return res
+					return res, nil
 				}
 			}
 		} else {
@@ -1361,7 +2067,7 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster)
 				res = append(res, nodeID)
 				offset++
 				if offset == len(dmChannels) {
-					return res
+					return res, nil
 				}
 			}
 		}
@@ -1371,18 +2077,11 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster)
 	}
 }
 
-func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster *queryNodeCluster) []int64 {
+func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster *queryNodeCluster, nodeIDs []int64) ([]int64, error) {
 	maxNumSegments := 0
-	nodes := make(map[int64]*queryNode)
-	var err error
-	for {
-		nodes, err = cluster.onServiceNodes()
-		if err != nil {
-			log.Debug(err.Error())
-			time.Sleep(1 * time.Second)
-			continue
-		}
-		break
+	nodes, err := waitOnServiceNodes(cluster, nodeIDs)
+	if err != nil {
+		return nil, err
 	}
 	for nodeID := range nodes {
 		numSegments, _ := cluster.getNumSegments(nodeID)
@@ -1393,7 +2092,7 @@ func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster *queryNodeCluster
 	res := make([]int64, 0)
 
 	if len(segmentIDs) == 0 {
-		return res
+		return res, nil
 	}
 
 	offset := 0
@@ -1409,7 +2108,7 @@ func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster *queryNodeCluster
 				res = append(res, nodeID)
 				offset++
 				if offset == len(segmentIDs) {
-					return res
+					return res, nil
 				}
 			}
 		} else {
@@ -1417,7 +2116,7 @@ func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster *queryNodeCluster
 				res = append(res, nodeID)
 				offset++
 				if offset == len(segmentIDs) {
-					return res
+					return res, nil
 				}
 			}
 		}
@@ -1458,13 +2157,47 @@ func mergeVChannelInfo(info1 *datapb.VchannelInfo, info2 *datapb.VchannelInfo) *
 		FlushedSegments:   flushedSegments,
 	}
 }
+// assignReplicaTasks assigns the internal tasks to the query nodes of each replica of the collection,
+// or to all the query nodes if the collection is loaded without replicas
+func assignReplicaTasks(ctx context.Context,
+	collectionID UniqueID,
+	parentTask task,
+	meta *meta,
+	cluster *queryNodeCluster,
+	loadSegmentRequests []*querypb.LoadSegmentsRequest,
+	watchDmChannelRequests []*querypb.WatchDmChannelsRequest,
+	replicas []*querypb.ReplicaInfo) error {
+	if len(replicas) == 0 {
+		return assignInternalTask(ctx, collectionID, parentTask, meta, cluster, loadSegmentRequests, watchDmChannelRequests, nil)
+	}
+
+	for _, replica := range replicas {
+		loadSegmentReqs := make([]*querypb.LoadSegmentsRequest, 0, len(loadSegmentRequests))
+		for _, req := range loadSegmentRequests {
+			loadSegmentReqs = append(loadSegmentReqs, proto.Clone(req).(*querypb.LoadSegmentsRequest))
+		}
+		watchDmChannelReqs := make([]*querypb.WatchDmChannelsRequest, 0, len(watchDmChannelRequests))
+		for _, req := range watchDmChannelRequests {
+			watchDmChannelReqs = append(watchDmChannelReqs, proto.Clone(req).(*querypb.WatchDmChannelsRequest))
+		}
+		err := assignInternalTask(ctx, collectionID, parentTask, meta, cluster, loadSegmentReqs, watchDmChannelReqs, replica)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// assignInternalTask assigns the internal tasks to the query nodes of the replica,
+// or to all the query nodes if replica is nil
 func assignInternalTask(ctx context.Context,
 	collectionID UniqueID,
 	parentTask task,
 	meta *meta,
 	cluster *queryNodeCluster,
 	loadSegmentRequests []*querypb.LoadSegmentsRequest,
-	watchDmChannelRequests []*querypb.WatchDmChannelsRequest) {
+	watchDmChannelRequests []*querypb.WatchDmChannelsRequest,
+	replica *querypb.ReplicaInfo) error {
 
 	sp, _ := trace.StartSpanFromContext(ctx)
 	defer sp.Finish()
@@ -1476,8 +2209,28 @@ func assignInternalTask(ctx context.Context,
 	for _, req := range watchDmChannelRequests {
 		channelsToWatch = append(channelsToWatch, req.Infos[0].ChannelName)
 	}
-	segment2Nodes := shuffleSegmentsToQueryNode(segmentsToLoad, cluster)
-	watchRequest2Nodes := shuffleChannelsToQueryNode(channelsToWatch, cluster)
+	var replicaID UniqueID
+	var nodeIDs []int64
+	if replica != nil {
+		replicaID = replica.ReplicaID
+		nodeIDs = replica.NodeIDs
+	}
+	segmentLoadInfos := make([]*querypb.SegmentLoadInfo, 0, len(loadSegmentRequests))
+	for _, req := range loadSegmentRequests {
+		segmentLoadInfos = append(segmentLoadInfos, req.Infos[0])
+	}
+	segment2Nodes, err := placeSegmentsOnQueryNode(ctx, segmentLoadInfos, cluster, nodeIDs)
+	if err != nil {
+		log.Debug("assignInternalTask: shuffle segments to query nodes by count", zap.Int64("collectionID", collectionID), zap.Error(err))
+		segment2Nodes, err = shuffleSegmentsToQueryNode(segmentsToLoad, cluster, nodeIDs)
+		if err != nil {
+			return err
+		}
+	}
+	watchRequest2Nodes, err := shuffleChannelsToQueryNode(channelsToWatch, cluster, nodeIDs)
+	if err != nil {
+		return err
+	}
 	log.Debug("assignInternalTask: segment to node", zap.Any("segments mao", segment2Nodes), zap.Int64("collectionID", collectionID))
 	log.Debug("assignInternalTask: watch request to node", zap.Any("request map", watchRequest2Nodes), zap.Int64("collectionID", collectionID))
 
@@ -1506,6 +2259,7 @@ func assignInternalTask(ctx context.Context,
 	for nodeID, loadSegmentsReq := range node2Segments {
 		ctx = opentracing.ContextWithSpan(context.Background(), sp)
 		loadSegmentsReq.NodeID = nodeID
+		loadSegmentsReq.ReplicaID = replicaID
 		loadSegmentTask := &LoadSegmentTask{
 			BaseTask: BaseTask{
 				ctx:              ctx,
@@ -1525,6 +2279,7 @@ func assignInternalTask(ctx context.Context,
 		ctx = opentracing.ContextWithSpan(context.Background(), sp)
 		watchDmChannelReq := watchDmChannelRequests[index]
 		watchDmChannelReq.NodeID = nodeID
+		watchDmChannelReq.ReplicaID = replicaID
 		watchDmChannelTask := &WatchDmChannelTask{
 			BaseTask: BaseTask{
 				ctx:              ctx,
@@ -1550,6 +2305,7 @@ func assignInternalTask(ctx context.Context,
 				CollectionID:     collectionID,
 				RequestChannelID: queryChannel,
 				ResultChannelID:  queryResultChannel,
+				ReplicaID:        replicaID,
 			}
 			watchQueryChannelTask := &WatchQueryChannelTask{
 				BaseTask: BaseTask{
@@ -1565,4 +2321,27 @@ func assignInternalTask(ctx context.Context,
 			log.Debug("assignInternalTask: add a watchQueryChannelTask childTask", zap.Any("task", watchQueryChannelTask))
 		}
 	}
+	return nil
+}
+
+// getReplicaNodeIDs returns the query nodes of the replica, or nil if the replica is not set
+func getReplicaNodeIDs(meta *meta, collectionID UniqueID, replicaID UniqueID) []int64 {
+	if replicaID == 0 {
+		return nil
+	}
+	replica, err := meta.getReplicaByID(collectionID, replicaID)
+	if err != nil {
+		log.Warn("replica not found", zap.Int64("collectionID", collectionID), zap.Int64("replicaID", replicaID))
+		return nil
+	}
+	return replica.NodeIDs
+}
+
+func containsNode(nodeIDs []int64, nodeID int64) bool {
+	for _, id := range nodeIDs {
+		if id == nodeID {
+			return true
+		}
+	}
+	return false
 }
@@ -126,15 +126,16 @@ type TaskScheduler struct {
 	taskIDAllocator  func() (UniqueID, error)
 	client           *etcdkv.EtcdKV
 
-	rootCoord types.RootCoord
-	dataCoord types.DataCoord
+	rootCoord  types.RootCoord
+	dataCoord  types.DataCoord
+	indexCoord types.IndexCoord
 
 	wg     sync.WaitGroup
 	ctx    context.Context
 	cancel context.CancelFunc
 }
 
-func NewTaskScheduler(ctx context.Context, meta *meta, cluster *queryNodeCluster, kv *etcdkv.EtcdKV, rootCoord types.RootCoord, dataCoord types.DataCoord) (*TaskScheduler, error) {
+func NewTaskScheduler(ctx context.Context, meta *meta, cluster *queryNodeCluster, kv *etcdkv.EtcdKV, rootCoord types.RootCoord, dataCoord types.DataCoord, indexCoord types.IndexCoord) (*TaskScheduler, error) {
 	ctx1, cancel := context.WithCancel(ctx)
 	taskChan := make(chan task, 1024)
 	s := &TaskScheduler{
@@ -146,6 +147,7 @@ func NewTaskScheduler(ctx context.Context, meta *meta, cluster *queryNodeCluster
 		client:           kv,
 		rootCoord:        rootCoord,
 		dataCoord:        dataCoord,
+		indexCoord:       indexCoord,
 	}
 	s.triggerTaskQueue = NewTaskQueue()
 	idAllocator := allocator.NewGlobalIDAllocator("idTimestamp", tsoutil.NewTSOKVBase(Params.EtcdEndpoints, Params.KvRootPath, "query coordinator task id"))
@@ -260,6 +262,7 @@ func (scheduler *TaskScheduler) unmarshalTask(t string) (task, error) {
 			LoadCollectionRequest: &loadReq,
 			rootCoord:             scheduler.rootCoord,
 			dataCoord:             scheduler.dataCoord,
+			indexCoord:            scheduler.indexCoord,
 			cluster:               scheduler.cluster,
 			meta:                  scheduler.meta,
 		}
@@ -277,7 +280,9 @@ func (scheduler *TaskScheduler) unmarshalTask(t string) (task, error) {
 				triggerCondition: querypb.TriggerCondition_grpcRequest,
 			},
 			LoadPartitionsRequest: &loadReq,
+			rootCoord:             scheduler.rootCoord,
 			dataCoord:             scheduler.dataCoord,
+			indexCoord:            scheduler.indexCoord,
 			cluster:               scheduler.cluster,
 			meta:                  scheduler.meta,
 		}
@@ -401,6 +406,24 @@ func (scheduler *TaskScheduler) unmarshalTask(t string) (task, error) {
 			meta:               scheduler.meta,
 		}
 		newTask = loadBalanceTask
+	case commonpb.MsgType_HandoffSegments:
+		handoffReq := querypb.HandoffSegments{}
+		err = proto.UnmarshalText(t, &handoffReq)
+		if err != nil {
+			log.Error(err.Error())
+		}
+		handoffTask := &HandoffTask{
+			BaseTask: BaseTask{
+				ctx:              scheduler.ctx,
+				Condition:        NewTaskCondition(scheduler.ctx),
+				triggerCondition: querypb.TriggerCondition_handoff,
+			},
+			HandoffSegments: &handoffReq,
+			dataCoord:       scheduler.dataCoord,
+			cluster:         scheduler.cluster,
+			meta:            scheduler.meta,
+		}
+		newTask = handoffTask
 	default:
 		err = errors.New("inValid msg type when unMarshal task")
 		log.Error(err.Error())
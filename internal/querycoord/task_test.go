@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestGetHandoffNodeIDs(t *testing.T) {
+	m := &meta{
+		segmentInfos: map[UniqueID]*querypb.SegmentInfo{
+			1: {SegmentID: 1, NodeID: 100},
+			4: {SegmentID: 4, NodeID: 100, NodeIDs: []int64{100, 400}},
+		},
+	}
+	collectionInfo := &querypb.CollectionInfo{
+		ChannelInfos: []*querypb.DmChannelInfo{
+			{NodeIDLoaded: 200, ChannelIDs: []string{"dml-0", "dml-1"}},
+			{NodeIDLoaded: 300, ChannelIDs: []string{"dml-2"}},
+			{NodeIDLoaded: 500, ChannelIDs: []string{"dml-0"}},
+		},
+	}
+
+	t.Run("growing segment", func(t *testing.T) {
+		nodeIDs, err := getHandoffNodeIDs(m, collectionInfo, &datapb.SegmentInfo{ID: 10, InsertChannel: "dml-2"})
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{300}, nodeIDs)
+
+		// the channel is watched by each replica
+		nodeIDs, err = getHandoffNodeIDs(m, collectionInfo, &datapb.SegmentInfo{ID: 10, InsertChannel: "dml-0"})
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{200, 500}, nodeIDs)
+	})
+
+	t.Run("compacted segment", func(t *testing.T) {
+		segmentInfo := &datapb.SegmentInfo{ID: 11, InsertChannel: "dml-1", CompactionFrom: []UniqueID{2, 1}}
+		nodeIDs, err := getHandoffNodeIDs(m, collectionInfo, segmentInfo)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{100}, nodeIDs)
+
+		segmentInfo.CompactionFrom = []UniqueID{4}
+		nodeIDs, err = getHandoffNodeIDs(m, collectionInfo, segmentInfo)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{100, 400}, nodeIDs)
+
+		// falls back to the channel if the compacted segments are not loaded
+		segmentInfo.CompactionFrom = []UniqueID{2, 3}
+		nodeIDs, err = getHandoffNodeIDs(m, collectionInfo, segmentInfo)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{200}, nodeIDs)
+	})
+
+	t.Run("channel not watched", func(t *testing.T) {
+		_, err := getHandoffNodeIDs(m, collectionInfo, &datapb.SegmentInfo{ID: 12, InsertChannel: "dml-3"})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestAssignReplicas(t *testing.T) {
+	replicas, err := assignReplicas(1, 100, 2, []int64{5, 3, 4, 1, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(replicas))
+	assert.EqualValues(t, 100, replicas[0].ReplicaID)
+	assert.EqualValues(t, 101, replicas[1].ReplicaID)
+	assert.EqualValues(t, 1, replicas[1].CollectionID)
+	assert.Equal(t, []int64{1, 3, 5}, replicas[0].NodeIDs)
+	assert.Equal(t, []int64{2, 4}, replicas[1].NodeIDs)
+
+	// one replica if not set
+	replicas, err = assignReplicas(1, 100, 0, []int64{1, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(replicas))
+	assert.Equal(t, []int64{1, 2}, replicas[0].NodeIDs)
+
+	_, err = assignReplicas(1, 100, 3, []int64{1, 2})
+	assert.NotNil(t, err)
+}
@@ -49,6 +49,9 @@ type Collection struct {
 	releaseMu          sync.RWMutex // guards release
 	releasedPartitions map[UniqueID]struct{}
 	releaseTime        Timestamp
+	// schemaOutdatedTime is the timestamp of the first field added after the collection is loaded,
+	// the loaded segments are not aware of the field, so the data since then is incomplete
+	schemaOutdatedTime Timestamp
 }
 
 func (c *Collection) ID() UniqueID {
@@ -112,6 +115,22 @@ func (c *Collection) getReleaseTime() Timestamp {
 	return c.releaseTime
 }
 
+// setSchemaOutdatedTime records the timestamp of a field added after the collection is loaded,
+// the earliest one is kept
+func (c *Collection) setSchemaOutdatedTime(t Timestamp) {
+	c.releaseMu.Lock()
+	defer c.releaseMu.Unlock()
+	if t < c.schemaOutdatedTime {
+		c.schemaOutdatedTime = t
+	}
+}
+
+func (c *Collection) getSchemaOutdatedTime() Timestamp {
+	c.releaseMu.RLock()
+	defer c.releaseMu.RUnlock()
+	return c.schemaOutdatedTime
+}
+
 func (c *Collection) addReleasedPartition(partitionID UniqueID) {
 	c.releaseMu.Lock()
 	defer c.releaseMu.Unlock()
@@ -164,7 +183,7 @@ func newCollection(collectionID UniqueID, schema *schemapb.CollectionSchema) *Co
 		CCollection
 		NewCollection(const char* schema_proto_blob);
 	*/
-	schemaBlob := proto.MarshalTextString(schema)
+	schemaBlob := proto.MarshalTextString(getSegcoreSchema(schema))
 
 	cSchemaBlob := C.CString(schemaBlob)
 	collection := C.NewCollection(cSchemaBlob)
@@ -182,6 +201,7 @@ func newCollection(collectionID UniqueID, schema *schemapb.CollectionSchema) *Co
 	log.Debug("create collection", zap.Int64("collectionID", collectionID))
 
 	newCollection.setReleaseTime(Timestamp(math.MaxUint64))
+	newCollection.schemaOutdatedTime = Timestamp(math.MaxUint64)
 	return newCollection
 }
 
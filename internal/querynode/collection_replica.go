@@ -72,6 +72,7 @@ type ReplicaInterface interface {
 	getSegmentByID(segmentID UniqueID) (*Segment, error)
 	hasSegment(segmentID UniqueID) bool
 	getSegmentNum() int
+	getSegmentsMemSize() int64
 	getSegmentStatistics() []*internalpb.SegmentStats
 
 	// excluded segments
@@ -453,6 +454,18 @@ func (colReplica *collectionReplica) getSegmentNum() int {
 	return len(colReplica.segments)
 }
 
+// getSegmentsMemSize returns the memory used by all the segments
+func (colReplica *collectionReplica) getSegmentsMemSize() int64 {
+	colReplica.mu.RLock()
+	defer colReplica.mu.RUnlock()
+
+	memSize := int64(0)
+	for _, segment := range colReplica.segments {
+		memSize += segment.getMemSize()
+	}
+	return memSize
+}
+
 func (colReplica *collectionReplica) getSegmentStatistics() []*internalpb.SegmentStats {
 	colReplica.mu.RLock()
 	defer colReplica.mu.RUnlock()
@@ -12,9 +12,14 @@
 package querynode
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 )
 
 func TestCollection_newCollection(t *testing.T) {
@@ -33,3 +38,39 @@ func TestCollection_deleteCollection(t *testing.T) {
 	assert.Equal(t, collection.ID(), collectionID)
 	deleteCollection(collection)
 }
+
+func TestCollection_schemaOutdatedTime(t *testing.T) {
+	node := newQueryNodeMock()
+	collectionID := UniqueID(0)
+	collectionMeta := genTestCollectionMeta(collectionID, false)
+	err := node.streaming.replica.addCollection(collectionID, collectionMeta.Schema)
+	assert.NoError(t, err)
+	collection, err := node.streaming.replica.getCollectionByID(collectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, Timestamp(math.MaxUint64), collection.getSchemaOutdatedTime())
+
+	fdmNode := newFilteredDmNode(node.streaming.replica, loadTypeCollection, collectionID, defaultPartitionID)
+	genAddFieldMsg := func(collectionID UniqueID, schemaVersion int64, ts Timestamp) *msgstream.AddFieldMsg {
+		return &msgstream.AddFieldMsg{
+			BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts},
+			AddFieldRequest: internalpb.AddFieldRequest{
+				Base:          &commonpb.MsgBase{MsgType: commonpb.MsgType_AddField, Timestamp: ts},
+				CollectionID:  collectionID,
+				SchemaVersion: schemaVersion,
+			},
+		}
+	}
+
+	// the fields added to other collections and the fields known by the loaded schema are ignored
+	fdmNode.handleAddFieldMessage(genAddFieldMsg(collectionID+1, collectionMeta.Schema.Version+1, 100))
+	fdmNode.handleAddFieldMessage(genAddFieldMsg(collectionID, collectionMeta.Schema.Version, 100))
+	assert.Equal(t, Timestamp(math.MaxUint64), collection.getSchemaOutdatedTime())
+
+	// the earliest field added after the collection is loaded is kept
+	fdmNode.handleAddFieldMessage(genAddFieldMsg(collectionID, collectionMeta.Schema.Version+1, 200))
+	fdmNode.handleAddFieldMessage(genAddFieldMsg(collectionID, collectionMeta.Schema.Version+2, 300))
+	assert.Equal(t, Timestamp(200), collection.getSchemaOutdatedTime())
+
+	err = node.Stop()
+	assert.NoError(t, err)
+}
@@ -11,20 +11,185 @@
 
 package querynode
 
+import (
+	"sync"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/flowgraph"
+	"github.com/milvus-io/milvus/internal/util/trace"
+)
+
+// deleteNode applies delete messages to both the growing segments in streaming
+// and the sealed segments in historical, so that deleted entities are invisible
+// to search and query. A delete only hides the entities inserted before its timestamp,
+// so the entities upserted along with it stay visible.
 type deleteNode struct {
 	baseNode
-	deleteMsg deleteMsg
+	streamingReplica  ReplicaInterface
+	historicalReplica ReplicaInterface
 }
 
 func (dNode *deleteNode) Name() string {
 	return "dNode"
 }
 
-func (dNode *deleteNode) Operate(in []*Msg) []*Msg {
-	return in
+func (dNode *deleteNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
+	//log.Debug("Do deleteNode operation")
+
+	if len(in) != 1 {
+		log.Error("Invalid operate message input in deleteNode", zap.Int("input length", len(in)))
+		// TODO: add error handling
+	}
+
+	dMsg, ok := in[0].(*deleteMsg)
+	if !ok {
+		log.Error("type assertion failed for deleteMsg")
+		// TODO: add error handling
+	}
+
+	if dMsg == nil {
+		return []Msg{}
+	}
+
+	deleteData := DeleteData{
+		deleteIDs:        make(map[UniqueID][]UniqueID),
+		deleteTimestamps: make(map[UniqueID][]Timestamp),
+		deleteOffset:     make(map[UniqueID]int64),
+	}
+	targetSegments := make(map[UniqueID]*Segment)
+
+	var spans []opentracing.Span
+	for _, msg := range dMsg.deleteMessages {
+		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
+		spans = append(spans, sp)
+		msg.SetTraceCtx(ctx)
+	}
+
+	// 1. hash deleteMessages to deleteData
+	for _, task := range dMsg.deleteMessages {
+		if len(task.PrimaryKeys)+len(task.StringPrimaryKeys) != len(task.Timestamps) {
+			log.Error("misaligned delete message detected",
+				zap.Int64("collectionID", task.CollectionID),
+				zap.Int("num of primary keys", len(task.PrimaryKeys)+len(task.StringPrimaryKeys)),
+				zap.Int("num of timestamps", len(task.Timestamps)))
+			continue
+		}
+		for _, replica := range []ReplicaInterface{dNode.streamingReplica, dNode.historicalReplica} {
+			segments, err := dNode.getTargetSegments(replica, task.CollectionID, task.PartitionID)
+			if err != nil {
+				log.Warn(err.Error())
+				continue
+			}
+			for _, segment := range segments {
+				segmentID := segment.segmentID
+				if len(task.StringPrimaryKeys) > 0 {
+					// string primary keys are deleted by the row IDs in the segment, see string_pk.go
+					if segment.strPKIndex == nil {
+						continue
+					}
+					for i, pk := range task.StringPrimaryKeys {
+						for _, rowID := range segment.strPKIndex.getRowIDs(pk) {
+							deleteData.deleteIDs[segmentID] = append(deleteData.deleteIDs[segmentID], rowID)
+							deleteData.deleteTimestamps[segmentID] = append(deleteData.deleteTimestamps[segmentID], task.Timestamps[i])
+						}
+					}
+					if len(deleteData.deleteIDs[segmentID]) > 0 {
+						targetSegments[segmentID] = segment
+					}
+					continue
+				}
+				targetSegments[segmentID] = segment
+				deleteData.deleteIDs[segmentID] = append(deleteData.deleteIDs[segmentID], task.PrimaryKeys...)
+				deleteData.deleteTimestamps[segmentID] = append(deleteData.deleteTimestamps[segmentID], task.Timestamps...)
+			}
+		}
+	}
+
+	// 2. do preDelete
+	for segmentID, pks := range deleteData.deleteIDs {
+		offset := targetSegments[segmentID].segmentPreDelete(len(pks))
+		deleteData.deleteOffset[segmentID] = offset
+		log.Debug("deleteNode operator", zap.Int("delete size", len(pks)), zap.Int64("delete offset", offset), zap.Int64("segment id", segmentID))
+	}
+
+	// 3. do delete
+	wg := sync.WaitGroup{}
+	for segmentID := range deleteData.deleteIDs {
+		wg.Add(1)
+		go dNode.delete(&deleteData, targetSegments[segmentID], &wg)
+	}
+	wg.Wait()
+
+	var res Msg = &serviceTimeMsg{
+		gcRecord:  dMsg.gcRecord,
+		timeRange: dMsg.timeRange,
+	}
+	for _, sp := range spans {
+		sp.Finish()
+	}
+
+	return []Msg{res}
+}
+
+// getTargetSegments returns the segments of replica which may contain the deleted entities,
+// partitionID 0 means that the delete applies to all partitions of the collection.
+func (dNode *deleteNode) getTargetSegments(replica ReplicaInterface, collectionID UniqueID, partitionID UniqueID) ([]*Segment, error) {
+	if !replica.hasCollection(collectionID) {
+		return nil, nil
+	}
+	var partitionIDs []UniqueID
+	if partitionID != 0 {
+		if !replica.hasPartition(partitionID) {
+			return nil, nil
+		}
+		partitionIDs = []UniqueID{partitionID}
+	} else {
+		var err error
+		partitionIDs, err = replica.getPartitionIDs(collectionID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	segments := make([]*Segment, 0)
+	for _, id := range partitionIDs {
+		segmentIDs, err := replica.getSegmentIDs(id)
+		if err != nil {
+			return nil, err
+		}
+		for _, segmentID := range segmentIDs {
+			segment, err := replica.getSegmentByID(segmentID)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, segment)
+		}
+	}
+	return segments, nil
+}
+
+func (dNode *deleteNode) delete(deleteData *DeleteData, targetSegment *Segment, wg *sync.WaitGroup) {
+	defer wg.Done()
+	segmentID := targetSegment.segmentID
+	log.Debug("QueryNode::dNode::delete", zap.Any("SegmentID", segmentID))
+
+	ids := deleteData.deleteIDs[segmentID]
+	timestamps := deleteData.deleteTimestamps[segmentID]
+	offset := deleteData.deleteOffset[segmentID]
+
+	err := targetSegment.segmentDelete(offset, &ids, &timestamps)
+	if err != nil {
+		log.Warn("QueryNode: targetSegmentDelete failed", zap.Int64("segmentID", segmentID), zap.Error(err))
+		return
+	}
+
+	log.Debug("Do delete done", zap.Int("len", len(ids)), zap.Int64("segmentID", segmentID))
 }
 
-func newDeleteNode() *deleteNode {
+func newDeleteNode(streamingReplica ReplicaInterface, historicalReplica ReplicaInterface) *deleteNode {
 	maxQueueLength := Params.FlowGraphMaxQueueLength
 	maxParallelism := Params.FlowGraphMaxParallelism
 
@@ -33,6 +198,8 @@ func newDeleteNode() *deleteNode {
 	baseNode.SetMaxParallelism(maxParallelism)
 
 	return &deleteNode{
-		baseNode: baseNode,
+		baseNode:          baseNode,
+		streamingReplica:  streamingReplica,
+		historicalReplica: historicalReplica,
 	}
 }
@@ -63,6 +63,7 @@ func (fdmNode *filterDmNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 	var iMsg = insertMsg{
 		insertMessages: make([]*msgstream.InsertMsg, 0),
+		deleteMessages: make([]*msgstream.DeleteMsg, 0),
 		timeRange: TimeRange{
 			timestampMin: msgStreamMsg.TimestampMin(),
 			timestampMax: msgStreamMsg.TimestampMax(),
@@ -75,6 +76,13 @@ func (fdmNode *filterDmNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			if resMsg != nil {
 				iMsg.insertMessages = append(iMsg.insertMessages, resMsg)
 			}
+		case commonpb.MsgType_Delete:
+			resMsg := fdmNode.filterInvalidDeleteMessage(msg.(*msgstream.DeleteMsg))
+			if resMsg != nil {
+				iMsg.deleteMessages = append(iMsg.deleteMessages, resMsg)
+			}
+		case commonpb.MsgType_AddField:
+			fdmNode.handleAddFieldMessage(msg.(*msgstream.AddFieldMsg))
 		default:
 			log.Warn("Non supporting", zap.Int32("message type", int32(msg.Type())))
 		}
@@ -87,6 +95,29 @@ func (fdmNode *filterDmNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	return []Msg{res}
 }
 
+// handleAddFieldMessage marks the collection outdated since the field is added. The segments of a loaded
+// collection can't add fields, the inserts carrying the new field fail and the requests guaranteed to see
+// them are rejected until the collection is released and loaded again.
+func (fdmNode *filterDmNode) handleAddFieldMessage(msg *msgstream.AddFieldMsg) {
+	if msg.CollectionID != fdmNode.collectionID {
+		return
+	}
+	col, err := fdmNode.replica.getCollectionByID(msg.CollectionID)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	if msg.SchemaVersion <= col.Schema().GetVersion() {
+		return
+	}
+	log.Error("field is added to the loaded collection, release and load the collection again to apply it",
+		zap.Int64("collectionID", msg.CollectionID),
+		zap.Int64("loaded schema version", col.Schema().GetVersion()),
+		zap.Int64("schema version", msg.SchemaVersion),
+		zap.Uint64("timestamp", msg.BeginTs()))
+	col.setSchemaOutdatedTime(msg.BeginTs())
+}
+
 func (fdmNode *filterDmNode) filterInvalidInsertMessage(msg *msgstream.InsertMsg) *msgstream.InsertMsg {
 	sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 	msg.SetTraceCtx(ctx)
@@ -170,6 +201,43 @@ func (fdmNode *filterDmNode) filterInvalidInsertMessage(msg *msgstream.InsertMsg
 	return msg
 }
 
+func (fdmNode *filterDmNode) filterInvalidDeleteMessage(msg *msgstream.DeleteMsg) *msgstream.DeleteMsg {
+	sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
+	msg.SetTraceCtx(ctx)
+	defer sp.Finish()
+
+	// check if the collection from message is target collection
+	if msg.CollectionID != fdmNode.collectionID {
+		log.Debug("filter invalid delete message, collection is not the target collection",
+			zap.Any("collectionID", msg.CollectionID),
+			zap.Any("partitionID", msg.PartitionID))
+		return nil
+	}
+
+	// if the flow graph type is partition, check if the partition is target partition,
+	// partitionID 0 means that the delete applies to the whole collection
+	if fdmNode.loadType == loadTypePartition && msg.PartitionID != 0 && msg.PartitionID != fdmNode.partitionID {
+		log.Debug("filter invalid delete message, partition is not the target partition",
+			zap.Any("collectionID", msg.CollectionID),
+			zap.Any("partitionID", msg.PartitionID))
+		return nil
+	}
+
+	if len(msg.PrimaryKeys)+len(msg.StringPrimaryKeys) != len(msg.Timestamps) {
+		log.Error("Error, misaligned delete messages detected")
+		return nil
+	}
+
+	if len(msg.Timestamps) <= 0 {
+		log.Debug("filter invalid delete message, no message",
+			zap.Any("collectionID", msg.CollectionID),
+			zap.Any("partitionID", msg.PartitionID))
+		return nil
+	}
+
+	return msg
+}
+
 func newFilteredDmNode(replica ReplicaInterface,
 	loadType loadType,
 	collectionID UniqueID,
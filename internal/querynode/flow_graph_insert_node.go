@@ -93,9 +93,30 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			}
 		}
 
+		records := task.RowData
+		segment, err := iNode.replica.getSegmentByID(task.SegmentID)
+		if err != nil {
+			log.Error(err.Error())
+			continue
+		}
+		if segment.strPKIndex != nil {
+			collection, err := iNode.replica.getCollectionByID(task.CollectionID)
+			if err != nil {
+				log.Error(err.Error())
+				continue
+			}
+			var pks []string
+			pks, records, err = rewriteStringPKRows(collection.schema, task.RowIDs, task.RowData)
+			if err != nil {
+				log.Error(err.Error())
+				continue
+			}
+			segment.strPKIndex.add(pks, task.RowIDs)
+		}
+
 		insertData.insertIDs[task.SegmentID] = append(insertData.insertIDs[task.SegmentID], task.RowIDs...)
 		insertData.insertTimestamps[task.SegmentID] = append(insertData.insertTimestamps[task.SegmentID], task.Timestamps...)
-		insertData.insertRecords[task.SegmentID] = append(insertData.insertRecords[task.SegmentID], task.RowData...)
+		insertData.insertRecords[task.SegmentID] = append(insertData.insertRecords[task.SegmentID], records...)
 	}
 
 	// 2. do preInsert
@@ -125,9 +146,10 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	}
 	wg.Wait()
 
-	var res Msg = &serviceTimeMsg{
-		gcRecord:  iMsg.gcRecord,
-		timeRange: iMsg.timeRange,
+	var res Msg = &deleteMsg{
+		deleteMessages: iMsg.deleteMessages,
+		gcRecord:       iMsg.gcRecord,
+		timeRange:      iMsg.timeRange,
 	}
 	for _, sp := range spans {
 		sp.Finish()
@@ -139,10 +161,6 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 func (iNode *insertNode) insert(insertData *InsertData, segmentID int64, wg *sync.WaitGroup) {
 	log.Debug("QueryNode::iNode::insert", zap.Any("SegmentID", segmentID))
 	var targetSegment, err = iNode.replica.getSegmentByID(segmentID)
-	if targetSegment.segmentType != segmentTypeGrowing {
-		wg.Done()
-		return
-	}
 	if err != nil {
 		log.Error("cannot find segment:", zap.Int64("segmentID", segmentID))
 		// TODO: add error handling
@@ -162,7 +180,11 @@ func (iNode *insertNode) insert(insertData *InsertData, segmentID int64, wg *syn
 
 	err = targetSegment.segmentInsert(offsets, &ids, &timestamps, &records)
 	if err != nil {
-		log.Debug("QueryNode: targetSegmentInsert failed", zap.Error(err))
+		log.Error("QueryNode: targetSegmentInsert failed, the inserted rows are missed",
+			zap.Int64("collectionID", targetSegment.collectionID),
+			zap.Int64("segmentID", segmentID),
+			zap.Int("len", len(ids)),
+			zap.Error(err))
 		// TODO: add error handling
 		wg.Done()
 		return
@@ -38,12 +38,14 @@ type metaOperateRecord struct {
 
 type insertMsg struct {
 	insertMessages []*msgstream.InsertMsg
+	deleteMessages []*msgstream.DeleteMsg
 	gcRecord       *gcRecord
 	timeRange      TimeRange
 }
 
 type deleteMsg struct {
 	deleteMessages []*msgstream.DeleteMsg
+	gcRecord       *gcRecord
 	timeRange      TimeRange
 }
 
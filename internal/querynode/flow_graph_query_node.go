@@ -38,6 +38,7 @@ func newQueryNodeFlowGraph(ctx context.Context,
 	collectionID UniqueID,
 	partitionID UniqueID,
 	streamingReplica ReplicaInterface,
+	historicalReplica ReplicaInterface,
 	tSafeReplica TSafeReplicaInterface,
 	channel Channel,
 	factory msgstream.Factory) *queryNodeFlowGraph {
@@ -56,11 +57,13 @@ func newQueryNodeFlowGraph(ctx context.Context,
 	var dmStreamNode node = q.newDmInputNode(ctx1, factory)
 	var filterDmNode node = newFilteredDmNode(streamingReplica, loadType, collectionID, partitionID)
 	var insertNode node = newInsertNode(streamingReplica)
+	var deleteNode node = newDeleteNode(streamingReplica, historicalReplica)
 	var serviceTimeNode node = newServiceTimeNode(ctx1, tSafeReplica, loadType, collectionID, partitionID, channel, factory)
 
 	q.flowGraph.AddNode(dmStreamNode)
 	q.flowGraph.AddNode(filterDmNode)
 	q.flowGraph.AddNode(insertNode)
+	q.flowGraph.AddNode(deleteNode)
 	q.flowGraph.AddNode(serviceTimeNode)
 
 	// dmStreamNode
@@ -84,15 +87,24 @@ func newQueryNodeFlowGraph(ctx context.Context,
 	// insertNode
 	err = q.flowGraph.SetEdges(insertNode.Name(),
 		[]string{filterDmNode.Name()},
-		[]string{serviceTimeNode.Name()},
+		[]string{deleteNode.Name()},
 	)
 	if err != nil {
 		log.Error("set edges failed in node:", zap.String("node name", insertNode.Name()))
 	}
 
+	// deleteNode
+	err = q.flowGraph.SetEdges(deleteNode.Name(),
+		[]string{insertNode.Name()},
+		[]string{serviceTimeNode.Name()},
+	)
+	if err != nil {
+		log.Error("set edges failed in node:", zap.String("node name", deleteNode.Name()))
+	}
+
 	// serviceTimeNode
 	err = q.flowGraph.SetEdges(serviceTimeNode.Name(),
-		[]string{insertNode.Name()},
+		[]string{deleteNode.Name()},
 		[]string{},
 	)
 	if err != nil {
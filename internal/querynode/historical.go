@@ -15,12 +15,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	queryPb "github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 )
 
@@ -29,6 +32,10 @@ type historical struct {
 	loader       *segmentLoader
 	statsService *statsService
 
+	// handoffMu is held by queries in read mode and by handoff in write mode,
+	// so that queries see the segments before or after a handoff
+	handoffMu sync.RWMutex
+
 	//TODO
 	globalSealedSegments []UniqueID
 }
@@ -65,7 +72,7 @@ func (h *historical) search(searchReqs []*searchRequest,
 	collID UniqueID,
 	partIDs []UniqueID,
 	plan *Plan,
-	searchTs Timestamp) ([]*SearchResult, []*Segment, error) {
+	searchTs []Timestamp) ([]*SearchResult, []*Segment, error) {
 
 	searchResults := make([]*SearchResult, 0)
 	segmentResults := make([]*Segment, 0)
@@ -138,7 +145,7 @@ func (h *historical) search(searchReqs []*searchRequest,
 			if !seg.getOnService() {
 				continue
 			}
-			searchResult, err := seg.segmentSearch(plan, searchReqs, []Timestamp{searchTs})
+			searchResult, err := seg.segmentSearch(plan, searchReqs, searchTs)
 			if err != nil {
 				return searchResults, segmentResults, err
 			}
@@ -149,3 +156,51 @@ func (h *historical) search(searchReqs []*searchRequest,
 
 	return searchResults, segmentResults, nil
 }
+
+// handoffSegments puts the sealed segments loaded for handoff on service. At the same time, the growing
+// segments in streaming with the same ids, and the segments they are compacted from, are released.
+func (h *historical) handoffSegments(streamingReplica ReplicaInterface, infos []*queryPb.SegmentLoadInfo) error {
+	h.handoffMu.Lock()
+	defer h.handoffMu.Unlock()
+
+	for _, info := range infos {
+		segment, err := h.replica.getSegmentByID(info.SegmentID)
+		if err != nil {
+			log.Warn("handoff segment not loaded", zap.Int64("segmentID", info.SegmentID), zap.Error(err))
+			continue
+		}
+		segment.setOnService(true)
+
+		releasedSegments := append([]UniqueID{info.SegmentID}, info.CompactionFrom...)
+		excludedSegments := make([]*datapb.SegmentInfo, 0)
+		for _, segmentID := range releasedSegments {
+			if segmentID != info.SegmentID && h.replica.hasSegment(segmentID) {
+				if err := h.replica.removeSegment(segmentID); err != nil {
+					return err
+				}
+			}
+			if streamingReplica.hasSegment(segmentID) {
+				if err := streamingReplica.removeSegment(segmentID); err != nil {
+					return err
+				}
+			}
+			if info.DmlPosition != nil {
+				// the inserts of the released growing segments not consumed yet are dropped
+				excludedSegments = append(excludedSegments, &datapb.SegmentInfo{
+					ID:           segmentID,
+					CollectionID: info.CollectionID,
+					PartitionID:  info.PartitionID,
+					DmlPosition:  info.DmlPosition,
+				})
+			}
+		}
+		if len(excludedSegments) > 0 {
+			if err := streamingReplica.addExcludedSegments(info.CollectionID, excludedSegments); err != nil {
+				log.Warn("exclude handoff segments from streaming failed", zap.Int64("segmentID", info.SegmentID), zap.Error(err))
+			}
+		}
+		log.Debug("handoff segment done", zap.Int64("segmentID", info.SegmentID),
+			zap.Int64s("compactionFrom", info.CompactionFrom))
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestHistorical_handoffSegments(t *testing.T) {
+	node := newQueryNodeMock()
+	collectionID := UniqueID(0)
+	initTestMeta(t, node, collectionID, 0)
+
+	collectionMeta := genTestCollectionMeta(collectionID, false)
+	streamingReplica := node.streaming.replica
+	err := streamingReplica.addCollection(collectionID, collectionMeta.Schema)
+	assert.NoError(t, err)
+	err = streamingReplica.addPartition(collectionID, defaultPartitionID)
+	assert.NoError(t, err)
+	streamingReplica.initExcludedSegments(collectionID)
+
+	// segment 1 is growing, segment 0 is sealed and compacted into segment 3 together with segment 1
+	err = node.historical.replica.addSegment(1, defaultPartitionID, collectionID, "", segmentTypeSealed, false)
+	assert.NoError(t, err)
+	err = streamingReplica.addSegment(1, defaultPartitionID, collectionID, "", segmentTypeGrowing, true)
+	assert.NoError(t, err)
+	err = node.historical.replica.addSegment(3, defaultPartitionID, collectionID, "", segmentTypeSealed, false)
+	assert.NoError(t, err)
+
+	infos := []*querypb.SegmentLoadInfo{
+		{
+			SegmentID:    1,
+			PartitionID:  defaultPartitionID,
+			CollectionID: collectionID,
+			DmlPosition:  &internalpb.MsgPosition{Timestamp: 100},
+		},
+		{
+			SegmentID:      3,
+			PartitionID:    defaultPartitionID,
+			CollectionID:   collectionID,
+			CompactionFrom: []UniqueID{0, 1},
+			DmlPosition:    &internalpb.MsgPosition{Timestamp: 200},
+		},
+		// not loaded, ignored
+		{
+			SegmentID:    4,
+			PartitionID:  defaultPartitionID,
+			CollectionID: collectionID,
+		},
+	}
+	err = node.historical.handoffSegments(streamingReplica, infos)
+	assert.NoError(t, err)
+
+	assert.False(t, node.historical.replica.hasSegment(0))
+	assert.False(t, node.historical.replica.hasSegment(1))
+	assert.False(t, streamingReplica.hasSegment(1))
+	segment, err := node.historical.replica.getSegmentByID(3)
+	assert.NoError(t, err)
+	assert.True(t, segment.getOnService())
+
+	excludedSegments, err := streamingReplica.getExcludedSegments(collectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(excludedSegments))
+	for _, info := range excludedSegments[1:] {
+		assert.EqualValues(t, 200, info.DmlPosition.Timestamp)
+	}
+
+	err = node.Stop()
+	assert.NoError(t, err)
+}
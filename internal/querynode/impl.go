@@ -49,6 +49,9 @@ func (node *QueryNode) GetComponentStates(ctx context.Context) (*internalpb.Comp
 		Role:      typeutil.QueryNodeRole,
 		StateCode: code,
 	}
+	if code == internalpb.StateCode_Healthy {
+		info.ExtraInfo = node.getMemoryInfo()
+	}
 	stats.State = info
 	return stats, nil
 }
@@ -118,8 +121,9 @@ func (node *QueryNode) AddQueryChannel(ctx context.Context, in *queryPb.AddQuery
 		node.queryService.addQueryCollection(collectionID)
 		log.Debug("add query collection", zap.Any("collectionID", collectionID))
 	}
+	sc.setReplicaID(in.ReplicaID)
 	sc.start()
-	log.Debug("start query collection", zap.Any("collectionID", collectionID))
+	log.Debug("start query collection", zap.Any("collectionID", collectionID), zap.Int64("replicaID", in.ReplicaID))
 
 	status := &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
@@ -238,14 +242,19 @@ func (node *QueryNode) LoadSegments(ctx context.Context, in *queryPb.LoadSegment
 	}
 	log.Debug("loadSegmentsTask Enqueue done", zap.Int64s("segmentIDs", segmentIDs))
 
-	func() {
-		err = dct.WaitToFinish()
-		if err != nil {
-			log.Error(err.Error())
-			return
+	err = dct.WaitToFinish()
+	if err != nil {
+		log.Error(err.Error())
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
 		}
-		log.Debug("loadSegmentsTask WaitToFinish done", zap.Int64s("segmentIDs", segmentIDs))
-	}()
+		if _, ok := err.(*insufficientMemoryError); ok {
+			status.ErrorCode = commonpb.ErrorCode_InsufficientMemoryToLoad
+		}
+		return status, nil
+	}
+	log.Debug("loadSegmentsTask WaitToFinish done", zap.Int64s("segmentIDs", segmentIDs))
 
 	status := &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
@@ -352,15 +361,21 @@ func (node *QueryNode) GetSegmentInfo(ctx context.Context, in *queryPb.GetSegmen
 	getSegmentInfo := func(segment *Segment) *queryPb.SegmentInfo {
 		var indexName string
 		var indexID int64
-		// TODO:: segment has multi vec column
-		if len(segment.indexInfos) > 0 {
-			for fieldID := range segment.indexInfos {
-				indexName = segment.getIndexName(fieldID)
-				indexID = segment.getIndexID(fieldID)
-				break
-			}
+		// index_name and indexID are of the vector field with the smallest id, for compatibility
+		indexInfos := make([]*queryPb.FieldIndexInfo, 0)
+		for _, fieldID := range segment.getIndexedFieldIDs() {
+			indexInfos = append(indexInfos, &queryPb.FieldIndexInfo{
+				FieldID:   fieldID,
+				IndexName: segment.getIndexName(fieldID),
+				IndexID:   segment.getIndexID(fieldID),
+			})
+		}
+		if len(indexInfos) > 0 {
+			indexName = indexInfos[0].IndexName
+			indexID = indexInfos[0].IndexID
 		}
 		info := &queryPb.SegmentInfo{
+			NodeID:       Params.QueryNodeID,
 			SegmentID:    segment.ID(),
 			CollectionID: segment.collectionID,
 			PartitionID:  segment.partitionID,
@@ -368,6 +383,7 @@ func (node *QueryNode) GetSegmentInfo(ctx context.Context, in *queryPb.GetSegmen
 			NumRows:      segment.getRowCount(),
 			IndexName:    indexName,
 			IndexID:      indexID,
+			IndexInfos:   indexInfos,
 		}
 		return info
 	}
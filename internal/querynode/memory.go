@@ -0,0 +1,101 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const memInfoPath = "/proc/meminfo"
+
+// getTotalMemory returns the memory of the query node for segments, which is Params.MemoryLimit if set,
+// or the total memory of the host. 0 is returned if neither is known.
+func getTotalMemory() int64 {
+	if Params.MemoryLimit > 0 {
+		return Params.MemoryLimit
+	}
+	content, err := ioutil.ReadFile(memInfoPath)
+	if err != nil {
+		return 0
+	}
+	total, err := parseMemTotal(content)
+	if err != nil {
+		return 0
+	}
+	return total
+}
+
+// parseMemTotal parses the total memory in bytes from the content of /proc/meminfo
+func parseMemTotal(content []byte) (int64, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "MemTotal:" {
+			continue
+		}
+		total, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		if len(fields) > 2 && fields[2] == "kB" {
+			total *= 1024
+		}
+		return total, nil
+	}
+	return 0, fmt.Errorf("MemTotal not found in %s", memInfoPath)
+}
+
+// insufficientMemoryError is returned when the query node has no enough memory to load segments
+type insufficientMemoryError struct {
+	segmentSize int64
+	usedMemory  int64
+	totalMemory int64
+}
+
+func (e *insufficientMemoryError) Error() string {
+	return fmt.Sprintf("insufficient memory to load segments of %d bytes, used memory = %d, total memory = %d",
+		e.segmentSize, e.usedMemory, e.totalMemory)
+}
+
+// checkLoadMemory checks whether the segments of the estimated size fit in the free memory of the query node,
+// the check passes if the total memory is unknown
+func checkLoadMemory(segmentSize, usedMemory, totalMemory int64) error {
+	if totalMemory <= 0 || usedMemory+segmentSize <= totalMemory {
+		return nil
+	}
+	return &insufficientMemoryError{
+		segmentSize: segmentSize,
+		usedMemory:  usedMemory,
+		totalMemory: totalMemory,
+	}
+}
+
+// getUsedMemory returns the memory used by the segments of historical and streaming
+func (node *QueryNode) getUsedMemory() int64 {
+	return node.historical.replica.getSegmentsMemSize() + node.streaming.replica.getSegmentsMemSize()
+}
+
+// getMemoryInfo returns the memory usage reported in the component states
+func (node *QueryNode) getMemoryInfo() []*commonpb.KeyValuePair {
+	return []*commonpb.KeyValuePair{
+		{Key: typeutil.MemoryUsedKey, Value: strconv.FormatInt(node.getUsedMemory(), 10)},
+		{Key: typeutil.MemoryTotalKey, Value: strconv.FormatInt(getTotalMemory(), 10)},
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemory_parseMemTotal(t *testing.T) {
+	total, err := parseMemTotal([]byte("MemTotal:       16384 kB\nMemFree:         1024 kB\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16384*1024), total)
+
+	_, err = parseMemTotal([]byte("MemFree:         1024 kB\n"))
+	assert.Error(t, err)
+	_, err = parseMemTotal([]byte("MemTotal:       abc kB\n"))
+	assert.Error(t, err)
+}
+
+func TestMemory_checkLoadMemory(t *testing.T) {
+	assert.NoError(t, checkLoadMemory(30, 70, 100))
+	assert.NoError(t, checkLoadMemory(30, 70, 0))
+
+	err := checkLoadMemory(31, 70, 100)
+	assert.Error(t, err)
+	_, ok := err.(*insufficientMemoryError)
+	assert.True(t, ok)
+}
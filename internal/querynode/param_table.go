@@ -53,6 +53,13 @@ type ParamTable struct {
 	SearchPulsarBufSize        int64
 	SearchResultReceiveBufSize int64
 
+	// the number of parsed search plans cached, 0 disables the cache
+	SearchPlanCacheSize int
+	// the max number of queries of the unsolved search requests batched together, 0 disables batching
+	SearchBatchMaxNQ int64
+	// the max distance in milliseconds between the travel timestamps of the search requests batched together
+	SearchBatchTimeWindow int64
+
 	// Retrieve
 	RetrieveChannelNames         []string
 	RetrieveResultChannelNames   []string
@@ -64,6 +71,9 @@ type ParamTable struct {
 	StatsPublishInterval int
 	StatsChannelName     string
 
+	// memory for segments in bytes, 0 means the total memory of the host
+	MemoryLimit int64
+
 	GracefulTime      int64
 	MsgChannelSubName string
 	SliceIndex        int
@@ -108,9 +118,15 @@ func (p *ParamTable) Init() {
 		p.initSearchPulsarBufSize()
 		p.initSearchResultReceiveBufSize()
 
+		p.initSearchPlanCacheSize()
+		p.initSearchBatchMaxNQ()
+		p.initSearchBatchTimeWindow()
+
 		p.initStatsPublishInterval()
 		p.initStatsChannelName()
 
+		p.initMemoryLimit()
+
 		p.initLogCfg()
 	})
 }
@@ -191,6 +207,10 @@ func (p *ParamTable) initStatsPublishInterval() {
 	p.StatsPublishInterval = p.ParseInt("queryNode.stats.publishInterval")
 }
 
+func (p *ParamTable) initMemoryLimit() {
+	p.MemoryLimit = p.ParseInt64("queryNode.memoryLimit") * 1024 * 1024
+}
+
 // dataSync:
 func (p *ParamTable) initFlowGraphMaxQueueLength() {
 	p.FlowGraphMaxQueueLength = p.ParseInt32("queryNode.dataSync.flowGraph.maxQueueLength")
@@ -213,6 +233,19 @@ func (p *ParamTable) initSearchResultReceiveBufSize() {
 	p.SearchResultReceiveBufSize = p.ParseInt64("queryNode.msgStream.searchResult.recvBufSize")
 }
 
+// search
+func (p *ParamTable) initSearchPlanCacheSize() {
+	p.SearchPlanCacheSize = p.ParseInt("queryNode.search.planCacheSize")
+}
+
+func (p *ParamTable) initSearchBatchMaxNQ() {
+	p.SearchBatchMaxNQ = p.ParseInt64("queryNode.search.batch.maxNQ")
+}
+
+func (p *ParamTable) initSearchBatchTimeWindow() {
+	p.SearchBatchTimeWindow = p.ParseInt64("queryNode.search.batch.timeWindow")
+}
+
 func (p *ParamTable) initEtcdEndpoints() {
 	endpoints, err := p.Load("_EtcdEndpoints")
 	if err != nil {
@@ -61,6 +61,16 @@ func TestParamTable_statsServiceTimeInterval(t *testing.T) {
 	assert.Equal(t, 1000, interval)
 }
 
+func TestParamTable_memoryLimit(t *testing.T) {
+	assert.Equal(t, int64(0), Params.MemoryLimit)
+}
+
+func TestParamTable_search(t *testing.T) {
+	assert.Equal(t, 1024, Params.SearchPlanCacheSize)
+	assert.Equal(t, int64(1024), Params.SearchBatchMaxNQ)
+	assert.Equal(t, int64(10), Params.SearchBatchTimeWindow)
+}
+
 func TestParamTable_searchMsgStreamReceiveBufSize(t *testing.T) {
 	bufSize := Params.SearchReceiveBufSize
 	assert.Equal(t, int64(512), bufSize)
@@ -0,0 +1,159 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
+
+// planCacheKey identifies a parsed search plan
+type planCacheKey struct {
+	collectionID  UniqueID
+	schemaVersion int64
+	dslType       commonpb.DslType
+	plan          string // the serialized expr plan or the dsl
+}
+
+type planCacheEntry struct {
+	key planCacheKey
+	// the plan refers to the schema of the collection it's created with, a collection reloaded with
+	// the same id doesn't share the plans of the released one
+	collection *Collection
+	plan       *Plan
+	refs       int  // the number of searches using the plan
+	evicted    bool // the plan is deleted once it's evicted and not used by any search
+}
+
+// planCache is a bounded LRU cache of the parsed search plans, shared by the collections of the query node
+type planCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[planCacheKey]*list.Element
+	lru      *list.List // the front is the most recently used
+}
+
+func newPlanCache(capacity int) *planCache {
+	return &planCache{
+		capacity: capacity,
+		entries:  make(map[planCacheKey]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+// getOrCreate returns the cached plan of the key, or creates the plan by create and caches it.
+// The returned release func must be called once the plan is no longer used.
+func (c *planCache) getOrCreate(collection *Collection, key planCacheKey, create func() (*Plan, error)) (*Plan, func(), error) {
+	if entry := c.get(collection, key); entry != nil {
+		return entry.plan, func() { c.release(entry) }, nil
+	}
+
+	plan, err := create()
+	if err != nil {
+		return nil, nil, err
+	}
+	entry := c.put(collection, key, plan)
+	if entry == nil {
+		return plan, plan.delete, nil
+	}
+	return entry.plan, func() { c.release(entry) }, nil
+}
+
+func (c *planCache) get(collection *Collection, key planCacheKey) *planCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	entry := elem.Value.(*planCacheEntry)
+	if entry.collection != collection {
+		c.evict(elem)
+		return nil
+	}
+	c.lru.MoveToFront(elem)
+	entry.refs++
+	return entry
+}
+
+// put caches the plan and returns its entry, or the entry of the same plan cached by a concurrent search,
+// in which case the plan is deleted. Nil is returned if the cache is disabled.
+func (c *planCache) put(collection *Collection, key planCacheKey, plan *Plan) *planCacheEntry {
+	if c.capacity <= 0 {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*planCacheEntry)
+		if entry.collection == collection {
+			plan.delete()
+			c.lru.MoveToFront(elem)
+			entry.refs++
+			return entry
+		}
+		c.evict(elem)
+	}
+
+	entry := &planCacheEntry{
+		key:        key,
+		collection: collection,
+		plan:       plan,
+		refs:       1,
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.capacity {
+		c.evict(c.lru.Back())
+	}
+	return entry
+}
+
+func (c *planCache) release(entry *planCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry.refs--
+	if entry.evicted && entry.refs == 0 {
+		entry.plan.delete()
+	}
+}
+
+// evict removes the entry from the cache, whose plan is deleted if no search uses it, the caller must hold mu
+func (c *planCache) evict(elem *list.Element) {
+	entry := elem.Value.(*planCacheEntry)
+	c.lru.Remove(elem)
+	delete(c.entries, entry.key)
+	entry.evicted = true
+	if entry.refs == 0 {
+		entry.plan.delete()
+	}
+}
+
+// removeCollection evicts the plans of the collection, called before the collection is released
+func (c *planCache) removeCollection(collectionID UniqueID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		if elem.Value.(*planCacheEntry).key.collectionID == collectionID {
+			c.evict(elem)
+		}
+		elem = next
+	}
+}
+
+func (c *planCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanCache_getOrCreate(t *testing.T) {
+	collectionMeta := genTestCollectionMeta(UniqueID(0), false)
+	collection := newCollection(collectionMeta.ID, collectionMeta.Schema)
+	defer deleteCollection(collection)
+
+	genDsl := func(topK int) string {
+		return fmt.Sprintf("{\"bool\": { \n\"vector\": {\n \"vec\": {\n \"metric_type\": \"L2\", \n \"params\": {\n \"nprobe\": 10 \n},\n \"query\": \"$0\",\"topk\": %d \n } \n } \n } \n }", topK)
+	}
+	created := 0
+	getPlan := func(cache *planCache, collection *Collection, topK int) (*Plan, func()) {
+		key := planCacheKey{collectionID: collection.ID(), plan: genDsl(topK)}
+		plan, release, err := cache.getOrCreate(collection, key, func() (*Plan, error) {
+			created++
+			return createPlan(collection, key.plan)
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(topK), plan.getTopK())
+		return plan, release
+	}
+
+	t.Run("cached and evicted", func(t *testing.T) {
+		created = 0
+		cache := newPlanCache(2)
+		plan1, release1 := getPlan(cache, collection, 1)
+		plan2, release2 := getPlan(cache, collection, 1)
+		assert.Equal(t, plan1, plan2)
+		assert.Equal(t, 1, created)
+		release1()
+		release2()
+
+		_, release := getPlan(cache, collection, 2)
+		release()
+		_, release = getPlan(cache, collection, 3)
+		release()
+		assert.Equal(t, 3, created)
+		assert.Equal(t, 2, cache.len())
+
+		// the plan of topK 1 is the least recently used one evicted
+		_, release = getPlan(cache, collection, 1)
+		release()
+		assert.Equal(t, 4, created)
+	})
+
+	t.Run("evicted in use", func(t *testing.T) {
+		cache := newPlanCache(1)
+		plan, release := getPlan(cache, collection, 1)
+		_, release2 := getPlan(cache, collection, 2)
+		release2()
+		// the evicted plan is still usable until it's released
+		assert.Equal(t, int64(1), plan.getTopK())
+		release()
+		assert.Equal(t, 1, cache.len())
+	})
+
+	t.Run("collection reloaded", func(t *testing.T) {
+		created = 0
+		cache := newPlanCache(2)
+		_, release := getPlan(cache, collection, 1)
+		release()
+
+		reloaded := newCollection(collectionMeta.ID, collectionMeta.Schema)
+		defer deleteCollection(reloaded)
+		_, release = getPlan(cache, reloaded, 1)
+		release()
+		assert.Equal(t, 2, created)
+		assert.Equal(t, 1, cache.len())
+
+		cache.removeCollection(collectionMeta.ID)
+		assert.Equal(t, 0, cache.len())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		created = 0
+		cache := newPlanCache(0)
+		_, release := getPlan(cache, collection, 1)
+		release()
+		_, release = getPlan(cache, collection, 1)
+		release()
+		assert.Equal(t, 2, created)
+		assert.Equal(t, 0, cache.len())
+	})
+}
@@ -17,9 +17,12 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"unsafe"
 
+	"github.com/opentracing/opentracing-go"
 	oplog "github.com/opentracing/opentracing-go/log"
 	"go.uber.org/zap"
 
@@ -48,8 +51,10 @@ type queryCollection struct {
 	cancel     context.CancelFunc
 
 	collectionID UniqueID
+	replicaID    UniqueID // the replica of the collection served by the query node, accessed atomically
 	historical   *historical
 	streaming    *streaming
+	planCache    *planCache
 
 	unsolvedMsgMu sync.Mutex // guards unsolvedMsg
 	unsolvedMsg   []queryMsg
@@ -71,6 +76,7 @@ func newQueryCollection(releaseCtx context.Context,
 	collectionID UniqueID,
 	historical *historical,
 	streaming *streaming,
+	planCache *planCache,
 	factory msgstream.Factory) *queryCollection {
 
 	unsolvedMsg := make([]queryMsg, 0)
@@ -85,6 +91,7 @@ func newQueryCollection(releaseCtx context.Context,
 		collectionID: collectionID,
 		historical:   historical,
 		streaming:    streaming,
+		planCache:    planCache,
 
 		tSafeWatchers: make(map[Channel]*tSafeWatcher),
 
@@ -114,6 +121,14 @@ func (q *queryCollection) close() {
 	}
 }
 
+func (q *queryCollection) setReplicaID(replicaID UniqueID) {
+	atomic.StoreInt64(&q.replicaID, replicaID)
+}
+
+func (q *queryCollection) getReplicaID() UniqueID {
+	return atomic.LoadInt64(&q.replicaID)
+}
+
 func (q *queryCollection) register() {
 	collection, err := q.streaming.replica.getCollectionByID(q.collectionID)
 	if err != nil {
@@ -260,11 +275,13 @@ func (q *queryCollection) loadBalance(msg *msgstream.LoadBalanceSegmentsMsg) {
 func (q *queryCollection) receiveQueryMsg(msg queryMsg) {
 	msgType := msg.Type()
 	var collectionID UniqueID
+	var replicaID UniqueID
 	var msgTypeStr string
 
 	switch msgType {
 	case commonpb.MsgType_Retrieve:
 		collectionID = msg.(*msgstream.RetrieveMsg).CollectionID
+		replicaID = msg.(*msgstream.RetrieveMsg).ReplicaID
 		msgTypeStr = "retrieve"
 		log.Debug("consume retrieve message",
 			zap.Any("collectionID", collectionID),
@@ -272,6 +289,7 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) {
 		)
 	case commonpb.MsgType_Search:
 		collectionID = msg.(*msgstream.SearchMsg).CollectionID
+		replicaID = msg.(*msgstream.SearchMsg).ReplicaID
 		msgTypeStr = "search"
 		log.Debug("consume search message",
 			zap.Any("collectionID", collectionID),
@@ -290,6 +308,16 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) {
 		)
 		return
 	}
+	// the other replicas of the collection serve the request
+	if servedReplicaID := q.getReplicaID(); replicaID != 0 && servedReplicaID != 0 && replicaID != servedReplicaID {
+		log.Debug("not target replica query request",
+			zap.Any("collectionID", q.collectionID),
+			zap.Int64("replicaID", servedReplicaID),
+			zap.Int64("target replicaID", replicaID),
+			zap.Int64("msgID", msg.ID()),
+		)
+		return
+	}
 
 	sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 	msg.SetTraceCtx(ctx)
@@ -328,6 +356,24 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) {
 		return
 	}
 
+	// the inserts after a field is added to the loaded collection are missed
+	if streamingCollection, err := q.streaming.replica.getCollectionByID(collectionID); err == nil &&
+		guaranteeTs >= streamingCollection.getSchemaOutdatedTime() {
+		err = fmt.Errorf("retrieve failed, a field is added to the collection after it is loaded, release and load it again, msgID = %d, collectionID = %d", msg.ID(), collectionID)
+		log.Error(err.Error())
+		err = q.publishFailedQueryResult(msg, err.Error())
+		if err != nil {
+			log.Error(err.Error())
+		} else {
+			log.Debug("do query failed in receiveQueryMsg, publish failed query result",
+				zap.Int64("collectionID", collectionID),
+				zap.Int64("msgID", msg.ID()),
+				zap.String("msgType", msgTypeStr),
+			)
+		}
+		return
+	}
+
 	serviceTime := q.getServiceableTime()
 	if guaranteeTs > serviceTime {
 		gt, _ := tsoutil.ParseTS(guaranteeTs)
@@ -441,43 +487,52 @@ func (q *queryCollection) doUnsolvedQueryMsg() {
 			if len(unSolvedMsg) <= 0 {
 				continue
 			}
-			for _, m := range unSolvedMsg {
+			// the compatible search messages are searched in batches
+			for _, msgs := range groupQueryMsgs(unSolvedMsg, Params.SearchBatchMaxNQ, Params.SearchBatchTimeWindow) {
+				m := msgs[0]
 				msgType := m.Type()
 				var err error
-				sp, ctx := trace.StartSpanFromContext(m.TraceCtx())
-				m.SetTraceCtx(ctx)
+				spans := make([]opentracing.Span, 0, len(msgs))
+				for _, msg := range msgs {
+					sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
+					msg.SetTraceCtx(ctx)
+					spans = append(spans, sp)
+				}
 				log.Debug("doing search in doUnsolvedMsg...",
 					zap.Int64("collectionID", q.collectionID),
 					zap.Int64("msgID", m.ID()),
+					zap.Int("batch", len(msgs)),
 				)
 				switch msgType {
 				case commonpb.MsgType_Retrieve:
 					err = q.retrieve(m)
 				case commonpb.MsgType_Search:
-					err = q.search(m)
+					err = q.searchBatch(msgs)
 				default:
 					err := fmt.Errorf("receive invalid msgType = %d", msgType)
 					log.Error(err.Error())
 					return
 				}
 
-				if err != nil {
-					log.Error(err.Error())
-					err = q.publishFailedQueryResult(m, err.Error())
+				for i, msg := range msgs {
 					if err != nil {
 						log.Error(err.Error())
-					} else {
-						log.Debug("do query failed in doUnsolvedMsg, publish failed query result",
-							zap.Int64("collectionID", q.collectionID),
-							zap.Int64("msgID", m.ID()),
-						)
+						err := q.publishFailedQueryResult(msg, err.Error())
+						if err != nil {
+							log.Error(err.Error())
+						} else {
+							log.Debug("do query failed in doUnsolvedMsg, publish failed query result",
+								zap.Int64("collectionID", q.collectionID),
+								zap.Int64("msgID", msg.ID()),
+							)
+						}
 					}
+					spans[i].Finish()
+					log.Debug("do query done in doUnsolvedMsg",
+						zap.Int64("collectionID", q.collectionID),
+						zap.Int64("msgID", msg.ID()),
+					)
 				}
-				sp.Finish()
-				log.Debug("do query done in doUnsolvedMsg",
-					zap.Int64("collectionID", q.collectionID),
-					zap.Int64("msgID", m.ID()),
-				)
 			}
 			log.Debug("doUnsolvedMsg: do query done", zap.Int("num of query msg", len(unSolvedMsg)))
 		}
@@ -761,40 +816,86 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 	return finalResult, nil
 }
 
-// TODO:: cache map[dsl]plan
-// TODO: reBatched search requests
 func (q *queryCollection) search(msg queryMsg) error {
-	searchMsg := msg.(*msgstream.SearchMsg)
+	return q.searchBatch([]queryMsg{msg})
+}
+
+// getSearchPlan returns the plan of the search message from the plan cache, or creates and caches it.
+// The returned release func must be called once the plan is no longer used.
+func (q *queryCollection) getSearchPlan(collection *Collection, searchMsg *msgstream.SearchMsg, pkField *schemapb.FieldSchema) (*Plan, func(), error) {
+	key := planCacheKey{
+		collectionID:  collection.ID(),
+		schemaVersion: collection.Schema().GetVersion(),
+		dslType:       searchMsg.GetDslType(),
+	}
+	if searchMsg.GetDslType() == commonpb.DslType_BoolExprV1 {
+		expr := searchMsg.SerializedExprPlan
+		if pkField != nil {
+			segments, err := q.getSegmentsOfCollection(collection.ID())
+			if err != nil {
+				return nil, nil, err
+			}
+			expr, err = translateStringPKExpr(expr, pkField, segments)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		key.plan = string(expr)
+		return q.planCache.getOrCreate(collection, key, func() (*Plan, error) {
+			return createPlanByExpr(collection, expr)
+		})
+	}
+	dsl := searchMsg.Dsl
+	key.plan = dsl
+	return q.planCache.getOrCreate(collection, key, func() (*Plan, error) {
+		return createPlan(collection, dsl)
+	})
+}
+
+// searchBatch searches the batch of search messages grouped by groupQueryMsgs with the queries of all the
+// messages at once, then publishes the hits of every message
+func (q *queryCollection) searchBatch(msgs []queryMsg) error {
+	searchMsgs := make([]*msgstream.SearchMsg, 0, len(msgs))
+	for _, msg := range msgs {
+		searchMsgs = append(searchMsgs, msg.(*msgstream.SearchMsg))
+	}
+	searchMsg := searchMsgs[0]
 	sp, ctx := trace.StartSpanFromContext(searchMsg.TraceCtx())
 	defer sp.Finish()
 	searchMsg.SetTraceCtx(ctx)
-	searchTimestamp := searchMsg.BeginTs()
-	travelTimestamp := searchMsg.TravelTimestamp
+	// the batch searches the growing segments served for any of its messages
+	guaranteeTimestamp := searchMsg.GuaranteeTimestamp
+	for _, msg := range searchMsgs[1:] {
+		if msg.GuaranteeTimestamp < guaranteeTimestamp {
+			guaranteeTimestamp = msg.GuaranteeTimestamp
+		}
+	}
 
 	collectionID := searchMsg.CollectionID
 	collection, err := q.streaming.replica.getCollectionByID(collectionID)
 	if err != nil {
 		return err
 	}
-	schema, err := typeutil.CreateSchemaHelper(collection.schema)
+	// hits of a collection keyed by strings are translated with the schema of segcore, see string_pk.go
+	schema, err := typeutil.CreateSchemaHelper(getSegcoreSchema(collection.schema))
 	if err != nil {
 		return err
 	}
-
-	var plan *Plan
-	if searchMsg.GetDslType() == commonpb.DslType_BoolExprV1 {
-		expr := searchMsg.SerializedExprPlan
-		plan, err = createPlanByExpr(collection, expr)
-		if err != nil {
-			return err
-		}
-	} else {
-		dsl := searchMsg.Dsl
-		plan, err = createPlan(collection, dsl)
-		if err != nil {
-			return err
+	pkField := getStringPKField(collection.schema)
+	pkIndex := -1
+	if pkField != nil {
+		for i, fieldID := range searchMsg.OutputFieldsId {
+			if fieldID == pkField.FieldID {
+				pkIndex = i
+			}
 		}
 	}
+
+	plan, releasePlan, err := q.getSearchPlan(collection, searchMsg, pkField)
+	if err != nil {
+		return err
+	}
+	defer releasePlan()
 	topK := plan.getTopK()
 	if topK == 0 {
 		return fmt.Errorf("limit must be greater than 0")
@@ -803,37 +904,64 @@ func (q *queryCollection) search(msg queryMsg) error {
 		return fmt.Errorf("limit %d is too large", topK)
 	}
 	searchRequestBlob := searchMsg.PlaceholderGroup
+	var nqs []int64
+	if len(searchMsgs) > 1 {
+		blobs := make([][]byte, 0, len(searchMsgs))
+		for _, msg := range searchMsgs {
+			blobs = append(blobs, msg.PlaceholderGroup)
+		}
+		searchRequestBlob, nqs, err = mergePlaceholderGroups(blobs)
+		if err != nil {
+			return err
+		}
+	}
 	searchReq, err := parseSearchRequest(plan, searchRequestBlob)
 	if err != nil {
 		return err
 	}
+	defer searchReq.delete()
 	queryNum := searchReq.getNumOfQuery()
+	if nqs == nil {
+		nqs = []int64{queryNum}
+	}
+	// every query sees the data at the travel timestamp of its own message
+	travelTimestamps := getQueryTravelTimestamps(searchMsgs, nqs)
 	searchRequests := make([]*searchRequest, 0)
 	searchRequests = append(searchRequests, searchReq)
 
 	if searchMsg.GetDslType() == commonpb.DslType_BoolExprV1 {
 		sp.LogFields(oplog.String("statistical time", "stats start"),
 			oplog.Object("nq", queryNum),
+			oplog.Object("batch", len(searchMsgs)),
 			oplog.Object("expr", searchMsg.SerializedExprPlan))
 	} else {
 		sp.LogFields(oplog.String("statistical time", "stats start"),
 			oplog.Object("nq", queryNum),
+			oplog.Object("batch", len(searchMsgs)),
 			oplog.Object("dsl", searchMsg.Dsl))
 	}
 
-	tr := timerecord.NewTimeRecorder(fmt.Sprintf("search %d(nq=%d, k=%d)", searchMsg.CollectionID, queryNum, topK))
+	tr := timerecord.NewTimeRecorder(fmt.Sprintf("search %d(nq=%d, k=%d, batch=%d)", searchMsg.CollectionID, queryNum, topK, len(searchMsgs)))
 
 	searchResults := make([]*SearchResult, 0)
 	matchedSegments := make([]*Segment, 0)
 	sealedSegmentSearched := make([]UniqueID, 0)
+	defer func() {
+		deleteSearchResults(searchResults)
+	}()
+
+	// hold handoff read lock until the matched segments are reduced, so that a segment handed off
+	// is searched either as a growing segment or as a sealed segment, never both or neither
+	q.historical.handoffMu.RLock()
+	defer q.historical.handoffMu.RUnlock()
 
 	// historical search
-	hisSearchResults, hisSegmentResults, err1 := q.historical.search(searchRequests, collectionID, searchMsg.PartitionIDs, plan, travelTimestamp)
+	hisSearchResults, hisSegmentResults, err1 := q.historical.search(searchRequests, collectionID, searchMsg.PartitionIDs, plan, travelTimestamps)
+	searchResults = append(searchResults, hisSearchResults...)
 	if err1 != nil {
 		log.Error(err1.Error())
 		return err1
 	}
-	searchResults = append(searchResults, hisSearchResults...)
 	matchedSegments = append(matchedSegments, hisSegmentResults...)
 	for _, seg := range hisSegmentResults {
 		sealedSegmentSearched = append(sealedSegmentSearched, seg.segmentID)
@@ -845,149 +973,108 @@ func (q *queryCollection) search(msg queryMsg) error {
 	for _, channel := range collection.getVChannels() {
 		var strSearchResults []*SearchResult
 		var strSegmentResults []*Segment
-		strSearchResults, strSegmentResults, err2 = q.streaming.search(searchRequests, collectionID, searchMsg.PartitionIDs, channel, plan, travelTimestamp)
+		strSearchResults, strSegmentResults, err2 = q.streaming.search(searchRequests, collectionID, searchMsg.PartitionIDs, channel, plan, travelTimestamps, guaranteeTimestamp)
+		searchResults = append(searchResults, strSearchResults...)
 		if err2 != nil {
 			log.Error(err2.Error())
 			return err2
 		}
-		searchResults = append(searchResults, strSearchResults...)
 		matchedSegments = append(matchedSegments, strSegmentResults...)
 	}
 	tr.Record("streaming search done")
 
 	sp.LogFields(oplog.String("statistical time", "segment search end"))
+	// whether each query of a range search may have missed hits within the distance range
+	truncated := getRangeSearchTruncated(searchResults, queryNum)
+	var hits [][]byte
 	if len(searchResults) <= 0 {
-		for _, group := range searchRequests {
-			nq := group.getNumOfQuery()
-			nilHits := make([][]byte, nq)
-			hit := &milvuspb.Hits{}
-			for i := 0; i < int(nq); i++ {
-				bs, err := proto.Marshal(hit)
-				if err != nil {
-					return err
-				}
-				nilHits[i] = bs
+		hits = make([][]byte, queryNum)
+		hit := &milvuspb.Hits{}
+		for i := 0; i < int(queryNum); i++ {
+			bs, err := proto.Marshal(hit)
+			if err != nil {
+				return err
 			}
-
-			// TODO: remove inefficient code in cgo and use SearchResultData directly
-			// TODO: Currently add a translate layer from hits to SearchResultData
-			// TODO: hits marshal and unmarshal is likely bottleneck
-
-			transformed, err := translateHits(schema, searchMsg.OutputFieldsId, nilHits)
+			hits[i] = bs
+		}
+	} else {
+		inReduced := make([]bool, len(searchResults))
+		numSegment := int64(len(searchResults))
+		var marshaledHits *MarshaledHits = nil
+		if numSegment == 1 {
+			inReduced[0] = true
+			err = fillTargetEntry(plan, searchResults, matchedSegments, inReduced)
+			sp.LogFields(oplog.String("statistical time", "fillTargetEntry end"))
 			if err != nil {
 				return err
 			}
-			byteBlobs, err := proto.Marshal(transformed)
+			marshaledHits, err = reorganizeSingleQueryResult(plan, searchRequests, searchResults[0])
+			sp.LogFields(oplog.String("statistical time", "reorganizeSingleQueryResult end"))
 			if err != nil {
 				return err
 			}
-
-			resultChannelInt := 0
-			searchResultMsg := &msgstream.SearchResultMsg{
-				BaseMsg: msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
-				SearchResults: internalpb.SearchResults{
-					Base: &commonpb.MsgBase{
-						MsgType:   commonpb.MsgType_SearchResult,
-						MsgID:     searchMsg.Base.MsgID,
-						Timestamp: searchTimestamp,
-						SourceID:  searchMsg.Base.SourceID,
-					},
-					Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-					ResultChannelID:          searchMsg.ResultChannelID,
-					Hits:                     nilHits,
-					SlicedBlob:               byteBlobs,
-					SlicedOffset:             1,
-					SlicedNumCount:           1,
-					MetricType:               plan.getMetricType(),
-					SealedSegmentIDsSearched: sealedSegmentSearched,
-					ChannelIDsSearched:       collection.getVChannels(),
-					//TODO:: get global sealed segment from etcd
-					GlobalSealedSegmentIDs: sealedSegmentSearched,
-				},
+		} else {
+			err = reduceSearchResults(searchResults, numSegment, inReduced)
+			sp.LogFields(oplog.String("statistical time", "reduceSearchResults end"))
+			if err != nil {
+				return err
 			}
-			log.Debug("QueryNode Empty SearchResultMsg",
-				zap.Any("collectionID", collection.ID()),
-				zap.Any("msgID", searchMsg.ID()),
-				zap.Any("vChannels", collection.getVChannels()),
-				zap.Any("sealedSegmentSearched", sealedSegmentSearched),
-			)
-			err = q.publishQueryResult(searchResultMsg, searchMsg.CollectionID)
+			err = fillTargetEntry(plan, searchResults, matchedSegments, inReduced)
+			sp.LogFields(oplog.String("statistical time", "fillTargetEntry end"))
+			if err != nil {
+				return err
+			}
+			marshaledHits, err = reorganizeQueryResults(plan, searchRequests, searchResults, numSegment, inReduced)
+			sp.LogFields(oplog.String("statistical time", "reorganizeQueryResults end"))
 			if err != nil {
 				return err
 			}
-			tr.Record("publish empty search result done")
-			tr.Elapse("all done")
-			return nil
-		}
-	}
-
-	inReduced := make([]bool, len(searchResults))
-	numSegment := int64(len(searchResults))
-	var marshaledHits *MarshaledHits = nil
-	if numSegment == 1 {
-		inReduced[0] = true
-		err = fillTargetEntry(plan, searchResults, matchedSegments, inReduced)
-		sp.LogFields(oplog.String("statistical time", "fillTargetEntry end"))
-		if err != nil {
-			return err
-		}
-		marshaledHits, err = reorganizeSingleQueryResult(plan, searchRequests, searchResults[0])
-		sp.LogFields(oplog.String("statistical time", "reorganizeSingleQueryResult end"))
-		if err != nil {
-			return err
-		}
-	} else {
-		err = reduceSearchResults(searchResults, numSegment, inReduced)
-		sp.LogFields(oplog.String("statistical time", "reduceSearchResults end"))
-		if err != nil {
-			return err
 		}
-		err = fillTargetEntry(plan, searchResults, matchedSegments, inReduced)
-		sp.LogFields(oplog.String("statistical time", "fillTargetEntry end"))
+		defer deleteMarshaledHits(marshaledHits)
+		hitsBlob, err := marshaledHits.getHitsBlob()
+		sp.LogFields(oplog.String("statistical time", "getHitsBlob end"))
 		if err != nil {
 			return err
 		}
-		marshaledHits, err = reorganizeQueryResults(plan, searchRequests, searchResults, numSegment, inReduced)
-		sp.LogFields(oplog.String("statistical time", "reorganizeQueryResults end"))
+		hitBlobSizePeerQuery, err := marshaledHits.hitBlobSizeInGroup(0)
 		if err != nil {
 			return err
 		}
-	}
-	hitsBlob, err := marshaledHits.getHitsBlob()
-	sp.LogFields(oplog.String("statistical time", "getHitsBlob end"))
-	if err != nil {
-		return err
-	}
-	tr.Record("reduce result done")
-
-	var offset int64 = 0
-	for index := range searchRequests {
-		hitBlobSizePeerQuery, err := marshaledHits.hitBlobSizeInGroup(int64(index))
-		if err != nil {
-			return err
-		}
-		hits := make([][]byte, len(hitBlobSizePeerQuery))
+		hits = make([][]byte, len(hitBlobSizePeerQuery))
+		var offset int64 = 0
 		for i, len := range hitBlobSizePeerQuery {
 			hits[i] = hitsBlob[offset : offset+len]
-			//test code to checkout marshaled hits
-			//marshaledHit := hitsBlob[offset:offset+len]
-			//unMarshaledHit := milvuspb.Hits{}
-			//err = proto.Unmarshal(marshaledHit, &unMarshaledHit)
-			//if err != nil {
-			//	return err
-			//}
-			//log.Debug("hits msg  = ", unMarshaledHit)
 			offset += len
 		}
+		tr.Record("reduce result done")
+	}
 
+	// the hits of the batch are split back to the messages, whose results are published one by one
+	msgHits, err := splitHits(hits, nqs)
+	if err != nil {
+		return err
+	}
+	var queryOffset int64
+	for i, searchMsg := range searchMsgs {
 		// TODO: remove inefficient code in cgo and use SearchResultData directly
 		// TODO: Currently add a translate layer from hits to SearchResultData
 		// TODO: hits marshal and unmarshal is likely bottleneck
 
-		transformed, err := translateHits(schema, searchMsg.OutputFieldsId, hits)
+		transformed, err := translateHits(schema, searchMsg.OutputFieldsId, msgHits[i])
 		if err != nil {
 			return err
 		}
+		trimSearchResultData(transformed)
+		for _, t := range truncated[queryOffset : queryOffset+nqs[i]] {
+			transformed.RangeSearchTruncated = transformed.RangeSearchTruncated || t
+		}
+		queryOffset += nqs[i]
+		if pkField != nil {
+			err = translateStringPKResults(transformed.Ids, transformed.FieldsData, pkIndex, matchedSegments)
+			if err != nil {
+				return err
+			}
+		}
 		byteBlobs, err := proto.Marshal(transformed)
 		if err != nil {
 			return err
@@ -1000,12 +1087,12 @@ func (q *queryCollection) search(msg queryMsg) error {
 				Base: &commonpb.MsgBase{
 					MsgType:   commonpb.MsgType_SearchResult,
 					MsgID:     searchMsg.Base.MsgID,
-					Timestamp: searchTimestamp,
+					Timestamp: searchMsg.BeginTs(),
 					SourceID:  searchMsg.Base.SourceID,
 				},
 				Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
 				ResultChannelID:          searchMsg.ResultChannelID,
-				Hits:                     hits,
+				Hits:                     msgHits[i],
 				SlicedBlob:               byteBlobs,
 				SlicedOffset:             1,
 				SlicedNumCount:           1,
@@ -1014,6 +1101,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 				ChannelIDsSearched:       collection.getVChannels(),
 				//TODO:: get global sealed segment from etcd
 				GlobalSealedSegmentIDs: sealedSegmentSearched,
+				ReplicaID:              searchMsg.ReplicaID,
 			},
 		}
 		log.Debug("QueryNode SearchResultMsg",
@@ -1021,6 +1109,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 			zap.Any("msgID", searchMsg.ID()),
 			zap.Any("vChannels", collection.getVChannels()),
 			zap.Any("sealedSegmentSearched", sealedSegmentSearched),
+			zap.Bool("empty", len(searchResults) <= 0),
 		)
 
 		// For debugging, please don't delete.
@@ -1041,12 +1130,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 		tr.Record("publish search result")
 	}
 
-	sp.LogFields(oplog.String("statistical time", "before free c++ memory"))
-	deleteSearchResults(searchResults)
-	deleteMarshaledHits(marshaledHits)
 	sp.LogFields(oplog.String("statistical time", "stats done"))
-	plan.delete()
-	searchReq.delete()
 	tr.Elapse("all done")
 	return nil
 }
@@ -1070,16 +1154,21 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 		return err
 	}
 
-	req := &segcorepb.RetrieveRequest{
-		Ids:          retrieveMsg.Ids,
-		OutputFields: retrieveMsg.OutputFields,
-	}
-
-	plan, err := createRetrievePlan(collection, req, timestamp)
-	if err != nil {
-		return err
+	// string primary keys are retrieved by the row IDs, see string_pk.go
+	pkField := getStringPKField(collection.schema)
+	var collectionSegments []*Segment
+	pkIndex := -1
+	if pkField != nil {
+		collectionSegments, err = q.getSegmentsOfCollection(collectionID)
+		if err != nil {
+			return err
+		}
+		for i, name := range retrieveMsg.OutputFields {
+			if name == pkField.Name {
+				pkIndex = i
+			}
+		}
 	}
-	defer plan.delete()
 
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("retrieve %d", retrieveMsg.CollectionID))
 
@@ -1109,50 +1198,47 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 			}
 		}
 	}
-	sealedSegmentRetrieved := make([]UniqueID, 0)
-	var mergeList []*segcorepb.RetrieveResults
-	for _, partitionID := range partitionIDsInHistorical {
-		segmentIDs, err := q.historical.replica.getSegmentIDs(partitionID)
+	// hold handoff read lock during retrieving, see search
+	q.historical.handoffMu.RLock()
+	defer q.historical.handoffMu.RUnlock()
+
+	// with a limit, the primary keys are retrieved in ascending batches of limit keys, and the retrieval
+	// stops once limit rows are found since the rows of the following batches have larger primary keys
+	var sealedSegmentRetrieved []UniqueID
+	result := &segcorepb.RetrieveResults{Ids: &schemapb.IDs{}}
+	for _, batch := range splitRetrieveIDs(retrieveMsg.Ids, retrieveMsg.Limit) {
+		ids := batch
+		if pkField != nil {
+			ids = &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: lookupRowIDs(collectionSegments, batch.GetStrId().GetData()),
+					},
+				},
+			}
+		}
+		var mergeList []*segcorepb.RetrieveResults
+		mergeList, sealedSegmentRetrieved, err = q.retrieveSegments(collection, ids, retrieveMsg.OutputFields, timestamp,
+			partitionIDsInHistorical, partitionIDsInStreaming)
 		if err != nil {
 			return err
 		}
-		for _, segmentID := range segmentIDs {
-			segment, err := q.historical.replica.getSegmentByID(segmentID)
-			if err != nil {
-				return err
-			}
-			result, err := segment.segmentGetEntityByIds(plan)
-			if err != nil {
-				return err
-			}
-			mergeList = append(mergeList, result)
-			sealedSegmentRetrieved = append(sealedSegmentRetrieved, segmentID)
-		}
-	}
-	tr.Record("historical retrieve done")
+		tr.Record("segments retrieve done")
 
-	for _, partitionID := range partitionIDsInStreaming {
-		segmentIDs, err := q.streaming.replica.getSegmentIDs(partitionID)
+		batchResult, err := mergeRetrieveResults(mergeList)
 		if err != nil {
 			return err
 		}
-		for _, segmentID := range segmentIDs {
-			segment, err := q.streaming.replica.getSegmentByID(segmentID)
+		if pkField != nil && batchResult.Ids != nil {
+			err = translateStringPKResults(batchResult.Ids, batchResult.FieldsData, pkIndex, collectionSegments)
 			if err != nil {
 				return err
 			}
-			result, err := segment.segmentGetEntityByIds(plan)
-			if err != nil {
-				return err
-			}
-			mergeList = append(mergeList, result)
 		}
-	}
-	tr.Record("streaming retrieve done")
-
-	result, err := mergeRetrieveResults(mergeList)
-	if err != nil {
-		return err
+		appendSortedRetrieveResults(result, batchResult, retrieveMsg.Limit)
+		if retrieveMsg.Limit > 0 && int64(typeutil.GetSizeOfIDs(result.Ids)) >= retrieveMsg.Limit {
+			break
+		}
 	}
 	tr.Record("merge result done")
 
@@ -1173,6 +1259,7 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 			ChannelIDsRetrieved:       collection.getVChannels(),
 			//TODO(yukun):: get global sealed segment from etcd
 			GlobalSealedSegmentIDs: sealedSegmentRetrieved,
+			ReplicaID:              retrieveMsg.ReplicaID,
 		},
 	}
 
@@ -1189,6 +1276,140 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	return nil
 }
 
+// getSegmentsOfCollection returns the segments of a collection in both historical and streaming
+func (q *queryCollection) getSegmentsOfCollection(collectionID UniqueID) ([]*Segment, error) {
+	hisSegments, err := getSegmentsOfCollection(q.historical.replica, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	strSegments, err := getSegmentsOfCollection(q.streaming.replica, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	return append(hisSegments, strSegments...), nil
+}
+
+// retrieveSegments retrieves ids from the segments of the partitions, the sealed segments retrieved
+// are returned along with the results
+func (q *queryCollection) retrieveSegments(collection *Collection, ids *schemapb.IDs, outputFields []string, timestamp Timestamp,
+	partitionIDsInHistorical []UniqueID, partitionIDsInStreaming []UniqueID) ([]*segcorepb.RetrieveResults, []UniqueID, error) {
+	req := &segcorepb.RetrieveRequest{
+		Ids:          ids,
+		OutputFields: outputFields,
+	}
+	plan, err := createRetrievePlan(collection, req, timestamp)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer plan.delete()
+
+	sealedSegmentRetrieved := make([]UniqueID, 0)
+	var mergeList []*segcorepb.RetrieveResults
+	for _, partitionID := range partitionIDsInHistorical {
+		segmentIDs, err := q.historical.replica.getSegmentIDs(partitionID)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, segmentID := range segmentIDs {
+			segment, err := q.historical.replica.getSegmentByID(segmentID)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !segment.getOnService() {
+				continue
+			}
+			result, err := segment.segmentGetEntityByIds(plan)
+			if err != nil {
+				return nil, nil, err
+			}
+			mergeList = append(mergeList, result)
+			sealedSegmentRetrieved = append(sealedSegmentRetrieved, segmentID)
+		}
+	}
+
+	for _, partitionID := range partitionIDsInStreaming {
+		segmentIDs, err := q.streaming.replica.getSegmentIDs(partitionID)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, segmentID := range segmentIDs {
+			segment, err := q.streaming.replica.getSegmentByID(segmentID)
+			if err != nil {
+				return nil, nil, err
+			}
+			result, err := segment.segmentGetEntityByIds(plan)
+			if err != nil {
+				return nil, nil, err
+			}
+			mergeList = append(mergeList, result)
+		}
+	}
+	return mergeList, sealedSegmentRetrieved, nil
+}
+
+// splitRetrieveIDs sorts and deduplicates the primary keys, and splits them into batches of limit keys
+// if limit is positive. There is at least one batch even if ids is empty.
+func splitRetrieveIDs(ids *schemapb.IDs, limit int64) []*schemapb.IDs {
+	size := typeutil.GetSizeOfIDs(ids)
+	if limit <= 0 || size == 0 {
+		return []*schemapb.IDs{ids}
+	}
+	pks := make([]interface{}, 0, size)
+	for i := 0; i < size; i++ {
+		pks = append(pks, typeutil.GetPK(ids, i))
+	}
+	sort.Slice(pks, func(i, j int) bool {
+		return typeutil.ComparePK(pks[i], pks[j]) < 0
+	})
+
+	var batches []*schemapb.IDs
+	var batch *schemapb.IDs
+	for i, pk := range pks {
+		if i > 0 && typeutil.ComparePK(pks[i-1], pk) == 0 {
+			continue
+		}
+		if batch == nil || int64(typeutil.GetSizeOfIDs(batch)) >= limit {
+			batch = &schemapb.IDs{}
+			batches = append(batches, batch)
+		}
+		typeutil.AppendPK(batch, pk)
+	}
+	return batches
+}
+
+// appendSortedRetrieveResults appends the rows of result to dst in ascending order of primary keys until dst
+// holds limit rows if limit is positive. The primary keys of result are larger than those of dst, and the
+// rows of a duplicated primary key are appended once.
+func appendSortedRetrieveResults(dst *segcorepb.RetrieveResults, result *segcorepb.RetrieveResults, limit int64) {
+	size := typeutil.GetSizeOfIDs(result.GetIds())
+	if size == 0 {
+		return
+	}
+	order := make([]int, size)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return typeutil.ComparePK(typeutil.GetPK(result.Ids, order[i]), typeutil.GetPK(result.Ids, order[j])) < 0
+	})
+
+	for _, idx := range order {
+		dstSize := typeutil.GetSizeOfIDs(dst.Ids)
+		if limit > 0 && int64(dstSize) >= limit {
+			return
+		}
+		pk := typeutil.GetPK(result.Ids, idx)
+		if dstSize > 0 && typeutil.ComparePK(typeutil.GetPK(dst.Ids, dstSize-1), pk) == 0 {
+			continue
+		}
+		typeutil.AppendPK(dst.Ids, pk)
+		if len(dst.FieldsData) == 0 {
+			dst.FieldsData = make([]*schemapb.FieldData, len(result.FieldsData))
+		}
+		typeutil.AppendFieldData(dst.FieldsData, result.FieldsData, int64(idx))
+	}
+}
+
 func mergeRetrieveResults(dataArr []*segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error) {
 	var final *segcorepb.RetrieveResults
 	for _, data := range dataArr {
@@ -1265,6 +1486,7 @@ func (q *queryCollection) publishFailedQueryResult(msg msgstream.TsMsg, errMsg s
 				ResultChannelID: retrieveMsg.ResultChannelID,
 				Ids:             nil,
 				FieldsData:      nil,
+				ReplicaID:       retrieveMsg.ReplicaID,
 			},
 		}
 		msgPack.Msgs = append(msgPack.Msgs, retrieveResultMsg)
@@ -1277,6 +1499,7 @@ func (q *queryCollection) publishFailedQueryResult(msg msgstream.TsMsg, errMsg s
 				Base:            baseResult,
 				Status:          &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: errMsg},
 				ResultChannelID: searchMsg.ResultChannelID,
+				ReplicaID:       searchMsg.ReplicaID,
 			},
 		}
 		msgPack.Msgs = append(msgPack.Msgs, searchResultMsg)
@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+)
+
+func TestQueryCollection_splitRetrieveIDs(t *testing.T) {
+	ids := &schemapb.IDs{
+		IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{5, 1, 3, 2, 3, 4}}},
+	}
+	batches := splitRetrieveIDs(ids, 0)
+	assert.Equal(t, []*schemapb.IDs{ids}, batches)
+
+	batches = splitRetrieveIDs(ids, 2)
+	assert.Equal(t, 3, len(batches))
+	assert.Equal(t, []int64{1, 2}, batches[0].GetIntId().GetData())
+	assert.Equal(t, []int64{3, 4}, batches[1].GetIntId().GetData())
+	assert.Equal(t, []int64{5}, batches[2].GetIntId().GetData())
+
+	strIDs := &schemapb.IDs{
+		IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"b", "a", "c"}}},
+	}
+	batches = splitRetrieveIDs(strIDs, 2)
+	assert.Equal(t, 2, len(batches))
+	assert.Equal(t, []string{"a", "b"}, batches[0].GetStrId().GetData())
+	assert.Equal(t, []string{"c"}, batches[1].GetStrId().GetData())
+
+	// there is always a batch
+	empty := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{}}}
+	assert.Equal(t, []*schemapb.IDs{empty}, splitRetrieveIDs(empty, 2))
+}
+
+func TestQueryCollection_appendSortedRetrieveResults(t *testing.T) {
+	newResult := func(pks []int64, values []int32) *segcorepb.RetrieveResults {
+		return &segcorepb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}},
+			},
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type: schemapb.DataType_Int32,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: values}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	dst := &segcorepb.RetrieveResults{Ids: &schemapb.IDs{}}
+	appendSortedRetrieveResults(dst, newResult(nil, nil), 0)
+	assert.Equal(t, 0, len(dst.FieldsData))
+
+	// the rows of duplicated primary keys are appended once
+	appendSortedRetrieveResults(dst, newResult([]int64{3, 1, 2, 1}, []int32{30, 10, 20, 11}), 0)
+	assert.Equal(t, []int64{1, 2, 3}, dst.GetIds().GetIntId().GetData())
+	assert.Equal(t, []int32{10, 20, 30}, dst.GetFieldsData()[0].GetScalars().GetIntData().GetData())
+
+	appendSortedRetrieveResults(dst, newResult([]int64{6, 4, 5}, []int32{60, 40, 50}), 5)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, dst.GetIds().GetIntId().GetData())
+	assert.Equal(t, []int32{10, 20, 30, 40, 50}, dst.GetFieldsData()[0].GetScalars().GetIntData().GetData())
+}
@@ -143,7 +143,7 @@ func (node *QueryNode) Init() error {
 		node.indexCoord,
 		node.msFactory,
 		node.etcdKV)
-	node.streaming = newStreaming(node.queryNodeLoopCtx, node.msFactory, node.etcdKV)
+	node.streaming = newStreaming(node.queryNodeLoopCtx, node.msFactory, node.etcdKV, node.historical.replica)
 
 	C.SegcoreInit()
 
@@ -30,6 +30,7 @@ type queryService struct {
 
 	queryNodeID      UniqueID
 	queryCollections map[UniqueID]*queryCollection
+	planCache        *planCache
 
 	factory msgstream.Factory
 }
@@ -49,6 +50,7 @@ func newQueryService(ctx context.Context,
 
 		queryNodeID:      Params.QueryNodeID,
 		queryCollections: make(map[UniqueID]*queryCollection),
+		planCache:        newPlanCache(Params.SearchPlanCacheSize),
 
 		factory: factory,
 	}
@@ -75,6 +77,7 @@ func (q *queryService) addQueryCollection(collectionID UniqueID) {
 		collectionID,
 		q.historical,
 		q.streaming,
+		q.planCache,
 		q.factory)
 	q.queryCollections[collectionID] = qc
 }
@@ -92,5 +95,6 @@ func (q *queryService) stopQueryCollection(collectionID UniqueID) {
 	}
 	sc.close()
 	sc.cancel()
+	q.planCache.removeCollection(collectionID)
 	delete(q.queryCollections, collectionID)
 }
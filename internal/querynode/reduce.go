@@ -23,11 +23,14 @@ import "C"
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"sync"
 	"unsafe"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type SearchResult struct {
@@ -59,6 +62,20 @@ func reduceSearchResults(searchResults []*SearchResult, numSegments int64, inRed
 	return nil
 }
 
+// getRangeSearchTruncated returns whether each of the numQueries queries of a range search may have missed hits
+// within the distance range in any of the searchResults
+func getRangeSearchTruncated(searchResults []*SearchResult, numQueries int64) []bool {
+	truncated := make([]bool, numQueries)
+	if numQueries <= 0 {
+		return truncated
+	}
+	cTruncated := (*C.bool)(&truncated[0])
+	for _, res := range searchResults {
+		C.GetRangeSearchTruncated(res.cQueryResult, C.long(numQueries), cTruncated)
+	}
+	return truncated
+}
+
 func fillTargetEntry(plan *Plan, searchResults []*SearchResult, matchedSegments []*Segment, inReduced []bool) error {
 	wg := &sync.WaitGroup{}
 	fmt.Println(inReduced)
@@ -151,6 +168,40 @@ func (mh *MarshaledHits) hitBlobSizeInGroup(groupOffset int64) ([]int64, error)
 	return result, nil
 }
 
+// trimSearchResultData drops the invalid hits padded to the top k hits of each query, which are out of the
+// distance range of a range search or beyond the row count, so that the queries have variable numbers of
+// hits set in Topks. The invalid hits have the lowest score since the scores are larger for closer hits.
+func trimSearchResultData(data *schemapb.SearchResultData) {
+	const invalidScore = -1 * float32(math.MaxFloat32)
+	topK := int(data.TopK)
+	ids := &schemapb.IDs{}
+	scores := make([]float32, 0, len(data.Scores))
+	fieldsData := make([]*schemapb.FieldData, len(data.FieldsData))
+	data.Topks = make([]int64, 0, data.NumQueries)
+	for q := 0; q < int(data.NumQueries); q++ {
+		hits := 0
+		for i := q * topK; i < (q+1)*topK && i < len(data.Scores); i++ {
+			if data.Scores[i] <= invalidScore {
+				continue
+			}
+			typeutil.AppendPK(ids, typeutil.GetPK(data.Ids, i))
+			scores = append(scores, data.Scores[i])
+			typeutil.AppendFieldData(fieldsData, data.FieldsData, int64(i))
+			hits++
+		}
+		data.Topks = append(data.Topks, int64(hits))
+	}
+	if ids.GetIdField() == nil {
+		ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{}}
+	}
+	if len(scores) == 0 {
+		fieldsData = nil
+	}
+	data.Ids = ids
+	data.Scores = scores
+	data.FieldsData = fieldsData
+}
+
 func deleteMarshaledHits(hits *MarshaledHits) {
 	C.DeleteMarshaledHits(hits.cMarshaledHits)
 }
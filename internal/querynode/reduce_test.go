@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
 func TestReduce_AllFunc(t *testing.T) {
@@ -111,3 +112,37 @@ func TestReduce_AllFunc(t *testing.T) {
 	deleteSegment(segment)
 	deleteCollection(collection)
 }
+
+func TestReduce_trimSearchResultData(t *testing.T) {
+	invalid := -1 * float32(math.MaxFloat32)
+	// 2 queries of top 3 hits padded with invalid hits
+	data := &schemapb.SearchResultData{
+		NumQueries: 2,
+		TopK:       3,
+		Scores:     []float32{-1, -2, invalid, -3, invalid, invalid},
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, -1, 3, -1, -1}}},
+		},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{10, 20, 0, 30, 0, 0}}},
+					},
+				},
+			},
+		},
+	}
+	trimSearchResultData(data)
+	assert.EqualValues(t, 3, data.TopK)
+	assert.Equal(t, []int64{2, 1}, data.Topks)
+	assert.Equal(t, []float32{-1, -2, -3}, data.Scores)
+	assert.Equal(t, []int64{1, 2, 3}, data.Ids.GetIntId().GetData())
+	assert.Equal(t, []int32{10, 20, 30}, data.FieldsData[0].GetScalars().GetIntData().GetData())
+
+	empty := &schemapb.SearchResultData{NumQueries: 2, Ids: &schemapb.IDs{}}
+	trimSearchResultData(empty)
+	assert.Equal(t, []int64{0, 0}, empty.Topks)
+	assert.NotNil(t, empty.Ids.GetIntId())
+	assert.Equal(t, 0, len(empty.FieldsData))
+}
@@ -0,0 +1,188 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// searchBatchKey is shared by the search messages which can be searched in one batch, with the same plan,
+// partitions, output fields and kind of queries
+type searchBatchKey struct {
+	dslType         commonpb.DslType
+	plan            string
+	partitionIDs    string
+	outputFieldIDs  string
+	tag             string
+	placeholderType milvuspb.PlaceholderType
+}
+
+// getSearchBatchKey returns the batch key and the number of queries of the search message
+func getSearchBatchKey(msg *msgstream.SearchMsg) (searchBatchKey, int64, error) {
+	var placeholderGroup milvuspb.PlaceholderGroup
+	if err := proto.Unmarshal(msg.PlaceholderGroup, &placeholderGroup); err != nil {
+		return searchBatchKey{}, 0, err
+	}
+	if len(placeholderGroup.Placeholders) != 1 {
+		return searchBatchKey{}, 0, fmt.Errorf("search with %d placeholders can't be batched", len(placeholderGroup.Placeholders))
+	}
+	placeholder := placeholderGroup.Placeholders[0]
+
+	key := searchBatchKey{
+		dslType:         msg.DslType,
+		partitionIDs:    fmt.Sprint(msg.PartitionIDs),
+		outputFieldIDs:  fmt.Sprint(msg.OutputFieldsId),
+		tag:             placeholder.Tag,
+		placeholderType: placeholder.Type,
+	}
+	if msg.DslType == commonpb.DslType_BoolExprV1 {
+		key.plan = string(msg.SerializedExprPlan)
+	} else {
+		key.plan = msg.Dsl
+	}
+	return key, int64(len(placeholder.Values)), nil
+}
+
+// groupQueryMsgs groups the search messages which can be searched in one batch, the number of queries
+// of a batch is no more than maxNQ unless it has a single message, and the travel timestamps of a batch are
+// no more than timeWindow milliseconds apart from the one of its first message. The retrieve messages and
+// the search messages which can't be batched are in their own groups. The groups are in the order of their
+// first messages, and maxNQ <= 0 disables batching.
+func groupQueryMsgs(msgs []queryMsg, maxNQ int64, timeWindow int64) [][]queryMsg {
+	groups := make([][]queryMsg, 0, len(msgs))
+	if maxNQ <= 0 {
+		for _, msg := range msgs {
+			groups = append(groups, []queryMsg{msg})
+		}
+		return groups
+	}
+
+	type openGroup struct {
+		index         int
+		nq            int64
+		firstTravelTs time.Time
+	}
+	openGroups := make(map[searchBatchKey]*openGroup)
+	for _, msg := range msgs {
+		searchMsg, ok := msg.(*msgstream.SearchMsg)
+		if !ok {
+			groups = append(groups, []queryMsg{msg})
+			continue
+		}
+		key, nq, err := getSearchBatchKey(searchMsg)
+		if err != nil {
+			groups = append(groups, []queryMsg{msg})
+			continue
+		}
+		travelTs, _ := tsoutil.ParseTS(searchMsg.TravelTimestamp)
+		if group, ok := openGroups[key]; ok && group.nq+nq <= maxNQ && inTimeWindow(group.firstTravelTs, travelTs, timeWindow) {
+			groups[group.index] = append(groups[group.index], msg)
+			group.nq += nq
+			continue
+		}
+		openGroups[key] = &openGroup{index: len(groups), nq: nq, firstTravelTs: travelTs}
+		groups = append(groups, []queryMsg{msg})
+	}
+	return groups
+}
+
+// inTimeWindow returns whether ts is no more than timeWindow milliseconds apart from first
+func inTimeWindow(first time.Time, ts time.Time, timeWindow int64) bool {
+	diff := ts.Sub(first)
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff <= time.Duration(timeWindow)*time.Millisecond
+}
+
+// getQueryTravelTimestamps returns the travel timestamp of every query of the batch, nqs are the numbers of
+// queries of the messages. A single timestamp is returned for a single message, which all of its queries travel to.
+func getQueryTravelTimestamps(msgs []*msgstream.SearchMsg, nqs []int64) []Timestamp {
+	if len(msgs) == 1 {
+		return []Timestamp{msgs[0].TravelTimestamp}
+	}
+	var total int64
+	for _, nq := range nqs {
+		total += nq
+	}
+	timestamps := make([]Timestamp, 0, total)
+	for i, msg := range msgs {
+		for j := int64(0); j < nqs[i]; j++ {
+			timestamps = append(timestamps, msg.TravelTimestamp)
+		}
+	}
+	return timestamps
+}
+
+// mergePlaceholderGroups merges the placeholder groups of a batch into one, with the queries of every
+// message in order, and returns the number of queries of each message
+func mergePlaceholderGroups(blobs [][]byte) ([]byte, []int64, error) {
+	if len(blobs) == 0 {
+		return nil, nil, errors.New("no placeholder group to merge")
+	}
+	var merged *milvuspb.PlaceholderValue
+	nqs := make([]int64, 0, len(blobs))
+	for _, blob := range blobs {
+		var placeholderGroup milvuspb.PlaceholderGroup
+		if err := proto.Unmarshal(blob, &placeholderGroup); err != nil {
+			return nil, nil, err
+		}
+		if len(placeholderGroup.Placeholders) != 1 {
+			return nil, nil, fmt.Errorf("search with %d placeholders can't be batched", len(placeholderGroup.Placeholders))
+		}
+		placeholder := placeholderGroup.Placeholders[0]
+		if merged == nil {
+			merged = &milvuspb.PlaceholderValue{
+				Tag:  placeholder.Tag,
+				Type: placeholder.Type,
+			}
+		} else if placeholder.Tag != merged.Tag || placeholder.Type != merged.Type {
+			return nil, nil, errors.New("placeholders of different tags or types can't be batched")
+		}
+		merged.Values = append(merged.Values, placeholder.Values...)
+		nqs = append(nqs, int64(len(placeholder.Values)))
+	}
+	blob, err := proto.Marshal(&milvuspb.PlaceholderGroup{
+		Placeholders: []*milvuspb.PlaceholderValue{merged},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return blob, nqs, nil
+}
+
+// splitHits splits the hits of the queries of a batch back to the messages by their numbers of queries
+func splitHits(hits [][]byte, nqs []int64) ([][][]byte, error) {
+	var total int64
+	for _, nq := range nqs {
+		total += nq
+	}
+	if total != int64(len(hits)) {
+		return nil, fmt.Errorf("%d hits can't be split to %d queries", len(hits), total)
+	}
+	res := make([][][]byte, 0, len(nqs))
+	var offset int64
+	for _, nq := range nqs {
+		res = append(res, hits[offset:offset+nq])
+		offset += nq
+	}
+	return res, nil
+}
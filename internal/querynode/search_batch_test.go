@@ -0,0 +1,165 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func genBatchPlaceholderGroup(t *testing.T, values ...[]byte) []byte {
+	blob, err := proto.Marshal(&milvuspb.PlaceholderGroup{
+		Placeholders: []*milvuspb.PlaceholderValue{
+			{
+				Tag:    "$0",
+				Type:   milvuspb.PlaceholderType_FloatVector,
+				Values: values,
+			},
+		},
+	})
+	assert.NoError(t, err)
+	return blob
+}
+
+func genBatchSearchMsg(t *testing.T, msgID UniqueID, expr string, travelTs Timestamp, nq int) *msgstream.SearchMsg {
+	values := make([][]byte, 0, nq)
+	for i := 0; i < nq; i++ {
+		values = append(values, []byte{byte(msgID), byte(i)})
+	}
+	return &msgstream.SearchMsg{
+		SearchRequest: internalpb.SearchRequest{
+			Base:               &commonpb.MsgBase{MsgType: commonpb.MsgType_Search, MsgID: msgID},
+			DslType:            commonpb.DslType_BoolExprV1,
+			SerializedExprPlan: []byte(expr),
+			PlaceholderGroup:   genBatchPlaceholderGroup(t, values...),
+			TravelTimestamp:    travelTs,
+		},
+	}
+}
+
+func TestSearchBatch_groupQueryMsgs(t *testing.T) {
+	ts := tsoutil.ComposeTS(1000, 0)
+	msgs := []queryMsg{
+		genBatchSearchMsg(t, 1, "a", ts, 1),
+		genBatchSearchMsg(t, 2, "b", ts, 1),
+		&msgstream.RetrieveMsg{RetrieveRequest: internalpb.RetrieveRequest{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_Retrieve, MsgID: 3}}},
+		genBatchSearchMsg(t, 4, "a", tsoutil.ComposeTS(1005, 1), 2),
+		genBatchSearchMsg(t, 5, "a", tsoutil.ComposeTS(1011, 0), 1),
+		genBatchSearchMsg(t, 6, "a", ts, 1),
+	}
+	groupIDs := func(groups [][]queryMsg) [][]UniqueID {
+		res := make([][]UniqueID, 0, len(groups))
+		for _, group := range groups {
+			ids := make([]UniqueID, 0, len(group))
+			for _, msg := range group {
+				ids = append(ids, msg.ID())
+			}
+			res = append(res, ids)
+		}
+		return res
+	}
+
+	t.Run("same plan in the time window", func(t *testing.T) {
+		groups := groupQueryMsgs(msgs, 1024, 10)
+		assert.Equal(t, [][]UniqueID{{1, 4, 6}, {2}, {3}, {5}}, groupIDs(groups))
+	})
+
+	t.Run("same travel timestamp", func(t *testing.T) {
+		groups := groupQueryMsgs(msgs, 1024, 0)
+		assert.Equal(t, [][]UniqueID{{1, 6}, {2}, {3}, {4}, {5}}, groupIDs(groups))
+	})
+
+	t.Run("max nq", func(t *testing.T) {
+		groups := groupQueryMsgs(msgs, 3, 10)
+		assert.Equal(t, [][]UniqueID{{1, 4}, {2}, {3}, {5}, {6}}, groupIDs(groups))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		groups := groupQueryMsgs(msgs, 0, 10)
+		assert.Equal(t, [][]UniqueID{{1}, {2}, {3}, {4}, {5}, {6}}, groupIDs(groups))
+	})
+}
+
+func TestSearchBatch_groupDefaultSearches(t *testing.T) {
+	// the proxy sets the travel timestamp of a search without one to its BeginTs allocated from TSO,
+	// so the searches sent together travel to distinct timestamps close to each other
+	now := time.Now()
+	msgs := make([]queryMsg, 0, 4)
+	for i := 0; i < 4; i++ {
+		beginTs := tsoutil.ComposeTS(now.Add(time.Duration(i)*time.Millisecond).UnixNano()/int64(time.Millisecond), int64(i))
+		msgs = append(msgs, genBatchSearchMsg(t, UniqueID(i+1), "a", beginTs, 1))
+	}
+	groups := groupQueryMsgs(msgs, Params.SearchBatchMaxNQ, Params.SearchBatchTimeWindow)
+	assert.Equal(t, 1, len(groups))
+	assert.Equal(t, 4, len(groups[0]))
+
+	// every query of the batch still travels to the timestamp of its own search
+	searchMsgs := make([]*msgstream.SearchMsg, 0, len(groups[0]))
+	for _, msg := range groups[0] {
+		searchMsgs = append(searchMsgs, msg.(*msgstream.SearchMsg))
+	}
+	timestamps := getQueryTravelTimestamps(searchMsgs, []int64{1, 1, 1, 1})
+	for i, msg := range searchMsgs {
+		assert.Equal(t, msg.TravelTimestamp, timestamps[i])
+	}
+}
+
+func TestSearchBatch_getQueryTravelTimestamps(t *testing.T) {
+	msgs := []*msgstream.SearchMsg{
+		genBatchSearchMsg(t, 1, "a", 10, 2),
+		genBatchSearchMsg(t, 2, "a", 20, 1),
+	}
+	assert.Equal(t, []Timestamp{10}, getQueryTravelTimestamps(msgs[:1], []int64{2}))
+	assert.Equal(t, []Timestamp{10, 10, 20}, getQueryTravelTimestamps(msgs, []int64{2, 1}))
+}
+
+func TestSearchBatch_mergePlaceholderGroups(t *testing.T) {
+	blob, nqs, err := mergePlaceholderGroups([][]byte{
+		genBatchPlaceholderGroup(t, []byte{1}),
+		genBatchPlaceholderGroup(t, []byte{2}, []byte{3}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, nqs)
+	var merged milvuspb.PlaceholderGroup
+	assert.NoError(t, proto.Unmarshal(blob, &merged))
+	assert.Equal(t, 1, len(merged.Placeholders))
+	assert.Equal(t, "$0", merged.Placeholders[0].Tag)
+	assert.Equal(t, [][]byte{{1}, {2}, {3}}, merged.Placeholders[0].Values)
+
+	binary, err := proto.Marshal(&milvuspb.PlaceholderGroup{
+		Placeholders: []*milvuspb.PlaceholderValue{
+			{Tag: "$0", Type: milvuspb.PlaceholderType_BinaryVector, Values: [][]byte{{4}}},
+		},
+	})
+	assert.NoError(t, err)
+	_, _, err = mergePlaceholderGroups([][]byte{genBatchPlaceholderGroup(t, []byte{1}), binary})
+	assert.Error(t, err)
+}
+
+func TestSearchBatch_splitHits(t *testing.T) {
+	hits := [][]byte{{1}, {2}, {3}}
+	res, err := splitHits(hits, []int64{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, [][][]byte{{{1}}, {{2}, {3}}}, res)
+
+	_, err = splitHits(hits, []int64{1, 1})
+	assert.Error(t, err)
+}
@@ -25,6 +25,7 @@ import "C"
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"sync"
 	"unsafe"
@@ -70,6 +71,9 @@ type Segment struct {
 
 	paramMutex sync.RWMutex // guards index
 	indexInfos map[int64]*indexInfo
+
+	// string primary keys of the segment, nil if the primary key is not of string type
+	strPKIndex *stringPKIndex
 }
 
 //-------------------------------------------------------------------------------------- common interfaces
@@ -153,6 +157,9 @@ func newSegment(collection *Collection, segmentID int64, partitionID UniqueID, c
 		onService:    onService,
 		indexInfos:   indexInfos,
 	}
+	if pkField := getStringPKField(collection.schema); pkField != nil {
+		newSegment.strPKIndex = newStringPKIndex(pkField.FieldID)
+	}
 
 	return newSegment
 }
@@ -211,6 +218,8 @@ func (s *Segment) getMemSize() int64 {
 	return int64(memoryUsageInBytes)
 }
 
+// segmentSearch searches the segment at the travel timestamp, timestamp has a single timestamp for all the queries
+// or one for each query of the search requests
 func (s *Segment) segmentSearch(plan *Plan,
 	searchRequests []*searchRequest,
 	timestamp []Timestamp) (*SearchResult, error) {
@@ -232,11 +241,17 @@ func (s *Segment) segmentSearch(plan *Plan,
 	}
 
 	var searchResult SearchResult
-	ts := C.uint64_t(timestamp[0])
 	cPlaceHolderGroup := cPlaceholderGroups[0]
 
 	log.Debug("do search on segment", zap.Int64("segmentID", s.segmentID), zap.Int32("segmentType", int32(s.segmentType)))
-	var status = C.Search(s.segmentPtr, plan.cPlan, cPlaceHolderGroup, ts, &searchResult.cQueryResult)
+	var status C.CStatus
+	if len(timestamp) == 1 {
+		ts := C.uint64_t(timestamp[0])
+		status = C.Search(s.segmentPtr, plan.cPlan, cPlaceHolderGroup, ts, &searchResult.cQueryResult)
+	} else {
+		cTimestamps := (*C.uint64_t)(unsafe.Pointer(&timestamp[0]))
+		status = C.SearchWithTimestamps(s.segmentPtr, plan.cPlan, cPlaceHolderGroup, cTimestamps, C.int64_t(len(timestamp)), &searchResult.cQueryResult)
+	}
 	errorCode := status.error_code
 
 	if errorCode != 0 {
@@ -331,6 +346,20 @@ func (s *Segment) setBuildID(fieldID int64, id UniqueID) error {
 	return nil
 }
 
+// getIndexedFieldIDs returns the ids of the fields with index info in ascending order
+func (s *Segment) getIndexedFieldIDs() []int64 {
+	s.paramMutex.Lock()
+	defer s.paramMutex.Unlock()
+	fieldIDs := make([]int64, 0, len(s.indexInfos))
+	for fieldID := range s.indexInfos {
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+	sort.Slice(fieldIDs, func(i, j int) bool {
+		return fieldIDs[i] < fieldIDs[j]
+	})
+	return fieldIDs
+}
+
 func (s *Segment) getIndexName(fieldID int64) string {
 	s.paramMutex.Lock()
 	defer s.paramMutex.Unlock()
@@ -606,8 +635,8 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interfa
 		}
 		dataPointer = unsafe.Pointer(&d[0])
 	case []string:
-		// TODO: support string type
-		return errors.New("we cannot support string type now")
+		// string primary keys are loaded as row IDs, see string_pk.go
+		return errors.New("only string primary key is supported, which should be loaded as row IDs")
 	default:
 		return errors.New("illegal field data type")
 	}
@@ -25,7 +25,9 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	queryPb "github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 )
@@ -47,12 +49,16 @@ type segmentLoader struct {
 	indexLoader *indexLoader
 }
 
+// loadSegmentOfConditionHandOff loads the segments out of service, they are put on service
+// along with releasing the segments they replace by historical.handoffSegments
 func (loader *segmentLoader) loadSegmentOfConditionHandOff(req *queryPb.LoadSegmentsRequest) error {
-	return errors.New("TODO: implement hand off")
+	return loader.loadSegment(req, false)
 }
 
+// loadSegmentOfConditionLoadBalance puts the segments moved to the query node on service once loaded, they are
+// released by the source query node afterwards, and the results searched on both in between are deduplicated by proxy
 func (loader *segmentLoader) loadSegmentOfConditionLoadBalance(req *queryPb.LoadSegmentsRequest) error {
-	return loader.loadSegment(req, false)
+	return loader.loadSegment(req, true)
 }
 
 func (loader *segmentLoader) loadSegmentOfConditionGRPC(req *queryPb.LoadSegmentsRequest) error {
@@ -63,6 +69,16 @@ func (loader *segmentLoader) loadSegmentOfConditionNodeDown(req *queryPb.LoadSeg
 	return loader.loadSegment(req, true)
 }
 
+// checkSegmentMemory checks whether the query node has enough memory to load the segments by their
+// estimated sizes, usedMemory is the memory used by the segments loaded on the query node
+func (loader *segmentLoader) checkSegmentMemory(infos []*queryPb.SegmentLoadInfo, usedMemory int64) error {
+	var segmentSize int64
+	for _, info := range infos {
+		segmentSize += info.SegmentSize
+	}
+	return checkLoadMemory(segmentSize, usedMemory, getTotalMemory())
+}
+
 func (loader *segmentLoader) loadSegment(req *queryPb.LoadSegmentsRequest, onService bool) error {
 	// no segment needs to load, return
 	if len(req.Infos) == 0 {
@@ -97,7 +113,7 @@ func (loader *segmentLoader) loadSegment(req *queryPb.LoadSegmentsRequest, onSer
 			continue
 		}
 		segment := newSegment(collection, segmentID, partitionID, collectionID, "", segmentTypeSealed, onService)
-		err = loader.loadSegmentInternal(collectionID, segment, info.BinlogPaths)
+		err = loader.loadSegmentInternal(collectionID, segment, info.BinlogPaths, info.Deltalogs)
 		if err != nil {
 			deleteSegment(segment)
 			log.Error(err.Error())
@@ -109,7 +125,7 @@ func (loader *segmentLoader) loadSegment(req *queryPb.LoadSegmentsRequest, onSer
 			log.Error(err.Error())
 			continue
 		}
-		if onService {
+		if onService || req.LoadCondition == queryPb.TriggerCondition_handoff {
 			key := fmt.Sprintf("%s/%d", queryCoordSegmentMetaPrefix, segmentID)
 			value, err := loader.etcdKV.Load(key)
 			if err != nil {
@@ -140,7 +156,8 @@ func (loader *segmentLoader) loadSegment(req *queryPb.LoadSegmentsRequest, onSer
 
 func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID,
 	segment *Segment,
-	binlogPaths []*datapb.FieldBinlog) error {
+	binlogPaths []*datapb.FieldBinlog,
+	deltaLogs []*datapb.DeltaLogInfo) error {
 
 	vectorFieldIDs, err := loader.historicalReplica.getVecFieldIDsByCollectionID(collectionID)
 	if err != nil {
@@ -172,7 +189,8 @@ func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID,
 		}
 	}
 
-	return nil
+	log.Debug("loading delta logs...")
+	return loader.loadDeltaLogs(segment, deltaLogs)
 }
 
 func (loader *segmentLoader) GetSegmentStates(segmentID UniqueID) (*datapb.GetSegmentStatesResponse, error) {
@@ -216,7 +234,15 @@ func (loader *segmentLoader) filterOutVectorFields(binlogPaths []*datapb.FieldBi
 }
 
 func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, binlogPaths []*datapb.FieldBinlog) error {
-	iCodec := storage.InsertCodec{}
+	collection, err := loader.historicalReplica.getCollectionByID(segment.collectionID)
+	if err != nil {
+		return err
+	}
+	// with the schema of the collection, the fields added after the segment is sealed read the default values
+	iCodec := storage.NewInsertCodec(&etcdpb.CollectionMeta{
+		ID:     segment.collectionID,
+		Schema: collection.Schema(),
+	})
 	defer func() {
 		err := iCodec.Close()
 		if err != nil {
@@ -254,6 +280,19 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, binlogPaths
 		log.Error(err.Error())
 		return err
 	}
+	if segment.strPKIndex != nil {
+		pkFieldID := segment.strPKIndex.fieldID
+		pks, ok := insertData.Data[pkFieldID].(*storage.StringFieldData)
+		if !ok {
+			return fmt.Errorf("string primary key field %d not found in segment %d", pkFieldID, segment.segmentID)
+		}
+		rowIDs, ok := insertData.Data[rootcoord.RowIDField].(*storage.Int64FieldData)
+		if !ok {
+			return fmt.Errorf("row id field not found in segment %d", segment.segmentID)
+		}
+		segment.strPKIndex.add(pks.Data, rowIDs.Data)
+		insertData.Data[pkFieldID] = rowIDs
+	}
 	for fieldID, value := range insertData.Data {
 		var numRows int
 		var data interface{}
@@ -279,7 +318,7 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, binlogPaths
 		case *storage.DoubleFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
-		case storage.StringFieldData:
+		case *storage.StringFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
 		case *storage.FloatVectorFieldData:
@@ -301,6 +340,64 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, binlogPaths
 	return nil
 }
 
+// loadDeltaLogs applies the delete records persisted in delta logs to the segment
+func (loader *segmentLoader) loadDeltaLogs(segment *Segment, deltaLogs []*datapb.DeltaLogInfo) error {
+	if len(deltaLogs) == 0 {
+		return nil
+	}
+	dCodec := storage.NewDeleteCodec()
+	defer func() {
+		err := dCodec.Close()
+		if err != nil {
+			log.Error(err.Error())
+		}
+	}()
+	blobs := make([]*storage.Blob, 0)
+	for _, deltaLog := range deltaLogs {
+		log.Debug("load segment delta logs",
+			zap.Int64("segmentID", segment.segmentID),
+			zap.String("path", deltaLog.DeltaLogPath),
+		)
+		value, err := loader.minioKV.Load(deltaLog.DeltaLogPath)
+		if err != nil {
+			return err
+		}
+		blobs = append(blobs, &storage.Blob{
+			Key:   deltaLog.DeltaLogPath,
+			Value: []byte(value),
+		})
+	}
+
+	_, _, deleteData, err := dCodec.Deserialize(blobs)
+	if err != nil {
+		log.Error(err.Error())
+		return err
+	}
+	if deleteData.RowCount == 0 {
+		return nil
+	}
+
+	pks, tss := deleteData.Pks, deleteData.Tss
+	if len(deleteData.StrPks) > 0 {
+		if segment.strPKIndex == nil {
+			return fmt.Errorf("string primary keys deleted from segment %d keyed by int64", segment.segmentID)
+		}
+		pks, tss = nil, nil
+		for i, pk := range deleteData.StrPks {
+			for _, rowID := range segment.strPKIndex.getRowIDs(pk) {
+				pks = append(pks, rowID)
+				tss = append(tss, deleteData.Tss[i])
+			}
+		}
+		if len(pks) == 0 {
+			return nil
+		}
+	}
+
+	offset := segment.segmentPreDelete(len(pks))
+	return segment.segmentDelete(offset, &pks, &tss)
+}
+
 func newSegmentLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord types.IndexCoord, dataCoord types.DataCoord, replica ReplicaInterface, etcdKV *etcdkv.EtcdKV) *segmentLoader {
 	option := &minioKV.Option{
 		Address:           Params.MinioEndPoint,
@@ -34,10 +34,10 @@ type streaming struct {
 	msFactory       msgstream.Factory
 }
 
-func newStreaming(ctx context.Context, factory msgstream.Factory, etcdKV *etcdkv.EtcdKV) *streaming {
+func newStreaming(ctx context.Context, factory msgstream.Factory, etcdKV *etcdkv.EtcdKV, historicalReplica ReplicaInterface) *streaming {
 	replica := newCollectionReplica(etcdKV)
 	tReplica := newTSafeReplica()
-	newDS := newDataSyncService(ctx, replica, tReplica, factory)
+	newDS := newDataSyncService(ctx, replica, historicalReplica, tReplica, factory)
 
 	return &streaming{
 		replica:         replica,
@@ -66,7 +66,8 @@ func (s *streaming) search(searchReqs []*searchRequest,
 	partIDs []UniqueID,
 	vChannel Channel,
 	plan *Plan,
-	searchTs Timestamp) ([]*SearchResult, []*Segment, error) {
+	searchTs []Timestamp,
+	guaranteeTs Timestamp) ([]*SearchResult, []*Segment, error) {
 
 	searchResults := make([]*SearchResult, 0)
 	segmentResults := make([]*Segment, 0)
@@ -153,7 +154,8 @@ func (s *streaming) search(searchReqs []*searchRequest,
 				return searchResults, segmentResults, err
 			}
 
-			// TSafe less than searchTs means this vChannel is not available
+			// TSafe less than guaranteeTs means this vChannel is not available,
+			// the guarantee timestamp may be earlier than searchTs under weak consistency levels
 			ts := s.tSafeReplica.getTSafe(seg.vChannelID)
 			gracefulTimeInMilliSecond := Params.GracefulTime
 			if gracefulTimeInMilliSecond > 0 {
@@ -161,19 +163,19 @@ func (s *streaming) search(searchReqs []*searchRequest,
 				ts += gracefulTime
 			}
 			tsp, _ := tsoutil.ParseTS(ts)
-			stp, _ := tsoutil.ParseTS(searchTs)
+			gtp, _ := tsoutil.ParseTS(guaranteeTs)
 			log.Debug("timestamp check in streaming search",
 				zap.Any("collectionID", collID),
 				zap.Any("serviceTime_l", ts),
-				zap.Any("searchTime_l", searchTs),
+				zap.Any("guaranteeTime_l", guaranteeTs),
 				zap.Any("serviceTime_p", tsp),
-				zap.Any("searchTime_p", stp),
+				zap.Any("guaranteeTime_p", gtp),
 			)
-			if ts < searchTs {
+			if ts < guaranteeTs {
 				continue
 			}
 
-			searchResult, err := seg.segmentSearch(plan, searchReqs, []Timestamp{searchTs})
+			searchResult, err := seg.segmentSearch(plan, searchReqs, searchTs)
 			if err != nil {
 				return searchResults, segmentResults, err
 			}
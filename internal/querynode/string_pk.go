@@ -0,0 +1,284 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/rootcoord"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// Segcore only supports int64 primary keys. For a collection keyed by strings, segcore is given a schema
+// whose primary key field is of int64 type and holds the row ID of every entity, and each segment keeps
+// the mapping between its string primary keys and row IDs. Row IDs are unique among all the segments,
+// so the string primary keys in requests are translated into row IDs, and the row IDs in results back.
+
+// stringPKIndex maps the string primary keys of a segment to row IDs and vice versa
+type stringPKIndex struct {
+	fieldID UniqueID // the primary key field
+
+	mu        sync.RWMutex // guards pk2RowIDs and rowID2PK
+	pk2RowIDs map[string][]UniqueID
+	rowID2PK  map[UniqueID]string
+}
+
+func newStringPKIndex(fieldID UniqueID) *stringPKIndex {
+	return &stringPKIndex{
+		fieldID:   fieldID,
+		pk2RowIDs: make(map[string][]UniqueID),
+		rowID2PK:  make(map[UniqueID]string),
+	}
+}
+
+func (idx *stringPKIndex) add(pks []string, rowIDs []UniqueID) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for i, pk := range pks {
+		idx.pk2RowIDs[pk] = append(idx.pk2RowIDs[pk], rowIDs[i])
+		idx.rowID2PK[rowIDs[i]] = pk
+	}
+}
+
+// getRowIDs returns the row IDs of all the entities inserted with primary key pk
+func (idx *stringPKIndex) getRowIDs(pk string) []UniqueID {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.pk2RowIDs[pk]
+}
+
+func (idx *stringPKIndex) getPK(rowID UniqueID) (string, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	pk, ok := idx.rowID2PK[rowID]
+	return pk, ok
+}
+
+// getStringPKField returns the primary key field of schema if it's of string type, otherwise nil
+func getStringPKField(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
+	for _, field := range schema.GetFields() {
+		if field.IsPrimaryKey && field.DataType == schemapb.DataType_String {
+			return field
+		}
+	}
+	return nil
+}
+
+// getSegcoreSchema returns the schema passed to segcore, in which a string primary key field is of int64 type
+func getSegcoreSchema(schema *schemapb.CollectionSchema) *schemapb.CollectionSchema {
+	if getStringPKField(schema) == nil {
+		return schema
+	}
+	segcoreSchema := proto.Clone(schema).(*schemapb.CollectionSchema)
+	getStringPKField(segcoreSchema).DataType = schemapb.DataType_Int64
+	return segcoreSchema
+}
+
+// rewriteStringPKRows parses the string primary keys out of the rows inserted, and rewrites the rows in
+// the layout of segcore. Strings are appended after all the fixed-length fields by proxy, each prefixed
+// with the uint32 length in bytes, while segcore expects the row ID in place of the primary key.
+func rewriteStringPKRows(schema *schemapb.CollectionSchema, rowIDs []UniqueID, records []*commonpb.Blob) ([]string, []*commonpb.Blob, error) {
+	pkField := getStringPKField(schema)
+	fieldsBeforePK := make([]*schemapb.FieldSchema, 0)
+	userFields := make([]*schemapb.FieldSchema, 0)
+	pkSeen := false
+	for _, field := range schema.Fields {
+		if field.FieldID == pkField.FieldID {
+			pkSeen = true
+			continue
+		}
+		if field.FieldID < rootcoord.StartOfUserFieldID {
+			continue
+		}
+		if !pkSeen {
+			fieldsBeforePK = append(fieldsBeforePK, field)
+		}
+		userFields = append(userFields, field)
+	}
+	pkOffset, err := typeutil.EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: fieldsBeforePK})
+	if err != nil {
+		return nil, nil, err
+	}
+	fixedSize, err := typeutil.EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: userFields})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pks := make([]string, 0, len(records))
+	rows := make([]*commonpb.Blob, 0, len(records))
+	for i, record := range records {
+		value := record.GetValue()
+		if len(value) < fixedSize+4 {
+			return nil, nil, fmt.Errorf("invalid row of %d bytes, string primary key not found", len(value))
+		}
+		l := int(binary.LittleEndian.Uint32(value[fixedSize:]))
+		if len(value) != fixedSize+4+l {
+			return nil, nil, fmt.Errorf("invalid row of %d bytes, string primary key of %d bytes", len(value), l)
+		}
+		pks = append(pks, string(value[fixedSize+4:]))
+
+		row := make([]byte, fixedSize+8)
+		copy(row, value[:pkOffset])
+		binary.LittleEndian.PutUint64(row[pkOffset:], uint64(rowIDs[i]))
+		copy(row[pkOffset+8:], value[pkOffset:fixedSize])
+		rows = append(rows, &commonpb.Blob{Value: row})
+	}
+	return pks, rows, nil
+}
+
+// getSegmentsOfCollection returns all the segments of a collection in replica
+func getSegmentsOfCollection(replica ReplicaInterface, collectionID UniqueID) ([]*Segment, error) {
+	if !replica.hasCollection(collectionID) {
+		return nil, nil
+	}
+	partitionIDs, err := replica.getPartitionIDs(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]*Segment, 0)
+	for _, partitionID := range partitionIDs {
+		segmentIDs, err := replica.getSegmentIDs(partitionID)
+		if err != nil {
+			return nil, err
+		}
+		for _, segmentID := range segmentIDs {
+			segment, err := replica.getSegmentByID(segmentID)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, segment)
+		}
+	}
+	return segments, nil
+}
+
+// lookupRowIDs returns the row IDs of the string primary keys in segments
+func lookupRowIDs(segments []*Segment, pks []string) []UniqueID {
+	rowIDs := make([]UniqueID, 0, len(pks))
+	for _, segment := range segments {
+		if segment.strPKIndex == nil {
+			continue
+		}
+		for _, pk := range pks {
+			rowIDs = append(rowIDs, segment.strPKIndex.getRowIDs(pk)...)
+		}
+	}
+	return rowIDs
+}
+
+// lookupStringPKs returns the string primary keys of the row IDs in segments
+func lookupStringPKs(segments []*Segment, rowIDs []UniqueID) ([]string, error) {
+	pks := make([]string, 0, len(rowIDs))
+	for _, rowID := range rowIDs {
+		found := false
+		for _, segment := range segments {
+			if segment.strPKIndex == nil {
+				continue
+			}
+			if pk, ok := segment.strPKIndex.getPK(rowID); ok {
+				pks = append(pks, pk)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("string primary key of row %d not found", rowID)
+		}
+	}
+	return pks, nil
+}
+
+// translateStringPKExpr replaces the string primary keys in the term expressions of a serialized plan
+// with the row IDs of segments
+func translateStringPKExpr(serializedPlan []byte, pkField *schemapb.FieldSchema, segments []*Segment) ([]byte, error) {
+	planNode := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, planNode); err != nil {
+		return nil, err
+	}
+
+	var translate func(expr *planpb.Expr) error
+	translate = func(expr *planpb.Expr) error {
+		switch e := expr.GetExpr().(type) {
+		case *planpb.Expr_TermExpr:
+			if e.TermExpr.GetColumnInfo().GetFieldId() != pkField.FieldID {
+				return nil
+			}
+			pks := make([]string, 0, len(e.TermExpr.Values))
+			for _, value := range e.TermExpr.Values {
+				pks = append(pks, value.GetStringVal())
+			}
+			values := make([]*planpb.GenericValue, 0, len(pks))
+			for _, rowID := range lookupRowIDs(segments, pks) {
+				values = append(values, &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: rowID}})
+			}
+			e.TermExpr.ColumnInfo.DataType = schemapb.DataType_Int64
+			e.TermExpr.Values = values
+		case *planpb.Expr_RangeExpr:
+			if e.RangeExpr.GetColumnInfo().GetFieldId() == pkField.FieldID {
+				return fmt.Errorf("range expr on string primary key %s is not supported", pkField.Name)
+			}
+		case *planpb.Expr_UnaryExpr:
+			return translate(e.UnaryExpr.Child)
+		case *planpb.Expr_BinaryExpr:
+			if err := translate(e.BinaryExpr.Left); err != nil {
+				return err
+			}
+			return translate(e.BinaryExpr.Right)
+		}
+		return nil
+	}
+	if predicates := planNode.GetVectorAnns().GetPredicates(); predicates != nil {
+		if err := translate(predicates); err != nil {
+			return nil, err
+		}
+	}
+	return proto.Marshal(planNode)
+}
+
+// translateStringPKResults replaces the row IDs in ids and the primary key field of fieldsData with
+// the string primary keys, fieldsData[pkIndex] is the primary key field, pkIndex -1 means it's not output
+func translateStringPKResults(ids *schemapb.IDs, fieldsData []*schemapb.FieldData, pkIndex int, segments []*Segment) error {
+	if ids.GetIntId() != nil {
+		pks, err := lookupStringPKs(segments, ids.GetIntId().GetData())
+		if err != nil {
+			return err
+		}
+		ids.IdField = &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: pks}}
+	}
+	if pkIndex < 0 || pkIndex >= len(fieldsData) {
+		return nil
+	}
+	pkData := fieldsData[pkIndex]
+	if pkData.GetScalars().GetLongData() == nil {
+		return nil
+	}
+	pks, err := lookupStringPKs(segments, pkData.GetScalars().GetLongData().GetData())
+	if err != nil {
+		return err
+	}
+	pkData.Type = schemapb.DataType_String
+	pkData.Field = &schemapb.FieldData_Scalars{
+		Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{
+				StringData: &schemapb.StringArray{Data: pks},
+			},
+		},
+	}
+	return nil
+}
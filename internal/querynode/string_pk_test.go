@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func genStringPKSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "string-pk-collection",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 0, Name: "RowID", DataType: schemapb.DataType_Int64},
+			{FieldID: 1, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "age", DataType: schemapb.DataType_Int32},
+			{FieldID: 101, Name: "uuid", IsPrimaryKey: true, DataType: schemapb.DataType_String},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Double},
+		},
+	}
+}
+
+func TestStringPK_getSegcoreSchema(t *testing.T) {
+	schema := genStringPKSchema()
+	segcoreSchema := getSegcoreSchema(schema)
+	assert.Equal(t, schemapb.DataType_Int64, segcoreSchema.Fields[3].DataType)
+	assert.True(t, segcoreSchema.Fields[3].IsPrimaryKey)
+	assert.Equal(t, schemapb.DataType_String, schema.Fields[3].DataType)
+	assert.Equal(t, int64(101), getStringPKField(schema).FieldID)
+	assert.Nil(t, getStringPKField(segcoreSchema))
+}
+
+func TestStringPK_rewriteStringPKRows(t *testing.T) {
+	schema := genStringPKSchema()
+	genRow := func(age int32, uuid string, score float64) *commonpb.Blob {
+		var buf bytes.Buffer
+		_ = binary.Write(&buf, binary.LittleEndian, age)
+		_ = binary.Write(&buf, binary.LittleEndian, score)
+		_ = binary.Write(&buf, binary.LittleEndian, uint32(len(uuid)))
+		buf.WriteString(uuid)
+		return &commonpb.Blob{Value: buf.Bytes()}
+	}
+
+	pks, rows, err := rewriteStringPKRows(schema, []UniqueID{7, 8},
+		[]*commonpb.Blob{genRow(10, "a", 0.5), genRow(20, "bcd", 1.5)})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "bcd"}, pks)
+	assert.Equal(t, 2, len(rows))
+
+	var age int32
+	var rowID int64
+	var score float64
+	buf := bytes.NewReader(rows[1].Value)
+	assert.Nil(t, binary.Read(buf, binary.LittleEndian, &age))
+	assert.Nil(t, binary.Read(buf, binary.LittleEndian, &rowID))
+	assert.Nil(t, binary.Read(buf, binary.LittleEndian, &score))
+	assert.Equal(t, int32(20), age)
+	assert.Equal(t, int64(8), rowID)
+	assert.Equal(t, 1.5, score)
+	assert.Equal(t, 0, buf.Len())
+
+	_, _, err = rewriteStringPKRows(schema, []UniqueID{7}, []*commonpb.Blob{{Value: []byte{1, 2}}})
+	assert.NotNil(t, err)
+}
+
+func TestStringPK_translate(t *testing.T) {
+	seg1 := &Segment{strPKIndex: newStringPKIndex(101)}
+	seg1.strPKIndex.add([]string{"a", "b", "a"}, []UniqueID{1, 2, 3})
+	seg2 := &Segment{strPKIndex: newStringPKIndex(101)}
+	seg2.strPKIndex.add([]string{"c"}, []UniqueID{4})
+	segments := []*Segment{seg1, seg2}
+
+	assert.ElementsMatch(t, []UniqueID{1, 3, 4}, lookupRowIDs(segments, []string{"a", "c", "d"}))
+	pks, err := lookupStringPKs(segments, []UniqueID{4, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"c", "b"}, pks)
+	_, err = lookupStringPKs(segments, []UniqueID{5})
+	assert.NotNil(t, err)
+
+	t.Run("expr", func(t *testing.T) {
+		pkField := getStringPKField(genStringPKSchema())
+		planNode := &planpb.PlanNode{
+			Node: &planpb.PlanNode_VectorAnns{
+				VectorAnns: &planpb.VectorANNS{
+					Predicates: &planpb.Expr{
+						Expr: &planpb.Expr_UnaryExpr{
+							UnaryExpr: &planpb.UnaryExpr{
+								Op: planpb.UnaryExpr_Not,
+								Child: &planpb.Expr{
+									Expr: &planpb.Expr_TermExpr{
+										TermExpr: &planpb.TermExpr{
+											ColumnInfo: &planpb.ColumnInfo{FieldId: 101, DataType: schemapb.DataType_String, IsPrimaryKey: true},
+											Values: []*planpb.GenericValue{
+												{Val: &planpb.GenericValue_StringVal{StringVal: "c"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		serialized, err := proto.Marshal(planNode)
+		assert.Nil(t, err)
+		serialized, err = translateStringPKExpr(serialized, pkField, segments)
+		assert.Nil(t, err)
+
+		translated := &planpb.PlanNode{}
+		assert.Nil(t, proto.Unmarshal(serialized, translated))
+		termExpr := translated.GetVectorAnns().GetPredicates().GetUnaryExpr().GetChild().GetTermExpr()
+		assert.Equal(t, schemapb.DataType_Int64, termExpr.ColumnInfo.DataType)
+		assert.Equal(t, 1, len(termExpr.Values))
+		assert.Equal(t, int64(4), termExpr.Values[0].GetInt64Val())
+	})
+
+	t.Run("results", func(t *testing.T) {
+		ids := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{3, 4}}}}
+		fieldsData := []*schemapb.FieldData{
+			{
+				Type: schemapb.DataType_Int64,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{3, 4}}},
+					},
+				},
+			},
+		}
+		err := translateStringPKResults(ids, fieldsData, 0, segments)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "c"}, ids.GetStrId().GetData())
+		assert.Equal(t, []string{"a", "c"}, fieldsData[0].GetScalars().GetStringData().GetData())
+	})
+}
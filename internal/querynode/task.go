@@ -340,9 +340,21 @@ func (l *loadSegmentsTask) Execute(ctx context.Context) error {
 	log.Debug("query node load segment", zap.String("loadSegmentRequest", fmt.Sprintln(l.req)))
 	var err error
 
+	// the handed off segments replace the growing ones in memory, which are not checked
+	if l.req.LoadCondition != queryPb.TriggerCondition_handoff {
+		err = l.node.historical.loader.checkSegmentMemory(l.req.Infos, l.node.getUsedMemory())
+		if err != nil {
+			log.Warn(err.Error())
+			return err
+		}
+	}
+
 	switch l.req.LoadCondition {
 	case queryPb.TriggerCondition_handoff:
 		err = l.node.historical.loader.loadSegmentOfConditionHandOff(l.req)
+		if err == nil {
+			err = l.node.historical.handoffSegments(l.node.streaming.replica, l.req.Infos)
+		}
 	case queryPb.TriggerCondition_loadBalance:
 		err = l.node.historical.loader.loadSegmentOfConditionLoadBalance(l.req)
 	case queryPb.TriggerCondition_grpcRequest:
@@ -376,6 +376,16 @@ func (ms *metaSnapshot) LoadWithPrefix(key string, ts typeutil.Timestamp) ([]str
 }
 
 func (ms *metaSnapshot) MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string, addition func(ts typeutil.Timestamp) (string, string, error)) (typeutil.Timestamp, error) {
+	return ms.multiSaveAndRemove(saves, removals, addition, clientv3.WithPrefix())
+}
+
+// MultiSaveAndRemove saves the kvs and removes the keys in one transaction, only the keys
+// exactly matching removals are removed
+func (ms *metaSnapshot) MultiSaveAndRemove(saves map[string]string, removals []string, addition func(ts typeutil.Timestamp) (string, string, error)) (typeutil.Timestamp, error) {
+	return ms.multiSaveAndRemove(saves, removals, addition)
+}
+
+func (ms *metaSnapshot) multiSaveAndRemove(saves map[string]string, removals []string, addition func(ts typeutil.Timestamp) (string, string, error), opts ...clientv3.OpOption) (typeutil.Timestamp, error) {
 	ms.lock.Lock()
 	defer ms.lock.Unlock()
 	ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
@@ -393,7 +403,7 @@ func (ms *metaSnapshot) MultiSaveAndRemoveWithPrefix(saves map[string]string, re
 		}
 	}
 	for _, key := range removals {
-		ops = append(ops, clientv3.OpDelete(path.Join(ms.root, key), clientv3.WithPrefix()))
+		ops = append(ops, clientv3.OpDelete(path.Join(ms.root, key), opts...))
 	}
 	ops = append(ops, clientv3.OpPut(path.Join(ms.root, ms.tsKey), strTs))
 	resp, err := ms.cli.Txn(ctx).If().Then(ops...).Commit()
@@ -14,7 +14,9 @@ package rootcoord
 import (
 	"fmt"
 	"path"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
@@ -24,7 +26,10 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -32,9 +37,17 @@ const (
 	ComponentPrefix        = "master-service"
 	TenantMetaPrefix       = ComponentPrefix + "/tenant"
 	ProxyMetaPrefix        = ComponentPrefix + "/proxy"
+	DatabaseMetaPrefix     = ComponentPrefix + "/database"
 	CollectionMetaPrefix   = ComponentPrefix + "/collection"
 	SegmentIndexMetaPrefix = ComponentPrefix + "/segment-index"
 	IndexMetaPrefix        = ComponentPrefix + "/index"
+	AliasMetaPrefix        = ComponentPrefix + "/alias"
+	UserMetaPrefix         = ComponentPrefix + "/credential/user"
+	RoleMetaPrefix         = ComponentPrefix + "/credential/role"
+
+	// the meta of dropped collections and partitions is kept within the retention duration
+	DroppedCollectionMetaPrefix = ComponentPrefix + "/dropped-collection"
+	DroppedPartitionMetaPrefix  = ComponentPrefix + "/dropped-partition"
 
 	TimestampPrefix = ComponentPrefix + "/timestamp"
 
@@ -45,21 +58,43 @@ const (
 	DropCollectionDDType   = "DropCollection"
 	CreatePartitionDDType  = "CreatePartition"
 	DropPartitionDDType    = "DropPartition"
+	AddFieldDDType         = "AddField"
+
+	// DefaultDatabaseID is the id of the default database, it is not persisted,
+	// collections created before databases were introduced belong to it
+	DefaultDatabaseID typeutil.UniqueID = 0
+
+	// RootUserName is the built-in user with the admin role, it is not persisted,
+	// its password is configured by common.security.rootPassword
+	RootUserName = "root"
+	// AdminRoleName is the built-in role which has all the privileges, it is not persisted
+	AdminRoleName = "admin"
+	// AnyName matches all the databases or collections in a grant
+	AnyName = "*"
 )
 
 type metaTable struct {
 	client          kv.SnapShotKV                                                   // client of a reliable kv service, i.e. etcd client
 	tenantID2Meta   map[typeutil.UniqueID]pb.TenantMeta                             // tenant id to tenant meta
 	proxyID2Meta    map[typeutil.UniqueID]pb.ProxyMeta                              // proxy id to proxy meta
+	dbID2Meta       map[typeutil.UniqueID]pb.DatabaseInfo                           // database id to database meta
+	dbName2ID       map[string]typeutil.UniqueID                                    // database name to database id
 	collID2Meta     map[typeutil.UniqueID]pb.CollectionInfo                         // collection_id -> meta
-	collName2ID     map[string]typeutil.UniqueID                                    // collection name to collection id
+	collName2ID     map[typeutil.UniqueID]map[string]typeutil.UniqueID              // database id -> collection name -> collection id
+	collAlias2ID    map[typeutil.UniqueID]map[string]typeutil.UniqueID              // database id -> collection alias -> collection id
 	partID2SegID    map[typeutil.UniqueID]map[typeutil.UniqueID]bool                // partition_id -> segment_id -> bool
 	segID2IndexMeta map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo // collection_id/index_id/partition_id/segment_id -> meta
 	indexID2Meta    map[typeutil.UniqueID]pb.IndexInfo                              // collection_id/index_id -> meta
+	userName2Meta   map[string]pb.UserInfo                                          // user name -> user meta
+	roleName2Meta   map[string]pb.RoleInfo                                          // role name -> role meta
+
+	droppedCollID2Meta map[typeutil.UniqueID]pb.CollectionInfo       // dropped collection id -> meta when it's dropped
+	droppedPartID2Meta map[typeutil.UniqueID]pb.DroppedPartitionInfo // dropped partition id -> meta
 
 	tenantLock sync.RWMutex
 	proxyLock  sync.RWMutex
 	ddLock     sync.RWMutex
+	credLock   sync.RWMutex
 }
 
 func NewMetaTable(kv kv.SnapShotKV) (*metaTable, error) {
@@ -68,6 +103,7 @@ func NewMetaTable(kv kv.SnapShotKV) (*metaTable, error) {
 		tenantLock: sync.RWMutex{},
 		proxyLock:  sync.RWMutex{},
 		ddLock:     sync.RWMutex{},
+		credLock:   sync.RWMutex{},
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
@@ -80,11 +116,18 @@ func (mt *metaTable) reloadFromKV() error {
 
 	mt.tenantID2Meta = make(map[typeutil.UniqueID]pb.TenantMeta)
 	mt.proxyID2Meta = make(map[typeutil.UniqueID]pb.ProxyMeta)
+	mt.dbID2Meta = make(map[typeutil.UniqueID]pb.DatabaseInfo)
+	mt.dbName2ID = make(map[string]typeutil.UniqueID)
 	mt.collID2Meta = make(map[typeutil.UniqueID]pb.CollectionInfo)
-	mt.collName2ID = make(map[string]typeutil.UniqueID)
+	mt.collName2ID = make(map[typeutil.UniqueID]map[string]typeutil.UniqueID)
+	mt.collAlias2ID = make(map[typeutil.UniqueID]map[string]typeutil.UniqueID)
 	mt.partID2SegID = make(map[typeutil.UniqueID]map[typeutil.UniqueID]bool)
 	mt.segID2IndexMeta = make(map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo)
 	mt.indexID2Meta = make(map[typeutil.UniqueID]pb.IndexInfo)
+	mt.userName2Meta = make(map[string]pb.UserInfo)
+	mt.roleName2Meta = make(map[string]pb.RoleInfo)
+	mt.droppedCollID2Meta = make(map[typeutil.UniqueID]pb.CollectionInfo)
+	mt.droppedPartID2Meta = make(map[typeutil.UniqueID]pb.DroppedPartitionInfo)
 
 	_, values, err := mt.client.LoadWithPrefix(TenantMetaPrefix, 0)
 	if err != nil {
@@ -114,6 +157,21 @@ func (mt *metaTable) reloadFromKV() error {
 		mt.proxyID2Meta[proxyMeta.ID] = proxyMeta
 	}
 
+	mt.addDatabase(pb.DatabaseInfo{ID: DefaultDatabaseID, Name: Params.DefaultDatabaseName})
+	_, values, err = mt.client.LoadWithPrefix(DatabaseMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		dbInfo := pb.DatabaseInfo{}
+		err = proto.UnmarshalText(value, &dbInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText pb.DatabaseInfo err:%w", err)
+		}
+		mt.addDatabase(dbInfo)
+	}
+
 	_, values, err = mt.client.LoadWithPrefix(CollectionMetaPrefix, 0)
 	if err != nil {
 		return err
@@ -125,8 +183,28 @@ func (mt *metaTable) reloadFromKV() error {
 		if err != nil {
 			return fmt.Errorf("RootCoord UnmarshalText pb.CollectionInfo err:%w", err)
 		}
+		if _, ok := mt.collName2ID[collInfo.DbId]; !ok {
+			return fmt.Errorf("RootCoord can't find database id %d of collection %d", collInfo.DbId, collInfo.ID)
+		}
 		mt.collID2Meta[collInfo.ID] = collInfo
-		mt.collName2ID[collInfo.Schema.Name] = collInfo.ID
+		mt.collName2ID[collInfo.DbId][collInfo.Schema.Name] = collInfo.ID
+	}
+
+	_, values, err = mt.client.LoadWithPrefix(AliasMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		aliasInfo := pb.CollectionInfo{}
+		err = proto.UnmarshalText(value, &aliasInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText pb.CollectionInfo err:%w", err)
+		}
+		if _, ok := mt.collAlias2ID[aliasInfo.DbId]; !ok {
+			return fmt.Errorf("RootCoord can't find database id %d of alias %s", aliasInfo.DbId, aliasInfo.Schema.Name)
+		}
+		mt.collAlias2ID[aliasInfo.DbId][aliasInfo.Schema.Name] = aliasInfo.ID
 	}
 
 	_, values, err = mt.client.LoadWithPrefix(SegmentIndexMetaPrefix, 0)
@@ -174,6 +252,61 @@ func (mt *metaTable) reloadFromKV() error {
 		mt.indexID2Meta[meta.IndexID] = meta
 	}
 
+	if err = mt.addBuiltinCredentials(); err != nil {
+		return err
+	}
+	_, values, err = mt.client.LoadWithPrefix(UserMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		userInfo := pb.UserInfo{}
+		err = proto.UnmarshalText(value, &userInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText pb.UserInfo err:%w", err)
+		}
+		mt.userName2Meta[userInfo.Name] = userInfo
+	}
+
+	_, values, err = mt.client.LoadWithPrefix(RoleMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		roleInfo := pb.RoleInfo{}
+		err = proto.UnmarshalText(value, &roleInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText pb.RoleInfo err:%w", err)
+		}
+		mt.roleName2Meta[roleInfo.Name] = roleInfo
+	}
+
+	_, values, err = mt.client.LoadWithPrefix(DroppedCollectionMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		collInfo := pb.CollectionInfo{}
+		err = proto.UnmarshalText(value, &collInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText dropped pb.CollectionInfo err:%w", err)
+		}
+		mt.droppedCollID2Meta[collInfo.ID] = collInfo
+	}
+
+	_, values, err = mt.client.LoadWithPrefix(DroppedPartitionMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		partInfo := pb.DroppedPartitionInfo{}
+		err = proto.UnmarshalText(value, &partInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText pb.DroppedPartitionInfo err:%w", err)
+		}
+		mt.droppedPartID2Meta[partInfo.PartitionID] = partInfo
+	}
+
 	return nil
 }
 
@@ -191,6 +324,350 @@ func (mt *metaTable) getAdditionKV(op func(ts typeutil.Timestamp) (string, error
 	}
 }
 
+// listKeysUnderDir returns the keys under the directory. The keys are removed exactly rather than by
+// prefix, since prefix "collection/1" also covers the keys of collection 10.
+func (mt *metaTable) listKeysUnderDir(dir string) ([]string, error) {
+	keys, _, err := mt.client.LoadWithPrefix(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if strings.HasPrefix(key, dir+"/") {
+			ret = append(ret, key)
+		}
+	}
+	return ret, nil
+}
+
+// addDatabase adds the database into the in-memory maps, caller must hold the lock
+func (mt *metaTable) addDatabase(db pb.DatabaseInfo) {
+	mt.dbID2Meta[db.ID] = db
+	mt.dbName2ID[db.Name] = db.ID
+	mt.collName2ID[db.ID] = make(map[string]typeutil.UniqueID)
+	mt.collAlias2ID[db.ID] = make(map[string]typeutil.UniqueID)
+}
+
+// getDatabaseID returns the id of the database, an empty name refers to the default database.
+// Caller must hold the lock
+func (mt *metaTable) getDatabaseID(dbName string) (typeutil.UniqueID, error) {
+	if dbName == "" {
+		return DefaultDatabaseID, nil
+	}
+	dbID, ok := mt.dbName2ID[dbName]
+	if !ok {
+		return 0, fmt.Errorf("can't find database: %s", dbName)
+	}
+	return dbID, nil
+}
+
+// AddDatabase creates a database, collection names are unique within a database
+func (mt *metaTable) AddDatabase(db *pb.DatabaseInfo) (typeutil.Timestamp, error) {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if _, ok := mt.dbName2ID[db.Name]; ok {
+		return 0, fmt.Errorf("database %s exist", db.Name)
+	}
+	if _, ok := mt.dbID2Meta[db.ID]; ok {
+		return 0, fmt.Errorf("database id %d exist", db.ID)
+	}
+
+	k := fmt.Sprintf("%s/%d", DatabaseMetaPrefix, db.ID)
+	v := proto.MarshalTextString(db)
+
+	ts, err := mt.client.Save(k, v)
+	if err != nil {
+		return 0, err
+	}
+	mt.addDatabase(*db)
+	return ts, nil
+}
+
+// DeleteDatabase drops a database, the default database and the databases with collections can't be dropped
+func (mt *metaTable) DeleteDatabase(dbName string) (typeutil.Timestamp, error) {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return 0, err
+	}
+	if dbID == DefaultDatabaseID {
+		return 0, fmt.Errorf("can't drop the default database")
+	}
+	if len(mt.collName2ID[dbID]) > 0 {
+		return 0, fmt.Errorf("database %s is not empty, it has %d collections", dbName, len(mt.collName2ID[dbID]))
+	}
+
+	delMetakeys := []string{fmt.Sprintf("%s/%d", DatabaseMetaPrefix, dbID)}
+	ts, err := mt.client.MultiSaveAndRemove(map[string]string{}, delMetakeys, nil)
+	if err != nil {
+		_ = mt.reloadFromKV()
+		return 0, err
+	}
+	delete(mt.dbID2Meta, dbID)
+	delete(mt.dbName2ID, dbName)
+	delete(mt.collName2ID, dbID)
+	delete(mt.collAlias2ID, dbID)
+	return ts, nil
+}
+
+// GetDatabaseByName returns the id of the database, an empty name refers to the default database
+func (mt *metaTable) GetDatabaseByName(dbName string) (typeutil.UniqueID, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+	return mt.getDatabaseID(dbName)
+}
+
+// ListDatabases returns the names and ids of all the databases, including the default one
+func (mt *metaTable) ListDatabases() map[string]typeutil.UniqueID {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+	dbs := make(map[string]typeutil.UniqueID, len(mt.dbName2ID))
+	for name, id := range mt.dbName2ID {
+		dbs[name] = id
+	}
+	return dbs
+}
+
+// addBuiltinCredentials adds the root user and the admin role into the in-memory maps, caller must hold the lock
+func (mt *metaTable) addBuiltinCredentials() error {
+	passwordHash, err := hashPassword(Params.RootPassword)
+	if err != nil {
+		return err
+	}
+	mt.userName2Meta[RootUserName] = pb.UserInfo{
+		Name:         RootUserName,
+		PasswordHash: passwordHash,
+		Roles:        []string{AdminRoleName},
+	}
+	mt.roleName2Meta[AdminRoleName] = pb.RoleInfo{
+		Name: AdminRoleName,
+		Grants: []*milvuspb.Grant{
+			{
+				DbName:         AnyName,
+				CollectionName: AnyName,
+				Privilege:      commonpb.Privilege_PrivilegeAdmin,
+			},
+		},
+	}
+	return nil
+}
+
+// AddUser creates a user, the password hash of user must be set
+func (mt *metaTable) AddUser(user *pb.UserInfo) (typeutil.Timestamp, error) {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.userName2Meta[user.Name]; ok {
+		return 0, fmt.Errorf("user %s exist", user.Name)
+	}
+	for _, roleName := range user.Roles {
+		if _, ok := mt.roleName2Meta[roleName]; !ok {
+			return 0, fmt.Errorf("can't find role: %s", roleName)
+		}
+	}
+
+	k := fmt.Sprintf("%s/%s", UserMetaPrefix, user.Name)
+	v := proto.MarshalTextString(user)
+
+	ts, err := mt.client.Save(k, v)
+	if err != nil {
+		return 0, err
+	}
+	mt.userName2Meta[user.Name] = *user
+	return ts, nil
+}
+
+// GetUser returns the user and the grants of its roles
+func (mt *metaTable) GetUser(username string) (*pb.UserInfo, []*milvuspb.RoleGrants, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	userInfo, ok := mt.userName2Meta[username]
+	if !ok {
+		return nil, nil, fmt.Errorf("can't find user: %s", username)
+	}
+	return proto.Clone(&userInfo).(*pb.UserInfo), mt.getRoleGrants(userInfo.Roles), nil
+}
+
+// getRoleGrants returns the grants of the roles, caller must hold the lock
+func (mt *metaTable) getRoleGrants(roleNames []string) []*milvuspb.RoleGrants {
+	roles := make([]*milvuspb.RoleGrants, 0, len(roleNames))
+	for _, roleName := range roleNames {
+		roleInfo, ok := mt.roleName2Meta[roleName]
+		if !ok {
+			continue
+		}
+		grants := make([]*milvuspb.Grant, 0, len(roleInfo.Grants))
+		for _, grant := range roleInfo.Grants {
+			grants = append(grants, proto.Clone(grant).(*milvuspb.Grant))
+		}
+		roles = append(roles, &milvuspb.RoleGrants{
+			RoleName: roleName,
+			Grants:   grants,
+		})
+	}
+	return roles
+}
+
+// normalizeGrant fills the default database name if the database of grant is empty
+func normalizeGrant(grant *milvuspb.Grant) *milvuspb.Grant {
+	dbName := grant.DbName
+	if dbName == "" {
+		dbName = Params.DefaultDatabaseName
+	}
+	return &milvuspb.Grant{
+		DbName:         dbName,
+		CollectionName: grant.CollectionName,
+		Privilege:      grant.Privilege,
+	}
+}
+
+func grantIndex(grants []*milvuspb.Grant, grant *milvuspb.Grant) int {
+	for i, g := range grants {
+		if proto.Equal(g, grant) {
+			return i
+		}
+	}
+	return -1
+}
+
+// GrantRole adds grants to the role, the role is created if absent.
+// The role is granted to the user as well if username is not empty
+func (mt *metaTable) GrantRole(roleName string, username string, grants []*milvuspb.Grant) (typeutil.Timestamp, error) {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if roleName == AdminRoleName && len(grants) > 0 {
+		return 0, fmt.Errorf("can't change the privileges of the built-in role %s", AdminRoleName)
+	}
+	if username == RootUserName {
+		return 0, fmt.Errorf("can't change the roles of the built-in user %s", RootUserName)
+	}
+
+	saves := make(map[string]string)
+	roleInfo, ok := mt.roleName2Meta[roleName]
+	if !ok {
+		roleInfo = pb.RoleInfo{Name: roleName}
+	}
+	if !ok || len(grants) > 0 {
+		roleInfo.Grants = append([]*milvuspb.Grant{}, roleInfo.Grants...)
+		for _, grant := range grants {
+			grant = normalizeGrant(grant)
+			if grantIndex(roleInfo.Grants, grant) < 0 {
+				roleInfo.Grants = append(roleInfo.Grants, grant)
+			}
+		}
+		saves[fmt.Sprintf("%s/%s", RoleMetaPrefix, roleName)] = proto.MarshalTextString(&roleInfo)
+	}
+
+	var userInfo pb.UserInfo
+	if username != "" {
+		userInfo, ok = mt.userName2Meta[username]
+		if !ok {
+			return 0, fmt.Errorf("can't find user: %s", username)
+		}
+		if !funcutil.SliceContain(userInfo.Roles, roleName) {
+			userInfo.Roles = append(append([]string{}, userInfo.Roles...), roleName)
+			saves[fmt.Sprintf("%s/%s", UserMetaPrefix, username)] = proto.MarshalTextString(&userInfo)
+		}
+	}
+
+	ts, err := mt.client.MultiSave(saves, nil)
+	if err != nil {
+		return 0, err
+	}
+	mt.roleName2Meta[roleName] = roleInfo
+	if username != "" {
+		mt.userName2Meta[username] = userInfo
+	}
+	return ts, nil
+}
+
+// RevokeRole removes grants from the role, and revokes the role from the user if username is not empty
+func (mt *metaTable) RevokeRole(roleName string, username string, grants []*milvuspb.Grant) (typeutil.Timestamp, error) {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if roleName == AdminRoleName && len(grants) > 0 {
+		return 0, fmt.Errorf("can't change the privileges of the built-in role %s", AdminRoleName)
+	}
+	if username == RootUserName {
+		return 0, fmt.Errorf("can't change the roles of the built-in user %s", RootUserName)
+	}
+	roleInfo, ok := mt.roleName2Meta[roleName]
+	if !ok {
+		return 0, fmt.Errorf("can't find role: %s", roleName)
+	}
+
+	saves := make(map[string]string)
+	if len(grants) > 0 {
+		roleGrants := make([]*milvuspb.Grant, 0, len(roleInfo.Grants))
+		for _, grant := range roleInfo.Grants {
+			revoked := false
+			for _, g := range grants {
+				if proto.Equal(grant, normalizeGrant(g)) {
+					revoked = true
+					break
+				}
+			}
+			if !revoked {
+				roleGrants = append(roleGrants, grant)
+			}
+		}
+		roleInfo.Grants = roleGrants
+		saves[fmt.Sprintf("%s/%s", RoleMetaPrefix, roleName)] = proto.MarshalTextString(&roleInfo)
+	}
+
+	var userInfo pb.UserInfo
+	if username != "" {
+		userInfo, ok = mt.userName2Meta[username]
+		if !ok {
+			return 0, fmt.Errorf("can't find user: %s", username)
+		}
+		roles := make([]string, 0, len(userInfo.Roles))
+		for _, r := range userInfo.Roles {
+			if r != roleName {
+				roles = append(roles, r)
+			}
+		}
+		userInfo.Roles = roles
+		saves[fmt.Sprintf("%s/%s", UserMetaPrefix, username)] = proto.MarshalTextString(&userInfo)
+	}
+
+	ts, err := mt.client.MultiSave(saves, nil)
+	if err != nil {
+		return 0, err
+	}
+	mt.roleName2Meta[roleName] = roleInfo
+	if username != "" {
+		mt.userName2Meta[username] = userInfo
+	}
+	return ts, nil
+}
+
+// ListGrants returns the grants of the roles of the user, or of all the roles if username is empty
+func (mt *metaTable) ListGrants(username string) ([]*milvuspb.RoleGrants, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	if username != "" {
+		userInfo, ok := mt.userName2Meta[username]
+		if !ok {
+			return nil, fmt.Errorf("can't find user: %s", username)
+		}
+		return mt.getRoleGrants(userInfo.Roles), nil
+	}
+	roleNames := make([]string, 0, len(mt.roleName2Meta))
+	for roleName := range mt.roleName2Meta {
+		roleNames = append(roleNames, roleName)
+	}
+	sort.Strings(roleNames)
+	return mt.getRoleGrants(roleNames), nil
+}
+
 func (mt *metaTable) AddTenant(te *pb.TenantMeta) (typeutil.Timestamp, error) {
 	mt.tenantLock.Lock()
 	defer mt.tenantLock.Unlock()
@@ -228,15 +705,22 @@ func (mt *metaTable) AddCollection(coll *pb.CollectionInfo, idx []*pb.IndexInfo,
 	if len(coll.PartitionIDs) != len(coll.PartitionNames) {
 		return 0, fmt.Errorf("PartitionIDs and PartitionNames' length mis-match when creating collection")
 	}
-	if _, ok := mt.collName2ID[coll.Schema.Name]; ok {
+	collNames, ok := mt.collName2ID[coll.DbId]
+	if !ok {
+		return 0, fmt.Errorf("can't find database id: %d", coll.DbId)
+	}
+	if _, ok := collNames[coll.Schema.Name]; ok {
 		return 0, fmt.Errorf("collection %s exist", coll.Schema.Name)
 	}
+	if _, ok := mt.collAlias2ID[coll.DbId][coll.Schema.Name]; ok {
+		return 0, fmt.Errorf("collection name %s conflicts with an alias", coll.Schema.Name)
+	}
 	if len(coll.FieldIndexes) != len(idx) {
 		return 0, fmt.Errorf("incorrect index id when creating collection")
 	}
 
 	mt.collID2Meta[coll.ID] = *coll
-	mt.collName2ID[coll.Schema.Name] = coll.ID
+	collNames[coll.Schema.Name] = coll.ID
 	for _, i := range idx {
 		mt.indexID2Meta[i.IndexID] = *i
 	}
@@ -271,8 +755,20 @@ func (mt *metaTable) DeleteCollection(collID typeutil.UniqueID, ddOpStr func(ts
 		return 0, fmt.Errorf("can't find collection. id = %d", collID)
 	}
 
+	delMetakeys := []string{fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)}
+	for _, dir := range []string{
+		fmt.Sprintf("%s/%d", SegmentIndexMetaPrefix, collID),
+		fmt.Sprintf("%s/%d", IndexMetaPrefix, collID),
+	} {
+		keys, err := mt.listKeysUnderDir(dir)
+		if err != nil {
+			return 0, err
+		}
+		delMetakeys = append(delMetakeys, keys...)
+	}
+
 	delete(mt.collID2Meta, collID)
-	delete(mt.collName2ID, collMeta.Schema.Name)
+	delete(mt.collName2ID[collMeta.DbId], collMeta.Schema.Name)
 
 	// update segID2IndexMeta
 	for partID := range collMeta.PartitionIDs {
@@ -297,16 +793,23 @@ func (mt *metaTable) DeleteCollection(collID typeutil.UniqueID, ddOpStr func(ts
 		delete(mt.indexID2Meta, idxInfo.IndexID)
 	}
 
-	delMetakeys := []string{
-		fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID),
-		fmt.Sprintf("%s/%d", SegmentIndexMetaPrefix, collID),
-		fmt.Sprintf("%s/%d", IndexMetaPrefix, collID),
+	for alias, id := range mt.collAlias2ID[collMeta.DbId] {
+		if id == collID {
+			delete(mt.collAlias2ID[collMeta.DbId], alias)
+			delMetakeys = append(delMetakeys, fmt.Sprintf("%s/%d/%s", AliasMetaPrefix, collMeta.DbId, alias))
+		}
 	}
 
+	// the collection meta is kept within the retention duration
+	collMeta.DropTime = tsoutil.GetCurrentTime()
+	mt.droppedCollID2Meta[collID] = collMeta
+
 	// save ddOpStr into etcd
-	var saveMeta = map[string]string{}
+	var saveMeta = map[string]string{
+		fmt.Sprintf("%s/%d", DroppedCollectionMetaPrefix, collID): proto.MarshalTextString(&collMeta),
+	}
 	addition := mt.getAdditionKV(ddOpStr, saveMeta)
-	ts, err := mt.client.MultiSaveAndRemoveWithPrefix(saveMeta, delMetakeys, addition)
+	ts, err := mt.client.MultiSaveAndRemove(saveMeta, delMetakeys, addition)
 	if err != nil {
 		_ = mt.reloadFromKV()
 		return 0, err
@@ -352,14 +855,22 @@ func (mt *metaTable) GetCollectionByID(collectionID typeutil.UniqueID, ts typeut
 	return &colMeta, nil
 }
 
-func (mt *metaTable) GetCollectionByName(collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
+// GetCollectionByName returns the collection named collectionName in the database,
+// collectionName may be an alias
+func (mt *metaTable) GetCollectionByName(dbName string, collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return nil, err
+	}
 	if ts == 0 {
-		vid, ok := mt.collName2ID[collectionName]
+		vid, ok := mt.collName2ID[dbID][collectionName]
 		if !ok {
-			return nil, fmt.Errorf("can't find collection: " + collectionName)
+			if vid, ok = mt.collAlias2ID[dbID][collectionName]; !ok {
+				return nil, fmt.Errorf("can't find collection: " + collectionName)
+			}
 		}
 		col, ok := mt.collID2Meta[vid]
 		if !ok {
@@ -372,6 +883,13 @@ func (mt *metaTable) GetCollectionByName(collectionName string, ts typeutil.Time
 	if err != nil {
 		return nil, err
 	}
+	var aliasID typeutil.UniqueID
+	if aliasVal, err := mt.client.Load(fmt.Sprintf("%s/%d/%s", AliasMetaPrefix, dbID, collectionName), ts); err == nil {
+		aliasInfo := pb.CollectionInfo{}
+		if err = proto.UnmarshalText(aliasVal, &aliasInfo); err == nil {
+			aliasID = aliasInfo.ID
+		}
+	}
 	for _, val := range vals {
 		collMeta := pb.CollectionInfo{}
 		err = proto.UnmarshalText(val, &collMeta)
@@ -379,20 +897,28 @@ func (mt *metaTable) GetCollectionByName(collectionName string, ts typeutil.Time
 			log.Debug("unmarshal collection info failed", zap.Error(err))
 			continue
 		}
-		if collMeta.Schema.Name == collectionName {
+		if collMeta.DbId != dbID {
+			continue
+		}
+		if collMeta.Schema.Name == collectionName || (aliasID != 0 && collMeta.ID == aliasID) {
 			return &collMeta, nil
 		}
 	}
 	return nil, fmt.Errorf("can't find collection: %s, at timestamp = %d", collectionName, ts)
 }
 
-func (mt *metaTable) ListCollections(ts typeutil.Timestamp) (map[string]typeutil.UniqueID, error) {
+// ListCollections returns the names and ids of the collections in the database
+func (mt *metaTable) ListCollections(dbName string, ts typeutil.Timestamp) (map[string]typeutil.UniqueID, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 	colls := make(map[string]typeutil.UniqueID)
 
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return nil, err
+	}
 	if ts == 0 {
-		for k, v := range mt.collName2ID {
+		for k, v := range mt.collName2ID[dbID] {
 			colls[k] = v
 		}
 		return colls, nil
@@ -408,11 +934,124 @@ func (mt *metaTable) ListCollections(ts typeutil.Timestamp) (map[string]typeutil
 		if err != nil {
 			log.Debug("unmarshal collection info failed", zap.Error(err))
 		}
-		colls[collMeta.Schema.Name] = collMeta.ID
+		if collMeta.DbId == dbID {
+			colls[collMeta.Schema.Name] = collMeta.ID
+		}
 	}
 	return colls, nil
 }
 
+// AddAlias creates alias for the collection named collectionName in the database
+func (mt *metaTable) AddAlias(dbName string, collectionAlias string, collectionName string) (typeutil.Timestamp, error) {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := mt.collAlias2ID[dbID][collectionAlias]; ok {
+		return 0, fmt.Errorf("alias %s already exist", collectionAlias)
+	}
+	if _, ok := mt.collName2ID[dbID][collectionAlias]; ok {
+		return 0, fmt.Errorf("alias %s conflicts with a collection name", collectionAlias)
+	}
+	collID, ok := mt.collName2ID[dbID][collectionName]
+	if !ok {
+		return 0, fmt.Errorf("can't find collection: %s", collectionName)
+	}
+	return mt.saveAlias(dbID, collectionAlias, collID)
+}
+
+// AlterAlias moves an existing alias to the collection named collectionName in the same database
+func (mt *metaTable) AlterAlias(dbName string, collectionAlias string, collectionName string) (typeutil.Timestamp, error) {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := mt.collAlias2ID[dbID][collectionAlias]; !ok {
+		return 0, fmt.Errorf("alias %s not exist", collectionAlias)
+	}
+	collID, ok := mt.collName2ID[dbID][collectionName]
+	if !ok {
+		return 0, fmt.Errorf("can't find collection: %s", collectionName)
+	}
+	return mt.saveAlias(dbID, collectionAlias, collID)
+}
+
+func (mt *metaTable) saveAlias(dbID typeutil.UniqueID, collectionAlias string, collID typeutil.UniqueID) (typeutil.Timestamp, error) {
+	aliasInfo := &pb.CollectionInfo{
+		ID:     collID,
+		Schema: &schemapb.CollectionSchema{Name: collectionAlias},
+		DbId:   dbID,
+	}
+	k := fmt.Sprintf("%s/%d/%s", AliasMetaPrefix, dbID, collectionAlias)
+	v := proto.MarshalTextString(aliasInfo)
+
+	ts, err := mt.client.Save(k, v)
+	if err != nil {
+		_ = mt.reloadFromKV()
+		return 0, err
+	}
+	mt.collAlias2ID[dbID][collectionAlias] = collID
+	return ts, nil
+}
+
+// DeleteAlias removes the alias, the collection it points to is untouched
+func (mt *metaTable) DeleteAlias(dbName string, collectionAlias string) (typeutil.Timestamp, error) {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := mt.collAlias2ID[dbID][collectionAlias]; !ok {
+		return 0, fmt.Errorf("alias %s not exist", collectionAlias)
+	}
+
+	delMetakeys := []string{fmt.Sprintf("%s/%d/%s", AliasMetaPrefix, dbID, collectionAlias)}
+	ts, err := mt.client.MultiSaveAndRemove(map[string]string{}, delMetakeys, nil)
+	if err != nil {
+		_ = mt.reloadFromKV()
+		return 0, err
+	}
+	delete(mt.collAlias2ID[dbID], collectionAlias)
+	return ts, nil
+}
+
+// IsAlias returns whether name is an alias rather than a collection name in the database
+func (mt *metaTable) IsAlias(dbName string, name string) bool {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return false
+	}
+	_, ok := mt.collAlias2ID[dbID][name]
+	return ok
+}
+
+// ListAliases returns the aliases of the collection
+func (mt *metaTable) ListAliases(collID typeutil.UniqueID) []string {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+	aliases := make([]string, 0)
+	collMeta, ok := mt.collID2Meta[collID]
+	if !ok {
+		return aliases
+	}
+	for alias, id := range mt.collAlias2ID[collMeta.DbId] {
+		if id == collID {
+			aliases = append(aliases, alias)
+		}
+	}
+	return aliases
+}
+
 // ListCollectionVirtualChannels list virtual channel of all the collection
 func (mt *metaTable) ListCollectionVirtualChannels() []string {
 	mt.ddLock.RLock()
@@ -482,6 +1121,48 @@ func (mt *metaTable) AddPartition(collID typeutil.UniqueID, partitionName string
 	return ts, nil
 }
 
+// AddField appends field to the schema of the collection and bumps the schema version, the id of field is
+// allocated after the existing fields
+func (mt *metaTable) AddField(collID typeutil.UniqueID, field *schemapb.FieldSchema, ddOpStr func(ts typeutil.Timestamp) (string, error)) (typeutil.Timestamp, error) {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return 0, fmt.Errorf("can't find collection. id = %d", collID)
+	}
+
+	fieldID := int64(StartOfUserFieldID)
+	for _, f := range coll.Schema.Fields {
+		if f.Name == field.Name {
+			return 0, fmt.Errorf("field name = %s already exists", field.Name)
+		}
+		if f.FieldID >= fieldID {
+			fieldID = f.FieldID + 1
+		}
+	}
+	field.FieldID = fieldID
+
+	schema := proto.Clone(coll.Schema).(*schemapb.CollectionSchema)
+	schema.Fields = append(schema.Fields, field)
+	schema.Version++
+	coll.Schema = schema
+
+	k1 := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
+	v1 := proto.MarshalTextString(&coll)
+	meta := map[string]string{k1: v1}
+
+	// save ddOpStr into etcd
+	addition := mt.getAdditionKV(ddOpStr, meta)
+
+	ts, err := mt.client.MultiSave(meta, addition)
+	if err != nil {
+		_ = mt.reloadFromKV()
+		return 0, err
+	}
+	mt.collID2Meta[collID] = coll
+	return ts, nil
+}
+
 func (mt *metaTable) GetPartitionNameByID(collID, partitionID typeutil.UniqueID, ts typeutil.Timestamp) (string, error) {
 	if ts == 0 {
 		mt.ddLock.RLock()
@@ -603,17 +1284,33 @@ func (mt *metaTable) DeletePartition(collID typeutil.UniqueID, partitionName str
 	}
 	delete(mt.partID2SegID, partID)
 
-	meta := map[string]string{path.Join(CollectionMetaPrefix, strconv.FormatInt(collID, 10)): proto.MarshalTextString(&collMeta)}
+	// the partition meta is kept within the retention duration
+	droppedPartInfo := pb.DroppedPartitionInfo{
+		CollectionID:  collID,
+		PartitionID:   partID,
+		PartitionName: partitionName,
+		DropTime:      tsoutil.GetCurrentTime(),
+	}
+	mt.droppedPartID2Meta[partID] = droppedPartInfo
+
+	meta := map[string]string{
+		path.Join(CollectionMetaPrefix, strconv.FormatInt(collID, 10)):      proto.MarshalTextString(&collMeta),
+		fmt.Sprintf("%s/%d/%d", DroppedPartitionMetaPrefix, collID, partID): proto.MarshalTextString(&droppedPartInfo),
+	}
 	delMetaKeys := []string{}
 	for _, idxInfo := range collMeta.FieldIndexes {
-		k := fmt.Sprintf("%s/%d/%d/%d", SegmentIndexMetaPrefix, collMeta.ID, idxInfo.IndexID, partID)
-		delMetaKeys = append(delMetaKeys, k)
+		keys, err := mt.listKeysUnderDir(fmt.Sprintf("%s/%d/%d/%d", SegmentIndexMetaPrefix, collMeta.ID, idxInfo.IndexID, partID))
+		if err != nil {
+			_ = mt.reloadFromKV()
+			return 0, 0, err
+		}
+		delMetaKeys = append(delMetaKeys, keys...)
 	}
 
 	// save ddOpStr into etcd
 	addition := mt.getAdditionKV(ddOpStr, meta)
 
-	ts, err := mt.client.MultiSaveAndRemoveWithPrefix(meta, delMetaKeys, addition)
+	ts, err := mt.client.MultiSaveAndRemove(meta, delMetaKeys, addition)
 	if err != nil {
 		_ = mt.reloadFromKV()
 		return 0, 0, err
@@ -621,6 +1318,43 @@ func (mt *metaTable) DeletePartition(collID typeutil.UniqueID, partitionName str
 	return ts, partID, nil
 }
 
+// PurgeDroppedMeta removes the meta of the collections and partitions dropped before expireTs
+func (mt *metaTable) PurgeDroppedMeta(expireTs typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	delMetaKeys := make([]string, 0)
+	collIDs := make([]typeutil.UniqueID, 0)
+	for collID, collMeta := range mt.droppedCollID2Meta {
+		if collMeta.DropTime < expireTs {
+			collIDs = append(collIDs, collID)
+			delMetaKeys = append(delMetaKeys, fmt.Sprintf("%s/%d", DroppedCollectionMetaPrefix, collID))
+		}
+	}
+	partIDs := make([]typeutil.UniqueID, 0)
+	for partID, partInfo := range mt.droppedPartID2Meta {
+		if partInfo.DropTime < expireTs {
+			partIDs = append(partIDs, partID)
+			delMetaKeys = append(delMetaKeys, fmt.Sprintf("%s/%d/%d", DroppedPartitionMetaPrefix, partInfo.CollectionID, partID))
+		}
+	}
+	if len(delMetaKeys) == 0 {
+		return nil
+	}
+
+	if _, err := mt.client.MultiSaveAndRemove(map[string]string{}, delMetaKeys, nil); err != nil {
+		return err
+	}
+	for _, collID := range collIDs {
+		delete(mt.droppedCollID2Meta, collID)
+	}
+	for _, partID := range partIDs {
+		delete(mt.droppedPartID2Meta, partID)
+	}
+	log.Debug("purge dropped meta", zap.Int64s("collection ids", collIDs), zap.Int64s("partition ids", partIDs))
+	return nil
+}
+
 func (mt *metaTable) AddIndex(segIdxInfo *pb.SegmentIndexInfo) (typeutil.Timestamp, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
@@ -676,11 +1410,11 @@ func (mt *metaTable) AddIndex(segIdxInfo *pb.SegmentIndexInfo) (typeutil.Timesta
 }
 
 //return timestamp, index id, is dropped, error
-func (mt *metaTable) DropIndex(collName, fieldName, indexName string) (typeutil.Timestamp, typeutil.UniqueID, bool, error) {
+func (mt *metaTable) DropIndex(dbName, collName, fieldName, indexName string) (typeutil.Timestamp, typeutil.UniqueID, bool, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.unlockGetCollectionID(dbName, collName)
 	if !ok {
 		return 0, 0, false, fmt.Errorf("collection name = %s not exist", collName)
 	}
@@ -688,7 +1422,7 @@ func (mt *metaTable) DropIndex(collName, fieldName, indexName string) (typeutil.
 	if !ok {
 		return 0, 0, false, fmt.Errorf("collection name  = %s not has meta", collName)
 	}
-	fieldSch, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSch, err := mt.unlockGetFieldSchema(dbName, collName, fieldName)
 	if err != nil {
 		return 0, 0, false, err
 	}
@@ -734,12 +1468,14 @@ func (mt *metaTable) DropIndex(collName, fieldName, indexName string) (typeutil.
 		}
 	}
 
-	delMeta := []string{
-		fmt.Sprintf("%s/%d/%d", SegmentIndexMetaPrefix, collMeta.ID, dropIdxID),
-		fmt.Sprintf("%s/%d/%d", IndexMetaPrefix, collMeta.ID, dropIdxID),
+	delMeta, err := mt.listKeysUnderDir(fmt.Sprintf("%s/%d/%d", SegmentIndexMetaPrefix, collMeta.ID, dropIdxID))
+	if err != nil {
+		_ = mt.reloadFromKV()
+		return 0, 0, false, err
 	}
+	delMeta = append(delMeta, fmt.Sprintf("%s/%d/%d", IndexMetaPrefix, collMeta.ID, dropIdxID))
 
-	ts, err := mt.client.MultiSaveAndRemoveWithPrefix(saveMeta, delMeta, nil)
+	ts, err := mt.client.MultiSaveAndRemove(saveMeta, delMeta, nil)
 	if err != nil {
 		_ = mt.reloadFromKV()
 		return 0, 0, false, err
@@ -766,13 +1502,24 @@ func (mt *metaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, filedID in
 		return pb.SegmentIndexInfo{}, fmt.Errorf("segment id %d not has any index", segID)
 	}
 
-	if filedID == -1 && idxName == "" { // return default index
+	if idxName == "" { // return default index of the field, or of any field if filedID is -1
 		for _, seg := range segIdxMap {
+			if filedID != -1 && seg.FieldID != filedID {
+				continue
+			}
 			info, ok := mt.indexID2Meta[seg.IndexID]
 			if ok && info.IndexName == Params.DefaultIndexName {
 				return seg, nil
 			}
 		}
+		if filedID != -1 {
+			// the field has no index on the segment, while the other fields have
+			return pb.SegmentIndexInfo{
+				SegmentID:   segID,
+				FieldID:     filedID,
+				EnableIndex: false,
+			}, nil
+		}
 	} else {
 		for idxID, seg := range segIdxMap {
 			idxMeta, ok := mt.indexID2Meta[idxID]
@@ -790,15 +1537,28 @@ func (mt *metaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, filedID in
 	return pb.SegmentIndexInfo{}, fmt.Errorf("can't find index name = %s on segment = %d, with filed id = %d", idxName, segID, filedID)
 }
 
-func (mt *metaTable) GetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
+func (mt *metaTable) GetFieldSchema(dbName string, collName string, fieldName string) (schemapb.FieldSchema, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	return mt.unlockGetFieldSchema(collName, fieldName)
+	return mt.unlockGetFieldSchema(dbName, collName, fieldName)
 }
 
-func (mt *metaTable) unlockGetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
-	collID, ok := mt.collName2ID[collName]
+// unlockGetCollectionID returns the id of the collection or alias named collName in the database
+func (mt *metaTable) unlockGetCollectionID(dbName string, collName string) (typeutil.UniqueID, bool) {
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return 0, false
+	}
+	if collID, ok := mt.collName2ID[dbID][collName]; ok {
+		return collID, true
+	}
+	collID, ok := mt.collAlias2ID[dbID][collName]
+	return collID, ok
+}
+
+func (mt *metaTable) unlockGetFieldSchema(dbName string, collName string, fieldName string) (schemapb.FieldSchema, error) {
+	collID, ok := mt.unlockGetCollectionID(dbName, collName)
 	if !ok {
 		return schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
@@ -845,14 +1605,14 @@ func (mt *metaTable) unlockIsSegmentIndexed(segID typeutil.UniqueID, fieldSchema
 }
 
 // return segment ids, type params, error
-func (mt *metaTable) GetNotIndexedSegments(collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
+func (mt *metaTable) GetNotIndexedSegments(dbName string, collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
 	if idxInfo.IndexParams == nil {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("index param is nil")
 	}
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.unlockGetCollectionID(dbName, collName)
 	if !ok {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
@@ -860,13 +1620,17 @@ func (mt *metaTable) GetNotIndexedSegments(collName string, fieldName string, id
 	if !ok {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
-	fieldSchema, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSchema, err := mt.unlockGetFieldSchema(dbName, collName, fieldName)
 	if err != nil {
 		return nil, fieldSchema, err
 	}
 
+	// index names are unique in a field, the vector fields of a collection have their own default indexes
 	var dupIdx typeutil.UniqueID = 0
 	for _, f := range collMeta.FieldIndexes {
+		if f.FiledID != fieldSchema.FieldID {
+			continue
+		}
 		if info, ok := mt.indexID2Meta[f.IndexID]; ok {
 			if info.IndexName == idxInfo.IndexName {
 				dupIdx = info.IndexID
@@ -953,11 +1717,11 @@ func (mt *metaTable) GetNotIndexedSegments(collName string, fieldName string, id
 	return rstID, fieldSchema, nil
 }
 
-func (mt *metaTable) GetIndexByName(collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
+func (mt *metaTable) GetIndexByName(dbName, collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.unlockGetCollectionID(dbName, collName)
 	if !ok {
 		return pb.CollectionInfo{}, nil, fmt.Errorf("collection %s not found", collName)
 	}
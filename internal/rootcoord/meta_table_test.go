@@ -20,10 +20,13 @@ import (
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	"go.etcd.io/etcd/clientv3"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type mockTestKV struct {
@@ -33,6 +36,7 @@ type mockTestKV struct {
 	save                         func(key, value string) (typeutil.Timestamp, error)
 	multiSave                    func(kvs map[string]string, addition func(ts typeutil.Timestamp) (string, string, error)) (typeutil.Timestamp, error)
 	multiSaveAndRemoveWithPrefix func(saves map[string]string, removals []string, addition func(ts typeutil.Timestamp) (string, string, error)) (typeutil.Timestamp, error)
+	multiSaveAndRemove           func(saves map[string]string, removals []string, addition func(ts typeutil.Timestamp) (string, string, error)) (typeutil.Timestamp, error)
 }
 
 func (m *mockTestKV) LoadWithPrefix(key string, ts typeutil.Timestamp) ([]string, []string, error) {
@@ -54,6 +58,10 @@ func (m *mockTestKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, remov
 	return m.multiSaveAndRemoveWithPrefix(saves, removals, addition)
 }
 
+func (m *mockTestKV) MultiSaveAndRemove(saves map[string]string, removals []string, addition func(ts typeutil.Timestamp) (string, string, error)) (typeutil.Timestamp, error) {
+	return m.multiSaveAndRemove(saves, removals, addition)
+}
+
 func Test_MockKV(t *testing.T) {
 	k1 := &mockTestKV{}
 	prefix := make(map[string][]string)
@@ -86,6 +94,15 @@ func Test_MockKV(t *testing.T) {
 	_, err = NewMetaTable(k1)
 	assert.NotNil(t, err)
 
+	prefix[DatabaseMetaPrefix] = []string{"database-meta"}
+	_, err = NewMetaTable(k1)
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "RootCoord UnmarshalText pb.DatabaseInfo err:line 1.0: unknown field name \"database-meta\" in milvus.proto.etcd.DatabaseInfo")
+
+	prefix[DatabaseMetaPrefix] = []string{proto.MarshalTextString(&pb.DatabaseInfo{ID: 1, Name: "db"})}
+	_, err = NewMetaTable(k1)
+	assert.NotNil(t, err)
+
 	prefix[CollectionMetaPrefix] = []string{"collection-meta"}
 	_, err = NewMetaTable(k1)
 	assert.NotNil(t, err)
@@ -95,6 +112,15 @@ func Test_MockKV(t *testing.T) {
 	_, err = NewMetaTable(k1)
 	assert.NotNil(t, err)
 
+	prefix[AliasMetaPrefix] = []string{"alias-meta"}
+	_, err = NewMetaTable(k1)
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "RootCoord UnmarshalText pb.CollectionInfo err:line 1.0: unknown field name \"alias-meta\" in milvus.proto.etcd.CollectionInfo")
+
+	prefix[AliasMetaPrefix] = []string{proto.MarshalTextString(&pb.CollectionInfo{Schema: &schemapb.CollectionSchema{}})}
+	_, err = NewMetaTable(k1)
+	assert.NotNil(t, err)
+
 	prefix[SegmentIndexMetaPrefix] = []string{"segment-index-meta"}
 	_, err = NewMetaTable(k1)
 	assert.NotNil(t, err)
@@ -115,6 +141,41 @@ func Test_MockKV(t *testing.T) {
 	assert.EqualError(t, err, "RootCoord UnmarshalText pb.IndexInfo err:line 1.0: unknown field name \"index-meta\" in milvus.proto.etcd.IndexInfo")
 
 	prefix[IndexMetaPrefix] = []string{proto.MarshalTextString(&pb.IndexInfo{})}
+	_, err = NewMetaTable(k1)
+	assert.NotNil(t, err)
+
+	prefix[UserMetaPrefix] = []string{"user-meta"}
+	_, err = NewMetaTable(k1)
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "RootCoord UnmarshalText pb.UserInfo err:line 1.0: unknown field name \"user-meta\" in milvus.proto.etcd.UserInfo")
+
+	prefix[UserMetaPrefix] = []string{proto.MarshalTextString(&pb.UserInfo{Name: "user"})}
+	_, err = NewMetaTable(k1)
+	assert.NotNil(t, err)
+
+	prefix[RoleMetaPrefix] = []string{"role-meta"}
+	_, err = NewMetaTable(k1)
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "RootCoord UnmarshalText pb.RoleInfo err:line 1.0: unknown field name \"role-meta\" in milvus.proto.etcd.RoleInfo")
+
+	prefix[RoleMetaPrefix] = []string{proto.MarshalTextString(&pb.RoleInfo{Name: "role"})}
+	_, err = NewMetaTable(k1)
+	assert.NotNil(t, err)
+
+	prefix[DroppedCollectionMetaPrefix] = []string{"dropped-collection-meta"}
+	_, err = NewMetaTable(k1)
+	assert.NotNil(t, err)
+
+	prefix[DroppedCollectionMetaPrefix] = []string{proto.MarshalTextString(&pb.CollectionInfo{ID: 1})}
+	_, err = NewMetaTable(k1)
+	assert.NotNil(t, err)
+
+	prefix[DroppedPartitionMetaPrefix] = []string{"dropped-partition-meta"}
+	_, err = NewMetaTable(k1)
+	assert.NotNil(t, err)
+	assert.EqualError(t, err, "RootCoord UnmarshalText pb.DroppedPartitionInfo err:line 1.0: unknown field name \"dropped-partition-meta\" in milvus.proto.etcd.DroppedPartitionInfo")
+
+	prefix[DroppedPartitionMetaPrefix] = []string{proto.MarshalTextString(&pb.DroppedPartitionInfo{PartitionID: 2})}
 	m1, err := NewMetaTable(k1)
 	assert.Nil(t, err)
 
@@ -245,13 +306,13 @@ func TestMetaTable(t *testing.T) {
 		_, err = mt.AddCollection(collInfo, idxInfo, ddOp)
 		assert.Nil(t, err)
 
-		collMeta, err := mt.GetCollectionByName("testColl", 0)
+		collMeta, err := mt.GetCollectionByName("", "testColl", 0)
 		assert.Nil(t, err)
 		assert.Equal(t, partIDDefault, collMeta.PartitionIDs[0])
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		assert.True(t, mt.HasCollection(collInfo.ID, 0))
 
-		field, err := mt.GetFieldSchema("testColl", "field110")
+		field, err := mt.GetFieldSchema("", "testColl", "field110")
 		assert.Nil(t, err)
 		assert.Equal(t, collInfo.Schema.Fields[0].FieldID, field.FieldID)
 
@@ -321,9 +382,9 @@ func TestMetaTable(t *testing.T) {
 			IndexParams: params,
 		}
 
-		_, _, err := mt.GetNotIndexedSegments("collTest", "field110", idxInfo, nil)
+		_, _, err := mt.GetNotIndexedSegments("", "collTest", "field110", idxInfo, nil)
 		assert.NotNil(t, err)
-		seg, field, err := mt.GetNotIndexedSegments("testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
+		seg, field, err := mt.GetNotIndexedSegments("", "testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(seg))
 		assert.Equal(t, segID2, seg[0])
@@ -339,7 +400,7 @@ func TestMetaTable(t *testing.T) {
 		idxInfo.IndexID = 2001
 		idxInfo.IndexName = "field110-1"
 
-		seg, field, err = mt.GetNotIndexedSegments("testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
+		seg, field, err = mt.GetNotIndexedSegments("", "testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(seg))
 		assert.Equal(t, segID, seg[0])
@@ -349,7 +410,7 @@ func TestMetaTable(t *testing.T) {
 	})
 
 	t.Run("get index by name", func(t *testing.T) {
-		_, idx, err := mt.GetIndexByName("testColl", "field110")
+		_, idx, err := mt.GetIndexByName("", "testColl", "field110")
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(idx))
 		assert.Equal(t, indexID, idx[0].IndexID)
@@ -365,7 +426,7 @@ func TestMetaTable(t *testing.T) {
 		}
 		assert.True(t, EqualKeyPairArray(idx[0].IndexParams, params))
 
-		_, idx, err = mt.GetIndexByName("testColl", "idx201")
+		_, idx, err = mt.GetIndexByName("", "testColl", "idx201")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idx))
 	})
@@ -387,20 +448,20 @@ func TestMetaTable(t *testing.T) {
 	})
 
 	t.Run("drop index", func(t *testing.T) {
-		_, idx, ok, err := mt.DropIndex("testColl", "field110", "field110")
+		_, idx, ok, err := mt.DropIndex("", "testColl", "field110", "field110")
 		assert.Nil(t, err)
 		assert.True(t, ok)
 		assert.Equal(t, indexID, idx)
 
-		_, _, ok, err = mt.DropIndex("testColl", "field110", "field110-error")
+		_, _, ok, err = mt.DropIndex("", "testColl", "field110", "field110-error")
 		assert.Nil(t, err)
 		assert.False(t, ok)
 
-		_, idxs, err := mt.GetIndexByName("testColl", "field110")
+		_, idxs, err := mt.GetIndexByName("", "testColl", "field110")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idxs))
 
-		_, idxs, err = mt.GetIndexByName("testColl", "field110-1")
+		_, idxs, err = mt.GetIndexByName("", "testColl", "field110-1")
 		assert.Nil(t, err)
 		assert.Equal(t, len(idxs), 1)
 		assert.Equal(t, idxs[0].IndexID, int64(2001))
@@ -454,8 +515,8 @@ func TestMetaTable(t *testing.T) {
 		mockKV.multiSave = func(kvs map[string]string, addition func(ts typeutil.Timestamp) (string, string, error)) (typeutil.Timestamp, error) {
 			return 0, nil
 		}
-		mockKV.multiSaveAndRemoveWithPrefix = func(save map[string]string, keys []string, addition func(ts typeutil.Timestamp) (string, string, error)) (typeutil.Timestamp, error) {
-			return 0, fmt.Errorf("multi save and remove with prefix error")
+		mockKV.multiSaveAndRemove = func(save map[string]string, keys []string, addition func(ts typeutil.Timestamp) (string, string, error)) (typeutil.Timestamp, error) {
+			return 0, fmt.Errorf("multi save and remove error")
 		}
 		collInfo.PartitionIDs = nil
 		collInfo.PartitionNames = nil
@@ -464,7 +525,7 @@ func TestMetaTable(t *testing.T) {
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
 		_, err = mt.DeleteCollection(collInfo.ID, nil)
 		assert.NotNil(t, err)
-		assert.EqualError(t, err, "multi save and remove with prefix error")
+		assert.EqualError(t, err, "multi save and remove error")
 	})
 
 	t.Run("get collection failed", func(t *testing.T) {
@@ -478,7 +539,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.GetCollectionByName(collInfo.Schema.Name, 0)
+		_, err = mt.GetCollectionByName("", collInfo.Schema.Name, 0)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("can't find collection: %s", collInfo.Schema.Name))
 
@@ -581,12 +642,12 @@ func TestMetaTable(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "partition abc does not exist")
 
-		mockKV.multiSaveAndRemoveWithPrefix = func(saves map[string]string, removals []string, addition func(ts typeutil.Timestamp) (string, string, error)) (typeutil.Timestamp, error) {
-			return 0, fmt.Errorf("multi save and remove with prefix error")
+		mockKV.multiSaveAndRemove = func(saves map[string]string, removals []string, addition func(ts typeutil.Timestamp) (string, string, error)) (typeutil.Timestamp, error) {
+			return 0, fmt.Errorf("multi save and remove error")
 		}
 		_, _, err = mt.DeletePartition(collInfo.ID, partName, nil)
 		assert.NotNil(t, err)
-		assert.EqualError(t, err, "multi save and remove with prefix error")
+		assert.EqualError(t, err, "multi save and remove error")
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
 		_, _, err = mt.DeletePartition(collInfo.ID, "abc", nil)
@@ -664,16 +725,16 @@ func TestMetaTable(t *testing.T) {
 		_, err = mt.AddCollection(collInfo, idxInfo, nil)
 		assert.Nil(t, err)
 
-		_, _, _, err = mt.DropIndex("abc", "abc", "abc")
+		_, _, _, err = mt.DropIndex("", "abc", "abc", "abc")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection name = abc not exist")
 
-		mt.collName2ID["abc"] = 2
-		_, _, _, err = mt.DropIndex("abc", "abc", "abc")
+		mt.collName2ID[DefaultDatabaseID]["abc"] = 2
+		_, _, _, err = mt.DropIndex("", "abc", "abc", "abc")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection name  = abc not has meta")
 
-		_, _, _, err = mt.DropIndex(collInfo.Schema.Name, "abc", "abc")
+		_, _, _, err = mt.DropIndex("", collInfo.Schema.Name, "abc", "abc")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed abc", collInfo.Schema.Name))
 
@@ -690,7 +751,7 @@ func TestMetaTable(t *testing.T) {
 		}
 		mt.collID2Meta[coll.ID] = coll
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, idxID, isDroped, err := mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName)
+		_, idxID, isDroped, err := mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName)
 		assert.Zero(t, idxID)
 		assert.False(t, isDroped)
 		assert.Nil(t, err)
@@ -701,12 +762,12 @@ func TestMetaTable(t *testing.T) {
 		collInfo.PartitionNames = nil
 		_, err = mt.AddCollection(collInfo, idxInfo, nil)
 		assert.Nil(t, err)
-		mockKV.multiSaveAndRemoveWithPrefix = func(saves map[string]string, removals []string, addition func(ts typeutil.Timestamp) (string, string, error)) (typeutil.Timestamp, error) {
-			return 0, fmt.Errorf("multi save and remove with prefix error")
+		mockKV.multiSaveAndRemove = func(saves map[string]string, removals []string, addition func(ts typeutil.Timestamp) (string, string, error)) (typeutil.Timestamp, error) {
+			return 0, fmt.Errorf("multi save and remove error")
 		}
-		_, _, _, err = mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName)
+		_, _, _, err = mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName)
 		assert.NotNil(t, err)
-		assert.EqualError(t, err, "multi save and remove with prefix error")
+		assert.EqualError(t, err, "multi save and remove error")
 	})
 
 	t.Run("get segment index info by id", func(t *testing.T) {
@@ -775,12 +836,12 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 
-		mt.collName2ID = make(map[string]int64)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		mt.collName2ID[DefaultDatabaseID] = make(map[string]int64)
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 	})
@@ -825,8 +886,8 @@ func TestMetaTable(t *testing.T) {
 			},
 		}
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetNotIndexedSegments("abc", "no-field", idx, nil)
+		mt.collName2ID[DefaultDatabaseID]["abc"] = 123
+		_, _, err = mt.GetNotIndexedSegments("", "abc", "no-field", idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection abc not found")
 
@@ -844,13 +905,13 @@ func TestMetaTable(t *testing.T) {
 		_, err = mt.AddCollection(collInfo, idxInfo, nil)
 		assert.Nil(t, err)
 
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, "no-field", idx, nil)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, "no-field", idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed no-field", collInfo.Schema.Name))
 
 		bakMeta := mt.indexID2Meta
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("index id = %d not found", idxInfo[0].IndexID))
 		mt.indexID2Meta = bakMeta
@@ -858,7 +919,7 @@ func TestMetaTable(t *testing.T) {
 		mockKV.multiSave = func(kvs map[string]string, addition func(ts typeutil.Timestamp) (string, string, error)) (typeutil.Timestamp, error) {
 			return 0, fmt.Errorf("multi save error")
 		}
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "multi save error")
 
@@ -888,7 +949,7 @@ func TestMetaTable(t *testing.T) {
 		mockKV.multiSave = func(kvs map[string]string, addition func(ts typeutil.Timestamp) (string, string, error)) (typeutil.Timestamp, error) {
 			return 0, fmt.Errorf("multi save error")
 		}
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "multi save error")
 	})
@@ -900,8 +961,8 @@ func TestMetaTable(t *testing.T) {
 		err := mt.reloadFromKV()
 		assert.Nil(t, err)
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetIndexByName("abc", "hij")
+		mt.collName2ID[DefaultDatabaseID]["abc"] = 123
+		_, _, err = mt.GetIndexByName("", "abc", "hij")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection abc not found")
 
@@ -919,7 +980,7 @@ func TestMetaTable(t *testing.T) {
 		_, err = mt.AddCollection(collInfo, idxInfo, nil)
 		assert.Nil(t, err)
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, _, err = mt.GetIndexByName(collInfo.Schema.Name, idxInfo[0].IndexName)
+		_, _, err = mt.GetIndexByName("", collInfo.Schema.Name, idxInfo[0].IndexName)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("index id = %d not found", idxInfo[0].IndexID))
 
@@ -1019,29 +1080,29 @@ func TestMetaWithTimestamp(t *testing.T) {
 	c2, err = mt.GetCollectionByID(collID2, tsoStart)
 	assert.NotNil(t, err)
 
-	c1, err = mt.GetCollectionByName(collName1, 0)
+	c1, err = mt.GetCollectionByName("", collName1, 0)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, 0)
+	c2, err = mt.GetCollectionByName("", collName2, 0)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t2)
+	c1, err = mt.GetCollectionByName("", collName1, t2)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t2)
+	c2, err = mt.GetCollectionByName("", collName2, t2)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t1)
+	c1, err = mt.GetCollectionByName("", collName1, t1)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t1)
+	c2, err = mt.GetCollectionByName("", collName2, t1)
 	assert.NotNil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, tsoStart)
+	c1, err = mt.GetCollectionByName("", collName1, tsoStart)
 	assert.NotNil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, tsoStart)
+	c2, err = mt.GetCollectionByName("", collName2, tsoStart)
 	assert.NotNil(t, err)
 
 	getKeys := func(m map[string]typeutil.UniqueID) []string {
@@ -1052,22 +1113,22 @@ func TestMetaWithTimestamp(t *testing.T) {
 		return keys
 	}
 
-	s1, err := mt.ListCollections(0)
+	s1, err := mt.ListCollections("", 0)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t2)
+	s1, err = mt.ListCollections("", t2)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t1)
+	s1, err = mt.ListCollections("", t1)
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1})
 
-	s1, err = mt.ListCollections(tsoStart)
+	s1, err = mt.ListCollections("", tsoStart)
 	assert.Nil(t, err)
 	assert.Equal(t, 0, len(s1))
 
@@ -1097,3 +1158,587 @@ func TestMetaWithTimestamp(t *testing.T) {
 	_, err = mt.GetPartitionByName(2, partName2, tsoStart)
 	assert.NotNil(t, err)
 }
+
+func TestMetaTable_Alias(t *testing.T) {
+	const (
+		collID1   = typeutil.UniqueID(1)
+		collID2   = typeutil.UniqueID(2)
+		collName1 = "t1"
+		collName2 = "t2"
+		alias     = "a1"
+	)
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	var vtso typeutil.Timestamp = 100
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7, ftso)
+	assert.Nil(t, err)
+	mt, err := NewMetaTable(skv)
+	assert.Nil(t, err)
+
+	_, err = mt.AddCollection(&pb.CollectionInfo{ID: collID1, Schema: &schemapb.CollectionSchema{Name: collName1}}, nil, nil)
+	assert.Nil(t, err)
+	_, err = mt.AddCollection(&pb.CollectionInfo{ID: collID2, Schema: &schemapb.CollectionSchema{Name: collName2}}, nil, nil)
+	assert.Nil(t, err)
+
+	t.Run("add alias", func(t *testing.T) {
+		_, err := mt.AddAlias("", alias, "no-collection")
+		assert.NotNil(t, err)
+		_, err = mt.AddAlias("", collName2, collName1)
+		assert.NotNil(t, err)
+		_, err = mt.AlterAlias("", alias, collName1)
+		assert.NotNil(t, err)
+
+		_, err = mt.AddAlias("", alias, collName1)
+		assert.Nil(t, err)
+		_, err = mt.AddAlias("", alias, collName2)
+		assert.NotNil(t, err)
+		assert.True(t, mt.IsAlias("", alias))
+		assert.False(t, mt.IsAlias("", collName1))
+		assert.Equal(t, []string{alias}, mt.ListAliases(collID1))
+
+		coll, err := mt.GetCollectionByName("", alias, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, collID1, coll.ID)
+
+		_, err = mt.AddCollection(&pb.CollectionInfo{ID: 3, Schema: &schemapb.CollectionSchema{Name: alias}}, nil, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("alter alias", func(t *testing.T) {
+		ts1 := vtso
+		ts2, err := mt.AlterAlias("", alias, collName2)
+		assert.Nil(t, err)
+
+		coll, err := mt.GetCollectionByName("", alias, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, collID2, coll.ID)
+		coll, err = mt.GetCollectionByName("", alias, ts2)
+		assert.Nil(t, err)
+		assert.Equal(t, collID2, coll.ID)
+		coll, err = mt.GetCollectionByName("", alias, ts1)
+		assert.Nil(t, err)
+		assert.Equal(t, collID1, coll.ID)
+
+		// aliases survive reload
+		mt, err = NewMetaTable(skv)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{alias}, mt.ListAliases(collID2))
+	})
+
+	t.Run("drop alias", func(t *testing.T) {
+		// the alias whose name has the dropped one as prefix is kept
+		_, err := mt.AddAlias("", alias+"0", collName2)
+		assert.Nil(t, err)
+		_, err = mt.DeleteAlias("", alias)
+		assert.Nil(t, err)
+		_, err = mt.DeleteAlias("", alias)
+		assert.NotNil(t, err)
+		_, err = mt.GetCollectionByName("", alias, 0)
+		assert.NotNil(t, err)
+
+		mt, err = NewMetaTable(skv)
+		assert.Nil(t, err)
+		assert.False(t, mt.IsAlias("", alias))
+		assert.True(t, mt.IsAlias("", alias+"0"))
+	})
+
+	t.Run("drop collection with alias", func(t *testing.T) {
+		// the collection whose id has the dropped one as prefix is kept
+		collID10 := typeutil.UniqueID(10)
+		_, err := mt.AddCollection(&pb.CollectionInfo{ID: collID10, Schema: &schemapb.CollectionSchema{Name: "t10"}}, nil, nil)
+		assert.Nil(t, err)
+		_, err = mt.AddAlias("", alias, collName1)
+		assert.Nil(t, err)
+		_, err = mt.DeleteCollection(collID1, nil)
+		assert.Nil(t, err)
+		assert.False(t, mt.IsAlias("", alias))
+
+		mt, err = NewMetaTable(skv)
+		assert.Nil(t, err)
+		assert.False(t, mt.IsAlias("", alias))
+		assert.False(t, mt.HasCollection(collID1, 0))
+		assert.True(t, mt.HasCollection(collID10, 0))
+	})
+}
+
+func TestMetaTable_PurgeDroppedMeta(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	var vtso typeutil.Timestamp = 100
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7, ftso)
+	assert.Nil(t, err)
+	mt, err := NewMetaTable(skv)
+	assert.Nil(t, err)
+
+	for _, collID := range []typeutil.UniqueID{1, 10} {
+		_, err = mt.AddCollection(&pb.CollectionInfo{
+			ID:             collID,
+			Schema:         &schemapb.CollectionSchema{Name: fmt.Sprintf("coll-%d", collID)},
+			PartitionIDs:   []typeutil.UniqueID{collID * 100},
+			PartitionNames: []string{Params.DefaultPartitionName},
+		}, nil, nil)
+		assert.Nil(t, err)
+	}
+
+	_, err = mt.DeleteCollection(1, nil)
+	assert.Nil(t, err)
+	time.Sleep(10 * time.Millisecond)
+	beforeDrop := tsoutil.GetCurrentTime()
+	time.Sleep(10 * time.Millisecond)
+	_, err = mt.DeleteCollection(10, nil)
+	assert.Nil(t, err)
+
+	// the dropped meta is kept and reloaded
+	mt, err = NewMetaTable(skv)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(mt.droppedCollID2Meta))
+	assert.Equal(t, "coll-1", mt.droppedCollID2Meta[1].Schema.Name)
+
+	// only the collection dropped before the expire ts is purged, the one whose id has the same prefix is kept
+	assert.Nil(t, mt.PurgeDroppedMeta(beforeDrop))
+	mt, err = NewMetaTable(skv)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(mt.droppedCollID2Meta))
+	_, ok := mt.droppedCollID2Meta[10]
+	assert.True(t, ok)
+
+	assert.Nil(t, mt.PurgeDroppedMeta(tsoutil.GetCurrentTime()+1))
+	assert.Equal(t, 0, len(mt.droppedCollID2Meta))
+}
+
+func TestMetaTable_DroppedPartition(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	var vtso typeutil.Timestamp = 100
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7, ftso)
+	assert.Nil(t, err)
+	mt, err := NewMetaTable(skv)
+	assert.Nil(t, err)
+
+	_, err = mt.AddCollection(&pb.CollectionInfo{
+		ID:             1,
+		Schema:         &schemapb.CollectionSchema{Name: "coll"},
+		PartitionIDs:   []typeutil.UniqueID{100},
+		PartitionNames: []string{Params.DefaultPartitionName},
+	}, nil, nil)
+	assert.Nil(t, err)
+	_, err = mt.AddPartition(1, "p1", 101, nil)
+	assert.Nil(t, err)
+	_, partID, err := mt.DeletePartition(1, "p1", nil)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 101, partID)
+
+	mt, err = NewMetaTable(skv)
+	assert.Nil(t, err)
+	partInfo, ok := mt.droppedPartID2Meta[101]
+	assert.True(t, ok)
+	assert.Equal(t, "p1", partInfo.PartitionName)
+	assert.EqualValues(t, 1, partInfo.CollectionID)
+
+	assert.Nil(t, mt.PurgeDroppedMeta(partInfo.DropTime))
+	assert.Equal(t, 1, len(mt.droppedPartID2Meta))
+	assert.Nil(t, mt.PurgeDroppedMeta(partInfo.DropTime+1))
+	mt, err = NewMetaTable(skv)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(mt.droppedPartID2Meta))
+}
+
+func TestMetaTable_Database(t *testing.T) {
+	const (
+		dbID1    = typeutil.UniqueID(10)
+		collID1  = typeutil.UniqueID(1)
+		collID2  = typeutil.UniqueID(2)
+		dbName1  = "db1"
+		collName = "t1"
+	)
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	var vtso typeutil.Timestamp = 100
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7, ftso)
+	assert.Nil(t, err)
+	mt, err := NewMetaTable(skv)
+	assert.Nil(t, err)
+
+	t.Run("add database", func(t *testing.T) {
+		dbID, err := mt.GetDatabaseByName("")
+		assert.Nil(t, err)
+		assert.Equal(t, DefaultDatabaseID, dbID)
+		_, err = mt.GetDatabaseByName(dbName1)
+		assert.NotNil(t, err)
+
+		_, err = mt.AddDatabase(&pb.DatabaseInfo{ID: dbID1, Name: dbName1})
+		assert.Nil(t, err)
+		_, err = mt.AddDatabase(&pb.DatabaseInfo{ID: 11, Name: dbName1})
+		assert.NotNil(t, err)
+		_, err = mt.AddDatabase(&pb.DatabaseInfo{ID: 12, Name: Params.DefaultDatabaseName})
+		assert.NotNil(t, err)
+
+		dbID, err = mt.GetDatabaseByName(dbName1)
+		assert.Nil(t, err)
+		assert.Equal(t, dbID1, dbID)
+		assert.Equal(t, map[string]typeutil.UniqueID{Params.DefaultDatabaseName: DefaultDatabaseID, dbName1: dbID1}, mt.ListDatabases())
+	})
+
+	t.Run("same collection name in databases", func(t *testing.T) {
+		_, err := mt.AddCollection(&pb.CollectionInfo{ID: collID1, Schema: &schemapb.CollectionSchema{Name: collName}}, nil, nil)
+		assert.Nil(t, err)
+		_, err = mt.AddCollection(&pb.CollectionInfo{ID: collID2, DbId: dbID1, Schema: &schemapb.CollectionSchema{Name: collName}}, nil, nil)
+		assert.Nil(t, err)
+
+		coll, err := mt.GetCollectionByName("", collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, collID1, coll.ID)
+		coll, err = mt.GetCollectionByName(dbName1, collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, collID2, coll.ID)
+		coll, err = mt.GetCollectionByName(dbName1, collName, vtso)
+		assert.Nil(t, err)
+		assert.Equal(t, collID2, coll.ID)
+		_, err = mt.GetCollectionByName("no-db", collName, 0)
+		assert.NotNil(t, err)
+
+		colls, err := mt.ListCollections(dbName1, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]typeutil.UniqueID{collName: collID2}, colls)
+
+		// databases and their collections survive reload
+		mt, err = NewMetaTable(skv)
+		assert.Nil(t, err)
+		coll, err = mt.GetCollectionByName(dbName1, collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, collID2, coll.ID)
+	})
+
+	t.Run("delete database", func(t *testing.T) {
+		_, err := mt.DeleteDatabase(Params.DefaultDatabaseName)
+		assert.NotNil(t, err)
+		_, err = mt.DeleteDatabase(dbName1)
+		assert.NotNil(t, err)
+
+		_, err = mt.DeleteCollection(collID2, nil)
+		assert.Nil(t, err)
+		_, err = mt.DeleteDatabase(dbName1)
+		assert.Nil(t, err)
+		_, err = mt.GetDatabaseByName(dbName1)
+		assert.NotNil(t, err)
+
+		mt, err = NewMetaTable(skv)
+		assert.Nil(t, err)
+		_, err = mt.GetDatabaseByName(dbName1)
+		assert.NotNil(t, err)
+		coll, err := mt.GetCollectionByName("", collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, collID1, coll.ID)
+	})
+}
+
+func TestMetaTable_AddField(t *testing.T) {
+	const (
+		collID   = typeutil.UniqueID(1)
+		collName = "t1"
+	)
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	var vtso typeutil.Timestamp = 100
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7, ftso)
+	assert.Nil(t, err)
+	mt, err := NewMetaTable(skv)
+	assert.Nil(t, err)
+
+	schema := &schemapb.CollectionSchema{
+		Name: collName,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: RowIDField, Name: RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	_, err = mt.AddCollection(&pb.CollectionInfo{ID: collID, Schema: schema}, nil, nil)
+	assert.Nil(t, err)
+	createTs := vtso
+
+	field := &schemapb.FieldSchema{
+		Name:         "age",
+		DataType:     schemapb.DataType_Int32,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 18}},
+	}
+	_, err = mt.AddField(collID, field, nil)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 102, field.FieldID)
+
+	coll, err := mt.GetCollectionByID(collID, 0)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 1, coll.Schema.Version)
+	assert.Equal(t, 4, len(coll.Schema.Fields))
+	assert.Equal(t, "age", coll.Schema.Fields[3].Name)
+	assert.EqualValues(t, 18, coll.Schema.Fields[3].DefaultValue.GetIntData())
+
+	// the schema before the field is added is kept in the snapshot
+	coll, err = mt.GetCollectionByID(collID, createTs)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 0, coll.Schema.Version)
+	assert.Equal(t, 3, len(coll.Schema.Fields))
+
+	_, err = mt.AddField(collID, &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int32}, nil)
+	assert.NotNil(t, err)
+	_, err = mt.AddField(collID+1, &schemapb.FieldSchema{Name: "name", DataType: schemapb.DataType_String}, nil)
+	assert.NotNil(t, err)
+
+	mt, err = NewMetaTable(skv)
+	assert.Nil(t, err)
+	coll, err = mt.GetCollectionByID(collID, 0)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 1, coll.Schema.Version)
+	assert.Equal(t, 4, len(coll.Schema.Fields))
+}
+
+func TestMetaTable_MultiVectorFieldIndexes(t *testing.T) {
+	const (
+		collID   = typeutil.UniqueID(1)
+		collName = "t1"
+		segID    = typeutil.UniqueID(1000)
+	)
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	var vtso typeutil.Timestamp = 100
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7, ftso)
+	assert.Nil(t, err)
+	mt, err := NewMetaTable(skv)
+	assert.Nil(t, err)
+
+	schema := &schemapb.CollectionSchema{
+		Name: collName,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: RowIDField, Name: RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "image", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "text", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	_, err = mt.AddCollection(&pb.CollectionInfo{ID: collID, Schema: schema, PartitionIDs: []typeutil.UniqueID{10}, PartitionNames: []string{"_default"}}, nil, nil)
+	assert.Nil(t, err)
+
+	// the default indexes of both vector fields are kept
+	for i, fieldName := range []string{"image", "text"} {
+		idxInfo := &pb.IndexInfo{
+			IndexName:   Params.DefaultIndexName,
+			IndexID:     typeutil.UniqueID(2001 + i),
+			IndexParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}},
+		}
+		segIDs, field, err := mt.GetNotIndexedSegments("", collName, fieldName, idxInfo, []typeutil.UniqueID{segID})
+		assert.Nil(t, err)
+		assert.Equal(t, []typeutil.UniqueID{segID}, segIDs)
+		_, err = mt.AddIndex(&pb.SegmentIndexInfo{
+			CollectionID: collID,
+			PartitionID:  10,
+			SegmentID:    segID,
+			FieldID:      field.FieldID,
+			IndexID:      idxInfo.IndexID,
+			BuildID:      typeutil.UniqueID(3001 + i),
+			EnableIndex:  true,
+		})
+		assert.Nil(t, err)
+	}
+	for i, fieldID := range []int64{101, 102} {
+		idxMeta, err := mt.GetIndexByID(typeutil.UniqueID(2001 + i))
+		assert.Nil(t, err)
+		assert.Equal(t, Params.DefaultIndexName, idxMeta.IndexName)
+
+		info, err := mt.GetSegmentIndexInfoByID(segID, fieldID, "")
+		assert.Nil(t, err)
+		assert.True(t, info.EnableIndex)
+		assert.Equal(t, typeutil.UniqueID(3001+i), info.BuildID)
+	}
+
+	info, err := mt.GetSegmentIndexInfoByID(segID, 103, "")
+	assert.Nil(t, err)
+	assert.False(t, info.EnableIndex)
+}
+
+func TestMetaTable_Credential(t *testing.T) {
+	const (
+		username = "alice"
+		roleName = "reader"
+	)
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	var vtso typeutil.Timestamp = 100
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7, ftso)
+	assert.Nil(t, err)
+	mt, err := NewMetaTable(skv)
+	assert.Nil(t, err)
+
+	searchGrant := &milvuspb.Grant{CollectionName: "c1", Privilege: commonpb.Privilege_PrivilegeSearch}
+	queryGrant := &milvuspb.Grant{DbName: AnyName, CollectionName: AnyName, Privilege: commonpb.Privilege_PrivilegeQuery}
+
+	t.Run("builtin credentials", func(t *testing.T) {
+		root, roles, err := mt.GetUser(RootUserName)
+		assert.Nil(t, err)
+		assert.Nil(t, bcrypt.CompareHashAndPassword([]byte(root.PasswordHash), []byte(Params.RootPassword)))
+		assert.Equal(t, 1, len(roles))
+		assert.Equal(t, AdminRoleName, roles[0].RoleName)
+		assert.Equal(t, commonpb.Privilege_PrivilegeAdmin, roles[0].Grants[0].Privilege)
+
+		_, err = mt.AddUser(&pb.UserInfo{Name: RootUserName})
+		assert.NotNil(t, err)
+		_, err = mt.GrantRole(AdminRoleName, "", []*milvuspb.Grant{searchGrant})
+		assert.NotNil(t, err)
+		_, err = mt.RevokeRole(AdminRoleName, RootUserName, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("add user", func(t *testing.T) {
+		passwordHash, err := hashPassword("123456")
+		assert.Nil(t, err)
+		_, err = mt.AddUser(&pb.UserInfo{Name: username, PasswordHash: passwordHash})
+		assert.Nil(t, err)
+		_, err = mt.AddUser(&pb.UserInfo{Name: username, PasswordHash: passwordHash})
+		assert.NotNil(t, err)
+		_, err = mt.AddUser(&pb.UserInfo{Name: "bob", Roles: []string{"no-role"}})
+		assert.NotNil(t, err)
+
+		user, roles, err := mt.GetUser(username)
+		assert.Nil(t, err)
+		assert.Equal(t, passwordHash, user.PasswordHash)
+		assert.Equal(t, 0, len(roles))
+		_, _, err = mt.GetUser("bob")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("grant role", func(t *testing.T) {
+		_, err := mt.GrantRole(roleName, "bob", nil)
+		assert.NotNil(t, err)
+
+		_, err = mt.GrantRole(roleName, username, []*milvuspb.Grant{searchGrant})
+		assert.Nil(t, err)
+		_, err = mt.GrantRole(roleName, "", []*milvuspb.Grant{searchGrant, queryGrant})
+		assert.Nil(t, err)
+
+		roles, err := mt.ListGrants(username)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(roles))
+		assert.Equal(t, roleName, roles[0].RoleName)
+		// an empty database name refers to the default database
+		assert.Equal(t, 2, len(roles[0].Grants))
+		assert.Equal(t, Params.DefaultDatabaseName, roles[0].Grants[0].DbName)
+		assert.True(t, proto.Equal(queryGrant, roles[0].Grants[1]))
+
+		roles, err = mt.ListGrants("")
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(roles))
+		_, err = mt.ListGrants("bob")
+		assert.NotNil(t, err)
+
+		// users and roles survive reload
+		mt, err = NewMetaTable(skv)
+		assert.Nil(t, err)
+		_, roles, err = mt.GetUser(username)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(roles))
+		assert.Equal(t, 2, len(roles[0].Grants))
+	})
+
+	t.Run("revoke role", func(t *testing.T) {
+		_, err := mt.RevokeRole("no-role", username, nil)
+		assert.NotNil(t, err)
+
+		_, err = mt.RevokeRole(roleName, "", []*milvuspb.Grant{searchGrant})
+		assert.Nil(t, err)
+		roles, err := mt.ListGrants(username)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(roles[0].Grants))
+		assert.True(t, proto.Equal(queryGrant, roles[0].Grants[0]))
+
+		_, err = mt.RevokeRole(roleName, username, nil)
+		assert.Nil(t, err)
+		mt, err = NewMetaTable(skv)
+		assert.Nil(t, err)
+		roles, err = mt.ListGrants(username)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(roles))
+	})
+}
@@ -39,13 +39,18 @@ type ParamTable struct {
 	StatisticsChannel string
 
 	MaxPartitionNum             int64
+	DefaultDatabaseName         string
 	DefaultPartitionName        string
 	DefaultIndexName            string
 	MinSegmentSizeToEnableIndex int64
+	RootPassword                string
 
 	Timeout          int
 	TimeTickInterval int
 
+	// seconds of history retained for time travel
+	RetentionDuration int64
+
 	Log log.Config
 
 	RoleName string
@@ -72,11 +77,14 @@ func (p *ParamTable) Init() {
 
 		p.initMaxPartitionNum()
 		p.initMinSegmentSizeToEnableIndex()
+		p.initDefaultDatabaseName()
 		p.initDefaultPartitionName()
 		p.initDefaultIndexName()
+		p.initRootPassword()
 
 		p.initTimeout()
 		p.initTimeTickInterval()
+		p.initRetentionDuration()
 
 		p.initLogCfg()
 		p.initRoleName()
@@ -163,6 +171,22 @@ func (p *ParamTable) initMinSegmentSizeToEnableIndex() {
 	p.MinSegmentSizeToEnableIndex = p.ParseInt64("rootcoord.minSegmentSizeToEnableIndex")
 }
 
+func (p *ParamTable) initDefaultDatabaseName() {
+	name, err := p.Load("common.defaultDatabaseName")
+	if err != nil {
+		panic(err)
+	}
+	p.DefaultDatabaseName = name
+}
+
+func (p *ParamTable) initRootPassword() {
+	password, err := p.Load("common.security.rootPassword")
+	if err != nil {
+		panic(err)
+	}
+	p.RootPassword = password
+}
+
 func (p *ParamTable) initDefaultPartitionName() {
 	name, err := p.Load("common.defaultPartitionName")
 	if err != nil {
@@ -187,6 +211,10 @@ func (p *ParamTable) initTimeTickInterval() {
 	p.TimeTickInterval = p.ParseInt("rootcoord.timeTickInterval")
 }
 
+func (p *ParamTable) initRetentionDuration() {
+	p.RetentionDuration = p.ParseInt64("common.retentionDuration")
+}
+
 func (p *ParamTable) initLogCfg() {
 	p.Log = log.Config{}
 	format, err := p.Load("log.format")
@@ -94,6 +94,9 @@ type Core struct {
 	//setMsgStreams, send drop collection into dd channel, and notify the proxy to delete this collection
 	SendDdDropCollectionReq func(ctx context.Context, req *internalpb.DropCollectionRequest, channelNames []string) error
 
+	//setMsgStreams, send add field into dd channel
+	SendDdAddFieldReq func(ctx context.Context, req *internalpb.AddFieldRequest, channelNames []string) error
+
 	//setMsgStreams, send create partition into dd channel
 	SendDdCreatePartitionReq func(ctx context.Context, req *internalpb.CreatePartitionRequest, channelNames []string) error
 
@@ -190,6 +193,9 @@ func (c *Core) checkInit() error {
 	if c.SendDdDropCollectionReq == nil {
 		return fmt.Errorf("SendDdDropCollectionReq is nil")
 	}
+	if c.SendDdAddFieldReq == nil {
+		return fmt.Errorf("SendDdAddFieldReq is nil")
+	}
 	if c.SendDdCreatePartitionReq == nil {
 		return fmt.Errorf("SendDdCreatePartitionReq is nil")
 	}
@@ -365,6 +371,25 @@ func (c *Core) checkFlushedSegmentsLoop() {
 	}
 }
 
+// purgeDroppedMetaLoop purges the meta of the collections and partitions dropped out of the retention duration
+func (c *Core) purgeDroppedMetaLoop() {
+	ticker := time.NewTicker(10 * time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			log.Debug("RootCoord context done,exit purgeDroppedMetaLoop")
+			return
+		case <-ticker.C:
+			retention := time.Duration(Params.RetentionDuration) * time.Second
+			expireTs := tsoutil.SubPhysicalDuration(tsoutil.GetCurrentTime(), retention)
+			if err := c.MetaTable.PurgeDroppedMeta(expireTs); err != nil {
+				log.Warn("purge dropped meta failed", zap.Error(err))
+			}
+		}
+	}
+}
+
 func (c *Core) getSegments(ctx context.Context, collID typeutil.UniqueID) (map[typeutil.UniqueID]typeutil.UniqueID, error) {
 	collMeta, err := c.MetaTable.GetCollectionByID(collID, 0)
 	if err != nil {
@@ -385,6 +410,42 @@ func (c *Core) getSegments(ctx context.Context, collID typeutil.UniqueID) (map[t
 	return segID2PartID, nil
 }
 
+// invalidateAliasMetaCache tells the proxies to drop the cached meta of the alias,
+// so requests against it are routed to the collection it points to at ts
+func (c *Core) invalidateAliasMetaCache(ctx context.Context, ts typeutil.Timestamp, dbName string, alias string) {
+	req := proxypb.InvalidateCollMetaCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   0, //TODO, msg type
+			MsgID:     0, //TODO, msg id
+			Timestamp: ts,
+			SourceID:  c.session.ServerID,
+		},
+		DbName:         dbName,
+		CollectionName: alias,
+	}
+	// error doesn't matter here
+	c.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
+}
+
+// invalidateCredentialCache tells the proxies to drop the cached credential of the user,
+// all the cached credentials are dropped if the privileges of a role are changed
+func (c *Core) invalidateCredentialCache(ctx context.Context, username string, roleChanged bool) {
+	if roleChanged {
+		username = ""
+	} else if username == "" {
+		return
+	}
+	req := proxypb.InvalidateCredCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_InvalidateCredentialCache,
+			SourceID: c.session.ServerID,
+		},
+		Username: username,
+	}
+	// error doesn't matter here
+	c.proxyClientManager.InvalidateCredentialCache(ctx, &req)
+}
+
 func (c *Core) setDdMsgSendFlag(b bool) error {
 	flag, err := c.MetaTable.client.Load(DDMsgSendPrefix, 0)
 	if err != nil {
@@ -497,6 +558,22 @@ func (c *Core) setMsgStreams() error {
 		return c.dmlChannels.BroadcastAll(channelNames, &msgPack)
 	}
 
+	c.SendDdAddFieldReq = func(ctx context.Context, req *internalpb.AddFieldRequest, channelNames []string) error {
+		msgPack := ms.MsgPack{}
+		baseMsg := ms.BaseMsg{
+			Ctx:            ctx,
+			BeginTimestamp: req.Base.Timestamp,
+			EndTimestamp:   req.Base.Timestamp,
+			HashValues:     []uint32{0},
+		}
+		msg := &ms.AddFieldMsg{
+			BaseMsg:         baseMsg,
+			AddFieldRequest: *req,
+		}
+		msgPack.Msgs = append(msgPack.Msgs, msg)
+		return c.dmlChannels.BroadcastAll(channelNames, &msgPack)
+	}
+
 	c.SendDdCreatePartitionReq = func(ctx context.Context, req *internalpb.CreatePartitionRequest, channelNames []string) error {
 		msgPack := ms.MsgPack{}
 		baseMsg := ms.BaseMsg{
@@ -993,7 +1070,7 @@ func (c *Core) reSendDdMsg(ctx context.Context) error {
 		if err = proto.UnmarshalText(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1005,7 +1082,7 @@ func (c *Core) reSendDdMsg(ctx context.Context) error {
 		if err = proto.UnmarshalText(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1024,12 +1101,35 @@ func (c *Core) reSendDdMsg(ctx context.Context) error {
 		}
 		c.proxyClientManager.InvalidateCollectionMetaCache(c.ctx, &req)
 
+	case AddFieldDDType:
+		var ddReq = internalpb.AddFieldRequest{}
+		if err = proto.UnmarshalText(ddOp.Body, &ddReq); err != nil {
+			return err
+		}
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
+		if err != nil {
+			return err
+		}
+		if err = c.SendDdAddFieldReq(ctx, &ddReq, collInfo.PhysicalChannelNames); err != nil {
+			return err
+		}
+		req := proxypb.InvalidateCollMetaCacheRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   0, //TODO, msg type
+				MsgID:     0, //TODO, msg id
+				Timestamp: ddReq.Base.Timestamp,
+				SourceID:  c.session.ServerID,
+			},
+			DbName:         ddReq.DbName,
+			CollectionName: ddReq.CollectionName,
+		}
+		c.proxyClientManager.InvalidateCollectionMetaCache(c.ctx, &req)
 	case CreatePartitionDDType:
 		var ddReq = internalpb.CreatePartitionRequest{}
 		if err = proto.UnmarshalText(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1052,7 +1152,7 @@ func (c *Core) reSendDdMsg(ctx context.Context) error {
 		if err = proto.UnmarshalText(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1101,6 +1201,7 @@ func (c *Core) Start() error {
 		go c.sessionLoop()
 		go c.chanTimeTick.StartWatch()
 		go c.checkFlushedSegmentsLoop()
+		go c.purgeDroppedMetaLoop()
 		c.stateCode.Store(internalpb.StateCode_Healthy)
 	})
 	log.Debug(typeutil.RootCoordRole, zap.String("State Code", internalpb.StateCode_name[int32(internalpb.StateCode_Healthy)]))
@@ -1159,6 +1260,288 @@ func (c *Core) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringRespon
 	}, nil
 }
 
+func (c *Core) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	metrics.RootCoordCreateDatabaseCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("CreateDatabase", zap.String("db name", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	t := &CreateDatabaseReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("CreateDatabase Failed", zap.String("db name", in.DbName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "CreateDatabase failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateDatabase Success", zap.String("db name", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordCreateDatabaseCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDropDatabaseCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DropDatabase", zap.String("db name", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	t := &DropDatabaseReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("DropDatabase Failed", zap.String("db name", in.DbName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "DropDatabase failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("DropDatabase Success", zap.String("db name", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordDropDatabaseCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	metrics.RootCoordListDatabasesCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("ListDatabases", zap.Int64("msgID", in.Base.MsgID))
+	t := &ListDatabasesReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.ListDatabasesResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("ListDatabases failed", zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "ListDatabases failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListDatabases Success", zap.Strings("db names", t.Rsp.DbNames), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordListDatabasesCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
+func (c *Core) CreateUser(ctx context.Context, in *milvuspb.CreateUserRequest) (*commonpb.Status, error) {
+	metrics.RootCoordCreateUserCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("CreateUser", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	t := &CreateUserReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("CreateUser Failed", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "CreateUser failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateUser Success", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordCreateUserCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) GrantRole(ctx context.Context, in *milvuspb.GrantRoleRequest) (*commonpb.Status, error) {
+	metrics.RootCoordGrantRoleCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("GrantRole", zap.String("role name", in.RoleName), zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	t := &GrantRoleReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("GrantRole Failed", zap.String("role name", in.RoleName), zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "GrantRole failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("GrantRole Success", zap.String("role name", in.RoleName), zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordGrantRoleCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) RevokeRole(ctx context.Context, in *milvuspb.RevokeRoleRequest) (*commonpb.Status, error) {
+	metrics.RootCoordRevokeRoleCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("RevokeRole", zap.String("role name", in.RoleName), zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	t := &RevokeRoleReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("RevokeRole Failed", zap.String("role name", in.RoleName), zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "RevokeRole failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("RevokeRole Success", zap.String("role name", in.RoleName), zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordRevokeRoleCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) ListGrants(ctx context.Context, in *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	metrics.RootCoordListGrantsCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListGrantsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("ListGrants", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	t := &ListGrantsReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.ListGrantsResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("ListGrants failed", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.ListGrantsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "ListGrants failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListGrants Success", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordListGrantsCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
+func (c *Core) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	metrics.RootCoordGetCredentialCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("GetCredential", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	t := &GetCredentialReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &rootcoordpb.GetCredentialResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("GetCredential failed", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "GetCredential failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("GetCredential Success", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordGetCredentialCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
 func (c *Core) CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	metrics.RootCoordCreateCollectionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
 	code := c.stateCode.Load().(internalpb.StateCode)
@@ -1355,6 +1738,138 @@ func (c *Core) ShowCollections(ctx context.Context, in *milvuspb.ShowCollections
 	return t.Rsp, nil
 }
 
+func (c *Core) CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
+	metrics.RootCoordCreateAliasCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("CreateAlias", zap.String("alias", in.Alias), zap.String("collection name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID))
+	t := &CreateAliasReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("CreateAlias Failed", zap.String("alias", in.Alias), zap.String("collection name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "CreateAlias failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateAlias Success", zap.String("alias", in.Alias), zap.String("collection name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordCreateAliasCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDropAliasCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DropAlias", zap.String("alias", in.Alias), zap.Int64("msgID", in.Base.MsgID))
+	t := &DropAliasReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("DropAlias Failed", zap.String("alias", in.Alias), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "DropAlias failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("DropAlias Success", zap.String("alias", in.Alias), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordDropAliasCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
+	metrics.RootCoordAlterAliasCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("AlterAlias", zap.String("alias", in.Alias), zap.String("collection name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID))
+	t := &AlterAliasReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("AlterAlias Failed", zap.String("alias", in.Alias), zap.String("collection name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "AlterAlias failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("AlterAlias Success", zap.String("alias", in.Alias), zap.String("collection name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordAlterAliasCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) AddField(ctx context.Context, in *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	metrics.RootCoordAddFieldCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("AddField", zap.String("collection name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID))
+	t := &AddFieldReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("AddField Failed", zap.String("collection name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "add field failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("AddField Success", zap.String("collection name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID))
+	metrics.RootCoordAddFieldCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsSuccess).Inc()
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func (c *Core) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	metrics.RootCoordCreatePartitionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
 	code := c.stateCode.Load().(internalpb.StateCode)
@@ -41,11 +41,13 @@ import (
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	"go.etcd.io/etcd/clientv3"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type proxyMock struct {
 	types.Proxy
 	collArray []string
+	userArray []string
 	mutex     sync.Mutex
 }
 
@@ -57,6 +59,21 @@ func (p *proxyMock) InvalidateCollectionMetaCache(ctx context.Context, request *
 		ErrorCode: commonpb.ErrorCode_Success,
 	}, nil
 }
+func (p *proxyMock) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.userArray = append(p.userArray, request.Username)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+func (p *proxyMock) GetUserArray() []string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	ret := make([]string, 0, len(p.userArray))
+	ret = append(ret, p.userArray...)
+	return ret
+}
 func (p *proxyMock) GetCollArray() []string {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
@@ -370,6 +387,128 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, ttm2.Base.Timestamp, ttm.Base.Timestamp+1)
 	})
 
+	t.Run("create database", func(t *testing.T) {
+		req := &milvuspb.CreateDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_CreateDatabase,
+				MsgID:     90,
+				Timestamp: 90,
+				SourceID:  90,
+			},
+			DbName: dbName,
+		}
+		status, err := core.CreateDatabase(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.CreateDatabase(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+
+		rsp, err := core.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_ListDatabases,
+				MsgID:     91,
+				Timestamp: 91,
+				SourceID:  91,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.ElementsMatch(t, []string{Params.DefaultDatabaseName, dbName}, rsp.DbNames)
+	})
+
+	t.Run("credential", func(t *testing.T) {
+		const username = "alice"
+		status, err := core.CreateUser(ctx, &milvuspb.CreateUserRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_CreateUser,
+				MsgID:     92,
+				Timestamp: 92,
+				SourceID:  92,
+			},
+			Username: username,
+			Password: "123456",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		grant := &milvuspb.Grant{
+			DbName:         dbName,
+			CollectionName: collName,
+			Privilege:      commonpb.Privilege_PrivilegeSearch,
+		}
+		status, err = core.GrantRole(ctx, &milvuspb.GrantRoleRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_GrantRole,
+				MsgID:     93,
+				Timestamp: 93,
+				SourceID:  93,
+			},
+			RoleName: "searcher",
+			Username: username,
+			Grants:   []*milvuspb.Grant{grant},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		// the privileges of a role changed, all the cached credentials are invalidated
+		assert.Equal(t, []string{""}, pnm.GetUserArray())
+
+		rsp, err := core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_GetCredential,
+				MsgID:     94,
+				Timestamp: 94,
+				SourceID:  94,
+			},
+			Username: username,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Nil(t, bcrypt.CompareHashAndPassword([]byte(rsp.PasswordHash), []byte("123456")))
+		assert.Equal(t, 1, len(rsp.Roles))
+		assert.True(t, proto.Equal(grant, rsp.Roles[0].Grants[0]))
+
+		status, err = core.RevokeRole(ctx, &milvuspb.RevokeRoleRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_RevokeRole,
+				MsgID:     95,
+				Timestamp: 95,
+				SourceID:  95,
+			},
+			RoleName: "searcher",
+			Username: username,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		assert.Equal(t, []string{"", username}, pnm.GetUserArray())
+
+		grantsRsp, err := core.ListGrants(ctx, &milvuspb.ListGrantsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_ListGrants,
+				MsgID:     96,
+				Timestamp: 96,
+				SourceID:  96,
+			},
+			Username: username,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, grantsRsp.Status.ErrorCode)
+		assert.Equal(t, 0, len(grantsRsp.Roles))
+
+		rsp, err = core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_GetCredential,
+				MsgID:     97,
+				Timestamp: 97,
+				SourceID:  97,
+			},
+			Username: "bob",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, rsp.Status.ErrorCode)
+	})
+
 	t.Run("create collection", func(t *testing.T) {
 		schema := schemapb.CollectionSchema{
 			Name:   collName,
@@ -420,7 +559,7 @@ func TestRootCoord(t *testing.T) {
 		assert.True(t, ok)
 		createMsg, ok := (msgPack.Msgs[0]).(*msgstream.CreateCollectionMsg)
 		assert.True(t, ok)
-		createMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		createMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, createMeta.ID, createMsg.CollectionID)
 		assert.Equal(t, 1, len(createMeta.PartitionIDs))
@@ -549,7 +688,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -604,7 +743,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Nil(t, err)
 		t.Log(status.Reason)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.PartitionIDs))
 		partNameIdx1, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[1], 0)
@@ -658,7 +797,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("show partition", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.ShowPartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -679,7 +818,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("show segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		dm.mu.Lock()
@@ -711,7 +850,7 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 180,
 				SourceID:  180,
 			},
-			DbName:         "",
+			DbName:         dbName,
 			CollectionName: collName,
 			FieldName:      "vector",
 			ExtraParams: []*commonpb.KeyValuePair{
@@ -721,7 +860,7 @@ func TestRootCoord(t *testing.T) {
 				},
 			},
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(collMeta.FieldIndexes))
 
@@ -732,7 +871,7 @@ func TestRootCoord(t *testing.T) {
 		files := im.getFileArray()
 		assert.Equal(t, 3, len(files))
 		assert.ElementsMatch(t, files, []string{"file0-100", "file1-100", "file2-100"})
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 		idxMeta, err := core.MetaTable.GetIndexByID(collMeta.FieldIndexes[0].IndexID)
@@ -746,7 +885,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("describe segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 
 		req := &milvuspb.DescribeSegmentRequest{
@@ -773,7 +912,7 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 200,
 				SourceID:  200,
 			},
-			DbName:         "",
+			DbName:         dbName,
 			CollectionName: collName,
 			FieldName:      "vector",
 			IndexName:      "",
@@ -794,7 +933,7 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 200,
 				SourceID:  200,
 			},
-			DbName:         "",
+			DbName:         dbName,
 			CollectionName: collName,
 			FieldName:      "vector",
 			IndexName:      "not-exist-index",
@@ -806,7 +945,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("flush segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 
@@ -831,7 +970,7 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 210,
 				SourceID:  210,
 			},
-			DbName:         "",
+			DbName:         dbName,
 			CollectionName: collName,
 			FieldName:      "vector",
 			IndexName:      "",
@@ -851,7 +990,7 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 211,
 				SourceID:  211,
 			},
-			DbName:         "",
+			DbName:         dbName,
 			CollectionName: collName,
 			FieldName:      "vector",
 			ExtraParams: []*commonpb.KeyValuePair{
@@ -862,7 +1001,7 @@ func TestRootCoord(t *testing.T) {
 			},
 		}
 
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 		oldIdx := collMeta.FieldIndexes[0].IndexID
@@ -872,7 +1011,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
 		time.Sleep(100 * time.Millisecond)
 
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.FieldIndexes))
 		assert.Equal(t, oldIdx, collMeta.FieldIndexes[0].IndexID)
@@ -895,12 +1034,12 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 215,
 				SourceID:  215,
 			},
-			DbName:         "",
+			DbName:         dbName,
 			CollectionName: collName,
 			FieldName:      "vector",
 			IndexName:      Params.DefaultIndexName,
 		}
-		_, idx, err := core.MetaTable.GetIndexByName(collName, Params.DefaultIndexName)
+		_, idx, err := core.MetaTable.GetIndexByName(dbName, collName, Params.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(idx))
 
@@ -913,7 +1052,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, idx[0].IndexID, im.idxDropID[0])
 		im.mutex.Unlock()
 
-		_, idx, err = core.MetaTable.GetIndexByName(collName, Params.DefaultIndexName)
+		_, idx, err = core.MetaTable.GetIndexByName(dbName, collName, Params.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(idx))
 	})
@@ -930,13 +1069,13 @@ func TestRootCoord(t *testing.T) {
 			CollectionName: collName,
 			PartitionName:  partName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		dropPartID := collMeta.PartitionIDs[1]
 		status, err := core.DropPartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		partName, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[0], 0)
@@ -971,6 +1110,94 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, dropPartID, ddReq.PartitionID)
 	})
 
+	t.Run("alias", func(t *testing.T) {
+		const aliasName = "testAlias"
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
+		assert.Nil(t, err)
+
+		status, err := core.CreateAlias(ctx, &milvuspb.CreateAliasRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateAlias,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+			Alias:          aliasName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		rsp, err := core.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_DescribeCollection,
+			},
+			DbName:         dbName,
+			CollectionName: aliasName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Equal(t, collMeta.ID, rsp.CollectionID)
+		assert.Equal(t, collName, rsp.Schema.Name)
+
+		status, err = core.AlterAlias(ctx, &milvuspb.AlterAliasRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_AlterAlias,
+			},
+			DbName:         dbName,
+			CollectionName: "notExistColl",
+			Alias:          aliasName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+
+		status, err = core.AlterAlias(ctx, &milvuspb.AlterAliasRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_AlterAlias,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+			Alias:          aliasName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.DropAlias(ctx, &milvuspb.DropAliasRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_DropAlias,
+			},
+			DbName: dbName,
+			Alias:  aliasName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		assert.False(t, core.MetaTable.IsAlias(dbName, aliasName))
+
+		collArray := pnm.GetCollArray()
+		assert.Equal(t, 5, len(collArray))
+		assert.Equal(t, []string{aliasName, aliasName, aliasName}, collArray[2:])
+
+		// the collection can't be dropped via its alias
+		status, err = core.CreateAlias(ctx, &milvuspb.CreateAliasRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateAlias,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+			Alias:          aliasName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		status, err = core.DropCollection(ctx, &milvuspb.DropCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_DropCollection,
+			},
+			DbName:         dbName,
+			CollectionName: aliasName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+		assert.Equal(t, 6, len(pnm.GetCollArray()))
+	})
+
 	t.Run("drop collection", func(t *testing.T) {
 		req := &milvuspb.DropCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -982,7 +1209,7 @@ func TestRootCoord(t *testing.T) {
 			DbName:         dbName,
 			CollectionName: collName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		status, err := core.DropCollection(ctx, req)
 		assert.Nil(t, err)
@@ -997,8 +1224,11 @@ func TestRootCoord(t *testing.T) {
 		assert.True(t, ok)
 		assert.Equal(t, collMeta.ID, dmsg.CollectionID)
 		collArray := pnm.GetCollArray()
-		assert.Equal(t, 3, len(collArray))
-		assert.Equal(t, collName, collArray[2])
+		assert.Equal(t, 8, len(collArray))
+		assert.Equal(t, collName, collArray[6])
+		// the alias created in the alias test is dropped with the collection
+		assert.Equal(t, "testAlias", collArray[7])
+		assert.False(t, core.MetaTable.IsAlias(dbName, "testAlias"))
 
 		time.Sleep(100 * time.Millisecond)
 		qm.mutex.Lock()
@@ -1021,8 +1251,8 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
 		time.Sleep(100 * time.Millisecond)
 		collArray = pnm.GetCollArray()
-		assert.Equal(t, 3, len(collArray))
-		assert.Equal(t, collName, collArray[2])
+		assert.Equal(t, 8, len(collArray))
+		assert.Equal(t, collName, collArray[6])
 
 		// check DD operation info
 		flag, err := core.MetaTable.client.Load(DDMsgSendPrefix, 0)
@@ -1041,6 +1271,27 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, collMeta.ID, ddReq.CollectionID)
 	})
 
+	t.Run("drop database", func(t *testing.T) {
+		req := &milvuspb.DropDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_DropDatabase,
+				MsgID:     240,
+				Timestamp: 240,
+				SourceID:  240,
+			},
+			DbName: Params.DefaultDatabaseName,
+		}
+		status, err := core.DropDatabase(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+
+		// testColl-again created in the create collection test is left in the database
+		req.DbName = dbName
+		status, err = core.DropDatabase(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+	})
+
 	t.Run("context_cancel", func(t *testing.T) {
 		ctx2, cancel2 := context.WithTimeout(ctx, time.Millisecond*100)
 		defer cancel2()
@@ -1756,6 +2007,18 @@ func TestRootCoord2(t *testing.T) {
 		assert.Greater(t, ttm.Base.Timestamp, typeutil.Timestamp(0))
 	})
 
+	t.Run("create database", func(t *testing.T) {
+		status, err := core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_CreateDatabase,
+				Timestamp: 90,
+			},
+			DbName: dbName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
 	t.Run("create collection", func(t *testing.T) {
 		schema := schemapb.CollectionSchema{
 			Name: collName,
@@ -1789,6 +2052,70 @@ func TestRootCoord2(t *testing.T) {
 		assert.True(t, ok)
 		t.Log("time tick", m1.Base.Timestamp)
 	})
+
+	t.Run("add field", func(t *testing.T) {
+		field := &schemapb.FieldSchema{
+			Name:         "age",
+			DataType:     schemapb.DataType_Int32,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 18}},
+		}
+		fbf, err := proto.Marshal(field)
+		assert.Nil(t, err)
+		req := &milvuspb.AddFieldRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_AddField,
+				Timestamp: 110,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+			Schema:         fbf,
+		}
+		status, err := core.AddField(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, collMeta.Schema.Version)
+		added := collMeta.Schema.Fields[len(collMeta.Schema.Fields)-1]
+		assert.Equal(t, "age", added.Name)
+		assert.EqualValues(t, 18, added.DefaultValue.GetIntData())
+
+		// check DD operation info
+		flag, err := core.MetaTable.client.Load(DDMsgSendPrefix, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, "true", flag)
+		ddOpStr, err := core.MetaTable.client.Load(DDOperationPrefix, 0)
+		assert.Nil(t, err)
+		var ddOp DdOperation
+		err = DecodeDdOperation(ddOpStr, &ddOp)
+		assert.Nil(t, err)
+		assert.Equal(t, AddFieldDDType, ddOp.Type)
+
+		var ddReq = internalpb.AddFieldRequest{}
+		err = proto.UnmarshalText(ddOp.Body, &ddReq)
+		assert.Nil(t, err)
+		assert.Equal(t, collMeta.ID, ddReq.CollectionID)
+		assert.EqualValues(t, 1, ddReq.SchemaVersion)
+		var ddField schemapb.FieldSchema
+		err = proto.Unmarshal(ddReq.Schema, &ddField)
+		assert.Nil(t, err)
+		assert.Equal(t, added.FieldID, ddField.FieldID)
+
+		// the field without default value, the primary key and the duplicate field are rejected
+		for _, f := range []*schemapb.FieldSchema{
+			{Name: "name", DataType: schemapb.DataType_String},
+			{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}}},
+			field,
+		} {
+			req.Schema, err = proto.Marshal(f)
+			assert.Nil(t, err)
+			status, err = core.AddField(ctx, req)
+			assert.Nil(t, err)
+			assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+		}
+	})
 }
 
 func TestCheckInit(t *testing.T) {
@@ -1849,6 +2176,12 @@ func TestCheckInit(t *testing.T) {
 	err = c.checkInit()
 	assert.NotNil(t, err)
 
+	c.SendDdAddFieldReq = func(context.Context, *internalpb.AddFieldRequest, []string) error {
+		return nil
+	}
+	err = c.checkInit()
+	assert.NotNil(t, err)
+
 	c.SendDdCreatePartitionReq = func(context.Context, *internalpb.CreatePartitionRequest, []string) error {
 		return nil
 	}
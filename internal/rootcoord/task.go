@@ -22,6 +22,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
@@ -64,6 +65,198 @@ func executeTask(t reqTask) error {
 	}
 }
 
+type CreateDatabaseReqTask struct {
+	baseReqTask
+	Req *milvuspb.CreateDatabaseRequest
+}
+
+func (t *CreateDatabaseReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *CreateDatabaseReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_CreateDatabase {
+		return fmt.Errorf("create database, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	dbID, _, err := t.core.IDAllocator(1)
+	if err != nil {
+		return err
+	}
+	dbInfo := etcdpb.DatabaseInfo{
+		ID:         dbID,
+		Name:       t.Req.DbName,
+		CreateTime: t.Req.Base.Timestamp,
+	}
+	ts, err := t.core.MetaTable.AddDatabase(&dbInfo)
+	if err != nil {
+		return err
+	}
+	t.core.SendTimeTick(ts)
+	return nil
+}
+
+type DropDatabaseReqTask struct {
+	baseReqTask
+	Req *milvuspb.DropDatabaseRequest
+}
+
+func (t *DropDatabaseReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *DropDatabaseReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_DropDatabase {
+		return fmt.Errorf("drop database, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	ts, err := t.core.MetaTable.DeleteDatabase(t.Req.DbName)
+	if err != nil {
+		return err
+	}
+	t.core.SendTimeTick(ts)
+	return nil
+}
+
+type ListDatabasesReqTask struct {
+	baseReqTask
+	Req *milvuspb.ListDatabasesRequest
+	Rsp *milvuspb.ListDatabasesResponse
+}
+
+func (t *ListDatabasesReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *ListDatabasesReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_ListDatabases {
+		return fmt.Errorf("list databases, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	for name, id := range t.core.MetaTable.ListDatabases() {
+		t.Rsp.DbNames = append(t.Rsp.DbNames, name)
+		t.Rsp.DbIds = append(t.Rsp.DbIds, id)
+	}
+	return nil
+}
+
+type CreateUserReqTask struct {
+	baseReqTask
+	Req *milvuspb.CreateUserRequest
+}
+
+func (t *CreateUserReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *CreateUserReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_CreateUser {
+		return fmt.Errorf("create user, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	passwordHash, err := hashPassword(t.Req.Password)
+	if err != nil {
+		return err
+	}
+	userInfo := etcdpb.UserInfo{
+		Name:         t.Req.Username,
+		PasswordHash: passwordHash,
+		CreateTime:   t.Req.Base.Timestamp,
+	}
+	ts, err := t.core.MetaTable.AddUser(&userInfo)
+	if err != nil {
+		return err
+	}
+	t.core.SendTimeTick(ts)
+	return nil
+}
+
+type GrantRoleReqTask struct {
+	baseReqTask
+	Req *milvuspb.GrantRoleRequest
+}
+
+func (t *GrantRoleReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *GrantRoleReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_GrantRole {
+		return fmt.Errorf("grant role, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	ts, err := t.core.MetaTable.GrantRole(t.Req.RoleName, t.Req.Username, t.Req.Grants)
+	if err != nil {
+		return err
+	}
+	t.core.SendTimeTick(ts)
+	t.core.invalidateCredentialCache(ctx, t.Req.Username, len(t.Req.Grants) > 0)
+	return nil
+}
+
+type RevokeRoleReqTask struct {
+	baseReqTask
+	Req *milvuspb.RevokeRoleRequest
+}
+
+func (t *RevokeRoleReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *RevokeRoleReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_RevokeRole {
+		return fmt.Errorf("revoke role, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	ts, err := t.core.MetaTable.RevokeRole(t.Req.RoleName, t.Req.Username, t.Req.Grants)
+	if err != nil {
+		return err
+	}
+	t.core.SendTimeTick(ts)
+	t.core.invalidateCredentialCache(ctx, t.Req.Username, len(t.Req.Grants) > 0)
+	return nil
+}
+
+type ListGrantsReqTask struct {
+	baseReqTask
+	Req *milvuspb.ListGrantsRequest
+	Rsp *milvuspb.ListGrantsResponse
+}
+
+func (t *ListGrantsReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *ListGrantsReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_ListGrants {
+		return fmt.Errorf("list grants, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	roles, err := t.core.MetaTable.ListGrants(t.Req.Username)
+	if err != nil {
+		return err
+	}
+	t.Rsp.Roles = roles
+	return nil
+}
+
+type GetCredentialReqTask struct {
+	baseReqTask
+	Req *rootcoordpb.GetCredentialRequest
+	Rsp *rootcoordpb.GetCredentialResponse
+}
+
+func (t *GetCredentialReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *GetCredentialReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_GetCredential {
+		return fmt.Errorf("get credential, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	userInfo, roles, err := t.core.MetaTable.GetUser(t.Req.Username)
+	if err != nil {
+		return err
+	}
+	t.Rsp.Username = userInfo.Name
+	t.Rsp.PasswordHash = userInfo.PasswordHash
+	t.Rsp.Roles = roles
+	return nil
+}
+
 type CreateCollectionReqTask struct {
 	baseReqTask
 	Req *milvuspb.CreateCollectionRequest
@@ -136,6 +329,11 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 		chanNames[i] = ToPhysicalChannel(vchanNames[i])
 	}
 
+	dbID, err := t.core.MetaTable.GetDatabaseByName(t.Req.DbName)
+	if err != nil {
+		return err
+	}
+
 	collInfo := etcdpb.CollectionInfo{
 		ID:                   collID,
 		Schema:               &schema,
@@ -145,6 +343,7 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 		FieldIndexes:         make([]*etcdpb.FieldIndexInfo, 0, 16),
 		VirtualChannelNames:  vchanNames,
 		PhysicalChannelNames: chanNames,
+		DbId:                 dbID,
 	}
 
 	idxInfo := make([]*etcdpb.IndexInfo, 0, 16)
@@ -161,7 +360,7 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 		DbName:               t.Req.DbName,
 		CollectionName:       t.Req.CollectionName,
 		PartitionName:        Params.DefaultPartitionName,
-		DbID:                 0, //TODO,not used
+		DbID:                 dbID,
 		CollectionID:         collID,
 		PartitionID:          partID,
 		Schema:               schemaBytes,
@@ -208,17 +407,21 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropCollection {
 		return fmt.Errorf("drop collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
+	if t.core.MetaTable.IsAlias(t.Req.DbName, t.Req.CollectionName) {
+		return fmt.Errorf("cannot drop the collection via alias = %s", t.Req.CollectionName)
+	}
 
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
+	aliases := t.core.MetaTable.ListAliases(collMeta.ID)
 
 	ddReq := internalpb.DropCollectionRequest{
 		Base:           t.Req.Base,
 		DbName:         t.Req.DbName,
 		CollectionName: t.Req.CollectionName,
-		DbID:           0, //not used
+		DbID:           collMeta.DbId,
 		CollectionID:   collMeta.ID,
 	}
 
@@ -263,6 +466,11 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 	}
 	// error doesn't matter here
 	t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
+	// the aliases of the dropped collection are dropped as well
+	for _, alias := range aliases {
+		req.CollectionName = alias
+		t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
+	}
 
 	// Update DDOperation in etcd
 	return t.core.setDdMsgSendFlag(true)
@@ -282,7 +490,7 @@ func (t *HasCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasCollection {
 		return fmt.Errorf("has collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	_, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+	_, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 	if err == nil {
 		t.HasCollection = true
 	} else {
@@ -309,7 +517,7 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 	var err error
 
 	if t.Req.CollectionName != "" {
-		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 		if err != nil {
 			return err
 		}
@@ -349,7 +557,7 @@ func (t *ShowCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_ShowCollections {
 		return fmt.Errorf("show collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.ListCollections(t.Req.TimeStamp)
+	coll, err := t.core.MetaTable.ListCollections(t.Req.DbName, t.Req.TimeStamp)
 	if err != nil {
 		return err
 	}
@@ -360,6 +568,86 @@ func (t *ShowCollectionReqTask) Execute(ctx context.Context) error {
 	return nil
 }
 
+type AddFieldReqTask struct {
+	baseReqTask
+	Req *milvuspb.AddFieldRequest
+}
+
+func (t *AddFieldReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *AddFieldReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_AddField {
+		return fmt.Errorf("add field, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	var field schemapb.FieldSchema
+	if err := proto.Unmarshal(t.Req.Schema, &field); err != nil {
+		return err
+	}
+	// the rows inserted before take the default value, so only scalar fields with default values can be added
+	if field.IsPrimaryKey || field.AutoID {
+		return fmt.Errorf("primary key field %s can't be added", field.Name)
+	}
+	if err := typeutil.ValidateDefaultValue(&field); err != nil {
+		return err
+	}
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+
+	ddReq := internalpb.AddFieldRequest{
+		Base:           t.Req.Base,
+		DbName:         t.Req.DbName,
+		CollectionName: t.Req.CollectionName,
+		DbID:           collMeta.DbId,
+		CollectionID:   collMeta.ID,
+		SchemaVersion:  collMeta.Schema.Version + 1,
+	}
+
+	// build DdOperation and save it into etcd, when ddmsg send fail,
+	// system can restore ddmsg from etcd and re-send
+	ddOp := func(ts typeutil.Timestamp) (string, error) {
+		// the field id is allocated by meta table
+		fieldBytes, err := proto.Marshal(&field)
+		if err != nil {
+			return "", err
+		}
+		ddReq.Base.Timestamp = ts
+		ddReq.Schema = fieldBytes
+		return EncodeDdOperation(&ddReq, AddFieldDDType)
+	}
+
+	ts, err := t.core.MetaTable.AddField(collMeta.ID, &field, ddOp)
+	if err != nil {
+		return err
+	}
+
+	err = t.core.SendDdAddFieldReq(ctx, &ddReq, collMeta.PhysicalChannelNames)
+	if err != nil {
+		return err
+	}
+
+	t.core.SendTimeTick(ts)
+
+	req := proxypb.InvalidateCollMetaCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   0, //TODO, msg type
+			MsgID:     0, //TODO, msg id
+			Timestamp: ts,
+			SourceID:  t.core.session.ServerID,
+		},
+		DbName:         t.Req.DbName,
+		CollectionName: t.Req.CollectionName,
+	}
+	// error doesn't matter here
+	t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
+
+	// Update DDOperation in etcd
+	return t.core.setDdMsgSendFlag(true)
+}
+
 type CreatePartitionReqTask struct {
 	baseReqTask
 	Req *milvuspb.CreatePartitionRequest
@@ -373,7 +661,7 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_CreatePartition {
 		return fmt.Errorf("create partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -387,7 +675,7 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 		DbName:         t.Req.DbName,
 		CollectionName: t.Req.CollectionName,
 		PartitionName:  t.Req.PartitionName,
-		DbID:           0, // todo, not used
+		DbID:           collMeta.DbId,
 		CollectionID:   collMeta.ID,
 		PartitionID:    partID,
 	}
@@ -441,7 +729,7 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropPartition {
 		return fmt.Errorf("drop partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -455,7 +743,7 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 		DbName:         t.Req.DbName,
 		CollectionName: t.Req.CollectionName,
 		PartitionName:  t.Req.PartitionName,
-		DbID:           0, //todo,not used
+		DbID:           collInfo.DbId,
 		CollectionID:   collInfo.ID,
 		PartitionID:    partID,
 	}
@@ -517,7 +805,7 @@ func (t *HasPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasPartition {
 		return fmt.Errorf("has partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -544,7 +832,7 @@ func (t *ShowPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Req.CollectionName == "" {
 		coll, err = t.core.MetaTable.GetCollectionByID(t.Req.CollectionID, 0)
 	} else {
-		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	}
 	if err != nil {
 		return err
@@ -590,8 +878,12 @@ func (t *DescribeSegmentReqTask) Execute(ctx context.Context) error {
 	if !exist {
 		return fmt.Errorf("segment id %d not belong to collection id %d", t.Req.SegmentID, t.Req.CollectionID)
 	}
-	//TODO, get filed_id and index_name from request
-	segIdxInfo, err := t.core.MetaTable.GetSegmentIndexInfoByID(t.Req.SegmentID, -1, "")
+	//TODO, get index_name from request
+	fieldID := t.Req.FieldID
+	if fieldID == 0 {
+		fieldID = -1
+	}
+	segIdxInfo, err := t.core.MetaTable.GetSegmentIndexInfoByID(t.Req.SegmentID, fieldID, "")
 	log.Debug("RootCoord DescribeSegmentReqTask, MetaTable.GetSegmentIndexInfoByID", zap.Any("SegmentID", t.Req.SegmentID),
 		zap.Any("segIdxInfo", segIdxInfo), zap.Error(err))
 	if err != nil {
@@ -600,6 +892,9 @@ func (t *DescribeSegmentReqTask) Execute(ctx context.Context) error {
 	t.Rsp.IndexID = segIdxInfo.IndexID
 	t.Rsp.BuildID = segIdxInfo.BuildID
 	t.Rsp.EnableIndex = segIdxInfo.EnableIndex
+	if segIdxInfo.FieldID > 0 {
+		t.Rsp.FieldID = segIdxInfo.FieldID
+	}
 	return nil
 }
 
@@ -665,7 +960,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		IndexID:     indexID,
 		IndexParams: t.Req.ExtraParams,
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -679,7 +974,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs)
+	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs)
 	if err != nil {
 		log.Debug("RootCoord CreateIndexReqTask metaTable.GetNotIndexedSegments", zap.Error(err))
 		return err
@@ -726,7 +1021,7 @@ func (t *DescribeIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DescribeIndex {
 		return fmt.Errorf("describe index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, t.Req.IndexName)
+	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.DbName, t.Req.CollectionName, t.Req.IndexName)
 	if err != nil {
 		return err
 	}
@@ -760,7 +1055,7 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropIndex {
 		return fmt.Errorf("drop index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	_, info, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, t.Req.IndexName)
+	_, info, err := t.core.MetaTable.GetIndexByName(t.Req.DbName, t.Req.CollectionName, t.Req.IndexName)
 	if err != nil {
 		log.Warn("GetIndexByName failed,", zap.String("collection name", t.Req.CollectionName), zap.String("field name", t.Req.FieldName), zap.String("index name", t.Req.IndexName), zap.Error(err))
 		return err
@@ -775,6 +1070,74 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	_, _, _, err = t.core.MetaTable.DropIndex(t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName)
+	_, _, _, err = t.core.MetaTable.DropIndex(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName)
 	return err
 }
+
+type CreateAliasReqTask struct {
+	baseReqTask
+	Req *milvuspb.CreateAliasRequest
+}
+
+func (t *CreateAliasReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *CreateAliasReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_CreateAlias {
+		return fmt.Errorf("create alias, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	ts, err := t.core.MetaTable.AddAlias(t.Req.DbName, t.Req.Alias, t.Req.CollectionName)
+	if err != nil {
+		return err
+	}
+	t.core.SendTimeTick(ts)
+	// the alias may be cached as a missing collection
+	t.core.invalidateAliasMetaCache(ctx, ts, t.Req.DbName, t.Req.Alias)
+	return nil
+}
+
+type DropAliasReqTask struct {
+	baseReqTask
+	Req *milvuspb.DropAliasRequest
+}
+
+func (t *DropAliasReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *DropAliasReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_DropAlias {
+		return fmt.Errorf("drop alias, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	ts, err := t.core.MetaTable.DeleteAlias(t.Req.DbName, t.Req.Alias)
+	if err != nil {
+		return err
+	}
+	t.core.SendTimeTick(ts)
+	t.core.invalidateAliasMetaCache(ctx, ts, t.Req.DbName, t.Req.Alias)
+	return nil
+}
+
+type AlterAliasReqTask struct {
+	baseReqTask
+	Req *milvuspb.AlterAliasRequest
+}
+
+func (t *AlterAliasReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *AlterAliasReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_AlterAlias {
+		return fmt.Errorf("alter alias, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	ts, err := t.core.MetaTable.AlterAlias(t.Req.DbName, t.Req.Alias, t.Req.CollectionName)
+	if err != nil {
+		return err
+	}
+	t.core.SendTimeTick(ts)
+	// proxies resolve the alias to the new collection on their next access
+	t.core.invalidateAliasMetaCache(ctx, ts, t.Req.DbName, t.Req.Alias)
+	return nil
+}
@@ -20,10 +20,55 @@ import (
 	"errors"
 )
 
+// ChecksumMismatchError is returned when the CRC32C checksum of a binlog event does not match its content
+type ChecksumMismatchError struct {
+	EventType EventTypeCode
+	// Offset is the position of the event in binlog
+	Offset int
+	// Part is the checked part of the event, descriptor, header or payload
+	Part     string
+	Expected uint32
+	Actual   uint32
+}
+
+func (e *ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("binlog corrupted, %s checksum mismatch of %s at offset %d, expected: %#08x, actual: %#08x",
+		e.Part, e.EventType.String(), e.Offset, e.Expected, e.Actual)
+}
+
+// TruncatedBinlogError is returned when a binlog event exceeds the end of binlog
+type TruncatedBinlogError struct {
+	EventType EventTypeCode
+	// Offset is the position of the event in binlog
+	Offset      int
+	EventLength int32
+	// Remaining is the size of binlog from the start of the event
+	Remaining int
+}
+
+func (e *TruncatedBinlogError) Error() string {
+	return fmt.Sprintf("binlog truncated, %s at offset %d has length %d, but only %d bytes remain",
+		e.EventType.String(), e.Offset, e.EventLength, e.Remaining)
+}
+
+// setCorruptionOffset sets offset of the event to the corruption error
+func setCorruptionOffset(err error, offset int) error {
+	var checksumErr *ChecksumMismatchError
+	if errors.As(err, &checksumErr) {
+		checksumErr.Offset = offset
+	}
+	var truncatedErr *TruncatedBinlogError
+	if errors.As(err, &truncatedErr) {
+		truncatedErr.Offset = offset
+	}
+	return err
+}
+
 type BinlogReader struct {
 	magicNumber int32
 	descriptorEvent
 	buffer    *bytes.Buffer
+	size      int
 	eventList []*EventReader
 	isClose   bool
 }
@@ -35,9 +80,10 @@ func (reader *BinlogReader) NextEventReader() (*EventReader, error) {
 	if reader.buffer.Len() <= 0 {
 		return nil, nil
 	}
-	eventReader, err := newEventReader(reader.descriptorEvent.PayloadDataType, reader.buffer)
+	offset := reader.size - reader.buffer.Len()
+	eventReader, err := newEventReader(reader.descriptorEvent.PayloadDataType, reader.buffer, reader.BinlogVersion)
 	if err != nil {
-		return nil, err
+		return nil, setCorruptionOffset(err, offset)
 	}
 	reader.eventList = append(reader.eventList, eventReader)
 	return eventReader, nil
@@ -55,9 +101,10 @@ func (reader *BinlogReader) readMagicNumber() (int32, error) {
 }
 
 func (reader *BinlogReader) readDescriptorEvent() (*descriptorEvent, error) {
+	offset := reader.size - reader.buffer.Len()
 	event, err := ReadDescriptorEvent(reader.buffer)
 	if err != nil {
-		return nil, err
+		return nil, setCorruptionOffset(err, offset)
 	}
 	reader.descriptorEvent = *event
 	return &reader.descriptorEvent, nil
@@ -76,9 +123,12 @@ func (reader *BinlogReader) Close() error {
 	return nil
 }
 
+// NewBinlogReader creates a reader of binlog data, it and NextEventReader return ChecksumMismatchError
+// or TruncatedBinlogError if the binlog is corrupted.
 func NewBinlogReader(data []byte) (*BinlogReader, error) {
 	reader := &BinlogReader{
 		buffer:    bytes.NewBuffer(data),
+		size:      len(data),
 		eventList: []*EventReader{},
 		isClose:   false,
 	}
@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"testing"
 	"time"
 	"unsafe"
@@ -25,7 +26,7 @@ import (
 )
 
 func TestInsertBinlog(t *testing.T) {
-	w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40)
+	w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40, schemapb.CompressionType_DefaultCompression)
 
 	e1, err := w.NextInsertEventWriter()
 	assert.Nil(t, err)
@@ -152,6 +153,16 @@ func TestInsertBinlog(t *testing.T) {
 		pos++
 	}
 
+	//descriptor data, compression type
+	compression := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, schemapb.CompressionType(compression), schemapb.CompressionType_DefaultCompression)
+	pos += int(unsafe.Sizeof(compression))
+
+	//descriptor, checksum
+	descChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[int(unsafe.Sizeof(MagicNumber)):pos], crc32cTable), uint32(descChecksum))
+	pos += int(unsafe.Sizeof(descChecksum))
+
 	//start of e1
 	assert.Equal(t, pos, int(descNxtPos))
 
@@ -180,6 +191,16 @@ func TestInsertBinlog(t *testing.T) {
 	assert.Equal(t, descNxtPos+e1EventLen, e1NxtPos)
 	pos += int(unsafe.Sizeof(descNxtPos))
 
+	//insert e1 header, payload checksum
+	e1PayloadChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[pos+binary.Size(eventChecksum{}):e1NxtPos], crc32cTable), uint32(e1PayloadChecksum))
+	pos += int(unsafe.Sizeof(e1PayloadChecksum))
+
+	//insert e1 header, header checksum
+	e1HeaderChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[descNxtPos:pos], crc32cTable), uint32(e1HeaderChecksum))
+	pos += int(unsafe.Sizeof(e1HeaderChecksum))
+
 	//insert e1 data, start time stamp
 	e1st := UnsafeReadInt64(buf, pos)
 	assert.Equal(t, e1st, int64(100))
@@ -228,6 +249,16 @@ func TestInsertBinlog(t *testing.T) {
 	assert.Equal(t, e1NxtPos+e2EventLen, e2NxtPos)
 	pos += int(unsafe.Sizeof(descNxtPos))
 
+	//insert e2 header, payload checksum
+	e2PayloadChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[pos+binary.Size(eventChecksum{}):e2NxtPos], crc32cTable), uint32(e2PayloadChecksum))
+	pos += int(unsafe.Sizeof(e2PayloadChecksum))
+
+	//insert e2 header, header checksum
+	e2HeaderChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[e1NxtPos:pos], crc32cTable), uint32(e2HeaderChecksum))
+	pos += int(unsafe.Sizeof(e2HeaderChecksum))
+
 	//insert e2 data, start time stamp
 	e2st := UnsafeReadInt64(buf, pos)
 	assert.Equal(t, e2st, int64(300))
@@ -281,7 +312,7 @@ func TestInsertBinlog(t *testing.T) {
 }
 
 func TestDeleteBinlog(t *testing.T) {
-	w := NewDeleteBinlogWriter(schemapb.DataType_Int64, 50)
+	w := NewDeleteBinlogWriter(schemapb.DataType_Int64, 50, 1, 1)
 
 	e1, err := w.NextDeleteEventWriter()
 	assert.Nil(t, err)
@@ -373,12 +404,12 @@ func TestDeleteBinlog(t *testing.T) {
 
 	//descriptor data fix, partition id
 	partID := UnsafeReadInt64(buf, pos)
-	assert.Equal(t, partID, int64(-1))
+	assert.Equal(t, partID, int64(1))
 	pos += int(unsafe.Sizeof(partID))
 
 	//descriptor data fix, segment id
 	segID := UnsafeReadInt64(buf, pos)
-	assert.Equal(t, segID, int64(-1))
+	assert.Equal(t, segID, int64(1))
 	pos += int(unsafe.Sizeof(segID))
 
 	//descriptor data fix, field id
@@ -408,6 +439,16 @@ func TestDeleteBinlog(t *testing.T) {
 		pos++
 	}
 
+	//descriptor data, compression type
+	compression := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, schemapb.CompressionType(compression), schemapb.CompressionType_DefaultCompression)
+	pos += int(unsafe.Sizeof(compression))
+
+	//descriptor, checksum
+	descChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[int(unsafe.Sizeof(MagicNumber)):pos], crc32cTable), uint32(descChecksum))
+	pos += int(unsafe.Sizeof(descChecksum))
+
 	//start of e1
 	assert.Equal(t, pos, int(descNxtPos))
 
@@ -436,6 +477,16 @@ func TestDeleteBinlog(t *testing.T) {
 	assert.Equal(t, descNxtPos+e1EventLen, e1NxtPos)
 	pos += int(unsafe.Sizeof(descNxtPos))
 
+	//insert e1 header, payload checksum
+	e1PayloadChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[pos+binary.Size(eventChecksum{}):e1NxtPos], crc32cTable), uint32(e1PayloadChecksum))
+	pos += int(unsafe.Sizeof(e1PayloadChecksum))
+
+	//insert e1 header, header checksum
+	e1HeaderChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[descNxtPos:pos], crc32cTable), uint32(e1HeaderChecksum))
+	pos += int(unsafe.Sizeof(e1HeaderChecksum))
+
 	//insert e1 data, start time stamp
 	e1st := UnsafeReadInt64(buf, pos)
 	assert.Equal(t, e1st, int64(100))
@@ -484,6 +535,16 @@ func TestDeleteBinlog(t *testing.T) {
 	assert.Equal(t, e1NxtPos+e2EventLen, e2NxtPos)
 	pos += int(unsafe.Sizeof(descNxtPos))
 
+	//insert e2 header, payload checksum
+	e2PayloadChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[pos+binary.Size(eventChecksum{}):e2NxtPos], crc32cTable), uint32(e2PayloadChecksum))
+	pos += int(unsafe.Sizeof(e2PayloadChecksum))
+
+	//insert e2 header, header checksum
+	e2HeaderChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[e1NxtPos:pos], crc32cTable), uint32(e2HeaderChecksum))
+	pos += int(unsafe.Sizeof(e2HeaderChecksum))
+
 	//insert e2 data, start time stamp
 	e2st := UnsafeReadInt64(buf, pos)
 	assert.Equal(t, e2st, int64(300))
@@ -664,6 +725,16 @@ func TestDDLBinlog1(t *testing.T) {
 		pos++
 	}
 
+	//descriptor data, compression type
+	compression := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, schemapb.CompressionType(compression), schemapb.CompressionType_DefaultCompression)
+	pos += int(unsafe.Sizeof(compression))
+
+	//descriptor, checksum
+	descChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[int(unsafe.Sizeof(MagicNumber)):pos], crc32cTable), uint32(descChecksum))
+	pos += int(unsafe.Sizeof(descChecksum))
+
 	//start of e1
 	assert.Equal(t, pos, int(descNxtPos))
 
@@ -692,6 +763,16 @@ func TestDDLBinlog1(t *testing.T) {
 	assert.Equal(t, descNxtPos+e1EventLen, e1NxtPos)
 	pos += int(unsafe.Sizeof(descNxtPos))
 
+	//insert e1 header, payload checksum
+	e1PayloadChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[pos+binary.Size(eventChecksum{}):e1NxtPos], crc32cTable), uint32(e1PayloadChecksum))
+	pos += int(unsafe.Sizeof(e1PayloadChecksum))
+
+	//insert e1 header, header checksum
+	e1HeaderChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[descNxtPos:pos], crc32cTable), uint32(e1HeaderChecksum))
+	pos += int(unsafe.Sizeof(e1HeaderChecksum))
+
 	//insert e1 data, start time stamp
 	e1st := UnsafeReadInt64(buf, pos)
 	assert.Equal(t, e1st, int64(100))
@@ -740,6 +821,16 @@ func TestDDLBinlog1(t *testing.T) {
 	assert.Equal(t, e1NxtPos+e2EventLen, e2NxtPos)
 	pos += int(unsafe.Sizeof(descNxtPos))
 
+	//insert e2 header, payload checksum
+	e2PayloadChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[pos+binary.Size(eventChecksum{}):e2NxtPos], crc32cTable), uint32(e2PayloadChecksum))
+	pos += int(unsafe.Sizeof(e2PayloadChecksum))
+
+	//insert e2 header, header checksum
+	e2HeaderChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[e1NxtPos:pos], crc32cTable), uint32(e2HeaderChecksum))
+	pos += int(unsafe.Sizeof(e2HeaderChecksum))
+
 	//insert e2 data, start time stamp
 	e2st := UnsafeReadInt64(buf, pos)
 	assert.Equal(t, e2st, int64(300))
@@ -920,6 +1011,16 @@ func TestDDLBinlog2(t *testing.T) {
 		pos++
 	}
 
+	//descriptor data, compression type
+	compression := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, schemapb.CompressionType(compression), schemapb.CompressionType_DefaultCompression)
+	pos += int(unsafe.Sizeof(compression))
+
+	//descriptor, checksum
+	descChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[int(unsafe.Sizeof(MagicNumber)):pos], crc32cTable), uint32(descChecksum))
+	pos += int(unsafe.Sizeof(descChecksum))
+
 	//start of e1
 	assert.Equal(t, pos, int(descNxtPos))
 
@@ -948,6 +1049,16 @@ func TestDDLBinlog2(t *testing.T) {
 	assert.Equal(t, descNxtPos+e1EventLen, e1NxtPos)
 	pos += int(unsafe.Sizeof(descNxtPos))
 
+	//insert e1 header, payload checksum
+	e1PayloadChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[pos+binary.Size(eventChecksum{}):e1NxtPos], crc32cTable), uint32(e1PayloadChecksum))
+	pos += int(unsafe.Sizeof(e1PayloadChecksum))
+
+	//insert e1 header, header checksum
+	e1HeaderChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[descNxtPos:pos], crc32cTable), uint32(e1HeaderChecksum))
+	pos += int(unsafe.Sizeof(e1HeaderChecksum))
+
 	//insert e1 data, start time stamp
 	e1st := UnsafeReadInt64(buf, pos)
 	assert.Equal(t, e1st, int64(100))
@@ -996,6 +1107,16 @@ func TestDDLBinlog2(t *testing.T) {
 	assert.Equal(t, e1NxtPos+e2EventLen, e2NxtPos)
 	pos += int(unsafe.Sizeof(descNxtPos))
 
+	//insert e2 header, payload checksum
+	e2PayloadChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[pos+binary.Size(eventChecksum{}):e2NxtPos], crc32cTable), uint32(e2PayloadChecksum))
+	pos += int(unsafe.Sizeof(e2PayloadChecksum))
+
+	//insert e2 header, header checksum
+	e2HeaderChecksum := UnsafeReadInt32(buf, pos)
+	assert.Equal(t, crc32.Checksum(buf[e1NxtPos:pos], crc32cTable), uint32(e2HeaderChecksum))
+	pos += int(unsafe.Sizeof(e2HeaderChecksum))
+
 	//insert e2 data, start time stamp
 	e2st := UnsafeReadInt64(buf, pos)
 	assert.Equal(t, e2st, int64(300))
@@ -1076,7 +1197,7 @@ func TestNewBinlogReaderError(t *testing.T) {
 	assert.Nil(t, reader)
 	assert.NotNil(t, err)
 
-	w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40)
+	w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40, schemapb.CompressionType_DefaultCompression)
 
 	w.SetEventTimeStamp(1000, 2000)
 
@@ -1111,7 +1232,7 @@ func TestNewBinlogReaderError(t *testing.T) {
 }
 
 func TestNewBinlogWriterTsError(t *testing.T) {
-	w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40)
+	w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40, schemapb.CompressionType_DefaultCompression)
 
 	_, err := w.GetBuffer()
 	assert.NotNil(t, err)
@@ -1135,7 +1256,7 @@ func TestNewBinlogWriterTsError(t *testing.T) {
 }
 
 func TestInsertBinlogWriterCloseError(t *testing.T) {
-	insertWriter := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40)
+	insertWriter := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40, schemapb.CompressionType_DefaultCompression)
 	e1, err := insertWriter.NextInsertEventWriter()
 	assert.Nil(t, err)
 	err = e1.AddDataToPayload([]int64{1, 2, 3})
@@ -1151,7 +1272,7 @@ func TestInsertBinlogWriterCloseError(t *testing.T) {
 }
 
 func TestDeleteBinlogWriteCloseError(t *testing.T) {
-	deleteWriter := NewDeleteBinlogWriter(schemapb.DataType_Int64, 10)
+	deleteWriter := NewDeleteBinlogWriter(schemapb.DataType_Int64, 10, 1, 1)
 	e1, err := deleteWriter.NextDeleteEventWriter()
 	assert.Nil(t, err)
 	err = e1.AddDataToPayload([]int64{1, 2, 3})
@@ -1250,7 +1371,7 @@ func (e *testEvent) SetOffset(offset int32) {
 var _ EventWriter = (*testEvent)(nil)
 
 func TestWriterListError(t *testing.T) {
-	insertWriter := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40)
+	insertWriter := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40, schemapb.CompressionType_DefaultCompression)
 	errorEvent := &testEvent{}
 	insertWriter.eventWriters = append(insertWriter.eventWriters, errorEvent)
 	insertWriter.SetEventTimeStamp(1000, 2000)
@@ -1274,3 +1395,203 @@ func TestWriterListError(t *testing.T) {
 	err = insertWriter.Close()
 	assert.NotNil(t, err)
 }
+
+func TestInsertBinlogCompression(t *testing.T) {
+	for _, compression := range []schemapb.CompressionType{
+		schemapb.CompressionType_Uncompressed,
+		schemapb.CompressionType_Snappy,
+		schemapb.CompressionType_Zstd,
+		schemapb.CompressionType_Lz4,
+	} {
+		w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40, compression)
+		w.SetEventTimeStamp(1000, 2000)
+		e1, err := w.NextInsertEventWriter()
+		assert.Nil(t, err)
+		err = e1.AddDataToPayload([]int64{1, 2, 3, 1, 2, 3})
+		assert.Nil(t, err)
+		e1.SetEventTimestamp(100, 200)
+		err = w.Close()
+		assert.Nil(t, err)
+		buf, err := w.GetBuffer()
+		assert.Nil(t, err)
+
+		reader, err := NewBinlogReader(buf)
+		assert.Nil(t, err)
+		assert.Equal(t, compression, reader.CompressionType)
+		event1, err := reader.NextEventReader()
+		assert.Nil(t, err)
+		values, err := event1.GetInt64FromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{1, 2, 3, 1, 2, 3}, values)
+		reader.Close()
+	}
+}
+
+func TestReadDescriptorEventWithoutExtras(t *testing.T) {
+	// descriptor events written before the extras are added
+	event := newDescriptorEvent()
+	event.BinlogVersion = 1
+	event.PostHeaderLengths = event.PostHeaderLengths[:getEventTypeEnd(event.BinlogVersion)]
+	event.EventLength = int32(binary.Size(event.descriptorEventHeader)) +
+		event.GetEventDataFixPartSize() + int32(binary.Size(event.PostHeaderLengths))
+	buffer := new(bytes.Buffer)
+	err := event.descriptorEventHeader.Write(buffer)
+	assert.Nil(t, err)
+	err = binary.Write(buffer, binary.LittleEndian, event.DescriptorEventDataFixPart)
+	assert.Nil(t, err)
+	err = binary.Write(buffer, binary.LittleEndian, event.PostHeaderLengths)
+	assert.Nil(t, err)
+	err = binary.Write(buffer, binary.LittleEndian, int64(555))
+	assert.Nil(t, err)
+
+	read, err := ReadDescriptorEvent(buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, schemapb.CompressionType_DefaultCompression, read.CompressionType)
+	assert.Equal(t, 8, buffer.Len())
+}
+
+func TestReadDescriptorEventVersion2(t *testing.T) {
+	// binlog version 2 has no post header length of AddFieldEventType
+	event := newDescriptorEvent()
+	event.BinlogVersion = 2
+	event.CompressionType = schemapb.CompressionType_Zstd
+	event.PostHeaderLengths = event.PostHeaderLengths[:AddFieldEventType]
+	event.EventLength = event.GetMemoryUsageInBytes()
+	buffer := new(bytes.Buffer)
+	err := event.Write(buffer)
+	assert.Nil(t, err)
+
+	read, err := ReadDescriptorEvent(buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, int(AddFieldEventType), len(read.PostHeaderLengths))
+	assert.Equal(t, schemapb.CompressionType_Zstd, read.CompressionType)
+	assert.Equal(t, 0, buffer.Len())
+}
+
+func TestBinlogChecksum(t *testing.T) {
+	w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40, schemapb.CompressionType_DefaultCompression)
+	e1, err := w.NextInsertEventWriter()
+	assert.Nil(t, err)
+	err = e1.AddDataToPayload([]int64{1, 2, 3})
+	assert.Nil(t, err)
+	e1.SetEventTimestamp(100, 200)
+	w.SetEventTimeStamp(1000, 2000)
+	err = w.Close()
+	assert.Nil(t, err)
+	buf, err := w.GetBuffer()
+	assert.Nil(t, err)
+
+	descNxtPos := int(w.NextPosition)
+	corrupt := func(pos int) []byte {
+		data := make([]byte, len(buf))
+		copy(data, buf)
+		data[pos] ^= 0xff
+		return data
+	}
+	readEvent := func(data []byte) error {
+		r, err := NewBinlogReader(data)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		_, err = r.NextEventReader()
+		return err
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert.Nil(t, readEvent(buf))
+	})
+
+	t.Run("corrupted descriptor", func(t *testing.T) {
+		err := readEvent(corrupt(descNxtPos - 10))
+		checksumErr, ok := err.(*ChecksumMismatchError)
+		assert.True(t, ok)
+		assert.Equal(t, DescriptorEventType, checksumErr.EventType)
+		assert.Equal(t, "descriptor", checksumErr.Part)
+		assert.Equal(t, int(unsafe.Sizeof(MagicNumber)), checksumErr.Offset)
+	})
+
+	t.Run("corrupted header", func(t *testing.T) {
+		err := readEvent(corrupt(descNxtPos + 1))
+		checksumErr, ok := err.(*ChecksumMismatchError)
+		assert.True(t, ok)
+		assert.Equal(t, InsertEventType, checksumErr.EventType)
+		assert.Equal(t, "header", checksumErr.Part)
+		assert.Equal(t, descNxtPos, checksumErr.Offset)
+	})
+
+	t.Run("corrupted payload", func(t *testing.T) {
+		err := readEvent(corrupt(len(buf) - 10))
+		checksumErr, ok := err.(*ChecksumMismatchError)
+		assert.True(t, ok)
+		assert.Equal(t, "payload", checksumErr.Part)
+		assert.Equal(t, descNxtPos, checksumErr.Offset)
+		assert.NotEqual(t, checksumErr.Expected, checksumErr.Actual)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		err := readEvent(buf[:len(buf)-10])
+		truncatedErr, ok := err.(*TruncatedBinlogError)
+		assert.True(t, ok)
+		assert.Equal(t, descNxtPos, truncatedErr.Offset)
+		assert.Equal(t, len(buf)-10-descNxtPos, truncatedErr.Remaining)
+	})
+}
+
+func TestReadBinlogVersion1(t *testing.T) {
+	// binlog version 1 has no checksums in descriptor event and event headers
+	payloadWriter, err := NewPayloadWriter(schemapb.DataType_Int64, schemapb.CompressionType_Uncompressed)
+	assert.Nil(t, err)
+	err = payloadWriter.AddInt64ToPayload([]int64{1, 2, 3})
+	assert.Nil(t, err)
+	err = payloadWriter.FinishPayloadWriter()
+	assert.Nil(t, err)
+	payload, err := payloadWriter.GetPayloadBufferFromWriter()
+	assert.Nil(t, err)
+
+	descriptor := newDescriptorEvent()
+	descriptor.BinlogVersion = 1
+	descriptor.PostHeaderLengths = descriptor.PostHeaderLengths[:getEventTypeEnd(descriptor.BinlogVersion)]
+	descriptor.HeaderLength = int8(binary.Size(baseEventHeader{}))
+	descriptor.PayloadDataType = schemapb.DataType_Int64
+	descriptor.SetEventTimeStamp(1000, 2000)
+	descriptor.EventLength = int32(binary.Size(descriptor.descriptorEventHeader)) +
+		descriptor.GetEventDataFixPartSize() + int32(binary.Size(descriptor.PostHeaderLengths))
+	descriptor.NextPosition = int32(unsafe.Sizeof(MagicNumber)) + descriptor.EventLength
+
+	header := newEventHeader(InsertEventType)
+	header.EventLength = int32(binary.Size(header.baseEventHeader)+binary.Size(insertEventData{})) + int32(len(payload))
+	header.NextPosition = descriptor.NextPosition + header.EventLength
+
+	buffer := new(bytes.Buffer)
+	err = binary.Write(buffer, binary.LittleEndian, MagicNumber)
+	assert.Nil(t, err)
+	err = descriptor.descriptorEventHeader.Write(buffer)
+	assert.Nil(t, err)
+	err = binary.Write(buffer, binary.LittleEndian, descriptor.DescriptorEventDataFixPart)
+	assert.Nil(t, err)
+	err = binary.Write(buffer, binary.LittleEndian, descriptor.PostHeaderLengths)
+	assert.Nil(t, err)
+	err = header.baseEventHeader.Write(buffer)
+	assert.Nil(t, err)
+	err = binary.Write(buffer, binary.LittleEndian, insertEventData{StartTimestamp: 100, EndTimestamp: 200})
+	assert.Nil(t, err)
+	_, err = buffer.Write(payload)
+	assert.Nil(t, err)
+	err = payloadWriter.ReleasePayloadWriter()
+	assert.Nil(t, err)
+
+	r, err := NewBinlogReader(buffer.Bytes())
+	assert.Nil(t, err)
+	assert.EqualValues(t, 1, r.BinlogVersion)
+	event, err := r.NextEventReader()
+	assert.Nil(t, err)
+	values, err := event.GetInt64FromPayload()
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, values)
+	event, err = r.NextEventReader()
+	assert.Nil(t, err)
+	assert.Nil(t, event)
+	err = r.Close()
+	assert.Nil(t, err)
+}
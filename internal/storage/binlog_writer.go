@@ -22,11 +22,17 @@ import (
 const (
 	// todo : put to param table
 	ServerID      = 1
-	BinlogVersion = 1
+	BinlogVersion = 3
 	CommitID      = 1
 	ServerVersion = 1
 )
 
+// checksumBinlogVersion is the first binlog version with CRC32C checksums of events
+const checksumBinlogVersion = 2
+
+// addFieldBinlogVersion is the first binlog version with AddFieldEventType
+const addFieldBinlogVersion = 3
+
 type BinlogType int32
 
 const (
@@ -137,7 +143,7 @@ func (writer *InsertBinlogWriter) NextInsertEventWriter() (*insertEventWriter, e
 	if writer.isClosed() {
 		return nil, fmt.Errorf("binlog has closed")
 	}
-	event, err := newInsertEventWriter(writer.PayloadDataType)
+	event, err := newInsertEventWriter(writer.PayloadDataType, writer.CompressionType)
 	if err != nil {
 		return nil, err
 	}
@@ -213,9 +219,25 @@ func (writer *DDLBinlogWriter) NextDropPartitionEventWriter() (*dropPartitionEve
 	return event, nil
 }
 
-func NewInsertBinlogWriter(dataType schemapb.DataType, collectionID, partitionID, segmentID, FieldID int64) *InsertBinlogWriter {
+func (writer *DDLBinlogWriter) NextAddFieldEventWriter() (*addFieldEventWriter, error) {
+	if writer.isClosed() {
+		return nil, fmt.Errorf("binlog has closed")
+	}
+	event, err := newAddFieldEventWriter(writer.PayloadDataType)
+	if err != nil {
+		return nil, err
+	}
+	writer.eventWriters = append(writer.eventWriters, event)
+	return event, nil
+}
+
+// NewInsertBinlogWriter creates a writer of insert binlog, the payloads are compressed by compression,
+// which is recorded in the descriptor event.
+func NewInsertBinlogWriter(dataType schemapb.DataType, collectionID, partitionID, segmentID, FieldID int64,
+	compression schemapb.CompressionType) *InsertBinlogWriter {
 	descriptorEvent := newDescriptorEvent()
 	descriptorEvent.PayloadDataType = dataType
+	descriptorEvent.CompressionType = compression
 	descriptorEvent.CollectionID = collectionID
 	descriptorEvent.PartitionID = partitionID
 	descriptorEvent.SegmentID = segmentID
@@ -231,10 +253,12 @@ func NewInsertBinlogWriter(dataType schemapb.DataType, collectionID, partitionID
 	}
 }
 
-func NewDeleteBinlogWriter(dataType schemapb.DataType, collectionID int64) *DeleteBinlogWriter {
+func NewDeleteBinlogWriter(dataType schemapb.DataType, collectionID, partitionID, segmentID int64) *DeleteBinlogWriter {
 	descriptorEvent := newDescriptorEvent()
 	descriptorEvent.PayloadDataType = dataType
 	descriptorEvent.CollectionID = collectionID
+	descriptorEvent.PartitionID = partitionID
+	descriptorEvent.SegmentID = segmentID
 	return &DeleteBinlogWriter{
 		baseBinlogWriter: baseBinlogWriter{
 			descriptorEvent: *descriptorEvent,
@@ -21,7 +21,7 @@ import (
 )
 
 func TestBinlogWriterReader(t *testing.T) {
-	binlogWriter := NewInsertBinlogWriter(schemapb.DataType_Int32, 10, 20, 30, 40)
+	binlogWriter := NewInsertBinlogWriter(schemapb.DataType_Int32, 10, 20, 30, 40, schemapb.CompressionType_DefaultCompression)
 	binlogWriter.SetEventTimeStamp(1000, 2000)
 	defer binlogWriter.Close()
 	eventWriter, err := binlogWriter.NextInsertEventWriter()
@@ -24,6 +24,13 @@ enum ColumnType : int {
   VECTOR_FLOAT = 101
 };
 
+enum CompressionType : int {
+  UNCOMPRESSED = 0,
+  SNAPPY = 1,
+  ZSTD = 2,
+  LZ4 = 3
+};
+
 enum ErrorCode : int {
   SUCCESS = 0,
   UNEXPECTED_ERROR = 1,
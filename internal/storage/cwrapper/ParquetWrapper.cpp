@@ -9,6 +9,8 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License.
 
+#include <arrow/array/concatenate.h>
+
 #include "ParquetWrapper.h"
 #include "PayloadStream.h"
 
@@ -20,9 +22,58 @@ static const char *ErrorMsg(const std::string &msg) {
   return ret;
 }
 
+// GetListValues gets the values of a list or fixed size list column of which every list has the same length,
+// which is how the vectors are written by the other parquet writers, e.g. pyarrow.
+template<typename ListArrayType, typename ValueArrayType>
+static bool GetListValues(const std::shared_ptr<arrow::Array> &array,
+                          const typename ValueArrayType::value_type **values,
+                          int *list_length) {
+  auto list = std::dynamic_pointer_cast<ListArrayType>(array);
+  if (list == nullptr || list->null_count() > 0) return false;
+  auto elems = std::dynamic_pointer_cast<ValueArrayType>(list->values());
+  if (elems == nullptr || elems->null_count() > 0) return false;
+  if (list->length() == 0) {
+    *list_length = 0;
+    *values = elems->raw_values();
+    return true;
+  }
+  auto length = list->value_length(0);
+  for (int64_t i = 1; i < list->length(); i++) {
+    if (list->value_length(i) != length) return false;
+  }
+  // the lists are contiguous in the values, from the offset of the first one
+  *list_length = length;
+  *values = elems->raw_values() + list->value_offset(0);
+  return true;
+}
+
+static bool ToArrowCompression(CompressionType compression, arrow::Compression::type *codec) {
+  switch (compression) {
+    case CompressionType::UNCOMPRESSED: *codec = arrow::Compression::UNCOMPRESSED;
+      return true;
+    case CompressionType::SNAPPY: *codec = arrow::Compression::SNAPPY;
+      return true;
+    case CompressionType::ZSTD: *codec = arrow::Compression::ZSTD;
+      return true;
+    case CompressionType::LZ4: *codec = arrow::Compression::LZ4;
+      return true;
+    default: return false;
+  }
+}
+
 extern "C"
 CPayloadWriter NewPayloadWriter(int columnType) {
+  return NewPayloadWriterWithCompression(columnType, CompressionType::UNCOMPRESSED);
+}
+
+extern "C"
+CPayloadWriter NewPayloadWriterWithCompression(int columnType, int compressionType) {
+  arrow::Compression::type codec;
+  if (!ToArrowCompression(static_cast<CompressionType>(compressionType), &codec)) {
+    return nullptr;
+  }
   auto p = new wrapper::PayloadWriter;
+  p->compression = static_cast<CompressionType>(compressionType);
   p->builder = nullptr;
   p->schema = nullptr;
   p->output = nullptr;
@@ -307,7 +358,11 @@ CStatus FinishPayloadWriter(CPayloadWriter payloadWriter) {
     }
     auto table = arrow::Table::Make(p->schema, {array});
     p->output = std::make_shared<wrapper::PayloadOutputStream>();
-    ast = parquet::arrow::WriteTable(*table, arrow::default_memory_pool(), p->output, 1024 * 1024 * 1024);
+    // the codec is recorded in the parquet metadata, readers decompress pages transparently
+    arrow::Compression::type codec;
+    ToArrowCompression(p->compression, &codec);
+    auto properties = parquet::WriterProperties::Builder().compression(codec)->build();
+    ast = parquet::arrow::WriteTable(*table, arrow::default_memory_pool(), p->output, 1024 * 1024 * 1024, properties);
     if (!ast.ok()) {
       st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
       st.error_msg = ErrorMsg(ast.message());
@@ -364,8 +419,17 @@ CPayloadReader NewPayloadReader(int columnType, uint8_t *buffer, int64_t buf_siz
   }
   p->column = p->table->column(0);
   assert(p->column != nullptr);
-  assert(p->column->chunks().size() == 1);
-  p->array = p->column->chunk(0);
+  if (p->column->num_chunks() == 1) {
+    p->array = p->column->chunk(0);
+  } else {
+    // the files written by the other parquet writers may have many row groups, the column is read as one array
+    auto array = arrow::Concatenate(p->column->chunks(), arrow::default_memory_pool());
+    if (!array.ok()) {
+      delete p;
+      return nullptr;
+    }
+    p->array = array.ValueOrDie();
+  }
 
   switch (columnType) {
     case ColumnType::BOOL :
@@ -490,8 +554,18 @@ CStatus GetBinaryVectorFromPayload(CPayloadReader payloadReader, uint8_t **value
   auto p = reinterpret_cast<wrapper::PayloadReader *>(payloadReader);
   auto array = std::dynamic_pointer_cast<arrow::FixedSizeBinaryArray>(p->array);
   if (array == nullptr) {
+    const uint8_t *list_values = nullptr;
+    int list_length = 0;
+    if (GetListValues<arrow::ListArray, arrow::UInt8Array>(p->array, &list_values, &list_length) ||
+        GetListValues<arrow::FixedSizeListArray, arrow::UInt8Array>(p->array, &list_values, &list_length)) {
+      *dimension = list_length * 8;
+      *length = p->array->length();
+      *values = const_cast<uint8_t *>(list_values);
+      return st;
+    }
     st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
-    st.error_msg = ErrorMsg("Incorrect data type");
+    st.error_msg = ErrorMsg("Incorrect data type, binary vectors should be fixed size binaries or lists of uint8 "
+                            "of the same length without nulls");
     return st;
   }
   *dimension = array->byte_width() * 8;
@@ -508,8 +582,18 @@ CStatus GetFloatVectorFromPayload(CPayloadReader payloadReader, float **values,
   auto p = reinterpret_cast<wrapper::PayloadReader *>(payloadReader);
   auto array = std::dynamic_pointer_cast<arrow::FixedSizeBinaryArray>(p->array);
   if (array == nullptr) {
+    const float *list_values = nullptr;
+    int list_length = 0;
+    if (GetListValues<arrow::ListArray, arrow::FloatArray>(p->array, &list_values, &list_length) ||
+        GetListValues<arrow::FixedSizeListArray, arrow::FloatArray>(p->array, &list_values, &list_length)) {
+      *dimension = list_length;
+      *length = p->array->length();
+      *values = const_cast<float *>(list_values);
+      return st;
+    }
     st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
-    st.error_msg = ErrorMsg("Incorrect data type");
+    st.error_msg = ErrorMsg("Incorrect data type, float vectors should be fixed size binaries or lists of float "
+                            "of the same length without nulls");
     return st;
   }
   *dimension = array->byte_width() / sizeof(float);
@@ -26,6 +26,7 @@ constexpr int EMPTY_DIMENSION = -1;
 
 struct PayloadWriter {
   ColumnType columnType;
+  CompressionType compression;
   int dimension; // binary vector, float vector
   std::shared_ptr<arrow::ArrayBuilder> builder;
   std::shared_ptr<arrow::Schema> schema;
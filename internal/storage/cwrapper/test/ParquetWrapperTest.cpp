@@ -314,3 +314,68 @@ TEST(wrapper, int8_2) {
   ASSERT_EQ(bool_array->Value(2), -100);
   ASSERT_EQ(bool_array->Value(3), 100);
 }
+
+TEST(wrapper, compression) {
+  for (auto compression : {CompressionType::UNCOMPRESSED, CompressionType::SNAPPY,
+                           CompressionType::ZSTD, CompressionType::LZ4}) {
+    auto payload = NewPayloadWriterWithCompression(ColumnType::INT64, compression);
+    ASSERT_NE(payload, nullptr);
+    std::vector<int64_t> data(1000, 7);
+
+    auto st = AddInt64ToPayload(payload, data.data(), data.size());
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+    st = FinishPayloadWriter(payload);
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+    auto cb = GetPayloadBufferFromWriter(payload);
+    ASSERT_GT(cb.length, 0);
+
+    auto reader = NewPayloadReader(ColumnType::INT64, (uint8_t *) cb.data, cb.length);
+    int64_t *values;
+    int length;
+    st = GetInt64FromPayload(reader, &values, &length);
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+    ASSERT_EQ(length, data.size());
+    for (int i = 0; i < length; i++) {
+      ASSERT_EQ(values[i], data[i]);
+    }
+
+    st = ReleasePayloadWriter(payload);
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+    st = ReleasePayloadReader(reader);
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  }
+
+  ASSERT_EQ(NewPayloadWriterWithCompression(ColumnType::INT64, 100), nullptr);
+}
+
+TEST(wrapper, list_float_vector) {
+  // the vectors written by the other parquet writers, e.g. pyarrow, are lists of float, here in a row group each
+  arrow::ListBuilder builder(arrow::default_memory_pool(), std::make_shared<arrow::FloatBuilder>());
+  auto value_builder = static_cast<arrow::FloatBuilder *>(builder.value_builder());
+  float data[] = {1, 2, 3, 4, 5, 6, 7, 8};
+  for (int i = 0; i < 4; i++) {
+    ASSERT_TRUE(builder.Append().ok());
+    ASSERT_TRUE(value_builder->AppendValues(data + i * 2, 2).ok());
+  }
+  std::shared_ptr<arrow::Array> array;
+  ASSERT_TRUE(builder.Finish(&array).ok());
+  auto table = arrow::Table::Make(arrow::schema({arrow::field("vec", arrow::list(arrow::float32()))}), {array});
+  auto output = arrow::io::BufferOutputStream::Create().ValueOrDie();
+  ASSERT_TRUE(parquet::arrow::WriteTable(*table, arrow::default_memory_pool(), output, 1).ok());
+  auto buffer = output->Finish().ValueOrDie();
+
+  auto reader = NewPayloadReader(ColumnType::VECTOR_FLOAT, (uint8_t *) buffer->data(), buffer->size());
+  ASSERT_NE(reader, nullptr);
+  float *values;
+  int length;
+  int dim;
+  auto st = GetFloatVectorFromPayload(reader, &values, &dim, &length);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  ASSERT_EQ(dim, 2);
+  ASSERT_EQ(length, 4);
+  for (int i = 0; i < 8; i++) {
+    ASSERT_EQ(values[i], data[i]);
+  }
+  st = ReleasePayloadReader(reader);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+}
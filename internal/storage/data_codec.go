@@ -132,6 +132,19 @@ type InsertData struct {
 	Infos []BlobInfo
 }
 
+// getFieldCompression returns the compression of field binlogs, fields without compression follow the collection.
+// Vector fields are uncompressed unless they set a compression themselves, since vectors hardly compress but
+// cost much to decompress when loaded.
+func getFieldCompression(schema *schemapb.CollectionSchema, field *schemapb.FieldSchema) schemapb.CompressionType {
+	if field.GetCompression() != schemapb.CompressionType_DefaultCompression {
+		return field.GetCompression()
+	}
+	if typeutil.IsVectorType(field.GetDataType()) {
+		return schemapb.CompressionType_Uncompressed
+	}
+	return schema.GetCompression()
+}
+
 // Blob key example:
 // ${tenant}/insert_log/${collection_id}/${partition_id}/${segment_id}/${field_id}/${log_idx}
 type InsertCodec struct {
@@ -165,7 +178,8 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		singleData := data.Data[field.FieldID]
 
 		// encode fields
-		writer = NewInsertBinlogWriter(field.DataType, insertCodec.Schema.ID, partitionID, segmentID, field.FieldID)
+		writer = NewInsertBinlogWriter(field.DataType, insertCodec.Schema.ID, partitionID, segmentID, field.FieldID,
+			getFieldCompression(insertCodec.Schema.Schema, field))
 		eventWriter, err := writer.NextInsertEventWriter()
 		if err != nil {
 			return nil, nil, err
@@ -188,12 +202,7 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		case schemapb.DataType_Double:
 			err = eventWriter.AddDoubleToPayload(singleData.(*DoubleFieldData).Data)
 		case schemapb.DataType_String:
-			for _, singleString := range singleData.(*StringFieldData).Data {
-				err = eventWriter.AddOneStringToPayload(singleString)
-				if err != nil {
-					return nil, nil, err
-				}
-			}
+			err = eventWriter.AddStringToPayload(singleData.(*StringFieldData).Data)
 		case schemapb.DataType_BinaryVector:
 			err = eventWriter.AddBinaryVectorToPayload(singleData.(*BinaryVectorFieldData).Data, singleData.(*BinaryVectorFieldData).Dim)
 		case schemapb.DataType_FloatVector:
@@ -226,6 +235,8 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		switch field.DataType {
 		case schemapb.DataType_Int64:
 			err = statsWriter.StatsInt64(singleData.(*Int64FieldData).Data)
+		case schemapb.DataType_String:
+			err = statsWriter.StatsString(singleData.(*StringFieldData).Data)
 		}
 		if err != nil {
 			return nil, nil, err
@@ -463,9 +474,91 @@ func (insertCodec *InsertCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 		insertCodec.readerCloseFunc = append(insertCodec.readerCloseFunc, readerClose(binlogReader))
 	}
 
+	if err := insertCodec.fillDefaultFields(resultData); err != nil {
+		return -1, -1, nil, err
+	}
 	return pID, sID, resultData, nil
 }
 
+// fillDefaultFields fills the fields added to the schema after the binlogs are written with the default values,
+// only when the timestamp field is read so that the number of rows is known
+func (insertCodec *InsertCodec) fillDefaultFields(data *InsertData) error {
+	tsFieldData, ok := data.Data[rootcoord.TimeStampField].(*Int64FieldData)
+	if !ok || insertCodec.Schema == nil {
+		return nil
+	}
+	for _, field := range insertCodec.Schema.GetSchema().GetFields() {
+		if _, ok := data.Data[field.GetFieldID()]; ok || field.GetDefaultValue() == nil {
+			continue
+		}
+		fieldData, err := GenDefaultFieldData(field, tsFieldData.NumRows)
+		if err != nil {
+			return err
+		}
+		data.Data[field.GetFieldID()] = fieldData
+	}
+	return nil
+}
+
+// GenDefaultFieldData returns the data of numRows rows of field, all of which are the default value of field
+func GenDefaultFieldData(field *schemapb.FieldSchema, numRows int) (FieldData, error) {
+	if err := typeutil.ValidateDefaultValue(field); err != nil {
+		return nil, err
+	}
+	value := field.GetDefaultValue()
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		fieldData := &BoolFieldData{NumRows: numRows, Data: make([]bool, numRows)}
+		for i := range fieldData.Data {
+			fieldData.Data[i] = value.GetBoolData()
+		}
+		return fieldData, nil
+	case schemapb.DataType_Int8:
+		fieldData := &Int8FieldData{NumRows: numRows, Data: make([]int8, numRows)}
+		for i := range fieldData.Data {
+			fieldData.Data[i] = int8(value.GetIntData())
+		}
+		return fieldData, nil
+	case schemapb.DataType_Int16:
+		fieldData := &Int16FieldData{NumRows: numRows, Data: make([]int16, numRows)}
+		for i := range fieldData.Data {
+			fieldData.Data[i] = int16(value.GetIntData())
+		}
+		return fieldData, nil
+	case schemapb.DataType_Int32:
+		fieldData := &Int32FieldData{NumRows: numRows, Data: make([]int32, numRows)}
+		for i := range fieldData.Data {
+			fieldData.Data[i] = value.GetIntData()
+		}
+		return fieldData, nil
+	case schemapb.DataType_Int64:
+		fieldData := &Int64FieldData{NumRows: numRows, Data: make([]int64, numRows)}
+		for i := range fieldData.Data {
+			fieldData.Data[i] = value.GetLongData()
+		}
+		return fieldData, nil
+	case schemapb.DataType_Float:
+		fieldData := &FloatFieldData{NumRows: numRows, Data: make([]float32, numRows)}
+		for i := range fieldData.Data {
+			fieldData.Data[i] = value.GetFloatData()
+		}
+		return fieldData, nil
+	case schemapb.DataType_Double:
+		fieldData := &DoubleFieldData{NumRows: numRows, Data: make([]float64, numRows)}
+		for i := range fieldData.Data {
+			fieldData.Data[i] = value.GetDoubleData()
+		}
+		return fieldData, nil
+	case schemapb.DataType_String:
+		fieldData := &StringFieldData{NumRows: numRows, Data: make([]string, numRows)}
+		for i := range fieldData.Data {
+			fieldData.Data[i] = value.GetStringData()
+		}
+		return fieldData, nil
+	}
+	return nil, fmt.Errorf("field %s of type %s can't have a default value", field.GetName(), field.GetDataType())
+}
+
 func (insertCodec *InsertCodec) Close() error {
 	for _, closeFunc := range insertCodec.readerCloseFunc {
 		err := closeFunc()
@@ -476,6 +569,164 @@ func (insertCodec *InsertCodec) Close() error {
 	return nil
 }
 
+// DeleteData saves each entity delete message represented as <primary key, timestamp> pair,
+// the primary keys are in Pks or StrPks depending on the primary key type of the collection
+type DeleteData struct {
+	Pks      []int64
+	StrPks   []string
+	Tss      []Timestamp
+	RowCount int64
+}
+
+// Append adds a delete record of primary key pk at timestamp ts
+func (data *DeleteData) Append(pk int64, ts Timestamp) {
+	data.Pks = append(data.Pks, pk)
+	data.Tss = append(data.Tss, ts)
+	data.RowCount++
+}
+
+// AppendString adds a delete record of string primary key pk at timestamp ts
+func (data *DeleteData) AppendString(pk string, ts Timestamp) {
+	data.StrPks = append(data.StrPks, pk)
+	data.Tss = append(data.Tss, ts)
+	data.RowCount++
+}
+
+// DeleteCodec serializes and deserializes the delete data of a segment,
+// every row is stored as a "pk,ts" string in the payload of one delete event,
+// string primary keys are quoted so that they are told apart from int64 primary keys
+type DeleteCodec struct {
+	readerCloseFunc []func() error
+}
+
+func NewDeleteCodec() *DeleteCodec {
+	return &DeleteCodec{}
+}
+
+func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID, data *DeleteData) (*Blob, error) {
+	if data == nil || len(data.Tss) == 0 {
+		return nil, fmt.Errorf("delete data is empty")
+	}
+	if len(data.Pks) != 0 && len(data.StrPks) != 0 {
+		return nil, fmt.Errorf("delete data has both int64 and string primary keys")
+	}
+	if len(data.Pks)+len(data.StrPks) != len(data.Tss) {
+		return nil, fmt.Errorf("the length of pks and tss is not equal")
+	}
+	binlogWriter := NewDeleteBinlogWriter(schemapb.DataType_String, collectionID, partitionID, segmentID)
+	eventWriter, err := binlogWriter.NextDeleteEventWriter()
+	if err != nil {
+		return nil, err
+	}
+	startTs, endTs := data.Tss[0], data.Tss[0]
+	for i, ts := range data.Tss {
+		if ts < startTs {
+			startTs = ts
+		}
+		if ts > endTs {
+			endTs = ts
+		}
+		var row string
+		if len(data.StrPks) > 0 {
+			row = fmt.Sprintf("%s,%d", strconv.Quote(data.StrPks[i]), ts)
+		} else {
+			row = fmt.Sprintf("%d,%d", data.Pks[i], ts)
+		}
+		err = eventWriter.AddOneStringToPayload(row)
+		if err != nil {
+			return nil, err
+		}
+	}
+	eventWriter.SetEventTimestamp(startTs, endTs)
+	binlogWriter.SetEventTimeStamp(startTs, endTs)
+	err = binlogWriter.Close()
+	if err != nil {
+		return nil, err
+	}
+	buffer, err := binlogWriter.GetBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return &Blob{
+		Value: buffer,
+	}, nil
+}
+
+func (deleteCodec *DeleteCodec) Deserialize(blobs []*Blob) (partitionID UniqueID, segmentID UniqueID, data *DeleteData, err error) {
+	if len(blobs) == 0 {
+		return -1, -1, nil, fmt.Errorf("blobs is empty")
+	}
+	readerClose := func(reader *BinlogReader) func() error {
+		return func() error { return reader.Close() }
+	}
+
+	var pID UniqueID
+	var sID UniqueID
+	result := &DeleteData{}
+	for _, blob := range blobs {
+		binlogReader, err := NewBinlogReader(blob.Value)
+		if err != nil {
+			return -1, -1, nil, err
+		}
+		deleteCodec.readerCloseFunc = append(deleteCodec.readerCloseFunc, readerClose(binlogReader))
+
+		pID, sID = binlogReader.PartitionID, binlogReader.SegmentID
+		for {
+			eventReader, err := binlogReader.NextEventReader()
+			if err != nil {
+				return -1, -1, nil, err
+			}
+			if eventReader == nil {
+				break
+			}
+			length, err := eventReader.GetPayloadLengthFromReader()
+			if err != nil {
+				return -1, -1, nil, err
+			}
+			for i := 0; i < length; i++ {
+				singleString, err := eventReader.GetOneStringFromPayload(i)
+				if err != nil {
+					return -1, -1, nil, err
+				}
+				// a quoted string primary key may contain commas, the timestamp follows the last one
+				sep := strings.LastIndex(singleString, ",")
+				if sep < 0 {
+					return -1, -1, nil, fmt.Errorf("the format of delete log is wrong: %s", singleString)
+				}
+				ts, err := strconv.ParseUint(singleString[sep+1:], 10, 64)
+				if err != nil {
+					return -1, -1, nil, err
+				}
+				if strings.HasPrefix(singleString, "\"") {
+					pk, err := strconv.Unquote(singleString[:sep])
+					if err != nil {
+						return -1, -1, nil, err
+					}
+					result.AppendString(pk, ts)
+					continue
+				}
+				pk, err := strconv.ParseInt(singleString[:sep], 10, 64)
+				if err != nil {
+					return -1, -1, nil, err
+				}
+				result.Append(pk, ts)
+			}
+		}
+	}
+
+	return pID, sID, result, nil
+}
+
+func (deleteCodec *DeleteCodec) Close() error {
+	for _, closeFunc := range deleteCodec.readerCloseFunc {
+		err := closeFunc()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Blob key example:
 // ${tenant}/data_definition_log/${collection_id}/ts/${log_idx}
 // ${tenant}/data_definition_log/${collection_id}/ddl/${log_idx}
@@ -564,6 +815,16 @@ func (dataDefinitionCodec *DataDefinitionCodec) Serialize(ts []Timestamp, ddRequ
 				return nil, err
 			}
 			eventWriter.SetEventTimestamp(ts[pos], ts[pos])
+		case AddFieldEventType:
+			eventWriter, err := writer.NextAddFieldEventWriter()
+			if err != nil {
+				return nil, err
+			}
+			err = eventWriter.AddOneStringToPayload(req)
+			if err != nil {
+				return nil, err
+			}
+			eventWriter.SetEventTimestamp(ts[pos], ts[pos])
 		}
 	}
 	writer.SetEventTimeStamp(ts[0], ts[len(ts)-1])
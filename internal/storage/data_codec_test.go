@@ -280,6 +280,98 @@ func TestInsertCodec(t *testing.T) {
 	_, _, _, err = insertCodec.Deserialize(blobs)
 	assert.NotNil(t, err)
 }
+func TestDeleteCodec(t *testing.T) {
+	deleteCodec := NewDeleteCodec()
+	deleteData := &DeleteData{}
+	deleteData.Append(1, 43757345)
+	deleteData.Append(2, 23578294723)
+	deleteData.Append(3, 43757344)
+	blob, err := deleteCodec.Serialize(1, 2, 3, deleteData)
+	assert.Nil(t, err)
+
+	pid, sid, data, err := deleteCodec.Deserialize([]*Blob{blob})
+	assert.Nil(t, err)
+	assert.Equal(t, pid, int64(2))
+	assert.Equal(t, sid, int64(3))
+	assert.Equal(t, data, deleteData)
+	assert.Nil(t, deleteCodec.Close())
+
+	_, err = deleteCodec.Serialize(1, 2, 3, &DeleteData{})
+	assert.NotNil(t, err)
+	_, err = deleteCodec.Serialize(1, 2, 3, &DeleteData{Pks: []int64{1}, Tss: []Timestamp{}, RowCount: 1})
+	assert.NotNil(t, err)
+	_, _, _, err = deleteCodec.Deserialize([]*Blob{})
+	assert.NotNil(t, err)
+
+	t.Run("string primary keys", func(t *testing.T) {
+		strData := &DeleteData{}
+		strData.AppendString("a", 43757345)
+		strData.AppendString("b,\"c\"", 23578294723)
+		strData.AppendString("123", 43757344)
+		blob, err := deleteCodec.Serialize(1, 2, 3, strData)
+		assert.Nil(t, err)
+
+		_, _, data, err := deleteCodec.Deserialize([]*Blob{blob})
+		assert.Nil(t, err)
+		assert.Equal(t, strData, data)
+		assert.Nil(t, deleteCodec.Close())
+
+		mixed := &DeleteData{Pks: []int64{1}, StrPks: []string{"a"}, Tss: []Timestamp{1, 2}, RowCount: 2}
+		_, err = deleteCodec.Serialize(1, 2, 3, mixed)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestInsertCodecDefaultFields(t *testing.T) {
+	meta := &etcdpb.CollectionMeta{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 0, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: 1, Name: "Ts", DataType: schemapb.DataType_Int64},
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			},
+		},
+	}
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			0:   &Int64FieldData{NumRows: 2, Data: []int64{1, 2}},
+			1:   &Int64FieldData{NumRows: 2, Data: []int64{1, 2}},
+			100: &Int64FieldData{NumRows: 2, Data: []int64{1, 2}},
+		},
+	}
+	blobs, _, err := NewInsertCodec(meta).Serialize(1, 1, insertData)
+	assert.Nil(t, err)
+
+	// fields added after the binlogs are written
+	meta.Schema.Fields = append(meta.Schema.Fields,
+		&schemapb.FieldSchema{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int8,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 18}}},
+		&schemapb.FieldSchema{FieldID: 102, Name: "name", DataType: schemapb.DataType_String,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "none"}}})
+	insertCodec := NewInsertCodec(meta)
+	_, _, resultData, err := insertCodec.Deserialize(blobs)
+	assert.Nil(t, err)
+	assert.Equal(t, &Int8FieldData{NumRows: 2, Data: []int8{18, 18}}, resultData.Data[101])
+	assert.Equal(t, &StringFieldData{NumRows: 2, Data: []string{"none", "none"}}, resultData.Data[102])
+	assert.Nil(t, insertCodec.Close())
+
+	// the number of rows is unknown without the timestamp field
+	var pkBlobs []*Blob
+	for _, blob := range blobs {
+		if blob.Key == "100" {
+			pkBlobs = append(pkBlobs, blob)
+		}
+	}
+	_, _, resultData, err = insertCodec.Deserialize(pkBlobs)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resultData.Data))
+	assert.Nil(t, insertCodec.Close())
+
+	_, err = GenDefaultFieldData(&schemapb.FieldSchema{Name: "vec", DataType: schemapb.DataType_FloatVector}, 1)
+	assert.NotNil(t, err)
+}
+
 func TestDDCodec(t *testing.T) {
 	dataDefinitionCodec := NewDataDefinitionCodec(int64(1))
 	ts := []Timestamp{
@@ -287,18 +379,21 @@ func TestDDCodec(t *testing.T) {
 		2,
 		3,
 		4,
+		5,
 	}
 	ddRequests := []string{
 		"CreateCollection",
 		"DropCollection",
 		"CreatePartition",
 		"DropPartition",
+		"AddField",
 	}
 	eventTypeCodes := []EventTypeCode{
 		CreateCollectionEventType,
 		DropCollectionEventType,
 		CreatePartitionEventType,
 		DropPartitionEventType,
+		AddFieldEventType,
 	}
 	blobs, err := dataDefinitionCodec.Serialize(ts, ddRequests, eventTypeCodes)
 	assert.Nil(t, err)
@@ -418,3 +513,18 @@ func TestSchemaError(t *testing.T) {
 	assert.Nil(t, blobs)
 	assert.NotNil(t, err)
 }
+
+func TestGetFieldCompression(t *testing.T) {
+	schema := &schemapb.CollectionSchema{Compression: schemapb.CompressionType_Zstd}
+	vector := &schemapb.FieldSchema{DataType: schemapb.DataType_FloatVector, Compression: schemapb.CompressionType_Uncompressed}
+	scalar := &schemapb.FieldSchema{DataType: schemapb.DataType_Int64}
+	assert.Equal(t, schemapb.CompressionType_Uncompressed, getFieldCompression(schema, vector))
+	assert.Equal(t, schemapb.CompressionType_Zstd, getFieldCompression(schema, scalar))
+	assert.Equal(t, schemapb.CompressionType_DefaultCompression, getFieldCompression(&schemapb.CollectionSchema{}, scalar))
+
+	// vector fields don't follow the collection unless they set a compression explicitly
+	vector.Compression = schemapb.CompressionType_DefaultCompression
+	assert.Equal(t, schemapb.CompressionType_Uncompressed, getFieldCompression(schema, vector))
+	binaryVector := &schemapb.FieldSchema{DataType: schemapb.DataType_BinaryVector, Compression: schemapb.CompressionType_Lz4}
+	assert.Equal(t, schemapb.CompressionType_Lz4, getFieldCompression(schema, binaryVector))
+}
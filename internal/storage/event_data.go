@@ -15,6 +15,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"io"
+	"io/ioutil"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -23,6 +24,8 @@ import (
 type descriptorEventData struct {
 	DescriptorEventDataFixPart
 	PostHeaderLengths []uint8
+	// extra part after post header lengths, absent in binlogs written before compression is supported
+	CompressionType schemapb.CompressionType
 }
 
 type DescriptorEventDataFixPart struct {
@@ -49,7 +52,7 @@ func (data *descriptorEventData) GetEventDataFixPartSize() int32 {
 }
 
 func (data *descriptorEventData) GetMemoryUsageInBytes() int32 {
-	return data.GetEventDataFixPartSize() + int32(binary.Size(data.PostHeaderLengths))
+	return data.GetEventDataFixPartSize() + int32(binary.Size(data.PostHeaderLengths)) + int32(binary.Size(data.CompressionType))
 }
 
 func (data *descriptorEventData) Write(buffer io.Writer) error {
@@ -59,20 +62,55 @@ func (data *descriptorEventData) Write(buffer io.Writer) error {
 	if err := binary.Write(buffer, binary.LittleEndian, data.PostHeaderLengths); err != nil {
 		return err
 	}
+	if err := binary.Write(buffer, binary.LittleEndian, data.CompressionType); err != nil {
+		return err
+	}
 	return nil
 }
 
+// getEventTypeEnd returns the end of the event types known to a binlog version, the descriptor event has the
+// post header lengths of these event types
+func getEventTypeEnd(binlogVersion int16) EventTypeCode {
+	if binlogVersion < addFieldBinlogVersion {
+		return AddFieldEventType
+	}
+	return EventTypeEnd
+}
+
 func readDescriptorEventData(buffer io.Reader) (*descriptorEventData, error) {
 	event := newDescriptorEventData()
 	if err := binary.Read(buffer, binary.LittleEndian, &event.DescriptorEventDataFixPart); err != nil {
 		return nil, err
 	}
+	event.PostHeaderLengths = event.PostHeaderLengths[:getEventTypeEnd(event.BinlogVersion)]
 	if err := binary.Read(buffer, binary.LittleEndian, &event.PostHeaderLengths); err != nil {
 		return nil, err
 	}
 	return event, nil
 }
 
+// readDescriptorEventExtras reads the extra part of descriptor event data of extraSize bytes,
+// unknown extras written by later versions are skipped.
+func readDescriptorEventExtras(buffer io.Reader, data *descriptorEventData, extraSize int32) error {
+	data.CompressionType = schemapb.CompressionType_DefaultCompression
+	if extraSize <= 0 {
+		return nil
+	}
+	compressionSize := int32(binary.Size(data.CompressionType))
+	if extraSize >= compressionSize {
+		if err := binary.Read(buffer, binary.LittleEndian, &data.CompressionType); err != nil {
+			return err
+		}
+		extraSize -= compressionSize
+	}
+	if extraSize > 0 {
+		if _, err := io.CopyN(ioutil.Discard, buffer, int64(extraSize)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type eventData interface {
 	GetEventDataFixPartSize() int32
 	WriteEventData(buffer io.Writer) error
@@ -224,6 +262,30 @@ func (data *dropPartitionEventData) WriteEventData(buffer io.Writer) error {
 	return binary.Write(buffer, binary.LittleEndian, data)
 }
 
+type addFieldEventData struct {
+	StartTimestamp typeutil.Timestamp
+	EndTimestamp   typeutil.Timestamp
+}
+
+func (data *addFieldEventData) SetEventTimestamp(start typeutil.Timestamp, end typeutil.Timestamp) {
+	data.StartTimestamp = start
+	data.EndTimestamp = end
+}
+
+func (data *addFieldEventData) GetEventDataFixPartSize() int32 {
+	return int32(binary.Size(data))
+}
+
+func (data *addFieldEventData) WriteEventData(buffer io.Writer) error {
+	if data.StartTimestamp == 0 {
+		return errors.New("hasn't set start time stamp")
+	}
+	if data.EndTimestamp == 0 {
+		return errors.New("hasn't set end time stamp")
+	}
+	return binary.Write(buffer, binary.LittleEndian, data)
+}
+
 func getEventFixPartSize(code EventTypeCode) int32 {
 	switch code {
 	case DescriptorEventType:
@@ -240,6 +302,8 @@ func getEventFixPartSize(code EventTypeCode) int32 {
 		return (&createPartitionEventData{}).GetEventDataFixPartSize()
 	case DropPartitionEventType:
 		return (&dropPartitionEventData{}).GetEventDataFixPartSize()
+	case AddFieldEventType:
+		return (&addFieldEventData{}).GetEventDataFixPartSize()
 	default:
 		return -1
 	}
@@ -304,6 +368,12 @@ func newDropPartitionEventData() *dropPartitionEventData {
 		EndTimestamp:   0,
 	}
 }
+func newAddFieldEventData() *addFieldEventData {
+	return &addFieldEventData{
+		StartTimestamp: 0,
+		EndTimestamp:   0,
+	}
+}
 
 func readInsertEventDataFixPart(buffer io.Reader) (*insertEventData, error) {
 	data := &insertEventData{}
@@ -352,3 +422,11 @@ func readDropPartitionEventDataFixPart(buffer io.Reader) (*dropPartitionEventDat
 	}
 	return data, nil
 }
+
+func readAddFieldEventDataFixPart(buffer io.Reader) (*addFieldEventData, error) {
+	data := &addFieldEventData{}
+	if err := binary.Read(buffer, binary.LittleEndian, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -12,7 +12,9 @@
 package storage
 
 import (
+	"bytes"
 	"encoding/binary"
+	"hash/crc32"
 	"io"
 	"time"
 
@@ -38,19 +40,64 @@ func (header *baseEventHeader) Write(buffer io.Writer) error {
 
 type descriptorEventHeader = baseEventHeader
 
+// crc32cTable is the Castagnoli table used by the checksums of binlog events
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// eventChecksum is the part of event header added in binlog version 2
+type eventChecksum struct {
+	// PayloadChecksum is the CRC32C of event data and payload
+	PayloadChecksum uint32
+	// HeaderChecksum is the CRC32C of all event header bytes before it
+	HeaderChecksum uint32
+}
+
 type eventHeader struct {
 	baseEventHeader
+	eventChecksum
+}
+
+func (header *eventHeader) GetMemoryUsageInBytes() int32 {
+	return int32(binary.Size(header))
 }
 
-func readEventHeader(buffer io.Reader) (*eventHeader, error) {
+func (header *eventHeader) Write(buffer io.Writer) error {
+	return binary.Write(buffer, binary.LittleEndian, header)
+}
+
+// computeHeaderChecksum returns the CRC32C of base event header and payload checksum
+func (header *eventHeader) computeHeaderChecksum() (uint32, error) {
+	var buffer bytes.Buffer
+	if err := header.baseEventHeader.Write(&buffer); err != nil {
+		return 0, err
+	}
+	if err := binary.Write(&buffer, binary.LittleEndian, header.PayloadChecksum); err != nil {
+		return 0, err
+	}
+	return crc32.Checksum(buffer.Bytes(), crc32cTable), nil
+}
+
+// readEventHeader reads the event header of binlogVersion, checksums are absent before version 2
+func readEventHeader(buffer io.Reader, binlogVersion int16) (*eventHeader, error) {
 	header := &eventHeader{}
-	if err := binary.Read(buffer, binary.LittleEndian, header); err != nil {
+	if err := binary.Read(buffer, binary.LittleEndian, &header.baseEventHeader); err != nil {
 		return nil, err
 	}
-
+	if binlogVersion >= checksumBinlogVersion {
+		if err := binary.Read(buffer, binary.LittleEndian, &header.eventChecksum); err != nil {
+			return nil, err
+		}
+	}
 	return header, nil
 }
 
+// getEventHeaderSize returns the size of event header of binlogVersion
+func getEventHeaderSize(binlogVersion int16) int32 {
+	if binlogVersion >= checksumBinlogVersion {
+		return int32(binary.Size(eventHeader{}))
+	}
+	return int32(binary.Size(baseEventHeader{}))
+}
+
 func readDescriptorEventHeader(buffer io.Reader) (*descriptorEventHeader, error) {
 	header := &descriptorEventHeader{}
 	if err := binary.Read(buffer, binary.LittleEndian, header); err != nil {
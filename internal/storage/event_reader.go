@@ -14,6 +14,7 @@ package storage
 import (
 	"bytes"
 	"fmt"
+	"hash/crc32"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
@@ -22,15 +23,16 @@ type EventReader struct {
 	eventHeader
 	eventData
 	PayloadReaderInterface
-	buffer   *bytes.Buffer
-	isClosed bool
+	buffer        *bytes.Buffer
+	binlogVersion int16
+	isClosed      bool
 }
 
 func (reader *EventReader) readHeader() error {
 	if reader.isClosed {
 		return fmt.Errorf("event reader is closed")
 	}
-	header, err := readEventHeader(reader.buffer)
+	header, err := readEventHeader(reader.buffer, reader.binlogVersion)
 	if err != nil {
 		return err
 	}
@@ -57,6 +59,8 @@ func (reader *EventReader) readData() error {
 		data, err = readCreatePartitionEventDataFixPart(reader.buffer)
 	case DropPartitionEventType:
 		data, err = readDropPartitionEventDataFixPart(reader.buffer)
+	case AddFieldEventType:
+		data, err = readAddFieldEventDataFixPart(reader.buffer)
 	default:
 		return fmt.Errorf("unknown header type code: %d", reader.TypeCode)
 	}
@@ -76,23 +80,81 @@ func (reader *EventReader) Close() error {
 	return nil
 }
 
-func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer) (*EventReader, error) {
+// verifyChecksum verifies the checksums of the event header and event content, which starts from the event header
+func (reader *EventReader) verifyChecksum(content []byte) error {
+	headerChecksum, err := reader.eventHeader.computeHeaderChecksum()
+	if err != nil {
+		return err
+	}
+	if headerChecksum != reader.HeaderChecksum {
+		return &ChecksumMismatchError{
+			EventType: reader.TypeCode,
+			Part:      "header",
+			Expected:  reader.HeaderChecksum,
+			Actual:    headerChecksum,
+		}
+	}
+	if err := reader.checkEventLength(len(content)); err != nil {
+		return err
+	}
+	payloadChecksum := crc32.Checksum(content[reader.eventHeader.GetMemoryUsageInBytes():reader.EventLength], crc32cTable)
+	if payloadChecksum != reader.PayloadChecksum {
+		return &ChecksumMismatchError{
+			EventType: reader.TypeCode,
+			Part:      "payload",
+			Expected:  reader.PayloadChecksum,
+			Actual:    payloadChecksum,
+		}
+	}
+	return nil
+}
+
+// checkEventLength checks the event length in header is within the size of the remaining binlog
+func (reader *EventReader) checkEventLength(remaining int) error {
+	if reader.EventLength < getEventHeaderSize(reader.binlogVersion) {
+		return fmt.Errorf("invalid event length %d", reader.EventLength)
+	}
+	if int(reader.EventLength) > remaining {
+		return &TruncatedBinlogError{
+			EventType:   reader.TypeCode,
+			EventLength: reader.EventLength,
+			Remaining:   remaining,
+		}
+	}
+	return nil
+}
+
+// newEventReader reads an event of binlogVersion from buffer, the checksums are verified since binlog version 2
+func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer, binlogVersion int16) (*EventReader, error) {
 	reader := &EventReader{
 		eventHeader: eventHeader{
 			baseEventHeader{},
+			eventChecksum{},
 		},
-		buffer:   buffer,
-		isClosed: false,
+		buffer:        buffer,
+		binlogVersion: binlogVersion,
+		isClosed:      false,
 	}
 
+	content := buffer.Bytes()
 	if err := reader.readHeader(); err != nil {
 		return nil, err
 	}
+	if binlogVersion >= checksumBinlogVersion {
+		if err := reader.verifyChecksum(content); err != nil {
+			return nil, err
+		}
+	} else if err := reader.checkEventLength(len(content)); err != nil {
+		return nil, err
+	}
 	if err := reader.readData(); err != nil {
 		return nil, err
 	}
 
-	next := int(reader.EventLength - reader.eventHeader.GetMemoryUsageInBytes() - reader.GetEventDataFixPartSize())
+	next := int(reader.EventLength - getEventHeaderSize(binlogVersion) - reader.GetEventDataFixPartSize())
+	if next < 0 {
+		return nil, fmt.Errorf("invalid event length %d", reader.EventLength)
+	}
 	payloadBuffer := buffer.Next(next)
 	payloadReader, err := NewPayloadReader(datatype, payloadBuffer)
 	if err != nil {
@@ -14,6 +14,7 @@ package storage
 import (
 	"bytes"
 	"encoding/binary"
+	"hash/crc32"
 	"testing"
 	"time"
 	"unsafe"
@@ -70,32 +71,32 @@ func TestDescriptorEvent(t *testing.T) {
 	assert.GreaterOrEqual(t, nPos, int32(binary.Size(MagicNumber)+len(buffer)))
 	t.Logf("next position = %d", nPos)
 
-	binVersion := UnsafeReadInt16(buffer, binary.Size(eventHeader{}))
+	binVersion := UnsafeReadInt16(buffer, binary.Size(descriptorEventHeader{}))
 	assert.Equal(t, binVersion, int16(BinlogVersion))
-	svrVersion := UnsafeReadInt64(buffer, binary.Size(eventHeader{})+int(unsafe.Sizeof(binVersion)))
+	svrVersion := UnsafeReadInt64(buffer, binary.Size(descriptorEventHeader{})+int(unsafe.Sizeof(binVersion)))
 	assert.Equal(t, svrVersion, int64(ServerVersion))
-	commitID := UnsafeReadInt64(buffer, binary.Size(eventHeader{})+int(unsafe.Sizeof(binVersion))+int(unsafe.Sizeof(svrVersion)))
+	commitID := UnsafeReadInt64(buffer, binary.Size(descriptorEventHeader{})+int(unsafe.Sizeof(binVersion))+int(unsafe.Sizeof(svrVersion)))
 	assert.Equal(t, commitID, int64(CommitID))
-	headLen := UnsafeReadInt8(buffer, binary.Size(eventHeader{})+
+	headLen := UnsafeReadInt8(buffer, binary.Size(descriptorEventHeader{})+
 		int(unsafe.Sizeof(binVersion))+
 		int(unsafe.Sizeof(svrVersion))+
 		int(unsafe.Sizeof(commitID)))
 	assert.Equal(t, headLen, int8(binary.Size(eventHeader{})))
 	t.Logf("head len = %d", headLen)
-	collID := UnsafeReadInt64(buffer, binary.Size(eventHeader{})+
+	collID := UnsafeReadInt64(buffer, binary.Size(descriptorEventHeader{})+
 		int(unsafe.Sizeof(binVersion))+
 		int(unsafe.Sizeof(svrVersion))+
 		int(unsafe.Sizeof(commitID))+
 		int(unsafe.Sizeof(headLen)))
 	assert.Equal(t, collID, int64(-1))
-	partID := UnsafeReadInt64(buffer, binary.Size(eventHeader{})+
+	partID := UnsafeReadInt64(buffer, binary.Size(descriptorEventHeader{})+
 		int(unsafe.Sizeof(binVersion))+
 		int(unsafe.Sizeof(svrVersion))+
 		int(unsafe.Sizeof(commitID))+
 		int(unsafe.Sizeof(headLen))+
 		int(unsafe.Sizeof(collID)))
 	assert.Equal(t, partID, int64(-1))
-	segID := UnsafeReadInt64(buffer, binary.Size(eventHeader{})+
+	segID := UnsafeReadInt64(buffer, binary.Size(descriptorEventHeader{})+
 		int(unsafe.Sizeof(binVersion))+
 		int(unsafe.Sizeof(svrVersion))+
 		int(unsafe.Sizeof(commitID))+
@@ -103,7 +104,7 @@ func TestDescriptorEvent(t *testing.T) {
 		int(unsafe.Sizeof(collID))+
 		int(unsafe.Sizeof(partID)))
 	assert.Equal(t, segID, int64(-1))
-	fieldID := UnsafeReadInt64(buffer, binary.Size(eventHeader{})+
+	fieldID := UnsafeReadInt64(buffer, binary.Size(descriptorEventHeader{})+
 		int(unsafe.Sizeof(binVersion))+
 		int(unsafe.Sizeof(svrVersion))+
 		int(unsafe.Sizeof(commitID))+
@@ -112,7 +113,7 @@ func TestDescriptorEvent(t *testing.T) {
 		int(unsafe.Sizeof(partID))+
 		int(unsafe.Sizeof(segID)))
 	assert.Equal(t, fieldID, int64(-1))
-	startTs := UnsafeReadInt64(buffer, binary.Size(eventHeader{})+
+	startTs := UnsafeReadInt64(buffer, binary.Size(descriptorEventHeader{})+
 		int(unsafe.Sizeof(binVersion))+
 		int(unsafe.Sizeof(svrVersion))+
 		int(unsafe.Sizeof(commitID))+
@@ -122,7 +123,7 @@ func TestDescriptorEvent(t *testing.T) {
 		int(unsafe.Sizeof(segID))+
 		int(unsafe.Sizeof(fieldID)))
 	assert.Equal(t, startTs, int64(0))
-	endTs := UnsafeReadInt64(buffer, binary.Size(eventHeader{})+
+	endTs := UnsafeReadInt64(buffer, binary.Size(descriptorEventHeader{})+
 		int(unsafe.Sizeof(binVersion))+
 		int(unsafe.Sizeof(svrVersion))+
 		int(unsafe.Sizeof(commitID))+
@@ -133,7 +134,7 @@ func TestDescriptorEvent(t *testing.T) {
 		int(unsafe.Sizeof(fieldID))+
 		int(unsafe.Sizeof(startTs)))
 	assert.Equal(t, endTs, int64(0))
-	colType := UnsafeReadInt32(buffer, binary.Size(eventHeader{})+
+	colType := UnsafeReadInt32(buffer, binary.Size(descriptorEventHeader{})+
 		int(unsafe.Sizeof(binVersion))+
 		int(unsafe.Sizeof(svrVersion))+
 		int(unsafe.Sizeof(commitID))+
@@ -146,7 +147,7 @@ func TestDescriptorEvent(t *testing.T) {
 		int(unsafe.Sizeof(endTs)))
 	assert.Equal(t, colType, int32(-1))
 
-	postHeadOffset := binary.Size(eventHeader{}) +
+	postHeadOffset := binary.Size(descriptorEventHeader{}) +
 		int(unsafe.Sizeof(binVersion)) +
 		int(unsafe.Sizeof(svrVersion)) +
 		int(unsafe.Sizeof(commitID)) +
@@ -165,6 +166,10 @@ func TestDescriptorEvent(t *testing.T) {
 		size := getEventFixPartSize(i)
 		assert.Equal(t, hen, uint8(size))
 	}
+
+	checksumOffset := len(buffer) - int(unsafe.Sizeof(desc.Checksum))
+	checksum := UnsafeReadInt32(buffer, checksumOffset)
+	assert.Equal(t, crc32.Checksum(buffer[:checksumOffset], crc32cTable), uint32(checksum))
 }
 
 func TestInsertEvent(t *testing.T) {
@@ -175,7 +180,7 @@ func TestInsertEvent(t *testing.T) {
 		iw func(w *insertEventWriter) error,
 		ev interface{},
 	) {
-		w, err := newInsertEventWriter(dt)
+		w, err := newInsertEventWriter(dt, schemapb.CompressionType_Uncompressed)
 		assert.Nil(t, err)
 		w.SetEventTimestamp(tsoutil.ComposeTS(10, 0), tsoutil.ComposeTS(100, 0))
 		err = ir1(w)
@@ -210,7 +215,7 @@ func TestInsertEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(dt, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(dt, bytes.NewBuffer(wBuf), BinlogVersion)
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -347,7 +352,7 @@ func TestInsertEvent(t *testing.T) {
 	})
 
 	t.Run("insert_string", func(t *testing.T) {
-		w, err := newInsertEventWriter(schemapb.DataType_String)
+		w, err := newInsertEventWriter(schemapb.DataType_String, schemapb.CompressionType_Uncompressed)
 		assert.Nil(t, err)
 		w.SetEventTimestamp(tsoutil.ComposeTS(10, 0), tsoutil.ComposeTS(100, 0))
 		err = w.AddDataToPayload("1234")
@@ -394,7 +399,7 @@ func TestInsertEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), BinlogVersion)
 		assert.Nil(t, err)
 
 		s0, err = r.GetOneStringFromPayload(0)
@@ -457,7 +462,7 @@ func TestDeleteEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(dt, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(dt, bytes.NewBuffer(wBuf), BinlogVersion)
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -641,7 +646,7 @@ func TestDeleteEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), BinlogVersion)
 		assert.Nil(t, err)
 
 		s0, err = r.GetOneStringFromPayload(0)
@@ -704,7 +709,7 @@ func TestCreateCollectionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), BinlogVersion)
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -762,7 +767,7 @@ func TestCreateCollectionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), BinlogVersion)
 		assert.Nil(t, err)
 
 		s0, err = r.GetOneStringFromPayload(0)
@@ -825,7 +830,7 @@ func TestDropCollectionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), BinlogVersion)
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -883,7 +888,7 @@ func TestDropCollectionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), BinlogVersion)
 		assert.Nil(t, err)
 
 		s0, err = r.GetOneStringFromPayload(0)
@@ -946,7 +951,7 @@ func TestCreatePartitionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), BinlogVersion)
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -1004,7 +1009,7 @@ func TestCreatePartitionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), BinlogVersion)
 		assert.Nil(t, err)
 
 		s0, err = r.GetOneStringFromPayload(0)
@@ -1067,7 +1072,7 @@ func TestDropPartitionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_Int64, bytes.NewBuffer(wBuf), BinlogVersion)
 		assert.Nil(t, err)
 		payload, _, err := r.GetDataFromPayload()
 		assert.Nil(t, err)
@@ -1125,7 +1130,7 @@ func TestDropPartitionEvent(t *testing.T) {
 		err = pR.Close()
 		assert.Nil(t, err)
 
-		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+		r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), BinlogVersion)
 		assert.Nil(t, err)
 
 		s0, err = r.GetOneStringFromPayload(0)
@@ -1211,7 +1216,7 @@ func TestDescriptorEventTsError(t *testing.T) {
 
 func TestReadFixPartError(t *testing.T) {
 	buf := new(bytes.Buffer)
-	_, err := readEventHeader(buf)
+	_, err := readEventHeader(buf, BinlogVersion)
 	assert.NotNil(t, err)
 
 	_, err = readInsertEventDataFixPart(buf)
@@ -1247,7 +1252,7 @@ func TestReadFixPartError(t *testing.T) {
 
 func TestEventReaderError(t *testing.T) {
 	buf := new(bytes.Buffer)
-	r, err := newEventReader(schemapb.DataType_Int64, buf)
+	r, err := newEventReader(schemapb.DataType_Int64, buf, BinlogVersion)
 	assert.Nil(t, r)
 	assert.NotNil(t, err)
 
@@ -1255,7 +1260,7 @@ func TestEventReaderError(t *testing.T) {
 	err = header.Write(buf)
 	assert.Nil(t, err)
 
-	r, err = newEventReader(schemapb.DataType_Int64, buf)
+	r, err = newEventReader(schemapb.DataType_Int64, buf, BinlogVersion)
 	assert.Nil(t, r)
 	assert.NotNil(t, err)
 
@@ -1264,7 +1269,7 @@ func TestEventReaderError(t *testing.T) {
 	err = header.Write(buf)
 	assert.Nil(t, err)
 
-	r, err = newEventReader(schemapb.DataType_Int64, buf)
+	r, err = newEventReader(schemapb.DataType_Int64, buf, BinlogVersion)
 	assert.Nil(t, r)
 	assert.NotNil(t, err)
 
@@ -1281,14 +1286,14 @@ func TestEventReaderError(t *testing.T) {
 	err = binary.Write(buf, binary.LittleEndian, insertData)
 	assert.Nil(t, err)
 
-	r, err = newEventReader(schemapb.DataType_Int64, buf)
+	r, err = newEventReader(schemapb.DataType_Int64, buf, BinlogVersion)
 	assert.Nil(t, r)
 	assert.NotNil(t, err)
 
 }
 
 func TestEventClose(t *testing.T) {
-	w, err := newInsertEventWriter(schemapb.DataType_String)
+	w, err := newInsertEventWriter(schemapb.DataType_String, schemapb.CompressionType_Uncompressed)
 	assert.Nil(t, err)
 	w.SetEventTimestamp(tsoutil.ComposeTS(10, 0), tsoutil.ComposeTS(100, 0))
 	err = w.AddDataToPayload("1234")
@@ -1303,7 +1308,7 @@ func TestEventClose(t *testing.T) {
 	assert.Nil(t, err)
 
 	wBuf := buf.Bytes()
-	r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf))
+	r, err := newEventReader(schemapb.DataType_String, bytes.NewBuffer(wBuf), BinlogVersion)
 	assert.Nil(t, err)
 
 	err = r.Close()
@@ -15,6 +15,8 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"fmt"
+	"hash/crc32"
 	"io"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
@@ -30,6 +32,7 @@ const (
 	DropCollectionEventType
 	CreatePartitionEventType
 	DropPartitionEventType
+	AddFieldEventType // since binlog version 3
 	EventTypeEnd
 )
 
@@ -42,6 +45,7 @@ func (code EventTypeCode) String() string {
 		DropCollectionEventType:   "DropCollectionEventType",
 		CreatePartitionEventType:  "CreatePartitionEventType",
 		DropPartitionEventType:    "DropPartitionEventType",
+		AddFieldEventType:         "AddFieldEventType",
 	}
 	if eventTypeStr, ok := codes[code]; ok {
 		return eventTypeStr
@@ -52,35 +56,70 @@ func (code EventTypeCode) String() string {
 type descriptorEvent struct {
 	descriptorEventHeader
 	descriptorEventData
+	// Checksum is the CRC32C of descriptor event header and data, absent before binlog version 2
+	Checksum uint32
 }
 
 func (event *descriptorEvent) GetMemoryUsageInBytes() int32 {
-	return event.descriptorEventHeader.GetMemoryUsageInBytes() + event.descriptorEventData.GetMemoryUsageInBytes()
+	return event.descriptorEventHeader.GetMemoryUsageInBytes() + event.descriptorEventData.GetMemoryUsageInBytes() +
+		int32(binary.Size(event.Checksum))
 }
 
 func (event *descriptorEvent) Write(buffer io.Writer) error {
-	if err := event.descriptorEventHeader.Write(buffer); err != nil {
+	var content bytes.Buffer
+	if err := event.descriptorEventHeader.Write(&content); err != nil {
 		return err
 	}
-	if err := event.descriptorEventData.Write(buffer); err != nil {
+	if err := event.descriptorEventData.Write(&content); err != nil {
 		return err
 	}
-	return nil
+	event.Checksum = crc32.Checksum(content.Bytes(), crc32cTable)
+	if _, err := buffer.Write(content.Bytes()); err != nil {
+		return err
+	}
+	return binary.Write(buffer, binary.LittleEndian, event.Checksum)
 }
 
+// ReadDescriptorEvent reads the descriptor event, whose checksum is verified since binlog version 2
 func ReadDescriptorEvent(buffer io.Reader) (*descriptorEvent, error) {
-	header, err := readDescriptorEventHeader(buffer)
+	var content bytes.Buffer
+	contentReader := io.TeeReader(buffer, &content)
+	header, err := readDescriptorEventHeader(contentReader)
 	if err != nil {
 		return nil, err
 	}
-	data, err := readDescriptorEventData(buffer)
+	data, err := readDescriptorEventData(contentReader)
 	if err != nil {
 		return nil, err
 	}
-	return &descriptorEvent{
-		descriptorEventHeader: *header,
-		descriptorEventData:   *data,
-	}, nil
+	if data.BinlogVersion > BinlogVersion {
+		return nil, fmt.Errorf("unsupported binlog version %d, the latest supported version is %d", data.BinlogVersion, BinlogVersion)
+	}
+	event := &descriptorEvent{}
+	extraSize := header.EventLength - header.GetMemoryUsageInBytes() -
+		data.GetEventDataFixPartSize() - int32(binary.Size(data.PostHeaderLengths))
+	if data.BinlogVersion >= checksumBinlogVersion {
+		extraSize -= int32(binary.Size(event.Checksum))
+	}
+	if err := readDescriptorEventExtras(contentReader, data, extraSize); err != nil {
+		return nil, err
+	}
+	event.descriptorEventHeader = *header
+	event.descriptorEventData = *data
+	if data.BinlogVersion >= checksumBinlogVersion {
+		if err := binary.Read(buffer, binary.LittleEndian, &event.Checksum); err != nil {
+			return nil, err
+		}
+		if actual := crc32.Checksum(content.Bytes(), crc32cTable); actual != event.Checksum {
+			return nil, &ChecksumMismatchError{
+				EventType: DescriptorEventType,
+				Part:      "descriptor",
+				Expected:  event.Checksum,
+				Actual:    actual,
+			}
+		}
+	}
+	return event, nil
 }
 
 type EventWriter interface {
@@ -115,16 +154,25 @@ func (writer *baseEventWriter) GetMemoryUsageInBytes() (int32, error) {
 }
 
 func (writer *baseEventWriter) Write(buffer *bytes.Buffer) error {
-	if err := writer.eventHeader.Write(buffer); err != nil {
-		return err
-	}
-	if err := writer.writeEventData(buffer); err != nil {
+	var eventData bytes.Buffer
+	if err := writer.writeEventData(&eventData); err != nil {
 		return err
 	}
 	data, err := writer.GetPayloadBufferFromWriter()
 	if err != nil {
 		return err
 	}
+	writer.PayloadChecksum = crc32.Update(crc32.Checksum(eventData.Bytes(), crc32cTable), crc32cTable, data)
+	if writer.HeaderChecksum, err = writer.eventHeader.computeHeaderChecksum(); err != nil {
+		return err
+	}
+
+	if err := writer.eventHeader.Write(buffer); err != nil {
+		return err
+	}
+	if _, err := buffer.Write(eventData.Bytes()); err != nil {
+		return err
+	}
 	if err := binary.Write(buffer, binary.LittleEndian, data); err != nil {
 		return err
 	}
@@ -192,20 +240,26 @@ type dropPartitionEventWriter struct {
 	dropPartitionEventData
 }
 
+type addFieldEventWriter struct {
+	baseEventWriter
+	addFieldEventData
+}
+
 func newDescriptorEvent() *descriptorEvent {
 	header := newDescriptorEventHeader()
 	data := newDescriptorEventData()
-	header.EventLength = header.GetMemoryUsageInBytes() + data.GetMemoryUsageInBytes()
-	header.NextPosition = int32(binary.Size(MagicNumber)) + header.EventLength
 	data.HeaderLength = int8(binary.Size(eventHeader{}))
-	return &descriptorEvent{
+	event := &descriptorEvent{
 		descriptorEventHeader: *header,
 		descriptorEventData:   *data,
 	}
+	event.EventLength = event.GetMemoryUsageInBytes()
+	event.NextPosition = int32(binary.Size(MagicNumber)) + event.EventLength
+	return event
 }
 
-func newInsertEventWriter(dataType schemapb.DataType) (*insertEventWriter, error) {
-	payloadWriter, err := NewPayloadWriter(dataType)
+func newInsertEventWriter(dataType schemapb.DataType, compression schemapb.CompressionType) (*insertEventWriter, error) {
+	payloadWriter, err := NewPayloadWriter(dataType, compression)
 	if err != nil {
 		return nil, err
 	}
@@ -227,7 +281,7 @@ func newInsertEventWriter(dataType schemapb.DataType) (*insertEventWriter, error
 }
 
 func newDeleteEventWriter(dataType schemapb.DataType) (*deleteEventWriter, error) {
-	payloadWriter, err := NewPayloadWriter(dataType)
+	payloadWriter, err := NewPayloadWriter(dataType, schemapb.CompressionType_Uncompressed)
 	if err != nil {
 		return nil, err
 	}
@@ -253,7 +307,7 @@ func newCreateCollectionEventWriter(dataType schemapb.DataType) (*createCollecti
 		return nil, errors.New("incorrect data type")
 	}
 
-	payloadWriter, err := NewPayloadWriter(dataType)
+	payloadWriter, err := NewPayloadWriter(dataType, schemapb.CompressionType_Uncompressed)
 	if err != nil {
 		return nil, err
 	}
@@ -279,7 +333,7 @@ func newDropCollectionEventWriter(dataType schemapb.DataType) (*dropCollectionEv
 		return nil, errors.New("incorrect data type")
 	}
 
-	payloadWriter, err := NewPayloadWriter(dataType)
+	payloadWriter, err := NewPayloadWriter(dataType, schemapb.CompressionType_Uncompressed)
 	if err != nil {
 		return nil, err
 	}
@@ -305,7 +359,7 @@ func newCreatePartitionEventWriter(dataType schemapb.DataType) (*createPartition
 		return nil, errors.New("incorrect data type")
 	}
 
-	payloadWriter, err := NewPayloadWriter(dataType)
+	payloadWriter, err := NewPayloadWriter(dataType, schemapb.CompressionType_Uncompressed)
 	if err != nil {
 		return nil, err
 	}
@@ -331,7 +385,7 @@ func newDropPartitionEventWriter(dataType schemapb.DataType) (*dropPartitionEven
 		return nil, errors.New("incorrect data type")
 	}
 
-	payloadWriter, err := NewPayloadWriter(dataType)
+	payloadWriter, err := NewPayloadWriter(dataType, schemapb.CompressionType_Uncompressed)
 	if err != nil {
 		return nil, err
 	}
@@ -351,3 +405,29 @@ func newDropPartitionEventWriter(dataType schemapb.DataType) (*dropPartitionEven
 	writer.baseEventWriter.writeEventData = writer.dropPartitionEventData.WriteEventData
 	return writer, nil
 }
+
+func newAddFieldEventWriter(dataType schemapb.DataType) (*addFieldEventWriter, error) {
+	if dataType != schemapb.DataType_String && dataType != schemapb.DataType_Int64 {
+		return nil, errors.New("incorrect data type")
+	}
+
+	payloadWriter, err := NewPayloadWriter(dataType, schemapb.CompressionType_Uncompressed)
+	if err != nil {
+		return nil, err
+	}
+	header := newEventHeader(AddFieldEventType)
+	data := newAddFieldEventData()
+
+	writer := &addFieldEventWriter{
+		baseEventWriter: baseEventWriter{
+			eventHeader:            *header,
+			PayloadWriterInterface: payloadWriter,
+			isClosed:               false,
+			isFinish:               false,
+		},
+		addFieldEventData: *data,
+	}
+	writer.baseEventWriter.getEventDataSize = writer.addFieldEventData.GetEventDataFixPartSize
+	writer.baseEventWriter.writeEventData = writer.addFieldEventData.WriteEventData
+	return writer, nil
+}
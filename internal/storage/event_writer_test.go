@@ -37,17 +37,17 @@ func TestSizeofStruct(t *testing.T) {
 	}
 	err = de.Write(&buf)
 	assert.Nil(t, err)
-	s3 := binary.Size(de.DescriptorEventDataFixPart) + binary.Size(de.PostHeaderLengths)
+	s3 := binary.Size(de.DescriptorEventDataFixPart) + binary.Size(de.PostHeaderLengths) + binary.Size(de.CompressionType)
 	assert.Equal(t, s3, buf.Len())
 }
 
 func TestEventWriter(t *testing.T) {
-	insertEvent, err := newInsertEventWriter(schemapb.DataType_Int32)
+	insertEvent, err := newInsertEventWriter(schemapb.DataType_Int32, schemapb.CompressionType_Uncompressed)
 	assert.Nil(t, err)
 	err = insertEvent.Close()
 	assert.Nil(t, err)
 
-	insertEvent, err = newInsertEventWriter(schemapb.DataType_Int32)
+	insertEvent, err = newInsertEventWriter(schemapb.DataType_Int32, schemapb.CompressionType_Uncompressed)
 	assert.Nil(t, err)
 	defer insertEvent.Close()
 
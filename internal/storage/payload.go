@@ -14,13 +14,14 @@ package storage
 /*
 #cgo CFLAGS: -I${SRCDIR}/cwrapper
 
-#cgo LDFLAGS: -L${SRCDIR}/cwrapper/output -lwrapper -lparquet -larrow -lthrift -lutf8proc -lstdc++ -lm
+#cgo LDFLAGS: -L${SRCDIR}/cwrapper/output -lwrapper -lparquet -larrow -larrow_bundled_dependencies -lthrift -lutf8proc -lstdc++ -lm
 #include <stdlib.h>
 #include "ParquetWrapper.h"
 */
 import "C"
 import (
 	"errors"
+	"fmt"
 	"unsafe"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -37,6 +38,7 @@ type PayloadWriterInterface interface {
 	AddFloatToPayload(msgs []float32) error
 	AddDoubleToPayload(msgs []float64) error
 	AddOneStringToPayload(msgs string) error
+	AddStringToPayload(msgs []string) error
 	AddBinaryVectorToPayload(binVec []byte, dim int) error
 	AddFloatVectorToPayload(binVec []float32, dim int) error
 	FinishPayloadWriter() error
@@ -73,14 +75,36 @@ type PayloadReader struct {
 	colType          schemapb.DataType
 }
 
-func NewPayloadWriter(colType schemapb.DataType) (*PayloadWriter, error) {
-	w := C.NewPayloadWriter(C.int(colType))
+// NewPayloadWriter creates a payload writer of colType, the parquet pages are compressed by compression.
+// The codec is recorded in parquet metadata, so payload readers decompress pages without knowing it.
+func NewPayloadWriter(colType schemapb.DataType, compression schemapb.CompressionType) (*PayloadWriter, error) {
+	codec, err := toCCompressionType(compression)
+	if err != nil {
+		return nil, err
+	}
+	w := C.NewPayloadWriterWithCompression(C.int(colType), codec)
 	if w == nil {
 		return nil, errors.New("create Payload writer failed")
 	}
 	return &PayloadWriter{payloadWriterPtr: w, colType: colType}, nil
 }
 
+// toCCompressionType converts compression to the CompressionType defined in cwrapper/ColumnType.h
+func toCCompressionType(compression schemapb.CompressionType) (C.int, error) {
+	switch compression {
+	case schemapb.CompressionType_DefaultCompression, schemapb.CompressionType_Uncompressed:
+		return 0, nil
+	case schemapb.CompressionType_Snappy:
+		return 1, nil
+	case schemapb.CompressionType_Zstd:
+		return 2, nil
+	case schemapb.CompressionType_Lz4:
+		return 3, nil
+	default:
+		return 0, fmt.Errorf("unknown compression type %d", compression)
+	}
+}
+
 func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 	switch len(dim) {
 	case 0:
@@ -128,11 +152,14 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 			}
 			return w.AddDoubleToPayload(val)
 		case schemapb.DataType_String:
-			val, ok := msgs.(string)
-			if !ok {
+			switch val := msgs.(type) {
+			case string:
+				return w.AddOneStringToPayload(val)
+			case []string:
+				return w.AddStringToPayload(val)
+			default:
 				return errors.New("incorrect data type")
 			}
-			return w.AddOneStringToPayload(val)
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -318,6 +345,19 @@ func (w *PayloadWriter) AddOneStringToPayload(msg string) error {
 	return nil
 }
 
+// AddStringToPayload adds the strings to the payload one by one
+func (w *PayloadWriter) AddStringToPayload(msgs []string) error {
+	if len(msgs) == 0 {
+		return errors.New("can't add empty msgs into payload")
+	}
+	for _, msg := range msgs {
+		if err := w.AddOneStringToPayload(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // dimension > 0 && (%8 == 0)
 func (w *PayloadWriter) AddBinaryVectorToPayload(binVec []byte, dim int) error {
 	length := len(binVec)
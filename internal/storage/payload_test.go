@@ -23,7 +23,7 @@ import (
 func TestPayload_ReaderandWriter(t *testing.T) {
 
 	t.Run("TestBool", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Bool)
+		w, err := NewPayloadWriter(schemapb.DataType_Bool, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -59,7 +59,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 	})
 
 	t.Run("TestInt8", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Int8)
+		w, err := NewPayloadWriter(schemapb.DataType_Int8, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -97,7 +97,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 	})
 
 	t.Run("TestInt16", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Int16)
+		w, err := NewPayloadWriter(schemapb.DataType_Int16, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -133,7 +133,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 	})
 
 	t.Run("TestInt32", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Int32)
+		w, err := NewPayloadWriter(schemapb.DataType_Int32, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -170,7 +170,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 	})
 
 	t.Run("TestInt64", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Int64)
+		w, err := NewPayloadWriter(schemapb.DataType_Int64, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -207,7 +207,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 	})
 
 	t.Run("TestFloat32", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Float)
+		w, err := NewPayloadWriter(schemapb.DataType_Float, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -244,7 +244,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 	})
 
 	t.Run("TestDouble", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Double)
+		w, err := NewPayloadWriter(schemapb.DataType_Double, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -280,8 +280,40 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		defer r.ReleasePayloadReader()
 	})
 
+	t.Run("TestAddString", func(t *testing.T) {
+		w, err := NewPayloadWriter(schemapb.DataType_String, schemapb.CompressionType_Uncompressed)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		err = w.AddStringToPayload([]string{"hello0", "hello1"})
+		assert.Nil(t, err)
+		err = w.AddDataToPayload([]string{"hello2"})
+		assert.Nil(t, err)
+		err = w.AddStringToPayload([]string{})
+		assert.NotNil(t, err)
+		err = w.AddStringToPayload([]string{""})
+		assert.NotNil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(schemapb.DataType_String, buffer)
+		assert.Nil(t, err)
+		length, err := r.GetPayloadLengthFromReader()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 3)
+		for i := 0; i < length; i++ {
+			str, err := r.GetOneStringFromPayload(i)
+			assert.Nil(t, err)
+			assert.Equal(t, fmt.Sprintf("hello%d", i), str)
+		}
+		defer r.ReleasePayloadReader()
+		defer w.ReleasePayloadWriter()
+	})
+
 	t.Run("TestAddOneString", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_String)
+		w, err := NewPayloadWriter(schemapb.DataType_String, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -346,7 +378,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 	})
 
 	t.Run("TestBinaryVector", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_BinaryVector)
+		w, err := NewPayloadWriter(schemapb.DataType_BinaryVector, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -395,7 +427,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 	})
 
 	t.Run("TestFloatVector", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_FloatVector)
+		w, err := NewPayloadWriter(schemapb.DataType_FloatVector, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -436,7 +468,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 	})
 
 	t.Run("TestAddDataToPayload", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Bool)
+		w, err := NewPayloadWriter(schemapb.DataType_Bool, schemapb.CompressionType_Uncompressed)
 		w.colType = 999
 		require.Nil(t, err)
 		require.NotNil(t, w)
@@ -459,7 +491,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 	})
 
 	t.Run("TestAddBoolAfterFinish", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Bool)
+		w, err := NewPayloadWriter(schemapb.DataType_Bool, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -475,7 +507,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 	})
 
 	t.Run("TestAddInt8AfterFinish", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Int8)
+		w, err := NewPayloadWriter(schemapb.DataType_Int8, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 		defer w.Close()
@@ -491,7 +523,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestAddInt16AfterFinish", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Int16)
+		w, err := NewPayloadWriter(schemapb.DataType_Int16, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 		defer w.Close()
@@ -507,7 +539,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestAddInt32AfterFinish", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Int32)
+		w, err := NewPayloadWriter(schemapb.DataType_Int32, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 		defer w.Close()
@@ -523,7 +555,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestAddInt64AfterFinish", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Int64)
+		w, err := NewPayloadWriter(schemapb.DataType_Int64, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 		defer w.Close()
@@ -539,7 +571,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestAddFloatAfterFinish", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Float)
+		w, err := NewPayloadWriter(schemapb.DataType_Float, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 		defer w.Close()
@@ -555,7 +587,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestAddDoubleAfterFinish", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Double)
+		w, err := NewPayloadWriter(schemapb.DataType_Double, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 		defer w.Close()
@@ -571,7 +603,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestAddOneStringAfterFinish", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_String)
+		w, err := NewPayloadWriter(schemapb.DataType_String, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 		defer w.Close()
@@ -587,7 +619,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestAddBinVectorAfterFinish", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_BinaryVector)
+		w, err := NewPayloadWriter(schemapb.DataType_BinaryVector, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 		defer w.Close()
@@ -611,7 +643,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestAddFloatVectorAfterFinish", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_FloatVector)
+		w, err := NewPayloadWriter(schemapb.DataType_FloatVector, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 		defer w.Close()
@@ -652,7 +684,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestGetBoolError", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Int8)
+		w, err := NewPayloadWriter(schemapb.DataType_Int8, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -676,7 +708,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestGetInt8Error", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Bool)
+		w, err := NewPayloadWriter(schemapb.DataType_Bool, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -700,7 +732,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestGetInt16Error", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Bool)
+		w, err := NewPayloadWriter(schemapb.DataType_Bool, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -724,7 +756,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestGetInt32Error", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Bool)
+		w, err := NewPayloadWriter(schemapb.DataType_Bool, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -748,7 +780,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestGetInt64Error", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Bool)
+		w, err := NewPayloadWriter(schemapb.DataType_Bool, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -772,7 +804,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestGetFloatError", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Bool)
+		w, err := NewPayloadWriter(schemapb.DataType_Bool, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -796,7 +828,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestGetDoubleError", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Bool)
+		w, err := NewPayloadWriter(schemapb.DataType_Bool, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -820,7 +852,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestGetOneStringError", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Bool)
+		w, err := NewPayloadWriter(schemapb.DataType_Bool, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -844,7 +876,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestGetBinaryVectorError", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Bool)
+		w, err := NewPayloadWriter(schemapb.DataType_Bool, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
@@ -868,7 +900,7 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 	t.Run("TestGetFloatVectorError", func(t *testing.T) {
-		w, err := NewPayloadWriter(schemapb.DataType_Bool)
+		w, err := NewPayloadWriter(schemapb.DataType_Bool, schemapb.CompressionType_Uncompressed)
 		require.Nil(t, err)
 		require.NotNil(t, w)
 
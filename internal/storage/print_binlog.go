@@ -56,6 +56,7 @@ func printBinlogFile(filename string) error {
 
 	r, err := NewBinlogReader(b)
 	if err != nil {
+		printCorruption(err)
 		return err
 	}
 	defer r.Close()
@@ -86,10 +87,13 @@ func printBinlogFile(filename string) error {
 	}
 	fmt.Printf("\tPayloadDataType: %v\n", dataTypeName)
 	fmt.Printf("\tPostHeaderLengths: %v\n", r.descriptorEvent.descriptorEventData.PostHeaderLengths)
+	fmt.Printf("\tCompressionType: %v\n", r.descriptorEvent.descriptorEventData.CompressionType.String())
+	printChecksum("Checksum", r.descriptorEvent.Checksum, r.descriptorEvent.descriptorEventData.BinlogVersion)
 	eventNum := 0
 	for {
 		event, err := r.NextEventReader()
 		if err != nil {
+			printCorruption(err)
 			return err
 		}
 		if event == nil {
@@ -102,6 +106,8 @@ func printBinlogFile(filename string) error {
 		fmt.Printf("\tServerID: %d\n", event.eventHeader.ServerID)
 		fmt.Printf("\tEventLength: %d\n", event.eventHeader.EventLength)
 		fmt.Printf("\tNextPosition: %d\n", event.eventHeader.NextPosition)
+		printChecksum("PayloadChecksum", event.eventHeader.PayloadChecksum, r.descriptorEvent.descriptorEventData.BinlogVersion)
+		printChecksum("HeaderChecksum", event.eventHeader.HeaderChecksum, r.descriptorEvent.descriptorEventData.BinlogVersion)
 		switch event.eventHeader.TypeCode {
 		case InsertEventType:
 			evd, ok := event.eventData.(*insertEventData)
@@ -181,6 +187,19 @@ func printBinlogFile(filename string) error {
 			if err := printDDLPayloadValues(event.eventHeader.TypeCode, r.descriptorEvent.descriptorEventData.PayloadDataType, event.PayloadReaderInterface); err != nil {
 				return err
 			}
+		case AddFieldEventType:
+			evd, ok := event.eventData.(*addFieldEventData)
+			if !ok {
+				return errors.New("incorrect event data type")
+			}
+			fmt.Printf("event %d add field event:\n", eventNum)
+			physical, _ = tsoutil.ParseTS(evd.StartTimestamp)
+			fmt.Printf("\tStartTimestamp: %v\n", physical)
+			physical, _ = tsoutil.ParseTS(evd.EndTimestamp)
+			fmt.Printf("\tEndTimestamp: %v\n", physical)
+			if err := printDDLPayloadValues(event.eventHeader.TypeCode, r.descriptorEvent.descriptorEventData.PayloadDataType, event.PayloadReaderInterface); err != nil {
+				return err
+			}
 		default:
 			return fmt.Errorf("undefined event typd %d", event.eventHeader.TypeCode)
 		}
@@ -190,6 +209,29 @@ func printBinlogFile(filename string) error {
 	return nil
 }
 
+// printChecksum prints the checksum which has been verified by the binlog reader
+func printChecksum(name string, checksum uint32, binlogVersion int16) {
+	if binlogVersion < checksumBinlogVersion {
+		fmt.Printf("\t%s: absent in binlog version %d\n", name, binlogVersion)
+		return
+	}
+	fmt.Printf("\t%s: %#08x, verified\n", name, checksum)
+}
+
+// printCorruption prints the checksum status of corrupted binlog
+func printCorruption(err error) {
+	var checksumErr *ChecksumMismatchError
+	if errors.As(err, &checksumErr) {
+		fmt.Printf("%s at offset %d: %s checksum mismatch, expected: %#08x, actual: %#08x\n",
+			checksumErr.EventType.String(), checksumErr.Offset, checksumErr.Part, checksumErr.Expected, checksumErr.Actual)
+	}
+	var truncatedErr *TruncatedBinlogError
+	if errors.As(err, &truncatedErr) {
+		fmt.Printf("%s at offset %d: truncated, event length %d, remaining %d bytes\n",
+			truncatedErr.EventType.String(), truncatedErr.Offset, truncatedErr.EventLength, truncatedErr.Remaining)
+	}
+}
+
 func printPayloadValues(colType schemapb.DataType, reader PayloadReaderInterface) error {
 	fmt.Println("\tpayload values:")
 	switch colType {
@@ -344,6 +386,12 @@ func printDDLPayloadValues(eventType EventTypeCode, colType schemapb.DataType, r
 					return err
 				}
 				fmt.Printf("\t\t%d : drop partition: %v\n", i, req)
+			case AddFieldEventType:
+				var req internalpb.AddFieldRequest
+				if err := proto.Unmarshal(valBytes, &req); err != nil {
+					return err
+				}
+				fmt.Printf("\t\t%d : add field: %v\n", i, req)
 			default:
 				return fmt.Errorf("undefined ddl event type %d", eventType)
 			}
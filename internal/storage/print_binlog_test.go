@@ -27,7 +27,7 @@ import (
 )
 
 func TestPrintBinlogFilesInt64(t *testing.T) {
-	w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40)
+	w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40, schemapb.CompressionType_DefaultCompression)
 
 	curTS := time.Now().UnixNano() / int64(time.Millisecond)
 
@@ -13,13 +13,26 @@ package storage
 
 import (
 	"encoding/json"
+
+	"github.com/milvus-io/milvus/internal/util/bloomfilter"
 )
 
+// bloomFilterFpRate is the false positive rate of the bloom filters in string stats
+const bloomFilterFpRate = 0.005
+
 type Int64Stats struct {
 	Max int64 `json:"max"`
 	Min int64 `json:"min"`
 }
 
+// StringStats is the stats of a string field, the bloom filter tells whether a string is in the field,
+// it is used to find the segments which may contain a string primary key
+type StringStats struct {
+	Max string                   `json:"max"`
+	Min string                   `json:"min"`
+	BF  *bloomfilter.BloomFilter `json:"bf,omitempty"`
+}
+
 type StatsWriter struct {
 	buffer []byte
 }
@@ -47,6 +60,35 @@ func (sw *StatsWriter) StatsInt64(msgs []int64) error {
 	return nil
 }
 
+// StatsString writes the min, max and the bloom filter of msgs, msgs are not required to be sorted
+func (sw *StatsWriter) StatsString(msgs []string) error {
+	if len(msgs) < 1 {
+		return nil
+	}
+
+	stats := &StringStats{
+		Max: msgs[0],
+		Min: msgs[0],
+		BF:  bloomfilter.New(uint(len(msgs)), bloomFilterFpRate),
+	}
+	for _, msg := range msgs {
+		if msg > stats.Max {
+			stats.Max = msg
+		}
+		if msg < stats.Min {
+			stats.Min = msg
+		}
+		stats.BF.AddString(msg)
+	}
+	b, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	sw.buffer = b
+
+	return nil
+}
+
 type StatsReader struct {
 	buffer []byte
 }
@@ -60,3 +102,9 @@ func (sr *StatsReader) GetInt64Stats() Int64Stats {
 	json.Unmarshal(sr.buffer, &stats)
 	return stats
 }
+
+func (sr *StatsReader) GetStringStats() (StringStats, error) {
+	stats := StringStats{}
+	err := json.Unmarshal(sr.buffer, &stats)
+	return stats, err
+}
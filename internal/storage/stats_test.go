@@ -35,3 +35,21 @@ func TestStatsInt64(t *testing.T) {
 	}
 	assert.Equal(t, stats, expectedStats)
 }
+
+func TestStatsString(t *testing.T) {
+	data := []string{"b", "c", "a", "e", "d"}
+	sw := &StatsWriter{}
+	err := sw.StatsString(data)
+	assert.NoError(t, err)
+	b := sw.GetBuffer()
+
+	sr := &StatsReader{}
+	sr.SetBuffer(b)
+	stats, err := sr.GetStringStats()
+	assert.NoError(t, err)
+	assert.Equal(t, "e", stats.Max)
+	assert.Equal(t, "a", stats.Min)
+	for _, str := range data {
+		assert.True(t, stats.BF.TestString(str))
+	}
+}
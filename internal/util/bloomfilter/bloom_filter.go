@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package bloomfilter
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+
+	"github.com/spaolacci/murmur3"
+)
+
+// BloomFilter is a bloom filter of fixed size. The k hash values of a key are derived
+// from the two halves of its 128 bits murmur3 hash by double hashing.
+type BloomFilter struct {
+	m    uint64 // number of bits
+	k    uint64 // number of hash functions
+	bits []byte
+}
+
+// New creates a bloom filter which has the false positive rate fpRate after capacity keys are added
+func New(capacity uint, fpRate float64) *BloomFilter {
+	if capacity == 0 {
+		capacity = 1
+	}
+	if fpRate <= 0 || fpRate >= 1 {
+		fpRate = 0.01
+	}
+	n := float64(capacity)
+	m := uint64(math.Ceil(-n * math.Log(fpRate) / (math.Ln2 * math.Ln2)))
+	k := uint64(math.Round(float64(m) / n * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	return &BloomFilter{
+		m:    m,
+		k:    k,
+		bits: make([]byte, (m+7)/8),
+	}
+}
+
+func (bf *BloomFilter) locations(key []byte) []uint64 {
+	h1, h2 := murmur3.Sum128(key)
+	locations := make([]uint64, bf.k)
+	for i := uint64(0); i < bf.k; i++ {
+		locations[i] = (h1 + i*h2) % bf.m
+	}
+	return locations
+}
+
+// Add adds key to the filter
+func (bf *BloomFilter) Add(key []byte) {
+	for _, loc := range bf.locations(key) {
+		bf.bits[loc/8] |= 1 << (loc % 8)
+	}
+}
+
+// AddString adds the string key to the filter
+func (bf *BloomFilter) AddString(key string) {
+	bf.Add([]byte(key))
+}
+
+// Test returns false if key is definitely not in the filter, true if it may be
+func (bf *BloomFilter) Test(key []byte) bool {
+	for _, loc := range bf.locations(key) {
+		if bf.bits[loc/8]&(1<<(loc%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// TestString returns false if the string key is definitely not in the filter, true if it may be
+func (bf *BloomFilter) TestString(key string) bool {
+	return bf.Test([]byte(key))
+}
+
+// Merge adds the keys of other to the filter, both filters must be of the same size
+func (bf *BloomFilter) Merge(other *BloomFilter) error {
+	if bf.m != other.m || bf.k != other.k {
+		return errors.New("cannot merge bloom filters of different sizes")
+	}
+	for i := range bf.bits {
+		bf.bits[i] |= other.bits[i]
+	}
+	return nil
+}
+
+type bloomFilterJSON struct {
+	M    uint64 `json:"m"`
+	K    uint64 `json:"k"`
+	Bits []byte `json:"bits"`
+}
+
+// MarshalJSON implements json.Marshaler, the bits are encoded in base64
+func (bf *BloomFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&bloomFilterJSON{M: bf.m, K: bf.k, Bits: bf.bits})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (bf *BloomFilter) UnmarshalJSON(data []byte) error {
+	var v bloomFilterJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.M == 0 || v.K == 0 || uint64(len(v.Bits)) != (v.M+7)/8 {
+		return errors.New("invalid bloom filter")
+	}
+	bf.m, bf.k, bf.bits = v.M, v.K, v.Bits
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package bloomfilter
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBloomFilter(t *testing.T) {
+	bf := New(1000, 0.01)
+	for i := 0; i < 1000; i++ {
+		bf.AddString(fmt.Sprintf("key-%d", i))
+	}
+	for i := 0; i < 1000; i++ {
+		assert.True(t, bf.TestString(fmt.Sprintf("key-%d", i)))
+	}
+
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		if bf.TestString(fmt.Sprintf("absent-%d", i)) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, falsePositives, 300)
+}
+
+func TestBloomFilter_JSON(t *testing.T) {
+	bf := New(100, 0.01)
+	bf.AddString("a")
+	bf.AddString("b")
+
+	data, err := json.Marshal(bf)
+	assert.Nil(t, err)
+	decoded := &BloomFilter{}
+	err = json.Unmarshal(data, decoded)
+	assert.Nil(t, err)
+	assert.Equal(t, bf, decoded)
+	assert.True(t, decoded.TestString("a"))
+	assert.True(t, decoded.TestString("b"))
+
+	err = json.Unmarshal([]byte(`{"m":100,"k":3,"bits":""}`), decoded)
+	assert.NotNil(t, err)
+}
+
+func TestBloomFilter_Merge(t *testing.T) {
+	bf1 := New(100, 0.01)
+	bf1.AddString("a")
+	bf2 := New(100, 0.01)
+	bf2.AddString("b")
+
+	assert.Nil(t, bf1.Merge(bf2))
+	assert.True(t, bf1.TestString("a"))
+	assert.True(t, bf1.TestString("b"))
+
+	assert.NotNil(t, bf1.Merge(New(1000, 0.01)))
+}
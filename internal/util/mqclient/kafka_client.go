@@ -0,0 +1,123 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+package mqclient
+
+import (
+	"strconv"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// kafkaPartition is the only partition used in every topic, msgstream relies on the order of messages in a channel
+const kafkaPartition int32 = 0
+
+type KafkaClient struct {
+	client sarama.Client
+}
+
+func NewKafkaClient(brokers []string) (*KafkaClient, error) {
+	c, err := sarama.NewClient(brokers, newKafkaConfig())
+	if err != nil {
+		log.Error("Set kafka client failed, error", zap.Error(err))
+		return nil, err
+	}
+	return &KafkaClient{client: c}, nil
+}
+
+func newKafkaConfig() *sarama.Config {
+	config := sarama.NewConfig()
+	// record headers carrying message properties require kafka 0.11 or later
+	config.Version = sarama.V2_0_0_0
+	config.Producer.Partitioner = sarama.NewManualPartitioner
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+	return config
+}
+
+func (kc *KafkaClient) CreateProducer(options ProducerOptions) (Producer, error) {
+	pp, err := sarama.NewSyncProducerFromClient(kc.client)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaProducer{p: pp, topic: options.Topic}, nil
+}
+
+func (kc *KafkaClient) Subscribe(options ConsumerOptions) (Consumer, error) {
+	consumer, err := sarama.NewConsumerFromClient(kc.client)
+	if err != nil {
+		return nil, err
+	}
+	offsetManager, err := sarama.NewOffsetManagerFromClient(options.SubscriptionName, kc.client)
+	if err != nil {
+		consumer.Close()
+		return nil, err
+	}
+	pom, err := offsetManager.ManagePartition(options.Topic, kafkaPartition)
+	if err != nil {
+		offsetManager.Close()
+		consumer.Close()
+		return nil, err
+	}
+
+	// continue from the committed offset of the subscription, the initial position applies to a new subscription
+	offset, _ := pom.NextOffset()
+	if offset < 0 {
+		offset = sarama.OffsetNewest
+		if options.SubscriptionInitialPosition == SubscriptionPositionEarliest {
+			offset = sarama.OffsetOldest
+		}
+	}
+	pc, err := consumer.ConsumePartition(options.Topic, kafkaPartition, offset)
+	if err != nil {
+		pom.Close()
+		offsetManager.Close()
+		consumer.Close()
+		return nil, err
+	}
+
+	kConsumer := &kafkaConsumer{
+		consumer:      consumer,
+		offsetManager: offsetManager,
+		pom:           pom,
+		topic:         options.Topic,
+		subName:       options.SubscriptionName,
+		pc:            pc,
+	}
+	return kConsumer, nil
+}
+
+func (kc *KafkaClient) EarliestMessageID() MessageID {
+	return &kafkaID{partition: kafkaPartition, offset: sarama.OffsetOldest}
+}
+
+// StringToMsgID parses the offset of a message in the only partition
+func (kc *KafkaClient) StringToMsgID(id string) (MessageID, error) {
+	offset, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaID{partition: kafkaPartition, offset: offset}, nil
+}
+
+func (kc *KafkaClient) BytesToMsgID(id []byte) (MessageID, error) {
+	partition, offset, err := DeserializeKafkaID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaID{partition: partition, offset: offset}, nil
+}
+
+func (kc *KafkaClient) Close() {
+	kc.client.Close()
+}
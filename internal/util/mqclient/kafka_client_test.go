@@ -0,0 +1,162 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+package mqclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+)
+
+// newKafkaMockBroker starts an in-process broker serving topic with the payloads at offset 0, 1, 2...,
+// committedOffsets are the offsets of topic committed by consumer groups.
+func newKafkaMockBroker(t *testing.T, topic string, payloads []string, committedOffsets map[string]int64) *sarama.MockBroker {
+	broker := sarama.NewMockBroker(t, 1)
+
+	fetchResponse := sarama.NewMockFetchResponse(t, len(payloads)+1).SetVersion(7).
+		SetHighWaterMark(topic, kafkaPartition, int64(len(payloads)))
+	for i, payload := range payloads {
+		fetchResponse.SetMessage(topic, kafkaPartition, int64(i), sarama.StringEncoder(payload))
+	}
+	offsetFetchResponse := sarama.NewMockOffsetFetchResponse(t)
+	findCoordinatorResponse := sarama.NewMockFindCoordinatorResponse(t)
+	for group, offset := range committedOffsets {
+		offsetFetchResponse.SetOffset(group, topic, kafkaPartition, offset, "", sarama.ErrNoError)
+		findCoordinatorResponse.SetCoordinator(sarama.CoordinatorGroup, group, broker)
+	}
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader(topic, kafkaPartition, broker.BrokerID()),
+		"ProduceRequest": sarama.NewMockProduceResponse(t).SetVersion(3),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).SetVersion(1).
+			SetOffset(topic, kafkaPartition, sarama.OffsetOldest, 0).
+			SetOffset(topic, kafkaPartition, sarama.OffsetNewest, int64(len(payloads))),
+		"FetchRequest":           fetchResponse,
+		"FindCoordinatorRequest": findCoordinatorResponse,
+		"OffsetFetchRequest":     offsetFetchResponse,
+		"OffsetCommitRequest":    sarama.NewMockOffsetCommitResponse(t),
+	})
+	return broker
+}
+
+func receiveKafkaMessage(t *testing.T, consumer Consumer) ConsumerMessage {
+	select {
+	case msg := <-consumer.Chan():
+		return msg
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "no message received from kafka")
+	}
+	return nil
+}
+
+func TestKafkaID(t *testing.T) {
+	id := &kafkaID{partition: 1, offset: 100}
+	partition, offset, err := DeserializeKafkaID(id.Serialize())
+	assert.Nil(t, err)
+	assert.EqualValues(t, 1, partition)
+	assert.EqualValues(t, 100, offset)
+
+	_, _, err = DeserializeKafkaID([]byte{1, 2, 3})
+	assert.NotNil(t, err)
+}
+
+func TestKafkaClient(t *testing.T) {
+	topic := "test_kafka_client"
+	broker := newKafkaMockBroker(t, topic, []string{"msg0", "msg1", "msg2"}, map[string]int64{
+		"sub_new":       -1,
+		"sub_committed": 2,
+	})
+	defer broker.Close()
+
+	client, err := NewKafkaClient([]string{broker.Addr()})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	t.Run("produce", func(t *testing.T) {
+		producer, err := client.CreateProducer(ProducerOptions{Topic: topic})
+		assert.Nil(t, err)
+		defer producer.Close()
+		err = producer.Send(context.Background(), &ProducerMessage{
+			Payload:    []byte("msg3"),
+			Properties: map[string]string{"key": "value"},
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("subscribe from earliest", func(t *testing.T) {
+		consumer, err := client.Subscribe(ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "sub_new",
+			SubscriptionInitialPosition: SubscriptionPositionEarliest,
+		})
+		assert.Nil(t, err)
+		defer consumer.Close()
+		assert.Equal(t, "sub_new", consumer.Subscription())
+
+		for i, payload := range []string{"msg0", "msg1", "msg2"} {
+			msg := receiveKafkaMessage(t, consumer)
+			assert.Equal(t, topic, msg.Topic())
+			assert.Equal(t, payload, string(msg.Payload()))
+			assert.Equal(t, SerializeKafkaID(kafkaPartition, int64(i)), msg.ID().Serialize())
+			consumer.Ack(msg)
+		}
+	})
+
+	t.Run("subscribe from committed offset", func(t *testing.T) {
+		consumer, err := client.Subscribe(ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "sub_committed",
+			SubscriptionInitialPosition: SubscriptionPositionEarliest,
+		})
+		assert.Nil(t, err)
+		defer consumer.Close()
+		msg := receiveKafkaMessage(t, consumer)
+		assert.Equal(t, "msg2", string(msg.Payload()))
+	})
+
+	t.Run("seek", func(t *testing.T) {
+		consumer, err := client.Subscribe(ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "sub_new",
+			SubscriptionInitialPosition: SubscriptionPositionEarliest,
+		})
+		assert.Nil(t, err)
+		defer consumer.Close()
+
+		msg := receiveKafkaMessage(t, consumer)
+		assert.Equal(t, "msg0", string(msg.Payload()))
+
+		id, err := client.BytesToMsgID(SerializeKafkaID(kafkaPartition, 1))
+		assert.Nil(t, err)
+		assert.Nil(t, consumer.Seek(id))
+		msg = receiveKafkaMessage(t, consumer)
+		assert.Equal(t, "msg1", string(msg.Payload()))
+
+		assert.Nil(t, consumer.Seek(client.EarliestMessageID()))
+		msg = receiveKafkaMessage(t, consumer)
+		assert.Equal(t, "msg0", string(msg.Payload()))
+	})
+
+	t.Run("message id", func(t *testing.T) {
+		id, err := client.StringToMsgID("2")
+		assert.Nil(t, err)
+		assert.Equal(t, SerializeKafkaID(kafkaPartition, 2), id.Serialize())
+		_, err = client.StringToMsgID("offset")
+		assert.NotNil(t, err)
+		_, err = client.BytesToMsgID([]byte{})
+		assert.NotNil(t, err)
+	})
+}
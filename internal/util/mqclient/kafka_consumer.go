@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+package mqclient
+
+import (
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// kafkaConsumer consumes the only partition of a topic, the offset of the subscription
+// is committed to kafka as the offset of a consumer group named after the subscription.
+type kafkaConsumer struct {
+	consumer      sarama.Consumer
+	offsetManager sarama.OffsetManager
+	pom           sarama.PartitionOffsetManager
+	topic         string
+	subName       string
+
+	mu         sync.Mutex
+	pc         sarama.PartitionConsumer
+	msgChannel chan ConsumerMessage
+	stopCh     chan struct{}
+	wg         sync.WaitGroup
+	closeOnce  sync.Once
+}
+
+func (kc *kafkaConsumer) Subscription() string {
+	return kc.subName
+}
+
+func (kc *kafkaConsumer) Chan() <-chan ConsumerMessage {
+	kc.mu.Lock()
+	defer kc.mu.Unlock()
+	if kc.msgChannel == nil {
+		kc.msgChannel = make(chan ConsumerMessage)
+		kc.startForward()
+	}
+	return kc.msgChannel
+}
+
+// startForward forwards the messages of the current partition consumer to msgChannel, caller must hold the lock
+func (kc *kafkaConsumer) startForward() {
+	if kc.pc == nil {
+		return
+	}
+	pc := kc.pc
+	stopCh := make(chan struct{})
+	kc.stopCh = stopCh
+	kc.wg.Add(1)
+	go func() {
+		defer kc.wg.Done()
+		for {
+			select {
+			case msg, ok := <-pc.Messages():
+				if !ok {
+					log.Debug("kafka partition consumer closed", zap.String("topic", kc.topic))
+					return
+				}
+				select {
+				case kc.msgChannel <- &kafkaMessage{msg: msg}:
+				case <-stopCh:
+					return
+				}
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// stopForward stops forwarding the messages of the current partition consumer, caller must hold the lock
+func (kc *kafkaConsumer) stopForward() {
+	if kc.stopCh == nil {
+		return
+	}
+	close(kc.stopCh)
+	kc.stopCh = nil
+	kc.wg.Wait()
+}
+
+// closePartitionConsumer closes the current partition consumer, caller must hold the lock
+func (kc *kafkaConsumer) closePartitionConsumer() {
+	if kc.pc == nil {
+		return
+	}
+	if err := kc.pc.Close(); err != nil {
+		log.Warn("close kafka partition consumer failed", zap.String("topic", kc.topic), zap.Error(err))
+	}
+	kc.pc = nil
+}
+
+// Seek restarts the consumption from the message of id, the message itself is consumed again
+func (kc *kafkaConsumer) Seek(id MessageID) error {
+	kid := id.(*kafkaID)
+	kc.mu.Lock()
+	defer kc.mu.Unlock()
+	kc.stopForward()
+	kc.closePartitionConsumer()
+	pc, err := kc.consumer.ConsumePartition(kc.topic, kid.partition, kid.offset)
+	if err != nil {
+		return err
+	}
+	kc.pc = pc
+	if kc.msgChannel != nil {
+		kc.startForward()
+	}
+	return nil
+}
+
+func (kc *kafkaConsumer) Ack(message ConsumerMessage) {
+	km := message.(*kafkaMessage)
+	kc.pom.MarkOffset(km.msg.Offset+1, "")
+}
+
+func (kc *kafkaConsumer) Close() {
+	kc.closeOnce.Do(func() {
+		kc.mu.Lock()
+		defer kc.mu.Unlock()
+		kc.stopForward()
+		kc.closePartitionConsumer()
+		kc.pom.Close()
+		kc.offsetManager.Close()
+		kc.consumer.Close()
+		if kc.msgChannel != nil {
+			close(kc.msgChannel)
+		}
+	})
+}
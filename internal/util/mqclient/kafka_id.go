@@ -0,0 +1,43 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+package mqclient
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// kafkaID locates a message in kafka by its partition and offset
+type kafkaID struct {
+	partition int32
+	offset    int64
+}
+
+func (kid *kafkaID) Serialize() []byte {
+	return SerializeKafkaID(kid.partition, kid.offset)
+}
+
+// SerializeKafkaID encodes the partition in the first 4 bytes and the offset in the last 8 bytes
+func SerializeKafkaID(partition int32, offset int64) []byte {
+	b := make([]byte, 12)
+	binary.LittleEndian.PutUint32(b, uint32(partition))
+	binary.LittleEndian.PutUint64(b[4:], uint64(offset))
+	return b
+}
+
+func DeserializeKafkaID(messageID []byte) (int32, int64, error) {
+	if len(messageID) != 12 {
+		return 0, 0, fmt.Errorf("invalid kafka message id length %d", len(messageID))
+	}
+	partition := int32(binary.LittleEndian.Uint32(messageID))
+	offset := int64(binary.LittleEndian.Uint64(messageID[4:]))
+	return partition, offset, nil
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+package mqclient
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+)
+
+type kafkaProducer struct {
+	p     sarama.SyncProducer
+	topic string
+}
+
+func (kp *kafkaProducer) Topic() string {
+	return kp.topic
+}
+
+func (kp *kafkaProducer) Send(ctx context.Context, message *ProducerMessage) error {
+	headers := make([]sarama.RecordHeader, 0, len(message.Properties))
+	for key, value := range message.Properties {
+		headers = append(headers, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+	}
+	kpm := &sarama.ProducerMessage{
+		Topic:     kp.topic,
+		Partition: kafkaPartition,
+		Value:     sarama.ByteEncoder(message.Payload),
+		Headers:   headers,
+	}
+	_, _, err := kp.p.SendMessage(kpm)
+	return err
+}
+
+func (kp *kafkaProducer) Close() {
+	kp.p.Close()
+}
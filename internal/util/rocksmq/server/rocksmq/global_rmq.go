@@ -16,6 +16,7 @@ import (
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 
 	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 )
@@ -41,6 +42,8 @@ func InitRocksMQ(rocksdbName string) error {
 			}
 		}
 
+		initRetentionParams()
+
 		kvname := rocksdbName + "_kv"
 		rocksdbKV, err := rocksdbkv.NewRocksdbKV(kvname)
 		if err != nil {
@@ -57,8 +60,16 @@ func InitRocksMQ(rocksdbName string) error {
 	return err
 }
 
+// initRetentionParams loads the retention configurations of rocksmq from the shared param table
+func initRetentionParams() {
+	paramtable.Params.Init()
+	RocksmqRetentionTimeInMinutes = paramtable.Params.ParseInt64("rocksmq.retentionTimeInMinutes")
+	RocksmqRetentionSizeInMB = paramtable.Params.ParseInt64("rocksmq.retentionSizeInMB")
+	RocksmqCompactionIntervalInSeconds = paramtable.Params.ParseInt64("rocksmq.compactionInterval")
+}
+
 func CloseRocksMQ() {
 	if Rmq != nil && Rmq.store != nil {
-		Rmq.store.Close()
+		Rmq.Close()
 	}
 }
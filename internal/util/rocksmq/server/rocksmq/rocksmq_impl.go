@@ -14,16 +14,20 @@ package rocksmq
 import (
 	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/allocator"
-	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/tecbot/gorocksdb"
+	"go.uber.org/zap"
 
-	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 )
 
 type UniqueID = typeutil.UniqueID
@@ -34,6 +38,17 @@ const (
 	RocksDBLRUCacheCapacity = 3 << 30
 )
 
+var (
+	// RocksmqRetentionTimeInMinutes is how long the messages acknowledged by all consumer groups are retained,
+	// negative means forever
+	RocksmqRetentionTimeInMinutes int64 = 10080
+	// RocksmqRetentionSizeInMB is the size limit of the messages retained in a topic, negative means unlimited,
+	// the oldest acknowledged messages are removed when a topic exceeds it
+	RocksmqRetentionSizeInMB int64 = 8192
+	// RocksmqCompactionIntervalInSeconds is the interval of the background retention compaction, non-positive disables it
+	RocksmqCompactionIntervalInSeconds int64 = 60
+)
+
 /**
  * @brief fill with '_' to ensure channel name fixed length
  */
@@ -63,13 +78,74 @@ func combKey(channelName string, id UniqueID) (string, error) {
 	return fixName + "/" + strconv.FormatInt(id, 10), nil
 }
 
+// retentionPrefix is the kv prefix of the produced batches of a topic
+func retentionPrefix(topicName string) string {
+	return topicName + "/retention/"
+}
+
+// consumerGroupPrefix is the kv prefix of the consumer groups of a topic
+func consumerGroupPrefix(topicName string) string {
+	return topicName + "/consumer_group/"
+}
+
+// producedBatch is the messages produced by a Produce call, their ids are continuous
+type producedBatch struct {
+	beginID     UniqueID
+	endID       UniqueID // exclusive
+	size        int64
+	produceTime time.Time
+}
+
+// key returns the kv key of the batch, the value is encoded by encode
+func (b *producedBatch) key(topicName string) string {
+	return retentionPrefix(topicName) + strconv.FormatInt(b.beginID, 10)
+}
+
+func (b *producedBatch) encode() string {
+	return fmt.Sprintf("%d,%d,%d,%d", b.beginID, b.endID, b.size, b.produceTime.UnixNano())
+}
+
+func decodeProducedBatch(value string) (producedBatch, error) {
+	fields := strings.Split(value, ",")
+	if len(fields) != 4 {
+		return producedBatch{}, fmt.Errorf("invalid produced batch %s", value)
+	}
+	nums := make([]int64, len(fields))
+	for i, field := range fields {
+		num, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return producedBatch{}, fmt.Errorf("invalid produced batch %s, %s", value, err.Error())
+		}
+		nums[i] = num
+	}
+	return producedBatch{
+		beginID:     nums[0],
+		endID:       nums[1],
+		size:        nums[2],
+		produceTime: time.Unix(0, nums[3]),
+	}, nil
+}
+
+// retentionInfo records the retained messages of a topic, it is guarded by the topic lock.
+// The batches are also saved in the meta kv so that the retention survives restarts.
+type retentionInfo struct {
+	batches []producedBatch
+	size    int64
+}
+
 type rocksmq struct {
 	store       *gorocksdb.DB
-	kv          kv.BaseKV
+	kv          *rocksdbkv.RocksdbKV
 	idAllocator allocator.GIDAllocator
 	channelMu   sync.Map
 
 	consumers sync.Map
+
+	retentionInfos sync.Map // topic name -> *retentionInfo
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	closeWg   sync.WaitGroup
 }
 
 func NewRocksMQ(name string, idAllocator allocator.GIDAllocator) (*rocksmq, error) {
@@ -85,18 +161,46 @@ func NewRocksMQ(name string, idAllocator allocator.GIDAllocator) (*rocksmq, erro
 		return nil, err
 	}
 
-	mkv := memkv.NewMemoryKV()
+	// the meta of topics and consumer groups is kept in a rocksdb beside the messages,
+	// so that the retention and the consumed positions are recovered after a restart
+	metaKV, err := rocksdbkv.NewRocksdbKV(metaKVName(name))
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
 
 	rmq := &rocksmq{
 		store:       db,
-		kv:          mkv,
+		kv:          metaKV,
 		idAllocator: idAllocator,
+		closeCh:     make(chan struct{}),
 	}
 	rmq.channelMu = sync.Map{}
 	rmq.consumers = sync.Map{}
+	rmq.retentionInfos = sync.Map{}
+
+	if RocksmqCompactionIntervalInSeconds > 0 {
+		rmq.closeWg.Add(1)
+		go rmq.retentionLoop(time.Duration(RocksmqCompactionIntervalInSeconds) * time.Second)
+	}
 	return rmq, nil
 }
 
+// metaKVName returns the path of the meta kv of the rocksmq at name
+func metaKVName(name string) string {
+	return name + "_meta_kv"
+}
+
+// Close stops the retention compaction and closes the store and the meta kv
+func (rmq *rocksmq) Close() {
+	rmq.closeOnce.Do(func() {
+		close(rmq.closeCh)
+		rmq.closeWg.Wait()
+		rmq.store.Close()
+		rmq.kv.Close()
+	})
+}
+
 func (rmq *rocksmq) checkKeyExist(key string) bool {
 	val, _ := rmq.kv.Load(key)
 	return val != ""
@@ -109,6 +213,16 @@ func (rmq *rocksmq) CreateTopic(topicName string) error {
 	// Check if topic exist
 	if rmq.checkKeyExist(beginKey) || rmq.checkKeyExist(endKey) {
 		log.Debug("RocksMQ: " + beginKey + " or " + endKey + " existed.")
+		// the topic is created before a restart, recover its retained messages from kv
+		if _, ok := rmq.channelMu.Load(topicName); !ok {
+			info, err := rmq.loadRetentionInfo(topicName)
+			if err != nil {
+				log.Debug("RocksMQ: load retention info of " + topicName + " failed.")
+				return err
+			}
+			rmq.channelMu.Store(topicName, new(sync.Mutex))
+			rmq.retentionInfos.Store(topicName, info)
+		}
 		return nil
 	}
 
@@ -124,6 +238,7 @@ func (rmq *rocksmq) CreateTopic(topicName string) error {
 		return err
 	}
 	rmq.channelMu.Store(topicName, new(sync.Mutex))
+	rmq.retentionInfos.Store(topicName, &retentionInfo{})
 
 	return nil
 }
@@ -144,7 +259,26 @@ func (rmq *rocksmq) DestroyTopic(topicName string) error {
 		return err
 	}
 
+	err = rmq.kv.Remove(topicName + "/removed_id")
+	if err != nil {
+		log.Debug("RocksMQ: remove " + topicName + "/removed_id failed.")
+		return err
+	}
+
+	err = rmq.kv.RemoveWithPrefix(retentionPrefix(topicName))
+	if err != nil {
+		log.Debug("RocksMQ: remove " + retentionPrefix(topicName) + " failed.")
+		return err
+	}
+
+	err = rmq.kv.RemoveWithPrefix(consumerGroupPrefix(topicName))
+	if err != nil {
+		log.Debug("RocksMQ: remove " + consumerGroupPrefix(topicName) + " failed.")
+		return err
+	}
+
 	rmq.consumers.Delete(topicName)
+	rmq.retentionInfos.Delete(topicName)
 	log.Debug("DestroyTopic: " + topicName)
 
 	return nil
@@ -170,12 +304,14 @@ func (rmq *rocksmq) CreateConsumerGroup(topicName, groupName string) error {
 		log.Debug("RocksMQ: " + key + " existed.")
 		return nil
 	}
-	err := rmq.kv.Save(key, DefaultMessageID)
+	err := rmq.kv.MultiSave(map[string]string{
+		key: DefaultMessageID,
+		consumerGroupPrefix(topicName) + groupName: groupName,
+	})
 	if err != nil {
 		log.Debug("RocksMQ: save " + key + " failed.")
 		return err
 	}
-
 	return nil
 }
 
@@ -199,7 +335,7 @@ func (rmq *rocksmq) RegisterConsumer(consumer *Consumer) {
 func (rmq *rocksmq) DestroyConsumerGroup(topicName, groupName string) error {
 	key := groupName + "/" + topicName + "/current_id"
 
-	err := rmq.kv.Remove(key)
+	err := rmq.kv.MultiRemove([]string{key, consumerGroupPrefix(topicName) + groupName})
 	if err != nil {
 		log.Debug("RocksMQ: remove " + key + " failed.")
 		return err
@@ -248,6 +384,7 @@ func (rmq *rocksmq) Produce(topicName string, messages []ProducerMessage) error
 
 	/* Step I: Insert data to store system */
 	batch := gorocksdb.NewWriteBatch()
+	var batchSize int64
 	for i := 0; i < msgLen && idStart+UniqueID(i) < idEnd; i++ {
 		key, err := combKey(topicName, idStart+UniqueID(i))
 		if err != nil {
@@ -256,6 +393,7 @@ func (rmq *rocksmq) Produce(topicName string, messages []ProducerMessage) error
 		}
 
 		batch.Put([]byte(key), messages[i].Payload)
+		batchSize += int64(len(messages[i].Payload))
 	}
 
 	err = rmq.store.Write(gorocksdb.NewDefaultWriteOptions(), batch)
@@ -283,12 +421,26 @@ func (rmq *rocksmq) Produce(topicName string, messages []ProducerMessage) error
 	kvChannelEndID := topicName + "/end_id"
 	kvValues[kvChannelEndID] = strconv.FormatInt(idEnd, 10)
 
+	produced := producedBatch{
+		beginID:     idStart,
+		endID:       idEnd,
+		size:        batchSize,
+		produceTime: time.Now(),
+	}
+	kvValues[produced.key(topicName)] = produced.encode()
+
 	err = rmq.kv.MultiSave(kvValues)
 	if err != nil {
 		log.Debug("RocksMQ: multisave failed")
 		return err
 	}
 
+	if info, ok := rmq.retentionInfos.Load(topicName); ok {
+		info := info.(*retentionInfo)
+		info.batches = append(info.batches, produced)
+		info.size += batchSize
+	}
+
 	if vals, ok := rmq.consumers.Load(topicName); ok {
 		for _, v := range vals.([]*Consumer) {
 			select {
@@ -341,7 +493,12 @@ func (rmq *rocksmq) Consume(topicName string, groupName string, n int) ([]Consum
 	// we move currentID to first location.
 	// Note that we assume currentId is always correct and not larger than the latest endID.
 	if iter.Seek([]byte(dataKey)); currentID != DefaultMessageID && iter.Valid() {
-		iter.Next()
+		// the message of currentID may have been removed by retention, then iter is at the next message
+		key := iter.Key()
+		if string(key.Data()) == dataKey {
+			iter.Next()
+		}
+		key.Free()
 	} else {
 		newKey := fixChanName + "/"
 		iter.Seek([]byte(newKey))
@@ -394,6 +551,16 @@ func (rmq *rocksmq) Seek(topicName string, groupName string, msgID UniqueID) err
 		return fmt.Errorf("ConsumerGroup %s, channel %s not exists", groupName, topicName)
 	}
 
+	removedID, err := rmq.kv.Load(topicName + "/removed_id")
+	if err != nil {
+		log.Debug("RocksMQ: load removed id of " + topicName + " failed")
+		return err
+	}
+	if id, err := strconv.ParseInt(removedID, 10, 64); err == nil && msgID <= id {
+		return fmt.Errorf("message %d of topic %s has been removed by retention, messages up to %d are removed",
+			msgID, topicName, id)
+	}
+
 	storeKey, err := combKey(topicName, msgID)
 	if err != nil {
 		log.Debug("RocksMQ: combKey(" + topicName + "," + strconv.FormatInt(msgID, 10) + ") failed")
@@ -431,3 +598,165 @@ func (rmq *rocksmq) Notify(topicName, groupName string) {
 		}
 	}
 }
+
+// retentionLoop removes the expired messages of all topics every interval until rocksmq is closed
+func (rmq *rocksmq) retentionLoop(interval time.Duration) {
+	defer rmq.closeWg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-rmq.closeCh:
+			return
+		case <-ticker.C:
+			rmq.expireMessages(time.Now())
+		}
+	}
+}
+
+// expireMessages removes the expired messages of all topics
+func (rmq *rocksmq) expireMessages(now time.Time) {
+	rmq.retentionInfos.Range(func(key, value interface{}) bool {
+		topicName := key.(string)
+		removed, err := rmq.expireTopicMessages(topicName, now)
+		if err != nil {
+			log.Warn("RocksMQ: failed to remove expired messages", zap.String("topic", topicName), zap.Error(err))
+		} else if removed > 0 {
+			log.Debug("RocksMQ: removed expired messages", zap.String("topic", topicName), zap.Int("count", removed))
+		}
+		return true
+	})
+}
+
+// loadRetentionInfo loads the retained messages of the topic from kv
+func (rmq *rocksmq) loadRetentionInfo(topicName string) (*retentionInfo, error) {
+	_, values, err := rmq.kv.LoadWithPrefix(retentionPrefix(topicName))
+	if err != nil {
+		return nil, err
+	}
+	info := &retentionInfo{
+		batches: make([]producedBatch, 0, len(values)),
+	}
+	for _, value := range values {
+		batch, err := decodeProducedBatch(value)
+		if err != nil {
+			return nil, err
+		}
+		info.batches = append(info.batches, batch)
+		info.size += batch.size
+	}
+	sort.Slice(info.batches, func(i, j int) bool {
+		return info.batches[i].beginID < info.batches[j].beginID
+	})
+	return info, nil
+}
+
+// getAckedID returns the largest message id acknowledged by all consumer groups of the topic, that is
+// the smallest current id of them. No message of a topic without consumer group is acknowledged, -1
+// is returned then.
+func (rmq *rocksmq) getAckedID(topicName string) (UniqueID, error) {
+	_, groupNames, err := rmq.kv.LoadWithPrefix(consumerGroupPrefix(topicName))
+	if err != nil {
+		return 0, err
+	}
+	if len(groupNames) == 0 {
+		return -1, nil
+	}
+
+	ackedID := UniqueID(math.MaxInt64)
+	for _, groupName := range groupNames {
+		currentID, err := rmq.kv.Load(groupName + "/" + topicName + "/current_id")
+		if err != nil {
+			return 0, err
+		}
+		id, err := strconv.ParseInt(currentID, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		if id < ackedID {
+			ackedID = id
+		}
+	}
+	return ackedID, nil
+}
+
+// expireTopicMessages removes the messages acknowledged by all consumer groups that are older than
+// RocksmqRetentionTimeInMinutes, or are the oldest ones when the topic exceeds RocksmqRetentionSizeInMB.
+// It returns the number of removed messages.
+func (rmq *rocksmq) expireTopicMessages(topicName string, now time.Time) (int, error) {
+	ll, ok := rmq.channelMu.Load(topicName)
+	if !ok {
+		return 0, fmt.Errorf("topic name = %s not exist", topicName)
+	}
+	lock, ok := ll.(*sync.Mutex)
+	if !ok {
+		return 0, fmt.Errorf("get mutex failed, topic name = %s", topicName)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	value, ok := rmq.retentionInfos.Load(topicName)
+	if !ok {
+		return 0, nil
+	}
+	info := value.(*retentionInfo)
+	ackedID, err := rmq.getAckedID(topicName)
+	if err != nil {
+		return 0, err
+	}
+
+	retentionTime := time.Duration(RocksmqRetentionTimeInMinutes) * time.Minute
+	retentionSize := RocksmqRetentionSizeInMB * 1024 * 1024
+	size := info.size
+	expired := 0
+	for _, batch := range info.batches {
+		if batch.endID-1 > ackedID {
+			break
+		}
+		timeExpired := RocksmqRetentionTimeInMinutes >= 0 && now.Sub(batch.produceTime) >= retentionTime
+		sizeExceeded := RocksmqRetentionSizeInMB >= 0 && size > retentionSize
+		if !timeExpired && !sizeExceeded {
+			break
+		}
+		size -= batch.size
+		expired++
+	}
+	if expired == 0 {
+		return 0, nil
+	}
+
+	writeBatch := gorocksdb.NewWriteBatch()
+	defer writeBatch.Destroy()
+	removed := 0
+	batchKeys := make([]string, 0, expired)
+	for _, batch := range info.batches[:expired] {
+		batchKeys = append(batchKeys, batch.key(topicName))
+		for id := batch.beginID; id < batch.endID; id++ {
+			key, err := combKey(topicName, id)
+			if err != nil {
+				return 0, err
+			}
+			writeBatch.Delete([]byte(key))
+			removed++
+		}
+	}
+	if err := rmq.store.Write(gorocksdb.NewDefaultWriteOptions(), writeBatch); err != nil {
+		return 0, err
+	}
+
+	removedID := info.batches[expired-1].endID - 1
+	beginID := removedID + 1
+	if expired < len(info.batches) {
+		beginID = info.batches[expired].beginID
+	}
+	err = rmq.kv.MultiSaveAndRemove(map[string]string{
+		topicName + "/begin_id":   strconv.FormatInt(beginID, 10),
+		topicName + "/removed_id": strconv.FormatInt(removedID, 10),
+	}, batchKeys)
+	if err != nil {
+		return 0, err
+	}
+	info.batches = info.batches[expired:]
+	info.size = size
+	return removed, nil
+}
@@ -54,6 +54,8 @@ func TestRocksMQ(t *testing.T) {
 	name := "/tmp/rocksmq"
 	_ = os.RemoveAll(name)
 	defer os.RemoveAll(name)
+	_ = os.RemoveAll(metaKVName(name))
+	defer os.RemoveAll(metaKVName(name))
 	rmq, err := NewRocksMQ(name, idAllocator)
 	assert.Nil(t, err)
 
@@ -107,6 +109,8 @@ func TestRocksMQ_Loop(t *testing.T) {
 	name := "/tmp/rocksmq_1"
 	_ = os.RemoveAll(name)
 	defer os.RemoveAll(name)
+	_ = os.RemoveAll(metaKVName(name))
+	defer os.RemoveAll(metaKVName(name))
 	rmq, err := NewRocksMQ(name, idAllocator)
 	assert.Nil(t, err)
 
@@ -170,6 +174,8 @@ func TestRocksMQ_Goroutines(t *testing.T) {
 
 	name := "/tmp/rocksmq_2"
 	defer os.RemoveAll(name)
+	_ = os.RemoveAll(metaKVName(name))
+	defer os.RemoveAll(metaKVName(name))
 	rmq, err := NewRocksMQ(name, idAllocator)
 	assert.Nil(t, err)
 
@@ -237,6 +243,8 @@ func TestRocksMQ_Throughout(t *testing.T) {
 
 	name := "/tmp/rocksmq_3"
 	defer os.RemoveAll(name)
+	_ = os.RemoveAll(metaKVName(name))
+	defer os.RemoveAll(metaKVName(name))
 	rmq, err := NewRocksMQ(name, idAllocator)
 	assert.Nil(t, err)
 
@@ -287,6 +295,8 @@ func TestRocksMQ_MultiChan(t *testing.T) {
 
 	name := "/tmp/rocksmq_multichan"
 	defer os.RemoveAll(name)
+	_ = os.RemoveAll(metaKVName(name))
+	defer os.RemoveAll(metaKVName(name))
 	rmq, err := NewRocksMQ(name, idAllocator)
 	assert.Nil(t, err)
 
@@ -321,3 +331,124 @@ func TestRocksMQ_MultiChan(t *testing.T) {
 	assert.Equal(t, len(cMsgs), 1)
 	assert.Equal(t, string(cMsgs[0].Payload), "for_chann1_"+strconv.Itoa(0))
 }
+
+func TestRocksMQ_Retention(t *testing.T) {
+	cli, err := newEtcdClient()
+	assert.Nil(t, err)
+	etcdKV := etcdkv.NewEtcdKV(cli, "/etcd/test/root")
+	defer etcdKV.Close()
+	idAllocator := allocator.NewGlobalIDAllocator("dummy", etcdKV)
+	_ = idAllocator.Initialize()
+
+	name := "/tmp/rocksmq_retention"
+	_ = os.RemoveAll(name)
+	defer os.RemoveAll(name)
+	_ = os.RemoveAll(metaKVName(name))
+	defer os.RemoveAll(metaKVName(name))
+	rmq, err := NewRocksMQ(name, idAllocator)
+	assert.Nil(t, err)
+	defer rmq.Close()
+
+	retentionTime, retentionSize := RocksmqRetentionTimeInMinutes, RocksmqRetentionSizeInMB
+	defer func() {
+		RocksmqRetentionTimeInMinutes, RocksmqRetentionSizeInMB = retentionTime, retentionSize
+	}()
+
+	channelName := "channel_retention"
+	err = rmq.CreateTopic(channelName)
+	assert.Nil(t, err)
+	defer rmq.DestroyTopic(channelName)
+
+	for i := 0; i < 4; i++ {
+		_ = idAllocator.UpdateID()
+		err = rmq.Produce(channelName, []ProducerMessage{{Payload: []byte("message_" + strconv.Itoa(i))}})
+		assert.Nil(t, err)
+	}
+
+	// no message is acknowledged without consumer group
+	RocksmqRetentionTimeInMinutes, RocksmqRetentionSizeInMB = 0, 0
+	removed, err := rmq.expireTopicMessages(channelName, time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, removed)
+
+	groupName := "group_retention"
+	err = rmq.CreateConsumerGroup(channelName, groupName)
+	assert.Nil(t, err)
+
+	// messages are not acknowledged
+	RocksmqRetentionTimeInMinutes, RocksmqRetentionSizeInMB = 0, -1
+	removed, err = rmq.expireTopicMessages(channelName, time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, removed)
+
+	cMsgs, err := rmq.Consume(channelName, groupName, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(cMsgs))
+
+	// acknowledged messages are retained within retention time and size
+	RocksmqRetentionTimeInMinutes, RocksmqRetentionSizeInMB = 60, -1
+	removed, err = rmq.expireTopicMessages(channelName, time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, removed)
+
+	RocksmqRetentionTimeInMinutes, RocksmqRetentionSizeInMB = 60, 0
+	removed, err = rmq.expireTopicMessages(channelName, time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, removed)
+
+	// the message of current id is removed, consumption continues from the next one
+	next, err := rmq.Consume(channelName, groupName, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(next))
+	assert.Equal(t, "message_2", string(next[0].Payload))
+
+	err = rmq.Seek(channelName, groupName, cMsgs[0].MsgID)
+	assert.NotNil(t, err)
+	err = rmq.Seek(channelName, groupName, next[0].MsgID)
+	assert.Nil(t, err)
+
+	// the retained messages and the consumed position are recovered from the meta kv after a restart
+	rmq.Close()
+	rmq, err = NewRocksMQ(name, idAllocator)
+	assert.Nil(t, err)
+	defer rmq.Close()
+	err = rmq.CreateTopic(channelName)
+	assert.Nil(t, err)
+	value, ok := rmq.retentionInfos.Load(channelName)
+	assert.True(t, ok)
+	info := value.(*retentionInfo)
+	assert.Equal(t, 2, len(info.batches))
+	assert.Equal(t, next[0].MsgID, info.batches[0].beginID)
+	assert.Equal(t, int64(len("message_2")+len("message_3")), info.size)
+
+	// the message of current id is removed by time
+	RocksmqRetentionTimeInMinutes, RocksmqRetentionSizeInMB = 60, -1
+	removed, err = rmq.expireTopicMessages(channelName, time.Now().Add(2*time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, removed)
+	last, err := rmq.Consume(channelName, groupName, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(last))
+	assert.Equal(t, "message_3", string(last[0].Payload))
+
+	err = rmq.DestroyTopic(channelName)
+	assert.Nil(t, err)
+	info, err = rmq.loadRetentionInfo(channelName)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(info.batches))
+	ackedID, err := rmq.getAckedID(channelName)
+	assert.Nil(t, err)
+	assert.Equal(t, UniqueID(-1), ackedID)
+}
+
+func TestProducedBatch(t *testing.T) {
+	batch := producedBatch{beginID: 1, endID: 3, size: 10, produceTime: time.Unix(0, 100)}
+	decoded, err := decodeProducedBatch(batch.encode())
+	assert.Nil(t, err)
+	assert.Equal(t, batch, decoded)
+
+	_, err = decodeProducedBatch("1,3,10")
+	assert.NotNil(t, err)
+	_, err = decodeProducedBatch("1,3,a,100")
+	assert.NotNil(t, err)
+}
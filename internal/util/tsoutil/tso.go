@@ -44,6 +44,20 @@ func Mod24H(ts uint64) uint64 {
 	return (physical << logicalBits) | logical
 }
 
+// SubPhysicalDuration returns the ts whose physical time is the duration before that of ts
+func SubPhysicalDuration(ts uint64, duration time.Duration) uint64 {
+	physical := int64(ts>>logicalBits) - duration.Milliseconds()
+	if physical < 0 {
+		return 0
+	}
+	return ComposeTS(physical, int64(ts&logicalBitsMask))
+}
+
+// GetCurrentTime returns the ts of the current physical time
+func GetCurrentTime() uint64 {
+	return ComposeTS(time.Now().UnixNano()/int64(time.Millisecond), 0)
+}
+
 func NewTSOKVBase(etcdEndpoints []string, tsoRoot, subPath string) *etcdkv.EtcdKV {
 	client, _ := clientv3.New(clientv3.Config{
 		Endpoints:   etcdEndpoints,
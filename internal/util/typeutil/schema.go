@@ -14,6 +14,7 @@ package typeutil
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
@@ -161,3 +162,232 @@ func IsFloatingType(dataType schemapb.DataType) bool {
 		return false
 	}
 }
+
+// GetSizeOfIDs returns the number of primary keys in ids
+func GetSizeOfIDs(ids *schemapb.IDs) int {
+	switch ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		return len(ids.GetIntId().GetData())
+	case *schemapb.IDs_StrId:
+		return len(ids.GetStrId().GetData())
+	}
+	return 0
+}
+
+// GetPK returns the primary key at idx of ids, which is an int64 or a string
+func GetPK(ids *schemapb.IDs, idx int) interface{} {
+	if ids.GetStrId() != nil {
+		return ids.GetStrId().GetData()[idx]
+	}
+	return ids.GetIntId().GetData()[idx]
+}
+
+// ComparePK compares two primary keys of the same type, the result is negative if a < b, 0 if a == b
+// and positive if a > b
+func ComparePK(a, b interface{}) int {
+	switch a := a.(type) {
+	case int64:
+		b := b.(int64)
+		if a < b {
+			return -1
+		} else if a > b {
+			return 1
+		}
+		return 0
+	case string:
+		b := b.(string)
+		if a < b {
+			return -1
+		} else if a > b {
+			return 1
+		}
+		return 0
+	}
+	panic(fmt.Sprintf("unsupported primary key type %T", a))
+}
+
+// AppendPK appends a primary key returned by GetPK to ids
+func AppendPK(ids *schemapb.IDs, pk interface{}) {
+	switch pk := pk.(type) {
+	case int64:
+		if ids.GetIntId() == nil {
+			ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{}}
+		}
+		ids.GetIntId().Data = append(ids.GetIntId().Data, pk)
+	case string:
+		if ids.GetStrId() == nil {
+			ids.IdField = &schemapb.IDs_StrId{StrId: &schemapb.StringArray{}}
+		}
+		ids.GetStrId().Data = append(ids.GetStrId().Data, pk)
+	}
+}
+
+// AppendFieldData appends the row at idx of the fields in src to the fields in dst, dst and src
+// have the same number of fields and nil fields in dst are created on demand
+func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx int64) {
+	for i, fieldData := range src {
+		switch fieldType := fieldData.Field.(type) {
+		case *schemapb.FieldData_Scalars:
+			if dst[i] == nil || dst[i].GetScalars() == nil {
+				dst[i] = &schemapb.FieldData{
+					Type:      fieldData.Type,
+					FieldName: fieldData.FieldName,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{},
+					},
+				}
+			}
+			dstScalar := dst[i].GetScalars()
+			switch srcScalar := fieldType.Scalars.Data.(type) {
+			case *schemapb.ScalarField_BoolData:
+				if dstScalar.GetBoolData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{}}
+				}
+				dstScalar.GetBoolData().Data = append(dstScalar.GetBoolData().Data, srcScalar.BoolData.Data[idx])
+			case *schemapb.ScalarField_IntData:
+				if dstScalar.GetIntData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{}}
+				}
+				dstScalar.GetIntData().Data = append(dstScalar.GetIntData().Data, srcScalar.IntData.Data[idx])
+			case *schemapb.ScalarField_LongData:
+				if dstScalar.GetLongData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{}}
+				}
+				dstScalar.GetLongData().Data = append(dstScalar.GetLongData().Data, srcScalar.LongData.Data[idx])
+			case *schemapb.ScalarField_FloatData:
+				if dstScalar.GetFloatData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{}}
+				}
+				dstScalar.GetFloatData().Data = append(dstScalar.GetFloatData().Data, srcScalar.FloatData.Data[idx])
+			case *schemapb.ScalarField_DoubleData:
+				if dstScalar.GetDoubleData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{}}
+				}
+				dstScalar.GetDoubleData().Data = append(dstScalar.GetDoubleData().Data, srcScalar.DoubleData.Data[idx])
+			case *schemapb.ScalarField_StringData:
+				if dstScalar.GetStringData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{}}
+				}
+				dstScalar.GetStringData().Data = append(dstScalar.GetStringData().Data, srcScalar.StringData.Data[idx])
+			}
+		case *schemapb.FieldData_Vectors:
+			dim := fieldType.Vectors.Dim
+			if dst[i] == nil || dst[i].GetVectors() == nil {
+				dst[i] = &schemapb.FieldData{
+					Type:      fieldData.Type,
+					FieldName: fieldData.FieldName,
+					Field: &schemapb.FieldData_Vectors{
+						Vectors: &schemapb.VectorField{
+							Dim: dim,
+						},
+					},
+				}
+			}
+			dstVector := dst[i].GetVectors()
+			switch srcVector := fieldType.Vectors.Data.(type) {
+			case *schemapb.VectorField_BinaryVector:
+				if dstVector.GetBinaryVector() == nil {
+					dstVector.Data = &schemapb.VectorField_BinaryVector{}
+				}
+				rowBytes := dim / 8
+				dstVector.Data.(*schemapb.VectorField_BinaryVector).BinaryVector = append(dstVector.GetBinaryVector(),
+					srcVector.BinaryVector[idx*rowBytes:(idx+1)*rowBytes]...)
+			case *schemapb.VectorField_FloatVector:
+				if dstVector.GetFloatVector() == nil {
+					dstVector.Data = &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{}}
+				}
+				dstVector.GetFloatVector().Data = append(dstVector.GetFloatVector().Data,
+					srcVector.FloatVector.Data[idx*dim:(idx+1)*dim]...)
+			}
+		}
+	}
+}
+
+// ValidateDefaultValue checks that field has a default value of its data type, only the scalar
+// fields have default values
+func ValidateDefaultValue(field *schemapb.FieldSchema) error {
+	value := field.GetDefaultValue()
+	if value == nil {
+		return fmt.Errorf("field %s has no default value", field.GetName())
+	}
+	var ok bool
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		_, ok = value.Data.(*schemapb.ValueField_BoolData)
+	case schemapb.DataType_Int8:
+		_, ok = value.Data.(*schemapb.ValueField_IntData)
+		ok = ok && value.GetIntData() >= math.MinInt8 && value.GetIntData() <= math.MaxInt8
+	case schemapb.DataType_Int16:
+		_, ok = value.Data.(*schemapb.ValueField_IntData)
+		ok = ok && value.GetIntData() >= math.MinInt16 && value.GetIntData() <= math.MaxInt16
+	case schemapb.DataType_Int32:
+		_, ok = value.Data.(*schemapb.ValueField_IntData)
+	case schemapb.DataType_Int64:
+		_, ok = value.Data.(*schemapb.ValueField_LongData)
+	case schemapb.DataType_Float:
+		_, ok = value.Data.(*schemapb.ValueField_FloatData)
+	case schemapb.DataType_Double:
+		_, ok = value.Data.(*schemapb.ValueField_DoubleData)
+	case schemapb.DataType_String:
+		_, ok = value.Data.(*schemapb.ValueField_StringData)
+	default:
+		return fmt.Errorf("field %s of type %s can't have a default value", field.GetName(), field.GetDataType())
+	}
+	if !ok {
+		return fmt.Errorf("invalid default value %v of field %s of type %s", value, field.GetName(), field.GetDataType())
+	}
+	return nil
+}
+
+// GenDefaultFieldData returns the data of numRows rows of field, all of which are the default value
+// of field
+func GenDefaultFieldData(field *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, error) {
+	if err := ValidateDefaultValue(field); err != nil {
+		return nil, err
+	}
+	value := field.GetDefaultValue()
+	scalars := &schemapb.ScalarField{}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		data := make([]bool, numRows)
+		for i := range data {
+			data[i] = value.GetBoolData()
+		}
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		data := make([]int32, numRows)
+		for i := range data {
+			data[i] = value.GetIntData()
+		}
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	case schemapb.DataType_Int64:
+		data := make([]int64, numRows)
+		for i := range data {
+			data[i] = value.GetLongData()
+		}
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case schemapb.DataType_Float:
+		data := make([]float32, numRows)
+		for i := range data {
+			data[i] = value.GetFloatData()
+		}
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+	case schemapb.DataType_Double:
+		data := make([]float64, numRows)
+		for i := range data {
+			data[i] = value.GetDoubleData()
+		}
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}
+	case schemapb.DataType_String:
+		data := make([]string, numRows)
+		for i := range data {
+			data[i] = value.GetStringData()
+		}
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	}
+	return &schemapb.FieldData{
+		Type:      field.GetDataType(),
+		FieldName: field.GetName(),
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+	}, nil
+}
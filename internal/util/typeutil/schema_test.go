@@ -0,0 +1,130 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPK(t *testing.T) {
+	intIDs := &schemapb.IDs{}
+	assert.Equal(t, 0, GetSizeOfIDs(intIDs))
+	AppendPK(intIDs, int64(3))
+	AppendPK(intIDs, int64(1))
+	assert.Equal(t, 2, GetSizeOfIDs(intIDs))
+	assert.Equal(t, []int64{3, 1}, intIDs.GetIntId().GetData())
+	assert.Equal(t, 1, ComparePK(GetPK(intIDs, 0), GetPK(intIDs, 1)))
+
+	strIDs := &schemapb.IDs{}
+	AppendPK(strIDs, "a")
+	AppendPK(strIDs, "b")
+	assert.Equal(t, []string{"a", "b"}, strIDs.GetStrId().GetData())
+	assert.Equal(t, -1, ComparePK(GetPK(strIDs, 0), GetPK(strIDs, 1)))
+	assert.Equal(t, 0, ComparePK("a", "a"))
+}
+
+func TestAppendFieldData(t *testing.T) {
+	src := []*schemapb.FieldData{
+		{
+			Type:      schemapb.DataType_Int64,
+			FieldName: "int64",
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+				},
+			},
+		},
+		{
+			Type:      schemapb.DataType_String,
+			FieldName: "string",
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b"}}},
+				},
+			},
+		},
+		{
+			Type:      schemapb.DataType_FloatVector,
+			FieldName: "float_vector",
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim:  2,
+					Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: []float32{1, 2, 3, 4}}},
+				},
+			},
+		},
+		{
+			Type:      schemapb.DataType_BinaryVector,
+			FieldName: "binary_vector",
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim:  8,
+					Data: &schemapb.VectorField_BinaryVector{BinaryVector: []byte{1, 2}},
+				},
+			},
+		},
+	}
+
+	dst := make([]*schemapb.FieldData, len(src))
+	AppendFieldData(dst, src, 1)
+	AppendFieldData(dst, src, 0)
+	assert.Equal(t, "int64", dst[0].GetFieldName())
+	assert.Equal(t, schemapb.DataType_Int64, dst[0].GetType())
+	assert.Equal(t, []int64{2, 1}, dst[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []string{"b", "a"}, dst[1].GetScalars().GetStringData().GetData())
+	assert.EqualValues(t, 2, dst[2].GetVectors().GetDim())
+	assert.Equal(t, []float32{3, 4, 1, 2}, dst[2].GetVectors().GetFloatVector().GetData())
+	assert.Equal(t, []byte{2, 1}, dst[3].GetVectors().GetBinaryVector())
+	// src is not modified
+	assert.Equal(t, []byte{1, 2}, src[3].GetVectors().GetBinaryVector())
+}
+
+func TestGenDefaultFieldData(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:         "age",
+		DataType:     schemapb.DataType_Int16,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 18}},
+	}
+	assert.Nil(t, ValidateDefaultValue(field))
+	fieldData, err := GenDefaultFieldData(field, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, "age", fieldData.GetFieldName())
+	assert.Equal(t, schemapb.DataType_Int16, fieldData.GetType())
+	assert.Equal(t, []int32{18, 18, 18}, fieldData.GetScalars().GetIntData().GetData())
+
+	field = &schemapb.FieldSchema{
+		Name:         "name",
+		DataType:     schemapb.DataType_String,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "none"}},
+	}
+	fieldData, err = GenDefaultFieldData(field, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"none", "none"}, fieldData.GetScalars().GetStringData().GetData())
+
+	invalidFields := []*schemapb.FieldSchema{
+		{Name: "no_default", DataType: schemapb.DataType_Int64},
+		{Name: "mismatch", DataType: schemapb.DataType_Int64,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 1}}},
+		{Name: "overflow", DataType: schemapb.DataType_Int8,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 128}}},
+		{Name: "vector", DataType: schemapb.DataType_FloatVector,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_FloatData{FloatData: 1}}},
+	}
+	for _, field := range invalidFields {
+		assert.NotNil(t, ValidateDefaultValue(field), field.GetName())
+		_, err = GenDefaultFieldData(field, 1)
+		assert.NotNil(t, err, field.GetName())
+	}
+}
@@ -25,3 +25,9 @@ const (
 	DataCoordRole  = "DataCoord"
 	DataNodeRole   = "DataNode"
 )
+
+// keys of the memory usage in bytes in the extra info of the component states of query nodes
+const (
+	MemoryUsedKey  = "memory_used"
+	MemoryTotalKey = "memory_total"
+)